@@ -94,6 +94,10 @@ type NodeAllocationRequestSpec struct {
 type NodeGroup struct {
 	NodeGroupData hwmgmtv1alpha1.NodeGroupData `json:"nodeGroupData"` // Explicitly include as a named field
 	Size          int                          `json:"size" yaml:"size"`
+	// NodesToRemove lists the AllocatedNodes to release when the size of the group is reduced.
+	// AllocatedNodes that are already released are ignored.
+	// +optional
+	NodesToRemove []string `json:"nodesToRemove,omitempty"`
 }
 
 type Properties struct {
@@ -0,0 +1,101 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NodeReservationSpec describes the hardware to hold for a planned deployment
+type NodeReservationSpec struct {
+	// ClusterID is the identifier of the O-Cloud request, such as a ProvisioningRequest, the hardware is held for.
+	// Only the NodeAllocationRequests with the same ClusterId may allocate the reserved hardware.
+	//
+	// +kubebuilder:validation:Required
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Cluster ID",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ClusterId string `json:"clusterId"`
+
+	// LocationSpec is the geographical location of the reserved hardware.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Location Spec",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	LocationSpec `json:",inline"`
+
+	// HardwarePluginRef is the name of the HardwarePlugin.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Hardware Plugin Reference",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	HardwarePluginRef string `json:"hardwarePluginRef,omitempty"`
+
+	// NodeGroup lists the node groups to hold hardware for, and how many nodes each of them needs.
+	// +kubebuilder:validation:MinItems=1
+	//+operator-sdk:csv:customresourcedefinitions:type=spec
+	NodeGroup []NodeGroup `json:"nodeGroup"`
+
+	// ExpiresAt is the time at which the reserved hardware is released, if it was not allocated by then.
+	// +kubebuilder:validation:Required
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Expires At",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	ExpiresAt metav1.Time `json:"expiresAt"`
+}
+
+// ReservedNode identifies a node held by a reservation
+type ReservedNode struct {
+	// GroupName is the node group the node is held for
+	GroupName string `json:"groupName"`
+
+	// HwMgrNodeId is the node identifier from the hardware manager.
+	HwMgrNodeId string `json:"hwMgrNodeId"`
+
+	// HwMgrNodeNs is the node namespace from the hardware manager.
+	HwMgrNodeNs string `json:"hwMgrNodeNs,omitempty"`
+}
+
+// NodeReservationStatus describes the observed state of a reservation
+type NodeReservationStatus struct {
+	// ReservedNodes lists the nodes held by the reservation
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	ReservedNodes []ReservedNode `json:"reservedNodes,omitempty"`
+
+	// Conditions represent the latest available observations of a NodeReservation's state.
+	// The Reserved condition is true once all the requested nodes are held.
+	// +optional
+	// +kubebuilder:validation:Type=array
+	// +kubebuilder:validation:Items=Type=object
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	Conditions []metav1.Condition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type" protobuf:"bytes,1,rep,name=conditions"`
+}
+
+// NodeReservation is the schema for holding hardware for a planned deployment
+//
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:path=nodereservations,shortName=nres
+// +kubebuilder:printcolumn:name="HardwarePlugin",type="string",JSONPath=".spec.hardwarePluginRef"
+// +kubebuilder:printcolumn:name="Cluster ID",type="string",JSONPath=".spec.clusterId"
+// +kubebuilder:printcolumn:name="Expires At",type="date",JSONPath=".spec.expiresAt"
+// +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+// +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.conditions[-1:].reason"
+// +operator-sdk:csv:customresourcedefinitions:displayName="Node Reservation",resources={{Namespace, v1}}
+type NodeReservation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NodeReservationSpec   `json:"spec,omitempty"`
+	Status NodeReservationStatus `json:"status,omitempty"`
+}
+
+// NodeReservationList contains a list of node reservations.
+//
+// +kubebuilder:object:root=true
+type NodeReservationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []NodeReservation `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&NodeReservation{},
+		&NodeReservationList{},
+	)
+}
@@ -349,6 +349,11 @@ func (in *NodeAllocationRequestStatus) DeepCopy() *NodeAllocationRequestStatus {
 func (in *NodeGroup) DeepCopyInto(out *NodeGroup) {
 	*out = *in
 	in.NodeGroupData.DeepCopyInto(&out.NodeGroupData)
+	if in.NodesToRemove != nil {
+		in, out := &in.NodesToRemove, &out.NodesToRemove
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroup.
@@ -361,6 +366,116 @@ func (in *NodeGroup) DeepCopy() *NodeGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReservation) DeepCopyInto(out *NodeReservation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReservation.
+func (in *NodeReservation) DeepCopy() *NodeReservation {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReservation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReservation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReservationList) DeepCopyInto(out *NodeReservationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]NodeReservation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReservationList.
+func (in *NodeReservationList) DeepCopy() *NodeReservationList {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReservationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *NodeReservationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReservationSpec) DeepCopyInto(out *NodeReservationSpec) {
+	*out = *in
+	out.LocationSpec = in.LocationSpec
+	if in.NodeGroup != nil {
+		in, out := &in.NodeGroup, &out.NodeGroup
+		*out = make([]NodeGroup, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReservationSpec.
+func (in *NodeReservationSpec) DeepCopy() *NodeReservationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReservationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeReservationStatus) DeepCopyInto(out *NodeReservationStatus) {
+	*out = *in
+	if in.ReservedNodes != nil {
+		in, out := &in.ReservedNodes, &out.ReservedNodes
+		*out = make([]ReservedNode, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeReservationStatus.
+func (in *NodeReservationStatus) DeepCopy() *NodeReservationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeReservationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Properties) DeepCopyInto(out *Properties) {
 	*out = *in
@@ -380,3 +495,18 @@ func (in *Properties) DeepCopy() *Properties {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReservedNode) DeepCopyInto(out *ReservedNode) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReservedNode.
+func (in *ReservedNode) DeepCopy() *ReservedNode {
+	if in == nil {
+		return nil
+	}
+	out := new(ReservedNode)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -13,6 +13,7 @@ const (
 	Provisioned ConditionType = "Provisioned"
 	Configured  ConditionType = "Configured"
 	Validation  ConditionType = "Validation"
+	Reserved    ConditionType = "Reserved"
 	Unknown     ConditionType = "Unknown" // Indicates the condition has not been evaluated
 )
 
@@ -29,6 +30,11 @@ const (
 	ConfigUpdate   ConditionReason = "ConfigurationUpdateRequested"
 	ConfigApplied  ConditionReason = "ConfigurationApplied"
 	InvalidInput   ConditionReason = "InvalidUserInput"
+	// PlacementUnsatisfiable indicates that the free resources cannot satisfy the anti-affinity or topology
+	// spread constraints of a node group
+	PlacementUnsatisfiable ConditionReason = "PlacementConstraintsUnsatisfiable"
+	// Expired indicates that a NodeReservation reached its expiry time and released the resources it held
+	Expired ConditionReason = "Expired"
 )
 
 // ConditionMessage provides detailed messages associated with condition status updates.
@@ -10,6 +10,51 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// NodeScoringStrategy ranks the free resources matching a node group
+type NodeScoringStrategy string
+
+// Supported node scoring strategies
+const (
+	// ScoringStrategyBestFit prefers the resources with the fewest CPU threads and the least RAM
+	ScoringStrategyBestFit NodeScoringStrategy = "BestFit"
+	// ScoringStrategySpread prefers the resources sharing the fewest spread label values with the other nodes of the group
+	ScoringStrategySpread NodeScoringStrategy = "Spread"
+	// ScoringStrategyFirmwareMatch prefers the resources already running the firmware and BIOS settings of the hardware profile
+	ScoringStrategyFirmwareMatch NodeScoringStrategy = "FirmwareMatch"
+	// ScoringStrategyAvoidErrors prefers the resources with no recent transient errors
+	ScoringStrategyAvoidErrors NodeScoringStrategy = "AvoidErrors"
+)
+
+// NodeScoring selects how the hardware plugin chooses among the free resources matching a node group
+type NodeScoring struct {
+	// +kubebuilder:validation:Enum=BestFit;Spread;FirmwareMatch;AvoidErrors
+	Strategy NodeScoringStrategy `json:"strategy"`
+	// SpreadLabels are the resource labels, such as rack or chassis labels, across which the Spread strategy
+	// distributes the nodes of the group, from the broadest failure domain to the narrowest.
+	// +optional
+	SpreadLabels []string `json:"spreadLabels,omitempty"`
+}
+
+// NodeAntiAffinity requires the nodes of a group to be allocated on resources with distinct values of a label
+type NodeAntiAffinity struct {
+	// TopologyKey is the resource label, such as a rack or power domain label, whose value must differ between
+	// the nodes of the group. Resources missing the label are not eligible.
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+}
+
+// NodeTopologySpreadConstraint bounds how unevenly the nodes of a group are spread across the values of a label
+type NodeTopologySpreadConstraint struct {
+	// TopologyKey is the resource label, such as a rack or power domain label, defining the failure domains.
+	// Resources missing the label are not eligible.
+	// +kubebuilder:validation:MinLength=1
+	TopologyKey string `json:"topologyKey"`
+	// MaxSkew is the maximum difference between the number of nodes of the group in any two failure domains
+	// holding free or allocated resources of the group.
+	// +kubebuilder:validation:Minimum=1
+	MaxSkew int `json:"maxSkew"`
+}
+
 // NodeGroupData provides the necessary information for populating a node allocation request
 type NodeGroupData struct {
 	// +kubebuilder:validation:MinLength=1
@@ -23,6 +68,18 @@ type NodeGroupData struct {
 	ResourcePoolId string `json:"resourcePoolId,omitempty"`
 	// +optional
 	ResourceSelector map[string]string `json:"resourceSelector,omitempty"`
+	// Scoring ranks the free resources matching the node group. The hardware plugin picks among them arbitrarily
+	// when it is not set.
+	// +optional
+	Scoring *NodeScoring `json:"scoring,omitempty"`
+	// AntiAffinity lists the resource labels whose values must differ between the nodes of the group.
+	// The allocation fails when the free resources cannot satisfy them.
+	// +optional
+	AntiAffinity []NodeAntiAffinity `json:"antiAffinity,omitempty"`
+	// TopologySpreadConstraints bound how unevenly the nodes of the group are spread across failure domains.
+	// The allocation fails when the free resources cannot satisfy them.
+	// +optional
+	TopologySpreadConstraints []NodeTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
 }
 
 // HardwareTemplateSpec defines the desired state of HardwareTemplate
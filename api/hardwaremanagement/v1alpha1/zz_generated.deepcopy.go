@@ -384,6 +384,21 @@ func (in *Nic) DeepCopy() *Nic {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeAntiAffinity) DeepCopyInto(out *NodeAntiAffinity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeAntiAffinity.
+func (in *NodeAntiAffinity) DeepCopy() *NodeAntiAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeAntiAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeGroupData) DeepCopyInto(out *NodeGroupData) {
 	*out = *in
@@ -394,6 +409,21 @@ func (in *NodeGroupData) DeepCopyInto(out *NodeGroupData) {
 			(*out)[key] = val
 		}
 	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(NodeScoring)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AntiAffinity != nil {
+		in, out := &in.AntiAffinity, &out.AntiAffinity
+		*out = make([]NodeAntiAffinity, len(*in))
+		copy(*out, *in)
+	}
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]NodeTopologySpreadConstraint, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeGroupData.
@@ -405,3 +435,38 @@ func (in *NodeGroupData) DeepCopy() *NodeGroupData {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScoring) DeepCopyInto(out *NodeScoring) {
+	*out = *in
+	if in.SpreadLabels != nil {
+		in, out := &in.SpreadLabels, &out.SpreadLabels
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScoring.
+func (in *NodeScoring) DeepCopy() *NodeScoring {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScoring)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeTopologySpreadConstraint) DeepCopyInto(out *NodeTopologySpreadConstraint) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeTopologySpreadConstraint.
+func (in *NodeTopologySpreadConstraint) DeepCopy() *NodeTopologySpreadConstraint {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeTopologySpreadConstraint)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -90,6 +90,12 @@ type ClusterServerConfig struct {
 // AlarmServerConfig contains the configuration for the alarm server.
 type AlarmServerConfig struct {
 	ServerConfig `json:",inline"`
+	// ArchiveRetentionPeriod is the number of days archived alarm event records are kept before being deleted. The
+	// alarms server default of 30 days is used when it is not set.
+	//+optional
+	// +kubebuilder:validation:Minimum=1
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Archive Retention Period",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ArchiveRetentionPeriod int `json:"archiveRetentionPeriod,omitempty"`
 }
 
 // ArtifactsServerConfig contains the configuration for the artifacts server.
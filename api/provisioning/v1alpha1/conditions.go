@@ -9,6 +9,15 @@ package v1alpha1
 const (
 	ProvisioningRequestFinalizer = "provisioningrequest.clcm.openshift.io/finalizer"
 	ProvisioningRequestNameLabel = "provisioningrequest.clcm.openshift.io/name"
+
+	// DryRunAnnotation, when set to "true" on a ProvisioningRequest, makes the controller only render
+	// the resources of the request and report how they differ from the applied ones in
+	// status.extensions.dryRun, without creating or updating anything.
+	DryRunAnnotation = "clcm.openshift.io/dry-run"
+
+	// UpgradeRollbackAnnotation is set on a ProvisioningRequest by a FleetUpgrade to have the
+	// controller roll back the cluster upgrade that failed. It is removed once the rollback completes.
+	UpgradeRollbackAnnotation = "clcm.openshift.io/upgrade-rollback"
 )
 
 // ConditionType is a string representing the condition's type
@@ -34,6 +43,7 @@ var PRconditionTypes = struct {
 	ClusterProvisioned        ConditionType
 	ConfigurationApplied      ConditionType
 	UpgradeCompleted          ConditionType
+	DryRunCompleted           ConditionType
 }{
 	Validated:                 "ProvisioningRequestValidated",
 	HardwareTemplateRendered:  "HardwareTemplateRendered",
@@ -46,6 +56,18 @@ var PRconditionTypes = struct {
 	ClusterProvisioned:        "ClusterProvisioned",
 	ConfigurationApplied:      "ConfigurationApplied",
 	UpgradeCompleted:          "UpgradeCompleted",
+	DryRunCompleted:           "DryRunCompleted",
+}
+
+// The following constants define the different types of conditions that will be set for FleetUpgrade
+var FUconditionTypes = struct {
+	Validated ConditionType
+	Paused    ConditionType
+	Completed ConditionType
+}{
+	Validated: "FleetUpgradeValidated",
+	Paused:    "FleetUpgradePaused",
+	Completed: "FleetUpgradeCompleted",
 }
 
 // ConditionReason is a string representing the condition's reason
@@ -83,6 +105,25 @@ var CRconditionReasons = struct {
 	Unknown:         "Unknown",
 }
 
+// The following constants define the different reasons that conditions will be set for FleetUpgrade
+var FUconditionReasons = struct {
+	Completed        ConditionReason
+	Failed           ConditionReason
+	InProgress       ConditionReason
+	UserRequested    ConditionReason
+	Resumed          ConditionReason
+	UpgradeFailed    ConditionReason
+	HealthGateFailed ConditionReason
+}{
+	Completed:        "Completed",
+	Failed:           "Failed",
+	InProgress:       "InProgress",
+	UserRequested:    "UserRequested",
+	Resumed:          "Resumed",
+	UpgradeFailed:    "UpgradeFailed",
+	HealthGateFailed: "HealthGateFailed",
+}
+
 // FatalPRconditionTypes is a list of ProvisioningRequest conditions
 // that are fatal and cannot be recovered on their own.
 var FatalPRconditionTypes = []ConditionType{
@@ -0,0 +1,286 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FleetUpgradeRollbackPolicy defines what is done with the clusters that failed to upgrade.
+type FleetUpgradeRollbackPolicy string
+
+const (
+	// RollbackPolicyNone leaves the clusters that failed to upgrade as they are.
+	RollbackPolicyNone FleetUpgradeRollbackPolicy = "None"
+
+	// RollbackPolicyAutomatic rolls back the clusters that failed to upgrade to their previous
+	// release through an ImageBasedGroupUpgrade, and moves their ProvisioningRequests back to the
+	// previous ClusterTemplate version. The clusters whose upgrade was finalized can no longer be
+	// rolled back, and are left at the target version.
+	RollbackPolicyAutomatic FleetUpgradeRollbackPolicy = "Automatic"
+)
+
+// FleetUpgradeHealthGates defines the checks a batch of upgraded clusters must pass, once the soak
+// time is over, before the next batch is started.
+type FleetUpgradeHealthGates struct {
+	// IgnorePolicyCompliance skips the check that the upgraded clusters are compliant with their
+	// policies.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ignore Policy Compliance",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	IgnorePolicyCompliance bool `json:"ignorePolicyCompliance,omitempty"`
+
+	// IgnoreCriticalAlarms skips the check of the active critical alarms of the upgraded clusters.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Ignore Critical Alarms",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	IgnoreCriticalAlarms bool `json:"ignoreCriticalAlarms,omitempty"`
+
+	// MaxCriticalAlarms is the number of active critical alarms an upgraded cluster may have and
+	// still be considered healthy.
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Max Critical Alarms",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	MaxCriticalAlarms int `json:"maxCriticalAlarms,omitempty"`
+}
+
+// FleetUpgradeSpec defines the desired state of FleetUpgrade
+type FleetUpgradeSpec struct {
+	// TemplateName defines the base name of the ClusterTemplate whose ProvisioningRequests are upgraded.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="templateName is immutable"
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Template Name",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TemplateName string `json:"templateName"`
+
+	// SourceVersion restricts the upgrade to the ProvisioningRequests using this version of the
+	// ClusterTemplate. When empty, all the ProvisioningRequests of the ClusterTemplate that are not
+	// using the target version are upgraded.
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="sourceVersion is immutable"
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Source Version",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SourceVersion string `json:"sourceVersion,omitempty"`
+
+	// TargetVersion defines the version of the ClusterTemplate the ProvisioningRequests are moved to.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="targetVersion is immutable"
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Target Version",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	TargetVersion string `json:"targetVersion"`
+
+	// Selector restricts the upgrade to the ProvisioningRequests with matching labels.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Selector",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:selector"}
+	Selector *metav1.LabelSelector `json:"selector,omitempty"`
+
+	// CanaryBatchSize is the number of ProvisioningRequests upgraded in the first batch. When zero,
+	// the first batch has the regular batch size.
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Canary Batch Size",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	CanaryBatchSize int `json:"canaryBatchSize,omitempty"`
+
+	// BatchSize is the number of ProvisioningRequests upgraded at the same time.
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Batch Size",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	BatchSize int `json:"batchSize,omitempty"`
+
+	// SoakTime is how long the clusters of a batch must run the new release before the health
+	// gates are checked.
+	// +kubebuilder:default="30m"
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Soak Time",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	SoakTime metav1.Duration `json:"soakTime,omitempty"`
+
+	// HealthGates defines the checks a batch must pass before the next one is started.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Health Gates"
+	HealthGates FleetUpgradeHealthGates `json:"healthGates,omitempty"`
+
+	// RollbackPolicy defines what is done with the clusters that failed to upgrade, or failed the
+	// health gates.
+	// +kubebuilder:validation:Enum=None;Automatic
+	// +kubebuilder:default=None
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Rollback Policy",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:text"}
+	RollbackPolicy FleetUpgradeRollbackPolicy `json:"rollbackPolicy,omitempty"`
+
+	// Paused stops the upgrade from starting new batches once the current batch is over. It is never
+	// set by the controller: a failed batch pauses the upgrade through status.pausedAtBatch instead.
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Paused",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:booleanSwitch"}
+	Paused bool `json:"paused,omitempty"`
+
+	// ResumeAfterBatch resumes an upgrade paused by a failed batch. It must be set to the number of
+	// the failed batch reported in status.pausedAtBatch, and the upgrade then goes on with the next
+	// batch.
+	// +kubebuilder:validation:Minimum=0
+	//+operator-sdk:csv:customresourcedefinitions:type=spec,displayName="Resume After Batch",xDescriptors={"urn:alm:descriptor:com.tectonic.ui:number"}
+	ResumeAfterBatch int `json:"resumeAfterBatch,omitempty"`
+}
+
+// FleetUpgradePhase defines the phases of a FleetUpgrade.
+type FleetUpgradePhase string
+
+const (
+	// FleetUpgradePending means the upgrade has not started yet, usually because it is not valid.
+	FleetUpgradePending FleetUpgradePhase = "Pending"
+
+	// FleetUpgradeProgressing means the batches are being upgraded.
+	FleetUpgradeProgressing FleetUpgradePhase = "Progressing"
+
+	// FleetUpgradePaused means no new batch is started until spec.paused is cleared, or until
+	// spec.resumeAfterBatch acknowledges the failed batch.
+	FleetUpgradePaused FleetUpgradePhase = "Paused"
+
+	// FleetUpgradeCompleted means all the ProvisioningRequests were upgraded.
+	FleetUpgradeCompleted FleetUpgradePhase = "Completed"
+
+	// FleetUpgradeFailed means all the batches were processed, and at least one
+	// ProvisioningRequest failed to upgrade.
+	FleetUpgradeFailed FleetUpgradePhase = "Failed"
+)
+
+// FleetUpgradeBatchState defines the states of a batch of a FleetUpgrade.
+type FleetUpgradeBatchState string
+
+const (
+	// BatchPending means the batch has not been started.
+	BatchPending FleetUpgradeBatchState = "Pending"
+
+	// BatchUpgrading means the ProvisioningRequests of the batch are being upgraded.
+	BatchUpgrading FleetUpgradeBatchState = "Upgrading"
+
+	// BatchSoaking means the upgraded clusters are running for the soak time before the health
+	// gates are checked.
+	BatchSoaking FleetUpgradeBatchState = "Soaking"
+
+	// BatchCompleted means the clusters of the batch were upgraded and passed the health gates.
+	BatchCompleted FleetUpgradeBatchState = "Completed"
+
+	// BatchFailed means at least one cluster of the batch failed to upgrade or failed the health gates.
+	BatchFailed FleetUpgradeBatchState = "Failed"
+)
+
+// FleetUpgradeTargetState defines the states of a ProvisioningRequest upgraded by a FleetUpgrade.
+type FleetUpgradeTargetState string
+
+const (
+	// TargetPending means the upgrade of the ProvisioningRequest has not been started.
+	TargetPending FleetUpgradeTargetState = "Pending"
+
+	// TargetSkipped means the ProvisioningRequest was not upgraded, because it no longer exists or
+	// was not fulfilled when its batch started.
+	TargetSkipped FleetUpgradeTargetState = "Skipped"
+
+	// TargetUpgrading means the ProvisioningRequest was moved to the target version and is being upgraded.
+	TargetUpgrading FleetUpgradeTargetState = "Upgrading"
+
+	// TargetUpgraded means the ProvisioningRequest was upgraded and waits for the health gates.
+	TargetUpgraded FleetUpgradeTargetState = "Upgraded"
+
+	// TargetCompleted means the ProvisioningRequest was upgraded and passed the health gates.
+	TargetCompleted FleetUpgradeTargetState = "Completed"
+
+	// TargetFailed means the ProvisioningRequest failed to upgrade or failed the health gates.
+	TargetFailed FleetUpgradeTargetState = "Failed"
+
+	// TargetRollingBack means the cluster of the ProvisioningRequest is being rolled back.
+	TargetRollingBack FleetUpgradeTargetState = "RollingBack"
+
+	// TargetRolledBack means the cluster of the ProvisioningRequest was rolled back.
+	TargetRolledBack FleetUpgradeTargetState = "RolledBack"
+
+	// TargetRollbackFailed means the rollback of the cluster of the ProvisioningRequest failed.
+	TargetRollbackFailed FleetUpgradeTargetState = "RollbackFailed"
+)
+
+// FleetUpgradeTarget holds the upgrade state of a ProvisioningRequest.
+type FleetUpgradeTarget struct {
+	// The name of the ProvisioningRequest.
+	Name string `json:"name"`
+
+	// The ClusterTemplate version the ProvisioningRequest used before the upgrade.
+	SourceVersion string `json:"sourceVersion,omitempty"`
+
+	// The upgrade state of the ProvisioningRequest.
+	// +kubebuilder:validation:Enum=Pending;Skipped;Upgrading;Upgraded;Completed;Failed;RollingBack;RolledBack;RollbackFailed
+	State FleetUpgradeTargetState `json:"state"`
+
+	// The generation of the ProvisioningRequest after its version was changed by the controller.
+	Generation int64 `json:"generation,omitempty"`
+
+	// The details about the upgrade state of the ProvisioningRequest.
+	Message string `json:"message,omitempty"`
+}
+
+// FleetUpgradeBatch holds the state of a batch of ProvisioningRequests upgraded together.
+type FleetUpgradeBatch struct {
+	// Canary is true for the first batch when spec.canaryBatchSize is set.
+	Canary bool `json:"canary,omitempty"`
+
+	// The state of the batch.
+	// +kubebuilder:validation:Enum=Pending;Upgrading;Soaking;Completed;Failed
+	State FleetUpgradeBatchState `json:"state"`
+
+	// The timestamp when the upgrade of the batch started.
+	StartedAt *metav1.Time `json:"startedAt,omitempty"`
+
+	// The timestamp when all the ProvisioningRequests of the batch were upgraded and the soak time started.
+	SoakStartedAt *metav1.Time `json:"soakStartedAt,omitempty"`
+
+	// The timestamp when the batch completed or failed.
+	CompletedAt *metav1.Time `json:"completedAt,omitempty"`
+
+	// The ProvisioningRequests of the batch.
+	ProvisioningRequests []FleetUpgradeTarget `json:"provisioningRequests"`
+}
+
+// FleetUpgradeStatus defines the observed state of FleetUpgrade
+type FleetUpgradeStatus struct {
+	// The current phase of the upgrade.
+	// +kubebuilder:validation:Enum=Pending;Progressing;Paused;Completed;Failed
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	Phase FleetUpgradePhase `json:"phase,omitempty"`
+
+	// The batches of ProvisioningRequests, in the order they are upgraded. They are planned when
+	// the upgrade starts.
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	Batches []FleetUpgradeBatch `json:"batches,omitempty"`
+
+	// PausedAtBatch is the number, starting at 1, of the batch whose failure paused the upgrade. It
+	// is cleared when spec.resumeAfterBatch is set to the same number.
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	PausedAtBatch int `json:"pausedAtBatch,omitempty"`
+
+	//+operator-sdk:csv:customresourcedefinitions:type=status
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// ObservedGeneration is the most recent generation observed by the controller.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:resource:scope=Cluster,shortName=fu
+//+kubebuilder:printcolumn:name="Template",type="string",JSONPath=".spec.templateName"
+//+kubebuilder:printcolumn:name="Target",type="string",JSONPath=".spec.targetVersion"
+//+kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase"
+//+kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp"
+
+// FleetUpgrade is the Schema for the fleetupgrades API. It moves the ProvisioningRequests of a
+// ClusterTemplate to a new version of the template in batches, checking the health of the upgraded
+// clusters between batches.
+// +kubebuilder:validation:XValidation:message="spec.sourceVersion must differ from spec.targetVersion", rule="!has(self.spec.sourceVersion) || self.spec.sourceVersion != self.spec.targetVersion"
+// +operator-sdk:csv:customresourcedefinitions:displayName="Fleet Upgrade",resources={{ProvisioningRequest, v1alpha1},{ImageBasedGroupUpgrade, v1alpha1}}
+type FleetUpgrade struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FleetUpgradeSpec   `json:"spec,omitempty"`
+	Status FleetUpgradeStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// FleetUpgradeList contains a list of FleetUpgrade
+type FleetUpgradeList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FleetUpgrade `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FleetUpgrade{}, &FleetUpgradeList{})
+}
@@ -82,8 +82,184 @@ type Extensions struct {
 	// AllocatedNodeHostMap stores the mapping of AllocatedNode IDs to Hostnames
 	AllocatedNodeHostMap map[string]string `json:"allocatedNodeHostMap,omitempty"`
 
+	// NodeScaling tracks the progress of the nodes added to or removed from the provisioned cluster.
+	NodeScaling []NodeScalingStatus `json:"nodeScaling,omitempty"`
+
 	// Holds policies that are matched with the ManagedCluster created by the ProvisioningRequest.
 	Policies []PolicyDetails `json:"policies,omitempty"`
+
+	// DryRun holds the outcome of the last dry-run, requested through the dry-run annotation.
+	DryRun *DryRunResult `json:"dryRun,omitempty"`
+
+	// Timeline records the start, end and result of each provisioning phase that has started.
+	Timeline []ProvisioningTimelineEntry `json:"timeline,omitempty"`
+}
+
+// ProvisioningTimelinePhase is a phase of the provisioning of a cluster.
+type ProvisioningTimelinePhase string
+
+const (
+	// TimelinePhaseValidation is the validation of the ProvisioningRequest and the rendering of the ClusterInstance.
+	TimelinePhaseValidation ProvisioningTimelinePhase = "Validation"
+
+	// TimelinePhaseResourceCreation is the creation of the cluster resources.
+	TimelinePhaseResourceCreation ProvisioningTimelinePhase = "ResourceCreation"
+
+	// TimelinePhaseHardwareProvisioning is the allocation and provisioning of the hardware nodes.
+	TimelinePhaseHardwareProvisioning ProvisioningTimelinePhase = "HardwareProvisioning"
+
+	// TimelinePhaseHardwareConfiguration is the configuration of the hardware nodes.
+	TimelinePhaseHardwareConfiguration ProvisioningTimelinePhase = "HardwareConfiguration"
+
+	// TimelinePhaseClusterInstallation is the installation of the cluster.
+	TimelinePhaseClusterInstallation ProvisioningTimelinePhase = "ClusterInstallation"
+
+	// TimelinePhasePolicyConfiguration is the application of the configuration policies to the cluster.
+	TimelinePhasePolicyConfiguration ProvisioningTimelinePhase = "PolicyConfiguration"
+
+	// TimelinePhaseUpgrade is the upgrade of the cluster.
+	TimelinePhaseUpgrade ProvisioningTimelinePhase = "Upgrade"
+)
+
+// ProvisioningTimelineResult is the result of the last attempt of a provisioning phase.
+type ProvisioningTimelineResult string
+
+const (
+	// TimelineResultInProgress means the phase has not finished yet.
+	TimelineResultInProgress ProvisioningTimelineResult = "InProgress"
+
+	// TimelineResultSucceeded means the phase finished successfully.
+	TimelineResultSucceeded ProvisioningTimelineResult = "Succeeded"
+
+	// TimelineResultFailed means the phase failed.
+	TimelineResultFailed ProvisioningTimelineResult = "Failed"
+
+	// TimelineResultTimedOut means the phase did not finish within its timeout.
+	TimelineResultTimedOut ProvisioningTimelineResult = "TimedOut"
+)
+
+// ProvisioningTimelineEntry describes the last attempt of a provisioning phase.
+type ProvisioningTimelineEntry struct {
+	// The provisioning phase.
+	// +kubebuilder:validation:Enum=Validation;ResourceCreation;HardwareProvisioning;HardwareConfiguration;ClusterInstallation;PolicyConfiguration;Upgrade
+	Phase ProvisioningTimelinePhase `json:"phase"`
+
+	// The time the last attempt of the phase started.
+	StartTime metav1.Time `json:"startTime"`
+
+	// The time the last attempt of the phase finished, unset while it is in progress.
+	EndTime *metav1.Time `json:"endTime,omitempty"`
+
+	// The result of the last attempt of the phase.
+	// +kubebuilder:validation:Enum=InProgress;Succeeded;Failed;TimedOut
+	Result ProvisioningTimelineResult `json:"result"`
+
+	// The number of times the phase was started, including the retries after a failure and the
+	// reruns for a day-2 change.
+	Attempts int `json:"attempts"`
+}
+
+// NodeScalingAction is the change made to the nodes of a provisioned cluster.
+type NodeScalingAction string
+
+const (
+	// NodeScalingActionAdd means the node is added to the cluster.
+	NodeScalingActionAdd NodeScalingAction = "Add"
+
+	// NodeScalingActionRemove means the node is removed from the cluster.
+	NodeScalingActionRemove NodeScalingAction = "Remove"
+)
+
+// NodeScalingState is the progress of a node added to or removed from a provisioned cluster.
+type NodeScalingState string
+
+const (
+	// NodeScalingStateAllocating means the hardware plugin is allocating a host for the added node.
+	NodeScalingStateAllocating NodeScalingState = "Allocating"
+
+	// NodeScalingStateInstalling means the added node is being installed and joined to the cluster.
+	NodeScalingStateInstalling NodeScalingState = "Installing"
+
+	// NodeScalingStateDraining means the removed node is being drained and deleted from the cluster.
+	NodeScalingStateDraining NodeScalingState = "Draining"
+
+	// NodeScalingStateReleasing means the host of the removed node is being returned to the pool.
+	NodeScalingStateReleasing NodeScalingState = "Releasing"
+
+	// NodeScalingStateCompleted means the node was added or removed.
+	NodeScalingStateCompleted NodeScalingState = "Completed"
+
+	// NodeScalingStateFailed means the node could not be added or removed.
+	NodeScalingStateFailed NodeScalingState = "Failed"
+)
+
+// NodeScalingStatus describes the progress of a node added to or removed from a provisioned cluster.
+type NodeScalingStatus struct {
+	// The hostName of the node in the ClusterInstance.
+	HostName string `json:"hostName"`
+
+	// The role of the node.
+	Role string `json:"role,omitempty"`
+
+	// Whether the node is added or removed.
+	// +kubebuilder:validation:Enum=Add;Remove
+	Action NodeScalingAction `json:"action"`
+
+	// The progress of the node.
+	// +kubebuilder:validation:Enum=Allocating;Installing;Draining;Releasing;Completed;Failed
+	State NodeScalingState `json:"state"`
+
+	// The identifier of the AllocatedNode of the node, once known.
+	AllocatedNodeID string `json:"allocatedNodeId,omitempty"`
+
+	// Details about the progress of the node.
+	Message string `json:"message,omitempty"`
+
+	// The last time the state of the node changed.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// DryRunAction is the change a dry-run found would be made to a resource.
+type DryRunAction string
+
+const (
+	// DryRunActionCreate means the resource does not exist and would be created.
+	DryRunActionCreate DryRunAction = "create"
+
+	// DryRunActionUpdate means the resource exists and would be updated.
+	DryRunActionUpdate DryRunAction = "update"
+
+	// DryRunActionUnchanged means the resource exists and already matches the rendered one.
+	DryRunActionUnchanged DryRunAction = "unchanged"
+)
+
+// DryRunResource describes a resource rendered by a dry-run, and how it differs from the applied one.
+type DryRunResource struct {
+	// The kind of the resource.
+	Kind string `json:"kind"`
+
+	// The name of the resource. It is empty for a NodeAllocationRequest that would be created, as the
+	// hardware plugin assigns its identifier.
+	Name string `json:"name,omitempty"`
+
+	// The namespace of the resource.
+	Namespace string `json:"namespace,omitempty"`
+
+	// The change that would be made to the resource.
+	// +kubebuilder:validation:Enum=create;update;unchanged
+	Action DryRunAction `json:"action"`
+
+	// A unified diff between the applied and the rendered resource, set when the resource would be updated.
+	Diff string `json:"diff,omitempty"`
+}
+
+// DryRunResult holds the outcome of a dry-run of the ProvisioningRequest.
+type DryRunResult struct {
+	// The generation of the ProvisioningRequest the dry-run was performed for.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// The resources rendered from the ProvisioningRequest.
+	Resources []DryRunResource `json:"resources,omitempty"`
 }
 
 // PolicyDetails holds information about an ACM policy.
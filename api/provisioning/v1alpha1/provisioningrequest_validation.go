@@ -11,6 +11,8 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/r3labs/diff/v3"
@@ -23,6 +25,9 @@ import (
 const (
 	TemplateParamClusterInstance = "clusterInstanceParameters"
 	TemplateParamPolicyConfig    = "policyTemplateParameters"
+
+	// NodeRoleWorker is the role of the nodes that can be added to or removed from a provisioned cluster.
+	NodeRoleWorker = "worker"
 )
 
 var (
@@ -271,7 +276,7 @@ func (r *ProvisioningRequest) GetClusterTemplateRef(ctx context.Context, client
 func FindClusterInstanceImmutableFieldUpdates(
 	oldData, newData map[string]any, ignoredFields [][]string, allowedFields [][]string) ([]string, []string, error) {
 
-	diffs, err := diff.Diff(oldData, newData, diff.AllowTypeMismatch(true))
+	diffs, err := diffClusterInstanceData(oldData, newData)
 	if err != nil {
 		return nil, nil, fmt.Errorf("error comparing differences between old "+
 			"and new ClusterInstance input: %w", err)
@@ -351,6 +356,155 @@ func FindClusterInstanceImmutableFieldUpdates(
 	return updatedFields, scalingNodes, nil
 }
 
+// FindScaledClusterInstanceNodes returns the nodes added to and removed from the ClusterInstance data,
+// matching the old and new nodes by hostName.
+func FindScaledClusterInstanceNodes(oldData, newData map[string]any) ([]map[string]any, []map[string]any, error) {
+	oldNodes, _ := oldData["nodes"].([]any)
+	newNodes, _ := newData["nodes"].([]any)
+	changes, err := diffClusterInstanceNodes(oldNodes, newNodes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error comparing the nodes of the old and new ClusterInstance input: %w", err)
+	}
+
+	var added, removed []map[string]any
+	for _, change := range changes {
+		if len(change.Path) != 2 {
+			continue
+		}
+		switch change.Type {
+		case diff.CREATE:
+			if node, ok := change.To.(map[string]any); ok {
+				added = append(added, node)
+			}
+		case diff.DELETE:
+			if node, ok := change.From.(map[string]any); ok {
+				removed = append(removed, node)
+			}
+		}
+	}
+	return added, removed, nil
+}
+
+// validateNodeScaling checks that the nodes added to or removed from a provisioned cluster are worker
+// nodes, and that the nodes of the previous scaling are done.
+func validateNodeScaling(oldPr *ProvisioningRequest, oldData, newData map[string]any) error {
+	for _, nodeScaling := range oldPr.Status.Extensions.NodeScaling {
+		if nodeScaling.State != NodeScalingStateCompleted && nodeScaling.State != NodeScalingStateFailed {
+			return fmt.Errorf("nodes cannot be added or removed until the scaling of node %s is done, "+
+				"its current state is %s", nodeScaling.HostName, nodeScaling.State)
+		}
+	}
+
+	added, removed, err := FindScaledClusterInstanceNodes(oldData, newData)
+	if err != nil {
+		return err
+	}
+	for _, node := range slices.Concat(added, removed) {
+		if role, _ := node["role"].(string); role != NodeRoleWorker {
+			hostName, _ := node["hostName"].(string)
+			return fmt.Errorf("only worker nodes can be added to or removed from a provisioned cluster, "+
+				"node %s has role %q", hostName, role)
+		}
+	}
+	return nil
+}
+
+// diffClusterInstanceData compares the old and new ClusterInstance data. The nodes are matched by
+// hostName rather than by position, so that adding or removing a node does not show up as updates to
+// the nodes that follow it. The paths of the changes use the index of the node in the new data, or in
+// the old data for a removed node.
+func diffClusterInstanceData(oldData, newData map[string]any) (diff.Changelog, error) {
+	oldNodes, oldOk := oldData["nodes"].([]any)
+	newNodes, newOk := newData["nodes"].([]any)
+	if (!oldOk && oldData["nodes"] != nil) || (!newOk && newData["nodes"] != nil) {
+		// Unexpected nodes structure, compare the data as a whole
+		// nolint: wrapcheck
+		return diff.Diff(oldData, newData, diff.AllowTypeMismatch(true))
+	}
+
+	oldCluster := make(map[string]any, len(oldData))
+	for key, value := range oldData {
+		if key != "nodes" {
+			oldCluster[key] = value
+		}
+	}
+	newCluster := make(map[string]any, len(newData))
+	for key, value := range newData {
+		if key != "nodes" {
+			newCluster[key] = value
+		}
+	}
+
+	changes, err := diff.Diff(oldCluster, newCluster, diff.AllowTypeMismatch(true))
+	if err != nil {
+		return nil, err // nolint: wrapcheck
+	}
+
+	nodeChanges, err := diffClusterInstanceNodes(oldNodes, newNodes)
+	if err != nil {
+		return nil, err
+	}
+	return append(changes, nodeChanges...), nil
+}
+
+// diffClusterInstanceNodes compares the old and new nodes of the ClusterInstance data, matching them by
+// hostName. Nodes without a hostName are matched by position.
+func diffClusterInstanceNodes(oldNodes, newNodes []any) (diff.Changelog, error) {
+	nodeHostName := func(node any) string {
+		if nodeMap, ok := node.(map[string]any); ok {
+			if hostName, ok := nodeMap["hostName"].(string); ok {
+				return hostName
+			}
+		}
+		return ""
+	}
+
+	oldIndexes := make(map[string]int)
+	for i, node := range oldNodes {
+		if hostName := nodeHostName(node); hostName != "" {
+			oldIndexes[hostName] = i
+		}
+	}
+
+	var changes diff.Changelog
+	matched := make(map[int]bool)
+	for i, node := range newNodes {
+		oldIndex := -1
+		if hostName := nodeHostName(node); hostName != "" {
+			if index, ok := oldIndexes[hostName]; ok {
+				oldIndex = index
+			}
+		} else if i < len(oldNodes) && nodeHostName(oldNodes[i]) == "" {
+			oldIndex = i
+		}
+
+		if oldIndex < 0 || matched[oldIndex] {
+			changes = append(changes, diff.Change{
+				Type: diff.CREATE, Path: []string{"nodes", strconv.Itoa(i)}, To: node})
+			continue
+		}
+		matched[oldIndex] = true
+
+		nodeChanges, err := diff.Diff(oldNodes[oldIndex], node, diff.AllowTypeMismatch(true))
+		if err != nil {
+			return nil, err // nolint: wrapcheck
+		}
+		for _, change := range nodeChanges {
+			change.Path = append([]string{"nodes", strconv.Itoa(i)}, change.Path...)
+			changes = append(changes, change)
+		}
+	}
+
+	for i, node := range oldNodes {
+		if !matched[i] {
+			changes = append(changes, diff.Change{
+				Type: diff.DELETE, Path: []string{"nodes", strconv.Itoa(i)}, From: node})
+		}
+	}
+
+	return changes, nil
+}
+
 // matchesPattern checks if the path matches the pattern
 func matchesPattern(path, pattern []string) bool {
 	if len(path) < len(pattern) {
@@ -173,6 +173,13 @@ func (v *provisioningRequestValidator) validateCreateOrUpdate(ctx context.Contex
 			AllowedClusterInstanceFields[0], AllowedClusterInstanceFields[1], strings.Join(disallowedFields, ", "))
 	}
 
+	if len(scalingNodes) > 0 && crProvisionedCond.Reason == string(CRconditionReasons.Completed) {
+		if err := validateNodeScaling(oldPr, oldPrClusterInstanceInput.(map[string]any),
+			newPrClusterInstanceInput.(map[string]any)); err != nil {
+			return err
+		}
+	}
+
 	disallowedFields = append(disallowedFields, scalingNodes...)
 	if len(disallowedFields) > 0 && crProvisionedCond.Reason == string(CRconditionReasons.InProgress) {
 		return fmt.Errorf("updates to spec.TemplateParameters.ClusterInstanceParameters are "+
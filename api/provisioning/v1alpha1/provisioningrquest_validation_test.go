@@ -697,3 +697,52 @@ func TestExtractMatchingInput(t *testing.T) {
 		})
 	}
 }
+
+var _ = Describe("validateNodeScaling", func() {
+	var (
+		oldPr   *ProvisioningRequest
+		oldData map[string]any
+	)
+
+	BeforeEach(func() {
+		oldPr = &ProvisioningRequest{}
+		oldData = map[string]any{"nodes": []any{
+			map[string]any{"hostName": "master1", "role": "master"},
+			map[string]any{"hostName": "worker1", "role": "worker"},
+		}}
+	})
+
+	It("allows adding and removing worker nodes", func() {
+		newData := map[string]any{"nodes": []any{
+			map[string]any{"hostName": "master1", "role": "master"},
+			map[string]any{"hostName": "worker2", "role": "worker"},
+		}}
+		Expect(validateNodeScaling(oldPr, oldData, newData)).To(Succeed())
+
+		added, removed, err := FindScaledClusterInstanceNodes(oldData, newData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(added).To(ConsistOf(HaveKeyWithValue("hostName", "worker2")))
+		Expect(removed).To(ConsistOf(HaveKeyWithValue("hostName", "worker1")))
+	})
+
+	It("rejects removing a node that is not a worker", func() {
+		newData := map[string]any{"nodes": []any{
+			map[string]any{"hostName": "worker1", "role": "worker"},
+		}}
+		err := validateNodeScaling(oldPr, oldData, newData)
+		Expect(err).To(MatchError(ContainSubstring(`node master1 has role "master"`)))
+	})
+
+	It("rejects scaling while a previous scaling is in progress", func() {
+		oldPr.Status.Extensions.NodeScaling = []NodeScalingStatus{
+			{HostName: "worker0", Action: NodeScalingActionRemove, State: NodeScalingStateCompleted},
+			{HostName: "worker1", Action: NodeScalingActionAdd, State: NodeScalingStateInstalling},
+		}
+		newData := map[string]any{"nodes": []any{
+			map[string]any{"hostName": "master1", "role": "master"},
+		}}
+		err := validateNodeScaling(oldPr, oldData, newData)
+		Expect(err).To(MatchError("nodes cannot be added or removed until the scaling of node worker1 is done, " +
+			"its current state is Installing"))
+	})
+})
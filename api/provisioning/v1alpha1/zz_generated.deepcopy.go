@@ -150,6 +150,41 @@ func (in *ClusterTemplateStatus) DeepCopy() *ClusterTemplateStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResource) DeepCopyInto(out *DryRunResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResource.
+func (in *DryRunResource) DeepCopy() *DryRunResource {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DryRunResult) DeepCopyInto(out *DryRunResult) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]DryRunResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DryRunResult.
+func (in *DryRunResult) DeepCopy() *DryRunResult {
+	if in == nil {
+		return nil
+	}
+	out := new(DryRunResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Extensions) DeepCopyInto(out *Extensions) {
 	*out = *in
@@ -170,11 +205,30 @@ func (in *Extensions) DeepCopyInto(out *Extensions) {
 			(*out)[key] = val
 		}
 	}
+	if in.NodeScaling != nil {
+		in, out := &in.NodeScaling, &out.NodeScaling
+		*out = make([]NodeScalingStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Policies != nil {
 		in, out := &in.Policies, &out.Policies
 		*out = make([]PolicyDetails, len(*in))
 		copy(*out, *in)
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(DryRunResult)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Timeline != nil {
+		in, out := &in.Timeline, &out.Timeline
+		*out = make([]ProvisioningTimelineEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Extensions.
@@ -187,6 +241,178 @@ func (in *Extensions) DeepCopy() *Extensions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgrade) DeepCopyInto(out *FleetUpgrade) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgrade.
+func (in *FleetUpgrade) DeepCopy() *FleetUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetUpgrade) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeBatch) DeepCopyInto(out *FleetUpgradeBatch) {
+	*out = *in
+	if in.StartedAt != nil {
+		in, out := &in.StartedAt, &out.StartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.SoakStartedAt != nil {
+		in, out := &in.SoakStartedAt, &out.SoakStartedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletedAt != nil {
+		in, out := &in.CompletedAt, &out.CompletedAt
+		*out = (*in).DeepCopy()
+	}
+	if in.ProvisioningRequests != nil {
+		in, out := &in.ProvisioningRequests, &out.ProvisioningRequests
+		*out = make([]FleetUpgradeTarget, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeBatch.
+func (in *FleetUpgradeBatch) DeepCopy() *FleetUpgradeBatch {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeBatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeHealthGates) DeepCopyInto(out *FleetUpgradeHealthGates) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeHealthGates.
+func (in *FleetUpgradeHealthGates) DeepCopy() *FleetUpgradeHealthGates {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeHealthGates)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeList) DeepCopyInto(out *FleetUpgradeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FleetUpgrade, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeList.
+func (in *FleetUpgradeList) DeepCopy() *FleetUpgradeList {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FleetUpgradeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeSpec) DeepCopyInto(out *FleetUpgradeSpec) {
+	*out = *in
+	if in.Selector != nil {
+		in, out := &in.Selector, &out.Selector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	out.SoakTime = in.SoakTime
+	out.HealthGates = in.HealthGates
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeSpec.
+func (in *FleetUpgradeSpec) DeepCopy() *FleetUpgradeSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeStatus) DeepCopyInto(out *FleetUpgradeStatus) {
+	*out = *in
+	if in.Batches != nil {
+		in, out := &in.Batches, &out.Batches
+		*out = make([]FleetUpgradeBatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeStatus.
+func (in *FleetUpgradeStatus) DeepCopy() *FleetUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FleetUpgradeTarget) DeepCopyInto(out *FleetUpgradeTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FleetUpgradeTarget.
+func (in *FleetUpgradeTarget) DeepCopy() *FleetUpgradeTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(FleetUpgradeTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NodeAllocationRequestRef) DeepCopyInto(out *NodeAllocationRequestRef) {
 	*out = *in
@@ -210,6 +436,22 @@ func (in *NodeAllocationRequestRef) DeepCopy() *NodeAllocationRequestRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NodeScalingStatus) DeepCopyInto(out *NodeScalingStatus) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NodeScalingStatus.
+func (in *NodeScalingStatus) DeepCopy() *NodeScalingStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NodeScalingStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PolicyDetails) DeepCopyInto(out *PolicyDetails) {
 	*out = *in
@@ -361,6 +603,26 @@ func (in *ProvisioningStatus) DeepCopy() *ProvisioningStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProvisioningTimelineEntry) DeepCopyInto(out *ProvisioningTimelineEntry) {
+	*out = *in
+	in.StartTime.DeepCopyInto(&out.StartTime)
+	if in.EndTime != nil {
+		in, out := &in.EndTime, &out.EndTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProvisioningTimelineEntry.
+func (in *ProvisioningTimelineEntry) DeepCopy() *ProvisioningTimelineEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(ProvisioningTimelineEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Templates) DeepCopyInto(out *Templates) {
 	*out = *in
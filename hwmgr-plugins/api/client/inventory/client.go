@@ -79,3 +79,21 @@ func (i *InventoryClient) GetResourcePoolsWithResponse(ctx context.Context, reqE
 	}
 	return ParseGetResourcePoolsResponse(rsp)
 }
+
+// GetSubscriptionsWithResponse request returning *GetSubscriptionsResponse
+func (i *InventoryClient) GetSubscriptionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetSubscriptionsResponse, error) {
+	rsp, err := i.client.GetSubscriptions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetSubscriptionsResponse(rsp)
+}
+
+// CreateSubscriptionWithResponse request returning *CreateSubscriptionResponse
+func (i *InventoryClient) CreateSubscriptionWithResponse(ctx context.Context, body CreateSubscriptionJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateSubscriptionResponse, error) {
+	rsp, err := i.client.CreateSubscription(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateSubscriptionResponse(rsp)
+}
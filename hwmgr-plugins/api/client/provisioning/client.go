@@ -27,6 +27,7 @@ const (
 	MsgForbidden           = "Forbidden"
 	MsgNotFound            = "Not Found"
 	MsgInternalServerError = "Internal Server Error"
+	MsgNotImplemented      = "Not Implemented"
 )
 
 // HardwarePluginClient provides functions for calling the HardwarePlugin APIs
@@ -327,6 +328,111 @@ func (h *HardwarePluginClient) GetAllocatedNodesFromNodeAllocationRequest(
 	}
 }
 
+// CreateNodeReservation creates a new NodeReservation, holding hardware for a planned deployment
+func (h *HardwarePluginClient) CreateNodeReservation(
+	ctx context.Context,
+	body CreateNodeReservationJSONRequestBody,
+) (string, error) {
+	response, err := h.client.CreateNodeReservationWithResponse(ctx, body)
+	if err != nil {
+		h.logger.Error("Failed to create NodeReservation", slog.Any("error", err))
+		return "", fmt.Errorf("failed to create NodeReservation: %w", err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusAccepted:
+		if response.JSON202 == nil {
+			h.logger.Error("Received nil JSON202 response for create NodeReservation")
+			return "", fmt.Errorf("received nil response for create NodeReservation")
+		}
+		return *response.JSON202, nil
+	default:
+		problem, status := h.getProblemDetails(response, response.StatusCode())
+		return "", h.handleErrorResponse(status, problem,
+			"NodeReservation", "", http.MethodPost)
+	}
+}
+
+// GetNodeReservation retrieves a specific NodeReservation by ID
+// returns: NodeReservationResponse, exists (true/false), error (if applicable)
+func (h *HardwarePluginClient) GetNodeReservation(
+	ctx context.Context,
+	nodeReservationID string,
+) (*NodeReservationResponse, bool, error) {
+	response, err := h.client.GetNodeReservationWithResponse(ctx, nodeReservationID)
+	if err != nil {
+		h.logger.Error("Failed to get NodeReservation", slog.String("id", nodeReservationID), slog.Any("error", err))
+		return nil, false, fmt.Errorf("failed to get NodeReservation '%s': %w", nodeReservationID, err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		if response.JSON200 == nil {
+			h.logger.Error("Received nil JSON200 response", slog.String("id", nodeReservationID))
+			return nil, true, fmt.Errorf("received nil response for NodeReservation '%s'", nodeReservationID)
+		}
+		return response.JSON200, true, nil
+	case http.StatusNotFound:
+		h.logger.Info("NodeReservation not found", slog.String("id", nodeReservationID))
+		return nil, false, nil
+	default:
+		problem, status := h.getProblemDetails(response, response.StatusCode())
+		return nil, false, h.handleErrorResponse(status, problem,
+			"NodeReservation", nodeReservationID, http.MethodGet)
+	}
+}
+
+// DeleteNodeReservation deletes a NodeReservation by ID, releasing the hardware it holds
+// returns: ID, exists (true/false), error (if applicable)
+func (h *HardwarePluginClient) DeleteNodeReservation(
+	ctx context.Context,
+	nodeReservationID string,
+) (string, bool, error) {
+	response, err := h.client.DeleteNodeReservationWithResponse(ctx, nodeReservationID)
+	if err != nil {
+		h.logger.Error("Failed to delete NodeReservation", slog.String("id", nodeReservationID), slog.Any("error", err))
+		return "", false, fmt.Errorf("failed to delete NodeReservation '%s': %w", nodeReservationID, err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusAccepted:
+		if response.JSON202 == nil {
+			h.logger.Error("Received nil JSON202 response", slog.String("id", nodeReservationID))
+			return "", false, fmt.Errorf("received nil response for delete NodeReservation '%s'", nodeReservationID)
+		}
+		return *response.JSON202, true, nil
+	case http.StatusNotFound:
+		h.logger.Info("NodeReservation not found", slog.String("id", nodeReservationID))
+		return "", false, nil
+	default:
+		problem, status := h.getProblemDetails(response, response.StatusCode())
+		return "", false, h.handleErrorResponse(status, problem,
+			"NodeReservation", nodeReservationID, http.MethodDelete)
+	}
+}
+
+// GetCapacity retrieves the number of resources available for a node group with the given HardwareProfile
+func (h *HardwarePluginClient) GetCapacity(ctx context.Context, params *GetCapacityParams) (*Capacity, error) {
+	response, err := h.client.GetCapacityWithResponse(ctx, params)
+	if err != nil {
+		h.logger.Error("Failed to get capacity", slog.String("hwProfile", params.HwProfile), slog.Any("error", err))
+		return nil, fmt.Errorf("failed to get capacity for HardwareProfile '%s': %w", params.HwProfile, err)
+	}
+
+	switch response.StatusCode() {
+	case http.StatusOK:
+		if response.JSON200 == nil {
+			h.logger.Error("Received nil JSON200 response", slog.String("hwProfile", params.HwProfile))
+			return nil, fmt.Errorf("received nil response for capacity of HardwareProfile '%s'", params.HwProfile)
+		}
+		return response.JSON200, nil
+	default:
+		problem, status := h.getProblemDetails(response, response.StatusCode())
+		return nil, h.handleErrorResponse(status, problem,
+			"Capacity", params.HwProfile, http.MethodGet)
+	}
+}
+
 // GetHardwarePluginRef returns the reference (name) of the HardwarePlugin
 func (h *HardwarePluginClient) GetHardwarePluginRef() string {
 	return h.hwPlugin.Name
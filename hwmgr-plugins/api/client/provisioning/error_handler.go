@@ -154,6 +154,58 @@ func (h *HardwarePluginClient) getProblemDetails(
 		case http.StatusInternalServerError:
 			return resp.ApplicationProblemJSON500, MsgInternalServerError
 		}
+	case *CreateNodeReservationResponse:
+		switch statusCode {
+		case http.StatusBadRequest:
+			return resp.ApplicationProblemJSON400, MsgBadRequest
+		case http.StatusUnauthorized:
+			return resp.ApplicationProblemJSON401, MsgUnauthorized
+		case http.StatusForbidden:
+			return resp.ApplicationProblemJSON403, MsgForbidden
+		case http.StatusInternalServerError:
+			return resp.ApplicationProblemJSON500, MsgInternalServerError
+		}
+	case *GetNodeReservationResponse:
+		switch statusCode {
+		case http.StatusBadRequest:
+			return resp.ApplicationProblemJSON400, MsgBadRequest
+		case http.StatusUnauthorized:
+			return resp.ApplicationProblemJSON401, MsgUnauthorized
+		case http.StatusForbidden:
+			return resp.ApplicationProblemJSON403, MsgForbidden
+		case http.StatusNotFound:
+			return resp.ApplicationProblemJSON404, MsgNotFound
+		case http.StatusInternalServerError:
+			return resp.ApplicationProblemJSON500, MsgInternalServerError
+		}
+	case *DeleteNodeReservationResponse:
+		switch statusCode {
+		case http.StatusBadRequest:
+			return resp.ApplicationProblemJSON400, MsgBadRequest
+		case http.StatusUnauthorized:
+			return resp.ApplicationProblemJSON401, MsgUnauthorized
+		case http.StatusForbidden:
+			return resp.ApplicationProblemJSON403, MsgForbidden
+		case http.StatusNotFound:
+			return resp.ApplicationProblemJSON404, MsgNotFound
+		case http.StatusInternalServerError:
+			return resp.ApplicationProblemJSON500, MsgInternalServerError
+		}
+	case *GetCapacityResponse:
+		switch statusCode {
+		case http.StatusBadRequest:
+			return resp.ApplicationProblemJSON400, MsgBadRequest
+		case http.StatusUnauthorized:
+			return resp.ApplicationProblemJSON401, MsgUnauthorized
+		case http.StatusForbidden:
+			return resp.ApplicationProblemJSON403, MsgForbidden
+		case http.StatusNotFound:
+			return resp.ApplicationProblemJSON404, MsgNotFound
+		case http.StatusInternalServerError:
+			return resp.ApplicationProblemJSON500, MsgInternalServerError
+		case http.StatusNotImplemented:
+			return resp.ApplicationProblemJSON501, MsgNotImplemented
+		}
 	}
 	return nil, http.StatusText(statusCode)
 }
@@ -28,6 +28,14 @@ const (
 	ServiceAccount AuthType = "ServiceAccount"
 )
 
+// Defines values for NodeScoringStrategy.
+const (
+	AvoidErrors   NodeScoringStrategy = "AvoidErrors"
+	BestFit       NodeScoringStrategy = "BestFit"
+	FirmwareMatch NodeScoringStrategy = "FirmwareMatch"
+	Spread        NodeScoringStrategy = "Spread"
+)
+
 // APIVersion Information about a version of the API.
 type APIVersion struct {
 	Version *string `json:"version,omitempty"`
@@ -119,6 +127,30 @@ type Callback struct {
 	CallbackURL string `json:"callbackURL"`
 }
 
+// Capacity Number of resources available for a node group.
+type Capacity struct {
+	// Allocated Number of allocated resources.
+	Allocated int `json:"allocated"`
+
+	// Free Number of resources that are neither allocated nor held by a reservation.
+	Free int `json:"free"`
+
+	// HwProfile Name of the HardwareProfile of the node group.
+	HwProfile string `json:"hwProfile"`
+
+	// Reserved Number of resources held by a reservation that is not expired.
+	Reserved int `json:"reserved"`
+
+	// ResourcePoolId Identifier of the resource pool, when the query was restricted to one.
+	ResourcePoolId *string `json:"resourcePoolId,omitempty"`
+
+	// Site Site identifier, when the query was restricted to one.
+	Site *string `json:"site,omitempty"`
+
+	// Total Number of resources that can be configured with the hardware profile.
+	Total int `json:"total"`
+}
+
 // Condition Condition represents an observation of an object's state.
 type Condition struct {
 	// LastTransitionTime LastTransitionTime is the last time the condition transitioned from one status to another
@@ -194,14 +226,29 @@ type NodeAllocationRequestStatus struct {
 	SelectedGroups *map[string]string `json:"selectedGroups,omitempty"`
 }
 
+// NodeAntiAffinity Requirement that the nodes of a group are allocated on resources with distinct values of a label.
+type NodeAntiAffinity struct {
+	// TopologyKey Resource label, such as a rack or power domain label, whose value must differ between the nodes of the
+	// group. Resources missing the label are not eligible.
+	TopologyKey string `json:"topologyKey"`
+}
+
 // NodeGroup Information about a node group within a NodeAllocationRequest.
 type NodeGroup struct {
 	// NodeGroupData Configuration data for a NodeGroup.
 	NodeGroupData NodeGroupData `json:"nodeGroupData"`
+
+	// NodesToRemove Identifiers of the allocated nodes to release when the size of the node group is reduced. Identifiers of
+	// nodes that are already released are ignored.
+	NodesToRemove *[]string `json:"nodesToRemove,omitempty"`
 }
 
 // NodeGroupData Configuration data for a NodeGroup.
 type NodeGroupData struct {
+	// AntiAffinity Resource labels whose values must differ between the nodes of the group. The allocation fails when the
+	// free resources cannot satisfy them.
+	AntiAffinity *[]NodeAntiAffinity `json:"antiAffinity,omitempty"`
+
 	// HwProfile Hardware profile associated with the node group data.
 	HwProfile string `json:"hwProfile"`
 
@@ -217,8 +264,85 @@ type NodeGroupData struct {
 	// Role Role of the node group data.
 	Role string `json:"role"`
 
+	// Scoring Ranking of the free resources matching a node group. The hardware plugin picks among them arbitrarily when
+	// it is not set.
+	Scoring *NodeScoring `json:"scoring,omitempty"`
+
 	// Size Size of the node group.
 	Size int `json:"size"`
+
+	// TopologySpreadConstraints Bounds on how unevenly the nodes of the group are spread across failure domains. The allocation fails
+	// when the free resources cannot satisfy them.
+	TopologySpreadConstraints *[]NodeTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// NodeReservation Hardware to hold for a planned deployment.
+type NodeReservation struct {
+	// ClusterId Identifier of the O-Cloud request, such as a ProvisioningRequest, the hardware is held for. Only the
+	// NodeAllocationRequests with the same clusterId may allocate the reserved hardware.
+	ClusterId string `json:"clusterId"`
+
+	// ExpiresAt Time at which the reserved hardware is released, if it was not allocated by then.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// NodeGroup Node groups to hold hardware for. The size of each node group is the number of resources to hold.
+	NodeGroup []NodeGroup `json:"nodeGroup"`
+
+	// Site Site identifier of the reserved hardware.
+	Site string `json:"site"`
+}
+
+// NodeReservationResponse Information (including observed state) about a NodeReservation resource.
+type NodeReservationResponse struct {
+	// NodeReservation Hardware to hold for a planned deployment.
+	NodeReservation NodeReservation `json:"nodeReservation"`
+
+	// NodeReservationId Identifier of the NodeReservation.
+	NodeReservationId string `json:"nodeReservationId"`
+
+	// Status NodeReservationStatus describes the observed state of a NodeReservation resource.
+	Status NodeReservationStatus `json:"status"`
+}
+
+// NodeReservationStatus NodeReservationStatus describes the observed state of a NodeReservation resource.
+type NodeReservationStatus struct {
+	// Conditions Conditions represent the latest available observations of a NodeReservation's state. The Reserved
+	// condition is true once all the requested resources are held.
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// ReservedNodes Resources held by the reservation.
+	ReservedNodes *[]ReservedNode `json:"reservedNodes,omitempty"`
+}
+
+// NodeScoring Ranking of the free resources matching a node group. The hardware plugin picks among them arbitrarily when
+// it is not set.
+type NodeScoring struct {
+	// SpreadLabels Resource labels, such as rack or chassis labels, across which the Spread strategy distributes the nodes
+	// of the group, from the broadest failure domain to the narrowest.
+	SpreadLabels *[]string `json:"spreadLabels,omitempty"`
+
+	// Strategy Strategy ranking the resources: BestFit prefers the resources with the fewest CPU threads and the least
+	// RAM, Spread prefers the resources sharing the fewest spreadLabels values with the other nodes of the
+	// group, FirmwareMatch prefers the resources already running the firmware and BIOS settings of the
+	// hardware profile, and AvoidErrors prefers the resources with no recent transient errors.
+	Strategy NodeScoringStrategy `json:"strategy"`
+}
+
+// NodeScoringStrategy Strategy ranking the resources: BestFit prefers the resources with the fewest CPU threads and the least
+// RAM, Spread prefers the resources sharing the fewest spreadLabels values with the other nodes of the
+// group, FirmwareMatch prefers the resources already running the firmware and BIOS settings of the
+// hardware profile, and AvoidErrors prefers the resources with no recent transient errors.
+type NodeScoringStrategy string
+
+// NodeTopologySpreadConstraint Bound on how unevenly the nodes of a group are spread across the values of a label.
+type NodeTopologySpreadConstraint struct {
+	// MaxSkew Maximum difference between the number of nodes of the group in any two failure domains holding free or
+	// allocated resources of the group.
+	MaxSkew int `json:"maxSkew"`
+
+	// TopologyKey Resource label, such as a rack or power domain label, defining the failure domains. Resources missing
+	// the label are not eligible.
+	TopologyKey string `json:"topologyKey"`
 }
 
 // OAuthClientConfig OAuthClientConfig defines the configurable client attributes that represent the authentication mechanism.
@@ -272,6 +396,15 @@ type Properties struct {
 	NodeNames *[]string `json:"nodeNames,omitempty"`
 }
 
+// ReservedNode Resource held by a NodeReservation.
+type ReservedNode struct {
+	// GroupName Node group the resource is held for.
+	GroupName string `json:"groupName"`
+
+	// HwMgrNodeId Identifier of the resource in the hardware manager.
+	HwMgrNodeId string `json:"hwMgrNodeId"`
+}
+
 // TLSConfig TLSConfig defines the configuration for TLS-specific attributes.
 type TLSConfig struct {
 	// SecretName Name of a secret (in the current namespace) containing an X.509 certificate and
@@ -283,12 +416,27 @@ type TLSConfig struct {
 	SecretName string `json:"secretName"`
 }
 
+// GetCapacityParams defines parameters for GetCapacity.
+type GetCapacityParams struct {
+	// HwProfile Name of the HardwareProfile of the planned node group.
+	HwProfile string `form:"hwProfile" json:"hwProfile"`
+
+	// ResourcePoolId Identifier of the resource pool. All the resource pools are counted when it is omitted.
+	ResourcePoolId *string `form:"resourcePoolId,omitempty" json:"resourcePoolId,omitempty"`
+
+	// Site Site identifier. All the sites are counted when it is omitted.
+	Site *string `form:"site,omitempty" json:"site,omitempty"`
+}
+
 // CreateNodeAllocationRequestJSONRequestBody defines body for CreateNodeAllocationRequest for application/json ContentType.
 type CreateNodeAllocationRequestJSONRequestBody = NodeAllocationRequest
 
 // UpdateNodeAllocationRequestJSONRequestBody defines body for UpdateNodeAllocationRequest for application/json ContentType.
 type UpdateNodeAllocationRequestJSONRequestBody = NodeAllocationRequest
 
+// CreateNodeReservationJSONRequestBody defines body for CreateNodeReservation for application/json ContentType.
+type CreateNodeReservationJSONRequestBody = NodeReservation
+
 // RequestEditorFn  is the function signature for the RequestEditor callback function
 type RequestEditorFn func(ctx context.Context, req *http.Request) error
 
@@ -374,6 +522,9 @@ type ClientInterface interface {
 	// GetMinorVersions request
 	GetMinorVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
+	// GetCapacity request
+	GetCapacity(ctx context.Context, params *GetCapacityParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
 	// GetNodeAllocationRequests request
 	GetNodeAllocationRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
 
@@ -395,6 +546,20 @@ type ClientInterface interface {
 
 	// GetAllocatedNodesFromNodeAllocationRequest request
 	GetAllocatedNodesFromNodeAllocationRequest(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNodeReservations request
+	GetNodeReservations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// CreateNodeReservationWithBody request with any body
+	CreateNodeReservationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	CreateNodeReservation(ctx context.Context, body CreateNodeReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteNodeReservation request
+	DeleteNodeReservation(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetNodeReservation request
+	GetNodeReservation(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*http.Response, error)
 }
 
 func (c *Client) GetAllVersions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
@@ -445,6 +610,18 @@ func (c *Client) GetMinorVersions(ctx context.Context, reqEditors ...RequestEdit
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetCapacity(ctx context.Context, params *GetCapacityParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetCapacityRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 func (c *Client) GetNodeAllocationRequests(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
 	req, err := NewGetNodeAllocationRequestsRequest(c.Server)
 	if err != nil {
@@ -541,6 +718,66 @@ func (c *Client) GetAllocatedNodesFromNodeAllocationRequest(ctx context.Context,
 	return c.Client.Do(req)
 }
 
+func (c *Client) GetNodeReservations(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNodeReservationsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateNodeReservationWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNodeReservationRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) CreateNodeReservation(ctx context.Context, body CreateNodeReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewCreateNodeReservationRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteNodeReservation(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteNodeReservationRequest(c.Server, nodeReservationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetNodeReservation(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetNodeReservationRequest(c.Server, nodeReservationId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
 // NewGetAllVersionsRequest generates requests for GetAllVersions
 func NewGetAllVersionsRequest(server string) (*http.Request, error) {
 	var err error
@@ -656,6 +893,83 @@ func NewGetMinorVersionsRequest(server string) (*http.Request, error) {
 	return req, nil
 }
 
+// NewGetCapacityRequest generates requests for GetCapacity
+func NewGetCapacityRequest(server string, params *GetCapacityParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hardware-manager/provisioning/v1/capacity")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "hwProfile", runtime.ParamLocationQuery, params.HwProfile); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.ResourcePoolId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resourcePoolId", runtime.ParamLocationQuery, *params.ResourcePoolId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Site != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "site", runtime.ParamLocationQuery, *params.Site); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 // NewGetNodeAllocationRequestsRequest generates requests for GetNodeAllocationRequests
 func NewGetNodeAllocationRequestsRequest(server string) (*http.Request, error) {
 	var err error
@@ -872,82 +1186,234 @@ func NewGetAllocatedNodesFromNodeAllocationRequestRequest(server string, nodeAll
 	return req, nil
 }
 
-func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
-	for _, r := range c.RequestEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+// NewGetNodeReservationsRequest generates requests for GetNodeReservations
+func NewGetNodeReservationsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
 	}
-	for _, r := range additionalEditors {
-		if err := r(ctx, req); err != nil {
-			return err
-		}
+
+	operationPath := fmt.Sprintf("/hardware-manager/provisioning/v1/node-reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
 	}
-	return nil
-}
 
-// ClientWithResponses builds on ClientInterface to offer response payloads
-type ClientWithResponses struct {
-	ClientInterface
-}
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-// NewClientWithResponses creates a new ClientWithResponses, which wraps
-// Client with return type handling
-func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
-	client, err := NewClient(server, opts...)
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
 	if err != nil {
 		return nil, err
 	}
-	return &ClientWithResponses{client}, nil
+
+	return req, nil
 }
 
-// WithBaseURL overrides the baseURL.
-func WithBaseURL(baseURL string) ClientOption {
-	return func(c *Client) error {
-		newBaseURL, err := url.Parse(baseURL)
-		if err != nil {
-			return err
-		}
-		c.Server = newBaseURL.String()
-		return nil
+// NewCreateNodeReservationRequest calls the generic CreateNodeReservation builder with application/json body
+func NewCreateNodeReservationRequest(server string, body CreateNodeReservationJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
 	}
+	bodyReader = bytes.NewReader(buf)
+	return NewCreateNodeReservationRequestWithBody(server, "application/json", bodyReader)
 }
 
-// ClientWithResponsesInterface is the interface specification for the client with responses above.
-type ClientWithResponsesInterface interface {
-	// GetAllVersionsWithResponse request
-	GetAllVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllVersionsResponse, error)
+// NewCreateNodeReservationRequestWithBody generates requests for CreateNodeReservation with any type of body
+func NewCreateNodeReservationRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
 
-	// GetAllocatedNodesWithResponse request
-	GetAllocatedNodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllocatedNodesResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllocatedNodeWithResponse request
-	GetAllocatedNodeWithResponse(ctx context.Context, allocatedNodeId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodeResponse, error)
+	operationPath := fmt.Sprintf("/hardware-manager/provisioning/v1/node-reservations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
 
-	// GetMinorVersionsWithResponse request
-	GetMinorVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinorVersionsResponse, error)
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetNodeAllocationRequestsWithResponse request
-	GetNodeAllocationRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestsResponse, error)
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
 
-	// CreateNodeAllocationRequestWithBodyWithResponse request with any body
-	CreateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNodeAllocationRequestResponse, error)
+	req.Header.Add("Content-Type", contentType)
 
-	CreateNodeAllocationRequestWithResponse(ctx context.Context, body CreateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNodeAllocationRequestResponse, error)
+	return req, nil
+}
 
-	// DeleteNodeAllocationRequestWithResponse request
-	DeleteNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*DeleteNodeAllocationRequestResponse, error)
+// NewDeleteNodeReservationRequest generates requests for DeleteNodeReservation
+func NewDeleteNodeReservationRequest(server string, nodeReservationId string) (*http.Request, error) {
+	var err error
 
-	// GetNodeAllocationRequestWithResponse request
-	GetNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestResponse, error)
+	var pathParam0 string
 
-	// UpdateNodeAllocationRequestWithBodyWithResponse request with any body
-	UpdateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, nodeAllocationRequestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error)
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nodeReservationId", runtime.ParamLocationPath, nodeReservationId)
+	if err != nil {
+		return nil, err
+	}
 
-	UpdateNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, body UpdateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error)
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
 
-	// GetAllocatedNodesFromNodeAllocationRequestWithResponse request
-	GetAllocatedNodesFromNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error)
+	operationPath := fmt.Sprintf("/hardware-manager/provisioning/v1/node-reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetNodeReservationRequest generates requests for GetNodeReservation
+func NewGetNodeReservationRequest(server string, nodeReservationId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "nodeReservationId", runtime.ParamLocationPath, nodeReservationId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/hardware-manager/provisioning/v1/node-reservations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetAllVersionsWithResponse request
+	GetAllVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllVersionsResponse, error)
+
+	// GetAllocatedNodesWithResponse request
+	GetAllocatedNodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllocatedNodesResponse, error)
+
+	// GetAllocatedNodeWithResponse request
+	GetAllocatedNodeWithResponse(ctx context.Context, allocatedNodeId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodeResponse, error)
+
+	// GetMinorVersionsWithResponse request
+	GetMinorVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetMinorVersionsResponse, error)
+
+	// GetCapacityWithResponse request
+	GetCapacityWithResponse(ctx context.Context, params *GetCapacityParams, reqEditors ...RequestEditorFn) (*GetCapacityResponse, error)
+
+	// GetNodeAllocationRequestsWithResponse request
+	GetNodeAllocationRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestsResponse, error)
+
+	// CreateNodeAllocationRequestWithBodyWithResponse request with any body
+	CreateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNodeAllocationRequestResponse, error)
+
+	CreateNodeAllocationRequestWithResponse(ctx context.Context, body CreateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNodeAllocationRequestResponse, error)
+
+	// DeleteNodeAllocationRequestWithResponse request
+	DeleteNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*DeleteNodeAllocationRequestResponse, error)
+
+	// GetNodeAllocationRequestWithResponse request
+	GetNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestResponse, error)
+
+	// UpdateNodeAllocationRequestWithBodyWithResponse request with any body
+	UpdateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, nodeAllocationRequestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error)
+
+	UpdateNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, body UpdateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error)
+
+	// GetAllocatedNodesFromNodeAllocationRequestWithResponse request
+	GetAllocatedNodesFromNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error)
+
+	// GetNodeReservationsWithResponse request
+	GetNodeReservationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeReservationsResponse, error)
+
+	// CreateNodeReservationWithBodyWithResponse request with any body
+	CreateNodeReservationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNodeReservationResponse, error)
+
+	CreateNodeReservationWithResponse(ctx context.Context, body CreateNodeReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNodeReservationResponse, error)
+
+	// DeleteNodeReservationWithResponse request
+	DeleteNodeReservationWithResponse(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*DeleteNodeReservationResponse, error)
+
+	// GetNodeReservationWithResponse request
+	GetNodeReservationWithResponse(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*GetNodeReservationResponse, error)
 }
 
 type GetAllVersionsResponse struct {
@@ -1051,6 +1517,34 @@ func (r GetMinorVersionsResponse) StatusCode() int {
 	return 0
 }
 
+type GetCapacityResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *Capacity
+	ApplicationProblemJSON400 *ProblemDetails
+	ApplicationProblemJSON401 *ProblemDetails
+	ApplicationProblemJSON403 *ProblemDetails
+	ApplicationProblemJSON404 *ProblemDetails
+	ApplicationProblemJSON500 *ProblemDetails
+	ApplicationProblemJSON501 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetCapacityResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetCapacityResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 type GetNodeAllocationRequestsResponse struct {
 	Body                      []byte
 	HTTPResponse              *http.Response
@@ -1211,6 +1705,112 @@ func (r GetAllocatedNodesFromNodeAllocationRequestResponse) StatusCode() int {
 	return 0
 }
 
+type GetNodeReservationsResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *[]NodeReservationResponse
+	ApplicationProblemJSON400 *ProblemDetails
+	ApplicationProblemJSON401 *ProblemDetails
+	ApplicationProblemJSON403 *ProblemDetails
+	ApplicationProblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodeReservationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodeReservationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type CreateNodeReservationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *string
+	ApplicationProblemJSON400 *ProblemDetails
+	ApplicationProblemJSON401 *ProblemDetails
+	ApplicationProblemJSON403 *ProblemDetails
+	ApplicationProblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r CreateNodeReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r CreateNodeReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteNodeReservationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON202                   *string
+	ApplicationProblemJSON400 *ProblemDetails
+	ApplicationProblemJSON401 *ProblemDetails
+	ApplicationProblemJSON403 *ProblemDetails
+	ApplicationProblemJSON404 *ProblemDetails
+	ApplicationProblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteNodeReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteNodeReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetNodeReservationResponse struct {
+	Body                      []byte
+	HTTPResponse              *http.Response
+	JSON200                   *NodeReservationResponse
+	ApplicationProblemJSON400 *ProblemDetails
+	ApplicationProblemJSON401 *ProblemDetails
+	ApplicationProblemJSON403 *ProblemDetails
+	ApplicationProblemJSON404 *ProblemDetails
+	ApplicationProblemJSON500 *ProblemDetails
+}
+
+// Status returns HTTPResponse.Status
+func (r GetNodeReservationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetNodeReservationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
 // GetAllVersionsWithResponse request returning *GetAllVersionsResponse
 func (c *ClientWithResponses) GetAllVersionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAllVersionsResponse, error) {
 	rsp, err := c.GetAllVersions(ctx, reqEditors...)
@@ -1247,6 +1847,15 @@ func (c *ClientWithResponses) GetMinorVersionsWithResponse(ctx context.Context,
 	return ParseGetMinorVersionsResponse(rsp)
 }
 
+// GetCapacityWithResponse request returning *GetCapacityResponse
+func (c *ClientWithResponses) GetCapacityWithResponse(ctx context.Context, params *GetCapacityParams, reqEditors ...RequestEditorFn) (*GetCapacityResponse, error) {
+	rsp, err := c.GetCapacity(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetCapacityResponse(rsp)
+}
+
 // GetNodeAllocationRequestsWithResponse request returning *GetNodeAllocationRequestsResponse
 func (c *ClientWithResponses) GetNodeAllocationRequestsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestsResponse, error) {
 	rsp, err := c.GetNodeAllocationRequests(ctx, reqEditors...)
@@ -1282,50 +1891,249 @@ func (c *ClientWithResponses) DeleteNodeAllocationRequestWithResponse(ctx contex
 	return ParseDeleteNodeAllocationRequestResponse(rsp)
 }
 
-// GetNodeAllocationRequestWithResponse request returning *GetNodeAllocationRequestResponse
-func (c *ClientWithResponses) GetNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestResponse, error) {
-	rsp, err := c.GetNodeAllocationRequest(ctx, nodeAllocationRequestId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetNodeAllocationRequestResponse(rsp)
-}
+// GetNodeAllocationRequestWithResponse request returning *GetNodeAllocationRequestResponse
+func (c *ClientWithResponses) GetNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetNodeAllocationRequestResponse, error) {
+	rsp, err := c.GetNodeAllocationRequest(ctx, nodeAllocationRequestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeAllocationRequestResponse(rsp)
+}
+
+// UpdateNodeAllocationRequestWithBodyWithResponse request with arbitrary body returning *UpdateNodeAllocationRequestResponse
+func (c *ClientWithResponses) UpdateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, nodeAllocationRequestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error) {
+	rsp, err := c.UpdateNodeAllocationRequestWithBody(ctx, nodeAllocationRequestId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNodeAllocationRequestResponse(rsp)
+}
+
+func (c *ClientWithResponses) UpdateNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, body UpdateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error) {
+	rsp, err := c.UpdateNodeAllocationRequest(ctx, nodeAllocationRequestId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseUpdateNodeAllocationRequestResponse(rsp)
+}
+
+// GetAllocatedNodesFromNodeAllocationRequestWithResponse request returning *GetAllocatedNodesFromNodeAllocationRequestResponse
+func (c *ClientWithResponses) GetAllocatedNodesFromNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error) {
+	rsp, err := c.GetAllocatedNodesFromNodeAllocationRequest(ctx, nodeAllocationRequestId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAllocatedNodesFromNodeAllocationRequestResponse(rsp)
+}
+
+// GetNodeReservationsWithResponse request returning *GetNodeReservationsResponse
+func (c *ClientWithResponses) GetNodeReservationsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetNodeReservationsResponse, error) {
+	rsp, err := c.GetNodeReservations(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeReservationsResponse(rsp)
+}
+
+// CreateNodeReservationWithBodyWithResponse request with arbitrary body returning *CreateNodeReservationResponse
+func (c *ClientWithResponses) CreateNodeReservationWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*CreateNodeReservationResponse, error) {
+	rsp, err := c.CreateNodeReservationWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNodeReservationResponse(rsp)
+}
+
+func (c *ClientWithResponses) CreateNodeReservationWithResponse(ctx context.Context, body CreateNodeReservationJSONRequestBody, reqEditors ...RequestEditorFn) (*CreateNodeReservationResponse, error) {
+	rsp, err := c.CreateNodeReservation(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseCreateNodeReservationResponse(rsp)
+}
+
+// DeleteNodeReservationWithResponse request returning *DeleteNodeReservationResponse
+func (c *ClientWithResponses) DeleteNodeReservationWithResponse(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*DeleteNodeReservationResponse, error) {
+	rsp, err := c.DeleteNodeReservation(ctx, nodeReservationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteNodeReservationResponse(rsp)
+}
+
+// GetNodeReservationWithResponse request returning *GetNodeReservationResponse
+func (c *ClientWithResponses) GetNodeReservationWithResponse(ctx context.Context, nodeReservationId string, reqEditors ...RequestEditorFn) (*GetNodeReservationResponse, error) {
+	rsp, err := c.GetNodeReservation(ctx, nodeReservationId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetNodeReservationResponse(rsp)
+}
+
+// ParseGetAllVersionsResponse parses an HTTP response from a GetAllVersionsWithResponse call
+func ParseGetAllVersionsResponse(rsp *http.Response) (*GetAllVersionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllVersionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest APIVersions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAllocatedNodesResponse parses an HTTP response from a GetAllocatedNodesWithResponse call
+func ParseGetAllocatedNodesResponse(rsp *http.Response) (*GetAllocatedNodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllocatedNodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AllocatedNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAllocatedNodeResponse parses an HTTP response from a GetAllocatedNodeWithResponse call
+func ParseGetAllocatedNodeResponse(rsp *http.Response) (*GetAllocatedNodeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAllocatedNodeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AllocatedNode
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
 
-// UpdateNodeAllocationRequestWithBodyWithResponse request with arbitrary body returning *UpdateNodeAllocationRequestResponse
-func (c *ClientWithResponses) UpdateNodeAllocationRequestWithBodyWithResponse(ctx context.Context, nodeAllocationRequestId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error) {
-	rsp, err := c.UpdateNodeAllocationRequestWithBody(ctx, nodeAllocationRequestId, contentType, body, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseUpdateNodeAllocationRequestResponse(rsp)
-}
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON500 = &dest
 
-func (c *ClientWithResponses) UpdateNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, body UpdateNodeAllocationRequestJSONRequestBody, reqEditors ...RequestEditorFn) (*UpdateNodeAllocationRequestResponse, error) {
-	rsp, err := c.UpdateNodeAllocationRequest(ctx, nodeAllocationRequestId, body, reqEditors...)
-	if err != nil {
-		return nil, err
 	}
-	return ParseUpdateNodeAllocationRequestResponse(rsp)
-}
 
-// GetAllocatedNodesFromNodeAllocationRequestWithResponse request returning *GetAllocatedNodesFromNodeAllocationRequestResponse
-func (c *ClientWithResponses) GetAllocatedNodesFromNodeAllocationRequestWithResponse(ctx context.Context, nodeAllocationRequestId string, reqEditors ...RequestEditorFn) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error) {
-	rsp, err := c.GetAllocatedNodesFromNodeAllocationRequest(ctx, nodeAllocationRequestId, reqEditors...)
-	if err != nil {
-		return nil, err
-	}
-	return ParseGetAllocatedNodesFromNodeAllocationRequestResponse(rsp)
+	return response, nil
 }
 
-// ParseGetAllVersionsResponse parses an HTTP response from a GetAllVersionsWithResponse call
-func ParseGetAllVersionsResponse(rsp *http.Response) (*GetAllVersionsResponse, error) {
+// ParseGetMinorVersionsResponse parses an HTTP response from a GetMinorVersionsWithResponse call
+func ParseGetMinorVersionsResponse(rsp *http.Response) (*GetMinorVersionsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllVersionsResponse{
+	response := &GetMinorVersionsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1357,22 +2165,22 @@ func ParseGetAllVersionsResponse(rsp *http.Response) (*GetAllVersionsResponse, e
 	return response, nil
 }
 
-// ParseGetAllocatedNodesResponse parses an HTTP response from a GetAllocatedNodesWithResponse call
-func ParseGetAllocatedNodesResponse(rsp *http.Response) (*GetAllocatedNodesResponse, error) {
+// ParseGetCapacityResponse parses an HTTP response from a GetCapacityWithResponse call
+func ParseGetCapacityResponse(rsp *http.Response) (*GetCapacityResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllocatedNodesResponse{
+	response := &GetCapacityResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AllocatedNode
+		var dest Capacity
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1399,6 +2207,13 @@ func ParseGetAllocatedNodesResponse(rsp *http.Response) (*GetAllocatedNodesRespo
 		}
 		response.ApplicationProblemJSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1406,27 +2221,34 @@ func ParseGetAllocatedNodesResponse(rsp *http.Response) (*GetAllocatedNodesRespo
 		}
 		response.ApplicationProblemJSON500 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 501:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON501 = &dest
+
 	}
 
 	return response, nil
 }
 
-// ParseGetAllocatedNodeResponse parses an HTTP response from a GetAllocatedNodeWithResponse call
-func ParseGetAllocatedNodeResponse(rsp *http.Response) (*GetAllocatedNodeResponse, error) {
+// ParseGetNodeAllocationRequestsResponse parses an HTTP response from a GetNodeAllocationRequestsWithResponse call
+func ParseGetNodeAllocationRequestsResponse(rsp *http.Response) (*GetNodeAllocationRequestsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllocatedNodeResponse{
+	response := &GetNodeAllocationRequestsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest AllocatedNode
+		var dest []NodeAllocationRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1453,12 +2275,59 @@ func ParseGetAllocatedNodeResponse(rsp *http.Response) (*GetAllocatedNodeRespons
 		}
 		response.ApplicationProblemJSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationProblemJSON404 = &dest
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNodeAllocationRequestResponse parses an HTTP response from a CreateNodeAllocationRequestWithResponse call
+func ParseCreateNodeAllocationRequestResponse(rsp *http.Response) (*CreateNodeAllocationRequestResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNodeAllocationRequestResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
@@ -1472,26 +2341,26 @@ func ParseGetAllocatedNodeResponse(rsp *http.Response) (*GetAllocatedNodeRespons
 	return response, nil
 }
 
-// ParseGetMinorVersionsResponse parses an HTTP response from a GetMinorVersionsWithResponse call
-func ParseGetMinorVersionsResponse(rsp *http.Response) (*GetMinorVersionsResponse, error) {
+// ParseDeleteNodeAllocationRequestResponse parses an HTTP response from a DeleteNodeAllocationRequestWithResponse call
+func ParseDeleteNodeAllocationRequestResponse(rsp *http.Response) (*DeleteNodeAllocationRequestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetMinorVersionsResponse{
+	response := &DeleteNodeAllocationRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest APIVersions
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest string
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON200 = &dest
+		response.JSON202 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ProblemDetails
@@ -1500,6 +2369,27 @@ func ParseGetMinorVersionsResponse(rsp *http.Response) (*GetMinorVersionsRespons
 		}
 		response.ApplicationProblemJSON400 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1512,22 +2402,22 @@ func ParseGetMinorVersionsResponse(rsp *http.Response) (*GetMinorVersionsRespons
 	return response, nil
 }
 
-// ParseGetNodeAllocationRequestsResponse parses an HTTP response from a GetNodeAllocationRequestsWithResponse call
-func ParseGetNodeAllocationRequestsResponse(rsp *http.Response) (*GetNodeAllocationRequestsResponse, error) {
+// ParseGetNodeAllocationRequestResponse parses an HTTP response from a GetNodeAllocationRequestWithResponse call
+func ParseGetNodeAllocationRequestResponse(rsp *http.Response) (*GetNodeAllocationRequestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeAllocationRequestsResponse{
+	response := &GetNodeAllocationRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []NodeAllocationRequestResponse
+		var dest NodeAllocationRequestResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1554,6 +2444,13 @@ func ParseGetNodeAllocationRequestsResponse(rsp *http.Response) (*GetNodeAllocat
 		}
 		response.ApplicationProblemJSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1566,15 +2463,15 @@ func ParseGetNodeAllocationRequestsResponse(rsp *http.Response) (*GetNodeAllocat
 	return response, nil
 }
 
-// ParseCreateNodeAllocationRequestResponse parses an HTTP response from a CreateNodeAllocationRequestWithResponse call
-func ParseCreateNodeAllocationRequestResponse(rsp *http.Response) (*CreateNodeAllocationRequestResponse, error) {
+// ParseUpdateNodeAllocationRequestResponse parses an HTTP response from a UpdateNodeAllocationRequestWithResponse call
+func ParseUpdateNodeAllocationRequestResponse(rsp *http.Response) (*UpdateNodeAllocationRequestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &CreateNodeAllocationRequestResponse{
+	response := &UpdateNodeAllocationRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1608,6 +2505,13 @@ func ParseCreateNodeAllocationRequestResponse(rsp *http.Response) (*CreateNodeAl
 		}
 		response.ApplicationProblemJSON403 = &dest
 
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON404 = &dest
+
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
@@ -1620,26 +2524,26 @@ func ParseCreateNodeAllocationRequestResponse(rsp *http.Response) (*CreateNodeAl
 	return response, nil
 }
 
-// ParseDeleteNodeAllocationRequestResponse parses an HTTP response from a DeleteNodeAllocationRequestWithResponse call
-func ParseDeleteNodeAllocationRequestResponse(rsp *http.Response) (*DeleteNodeAllocationRequestResponse, error) {
+// ParseGetAllocatedNodesFromNodeAllocationRequestResponse parses an HTTP response from a GetAllocatedNodesFromNodeAllocationRequestWithResponse call
+func ParseGetAllocatedNodesFromNodeAllocationRequestResponse(rsp *http.Response) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &DeleteNodeAllocationRequestResponse{
+	response := &GetAllocatedNodesFromNodeAllocationRequestResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
-		var dest string
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []AllocatedNode
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.JSON202 = &dest
+		response.JSON200 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
 		var dest ProblemDetails
@@ -1681,22 +2585,22 @@ func ParseDeleteNodeAllocationRequestResponse(rsp *http.Response) (*DeleteNodeAl
 	return response, nil
 }
 
-// ParseGetNodeAllocationRequestResponse parses an HTTP response from a GetNodeAllocationRequestWithResponse call
-func ParseGetNodeAllocationRequestResponse(rsp *http.Response) (*GetNodeAllocationRequestResponse, error) {
+// ParseGetNodeReservationsResponse parses an HTTP response from a GetNodeReservationsWithResponse call
+func ParseGetNodeReservationsResponse(rsp *http.Response) (*GetNodeReservationsResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetNodeAllocationRequestResponse{
+	response := &GetNodeReservationsResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest NodeAllocationRequestResponse
+		var dest []NodeReservationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1723,12 +2627,59 @@ func ParseGetNodeAllocationRequestResponse(rsp *http.Response) (*GetNodeAllocati
 		}
 		response.ApplicationProblemJSON403 = &dest
 
-	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
-		response.ApplicationProblemJSON404 = &dest
+		response.ApplicationProblemJSON500 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseCreateNodeReservationResponse parses an HTTP response from a CreateNodeReservationWithResponse call
+func ParseCreateNodeReservationResponse(rsp *http.Response) (*CreateNodeReservationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &CreateNodeReservationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 202:
+		var dest string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON202 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest ProblemDetails
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.ApplicationProblemJSON403 = &dest
 
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 500:
 		var dest ProblemDetails
@@ -1742,15 +2693,15 @@ func ParseGetNodeAllocationRequestResponse(rsp *http.Response) (*GetNodeAllocati
 	return response, nil
 }
 
-// ParseUpdateNodeAllocationRequestResponse parses an HTTP response from a UpdateNodeAllocationRequestWithResponse call
-func ParseUpdateNodeAllocationRequestResponse(rsp *http.Response) (*UpdateNodeAllocationRequestResponse, error) {
+// ParseDeleteNodeReservationResponse parses an HTTP response from a DeleteNodeReservationWithResponse call
+func ParseDeleteNodeReservationResponse(rsp *http.Response) (*DeleteNodeReservationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &UpdateNodeAllocationRequestResponse{
+	response := &DeleteNodeReservationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
@@ -1803,22 +2754,22 @@ func ParseUpdateNodeAllocationRequestResponse(rsp *http.Response) (*UpdateNodeAl
 	return response, nil
 }
 
-// ParseGetAllocatedNodesFromNodeAllocationRequestResponse parses an HTTP response from a GetAllocatedNodesFromNodeAllocationRequestWithResponse call
-func ParseGetAllocatedNodesFromNodeAllocationRequestResponse(rsp *http.Response) (*GetAllocatedNodesFromNodeAllocationRequestResponse, error) {
+// ParseGetNodeReservationResponse parses an HTTP response from a GetNodeReservationWithResponse call
+func ParseGetNodeReservationResponse(rsp *http.Response) (*GetNodeReservationResponse, error) {
 	bodyBytes, err := io.ReadAll(rsp.Body)
 	defer func() { _ = rsp.Body.Close() }()
 	if err != nil {
 		return nil, err
 	}
 
-	response := &GetAllocatedNodesFromNodeAllocationRequestResponse{
+	response := &GetNodeReservationResponse{
 		Body:         bodyBytes,
 		HTTPResponse: rsp,
 	}
 
 	switch {
 	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
-		var dest []AllocatedNode
+		var dest NodeReservationResponse
 		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
 			return nil, err
 		}
@@ -1867,72 +2818,102 @@ func ParseGetAllocatedNodesFromNodeAllocationRequestResponse(rsp *http.Response)
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcbXMbN5L+K11zVyWrjiKV2LuV1TeJideskmKWRN9dVegP4EyTRDQDTACMZCal/76F",
-	"BuaVGJKykjje5TeRxACNfnn66QZGv0WxzHIpUBgdXfwW6XiNGaM/L6eT/0WluRT2U4I6Vjw39DGaiKVU",
-	"GbOfgC1kYYDBgxsMcglmjXA5nQznIhpEuZI5KsORZn2op8RPLMtTjC6ib4bnw/NoEJlNbj9qo7hYRU9P",
-	"1Tdy8TPGJnoaNKTSh4mVcm2sTH5hvUc+lvPm/JWMPzVE9/I+fRxE3GBGA/9b4TK6iP5rVOtz5JU5amiy",
-	"3hJTim3s50LxqcIl/9TWyWjNVPLIFJ5lTLAVqlGu5AO3s3CxGj18c6C+0lTGzGDyo0zwII0JYOUzIGSC",
-	"oFDLQsUYUtcii/ft/upmbAWJpVjy1UwxoVls15sk2+KMtwcB12QvnqAwfMlRlRZ0MxbKSW/qh5ygbmPR",
-	"RcSF+fubWltcGFyhsjKtlCzyH1kWUIz9tlzIqu6fdih96miHa2Bay5jTV4/crN3yHdsMovXjVMklTwOL",
-	"vfOmhtyNKBe2C/TMxgPa+yD4LwVCy+QNvfXNJAyqJYsxEFDXPngEmkep7qEe2910R96D4mJSzhYKC22Y",
-	"KfaHVnOvd+4RGwcKfym4wiS6+MmqakCO2tpr0/pN44Q9tZLn474Yu6vk7rh2oRQKA24eq1Qm2qYKxVcs",
-	"RcJNGO3G1W+gMFeo7fTWDCkzqA2wB8ZTtrDutNCoHpgp8a+78okmsXB4qOWqpUOWc6thMj4k4t/3D+6P",
-	"/HKFF0NAEDELsx6nHIVxMm3L3B0BCS65QB1ApaVUkPDlEsn2rDBru5fYy7vJUQ/nYrbm1gCqiA2giFmu",
-	"CzIhzafRGC5W1sbOpWnOO1QPPMbLOJaFMAO4YprHA2AigfdWvO5SGcZrJrjOdBDF7dP2sTuMFZp+NGSg",
-	"aQS84sLt1nu1YBnqnMV4ahVgGLdJikYUGpX9dS6scDnT+lEqtwkSuiPpEGa0aVqFa8BPOcYWZIwsZ4aT",
-	"cs4T2vBJOekJ3OOmUuiSY0o+VCnucY0CZpucQFujsXPOI5JiHvWgo7Ti1Y6wKyjeb3mOda9UH/b07Pqu",
-	"8RSJsQf6CrO2m9nCO3r4Y49nz/zM2x5Niml6st25VPzX2lmtMZ3pOkYj3aEoMrt+2zejgdNwNIhIQQ3J",
-	"ai1bjrAl1BXTuJBMJXBDDCizjjaWwiiZpqjg1dXN+DQQb4fgKksShTpEIafgfwOpYC21EQ0mcHUz7vGT",
-	"WCHBFEv1fkLRGOxUaiSwOLaL7lqlY+dyD9uLh4w/Zmm6YPF9II/4XwKqXFfEJC1WXICQFoid0YNIwgLg",
-	"uc+JuyETs6tCJCmG9XiLBKZEQbzEkDFLzZgpEUITUBFziQttZAbjS4itlCS89W8JC+/OhAqxzLJC0M7E",
-	"ai4qRhOXuqGEo9wqa6aBGw2z67vmrKD5SmACiw0wEFKc5cUi5XFnbYInbEreg3IMNBerFIEYoRUEEziJ",
-	"2dmCtDOMlTlpgi1LUzCq0HaaznbngguY/nADLiH2ubDf64fb6wC3vL22wmkUSa2UljvYn0mXldNYx/C/",
-	"WdOnaPAQv27KEfTkinz0U6KaEWmLBw0C5PmPm65JfNqOnDJtiI3QdDMecsXrrTElZbFPg7FfeErgpTLV",
-	"aIujSmYgBZaU0IKAkGaNqkldEmbwzE4VMlmGWrNVQLQb9wNVJ7AuMibOFLKE2GBW/iYS7/CQoGE81b4E",
-	"tDLXkobWVch0SP+39D1BR2vjJ9qrZOesuoc631WUuTXpgJQnlzBTBQ7gLUs1DuCDuBfyMTi/CeY+ynsW",
-	"KSq32ueg9Gsl7iDkK5WKahuFXLmugLbzRbfk+sxMl7IFpiHfXWBqU1yDXZdm26r2egAjY/FlXx69uRzX",
-	"iXT5rGnF3vx52EwdqwlX6Tl9tIQPWcaq0+uWS3GLvxSozWEdp+CjdRcFACDEwaU0lTtch412tTWmBhwy",
-	"p1OPnWqvluMGH9hZ6pXj7DOpTS8q2LlxPzXdKdQhCOqmT8K/YMdIlK2gHb0SmSBQb0HD45rHduNUhTj7",
-	"W+kWzBKPctmDKu6qBxXslXATiJg7bvD3s0c3mip5/PJN7+jroQR8/ODYu0WdS6H39DBfcRGnRWJzWtUk",
-	"oAx/+sLw7MWDfUbbfuhpEN0d1NwKPt5och2muL6G1I7B4EYufCHY1qTrA+zR4p/VyArL8R/bz+pqfdee",
-	"p/VICyGYUgVCMV2WyKQSlk5bc27hdAd1WhM5AmqrHLl0oNjYPlFes0auoFweHlhaUHe249x97t4DxqG8",
-	"XAMz4R+VWDvwr63JCu++Z4YdDNY0uBc66dePuzZWLhbKeaWXJMwwxwbrg4rQDp5z/tDX3Pfqs0t+Pnk7",
-	"aJ4SSWg7oUCbbPPWKsWuah30Tuz8VKoXu7pUekuC1tq1XZUMaf9Wps9Ujua/BvP9r4F5WlM0G98Bbkzy",
-	"tQ9DunYIKNDLE3Lk9/ub6e8P66Zb9I9pFDBjFF8UrkHOTCdn9DW+y7Yw12XTOt20+i4LBAaPbOOacaQb",
-	"YGDkPQpXqjPheuvf+mZQ8LyGRHRt9N19wENa6Y4+Vk0tGkULnPEEmEjmwn/0UznwdG2txYbGu9MAN6zP",
-	"m2KZhw7/3KP0azmzkdRNt0mZWj1OT9R9cpoawmyTc1tbbAaO9+q1LNLEatd13OdiHskcBU/mEVgMTsre",
-	"iAQmNkANELeqt6/dxt3Ne9A5xtRuStNN2dTX8AqHq+EA5uS8eh7ZPx37tn+jiYfD4elc2Nl9PxtBFqrc",
-	"h+5w8J6mQc0GaJs/iCSXXAQKwsvpBND/2ujttt3JyzzKlTQylunIKeQslkJgbEY0ypt/Lh55mpJ35jmK",
-	"xM1IZR7TCL4vZ7Mdfbks0tR+2WPrQqXhRjtN5MGj3fj3nU8v89qYXF+MRve4iVPJ7of+xsIwltlIIUsz",
-	"PZKKidNDiggrTVejg+0Yqlw0BDFTJRcpZt+7Dpbd3Fan32P7ZYUbL8D8S7EBUWQLR97qSRqoNACmPYgl",
-	"4Bq5pee6BqSiFrEAbhWXoTDuECWUMVxjLuBm3aYefspTJrzF/HLOUbgGGTtwiav8kDut2TXrKydj534+",
-	"GG3+IRczPLPVamHCtwe0YSLUwLqED7cTUGW73sVyRf784WYpab+EczExkLENbOg8b1koAgjeIHh8CQlW",
-	"CyUdrlwo/pxm42yN8G42m5Zd2dhmUpff92mySc+D5NxwkwY1pddSmUHXprrIMqY2nZXoJG4IE1NCq5AG",
-	"bJJboW8p1zIa2S/xYC7wU4y5od3lhcqlRqqqLB1OffgPYbJ0Z39cw4o/WNAXiUdps2YC5hER7ItFysS9",
-	"xVxSVBUOoNcsTYGlmlIsXWJKSiMd2KLtuhKLY6moyDcSJj/M3sLt2zG8/sd3f4efXn8MetqW8rgGFLEs",
-	"FFtZSC0TjV3Iy6jnomOQRMZFFa8V6SunJoSEQnOxeje7uT51KbLlmfB/9itSUIYEIlyT/TyDGcwFN9pl",
-	"W3+zqMgqetLRtD91rcK3hObSIxs6tOi8NyY64Fy1tz0G9YBvAzrbRqt/6zC09v2kGq3BEyFHW4MVmE0H",
-	"O64ptWcmIrU16aGZPlRw1mf027BR/rTnMsjs+u6sQr06Z4S4pP6dWGTzjFDA/w//dv6P1rElEclc8Qf7",
-	"4R43rSsYWaENuKYawolJ9fAeN/7ehf1Ep5B07YKAwkrQmNryv/JQdC6oJZ1hYgtLGF++0qeDmrM0H4vX",
-	"jIsGc/TrJ6Uxm2NtnTKoqtS5SJEtOwOUNiQwVWhSGhhf0nGU22hj7ImGscwyKYD0+2r846nN1ncFeQBc",
-	"pgaVzbEPOBduxN3lj6eloCzlK1HXy9pdgzjRtL8N/FKw1OJRAonM7P7oYoGRoIs8l8rAQpo1cLGiMxMy",
-	"iizMSlq7+cqnua1CI8RMl66zJ5ZrV9oO4ydK40vp23SGxcRrXSUf3WIC75jNZ8QdK5R5fHwcKkzWzBC4",
-	"bBOl6YQcntQgVvCufZWAWjwuHdbth6m7ZXA5nUSD7eu2A6obWM6ji+j18Hz42kYMM2sKlj3XZVnOzx4a",
-	"93pXoVtOt2gKVdZZ/sC6uj9s91PdIa6YW11oeXM7a1Qn35Mkuoj+ieYyTatrxVRCUy+bRPn2/LzUPLqK",
-	"guV56sni6Gd/zupaS4ffNNbOrp0OQUGXTJw/yoVFBUzC2y23avfzNIje7BTSZ5z/eZ6wHeYekPeKJWWd",
-	"ZoX42xcRgg4sLMP3hRAqJdWQQswTNGfilodY72YrbWMvQ8MS6vTZR/Ze6x5V94vPbMY7zFvTYAIsOzW6",
-	"3ynr0S/2y8Ouwreuo28n3T6X/Qt54Zvzb76AEB9E1b5InBSvv4AUb6Va8CRB8TWEYzgWGpHZjLzPjM7R",
-	"b6y5zCR5Oihew7IdEqaU8xTL0KDS9FoKt5PbPBiV3feoI1PUJANGFThoWKNLHD7+kempHf3HaP+Kov3N",
-	"+ZsvIMKsbhJhAraiNxt4ZK5oXspCJMP/XCh6LqctWULGhVT9hLZqdmXsZ6l63+vbwqkbO+1fh+Ueieuh",
-	"7rntDy+grzYtnrHqSP2sPGd5lofuvl3Sx2eDT/05vHb3laUjzz3y3D8sevfESn+SGUS5DN1pHSukdyT2",
-	"X5WbLIHVbwO0m51cV73+sm3nnWswF9Q2d80eOt2k1woYTN/fzaoDZn/KWc6KjfcOPtxeuz4bg/J66pRt",
-	"Usn82xzUCKzgoWpuaHilWx0Q5WcRTrunIVxx2gjf5HO8GrW5ksnmd0tvPbcG2z09S+OftpDt22cJccB9",
-	"wIl/g85CdM+hTZi0eeu29M0FN+5SkX8JypknVzJuXBPf43RzscvrLDUs3W7QHFYeotMpRJHXXuEv1x1R",
-	"94i6h6Kug4Q9jvpCct9Ho0a/iXCcPrmItji3Hdvfo8M/B+lQPw6tK49t5HMP9SHf/i5Ej6Av7EZ8TRBH",
-	"5ng+xB3B6Nj8+PdofjgIgT4M6SWmvlA8rML7gmB0/seSvrqA3HWWB6pRZx5x44gbXz9u3KJRHB/woGy5",
-	"q8AtAvXthzw51rcVqjpt/AWA9VhIfybLLMiCxzL6mIGOGeh3y0A3MuHLDf1TFfpHUrvjavinV/s7rgnt",
-	"uefzVsns6+bRf+hNoyOhPsLZvzmh7rmOsPXWL/t86k2rkzwOSXreN64v/NJ/NKT/fFRfM74YjehVlLXU",
-	"5uK78+/cv9z1K4ZevCvPqptvB9XQVZ1kPw0C7ypU0reOzvyjrd09fXz6VwAAAP//s+8bBDdZAAA=",
+	"H4sIAAAAAAAC/+w9XXPbOJJ/BcW7qiR1tOzZmd3a9T453smO6+LEZTt3VzXKA0S2RIxJgAOAdjRT/u9X",
+	"aAAkSIL6SOJkPvQmiSDQ6O9uNFq/JpmoasGBa5Wc/pqorICK4sezq4v/AamY4OZbDiqTrNb4NbngSyEr",
+	"ar4RuhCNJpTc28FELIkugJxdXczmPEmTWooapGaAs953U8IHWtUlJKfJN7OT2UmSJnpdm69KS8ZXyeNj",
+	"+4tY/ASZTh7TACq1G1glU9rA5BZWW+CjNQvnb2H8MQDdwfv4Pk2YhgoH/qeEZXKa/Mdxh89jh8zjAJPd",
+	"lqiUdG2+N5JdSViyD32cHBdU5g9UwlFFOV2BPK6luGdmFsZXx/ff7IivshQZ1ZC/ETnshDFOqH+HcJED",
+	"kaBEIzOIoWtRZdt2//Ly3ACSCb5kq1tJuaKZWe8iH4NzPh5EmEJ6sRy4ZksG0lPQzthIC73uXrKA2o0l",
+	"pwnj+m/fddhiXMMKpIFpJUVTv6FVBDHmV7+QQd2/zVD8NsAOU4QqJTKGPz0wXdjlB7RJk+LhSoolKyOL",
+	"/eBITWo7wi9sFpiYjUWw946znxsgPZIHeJuaiWuQS5pBRKBeO+HhoB+EvCPd2OGmB/DuJBcXfraYWChN",
+	"dbNdtMK93thXjBxI+LlhEvLk9EeDqhQZtbfXkPohceKc2sLzfpuM3bRwD1i7kRK4JnYeg1TK+6SKyVcm",
+	"eM50XNudt8+IhFqCMtMbMpRUg9KE3lNW0oVhp4UCeU+113/DlZ8pBAtmu1KuXTpGObsa5Oe7SPzb6cHT",
+	"ku9X+GQVENWYjS7OSwZcW5jGMA9HkByWjIOKaKWlkCRnyyUg7WmjC7OXzMG7rkHN5vy2YIYAssk0AZ7R",
+	"WjVIQpxPgdaMrxTxLI1z3oC8ZxmcZZlouE7JS6pYlhLKc/LWgDdcqoKsoJypSkW1uHnbvHYDmQQ9rQ0p",
+	"UTiCPGfc7tZxNacVqJpm8MIgQFNmjBSOaBRI83TODXA1VepBSLsJBHoA6YzcFuBXYYrAhxoyo2S08DOT",
+	"Z37OZ7jhZ37SZ+QO1i1ClwxK5KEWcQ8FcHK7rlFpK9BmznmCUMyTCe0oDHgdI2wSircjzjHsVard3r59",
+	"fRO8hWBsUX2NLsxmRvoOX34/wdm3buYxRyNiQk42OxeS/dIxqyGmJd2AaIg74E1l1u/zZpJaDCdpgghK",
+	"3kewbHyEEVAvqYKFoDInl+gBVYbRzgXXUpQlSPL85eX5i4i87aJXaZ5LUDEX8oq4Z0RIUgileeAJvLw8",
+	"n+CTTAKqKVqq7Q5FMNiiVAtCs8wsummVAZ39HsaLx4h/TstyQbO7iB1xTyKoLFrHpGxWjBMujCK2RI9q",
+	"EhpRntuYeCgyGX3Z8LyEOB6vAZUpuiAOYlJR45pR7TWEQkWFnkvWKC0qcn5GMpAOeMPfgiwcO6NWyERV",
+	"NRx3xldz3no0mccNGhxpVymoIkwrcvv6JpyVKLbikJPFmlDCBT+qm0XJssHaqJ4ghHxCy1GiGF+VQNAj",
+	"NIBATp5l9GiB2JllUj8LlS0tS6Jlo8w0g+3OOePk6vtLYg3iFAu7vb67fh3xLa9fG+AU8LxDSo8dzGPE",
+	"Zcs0hjHcM0P6EjTswtchHHFOrmnG9DoiY021sF6Cj1lU4AWhbrBOO7p+szH3erWxaep2ULfILBpdLCXA",
+	"bjAiVxmMcWC6ANmLMSQpoHRcJaF15OJrbggyQgXkA46rcbzR4WbEIHZ1yHfbVBRqu1WmDOsYrjc0j+/E",
+	"T3QlRBlzHC9GXqF/g9RClKnlRfP7zw3INXmgyozQknlBExyi21RMR9B3w3Toin7C/FpoWu7BGBnlZOE1",
+	"RiPDgKsYBI6zuI8bSlcY6VhIHKcG9E0DSYhKYOv+TwclXUyijEUOQhAXgdjpwtCjL4wlVRrjAZzulsWM",
+	"wevRGB80mLeJNj84pzxnXYyAH40nI0VlyOSDMmOGuTASGAYPOdVwZKaKEbMCpegqAtqlfWDgoaRoKsqP",
+	"JNAcNVHln/HcmRySg6asVC4JY2DuII3LIlUx/F/j76jreht/phxKNs6qJoLXmzZo7U2aIvLEktzKBlLy",
+	"ipYKUvKO33HxEJ1fR71P9DzFspt3q4nApy24aYxXWhR1NIqxcpeDGAvkMOnxkb5mSRdQxnh3AaVxMoP4",
+	"1pNtlG+ZMNkVzc6mPNnLs/POlV3uNS3f6sHuNtOAatzmWiw+esDHKGPQ6XDLBL+GnxtQerecb/TVLo9J",
+	"CCGxKFgI3bLD6zjRXo7GdArHfHHoMVNtxXIWeOQbky1+nHmnbJQGGc2d2kchO8VydFHcTEH4G8zZcp+M",
+	"3ZCtbN0YRR4KlpmNM9XS30C3oApy4pfdKefVZoGj2cpdfIZPpMdQmlp43PIhd0xlMSM8vrPsXYOqBVdb",
+	"ThGeM56VTW5sWpumQwv/4hPFc1IfbCPa+KXHNLnZKb0cfT1IM++GuKmU8IbBxI5cuFRMH5M2E7cFi18q",
+	"lRyH40+bUR5ifdOer7qRRoVAiTkAlGmfpEKU0PKqN+dITw+0Tm8i64CaPINYWqUYbB9dXl0Ak8QvT+5p",
+	"2eD5yIC5J9mda3a2XDIeDcmvrcaqLENR3caZjnssRFSG52qCt2ysrKLMmdKMZ9oBZ19FixtzuLSoRSlW",
+	"6/+GKEDOCuDrKVFNVhBqcCRNSkNIUosHkCQXFWXcj3oohAK7OqkapV1SnyxAPwDw/q50AXNuw2hy3W6k",
+	"Ykr5pDjOirvGOLhkK7YonZ9QMf4a+EoXyek3W/3gYKdTenzCWMb8ps5wItoxCbXBPvWx3tqjf1FNdzam",
+	"ONiZdXUrrqES97Ap2m+d2f45LHKyhBKogi40V+yXSHLDHgrkTQb5jPRnnnM3mc/I0FICzdd+6hx/ZCsu",
+	"TOKi7zxMRDtejU2Zb8TARuJ5hMb8Lq+pcqqpy3C170UTtFukNRQOFbK92onvXfaI3HYEwlAJY1tPljlf",
+	"SoBAxjPKjRwoqplars2Qak/HrKeEIhZkn8P3qZNtxzwG0x8fN+00j8cMUnFL8suHjP4dR4HNE1sTIeQn",
+	"Wxkh1QiC3todO0sRw/61KPdEjsoEftyBK24y0b7Ffok66DH90Fs4sOxe297URiecC660pMxVTg3jxIbn",
+	"yliyQjyQhsM98HI9IS6oUxROSmgmhVIoMI0EZ4VUXKDmvFV0n12gbif2ul2v2SAfqd2vqxhydYQdHZ2m",
+	"tOF1l0jeIMdakEKU7pyS1CXlHHKSQ12KdQU8arw2xNXjRPPbo/NSNDkeLIPSoQ9xFdRoXfvHvUwtc5nx",
+	"pZAz8tYxxZxHzazqFJAySqSFklR03RpAL3zWgfULTUiPzbmrs0gmBdOnVLchc2RSazmtJUwJWxKmMfFt",
+	"mK2zxwvc09Bj3phG3RDVvwmieU/aFh7E4m1g6YFmxcDUo9DFkut2si+bAhDLOGY/Q8TfkXYH+fmswXww",
+	"78ZqQT6W4W3oDoc7Pgl+2k1eB/PMNme+94BoouBsDOQI8M0FZfFlopIxGrZj5iBKsi+aMwggaLMFKMrX",
+	"TjbmvF0exVg2QATPwJ50oxChlgxPYtGUGv26h1RvTD14QTUQq2mHuTvu7KS7ZbadoLgOFooa2SiX3HTO",
+	"0AAwyu9QbJcx/6CiOivM4955OGJ/WPJRs+xOEVoJG7pWhMoF05JKVq7Ro59z1p7oKohaV+vbYLpRbQ06",
+	"OnPqA/KsoEox1T53PlJnp6yTQpSWVMNqjekCyRaNr6Eze1RzHvpcqT38M98XUtDccGzf6XKpEcKplOKh",
+	"DXp3DffSxIMTO05zgEpHpdB9VqfkJSj9imlSS1hikiZ83PkESzBgkfOrd0QXBgMK6+HMI2Oi9Zxfn12m",
+	"HjvxyVRBpYfAzRdSy8d+7Zp4OhpLdaTkFZOV4ZxLw1wTy7XhdMPbCsGlew+Bf3nx9qarefQrDM+5baXj",
+	"2b1g+fdSCqk2oYoLIiFD7YRHhOYT4Fv9ujWH9SRNLMaSNOltKUmTYMVIHdvADLT0n1Lxkz52PJzYHE3Q",
+	"yVjCDNspb1bRDzd38BA5SqQfWNVUbSVrBv0EQOtcRWIbxgnla6IfxDCoQe/LMAEqJyHnPFJa088r+ASZ",
+	"gSZMj0WCtM+Y/MOiyJZbh5HZKMM350+V4ktbEsVY6u32yuW3u5UuG6Od4ShCdaBJqR6Y+qkqY1+Dy5Sv",
+	"EC7XvSK3BRBKHuiaYOUj7pZQosUdcKuYKbeFzH9xlXfxoM2AaGuWNxdd7lK3bM1JW0GIo3CBI5YbdTPn",
+	"7qubyokU1hA6u48Qu7emsxd1zJGwr+JTP7MW3sOxmTOLJ2u1EVMzU8zMzDHyOrVHnKoQjXFDwJU3z/k8",
+	"ETVwls8TlMTcl8EIlEqrzS1MXXr+5vItUTVkWNtXlmtfQa3Ic5itZimZY3iv5on5aEMz8xl0NpvNXsy5",
+	"Fm3xMBDRSL8Pta8JxW1+z/NaRNXi2dUFAfc0KKTts5OD2Vyf0iIT5bFFyFEmOIdMH+MoR35T+VmWyJ11",
+	"DTy3M6KTQBUQVwRp4iP8cdmUpflxgtaNLONVzTiRT2P3qqxdmamDudC6VqfHx3ewzkpB72buetgsE9Wx",
+	"BFpW6lhIyl/sEj0aaIYYTccy1LJoTMVcSbEoofqXLVYymxuVVbtc4lmrNz4hx3jG14Fx6SYJtFJq1LdV",
+	"YjmxVbOecxGhQmINIifMIK4CrocxYLc7W4MVYbNh/RZ8MFklRzG3nGUUpojIrHLJ2sxibbE2wxjd3+87",
+	"t+znhNHkO5HFNKsgJ6LR8ataSlMeq1U6I++uL4j0tdFWltu0g7tJ4iGdhnDOLzTmltZ4eWLZSFQQLEgJ",
+	"sCXJoV0oHyR5Gsn2qSszAccPt7dXvgAvEzm4fPI2TIYnsfFsLdNlFFOqEFKnQ5qqpqqoXA9WwmsPM3Kh",
+	"vWo1xtwYuRW46sEORi2mIU7nHD5kUGvcXd3IWijr3BiPp3TiPyMXS1zRGM4VuzdK3/h9SARdUE7mCeZd",
+	"Thcl5XdG5yKiWnEw7nxZEloqNLF4YzT3RNqxGm/ISjTLhEQ/TQty8f3tK3L96px8+4+//438+O37KKeN",
+	"kMcUAZ6JRtIV5PYVM84s5GBUcz4gSC6yppXX9pDBT40akjTG3/rh9vL1C2sie5xJ/tdmx5kiFaAScVGq",
+	"82BSE7m6CMdd42yq1j0ZYNqisBNfr5o9RwY4NNp5q0yMYgVXyeh00ITyDVRnn2jds4GH1r8M2mlr4hyh",
+	"iUp4LnK8f7HhTmh/ZnSkRpPucTY62m4vITLtyXdl5pE0X39Tm677dhnj3mFamK2PX+q9XEnz8p4l6g5R",
+	"bVzr7njPtjJK/9Jqt3iMYborZWPF6x9tubt4+/rmqLUbndWNJnk+kx8eXmnh5P9mfz35R++WDbritWT3",
+	"5ssdrHs3BvGM2iatgTzTpZrdwdpdEzTf8NIM3hJEVVtAb2qmujs85s6MBllBzsyj87Pn6kXaeX3ha1lh",
+	"gsXO93br557K4VibvfDplDkvgS4HA6TSbR5HCqHNbZ6SKm03Gox9psi5qCrBCeL3+fmbF8bfuWmQA8hZ",
+	"qUFyqtm9OV/CETdnb154QGnJVjw4YLK39p4p3N+a/NzQ0vBu7oNhQyK8AtTUtZCaLIQuCOMrLDBGoohG",
+	"r4Shm4sdw201CkhGlWedLdqwY6UxXz+iI7QULj+taYaRgT17T64hJz9Q4xGg993q6YeHh5mEvKAa1fPY",
+	"1by6QIZHNPAV+aGfBlVJ61B0B41X+Mh0kkjScXeIFCMvWrPkNPl2djL71kgM1QUKy5buDrRmR/dBG4pV",
+	"7FLuNehG+kjV3a9q212Y/fgZOt+3C1UduS012otaRosl/wZ9VpZtFwxDGntWhKD85eTEYx5sTEbrunTu",
+	"9vFP7lKCzWrv3hhDWboO0qQN3om0/CgWRitAHt+u36rZz2OafLcRSGez/2s/YAexTwTel7Q9EzZA/PWr",
+	"AIHVvSZGcqEkZjpnKGLOxbUk7nGI4W66Ukb2KtA0x5Ik88rWLiTHbc7uiPsjkq3cWkZdiO463SRTdqM/",
+	"mS9369wSLhlxW6ZY9jfEhd+dfPMVgHjH2wRQbqH49itA8UrIBctz4L8HcYzLQiCZoeR9pHQe/0rDZS7y",
+	"x53kNQ7bLmKKNk/SCjRIhV2UmJnc2MHE18slA5iS0BnQsoE0oMbQcXj/lOapL/0Haf8dSft3J999BRBu",
+	"uzQb5AS4ZnrdFkgtzSHe7M+rivb1ab2XUDEu5LRD26YLK/qTkJNt6EZ66tJM+9vxcg+O667sOeaHT3Bf",
+	"s6CZxEaONCfvlTkz6x1N037XAYzbFdOAB79LCZB2pX7mWWvq0lE7ijlv2vshNvc7ut3f1osOWwQU9N6W",
+	"gfbLJ3wW4c3FOVGl0CpoIjDnLhIsRvXvEgi27jE51Nu2wQGWftL8SBgYzMy5AKvYsCQzRM0/Mdyncz5I",
+	"ybX1m11B8kgs2+4eI89h73YWvvS339YCHRDcUueB9MuUd/U90j17UsyMZh3/rEKM2zy2ragSFdMa8img",
+	"Bx0y9oJ0UJbaQaaYho8FyNWkfh1nreWbjYkE4tc/eGwHj+1367EZeL75Ssga1oe2VsDnhe3RrVfrMQPe",
+	"szpZp+8/xbvkIoej7oLMkZOw/TzNzVeqp/JS0be+TH5q8z39Q77qkK96Mi98i6xMi3Oa1CLWyOVcArbm",
+	"294f4sL43Vm8ayFTbdWDP37z17RsXaZTW1jnhd3sKLl6e3PrR/l6r6DbVruSqdrC8zJKfE+WK7ouBc2D",
+	"q62temgPKRR5rnonGdLNwi12X8T0isVGFBPORwWlX4p8/dkcqPhaj4+PQ5f4caTZ/rIXEDs0wbhwjVuN",
+	"ip4oX4lbJ0fdHr4ZZ9pe53W9Ny15aimyoDfSFqab801c90A7tkvDYb6cEOsxmrrjCtdR4qB1D1p3V61r",
+	"VcIWRn0iN+r4Vx6X00cr0SXELj/+C0roVDrpXie9PhJ9zWdfmtJ8208TJgD9xFOF35OKQ3Lsr+IOyugQ",
+	"Ev8xDjGsCiFTOmTSMXWB4m4R3ldURidP6/R1AeQhlXbQG38ivXENWjJwRynb++tNB7hNJL59V+eH+LbV",
+	"qhYbvwHFegikP9LLbJCChzD6YIEOFuizWaBLkbMltnay/1+0Wa5mXzza31Duu6Ve95UU1e/bj37SiuGD",
+	"Q31QZ39wh3qirHDUb5N+vOu9u9YLWjTtf0Yca5616XT4OlzsS50Lx5q9HU6ED2cTT3oiHJWM/c+C7TSg",
+	"wguyrmNZv1WrK8IcN/kkDdfMlrRhX8Q1dlZ40o6baVtvWXTtMue87RzdNmsN/w8LgQP8s0GmbObel12G",
+	"wxhey1wy/BM4qtY8K6TgolHl+p/4X3T2ArlZrRZSqz6Eti2Tge6hAP8HY86zrEjm7612ffOmzqL7HQyf",
+	"KngOV/kiYXOvWeOTBcxhV8CDej2o1487+o1p2M/tENn4rycVe5zzBu+lrluwV9ydYrRV42rzAXBf3ewW",
+	"JQ4br37NQ98vo1c2HffG27weVM8hvvyjHfT2dcVHHfF+bXVz8lRe1OFA96AfDvmnTW3Pp9wnMxvOH7uC",
+	"FmkEQ25wdK/9zOnxMTZ5K4TSp38/+ftJ8vi+XTHW0tLfYQz77nVaxz9Nxre6wn+f6IXf7tXe7h7fP/7/",
+	"ABC1Ao/+iQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -0,0 +1,212 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/api/middleware"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/auth"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+)
+
+// HardwarePlugin Server config values
+const (
+	readTimeout  = 5 * time.Second
+	writeTimeout = 10 * time.Second
+	idleTimeout  = 120 * time.Second
+)
+
+// NewServerLogger creates the JSON logger used by the provisioning and inventory servers of a HardwarePlugin
+func NewServerLogger() *slog.Logger {
+	return slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
+		AddSource: true,
+		Level:     slog.LevelDebug,
+	}))
+}
+
+// BuildAndServe builds the provisioning and inventory servers of a HardwarePlugin with a logger created by
+// NewServerLogger, and serves them with Serve.
+func BuildAndServe(ctx context.Context, logger *slog.Logger, config svcutils.CommonServerConfig, pluginName string,
+	newProvisioningServer func(*slog.Logger) (provisioning.StrictServerInterface, error),
+	newInventoryServer func(*slog.Logger) (inventory.StrictServerInterface, error)) error {
+	serverLogger := NewServerLogger()
+
+	provisioningServer, err := newProvisioningServer(serverLogger)
+	if err != nil {
+		return fmt.Errorf("failed to build %s provisioning server: %w", pluginName, err)
+	}
+
+	inventoryServer, err := newInventoryServer(serverLogger)
+	if err != nil {
+		return fmt.Errorf("failed to build %s inventory server: %w", pluginName, err)
+	}
+
+	return Serve(ctx, logger, config, pluginName, provisioningServer, inventoryServer)
+}
+
+// Serve starts the API server of a HardwarePlugin and blocks until it terminates or context is canceled.  The plugin
+// name is only used to identify the server in logs and errors.
+func Serve(ctx context.Context, logger *slog.Logger, config svcutils.CommonServerConfig, pluginName string,
+	provisioningServer provisioning.StrictServerInterface, inventoryServer inventory.StrictServerInterface) error {
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.InfoContext(ctx, "Initializing the HardwarePlugin server", slog.String("plugin", pluginName))
+
+	// Retrieve the OpenAPI spec file
+	provisioningAPIswagger, err := provisioning.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to get provisioning swagger: %w", err)
+	}
+
+	inventoryAPIswagger, err := inventory.GetSwagger()
+	if err != nil {
+		return fmt.Errorf("failed to get inventory swagger: %w", err)
+	}
+
+	// Channel for shutdown signals
+	shutdown := make(chan os.Signal, 1)
+	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	go func() {
+		sig := <-shutdown
+		slog.InfoContext(ctx, "Shutdown signal received", slog.String("signal", sig.String()))
+		cancel()
+	}()
+
+	// Create strict handler for provisioning server
+	provisioningServerStrictHandler := provisioning.NewStrictHandlerWithOptions(provisioningServer, nil,
+		provisioning.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  GetRequestErrorFunc(),
+			ResponseErrorHandlerFunc: GetResponseErrorFunc(),
+		},
+	)
+
+	// Create strict handler for inventory server
+	inventoryStrictHandler := inventory.NewStrictHandlerWithOptions(inventoryServer, nil,
+		inventory.StrictHTTPServerOptions{
+			RequestErrorHandlerFunc:  GetRequestErrorFunc(),
+			ResponseErrorHandlerFunc: GetResponseErrorFunc(),
+		},
+	)
+
+	// Create base router
+	baseRouter := http.NewServeMux()
+
+	// Register a default handler that replies with 404 so that we can override the response format
+	baseRouter.HandleFunc("/", GetNotFoundFunc())
+
+	// Create authn/authz middleware
+	authn, err := auth.GetAuthenticator(ctx, &config)
+	if err != nil {
+		return fmt.Errorf("error setting up %s authenticator middleware: %w", pluginName, err)
+	}
+
+	authz, err := auth.GetAuthorizer()
+	if err != nil {
+		return fmt.Errorf("error setting up %s authorizer middleware: %w", pluginName, err)
+	}
+
+	// Create subrouters for provisioning and inventory
+	provisioningRouter := http.NewServeMux()
+	inventoryRouter := http.NewServeMux()
+
+	// Register handlers with subrouters
+	provisioning.HandlerWithOptions(provisioningServerStrictHandler, provisioning.StdHTTPServerOptions{
+		BaseRouter: provisioningRouter,
+		Middlewares: []provisioning.MiddlewareFunc{
+			GetOpenAPIValidationFunc(provisioningAPIswagger),
+			authz,
+			authn,
+			GetLogDurationFunc(),
+		},
+		ErrorHandlerFunc: GetRequestErrorFunc(),
+	})
+	inventory.HandlerWithOptions(inventoryStrictHandler, inventory.StdHTTPServerOptions{
+		BaseRouter: inventoryRouter,
+		Middlewares: []inventory.MiddlewareFunc{
+			GetOpenAPIValidationFunc(inventoryAPIswagger),
+			authz,
+			authn,
+			GetLogDurationFunc(),
+		},
+		ErrorHandlerFunc: GetRequestErrorFunc(),
+	})
+
+	// Mount subrouters to base router with path prefixes
+	baseRouter.Handle(constants.HardwareManagerProvisioningAPIPath+"/", provisioningRouter)
+	baseRouter.Handle(constants.HardwareManagerInventoryAPIPath+"/", inventoryRouter)
+
+	// Apply global middleware chain
+	handler := middleware.ChainHandlers(
+		baseRouter,
+		middleware.ErrorJsonifier(),
+		middleware.TrailingSlashStripper(),
+	)
+
+	serverTLSConfig, err := ctlrutils.GetServerTLSConfig(ctx, config.TLS.CertFile, config.TLS.KeyFile)
+	if err != nil {
+		return fmt.Errorf("failed to get %s server TLS config: %w", pluginName, err)
+	}
+
+	srv := &http.Server{
+		Handler:      handler,
+		Addr:         config.Listener.Address,
+		TLSConfig:    serverTLSConfig,
+		ReadTimeout:  readTimeout,
+		WriteTimeout: writeTimeout,
+		IdleTimeout:  idleTimeout,
+		ErrorLog:     slog.NewLogLogger(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{AddSource: true}), slog.LevelError),
+	}
+
+	// Start server
+	serverErrors := make(chan error, 1)
+	go func() {
+		logger.InfoContext(ctx, "Server listening", "address", srv.Addr)
+		// Cert/Key files aren't needed here since they've been added to the tls.Config above.
+		if err := srv.ListenAndServeTLS("", ""); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			serverErrors <- err
+		}
+	}()
+
+	defer func() {
+		// Cancel the context in case it wasn't already canceled
+		cancel()
+		// Shutdown the HardwarePlugin server
+		logger.InfoContext(ctx, "Shutting down HardwarePlugin server", slog.String("plugin", pluginName))
+		if err := common.GracefulShutdown(srv); err != nil {
+			logger.ErrorContext(ctx, "Error shutting down HardwarePlugin server", slog.String("plugin", pluginName), "error", err)
+		}
+	}()
+
+	// Blocking select
+	select {
+	case err := <-serverErrors:
+		return fmt.Errorf("error starting %s server: %w", pluginName, err)
+	case <-ctx.Done():
+		logger.InfoContext(ctx, "Process shutting down HardwarePlugin server", slog.String("plugin", pluginName))
+	}
+
+	return nil
+}
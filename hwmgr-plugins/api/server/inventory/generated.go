@@ -23,6 +23,13 @@ import (
 	openapi_types "github.com/oapi-codegen/runtime/types"
 )
 
+// Defines values for ResourceChangeNotificationNotificationEventType.
+const (
+	CREATE ResourceChangeNotificationNotificationEventType = 0
+	DELETE ResourceChangeNotificationNotificationEventType = 2
+	MODIFY ResourceChangeNotificationNotificationEventType = 1
+)
+
 // Defines values for ResourceInfoAdminState.
 const (
 	ResourceInfoAdminStateLOCKED       ResourceInfoAdminState = "LOCKED"
@@ -103,6 +110,27 @@ type ProcessorInfo struct {
 	Model *string `json:"model,omitempty"`
 }
 
+// ResourceChangeNotification Information about a resource change notification
+type ResourceChangeNotification struct {
+	// ConsumerSubscriptionId The value provided by the consumer in the subscription
+	ConsumerSubscriptionId *openapi_types.UUID `json:"consumerSubscriptionId,omitempty"`
+
+	// NotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+	NotificationEventType ResourceChangeNotificationNotificationEventType `json:"notificationEventType"`
+
+	// NotificationId A unique identifier to represent this notification event
+	NotificationId openapi_types.UUID `json:"notificationId"`
+
+	// Object The changed resource object.
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// ObjectRef The URL to the object. This is not required if the notificationEventType is 2 (DELETE).
+	ObjectRef *string `json:"objectRef,omitempty"`
+}
+
+// ResourceChangeNotificationNotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+type ResourceChangeNotificationNotificationEventType int
+
 // ResourceInfo Information about a resource.
 type ResourceInfo struct {
 	// AdminState The administrative state of the resource
@@ -794,6 +822,15 @@ func (response GetResourcePoolResources400ApplicationProblemPlusJSONResponse) Vi
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetResourcePoolResources404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetResourcePoolResources404ApplicationProblemPlusJSONResponse) VisitGetResourcePoolResourcesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetResourcePoolResources500ApplicationProblemPlusJSONResponse ProblemDetails
 
 func (response GetResourcePoolResources500ApplicationProblemPlusJSONResponse) VisitGetResourcePoolResourcesResponse(w http.ResponseWriter) error {
@@ -1468,52 +1505,56 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xb63LbNhZ+FQx3Z7adlSW7cj1e/3PsXDRNHI0vbXciTwciDkW0IMACoBw1o3ffAUBS",
-	"IAnJ9CZpnIx/JaZwObfvOwcH5IcoFlkuOHCtopMPUY4lzkCDtH+pYq5iSXNNBZ8Q84RA/SA6iW44/bMA",
-	"RAlwTRMKEokEYeRPG854NIjgPc5yBtFJhPHxmOzP8R7+EWDvMDlI9uZwfLiXjMeH8x8ODo6O4iQaRNQs",
-	"n2OdRoOI48zMbAkziCT8WVAJJDrRsoBBpOIUMmykTITMsI5OoqKgZqRe5XYFLSlfROv1uhpstTydTn4G",
-	"qaxKbQ0n3K1FBUd4LgqNMFq6wUZXnQI6nU6ckrkUOUhNwa663Cy50f5guD/cDwhUPxHz3yHW0XrgSaX6",
-	"icWo0kamcmN1j3w4p/76tYzvPNFLede3g4hqyOzAf0pIopPoH6NN4IxKY448S25UwlLilfm7kHQqIaHv",
-	"mzYZpViSOyxhL8McL0COKF8C10KuRsuDfsaaSjFnkJ2DxpS5QG4qSwg1xsLsVGtJ54VuP582xre2HLTM",
-	"f8pXiBfZvAz4ehGE69UHCCtEIKEcCKLcoCKHmCY0dl4TEs1XCHNEjRky4No+H0YB7YhVqxsFpygtMsz3",
-	"JGCC5wwQvM8Z5m6DajukBdIpVUjEcSEl8BiqyMid1YYNgJ4JziG2S2iBCNZ4jhUgTTMgSBS66xCDVqUx",
-	"jyEk4s3lBElIwO2sU6w3fKGsGLWk2yWc8YlGGV6hFQVGUFJInYJE1IMBTRCBeiPiQn5DBJKGBFca6yKA",
-	"r+sU0Kvr6ylyA1AsCKBEyB6WrLek3LMV5RoWIC0sqGZBS6lUSD1o+1QVWYblqrUTMusO0USbWQUjiAuN",
-	"4hTzBaBEisyXUYvtEg9mHN7HkGurXV7IXCiw1MFEjBn9y0UlmiR2R0QVWtAlcIQ5QcI6QaeYo1lkaehk",
-	"zjD/YxYNnKFqOCCVYsYQZkqgud18SUnlpI5X3IP7QgnHsZCE8oVRcPL8+gW6fHGGxv85PkLvxrfBSOsY",
-	"jyoEPBaFxAsgbooZZzYqZVQz3nIIEXFR47UMis3S38FwMUSFonzx6vrN6+/RXQq8GZnoF/PIGigDSyJU",
-	"Wf/lEhRwPZhxqhVaYlZYg2OlCgM+bW3XsnQ7v6Za5+pkNKoi0rPhMBbZvZhY+3n1XQWQmoNuw+Qbg1JC",
-	"mqzUL1fl1ZRuWpJxSjXEupAQxmU9FzXG+kZ4f3y0d3QYCq1YSNiCdy00Zh6t5+lK0Rgz5OZ4649/COE6",
-	"w7xIsBVGhnfwR3g4rC2xUWDCNbCQ/JkgwO5f/V/KM5Odg2wV1dnju8vv0a8guPn3pWAEHR2Oxxf9ku4l",
-	"KFHIGPq7XZYzhl23k4zyK431Fqfb36nSEmu6BEvLNZVVqxrteJGZsL25eP327Kfn59Egunp1c309uXj5",
-	"2/nbX4xi9Q83Fz9dmEe3g3vSfVueV4YP0IYPNj+2JWpm1iuRNUc7s1gi8HToCLNgYo7ZqVKgQ0X4xKu+",
-	"JVIgaSOMfXkGJkviJabMSN6UDpv1KdnDQRGkKPIAbn6C1Z2QxFQ6XGjDxW6k52s0Byb4QiEtzIZ1HbmF",
-	"9TflYno3lSKhLld6BHeXu8dhSRmeA/uY2u5tXtZybiWE85xRx75tT22E+jBzG+/hWXSCZpHlbvPHYGZy",
-	"o/tt7v82n0VrP/ttYJVBJuRqF0fVzOSGmvLyDX0WLDZ28MUSOGmwQwhPtYZTcQfyOVkA+vXy+Gg/WAa6",
-	"g1p7rytT1rgNqmQZxocCuQS5h32MhLYxzsTOSTsYwxt1L108vzh99tqSwvnkqvrvLn7IsdQXFmI7bWuG",
-	"bYFiSLHc2HiHSvb3e5V5a1ju7YsXYcGrrGCh0OtQ10zvAaBWMtxDTpXzL3c6P2SXaoOpEMxtspmXC8HC",
-	"sxwT9vDSTsoM1qd4sZsLzeO5YUMhUcywUjRZ2ULVWxjVh6aHkGKh8ALqEKlcPjl//TwaRKdn15OfzX+e",
-	"3Vz9954Idrp3tfjZ2cQQg1+tdKqHc2AMTXg8vLeE9MKj40qf5ZtEXLJJLWhFZS2/NqBYc2cjzgd+cRFg",
-	"j4ZRb3fUOVbmB9c6yERot+D5RBVGvfrHlxlh9m6JEsoTARm2o7KL5d6Egcwck+zMw6pphMqmUQ2nvoIo",
-	"qvsyljkK9zS+JMW4NyJqEJSh7gsSCsQrrwPaKwg5qltpgaZsMyBjzNgcx3+EqTIpGFuhPwvMjGmIPSFr",
-	"gTCKBTenU+nOG6SQgO5SGqcoxrw6gyCMpsI1J435Zrzy6JltWFwIXffFtnQEql2u7mlIB5xXCygSBMYY",
-	"CplDNiIFuJoOkL8qMo4CpRutnHAbeRAllOlQcjmTVBuWskKUmzqrEGFP+hzq87yEXEgNxFDuHWXMPHPr",
-	"AkHzleuQ+QLOOPcMZrLXksYwRNcpSEiELKv8cpFNb8G1XMx6HGHGKrkMhioZtlhfPdzqvkmNaFT5twRU",
-	"GQkMqjY6vqoA/cYBOuQAQ0NvOVtVHf/dMKsjuoultW1aOiqPBdc41ua/5U3DJRD0CpsSt5DM66nc3d0N",
-	"JZAUa9tK6baFpxNrAOsSvuio5KGxogCTnsqGYNQZPqmHn04nNhW2+vI2m3Gc0+gkGg/3h2ObD3VqAb2r",
-	"r45z+tvS6/4vQHfdegm6kFyVKDIEp6G+ZTC61jcNdQ/bC9kyLG1E1TnXRE/0EvQpY/Xlg80JueDK8dAP",
-	"+/uVV4Brd1ORszLaR78rR32bu55+9xHK+bx1MiliQ0+O28RcY9usD6pbqWr0WQ+iw51Clr23fz9M2NYd",
-	"RkDeZ5hU9GSE+PGLCDHhGqQ9VNmKHYGUQg4t+MpWtXNxI0Kiqmh+F2WgMcEaR7dmyu7Ln4fHaeWvjHIh",
-	"twdp3crP8O9Cbr3R68TtG7Ps44ncp2DsG4zdePh/Q9Iv3vyY7MTKZWPgR8ZKr5N656DSOUDu4kBUCfho",
-	"Yupwf/wFhHgh5JwSAnzoZDj8AjJcb65FgXTPOnfYFXWJKDgZPj74GXnGj9NsBff6302euAQtKSyhkUga",
-	"Zz2fNGpSeDhrjD40T4DrvjTi2h31Czrvgo2wwKsznQPn9ldn2iX17WdMcl2++tr46ctzQyM+Hz0xhPEG",
-	"73GsTQnOW72VzwS30eb01RN4l95x7WtD4INKh2+hbHhEIf+QDKPsqQSX79d8Whz0CvS/t0j9NgrUp+Lw",
-	"oYD4BmvDjy8LvRzVsxzclYi8u9QdqegRFoJPRWBfIS4qgH8lCS9U4nmo8W8YVC/kNGfsAMxVY+DfkeEa",
-	"13RffYY7+AJC3HBc6FRI+heQR9AI+gqLyvC9r9oBuUGUC6VDd5mANTTe6OteJTdR56Y0YOAyDSj9TJDV",
-	"J8sbTaQ17/9MPlt34H7wGffecbkUW4uQzmXuY7pOeoL644N6uwx1yGqE0KfLoqMPzYv+tSMDBqH3Ec/t",
-	"cxX82q/JBm5kiw1atWvIdJsho9YLCNtq0R0AdGrsAOBT7PPHcqIFrqlefV29TBfkfYE5uP8i231vo7Z9",
-	"ULuzzP1c+Pr782bjpQzPJE959IlLvlkueQm6d4Z377kvK5y3PmXZO2OiIN330E6nE3RlpzXecTsZjexX",
-	"n6lQ+uR4/9h9+V3u/SHwslv14oT/Ie6m0VS/VrEedN9VrY4nfvO5nLdpoa1v1/8LAAD//5f0ipahQAAA",
+	"H4sIAAAAAAAC/+xc+2/btrf/VwjeC9wNV7aTJity/VuapKuxNgmcZA/UwUBLRzY3idRIyolX+H+/IKkH",
+	"JdGO8m27JkN+aizzcXjO+XzOg3I/4ZCnGWfAlMTjTzgjgqSgQJhPMp/LUNBMUc4mkX4SQfUAj/ENo3/l",
+	"gGgETNGYgkA8RgS504YzhgMM9yTNEsBjTMjRQbQ3JwPyA8DgMN6PB3M4OhzEBweH81f7+69fhzEOMNXL",
+	"Z0QtcYAZSfXMljABFvBXTgVEeKxEDgGW4RJSoqWMuUiJwmOc51SPVOvMrKAEZQu82WzKweaUx5eTn0FI",
+	"c6T2CSfMrkU5Q2TOc4UIWtnB+qxqCej4cmIPmQmegVAUzKqresn69PvDveGeR6DqCZ//AaHCm8CRSvYT",
+	"K6FSaZmKjeUD8pGMuutXMn50RC/k3dwGmCpIzcD/FhDjMf6vUe04o0KZI0eT9ZGIEGStP+eCXgqI6X1T",
+	"J6MlEdEdETBICSMLECPKVsAUF+vRar+fsi4FnyeQnoIiNLGO3DxsFFGtLJIcKyXoPFft55eN8a0tg5b6",
+	"j9kasTydFw5fLYJItXqAiEQRxJRBhCjTqMggpDENrdW4QPM1IgxRrYYUmDLPh9hzusgcq+sFx2iZp4QN",
+	"BJCIzBNAcJ8lhNkNyu2Q4kgtqUQ8DHMhgIVQekZmtTZsAPSEMwahWUJxFBFF5kQCUjSFCPFcdQ2i0SoV",
+	"YSH4RLyZTpCAGOzOaklUzRfSiFFJul3CGZsolJI1WlNIIhTnQi1BIOrAgMYogmqjyLp8TQSC+gSXiqjc",
+	"g6/rJaB319eXyA5AIY8AxVz00GS1JWWOrihTsABhYEFV4tWUXHKhgrZNZZ6mRKxbOyG97hBNlJ6VJxFi",
+	"XKFwSdgCUCx46sqo+HaJgxmD+xAyZU6X5SLjEgx1JDwkCf3beiWaxGZHRCVa0BUwRFiEuDGCWhKGZtjQ",
+	"0HieEPbnDAdWURUckFySJEEkkRzNzeYrGpVG6ljFPnjIlUgYchFRttAHnJxdv0XTtyfo4P+OXqOPB7de",
+	"T+soj0oELOS5IAuI7BQ9Tm9UyChnrGWQiId5hdfCKeqlv4PhYohySdni3fWH99+juyWwpmeiX/Qjo6AU",
+	"DIlQaeyXCZDAVDBjVEm0IkluFE6kzDX4lNFdS9Pt+LpUKpPj0aj0SEeHw5CnD2Ji48bVjyVAKg669ZNv",
+	"CFJyoaNSv1iVlVO6YUmES6ogVLkAPy6ruagx1lXC/dHrwetDn2uFXMAWvCuuSOLQerZcSxqSBNk5zvoH",
+	"r3y4TgnLY2KEEf4d3BEODitN1AeYMAWJT/6UR5A8vPr/SEdNZg4yWVRnj++m36NfgTP97488idDrw4OD",
+	"835BdwqS5yKEE0M751xV0a2fE4hifslbzF2h7RchZxoF4uqBlFRrwiKnJBkdZ7WmyxV0NNaf3XyyFSy8",
+	"WWOAXQHPdIpy7SWqC1axbMyThN9pjjIyyTHaQwMUCiAKArSPBto4NF4H6BUaoAgSUGABzfIUjz/uBfvB",
+	"q9uOtwX4fqBHDFZEaLtKDdWT6dnx9RkO8IeL08nb33CAT8/en12f4duW6D61HaO8k8srjgQUnGTpyl0F",
+	"gdZAP8UVPuM1ljV+VHuDHdwIDbXP2b+mEPsXu5m+t/lOtQy61oIXBFsym04W9BivQfXgV+g7q7zvvSGq",
+	"RZIt5W5zlNsdGOpPnaWehl3qjFLKrhRRW4jTfE+lEkTRFZjUpnLUctXa9fDN+fuLk5/OTnGAr97dXF9P",
+	"zn/8/fTiF00O1Rc35z+d60e3wQMpc1uedzqmojqm1l+2JWpmp1c8bY62ajHe6ZyhI8wi4XOSHEsJyuf+",
+	"E6eCFUiCoI1Q4MoTaOchK0ITLXlTOqLXp9GAeEUQPM88secnWN9xEelqQfsNWyA70mXIOSScLSRSfIid",
+	"WmxL5lSXXMu7S8FjavNNJ0m4y+xjv6QJmUPyOfXRRWYnIbsSIlmWUJvBtC1VC/VpZjcekBkeoxk2jKk/",
+	"BDOGyu/m7nfzGd74aSKFlIv1rjhfRXc7VAeFD/SNN2HfEXNXwKJGhPXhqTrhJb8DcRYtAP06PXq95y2l",
+	"bLOjvdeVLg3sBmXC6ceHBLECMSAuRnzbaGMSa6QdjOGMepAuzs6P37w3pHA6uSr/3MUPGRHq3EBsp271",
+	"sC1Q9B0s0zrecSTz/YOHudAsd/H2rV/wMrMyUOjVGGmmyB6gljI8QE6l8ac7je/TS7nBJeeJ3aSel3Ge",
+	"+GdZJuxhpZ2U6VtZkcVuLtSP55oNuUBhQqSk8Vp/dBdGVePhMaSYS7KAykVKk09O3+vk6fjkevKz/uPN",
+	"zdVvD3iwPXv3FD9bnXDRyMm7GfgpJAmasHD4YIbhuEfHlC7LN4m4YJNK0JLKWnZtQLHizoafB25y4WGP",
+	"hlJ35TlG5kfnOkh7aDfh+UIZRrX656cZfvZuieKLEx4ZtqOyi+XehIH0nLICKhuvqGi8VnDqK4ikqi9j",
+	"6XZST+WLKD/ojYgKBIWru4L4HNGtH3s5IUNVO9pzsdEqUkmSzEn4p58q4zxJ1uivnCRaNZHpMimOSF2Z",
+	"GsRFuQB0t6ThEoWElXU8IuiSS1Wqb8a2V99bump9K2iP8SoBeWzLPolMURjlUJZb7qqmzgKphn1qw5gm",
+	"yhdcTgRVmqWMEMWmVisRN8Ucg6onJiDjQun+tEB3NEn0M7tuXf67tkMz1ihldeCkIehiEQTEXBRZfrFI",
+	"3Z8rOgpKN/B0Q7OQi4hahi3al4/XuqvSso6tR1GpJdCoqs/4rgT0BwtonwE0DV2wZF3emu2GWeXRXSxt",
+	"TOPfUnnImSK2wi9u66YQoXdE6cggEqcveXd3NxQQLYky7cju1crlxCjAmIQtOkdy0FhSgMRVUx13hk+q",
+	"4ceXExMKW3dbJpoxklE8xgfDveGBiYdqaQC9626KZPT3lXODtgBPh2MKKhdMFijSBKeguqnTZy1XqO+B",
+	"HJct3NJ4VBVztffgH0EdJ0l1gWdiQsaZtDz0am+vtAowZW/7sqTw9tEf0lJffV/a705PWpu3KpM81PRk",
+	"uY3PFTEXXt7jlkfV59kE+HCnkEX/+n8fJ2zrHtAj7xsSlfSkhfjhmwihW6/CFFUmY0cgBBdDA77iusea",
+	"uOEhuEyaP+IUFNE3c/hWT9l9gfp4Py3tlVLGxXYnra7DUvIHF1tvxTt++0Ev+3Q898UZ+zpj1x/+U5d0",
+	"kzfXJzu+Mm0M/Exf6VWpdwqVTgG5iwNRKeCT8anDvYNvIMRbLuY0ioANrQyH30CG6/rVAoi6tc4dsUld",
+	"zHMWDZ8e/LQ8B09TbTlz+t9NnpiCEhRW0AgkjVrPJY2KFB7PGqNPzQpw05dGcNB4ye2jtxHmef2sU3Bu",
+	"f/2snVLffsUg1+Wr58ZP354bGv755InBjze4J6HSKThr9Va+Etyqr3vH76lTrj03BD4qdfh3pA0vsHwE",
+	"LB8TBaWpnEjxHt2XxWovMEr8gobnh4bnlcD+K/PXz09dnTjaM2XdFSyd+94d4fIJJqsviWpfIc5LgD+T",
+	"gOdLQx3UuLcgshdymjN2AOaqMfCfiHDujs8/wu1/AyFuGMnVkgv6N0RPoFn1DJNK/9203AG5AGdcKt99",
+	"KxAFjbcOu9fdTdTZKQ0Y2EgDUr3h0fqLxY0m0jabdjzbdOC+/xX33nEBZt/sjjoXzk/pyusF6k8P6u00",
+	"1CKr4UJfLoqOPjVfRthYMkjA987kqXkuEXmQDezIFhu0clef6uoho6ZceFsuugOA9hg7APji++ypVLTA",
+	"FFXr59VvtU7eF5jBw5ft9nd1ctsP53emuV8LX/983Gy8OOKo5CWOvnDJv5ZL9DsVfSP8Jii6PRbnrZ/b",
+	"DE4Snkfdd+X0uxpXZlrjPbzxaGR+3b3kUo2P9o7s//BQ7P3J80Je+XKH+4P7utFUfos3Qfd92rI8cZvP",
+	"xby6hba53fz/AARcNzKJRAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -8,15 +8,29 @@ package inventory
 
 import (
 	"context"
+	"fmt"
 	"log/slog"
+	"net/http"
 
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type InventoryServer struct {
-	HubClient client.Client
-	Logger    *slog.Logger
+	HubClient     client.Client
+	Logger        *slog.Logger
+	Subscriptions *SubscriptionStore
+}
+
+// NewInventoryServer creates the base inventory server of a HardwarePlugin, keeping its subscriptions in the given
+// ConfigMap of the plugin namespace
+func NewInventoryServer(hubClient client.Client, noncachedClient client.Reader, logger *slog.Logger,
+	namespace, subscriptionsConfigMap string) InventoryServer {
+	return InventoryServer{
+		HubClient:     hubClient,
+		Logger:        logger,
+		Subscriptions: NewSubscriptionStore(hubClient, noncachedClient, namespace, subscriptionsConfigMap),
+	}
 }
 
 // InventoryServer implements StrictServerInterface. This ensures that we've conformed to the `StrictServerInterface` with a compile-time check
@@ -82,28 +96,70 @@ func (i *InventoryServer) GetResource(ctx context.Context, request GetResourceRe
 // GetSubscriptions receives the API request to this endpoint, executes the request, and responds appropriately
 func (i *InventoryServer) GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject,
 ) (GetSubscriptionsResponseObject, error) {
-	// TODO
-	objects := make([]Subscription, 1)
-	return GetSubscriptions200JSONResponse(objects), nil
+	subscriptions, err := i.Subscriptions.List(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+	return GetSubscriptions200JSONResponse(subscriptions), nil
 }
 
 // CreateSubscription receives the API request to this endpoint, executes the request, and responds appropriately
 func (i *InventoryServer) CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject,
 ) (CreateSubscriptionResponseObject, error) {
-	// TODO
-	return CreateSubscription201JSONResponse(Subscription{}), nil
+	if err := ValidateSubscription(request.Body); err != nil {
+		return CreateSubscription400ApplicationProblemPlusJSONResponse(ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"callback": request.Body.Callback,
+			},
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
+	subscription, err := i.Subscriptions.Create(ctx, *request.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	i.Logger.InfoContext(ctx, "Created inventory subscription",
+		slog.String("subscriptionId", subscription.SubscriptionId.String()),
+		slog.String("callback", subscription.Callback))
+	return CreateSubscription201JSONResponse(*subscription), nil
 }
 
 // GetSubscription receives the API request to this endpoint, executes the request, and responds appropriately
 func (i *InventoryServer) GetSubscription(ctx context.Context, request GetSubscriptionRequestObject,
 ) (GetSubscriptionResponseObject, error) {
-	// TODO
-	return GetSubscription200JSONResponse(Subscription{}), nil
+	subscription, err := i.Subscriptions.Get(ctx, request.SubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscription '%s': %w", request.SubscriptionId, err)
+	}
+
+	if subscription == nil {
+		return GetSubscription404ApplicationProblemPlusJSONResponse(ProblemDetails{
+			Detail: fmt.Sprintf("could not find subscription '%s'", request.SubscriptionId),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	return GetSubscription200JSONResponse(*subscription), nil
 }
 
 // DeleteSubscription receives the API request to this endpoint, executes the request, and responds appropriately
 func (i *InventoryServer) DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject,
 ) (DeleteSubscriptionResponseObject, error) {
-	// TODO
+	found, err := i.Subscriptions.Delete(ctx, request.SubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete subscription '%s': %w", request.SubscriptionId, err)
+	}
+
+	if !found {
+		return DeleteSubscription404ApplicationProblemPlusJSONResponse(ProblemDetails{
+			Detail: fmt.Sprintf("could not find subscription '%s'", request.SubscriptionId),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	i.Logger.InfoContext(ctx, "Deleted inventory subscription",
+		slog.String("subscriptionId", request.SubscriptionId.String()))
 	return DeleteSubscription200Response{}, nil
 }
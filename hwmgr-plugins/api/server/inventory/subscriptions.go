@@ -0,0 +1,210 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package inventory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"slices"
+	"strings"
+
+	"github.com/google/uuid"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+)
+
+// SubscriptionStore persists the inventory subscriptions of a hardware plugin in a ConfigMap.  Each subscription is
+// stored as a JSON document keyed by its identifier so that the subscriptions survive restarts of the plugin and are
+// shared between the API server and the controllers that deliver the notifications.
+type SubscriptionStore struct {
+	client    client.Client
+	reader    client.Reader
+	namespace string
+	name      string
+}
+
+// NewSubscriptionStore creates a store backed by the named ConfigMap.  Reads go through the given reader so that a
+// non-cached client can be used to always observe the latest subscriptions.
+func NewSubscriptionStore(c client.Client, reader client.Reader, namespace, name string) *SubscriptionStore {
+	return &SubscriptionStore{
+		client:    c,
+		reader:    reader,
+		namespace: namespace,
+		name:      name,
+	}
+}
+
+// List returns all subscriptions sorted by identifier
+func (s *SubscriptionStore) List(ctx context.Context) ([]Subscription, error) {
+	cm, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	subscriptions := []Subscription{}
+	if cm == nil {
+		return subscriptions, nil
+	}
+
+	keys := make([]string, 0, len(cm.Data))
+	for key := range cm.Data {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		var subscription Subscription
+		if err := json.Unmarshal([]byte(cm.Data[key]), &subscription); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal subscription '%s': %w", key, err)
+		}
+		subscriptions = append(subscriptions, subscription)
+	}
+	return subscriptions, nil
+}
+
+// Get returns the subscription with the given identifier, or nil if it does not exist
+func (s *SubscriptionStore) Get(ctx context.Context, id uuid.UUID) (*Subscription, error) {
+	cm, err := s.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if cm == nil {
+		return nil, nil
+	}
+
+	data, found := cm.Data[id.String()]
+	if !found {
+		return nil, nil
+	}
+
+	var subscription Subscription
+	if err := json.Unmarshal([]byte(data), &subscription); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal subscription '%s': %w", id, err)
+	}
+	return &subscription, nil
+}
+
+// Create assigns a new identifier to the subscription and persists it
+func (s *SubscriptionStore) Create(ctx context.Context, subscription Subscription) (*Subscription, error) {
+	id := uuid.New()
+	subscription.SubscriptionId = &id
+
+	data, err := json.Marshal(subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal subscription: %w", err)
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		if cm == nil {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      s.name,
+					Namespace: s.namespace,
+				},
+				Data: map[string]string{id.String(): string(data)},
+			}
+			if err := s.client.Create(ctx, cm); err != nil {
+				if errors.IsAlreadyExists(err) {
+					// Created concurrently; retry as a conflict so that the update path is taken
+					return errors.NewConflict(corev1.Resource("configmaps"), s.name, err)
+				}
+				return fmt.Errorf("failed to create subscriptions ConfigMap: %w", err)
+			}
+			return nil
+		}
+
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[id.String()] = string(data)
+		return s.client.Update(ctx, cm) // nolint: wrapcheck
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to persist subscription '%s': %w", id, err)
+	}
+
+	return &subscription, nil
+}
+
+// Delete removes the subscription with the given identifier.  It returns false if the subscription does not exist.
+func (s *SubscriptionStore) Delete(ctx context.Context, id uuid.UUID) (bool, error) {
+	found := false
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := s.get(ctx)
+		if err != nil {
+			return err
+		}
+
+		found = false
+		if cm == nil {
+			return nil
+		}
+		if _, found = cm.Data[id.String()]; !found {
+			return nil
+		}
+
+		delete(cm.Data, id.String())
+		return s.client.Update(ctx, cm) // nolint: wrapcheck
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to delete subscription '%s': %w", id, err)
+	}
+
+	return found, nil
+}
+
+// get returns the ConfigMap holding the subscriptions, or nil if no subscription was ever created
+func (s *SubscriptionStore) get(ctx context.Context) (*corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := s.reader.Get(ctx, client.ObjectKey{Namespace: s.namespace, Name: s.name}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get subscriptions ConfigMap: %w", err)
+	}
+	return cm, nil
+}
+
+// ValidateSubscription checks the callback and filter of a new subscription.  The plugin authenticates to the
+// subscribers with its service account so only callbacks within the cluster are supported.
+func ValidateSubscription(subscription *Subscription) error {
+	u, err := url.Parse(subscription.Callback)
+	if err != nil {
+		return fmt.Errorf("invalid callback URL: %w", err)
+	}
+
+	if u.Scheme != "https" {
+		return fmt.Errorf("invalid callback scheme %q, only https is supported", u.Scheme)
+	}
+
+	if !strings.HasSuffix(u.Hostname(), "."+constants.ClusterLocalDomain) {
+		return fmt.Errorf("invalid callback host %q, only hosts in the %s domain are supported",
+			u.Hostname(), constants.ClusterLocalDomain)
+	}
+
+	if subscription.Filter != nil {
+		if _, err := search.ParseSelector(slog.Default(), *subscription.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+	return nil
+}
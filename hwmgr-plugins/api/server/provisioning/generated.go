@@ -30,6 +30,14 @@ const (
 	ServiceAccount AuthType = "ServiceAccount"
 )
 
+// Defines values for NodeScoringStrategy.
+const (
+	AvoidErrors   NodeScoringStrategy = "AvoidErrors"
+	BestFit       NodeScoringStrategy = "BestFit"
+	FirmwareMatch NodeScoringStrategy = "FirmwareMatch"
+	Spread        NodeScoringStrategy = "Spread"
+)
+
 // APIVersion Information about a version of the API.
 type APIVersion struct {
 	Version *string `json:"version,omitempty"`
@@ -121,6 +129,30 @@ type Callback struct {
 	CallbackURL string `json:"callbackURL"`
 }
 
+// Capacity Number of resources available for a node group.
+type Capacity struct {
+	// Allocated Number of allocated resources.
+	Allocated int `json:"allocated"`
+
+	// Free Number of resources that are neither allocated nor held by a reservation.
+	Free int `json:"free"`
+
+	// HwProfile Name of the HardwareProfile of the node group.
+	HwProfile string `json:"hwProfile"`
+
+	// Reserved Number of resources held by a reservation that is not expired.
+	Reserved int `json:"reserved"`
+
+	// ResourcePoolId Identifier of the resource pool, when the query was restricted to one.
+	ResourcePoolId *string `json:"resourcePoolId,omitempty"`
+
+	// Site Site identifier, when the query was restricted to one.
+	Site *string `json:"site,omitempty"`
+
+	// Total Number of resources that can be configured with the hardware profile.
+	Total int `json:"total"`
+}
+
 // Condition Condition represents an observation of an object's state.
 type Condition struct {
 	// LastTransitionTime LastTransitionTime is the last time the condition transitioned from one status to another
@@ -196,14 +228,29 @@ type NodeAllocationRequestStatus struct {
 	SelectedGroups *map[string]string `json:"selectedGroups,omitempty"`
 }
 
+// NodeAntiAffinity Requirement that the nodes of a group are allocated on resources with distinct values of a label.
+type NodeAntiAffinity struct {
+	// TopologyKey Resource label, such as a rack or power domain label, whose value must differ between the nodes of the
+	// group. Resources missing the label are not eligible.
+	TopologyKey string `json:"topologyKey"`
+}
+
 // NodeGroup Information about a node group within a NodeAllocationRequest.
 type NodeGroup struct {
 	// NodeGroupData Configuration data for a NodeGroup.
 	NodeGroupData NodeGroupData `json:"nodeGroupData"`
+
+	// NodesToRemove Identifiers of the allocated nodes to release when the size of the node group is reduced. Identifiers of
+	// nodes that are already released are ignored.
+	NodesToRemove *[]string `json:"nodesToRemove,omitempty"`
 }
 
 // NodeGroupData Configuration data for a NodeGroup.
 type NodeGroupData struct {
+	// AntiAffinity Resource labels whose values must differ between the nodes of the group. The allocation fails when the
+	// free resources cannot satisfy them.
+	AntiAffinity *[]NodeAntiAffinity `json:"antiAffinity,omitempty"`
+
 	// HwProfile Hardware profile associated with the node group data.
 	HwProfile string `json:"hwProfile"`
 
@@ -219,8 +266,85 @@ type NodeGroupData struct {
 	// Role Role of the node group data.
 	Role string `json:"role"`
 
+	// Scoring Ranking of the free resources matching a node group. The hardware plugin picks among them arbitrarily when
+	// it is not set.
+	Scoring *NodeScoring `json:"scoring,omitempty"`
+
 	// Size Size of the node group.
 	Size int `json:"size"`
+
+	// TopologySpreadConstraints Bounds on how unevenly the nodes of the group are spread across failure domains. The allocation fails
+	// when the free resources cannot satisfy them.
+	TopologySpreadConstraints *[]NodeTopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+}
+
+// NodeReservation Hardware to hold for a planned deployment.
+type NodeReservation struct {
+	// ClusterId Identifier of the O-Cloud request, such as a ProvisioningRequest, the hardware is held for. Only the
+	// NodeAllocationRequests with the same clusterId may allocate the reserved hardware.
+	ClusterId string `json:"clusterId"`
+
+	// ExpiresAt Time at which the reserved hardware is released, if it was not allocated by then.
+	ExpiresAt time.Time `json:"expiresAt"`
+
+	// NodeGroup Node groups to hold hardware for. The size of each node group is the number of resources to hold.
+	NodeGroup []NodeGroup `json:"nodeGroup"`
+
+	// Site Site identifier of the reserved hardware.
+	Site string `json:"site"`
+}
+
+// NodeReservationResponse Information (including observed state) about a NodeReservation resource.
+type NodeReservationResponse struct {
+	// NodeReservation Hardware to hold for a planned deployment.
+	NodeReservation NodeReservation `json:"nodeReservation"`
+
+	// NodeReservationId Identifier of the NodeReservation.
+	NodeReservationId string `json:"nodeReservationId"`
+
+	// Status NodeReservationStatus describes the observed state of a NodeReservation resource.
+	Status NodeReservationStatus `json:"status"`
+}
+
+// NodeReservationStatus NodeReservationStatus describes the observed state of a NodeReservation resource.
+type NodeReservationStatus struct {
+	// Conditions Conditions represent the latest available observations of a NodeReservation's state. The Reserved
+	// condition is true once all the requested resources are held.
+	Conditions *[]Condition `json:"conditions,omitempty"`
+
+	// ReservedNodes Resources held by the reservation.
+	ReservedNodes *[]ReservedNode `json:"reservedNodes,omitempty"`
+}
+
+// NodeScoring Ranking of the free resources matching a node group. The hardware plugin picks among them arbitrarily when
+// it is not set.
+type NodeScoring struct {
+	// SpreadLabels Resource labels, such as rack or chassis labels, across which the Spread strategy distributes the nodes
+	// of the group, from the broadest failure domain to the narrowest.
+	SpreadLabels *[]string `json:"spreadLabels,omitempty"`
+
+	// Strategy Strategy ranking the resources: BestFit prefers the resources with the fewest CPU threads and the least
+	// RAM, Spread prefers the resources sharing the fewest spreadLabels values with the other nodes of the
+	// group, FirmwareMatch prefers the resources already running the firmware and BIOS settings of the
+	// hardware profile, and AvoidErrors prefers the resources with no recent transient errors.
+	Strategy NodeScoringStrategy `json:"strategy"`
+}
+
+// NodeScoringStrategy Strategy ranking the resources: BestFit prefers the resources with the fewest CPU threads and the least
+// RAM, Spread prefers the resources sharing the fewest spreadLabels values with the other nodes of the
+// group, FirmwareMatch prefers the resources already running the firmware and BIOS settings of the
+// hardware profile, and AvoidErrors prefers the resources with no recent transient errors.
+type NodeScoringStrategy string
+
+// NodeTopologySpreadConstraint Bound on how unevenly the nodes of a group are spread across the values of a label.
+type NodeTopologySpreadConstraint struct {
+	// MaxSkew Maximum difference between the number of nodes of the group in any two failure domains holding free or
+	// allocated resources of the group.
+	MaxSkew int `json:"maxSkew"`
+
+	// TopologyKey Resource label, such as a rack or power domain label, defining the failure domains. Resources missing
+	// the label are not eligible.
+	TopologyKey string `json:"topologyKey"`
 }
 
 // OAuthClientConfig OAuthClientConfig defines the configurable client attributes that represent the authentication mechanism.
@@ -274,6 +398,15 @@ type Properties struct {
 	NodeNames *[]string `json:"nodeNames,omitempty"`
 }
 
+// ReservedNode Resource held by a NodeReservation.
+type ReservedNode struct {
+	// GroupName Node group the resource is held for.
+	GroupName string `json:"groupName"`
+
+	// HwMgrNodeId Identifier of the resource in the hardware manager.
+	HwMgrNodeId string `json:"hwMgrNodeId"`
+}
+
 // TLSConfig TLSConfig defines the configuration for TLS-specific attributes.
 type TLSConfig struct {
 	// SecretName Name of a secret (in the current namespace) containing an X.509 certificate and
@@ -285,12 +418,27 @@ type TLSConfig struct {
 	SecretName string `json:"secretName"`
 }
 
+// GetCapacityParams defines parameters for GetCapacity.
+type GetCapacityParams struct {
+	// HwProfile Name of the HardwareProfile of the planned node group.
+	HwProfile string `form:"hwProfile" json:"hwProfile"`
+
+	// ResourcePoolId Identifier of the resource pool. All the resource pools are counted when it is omitted.
+	ResourcePoolId *string `form:"resourcePoolId,omitempty" json:"resourcePoolId,omitempty"`
+
+	// Site Site identifier. All the sites are counted when it is omitted.
+	Site *string `form:"site,omitempty" json:"site,omitempty"`
+}
+
 // CreateNodeAllocationRequestJSONRequestBody defines body for CreateNodeAllocationRequest for application/json ContentType.
 type CreateNodeAllocationRequestJSONRequestBody = NodeAllocationRequest
 
 // UpdateNodeAllocationRequestJSONRequestBody defines body for UpdateNodeAllocationRequest for application/json ContentType.
 type UpdateNodeAllocationRequestJSONRequestBody = NodeAllocationRequest
 
+// CreateNodeReservationJSONRequestBody defines body for CreateNodeReservation for application/json ContentType.
+type CreateNodeReservationJSONRequestBody = NodeReservation
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get API versions
@@ -305,6 +453,9 @@ type ServerInterface interface {
 	// Get minor API versions
 	// (GET /hardware-manager/provisioning/v1/api-versions)
 	GetMinorVersions(w http.ResponseWriter, r *http.Request)
+	// Get the hardware capacity
+	// (GET /hardware-manager/provisioning/v1/capacity)
+	GetCapacity(w http.ResponseWriter, r *http.Request, params GetCapacityParams)
 	// Get NodeAllocationRequest resources
 	// (GET /hardware-manager/provisioning/v1/node-allocation-requests)
 	GetNodeAllocationRequests(w http.ResponseWriter, r *http.Request)
@@ -323,6 +474,18 @@ type ServerInterface interface {
 	// Retrieve the AllocatedNode resources associated with a NodeAllocationRequest resource.
 	// (GET /hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}/allocated-nodes)
 	GetAllocatedNodesFromNodeAllocationRequest(w http.ResponseWriter, r *http.Request, nodeAllocationRequestId string)
+	// Get NodeReservation resources
+	// (GET /hardware-manager/provisioning/v1/node-reservations)
+	GetNodeReservations(w http.ResponseWriter, r *http.Request)
+	// Create NodeReservation resource
+	// (POST /hardware-manager/provisioning/v1/node-reservations)
+	CreateNodeReservation(w http.ResponseWriter, r *http.Request)
+	// Delete NodeReservation
+	// (DELETE /hardware-manager/provisioning/v1/node-reservations/{nodeReservationId})
+	DeleteNodeReservation(w http.ResponseWriter, r *http.Request, nodeReservationId string)
+	// Retrieve the NodeReservation resource.
+	// (GET /hardware-manager/provisioning/v1/node-reservations/{nodeReservationId})
+	GetNodeReservation(w http.ResponseWriter, r *http.Request, nodeReservationId string)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -401,6 +564,56 @@ func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// GetCapacity operation middleware
+func (siw *ServerInterfaceWrapper) GetCapacity(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCapacityParams
+
+	// ------------- Required query parameter "hwProfile" -------------
+
+	if paramValue := r.URL.Query().Get("hwProfile"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "hwProfile"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "hwProfile", r.URL.Query(), &params.HwProfile)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hwProfile", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "resourcePoolId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resourcePoolId", r.URL.Query(), &params.ResourcePoolId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "resourcePoolId", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "site" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "site", r.URL.Query(), &params.Site)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "site", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCapacity(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetNodeAllocationRequests operation middleware
 func (siw *ServerInterfaceWrapper) GetNodeAllocationRequests(w http.ResponseWriter, r *http.Request) {
 
@@ -529,6 +742,84 @@ func (siw *ServerInterfaceWrapper) GetAllocatedNodesFromNodeAllocationRequest(w
 	handler.ServeHTTP(w, r)
 }
 
+// GetNodeReservations operation middleware
+func (siw *ServerInterfaceWrapper) GetNodeReservations(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNodeReservations(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateNodeReservation operation middleware
+func (siw *ServerInterfaceWrapper) CreateNodeReservation(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateNodeReservation(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteNodeReservation operation middleware
+func (siw *ServerInterfaceWrapper) DeleteNodeReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "nodeReservationId" -------------
+	var nodeReservationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeReservationId", r.PathValue("nodeReservationId"), &nodeReservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeReservationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteNodeReservation(w, r, nodeReservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetNodeReservation operation middleware
+func (siw *ServerInterfaceWrapper) GetNodeReservation(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "nodeReservationId" -------------
+	var nodeReservationId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "nodeReservationId", r.PathValue("nodeReservationId"), &nodeReservationId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nodeReservationId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetNodeReservation(w, r, nodeReservationId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -653,12 +944,17 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/allocated-nodes", wrapper.GetAllocatedNodes)
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/allocated-nodes/{allocatedNodeId}", wrapper.GetAllocatedNode)
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/api-versions", wrapper.GetMinorVersions)
+	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/capacity", wrapper.GetCapacity)
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests", wrapper.GetNodeAllocationRequests)
 	m.HandleFunc("POST "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests", wrapper.CreateNodeAllocationRequest)
 	m.HandleFunc("DELETE "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}", wrapper.DeleteNodeAllocationRequest)
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}", wrapper.GetNodeAllocationRequest)
 	m.HandleFunc("PUT "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}", wrapper.UpdateNodeAllocationRequest)
 	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}/allocated-nodes", wrapper.GetAllocatedNodesFromNodeAllocationRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/node-reservations", wrapper.GetNodeReservations)
+	m.HandleFunc("POST "+options.BaseURL+"/hardware-manager/provisioning/v1/node-reservations", wrapper.CreateNodeReservation)
+	m.HandleFunc("DELETE "+options.BaseURL+"/hardware-manager/provisioning/v1/node-reservations/{nodeReservationId}", wrapper.DeleteNodeReservation)
+	m.HandleFunc("GET "+options.BaseURL+"/hardware-manager/provisioning/v1/node-reservations/{nodeReservationId}", wrapper.GetNodeReservation)
 
 	return m
 }
@@ -845,6 +1141,77 @@ func (response GetMinorVersions500ApplicationProblemPlusJSONResponse) VisitGetMi
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetCapacityRequestObject struct {
+	Params GetCapacityParams
+}
+
+type GetCapacityResponseObject interface {
+	VisitGetCapacityResponse(w http.ResponseWriter) error
+}
+
+type GetCapacity200JSONResponse Capacity
+
+func (response GetCapacity200JSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity400ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity401ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity401ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity403ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity404ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity500ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCapacity501ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetCapacity501ApplicationProblemPlusJSONResponse) VisitGetCapacityResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(501)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 type GetNodeAllocationRequestsRequestObject struct {
 }
 
@@ -1199,6 +1566,235 @@ func (response GetAllocatedNodesFromNodeAllocationRequest500ApplicationProblemPl
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetNodeReservationsRequestObject struct {
+}
+
+type GetNodeReservationsResponseObject interface {
+	VisitGetNodeReservationsResponse(w http.ResponseWriter) error
+}
+
+type GetNodeReservations200JSONResponse []NodeReservationResponse
+
+func (response GetNodeReservations200JSONResponse) VisitGetNodeReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservations400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservations400ApplicationProblemPlusJSONResponse) VisitGetNodeReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservations401ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservations401ApplicationProblemPlusJSONResponse) VisitGetNodeReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservations403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservations403ApplicationProblemPlusJSONResponse) VisitGetNodeReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservations500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservations500ApplicationProblemPlusJSONResponse) VisitGetNodeReservationsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNodeReservationRequestObject struct {
+	Body *CreateNodeReservationJSONRequestBody
+}
+
+type CreateNodeReservationResponseObject interface {
+	VisitCreateNodeReservationResponse(w http.ResponseWriter) error
+}
+
+type CreateNodeReservation202JSONResponse string
+
+func (response CreateNodeReservation202JSONResponse) VisitCreateNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNodeReservation400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateNodeReservation400ApplicationProblemPlusJSONResponse) VisitCreateNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNodeReservation401ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateNodeReservation401ApplicationProblemPlusJSONResponse) VisitCreateNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNodeReservation403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateNodeReservation403ApplicationProblemPlusJSONResponse) VisitCreateNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateNodeReservation500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response CreateNodeReservation500ApplicationProblemPlusJSONResponse) VisitCreateNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservationRequestObject struct {
+	NodeReservationId string `json:"nodeReservationId"`
+}
+
+type DeleteNodeReservationResponseObject interface {
+	VisitDeleteNodeReservationResponse(w http.ResponseWriter) error
+}
+
+type DeleteNodeReservation202JSONResponse string
+
+func (response DeleteNodeReservation202JSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(202)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservation400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteNodeReservation400ApplicationProblemPlusJSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservation401ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteNodeReservation401ApplicationProblemPlusJSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservation403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteNodeReservation403ApplicationProblemPlusJSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservation404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteNodeReservation404ApplicationProblemPlusJSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteNodeReservation500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response DeleteNodeReservation500ApplicationProblemPlusJSONResponse) VisitDeleteNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservationRequestObject struct {
+	NodeReservationId string `json:"nodeReservationId"`
+}
+
+type GetNodeReservationResponseObject interface {
+	VisitGetNodeReservationResponse(w http.ResponseWriter) error
+}
+
+type GetNodeReservation200JSONResponse NodeReservationResponse
+
+func (response GetNodeReservation200JSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservation400ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservation400ApplicationProblemPlusJSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservation401ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservation401ApplicationProblemPlusJSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservation403ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservation403ApplicationProblemPlusJSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservation404ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservation404ApplicationProblemPlusJSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetNodeReservation500ApplicationProblemPlusJSONResponse ProblemDetails
+
+func (response GetNodeReservation500ApplicationProblemPlusJSONResponse) VisitGetNodeReservationResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get API versions
@@ -1213,6 +1809,9 @@ type StrictServerInterface interface {
 	// Get minor API versions
 	// (GET /hardware-manager/provisioning/v1/api-versions)
 	GetMinorVersions(ctx context.Context, request GetMinorVersionsRequestObject) (GetMinorVersionsResponseObject, error)
+	// Get the hardware capacity
+	// (GET /hardware-manager/provisioning/v1/capacity)
+	GetCapacity(ctx context.Context, request GetCapacityRequestObject) (GetCapacityResponseObject, error)
 	// Get NodeAllocationRequest resources
 	// (GET /hardware-manager/provisioning/v1/node-allocation-requests)
 	GetNodeAllocationRequests(ctx context.Context, request GetNodeAllocationRequestsRequestObject) (GetNodeAllocationRequestsResponseObject, error)
@@ -1231,6 +1830,18 @@ type StrictServerInterface interface {
 	// Retrieve the AllocatedNode resources associated with a NodeAllocationRequest resource.
 	// (GET /hardware-manager/provisioning/v1/node-allocation-requests/{nodeAllocationRequestId}/allocated-nodes)
 	GetAllocatedNodesFromNodeAllocationRequest(ctx context.Context, request GetAllocatedNodesFromNodeAllocationRequestRequestObject) (GetAllocatedNodesFromNodeAllocationRequestResponseObject, error)
+	// Get NodeReservation resources
+	// (GET /hardware-manager/provisioning/v1/node-reservations)
+	GetNodeReservations(ctx context.Context, request GetNodeReservationsRequestObject) (GetNodeReservationsResponseObject, error)
+	// Create NodeReservation resource
+	// (POST /hardware-manager/provisioning/v1/node-reservations)
+	CreateNodeReservation(ctx context.Context, request CreateNodeReservationRequestObject) (CreateNodeReservationResponseObject, error)
+	// Delete NodeReservation
+	// (DELETE /hardware-manager/provisioning/v1/node-reservations/{nodeReservationId})
+	DeleteNodeReservation(ctx context.Context, request DeleteNodeReservationRequestObject) (DeleteNodeReservationResponseObject, error)
+	// Retrieve the NodeReservation resource.
+	// (GET /hardware-manager/provisioning/v1/node-reservations/{nodeReservationId})
+	GetNodeReservation(ctx context.Context, request GetNodeReservationRequestObject) (GetNodeReservationResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1360,6 +1971,32 @@ func (sh *strictHandler) GetMinorVersions(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetCapacity operation middleware
+func (sh *strictHandler) GetCapacity(w http.ResponseWriter, r *http.Request, params GetCapacityParams) {
+	var request GetCapacityRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCapacity(ctx, request.(GetCapacityRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCapacity")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCapacityResponseObject); ok {
+		if err := validResponse.VisitGetCapacityResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetNodeAllocationRequests operation middleware
 func (sh *strictHandler) GetNodeAllocationRequests(w http.ResponseWriter, r *http.Request) {
 	var request GetNodeAllocationRequestsRequestObject
@@ -1526,75 +2163,212 @@ func (sh *strictHandler) GetAllocatedNodesFromNodeAllocationRequest(w http.Respo
 	}
 }
 
+// GetNodeReservations operation middleware
+func (sh *strictHandler) GetNodeReservations(w http.ResponseWriter, r *http.Request) {
+	var request GetNodeReservationsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeReservations(ctx, request.(GetNodeReservationsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeReservations")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNodeReservationsResponseObject); ok {
+		if err := validResponse.VisitGetNodeReservationsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateNodeReservation operation middleware
+func (sh *strictHandler) CreateNodeReservation(w http.ResponseWriter, r *http.Request) {
+	var request CreateNodeReservationRequestObject
+
+	var body CreateNodeReservationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateNodeReservation(ctx, request.(CreateNodeReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateNodeReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateNodeReservationResponseObject); ok {
+		if err := validResponse.VisitCreateNodeReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteNodeReservation operation middleware
+func (sh *strictHandler) DeleteNodeReservation(w http.ResponseWriter, r *http.Request, nodeReservationId string) {
+	var request DeleteNodeReservationRequestObject
+
+	request.NodeReservationId = nodeReservationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteNodeReservation(ctx, request.(DeleteNodeReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteNodeReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteNodeReservationResponseObject); ok {
+		if err := validResponse.VisitDeleteNodeReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetNodeReservation operation middleware
+func (sh *strictHandler) GetNodeReservation(w http.ResponseWriter, r *http.Request, nodeReservationId string) {
+	var request GetNodeReservationRequestObject
+
+	request.NodeReservationId = nodeReservationId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetNodeReservation(ctx, request.(GetNodeReservationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetNodeReservation")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetNodeReservationResponseObject); ok {
+		if err := validResponse.VisitGetNodeReservationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xcbXMbN5L+K11zVyWrjiKV2LuV1TeJideskmKWRN9dVegP4EyTRDQDTACMZCal/76F",
-	"BuaVGJKykjje5TeRxACNfnn66QZGv0WxzHIpUBgdXfwW6XiNGaM/L6eT/0WluRT2U4I6Vjw39DGaiKVU",
-	"GbOfgC1kYYDBgxsMcglmjXA5nQznIhpEuZI5KsORZn2op8RPLMtTjC6ib4bnw/NoEJlNbj9qo7hYRU9P",
-	"1Tdy8TPGJnoaNKTSh4mVcm2sTH5hvUc+lvPm/JWMPzVE9/I+fRxE3GBGA/9b4TK6iP5rVOtz5JU5amiy",
-	"3hJTim3s50LxqcIl/9TWyWjNVPLIFJ5lTLAVqlGu5AO3s3CxGj18c6C+0lTGzGDyo0zwII0JYOUzIGSC",
-	"oFDLQsUYUtcii/ft/upmbAWJpVjy1UwxoVls15sk2+KMtwcB12QvnqAwfMlRlRZ0MxbKSW/qh5ygbmPR",
-	"RcSF+fubWltcGFyhsjKtlCzyH1kWUIz9tlzIqu6fdih96miHa2Bay5jTV4/crN3yHdsMovXjVMklTwOL",
-	"vfOmhtyNKBe2C/TMxgPa+yD4LwVCy+QNvfXNJAyqJYsxEFDXPngEmkep7qEe2910R96D4mJSzhYKC22Y",
-	"KfaHVnOvd+4RGwcKfym4wiS6+MmqakCO2tpr0/pN44Q9tZLn474Yu6vk7rh2oRQKA24eq1Qm2qYKxVcs",
-	"RcJNGO3G1W+gMFeo7fTWDCkzqA2wB8ZTtrDutNCoHpgp8a+78okmsXB4qOWqpUOWc6thMj4k4t/3D+6P",
-	"/HKFF0NAEDELsx6nHIVxMm3L3B0BCS65QB1ApaVUkPDlEsn2rDBru5fYy7vJUQ/nYrbm1gCqiA2giFmu",
-	"CzIhzafRGC5W1sbOpWnOO1QPPMbLOJaFMAO4YprHA2AigfdWvO5SGcZrJrjOdBDF7dP2sTuMFZp+NGSg",
-	"aQS84sLt1nu1YBnqnMV4ahVgGLdJikYUGpX9dS6scDnT+lEqtwkSuiPpEGa0aVqFa8BPOcYWZIwsZ4aT",
-	"cs4T2vBJOekJ3OOmUuiSY0o+VCnucY0CZpucQFujsXPOI5JiHvWgo7Ti1Y6wKyjeb3mOda9UH/b07Pqu",
-	"8RSJsQf6CrO2m9nCO3r4Y49nz/zM2x5Niml6st25VPzX2lmtMZ3pOkYj3aEoMrt+2zejgdNwNIhIQQ3J",
-	"ai1bjrAl1BXTuJBMJXBDDCizjjaWwiiZpqjg1dXN+DQQb4fgKksShTpEIafgfwOpYC21EQ0mcHUz7vGT",
-	"WCHBFEv1fkLRGOxUaiSwOLaL7lqlY+dyD9uLh4w/Zmm6YPF9II/4XwKqXFfEJC1WXICQFoid0YNIwgLg",
-	"uc+JuyETs6tCJCmG9XiLBKZEQbzEkDFLzZgpEUITUBFziQttZAbjS4itlCS89W8JC+/OhAqxzLJC0M7E",
-	"ai4qRhOXuqGEo9wqa6aBGw2z67vmrKD5SmACiw0wEFKc5cUi5XFnbYInbEreg3IMNBerFIEYoRUEEziJ",
-	"2dmCtDOMlTlpgi1LUzCq0HaaznbngguY/nADLiH2ubDf64fb6wC3vL22wmkUSa2UljvYn0mXldNYx/C/",
-	"WdOnaPAQv27KEfTkinz0U6KaEWmLBw0C5PmPm65JfNqOnDJtiI3QdDMecsXrrTElZbFPg7FfeErgpTLV",
-	"aIujSmYgBZaU0IKAkGaNqkldEmbwzE4VMlmGWrNVQLQb9wNVJ7AuMibOFLKE2GBW/iYS7/CQoGE81b4E",
-	"tDLXkobWVch0SP+39D1BR2vjJ9qrZOesuoc631WUuTXpgJQnlzBTBQ7gLUs1DuCDuBfyMTi/CeY+ynsW",
-	"KSq32ueg9Gsl7iDkK5WKahuFXLmugLbzRbfk+sxMl7IFpiHfXWBqU1yDXZdm26r2egAjY/FlXx69uRzX",
-	"iXT5rGnF3vx52EwdqwlX6Tl9tIQPWcaq0+uWS3GLvxSozWEdp+CjdRcFACDEwaU0lTtch412tTWmBhwy",
-	"p1OPnWqvluMGH9hZ6pXj7DOpTS8q2LlxPzXdKdQhCOqmT8K/YMdIlK2gHb0SmSBQb0HD45rHduNUhTj7",
-	"W+kWzBKPctmDKu6qBxXslXATiJg7bvD3s0c3mip5/PJN7+jroQR8/ODYu0WdS6H39DBfcRGnRWJzWtUk",
-	"oAx/+sLw7MWDfUbbfuhpEN0d1NwKPt5och2muL6G1I7B4EYufCHY1qTrA+zR4p/VyArL8R/bz+pqfdee",
-	"p/VICyGYUgVCMV2WyKQSlk5bc27hdAd1WhM5AmqrHLl0oNjYPlFes0auoFweHlhaUHe249x97t4DxqG8",
-	"XAMz4R+VWDvwr63JCu++Z4YdDNY0uBc66dePuzZWLhbKeaWXJMwwxwbrg4rQDp5z/tDX3Pfqs0t+Pnk7",
-	"aJ4SSWg7oUCbbPPWKsWuah30Tuz8VKoXu7pUekuC1tq1XZUMaf9Wps9Ujua/BvP9r4F5WlM0G98Bbkzy",
-	"tQ9DunYIKNDLE3Lk9/ub6e8P66Zb9I9pFDBjFF8UrkHOTCdn9DW+y7Yw12XTOt20+i4LBAaPbOOacaQb",
-	"YGDkPQpXqjPheuvf+mZQ8LyGRHRt9N19wENa6Y4+Vk0tGkULnPEEmEjmwn/0UznwdG2txYbGu9MAN6zP",
-	"m2KZhw7/3KP0azmzkdRNt0mZWj1OT9R9cpoawmyTc1tbbAaO9+q1LNLEatd13OdiHskcBU/mEVgMTsre",
-	"iAQmNkANELeqt6/dxt3Ne9A5xtRuStNN2dTX8AqHq+EA5uS8eh7ZPx37tn+jiYfD4elc2Nl9PxtBFqrc",
-	"h+5w8J6mQc0GaJs/iCSXXAQKwsvpBND/2ujttt3JyzzKlTQylunIKeQslkJgbEY0ypt/Lh55mpJ35jmK",
-	"xM1IZR7TCL4vZ7Mdfbks0tR+2WPrQqXhRjtN5MGj3fj3nU8v89qYXF+MRve4iVPJ7of+xsIwltlIIUsz",
-	"PZKKidNDiggrTVejg+0Yqlw0BDFTJRcpZt+7Dpbd3Fan32P7ZYUbL8D8S7EBUWQLR97qSRqoNACmPYgl",
-	"4Bq5pee6BqSiFrEAbhWXoTDuECWUMVxjLuBm3aYefspTJrzF/HLOUbgGGTtwiav8kDut2TXrKydj534+",
-	"GG3+IRczPLPVamHCtwe0YSLUwLqED7cTUGW73sVyRf784WYpab+EczExkLENbOg8b1koAgjeIHh8CQlW",
-	"CyUdrlwo/pxm42yN8G42m5Zd2dhmUpff92mySc+D5NxwkwY1pddSmUHXprrIMqY2nZXoJG4IE1NCq5AG",
-	"bJJboW8p1zIa2S/xYC7wU4y5od3lhcqlRqqqLB1OffgPYbJ0Z39cw4o/WNAXiUdps2YC5hER7ItFysS9",
-	"xVxSVBUOoNcsTYGlmlIsXWJKSiMd2KLtuhKLY6moyDcSJj/M3sLt2zG8/sd3f4efXn8MetqW8rgGFLEs",
-	"FFtZSC0TjV3Iy6jnomOQRMZFFa8V6SunJoSEQnOxeje7uT51KbLlmfB/9itSUIYEIlyT/TyDGcwFN9pl",
-	"W3+zqMgqetLRtD91rcK3hObSIxs6tOi8NyY64Fy1tz0G9YBvAzrbRqt/6zC09v2kGq3BEyFHW4MVmE0H",
-	"O64ptWcmIrU16aGZPlRw1mf027BR/rTnMsjs+u6sQr06Z4S4pP6dWGTzjFDA/w//dv6P1rElEclc8Qf7",
-	"4R43rSsYWaENuKYawolJ9fAeN/7ehf1Ep5B07YKAwkrQmNryv/JQdC6oJZ1hYgtLGF++0qeDmrM0H4vX",
-	"jIsGc/TrJ6Uxm2NtnTKoqtS5SJEtOwOUNiQwVWhSGhhf0nGU22hj7ImGscwyKYD0+2r846nN1ncFeQBc",
-	"pgaVzbEPOBduxN3lj6eloCzlK1HXy9pdgzjRtL8N/FKw1OJRAonM7P7oYoGRoIs8l8rAQpo1cLGiMxMy",
-	"iizMSlq7+cqnua1CI8RMl66zJ5ZrV9oO4ydK40vp23SGxcRrXSUf3WIC75jNZ8QdK5R5fHwcKkzWzBC4",
-	"bBOl6YQcntQgVvCufZWAWjwuHdbth6m7ZXA5nUSD7eu2A6obWM6ji+j18Hz42kYMM2sKlj3XZVnOzx4a",
-	"93pXoVtOt2gKVdZZ/sC6uj9s91PdIa6YW11oeXM7a1Qn35Mkuoj+ieYyTatrxVRCUy+bRPn2/LzUPLqK",
-	"guV56sni6Gd/zupaS4ffNNbOrp0OQUGXTJw/yoVFBUzC2y23avfzNIje7BTSZ5z/eZ6wHeYekPeKJWWd",
-	"ZoX42xcRgg4sLMP3hRAqJdWQQswTNGfilodY72YrbWMvQ8MS6vTZR/Ze6x5V94vPbMY7zFvTYAIsOzW6",
-	"3ynr0S/2y8Ouwreuo28n3T6X/Qt54Zvzb76AEB9E1b5InBSvv4AUb6Va8CRB8TWEYzgWGpHZjLzPjM7R",
-	"b6y5zCR5Oihew7IdEqaU8xTL0KDS9FoKt5PbPBiV3feoI1PUJANGFThoWKNLHD7+kempHf3HaP+Kov3N",
-	"+ZsvIMKsbhJhAraiNxt4ZK5oXspCJMP/XCh6LqctWULGhVT9hLZqdmXsZ6l63+vbwqkbO+1fh+Ueieuh",
-	"7rntDy+grzYtnrHqSP2sPGd5lofuvl3Sx2eDT/05vHb3laUjzz3y3D8sevfESn+SGUS5DN1pHSukdyT2",
-	"X5WbLIHVbwO0m51cV73+sm3nnWswF9Q2d80eOt2k1woYTN/fzaoDZn/KWc6KjfcOPtxeuz4bg/J66pRt",
-	"Usn82xzUCKzgoWpuaHilWx0Q5WcRTrunIVxx2gjf5HO8GrW5ksnmd0tvPbcG2z09S+OftpDt22cJccB9",
-	"wIl/g85CdM+hTZi0eeu29M0FN+5SkX8JypknVzJuXBPf43RzscvrLDUs3W7QHFYeotMpRJHXXuEv1x1R",
-	"94i6h6Kug4Q9jvpCct9Ho0a/iXCcPrmItji3Hdvfo8M/B+lQPw6tK49t5HMP9SHf/i5Ej6Av7EZ8TRBH",
-	"5ng+xB3B6Nj8+PdofjgIgT4M6SWmvlA8rML7gmB0/seSvrqA3HWWB6pRZx5x44gbXz9u3KJRHB/woGy5",
-	"q8AtAvXthzw51rcVqjpt/AWA9VhIfybLLMiCxzL6mIGOGeh3y0A3MuHLDf1TFfpHUrvjavinV/s7rgnt",
-	"uefzVsns6+bRf+hNoyOhPsLZvzmh7rmOsPXWL/t86k2rkzwOSXreN64v/NJ/NKT/fFRfM74YjehVlLXU",
-	"5uK78+/cv9z1K4ZevCvPqptvB9XQVZ1kPw0C7ypU0reOzvyjrd09fXz6VwAAAP//s+8bBDdZAAA=",
+	"H4sIAAAAAAAC/+w9XXPbOJJ/BcW7qiR1tOzZmd3a9T453smO6+LEZTt3VzXKA0S2RIxJgAOAdjRT/u9X",
+	"aAAkSIL6SOJkPvQmiSDQ6O9uNFq/JpmoasGBa5Wc/pqorICK4sezq4v/AamY4OZbDiqTrNb4NbngSyEr",
+	"ar4RuhCNJpTc28FELIkugJxdXczmPEmTWooapGaAs953U8IHWtUlJKfJN7OT2UmSJnpdm69KS8ZXyeNj",
+	"+4tY/ASZTh7TACq1G1glU9rA5BZWW+CjNQvnb2H8MQDdwfv4Pk2YhgoH/qeEZXKa/Mdxh89jh8zjAJPd",
+	"lqiUdG2+N5JdSViyD32cHBdU5g9UwlFFOV2BPK6luGdmFsZXx/ff7IivshQZ1ZC/ETnshDFOqH+HcJED",
+	"kaBEIzOIoWtRZdt2//Ly3ACSCb5kq1tJuaKZWe8iH4NzPh5EmEJ6sRy4ZksG0lPQzthIC73uXrKA2o0l",
+	"pwnj+m/fddhiXMMKpIFpJUVTv6FVBDHmV7+QQd2/zVD8NsAOU4QqJTKGPz0wXdjlB7RJk+LhSoolKyOL",
+	"/eBITWo7wi9sFpiYjUWw946znxsgPZIHeJuaiWuQS5pBRKBeO+HhoB+EvCPd2OGmB/DuJBcXfraYWChN",
+	"dbNdtMK93thXjBxI+LlhEvLk9EeDqhQZtbfXkPohceKc2sLzfpuM3bRwD1i7kRK4JnYeg1TK+6SKyVcm",
+	"eM50XNudt8+IhFqCMtMbMpRUg9KE3lNW0oVhp4UCeU+113/DlZ8pBAtmu1KuXTpGObsa5Oe7SPzb6cHT",
+	"ku9X+GQVENWYjS7OSwZcW5jGMA9HkByWjIOKaKWlkCRnyyUg7WmjC7OXzMG7rkHN5vy2YIYAssk0AZ7R",
+	"WjVIQpxPgdaMrxTxLI1z3oC8ZxmcZZlouE7JS6pYlhLKc/LWgDdcqoKsoJypSkW1uHnbvHYDmQQ9rQ0p",
+	"UTiCPGfc7tZxNacVqJpm8MIgQFNmjBSOaBRI83TODXA1VepBSLsJBHoA6YzcFuBXYYrAhxoyo2S08DOT",
+	"Z37OZ7jhZ37SZ+QO1i1ClwxK5KEWcQ8FcHK7rlFpK9BmznmCUMyTCe0oDHgdI2wSircjzjHsVard3r59",
+	"fRO8hWBsUX2NLsxmRvoOX34/wdm3buYxRyNiQk42OxeS/dIxqyGmJd2AaIg74E1l1u/zZpJaDCdpgghK",
+	"3kewbHyEEVAvqYKFoDInl+gBVYbRzgXXUpQlSPL85eX5i4i87aJXaZ5LUDEX8oq4Z0RIUgileeAJvLw8",
+	"n+CTTAKqKVqq7Q5FMNiiVAtCs8wsummVAZ39HsaLx4h/TstyQbO7iB1xTyKoLFrHpGxWjBMujCK2RI9q",
+	"EhpRntuYeCgyGX3Z8LyEOB6vAZUpuiAOYlJR45pR7TWEQkWFnkvWKC0qcn5GMpAOeMPfgiwcO6NWyERV",
+	"NRx3xldz3no0mccNGhxpVymoIkwrcvv6JpyVKLbikJPFmlDCBT+qm0XJssHaqJ4ghHxCy1GiGF+VQNAj",
+	"NIBATp5l9GiB2JllUj8LlS0tS6Jlo8w0g+3OOePk6vtLYg3iFAu7vb67fh3xLa9fG+AU8LxDSo8dzGPE",
+	"Zcs0hjHcM0P6EjTswtchHHFOrmnG9DoiY021sF6Cj1lU4AWhbrBOO7p+szH3erWxaep2ULfILBpdLCXA",
+	"bjAiVxmMcWC6ANmLMSQpoHRcJaF15OJrbggyQgXkA46rcbzR4WbEIHZ1yHfbVBRqu1WmDOsYrjc0j+/E",
+	"T3QlRBlzHC9GXqF/g9RClKnlRfP7zw3INXmgyozQknlBExyi21RMR9B3w3Toin7C/FpoWu7BGBnlZOE1",
+	"RiPDgKsYBI6zuI8bSlcY6VhIHKcG9E0DSYhKYOv+TwclXUyijEUOQhAXgdjpwtCjL4wlVRrjAZzulsWM",
+	"wevRGB80mLeJNj84pzxnXYyAH40nI0VlyOSDMmOGuTASGAYPOdVwZKaKEbMCpegqAtqlfWDgoaRoKsqP",
+	"JNAcNVHln/HcmRySg6asVC4JY2DuII3LIlUx/F/j76jreht/phxKNs6qJoLXmzZo7U2aIvLEktzKBlLy",
+	"ipYKUvKO33HxEJ1fR71P9DzFspt3q4nApy24aYxXWhR1NIqxcpeDGAvkMOnxkb5mSRdQxnh3AaVxMoP4",
+	"1pNtlG+ZMNkVzc6mPNnLs/POlV3uNS3f6sHuNtOAatzmWiw+esDHKGPQ6XDLBL+GnxtQerecb/TVLo9J",
+	"CCGxKFgI3bLD6zjRXo7GdArHfHHoMVNtxXIWeOQbky1+nHmnbJQGGc2d2kchO8VydFHcTEH4G8zZcp+M",
+	"3ZCtbN0YRR4KlpmNM9XS30C3oApy4pfdKefVZoGj2cpdfIZPpMdQmlp43PIhd0xlMSM8vrPsXYOqBVdb",
+	"ThGeM56VTW5sWpumQwv/4hPFc1IfbCPa+KXHNLnZKb0cfT1IM++GuKmU8IbBxI5cuFRMH5M2E7cFi18q",
+	"lRyH40+bUR5ifdOer7qRRoVAiTkAlGmfpEKU0PKqN+dITw+0Tm8i64CaPINYWqUYbB9dXl0Ak8QvT+5p",
+	"2eD5yIC5J9mda3a2XDIeDcmvrcaqLENR3caZjnssRFSG52qCt2ysrKLMmdKMZ9oBZ19FixtzuLSoRSlW",
+	"6/+GKEDOCuDrKVFNVhBqcCRNSkNIUosHkCQXFWXcj3oohAK7OqkapV1SnyxAPwDw/q50AXNuw2hy3W6k",
+	"Ykr5pDjOirvGOLhkK7YonZ9QMf4a+EoXyek3W/3gYKdTenzCWMb8ps5wItoxCbXBPvWx3tqjf1FNdzam",
+	"ONiZdXUrrqES97Ap2m+d2f45LHKyhBKogi40V+yXSHLDHgrkTQb5jPRnnnM3mc/I0FICzdd+6hx/ZCsu",
+	"TOKi7zxMRDtejU2Zb8TARuJ5hMb8Lq+pcqqpy3C170UTtFukNRQOFbK92onvXfaI3HYEwlAJY1tPljlf",
+	"SoBAxjPKjRwoqplars2Qak/HrKeEIhZkn8P3qZNtxzwG0x8fN+00j8cMUnFL8suHjP4dR4HNE1sTIeQn",
+	"Wxkh1QiC3todO0sRw/61KPdEjsoEftyBK24y0b7Ffok66DH90Fs4sOxe297URiecC660pMxVTg3jxIbn",
+	"yliyQjyQhsM98HI9IS6oUxROSmgmhVIoMI0EZ4VUXKDmvFV0n12gbif2ul2v2SAfqd2vqxhydYQdHZ2m",
+	"tOF1l0jeIMdakEKU7pyS1CXlHHKSQ12KdQU8arw2xNXjRPPbo/NSNDkeLIPSoQ9xFdRoXfvHvUwtc5nx",
+	"pZAz8tYxxZxHzazqFJAySqSFklR03RpAL3zWgfULTUiPzbmrs0gmBdOnVLchc2RSazmtJUwJWxKmMfFt",
+	"mK2zxwvc09Bj3phG3RDVvwmieU/aFh7E4m1g6YFmxcDUo9DFkut2si+bAhDLOGY/Q8TfkXYH+fmswXww",
+	"78ZqQT6W4W3oDoc7Pgl+2k1eB/PMNme+94BoouBsDOQI8M0FZfFlopIxGrZj5iBKsi+aMwggaLMFKMrX",
+	"TjbmvF0exVg2QATPwJ50oxChlgxPYtGUGv26h1RvTD14QTUQq2mHuTvu7KS7ZbadoLgOFooa2SiX3HTO",
+	"0AAwyu9QbJcx/6CiOivM4955OGJ/WPJRs+xOEVoJG7pWhMoF05JKVq7Ro59z1p7oKohaV+vbYLpRbQ06",
+	"OnPqA/KsoEox1T53PlJnp6yTQpSWVMNqjekCyRaNr6Eze1RzHvpcqT38M98XUtDccGzf6XKpEcKplOKh",
+	"DXp3DffSxIMTO05zgEpHpdB9VqfkJSj9imlSS1hikiZ83PkESzBgkfOrd0QXBgMK6+HMI2Oi9Zxfn12m",
+	"HjvxyVRBpYfAzRdSy8d+7Zp4OhpLdaTkFZOV4ZxLw1wTy7XhdMPbCsGlew+Bf3nx9qarefQrDM+5baXj",
+	"2b1g+fdSCqk2oYoLIiFD7YRHhOYT4Fv9ujWH9SRNLMaSNOltKUmTYMVIHdvADLT0n1Lxkz52PJzYHE3Q",
+	"yVjCDNspb1bRDzd38BA5SqQfWNVUbSVrBv0EQOtcRWIbxgnla6IfxDCoQe/LMAEqJyHnPFJa088r+ASZ",
+	"gSZMj0WCtM+Y/MOiyJZbh5HZKMM350+V4ktbEsVY6u32yuW3u5UuG6Od4ShCdaBJqR6Y+qkqY1+Dy5Sv",
+	"EC7XvSK3BRBKHuiaYOUj7pZQosUdcKuYKbeFzH9xlXfxoM2AaGuWNxdd7lK3bM1JW0GIo3CBI5YbdTPn",
+	"7qubyokU1hA6u48Qu7emsxd1zJGwr+JTP7MW3sOxmTOLJ2u1EVMzU8zMzDHyOrVHnKoQjXFDwJU3z/k8",
+	"ETVwls8TlMTcl8EIlEqrzS1MXXr+5vItUTVkWNtXlmtfQa3Ic5itZimZY3iv5on5aEMz8xl0NpvNXsy5",
+	"Fm3xMBDRSL8Pta8JxW1+z/NaRNXi2dUFAfc0KKTts5OD2Vyf0iIT5bFFyFEmOIdMH+MoR35T+VmWyJ11",
+	"DTy3M6KTQBUQVwRp4iP8cdmUpflxgtaNLONVzTiRT2P3qqxdmamDudC6VqfHx3ewzkpB72buetgsE9Wx",
+	"BFpW6lhIyl/sEj0aaIYYTccy1LJoTMVcSbEoofqXLVYymxuVVbtc4lmrNz4hx3jG14Fx6SYJtFJq1LdV",
+	"YjmxVbOecxGhQmINIifMIK4CrocxYLc7W4MVYbNh/RZ8MFklRzG3nGUUpojIrHLJ2sxibbE2wxjd3+87",
+	"t+znhNHkO5HFNKsgJ6LR8ataSlMeq1U6I++uL4j0tdFWltu0g7tJ4iGdhnDOLzTmltZ4eWLZSFQQLEgJ",
+	"sCXJoV0oHyR5Gsn2qSszAccPt7dXvgAvEzm4fPI2TIYnsfFsLdNlFFOqEFKnQ5qqpqqoXA9WwmsPM3Kh",
+	"vWo1xtwYuRW46sEORi2mIU7nHD5kUGvcXd3IWijr3BiPp3TiPyMXS1zRGM4VuzdK3/h9SARdUE7mCeZd",
+	"Thcl5XdG5yKiWnEw7nxZEloqNLF4YzT3RNqxGm/ISjTLhEQ/TQty8f3tK3L96px8+4+//438+O37KKeN",
+	"kMcUAZ6JRtIV5PYVM84s5GBUcz4gSC6yppXX9pDBT40akjTG3/rh9vL1C2sie5xJ/tdmx5kiFaAScVGq",
+	"82BSE7m6CMdd42yq1j0ZYNqisBNfr5o9RwY4NNp5q0yMYgVXyeh00ITyDVRnn2jds4GH1r8M2mlr4hyh",
+	"iUp4LnK8f7HhTmh/ZnSkRpPucTY62m4vITLtyXdl5pE0X39Tm677dhnj3mFamK2PX+q9XEnz8p4l6g5R",
+	"bVzr7njPtjJK/9Jqt3iMYborZWPF6x9tubt4+/rmqLUbndWNJnk+kx8eXmnh5P9mfz35R++WDbritWT3",
+	"5ssdrHs3BvGM2iatgTzTpZrdwdpdEzTf8NIM3hJEVVtAb2qmujs85s6MBllBzsyj87Pn6kXaeX3ha1lh",
+	"gsXO93br557K4VibvfDplDkvgS4HA6TSbR5HCqHNbZ6SKm03Gox9psi5qCrBCeL3+fmbF8bfuWmQA8hZ",
+	"qUFyqtm9OV/CETdnb154QGnJVjw4YLK39p4p3N+a/NzQ0vBu7oNhQyK8AtTUtZCaLIQuCOMrLDBGoohG",
+	"r4Shm4sdw201CkhGlWedLdqwY6UxXz+iI7QULj+taYaRgT17T64hJz9Q4xGg993q6YeHh5mEvKAa1fPY",
+	"1by6QIZHNPAV+aGfBlVJ61B0B41X+Mh0kkjScXeIFCMvWrPkNPl2djL71kgM1QUKy5buDrRmR/dBG4pV",
+	"7FLuNehG+kjV3a9q212Y/fgZOt+3C1UduS012otaRosl/wZ9VpZtFwxDGntWhKD85eTEYx5sTEbrunTu",
+	"9vFP7lKCzWrv3hhDWboO0qQN3om0/CgWRitAHt+u36rZz2OafLcRSGez/2s/YAexTwTel7Q9EzZA/PWr",
+	"AIHVvSZGcqEkZjpnKGLOxbUk7nGI4W66Ukb2KtA0x5Ik88rWLiTHbc7uiPsjkq3cWkZdiO463SRTdqM/",
+	"mS9369wSLhlxW6ZY9jfEhd+dfPMVgHjH2wRQbqH49itA8UrIBctz4L8HcYzLQiCZoeR9pHQe/0rDZS7y",
+	"x53kNQ7bLmKKNk/SCjRIhV2UmJnc2MHE18slA5iS0BnQsoE0oMbQcXj/lOapL/0Haf8dSft3J999BRBu",
+	"uzQb5AS4ZnrdFkgtzSHe7M+rivb1ab2XUDEu5LRD26YLK/qTkJNt6EZ66tJM+9vxcg+O667sOeaHT3Bf",
+	"s6CZxEaONCfvlTkz6x1N037XAYzbFdOAB79LCZB2pX7mWWvq0lE7ijlv2vshNvc7ut3f1osOWwQU9N6W",
+	"gfbLJ3wW4c3FOVGl0CpoIjDnLhIsRvXvEgi27jE51Nu2wQGWftL8SBgYzMy5AKvYsCQzRM0/Mdyncz5I",
+	"ybX1m11B8kgs2+4eI89h73YWvvS339YCHRDcUueB9MuUd/U90j17UsyMZh3/rEKM2zy2ragSFdMa8img",
+	"Bx0y9oJ0UJbaQaaYho8FyNWkfh1nreWbjYkE4tc/eGwHj+1367EZeL75Ssga1oe2VsDnhe3RrVfrMQPe",
+	"szpZp+8/xbvkIoej7oLMkZOw/TzNzVeqp/JS0be+TH5q8z39Q77qkK96Mi98i6xMi3Oa1CLWyOVcArbm",
+	"294f4sL43Vm8ayFTbdWDP37z17RsXaZTW1jnhd3sKLl6e3PrR/l6r6DbVruSqdrC8zJKfE+WK7ouBc2D",
+	"q62temgPKRR5rnonGdLNwi12X8T0isVGFBPORwWlX4p8/dkcqPhaj4+PQ5f4caTZ/rIXEDs0wbhwjVuN",
+	"ip4oX4lbJ0fdHr4ZZ9pe53W9Ny15aimyoDfSFqab801c90A7tkvDYb6cEOsxmrrjCtdR4qB1D1p3V61r",
+	"VcIWRn0iN+r4Vx6X00cr0SXELj/+C0roVDrpXie9PhJ9zWdfmtJ8208TJgD9xFOF35OKQ3Lsr+IOyugQ",
+	"Ev8xDjGsCiFTOmTSMXWB4m4R3ldURidP6/R1AeQhlXbQG38ivXENWjJwRynb++tNB7hNJL59V+eH+LbV",
+	"qhYbvwHFegikP9LLbJCChzD6YIEOFuizWaBLkbMltnay/1+0Wa5mXzza31Duu6Ve95UU1e/bj37SiuGD",
+	"Q31QZ39wh3qirHDUb5N+vOu9u9YLWjTtf0Yca5616XT4OlzsS50Lx5q9HU6ED2cTT3oiHJWM/c+C7TSg",
+	"wguyrmNZv1WrK8IcN/kkDdfMlrRhX8Q1dlZ40o6baVtvWXTtMue87RzdNmsN/w8LgQP8s0GmbObel12G",
+	"wxhey1wy/BM4qtY8K6TgolHl+p/4X3T2ArlZrRZSqz6Eti2Tge6hAP8HY86zrEjm7612ffOmzqL7HQyf",
+	"KngOV/kiYXOvWeOTBcxhV8CDej2o1487+o1p2M/tENn4rycVe5zzBu+lrluwV9ydYrRV42rzAXBf3ewW",
+	"JQ4br37NQ98vo1c2HffG27weVM8hvvyjHfT2dcVHHfF+bXVz8lRe1OFA96AfDvmnTW3Pp9wnMxvOH7uC",
+	"FmkEQ25wdK/9zOnxMTZ5K4TSp38/+ftJ8vi+XTHW0tLfYQz77nVaxz9Nxre6wn+f6IXf7tXe7h7fP/7/",
+	"ABC1Ao/+iQAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -10,20 +10,27 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"slices"
 	"strings"
 
 	"github.com/google/uuid"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift-kni/oran-o2ims/api/common"
 	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 )
 
-func GetLoopbackHWPluginNamespace() string {
+func GetSimulatorHWPluginNamespace() string {
+	return ctlrutils.GetHwMgrPluginNS()
+}
+
+func GetRedfishHWPluginNamespace() string {
 	return ctlrutils.GetHwMgrPluginNS()
 }
 
@@ -54,11 +61,119 @@ func GenerateResourceIdentifier(baseName string) (string, error) {
 	return resourceID, nil
 }
 
-// NodeAllocationRequestCRToResponseObject Converts a NodeAllocationRequest CR to NodeAllocationRequestResponse object
-func NodeAllocationRequestCRToResponseObject(nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (NodeAllocationRequestResponse, error) {
-	// Convert NodeGroup slice
+// NodeScoringToCR converts the NodeScoring of a NodeGroupData to its CR representation
+func NodeScoringToCR(scoring *NodeScoring) *hwmgmtv1alpha1.NodeScoring {
+	if scoring == nil {
+		return nil
+	}
+	result := &hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.NodeScoringStrategy(scoring.Strategy)}
+	if scoring.SpreadLabels != nil {
+		result.SpreadLabels = *scoring.SpreadLabels
+	}
+	return result
+}
+
+// NodeScoringCRToResponseObject converts the NodeScoring of a NodeGroupData CR to its API representation
+func NodeScoringCRToResponseObject(scoring *hwmgmtv1alpha1.NodeScoring) *NodeScoring {
+	if scoring == nil {
+		return nil
+	}
+	result := &NodeScoring{Strategy: NodeScoringStrategy(scoring.Strategy)}
+	if len(scoring.SpreadLabels) > 0 {
+		spreadLabels := scoring.SpreadLabels
+		result.SpreadLabels = &spreadLabels
+	}
+	return result
+}
+
+// NodeAntiAffinityToCR converts the anti-affinity rules of a NodeGroupData to their CR representation
+func NodeAntiAffinityToCR(rules *[]NodeAntiAffinity) []hwmgmtv1alpha1.NodeAntiAffinity {
+	if rules == nil {
+		return nil
+	}
+	var result []hwmgmtv1alpha1.NodeAntiAffinity
+	for _, rule := range *rules {
+		result = append(result, hwmgmtv1alpha1.NodeAntiAffinity{TopologyKey: rule.TopologyKey})
+	}
+	return result
+}
+
+// NodeAntiAffinityCRToResponseObject converts the anti-affinity rules of a NodeGroupData CR to their API representation
+func NodeAntiAffinityCRToResponseObject(rules []hwmgmtv1alpha1.NodeAntiAffinity) *[]NodeAntiAffinity {
+	if len(rules) == 0 {
+		return nil
+	}
+	result := make([]NodeAntiAffinity, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, NodeAntiAffinity{TopologyKey: rule.TopologyKey})
+	}
+	return &result
+}
+
+// NodeTopologySpreadConstraintsToCR converts the topology spread constraints of a NodeGroupData to their CR
+// representation
+func NodeTopologySpreadConstraintsToCR(
+	constraints *[]NodeTopologySpreadConstraint) []hwmgmtv1alpha1.NodeTopologySpreadConstraint {
+	if constraints == nil {
+		return nil
+	}
+	var result []hwmgmtv1alpha1.NodeTopologySpreadConstraint
+	for _, constraint := range *constraints {
+		result = append(result, hwmgmtv1alpha1.NodeTopologySpreadConstraint{
+			TopologyKey: constraint.TopologyKey,
+			MaxSkew:     constraint.MaxSkew,
+		})
+	}
+	return result
+}
+
+// NodeTopologySpreadConstraintsCRToResponseObject converts the topology spread constraints of a NodeGroupData CR to
+// their API representation
+func NodeTopologySpreadConstraintsCRToResponseObject(
+	constraints []hwmgmtv1alpha1.NodeTopologySpreadConstraint) *[]NodeTopologySpreadConstraint {
+	if len(constraints) == 0 {
+		return nil
+	}
+	result := make([]NodeTopologySpreadConstraint, 0, len(constraints))
+	for _, constraint := range constraints {
+		result = append(result, NodeTopologySpreadConstraint{
+			TopologyKey: constraint.TopologyKey,
+			MaxSkew:     constraint.MaxSkew,
+		})
+	}
+	return &result
+}
+
+// NodeGroupsToCR converts the node groups of a request to their CR representation
+func NodeGroupsToCR(groups []NodeGroup) []pluginsv1alpha1.NodeGroup {
+	nodeGroups := []pluginsv1alpha1.NodeGroup{}
+	for _, ng := range groups {
+		var nodesToRemove []string
+		if ng.NodesToRemove != nil {
+			nodesToRemove = *ng.NodesToRemove
+		}
+		nodeGroups = append(nodeGroups, pluginsv1alpha1.NodeGroup{
+			Size:          ng.NodeGroupData.Size,
+			NodesToRemove: nodesToRemove,
+			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
+				Name:                      ng.NodeGroupData.Name,
+				Role:                      ng.NodeGroupData.Role,
+				HwProfile:                 ng.NodeGroupData.HwProfile,
+				ResourcePoolId:            ng.NodeGroupData.ResourceGroupId,
+				ResourceSelector:          ng.NodeGroupData.ResourceSelector,
+				Scoring:                   NodeScoringToCR(ng.NodeGroupData.Scoring),
+				AntiAffinity:              NodeAntiAffinityToCR(ng.NodeGroupData.AntiAffinity),
+				TopologySpreadConstraints: NodeTopologySpreadConstraintsToCR(ng.NodeGroupData.TopologySpreadConstraints),
+			},
+		})
+	}
+	return nodeGroups
+}
+
+// NodeGroupsCRToResponseObject converts the node groups of a CR to their API representation
+func NodeGroupsCRToResponseObject(groups []pluginsv1alpha1.NodeGroup) []NodeGroup {
 	nodeGroups := []NodeGroup{}
-	for _, ng := range nodeAllocationRequest.Spec.NodeGroup {
+	for _, ng := range groups {
 		nodeGroup := NodeGroup{
 			NodeGroupData: NodeGroupData{
 				Name:             ng.NodeGroupData.Name,
@@ -66,11 +181,40 @@ func NodeAllocationRequestCRToResponseObject(nodeAllocationRequest *pluginsv1alp
 				HwProfile:        ng.NodeGroupData.HwProfile,
 				ResourceGroupId:  ng.NodeGroupData.ResourcePoolId,
 				ResourceSelector: ng.NodeGroupData.ResourceSelector,
-				Size:             ng.Size,
+				Scoring:          NodeScoringCRToResponseObject(ng.NodeGroupData.Scoring),
+				AntiAffinity:     NodeAntiAffinityCRToResponseObject(ng.NodeGroupData.AntiAffinity),
+				TopologySpreadConstraints: NodeTopologySpreadConstraintsCRToResponseObject(
+					ng.NodeGroupData.TopologySpreadConstraints),
+				Size: ng.Size,
 			},
 		}
+		if len(ng.NodesToRemove) > 0 {
+			nodesToRemove := slices.Clone(ng.NodesToRemove)
+			nodeGroup.NodesToRemove = &nodesToRemove
+		}
 		nodeGroups = append(nodeGroups, nodeGroup)
 	}
+	return nodeGroups
+}
+
+// conditionsCRToResponseObject converts the conditions of a CR to their API representation
+func conditionsCRToResponseObject(crConditions []metav1.Condition) []Condition {
+	conditions := []Condition{}
+	for _, condition := range crConditions {
+		conditions = append(conditions, Condition{
+			Type:               condition.Type,
+			Reason:             condition.Reason,
+			Status:             string(condition.Status),
+			Message:            condition.Message,
+			LastTransitionTime: condition.LastTransitionTime.Time,
+		})
+	}
+	return conditions
+}
+
+// NodeAllocationRequestCRToResponseObject Converts a NodeAllocationRequest CR to NodeAllocationRequestResponse object
+func NodeAllocationRequestCRToResponseObject(nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (NodeAllocationRequestResponse, error) {
+	nodeGroups := NodeGroupsCRToResponseObject(nodeAllocationRequest.Spec.NodeGroup)
 
 	// Create generated.NodeAllocationRequest object
 	nodeAllocationRequestObject := NodeAllocationRequest{
@@ -82,16 +226,7 @@ func NodeAllocationRequestCRToResponseObject(nodeAllocationRequest *pluginsv1alp
 	}
 
 	nodeAllocationRequestStatus := NodeAllocationRequestStatus{}
-	conditions := []Condition{}
-	for _, condition := range nodeAllocationRequest.Status.Conditions {
-		conditions = append(conditions, Condition{
-			Type:               condition.Type,
-			Reason:             condition.Reason,
-			Status:             string(condition.Status),
-			Message:            condition.Message,
-			LastTransitionTime: condition.LastTransitionTime.Time,
-		})
-	}
+	conditions := conditionsCRToResponseObject(nodeAllocationRequest.Status.Conditions)
 	nodeAllocationRequestStatus.Conditions = &conditions
 	nodeAllocationRequestStatus.SelectedGroups = &nodeAllocationRequest.Status.SelectedGroups
 	nodeAllocationRequestStatus.Properties = &Properties{
@@ -172,6 +307,32 @@ func CreateOrUpdateNodeAllocationRequest(
 	return nil
 }
 
+// NodeReservationCRToResponseObject converts a NodeReservation CR to a NodeReservationResponse object
+func NodeReservationCRToResponseObject(nodeReservation *pluginsv1alpha1.NodeReservation) NodeReservationResponse {
+	reservedNodes := []ReservedNode{}
+	for _, node := range nodeReservation.Status.ReservedNodes {
+		reservedNodes = append(reservedNodes, ReservedNode{
+			GroupName:   node.GroupName,
+			HwMgrNodeId: node.HwMgrNodeId,
+		})
+	}
+	conditions := conditionsCRToResponseObject(nodeReservation.Status.Conditions)
+
+	return NodeReservationResponse{
+		NodeReservationId: nodeReservation.Name,
+		NodeReservation: NodeReservation{
+			ClusterId: nodeReservation.Spec.ClusterId,
+			Site:      nodeReservation.Spec.Site,
+			NodeGroup: NodeGroupsCRToResponseObject(nodeReservation.Spec.NodeGroup),
+			ExpiresAt: nodeReservation.Spec.ExpiresAt.Time,
+		},
+		Status: NodeReservationStatus{
+			ReservedNodes: &reservedNodes,
+			Conditions:    &conditions,
+		},
+	}
+}
+
 func AllocatedNodeCRToAllocatedNodeObject(node *pluginsv1alpha1.AllocatedNode) (AllocatedNode, error) {
 	interfaces := []Interface{}
 	for _, ifc := range node.Status.Interfaces {
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,14 +19,13 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
-	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
 	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
-// HardwarePluginServer implements StricerServerInterface.
+// HardwarePluginServer implements StrictServerInterface.
 // This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
 var _ StrictServerInterface = (*HardwarePluginServer)(nil)
 
@@ -39,6 +39,21 @@ type HardwarePluginServer struct {
 	ResourcePrefix   string
 }
 
+// NewHardwarePluginServer creates the base provisioning server of a HardwarePlugin managing the NodeAllocationRequests
+// labeled with its ID in the given namespace
+func NewHardwarePluginServer(config svcutils.CommonServerConfig, hubClient client.Client, noncachedClient client.Reader,
+	logger *slog.Logger, namespace, hardwarePluginID, resourcePrefix string) HardwarePluginServer {
+	return HardwarePluginServer{
+		CommonServerConfig: config,
+		HubClient:          hubClient,
+		NoncachedClient:    noncachedClient,
+		Logger:             logger,
+		Namespace:          namespace,
+		HardwarePluginID:   hardwarePluginID,
+		ResourcePrefix:     resourcePrefix,
+	}
+}
+
 var baseURL = constants.HardwareManagerProvisioningBaseURL
 var currentVerion = "1.0.0"
 
@@ -132,20 +147,7 @@ func (h *HardwarePluginServer) CreateNodeAllocationRequest(
 	request CreateNodeAllocationRequestRequestObject,
 ) (CreateNodeAllocationRequestResponseObject, error) {
 
-	// construct nodeGroups object
-	nodeGroups := []pluginsv1alpha1.NodeGroup{}
-	for _, group := range request.Body.NodeGroup {
-		nodeGroups = append(nodeGroups, pluginsv1alpha1.NodeGroup{
-			Size: group.NodeGroupData.Size,
-			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
-				Name:             group.NodeGroupData.Name,
-				Role:             group.NodeGroupData.Role,
-				HwProfile:        group.NodeGroupData.HwProfile,
-				ResourcePoolId:   group.NodeGroupData.ResourceGroupId,
-				ResourceSelector: group.NodeGroupData.ResourceSelector,
-			},
-		})
-	}
+	nodeGroups := NodeGroupsToCR(request.Body.NodeGroup)
 
 	// Construct NodeAllocationRequest resource
 
@@ -212,20 +214,7 @@ func (h *HardwarePluginServer) UpdateNodeAllocationRequest(
 			}), nil
 	}
 
-	// Construct nodeGroups object
-	nodeGroups := []pluginsv1alpha1.NodeGroup{}
-	for _, ng := range request.Body.NodeGroup {
-		nodeGroups = append(nodeGroups, pluginsv1alpha1.NodeGroup{
-			Size: ng.NodeGroupData.Size,
-			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
-				Name:             ng.NodeGroupData.Name,
-				Role:             ng.NodeGroupData.Role,
-				HwProfile:        ng.NodeGroupData.HwProfile,
-				ResourcePoolId:   ng.NodeGroupData.ResourceGroupId,
-				ResourceSelector: ng.NodeGroupData.ResourceSelector,
-			},
-		})
-	}
+	nodeGroups := NodeGroupsToCR(request.Body.NodeGroup)
 
 	// construct NodeAllocationRequest resource
 	nodeAllocationRequest := &pluginsv1alpha1.NodeAllocationRequest{
@@ -386,3 +375,142 @@ func (h *HardwarePluginServer) GetAllocatedNodesFromNodeAllocationRequest(
 
 	return GetAllocatedNodesFromNodeAllocationRequest200JSONResponse(allocatedNodeObjects), nil
 }
+
+func (h *HardwarePluginServer) GetNodeReservations(
+	ctx context.Context,
+	request GetNodeReservationsRequestObject,
+) (GetNodeReservationsResponseObject, error) {
+
+	// List NodeReservations with the HardwarePlugin label
+	nodeReservationList := &pluginsv1alpha1.NodeReservationList{}
+	listOptions := client.MatchingLabels{
+		hwmgrutils.HardwarePluginLabel: h.HardwarePluginID,
+	}
+	if err := h.HubClient.List(ctx, nodeReservationList, client.InNamespace(h.Namespace), &listOptions); err != nil {
+		return nil, fmt.Errorf("failed to list all NodeReservations: %w", err)
+	}
+
+	nodeReservationResponse := []NodeReservationResponse{}
+	for _, nodeReservation := range nodeReservationList.Items {
+		nodeReservationResponse = append(nodeReservationResponse, NodeReservationCRToResponseObject(&nodeReservation))
+	}
+
+	return GetNodeReservations200JSONResponse(nodeReservationResponse), nil
+}
+
+func (h *HardwarePluginServer) GetNodeReservation(
+	ctx context.Context,
+	request GetNodeReservationRequestObject,
+) (GetNodeReservationResponseObject, error) {
+
+	// Use non-cached client for guaranteed fresh data
+	nodeReservation := &pluginsv1alpha1.NodeReservation{}
+	err := h.NoncachedClient.Get(ctx, types.NamespacedName{Namespace: h.Namespace, Name: request.NodeReservationId},
+		nodeReservation)
+	if errors.IsNotFound(err) {
+		return GetNodeReservation404ApplicationProblemPlusJSONResponse(ProblemDetails{
+			Detail: fmt.Sprintf("could not find NodeReservation '%s', err: %s", request.NodeReservationId, err.Error()),
+			Status: http.StatusNotFound,
+		}), nil
+	} else if err != nil {
+		return nil, fmt.Errorf("failed to get NodeReservation '%s', err: %w", request.NodeReservationId, err)
+	}
+
+	return GetNodeReservation200JSONResponse(NodeReservationCRToResponseObject(nodeReservation)), nil
+}
+
+// CreateNodeReservation creates a NodeReservation object, which holds hardware for a planned deployment until it
+// expires
+func (h *HardwarePluginServer) CreateNodeReservation(
+	ctx context.Context,
+	request CreateNodeReservationRequestObject,
+) (CreateNodeReservationResponseObject, error) {
+
+	if !request.Body.ExpiresAt.After(time.Now()) {
+		return CreateNodeReservation400ApplicationProblemPlusJSONResponse(ProblemDetails{
+			Detail: fmt.Sprintf("expiresAt '%s' must be in the future", request.Body.ExpiresAt.Format(time.RFC3339)),
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+	if len(request.Body.NodeGroup) == 0 {
+		return CreateNodeReservation400ApplicationProblemPlusJSONResponse(ProblemDetails{
+			Detail: "at least one node group is required",
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+	for _, group := range request.Body.NodeGroup {
+		if group.NodeGroupData.Size < 1 {
+			return CreateNodeReservation400ApplicationProblemPlusJSONResponse(ProblemDetails{
+				Detail: fmt.Sprintf("the size of node group '%s' must be at least 1", group.NodeGroupData.Name),
+				Status: http.StatusBadRequest,
+			}), nil
+		}
+	}
+
+	nodeReservationID, err := GenerateResourceIdentifier(h.ResourcePrefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate unique NodeReservation identifier, err: %w", err)
+	}
+
+	nodeReservation := &pluginsv1alpha1.NodeReservation{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodeReservationID,
+			Namespace: h.Namespace,
+			Labels: map[string]string{
+				hwmgrutils.HardwarePluginLabel: h.HardwarePluginID,
+			},
+		},
+		Spec: pluginsv1alpha1.NodeReservationSpec{
+			ClusterId:         request.Body.ClusterId,
+			LocationSpec:      pluginsv1alpha1.LocationSpec{Site: request.Body.Site},
+			HardwarePluginRef: h.HardwarePluginID,
+			NodeGroup:         NodeGroupsToCR(request.Body.NodeGroup),
+			ExpiresAt:         metav1.NewTime(request.Body.ExpiresAt),
+		},
+	}
+
+	if err := ctlrutils.CreateK8sCR(ctx, h.HubClient, nodeReservation, nil, ""); err != nil {
+		return nil, fmt.Errorf("failed to create NodeReservation resource, err: %w", err)
+	}
+
+	return CreateNodeReservation202JSONResponse(nodeReservationID), nil
+}
+
+// DeleteNodeReservation deletes a NodeReservation object, which releases the hardware it holds
+func (h *HardwarePluginServer) DeleteNodeReservation(
+	ctx context.Context,
+	request DeleteNodeReservationRequestObject,
+) (DeleteNodeReservationResponseObject, error) {
+
+	// Check that NodeReservation object exists
+	existingNodeReservation := &pluginsv1alpha1.NodeReservation{}
+	exist, err := ctlrutils.DoesK8SResourceExist(ctx, h.HubClient, request.NodeReservationId, h.Namespace, existingNodeReservation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get NodeReservation '%s', err: %w", request.NodeReservationId, err)
+	}
+	if !exist {
+		return DeleteNodeReservation404ApplicationProblemPlusJSONResponse(
+			ProblemDetails{
+				Detail: fmt.Sprintf("could not find NodeReservation '%s'", request.NodeReservationId),
+				Status: http.StatusNotFound,
+			}), nil
+	}
+
+	if err := h.HubClient.Delete(ctx, existingNodeReservation); err != nil {
+		return nil, fmt.Errorf("failed to delete NodeReservation '%s', err: %w", request.NodeReservationId, err)
+	}
+
+	return DeleteNodeReservation202JSONResponse(request.NodeReservationId), nil
+}
+
+// GetCapacity reports the number of resources available for a node group. Counting the resources is specific to
+// the hardware plugin, which overrides this method when it supports it.
+func (h *HardwarePluginServer) GetCapacity(
+	_ context.Context,
+	request GetCapacityRequestObject,
+) (GetCapacityResponseObject, error) {
+	return GetCapacity501ApplicationProblemPlusJSONResponse(ProblemDetails{
+		Detail: fmt.Sprintf("capacity queries are not supported by the %s hardware plugin", h.HardwarePluginID),
+		Status: http.StatusNotImplemented,
+	}), nil
+}
@@ -9,5 +9,7 @@ package utils
 const HardwarePluginLabel = "clcm.openshift.io/hardware-plugin"
 
 const (
-	Metal3HardwarePluginID = "metal3-hwplugin"
+	Metal3HardwarePluginID    = "metal3-hwplugin"
+	SimulatorHardwarePluginID = "simulator-hwplugin"
+	RedfishHardwarePluginID   = "redfish-hwplugin"
 )
@@ -0,0 +1,379 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	narcallbackclient "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/nar-callback"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+)
+
+const (
+	// maxCallbackRetries defines the maximum number of callback retry attempts
+	maxCallbackRetries = 3
+
+	// maxConcurrentCallbacks limits the number of concurrent callback goroutines
+	// to prevent resource exhaustion
+	maxConcurrentCallbacks = 20
+)
+
+// MapConditionToCallbackStatus maps hardware management condition types and reasons to callback status values
+func MapConditionToCallbackStatus(conditionType hwmgmtv1alpha1.ConditionType, conditionReason hwmgmtv1alpha1.ConditionReason) narcallbackclient.CallbackPayloadStatus {
+	switch conditionType {
+	case hwmgmtv1alpha1.Provisioned:
+		switch conditionReason {
+		case hwmgmtv1alpha1.InProgress:
+			return narcallbackclient.InProgress
+		case hwmgmtv1alpha1.Completed:
+			return narcallbackclient.Completed
+		case hwmgmtv1alpha1.Failed, hwmgmtv1alpha1.PlacementUnsatisfiable:
+			return narcallbackclient.Failed
+		case hwmgmtv1alpha1.TimedOut:
+			return narcallbackclient.TimedOut
+		case hwmgmtv1alpha1.Unprovisioned:
+			return narcallbackclient.Unprovisioned
+		case hwmgmtv1alpha1.NotInitialized:
+			return narcallbackclient.NotInitialized
+		case hwmgmtv1alpha1.InvalidInput:
+			return narcallbackclient.InvalidInput
+		}
+	case hwmgmtv1alpha1.Configured:
+		switch conditionReason {
+		case hwmgmtv1alpha1.InProgress:
+			return narcallbackclient.InProgress
+		case hwmgmtv1alpha1.Completed, hwmgmtv1alpha1.ConfigApplied:
+			return narcallbackclient.ConfigurationApplied
+		case hwmgmtv1alpha1.Failed:
+			return narcallbackclient.Failed
+		case hwmgmtv1alpha1.TimedOut:
+			return narcallbackclient.TimedOut
+		case hwmgmtv1alpha1.ConfigUpdate:
+			return narcallbackclient.ConfigurationUpdateRequested
+		case hwmgmtv1alpha1.InvalidInput:
+			return narcallbackclient.InvalidInput
+		}
+	case hwmgmtv1alpha1.Validation:
+		switch conditionReason {
+		case hwmgmtv1alpha1.InProgress:
+			return narcallbackclient.InProgress
+		case hwmgmtv1alpha1.Completed:
+			return narcallbackclient.Completed
+		case hwmgmtv1alpha1.Failed:
+			return narcallbackclient.Failed
+		case hwmgmtv1alpha1.InvalidInput:
+			return narcallbackclient.InvalidInput
+		}
+	case hwmgmtv1alpha1.Unknown:
+		return narcallbackclient.Pending
+	}
+
+	// Default fallback
+	return narcallbackclient.Pending
+}
+
+// CallbackSender notifies the O-Cloud Manager of NodeAllocationRequest status changes through the callback
+// registered in the NodeAllocationRequest.  Callbacks are delivered asynchronously with retries so that the
+// controllers are never blocked by an unreachable callback server.
+type CallbackSender struct {
+	client client.Client
+	logger *slog.Logger
+
+	// Goroutine management for callback retries
+	callbackCtx       context.Context
+	callbackCancel    context.CancelFunc
+	activeCallbacks   sync.WaitGroup
+	callbackSemaphore chan struct{} // Limits concurrent callback goroutines
+}
+
+// NewCallbackSender creates a callback sender.  The client is used to retrieve the CA bundle and credentials
+// referenced by the callback configuration.
+func NewCallbackSender(c client.Client, logger *slog.Logger) *CallbackSender {
+	return &CallbackSender{
+		client:            c,
+		logger:            logger,
+		callbackSemaphore: make(chan struct{}, maxConcurrentCallbacks),
+	}
+}
+
+// Initialize sets up the long-lived context for callback goroutines
+func (s *CallbackSender) Initialize(ctx context.Context) {
+	s.callbackCtx, s.callbackCancel = context.WithCancel(ctx)
+	s.logger.Info("Callback context initialized",
+		slog.Int("maxConcurrentCallbacks", maxConcurrentCallbacks))
+}
+
+// Shutdown gracefully shuts down all active callback goroutines
+func (s *CallbackSender) Shutdown(timeout time.Duration) {
+	s.logger.Info("Shutting down callback goroutines...")
+
+	// Cancel the callback context to signal all goroutines to stop
+	if s.callbackCancel != nil {
+		s.callbackCancel()
+	}
+
+	// Wait for all active callbacks to complete with timeout
+	done := make(chan struct{})
+	go func() {
+		s.activeCallbacks.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		s.logger.Info("All callback goroutines terminated successfully")
+	case <-time.After(timeout):
+		s.logger.Warn("Timeout waiting for callback goroutines to terminate",
+			slog.Duration("timeout", timeout))
+	}
+}
+
+// Send notifies the callback of a NodeAllocationRequest condition change without blocking the caller
+func (s *CallbackSender) Send(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	conditionType hwmgmtv1alpha1.ConditionType,
+	conditionReason hwmgmtv1alpha1.ConditionReason,
+	conditionStatus metav1.ConditionStatus,
+	message string) {
+
+	callbackStatus := MapConditionToCallbackStatus(conditionType, conditionReason)
+	errorMsg := ""
+	if conditionStatus == metav1.ConditionFalse && (conditionReason == hwmgmtv1alpha1.Failed ||
+		conditionReason == hwmgmtv1alpha1.TimedOut || conditionReason == hwmgmtv1alpha1.InvalidInput ||
+		conditionReason == hwmgmtv1alpha1.PlacementUnsatisfiable) {
+		errorMsg = message
+	}
+
+	// Send callback with async retries to avoid blocking the controller
+	callbackCtx := s.callbackCtx
+	if callbackCtx == nil {
+		// Fallback to background context if not initialized (shouldn't happen in normal operation)
+		s.logger.WarnContext(ctx, "Callback context not initialized, using background context")
+		callbackCtx = context.Background()
+	}
+
+	// Launch callback goroutine with semaphore-based rate limiting
+	s.activeCallbacks.Add(1)
+	go s.sendCallbackWithAsyncRetryRateLimited(callbackCtx, nodeAllocationRequest, callbackStatus, errorMsg)
+}
+
+// calculateBackoffDuration calculates exponential backoff duration for the given attempt
+func calculateBackoffDuration(attempt int) time.Duration {
+	shift := attempt - 1
+	if shift > 31 { // Prevent overflow for very large attempt values
+		shift = 31
+	}
+	if shift < 0 { // Safety check for negative values
+		shift = 0
+	}
+	// Convert to uint safely after bounds checking
+	uintShift := uint(shift) // #nosec G115 -- shift is bounds-checked above
+	return time.Duration(1<<uintShift) * time.Second
+}
+
+// sendCallbackWithAsyncRetry sends a callback notification with retry logic running asynchronously
+func (s *CallbackSender) sendCallbackWithAsyncRetry(ctx context.Context, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, status narcallbackclient.CallbackPayloadStatus, errorMsg string) {
+	if nodeAllocationRequest.Spec.Callback == nil || nodeAllocationRequest.Spec.Callback.CallbackURL == "" {
+		s.logger.DebugContext(ctx, "No callback configuration provided, skipping callback")
+		return
+	}
+
+	callback := nodeAllocationRequest.Spec.Callback
+	callbackURLStr := callback.CallbackURL
+
+	// Parse the callback URL to extract the provisioning request name
+	callbackURL, err := url.Parse(callbackURLStr)
+	if err != nil {
+		s.logger.WarnContext(ctx, "Failed to parse callback URL, skipping callback",
+			slog.String("callbackURL", callbackURLStr),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	// Extract provisioning request name from the URL path pattern: /nar-callback/v1/provisioning-requests/{provisioningRequestName}
+	if !strings.HasPrefix(callbackURL.Path, constants.NarCallbackServicePath) {
+		s.logger.WarnContext(ctx, "Callback URL does not match expected pattern, skipping callback",
+			slog.String("callbackURL", callbackURLStr),
+			slog.String("expectedPath", constants.NarCallbackServicePath+"/{provisioningRequestName}"),
+			slog.String("actualPath", callbackURL.Path))
+		return
+	}
+
+	provisioningRequestName := strings.TrimPrefix(callbackURL.Path, constants.NarCallbackServicePath+"/")
+	if provisioningRequestName == "" {
+		s.logger.WarnContext(ctx, "Could not extract provisioning request name from callback URL, skipping callback",
+			slog.String("callbackURL", callbackURLStr))
+		return
+	}
+
+	// Create base URL for the callback client (without the path)
+	baseURL := fmt.Sprintf("%s://%s", callbackURL.Scheme, callbackURL.Host)
+	if callbackURL.Port() != "" {
+		baseURL = fmt.Sprintf("%s://%s:%s", callbackURL.Scheme, callbackURL.Hostname(), callbackURL.Port())
+	}
+
+	// Create a modified callback config with the base URL instead of the full URL
+	callbackForClient := &pluginsv1alpha1.Callback{
+		CallbackURL:      baseURL,
+		CaBundleName:     callback.CaBundleName,
+		AuthClientConfig: callback.AuthClientConfig,
+	}
+
+	narCallbackClient, err := narcallbackclient.NewNarCallbackClient(ctx, s.client, s.logger, callbackForClient)
+	if err != nil {
+		s.logger.ErrorContext(ctx, "Unable to create NAR callback client",
+			slog.String("baseURL", baseURL),
+			slog.String("error", err.Error()))
+		return
+	}
+
+	// Create callback payload using the generated types
+	payload := narcallbackclient.CallbackPayload{
+		NodeAllocationRequestId: nodeAllocationRequest.Name,
+		Status:                  status,
+		Timestamp:               time.Now().UTC(),
+	}
+	if errorMsg != "" {
+		payload.Error = &errorMsg
+	}
+
+	// Execute retry logic asynchronously (doesn't block the controller)
+	s.executeAsyncRetry(ctx, narCallbackClient, provisioningRequestName, payload, nodeAllocationRequest.Name, status)
+}
+
+// sendCallbackWithAsyncRetryRateLimited wraps the callback with rate limiting
+func (s *CallbackSender) sendCallbackWithAsyncRetryRateLimited(ctx context.Context, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, status narcallbackclient.CallbackPayloadStatus, errorMsg string) {
+	defer s.activeCallbacks.Done()
+
+	// Acquire semaphore to limit concurrent callbacks
+	select {
+	case s.callbackSemaphore <- struct{}{}:
+		// Successfully acquired semaphore slot
+		defer func() { <-s.callbackSemaphore }() // Release when done
+	case <-ctx.Done():
+		// Context cancelled while waiting for semaphore
+		s.logger.WarnContext(ctx, "Context cancelled while waiting for callback semaphore",
+			slog.String("nodeAllocationRequest", nodeAllocationRequest.Name))
+		return
+	}
+
+	// Execute the actual callback logic
+	s.sendCallbackWithAsyncRetry(ctx, nodeAllocationRequest, status, errorMsg)
+}
+
+// executeAsyncRetry runs the callback retry logic with exponential backoff in a goroutine
+func (s *CallbackSender) executeAsyncRetry(
+	ctx context.Context,
+	narCallbackClient *narcallbackclient.NarCallbackClient,
+	provisioningRequestName string,
+	payload narcallbackclient.CallbackPayload,
+	nodeAllocationRequestName string,
+	status narcallbackclient.CallbackPayloadStatus) {
+
+	var lastErr error
+
+	for attempt := 1; attempt <= maxCallbackRetries; attempt++ {
+		// Create a context with timeout for this specific attempt
+		attemptCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+
+		// Try to send the callback
+		resp, err := narCallbackClient.Client.ProvisioningRequestCallback(attemptCtx, provisioningRequestName, payload)
+		cancel()
+
+		if err != nil {
+			lastErr = fmt.Errorf("attempt %d: %w", attempt, err)
+			s.logger.WarnContext(ctx, "Callback attempt failed (async)",
+				slog.Int("attempt", attempt),
+				slog.Int("maxRetries", maxCallbackRetries),
+				slog.String("provisioningRequest", provisioningRequestName),
+				slog.String("nodeAllocationRequest", nodeAllocationRequestName),
+				slog.String("error", err.Error()))
+
+			if attempt < maxCallbackRetries {
+				// Calculate exponential backoff duration
+				backoffDuration := calculateBackoffDuration(attempt)
+				s.logger.InfoContext(ctx, "Retrying callback after backoff (async)",
+					slog.Int("attempt", attempt+1),
+					slog.Duration("backoff", backoffDuration))
+
+				// Wait for backoff duration (safe since we're in a goroutine)
+				select {
+				case <-ctx.Done():
+					s.logger.WarnContext(ctx, "Context cancelled during async callback retry",
+						slog.String("provisioningRequest", provisioningRequestName),
+						slog.String("nodeAllocationRequest", nodeAllocationRequestName))
+					return
+				case <-time.After(backoffDuration):
+					// Continue to next attempt
+				}
+				continue
+			}
+			continue
+		}
+
+		defer resp.Body.Close()
+
+		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+			lastErr = fmt.Errorf("attempt %d: received non-success status code %d", attempt, resp.StatusCode)
+			s.logger.WarnContext(ctx, "Callback returned non-success status (async)",
+				slog.Int("attempt", attempt),
+				slog.Int("maxRetries", maxCallbackRetries),
+				slog.String("provisioningRequest", provisioningRequestName),
+				slog.String("nodeAllocationRequest", nodeAllocationRequestName),
+				slog.Int("statusCode", resp.StatusCode))
+
+			if attempt < maxCallbackRetries {
+				// Calculate exponential backoff duration
+				backoffDuration := calculateBackoffDuration(attempt)
+				s.logger.InfoContext(ctx, "Retrying callback after backoff (async)",
+					slog.Int("attempt", attempt+1),
+					slog.Duration("backoff", backoffDuration))
+
+				// Wait for backoff duration (safe since we're in a goroutine)
+				select {
+				case <-ctx.Done():
+					s.logger.WarnContext(ctx, "Context cancelled during async callback retry",
+						slog.String("provisioningRequest", provisioningRequestName),
+						slog.String("nodeAllocationRequest", nodeAllocationRequestName))
+					return
+				case <-time.After(backoffDuration):
+					// Continue to next attempt
+				}
+				continue
+			}
+			continue
+		}
+
+		// Success
+		s.logger.InfoContext(ctx, "Callback sent successfully (async)",
+			slog.Int("attempt", attempt),
+			slog.String("provisioningRequest", provisioningRequestName),
+			slog.String("nodeAllocationRequest", nodeAllocationRequestName),
+			slog.String("status", string(status)))
+		return
+	}
+
+	// All attempts failed
+	s.logger.ErrorContext(ctx, "Callback failed after all async retry attempts",
+		slog.Int("maxRetries", maxCallbackRetries),
+		slog.String("provisioningRequest", provisioningRequestName),
+		slog.String("nodeAllocationRequest", nodeAllocationRequestName),
+		slog.String("status", string(status)),
+		slog.String("lastError", lastErr.Error()))
+}
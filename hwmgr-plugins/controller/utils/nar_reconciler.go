@@ -0,0 +1,203 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utils
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/logging"
+)
+
+// NodeAllocationRequestHandler implements the plugin specific steps of the reconciliation of a NodeAllocationRequest
+// by ReconcileNodeAllocationRequest. The state returned by Load, e.g. the inventory of the plugin, is loaded once per
+// reconciliation and passed to the other steps.
+type NodeAllocationRequestHandler[T any] interface {
+	Load(ctx context.Context) (T, error)
+	HandleCreate(ctx context.Context, state T, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error)
+	HandleProcessing(ctx context.Context, state T, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error)
+	HandleSpecChanged(ctx context.Context, state T, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error)
+	HandleDeletion(ctx context.Context, state T, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error)
+}
+
+// NodeAllocationRequestReconcilerBase holds the clients and the callback sender shared by the NodeAllocationRequest
+// reconcilers of the HardwarePlugins that manage their own inventory, such as the simulator and Redfish plugins
+type NodeAllocationRequestReconcilerBase struct {
+	client.Client
+	NoncachedClient client.Reader
+	Logger          *slog.Logger
+	PluginNamespace string
+
+	// Now returns the current time, and can be replaced by tests
+	Now func() time.Time
+
+	// callbacks delivers the status changes to the callback of the NodeAllocationRequest
+	callbacks *CallbackSender
+}
+
+// NewNodeAllocationRequestReconcilerBase creates the base of a NodeAllocationRequest reconciler using the clients of
+// the manager
+func NewNodeAllocationRequestReconcilerBase(mgr ctrl.Manager, namespace string, logger *slog.Logger) NodeAllocationRequestReconcilerBase {
+	return NodeAllocationRequestReconcilerBase{
+		Client:          mgr.GetClient(),
+		NoncachedClient: mgr.GetAPIReader(),
+		Logger:          logger,
+		PluginNamespace: namespace,
+		callbacks:       NewCallbackSender(mgr.GetClient(), logger),
+	}
+}
+
+// InitializeCallbackContext sets up the long-lived context for callback goroutines
+func (r *NodeAllocationRequestReconcilerBase) InitializeCallbackContext(ctx context.Context) {
+	if r.callbacks == nil {
+		r.callbacks = NewCallbackSender(r.Client, r.Logger)
+	}
+	r.callbacks.Initialize(ctx)
+}
+
+// ShutdownCallbacks gracefully shuts down all active callback goroutines
+func (r *NodeAllocationRequestReconcilerBase) ShutdownCallbacks(timeout time.Duration) {
+	if r.callbacks != nil {
+		r.callbacks.Shutdown(timeout)
+	}
+}
+
+// UpdateConditionAndSendCallback updates the NodeAllocationRequest condition and sends a callback notification
+func (r *NodeAllocationRequestReconcilerBase) UpdateConditionAndSendCallback(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	conditionType hwmgmtv1alpha1.ConditionType,
+	conditionReason hwmgmtv1alpha1.ConditionReason,
+	conditionStatus metav1.ConditionStatus,
+	message string) error {
+
+	if err := UpdateNodeAllocationRequestStatusCondition(ctx, r.Client, nodeAllocationRequest,
+		conditionType, conditionReason, conditionStatus, message); err != nil {
+		return err
+	}
+
+	if r.callbacks == nil {
+		r.callbacks = NewCallbackSender(r.Client, r.Logger)
+	}
+	r.callbacks.Send(ctx, nodeAllocationRequest, conditionType, conditionReason, conditionStatus, message)
+
+	return nil
+}
+
+// CurrentTime returns the current time, as given by Now if it is set
+func (r *NodeAllocationRequestReconcilerBase) CurrentTime() time.Time {
+	if r.Now != nil {
+		return r.Now()
+	}
+	return time.Now()
+}
+
+// SetupController indexes the AllocatedNodes by NodeAllocationRequest and registers a controller reconciling the
+// NodeAllocationRequests labeled with the given HardwarePlugin ID
+func (r *NodeAllocationRequestReconcilerBase) SetupController(
+	mgr ctrl.Manager, name, hardwarePluginID string, reconciler reconcile.Reconciler) error {
+
+	// The AllocatedNode CRs of a NodeAllocationRequest are queried by the spec.nodeAllocationRequest field
+	if err := mgr.GetFieldIndexer().IndexField(context.Background(), &pluginsv1alpha1.AllocatedNode{},
+		AllocatedNodeSpecNodeAllocationRequestKey, func(obj client.Object) []string {
+			return []string{obj.(*pluginsv1alpha1.AllocatedNode).Spec.NodeAllocationRequest}
+		}); err != nil {
+		return fmt.Errorf("failed to setup node indexer: %w", err)
+	}
+
+	// Filter the NodeAllocationRequests pertaining to the HardwarePlugin
+	pred, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			HardwarePluginLabel: hardwarePluginID,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create label selector predicate: %w", err)
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named(name).
+		For(&pluginsv1alpha1.NodeAllocationRequest{}).
+		WithEventFilter(pred).
+		Complete(reconciler); err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
+
+// ReconcileNodeAllocationRequest fetches a NodeAllocationRequest, manages its finalizer and dispatches it to the step
+// of the handler matching its state
+func ReconcileNodeAllocationRequest[T any](
+	ctx context.Context,
+	r *NodeAllocationRequestReconcilerBase,
+	req ctrl.Request,
+	handler NodeAllocationRequestHandler[T]) (ctrl.Result, error) {
+
+	ctx = ctlrutils.LogReconcileStart(ctx, r.Logger, req, "NodeAllocationRequest")
+	ctx = logging.AppendCtx(ctx, slog.String("NodeAllocationRequest", req.Name))
+
+	nodeAllocationRequest := &pluginsv1alpha1.NodeAllocationRequest{}
+	if err := GetNodeAllocationRequest(ctx, r.NoncachedClient, req.NamespacedName, nodeAllocationRequest); err != nil {
+		if errors.IsNotFound(err) {
+			r.Logger.InfoContext(ctx, "NodeAllocationRequest not found, assuming deleted")
+			return DoNotRequeue(), nil
+		}
+		ctlrutils.LogError(ctx, r.Logger, "Unable to fetch NodeAllocationRequest", err)
+		return RequeueWithShortInterval(), nil
+	}
+
+	ctx = ctlrutils.AddObjectContext(ctx, nodeAllocationRequest)
+	ctx = logging.AppendCtx(ctx, slog.String("ClusterID", nodeAllocationRequest.Spec.ClusterId))
+
+	state, err := handler.Load(ctx)
+	if err != nil {
+		// nolint: wrapcheck
+		return RequeueWithMediumInterval(), err
+	}
+
+	if nodeAllocationRequest.GetDeletionTimestamp() != nil {
+		if !controllerutil.ContainsFinalizer(nodeAllocationRequest, NodeAllocationRequestFinalizer) {
+			return DoNotRequeue(), nil
+		}
+		// nolint: wrapcheck
+		return handler.HandleDeletion(ctx, state, nodeAllocationRequest)
+	}
+
+	if !controllerutil.ContainsFinalizer(nodeAllocationRequest, NodeAllocationRequestFinalizer) {
+		if err := NodeAllocationRequestAddFinalizer(ctx, r.Client, nodeAllocationRequest); err != nil {
+			return RequeueImmediately(), fmt.Errorf("failed to add finalizer to NodeAllocationRequest: %w", err)
+		}
+	}
+
+	switch DetermineAction(ctx, r.Logger, nodeAllocationRequest) {
+	case NodeAllocationRequestFSMCreate:
+		// nolint: wrapcheck
+		return handler.HandleCreate(ctx, state, nodeAllocationRequest)
+	case NodeAllocationRequestFSMProcessing:
+		// nolint: wrapcheck
+		return handler.HandleProcessing(ctx, state, nodeAllocationRequest)
+	case NodeAllocationRequestFSMSpecChanged:
+		// nolint: wrapcheck
+		return handler.HandleSpecChanged(ctx, state, nodeAllocationRequest)
+	}
+
+	return DoNotRequeue(), nil
+}
@@ -0,0 +1,243 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/api/common"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	alarmsapi "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	commonhw "github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+)
+
+const (
+	// DefaultHardwareAlarmReportInterval is the interval at which the full set of hardware faults is reported
+	DefaultHardwareAlarmReportInterval = 30 * time.Second
+
+	hardwareAlertsPath = "/internal/v1/hardware-alerts"
+)
+
+// bmhErrorTypeToAlarmName maps the BareMetalHost error types to the names of the hardware alarm definitions
+var bmhErrorTypeToAlarmName = map[metal3v1alpha1.ErrorType]string{
+	metal3v1alpha1.RegistrationError:            commonhw.AlarmRegistrationError,
+	metal3v1alpha1.ProvisionedRegistrationError: commonhw.AlarmProvisionedRegistrationError,
+	metal3v1alpha1.InspectionError:              commonhw.AlarmInspectionError,
+	metal3v1alpha1.PreparationError:             commonhw.AlarmPreparationError,
+	metal3v1alpha1.ProvisioningError:            commonhw.AlarmProvisioningError,
+	metal3v1alpha1.PowerManagementError:         commonhw.AlarmPowerManagementError,
+	metal3v1alpha1.DetachError:                  commonhw.AlarmDetachError,
+	metal3v1alpha1.ServicingError:               commonhw.AlarmServicingError,
+}
+
+// HardwareAlarmReporter periodically reports the faults of the BareMetalHosts managed by the O-Cloud to the alarms
+// server.  Every report contains the complete set of active faults so that the alarms server can resolve the faults
+// that are no longer reported.
+type HardwareAlarmReporter struct {
+	Client     client.Client
+	Logger     *slog.Logger
+	Interval   time.Duration
+	URL        string
+	HTTPClient *http.Client
+	// startsAt tracks when each fault was first seen.  It is only kept in memory; the alarms server matches the faults
+	// that are still active on their fingerprint so that a restart of the plugin does not raise them again.
+	startsAt map[string]time.Time
+}
+
+// NewHardwareAlarmReporter creates a reporter that posts the hardware faults to the alarms server deployed in the
+// given namespace.
+func NewHardwareAlarmReporter(c client.Client, logger *slog.Logger, namespace string) *HardwareAlarmReporter {
+	return &HardwareAlarmReporter{
+		Client:   c,
+		Logger:   logger,
+		Interval: DefaultHardwareAlarmReportInterval,
+		URL: fmt.Sprintf("https://%s.%s.%s:%d%s/%s",
+			ctlrutils.InventoryAlarmServerName, namespace, constants.ClusterLocalDomain, constants.DefaultServicePort,
+			hardwareAlertsPath, hwmgrutils.Metal3HardwarePluginID),
+	}
+}
+
+// NeedLeaderElection ensures that only the leader reports the hardware faults
+func (r *HardwareAlarmReporter) NeedLeaderElection() bool {
+	return true
+}
+
+// Start runs the reporter until the context is cancelled.  It implements the manager.Runnable interface.
+func (r *HardwareAlarmReporter) Start(ctx context.Context) error {
+	if r.HTTPClient == nil {
+		httpClient, err := notifier.NewClientFactory(nil, constants.DefaultBackendTokenFile).NewClient(ctx, common.ServiceAccount)
+		if err != nil {
+			return fmt.Errorf("failed to create hardware alarm reporter client: %w", err)
+		}
+		r.HTTPClient = httpClient
+	}
+
+	ticker := time.NewTicker(r.Interval)
+	defer ticker.Stop()
+
+	r.Logger.InfoContext(ctx, "Starting hardware alarm reporter", slog.String("url", r.URL))
+	for {
+		if err := r.report(ctx); err != nil {
+			// The next report carries the full set of faults so there is no need to retry here
+			r.Logger.WarnContext(ctx, "Failed to report hardware alarms", slog.String("error", err.Error()))
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// report collects the current faults and posts them to the alarms server
+func (r *HardwareAlarmReporter) report(ctx context.Context) error {
+	alerts, err := r.collect(ctx)
+	if err != nil {
+		return err
+	}
+
+	complete := true
+	body, err := json.Marshal(alarmsapi.HardwareAlert{
+		Alerts:   alerts,
+		Complete: &complete,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal hardware alerts: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create hardware alerts request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post hardware alerts: %w", err)
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status code posting hardware alerts: %d", resp.StatusCode)
+	}
+
+	r.Logger.DebugContext(ctx, "Reported hardware alarms", slog.Int("alerts", len(alerts)))
+	return nil
+}
+
+// collect builds the list of active faults of the BareMetalHosts managed by the O-Cloud
+func (r *HardwareAlarmReporter) collect(ctx context.Context) ([]alarmsapi.HardwareAlertEvent, error) {
+	var bmhList metal3v1alpha1.BareMetalHostList
+	if err := r.Client.List(ctx, &bmhList); err != nil {
+		return nil, fmt.Errorf("failed to list BareMetalHosts: %w", err)
+	}
+
+	var hwdataList metal3v1alpha1.HardwareDataList
+	if err := r.Client.List(ctx, &hwdataList); err != nil {
+		return nil, fmt.Errorf("failed to list HardwareData: %w", err)
+	}
+
+	bmhToHardwareData := make(map[string]metal3v1alpha1.HardwareData)
+	for _, hwdata := range hwdataList.Items {
+		bmhToHardwareData[hwdata.Namespace+"/"+hwdata.Name] = hwdata
+	}
+
+	if r.startsAt == nil {
+		r.startsAt = make(map[string]time.Time)
+	}
+
+	now := time.Now().UTC()
+	active := make(map[string]time.Time)
+	alerts := []alarmsapi.HardwareAlertEvent{}
+	for i := range bmhList.Items {
+		bmh := &bmhList.Items[i]
+		if bmh.Labels == nil || bmh.Labels[LabelResourcePoolID] == "" || bmh.Labels[LabelSiteID] == "" {
+			// Ignore BMH CRs that are not managed by the O-Cloud
+			continue
+		}
+
+		hwdata := bmhToHardwareData[bmh.Namespace+"/"+bmh.Name]
+		alert := getHardwareAlert(bmh, &hwdata)
+		if alert == nil {
+			continue
+		}
+
+		startsAt, found := r.startsAt[alert.Fingerprint]
+		if !found {
+			startsAt = now
+		}
+		active[alert.Fingerprint] = startsAt
+		alert.StartsAt = startsAt
+		alerts = append(alerts, *alert)
+	}
+
+	// Forget the faults that are no longer active
+	r.startsAt = active
+
+	return alerts, nil
+}
+
+// getHardwareAlert derives the active fault of a BareMetalHost, if any.  The StartsAt value is left for the caller to
+// set.
+func getHardwareAlert(bmh *metal3v1alpha1.BareMetalHost, hwdata *metal3v1alpha1.HardwareData) *alarmsapi.HardwareAlertEvent {
+	alarmName, found := bmhErrorTypeToAlarmName[bmh.Status.ErrorType]
+	if !found {
+		if bmh.Status.OperationalStatus != metal3v1alpha1.OperationalStatusError {
+			return nil
+		}
+		alarmName = commonhw.AlarmOperationalStatusError
+	}
+
+	severity := getHardwareAlarmSeverity(alarmName)
+	resourceID := getResourceInfoResourceId(bmh)
+	extensions := map[string]string{
+		"errorMessage":      bmh.Status.ErrorMessage,
+		"provisioningState": string(bmh.Status.Provisioning.State),
+	}
+
+	alert := &alarmsapi.HardwareAlertEvent{
+		AlarmName:   alarmName,
+		Extensions:  &extensions,
+		Fingerprint: fmt.Sprintf("%s/%s/%s", hwmgrutils.Metal3HardwarePluginID, resourceID, alarmName),
+		ResourceId:  resourceID,
+		Severity:    severity,
+		Status:      alarmsapi.Firing,
+	}
+
+	// The resource type can only be derived once the host has been inspected
+	if hwdata.Spec.HardwareDetails != nil {
+		vendor := getResourceInfoVendor(hwdata)
+		model := getResourceInfoModel(hwdata)
+		alert.Vendor = &vendor
+		alert.Model = &model
+	}
+
+	return alert
+}
+
+// getHardwareAlarmSeverity returns the severity of a hardware alarm definition
+func getHardwareAlarmSeverity(alarmName string) string {
+	for _, definition := range commonhw.AlarmDefinitions {
+		if definition.Name == alarmName {
+			return definition.Severity
+		}
+	}
+	return "major"
+}
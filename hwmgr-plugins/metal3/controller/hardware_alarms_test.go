@@ -0,0 +1,156 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	alarmsapi "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	commonhw "github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+)
+
+var _ = Describe("HardwareAlarmReporter", func() {
+	var (
+		ctx    context.Context
+		scheme *runtime.Scheme
+	)
+
+	newBMH := func(name string, errorType metal3v1alpha1.ErrorType, operationalStatus metal3v1alpha1.OperationalStatus) *metal3v1alpha1.BareMetalHost {
+		return &metal3v1alpha1.BareMetalHost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-ns",
+				Labels: map[string]string{
+					LabelResourcePoolID: "pool-1",
+					LabelSiteID:         "site-1",
+				},
+			},
+			Status: metal3v1alpha1.BareMetalHostStatus{
+				ErrorType:         errorType,
+				ErrorMessage:      "something failed",
+				OperationalStatus: operationalStatus,
+			},
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme = runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	Describe("getHardwareAlert", func() {
+		It("should map the BMH error type to an alarm", func() {
+			bmh := newBMH("host-1", metal3v1alpha1.PowerManagementError, metal3v1alpha1.OperationalStatusError)
+			hwdata := &metal3v1alpha1.HardwareData{
+				Spec: metal3v1alpha1.HardwareDataSpec{
+					HardwareDetails: &metal3v1alpha1.HardwareDetails{
+						SystemVendor: metal3v1alpha1.HardwareSystemVendor{
+							Manufacturer: "Dell Inc.",
+							ProductName:  "PowerEdge R640",
+						},
+					},
+				},
+			}
+
+			alert := getHardwareAlert(bmh, hwdata)
+			Expect(alert).NotTo(BeNil())
+			Expect(alert.AlarmName).To(Equal(commonhw.AlarmPowerManagementError))
+			Expect(alert.Severity).To(Equal("critical"))
+			Expect(alert.ResourceId).To(Equal("test-ns/host-1"))
+			Expect(alert.Status).To(Equal(alarmsapi.Firing))
+			Expect(*alert.Vendor).To(Equal("Dell Inc."))
+			Expect(alert.Model).NotTo(BeNil())
+			Expect(*alert.Extensions).To(HaveKeyWithValue("errorMessage", "something failed"))
+		})
+
+		It("should fall back to the operational status", func() {
+			bmh := newBMH("host-1", "", metal3v1alpha1.OperationalStatusError)
+			alert := getHardwareAlert(bmh, &metal3v1alpha1.HardwareData{})
+			Expect(alert).NotTo(BeNil())
+			Expect(alert.AlarmName).To(Equal(commonhw.AlarmOperationalStatusError))
+			Expect(alert.Vendor).To(BeNil())
+			Expect(alert.Model).To(BeNil())
+		})
+
+		It("should return nil for a healthy host", func() {
+			bmh := newBMH("host-1", "", metal3v1alpha1.OperationalStatusOK)
+			Expect(getHardwareAlert(bmh, &metal3v1alpha1.HardwareData{})).To(BeNil())
+		})
+	})
+
+	Describe("report", func() {
+		It("should post the complete set of faults and keep their start time", func() {
+			var received []alarmsapi.HardwareAlert
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.URL.Path).To(Equal("/internal/v1/hardware-alerts/metal3-hwplugin"))
+				var payload alarmsapi.HardwareAlert
+				Expect(json.NewDecoder(r.Body).Decode(&payload)).To(Succeed())
+				received = append(received, payload)
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			unmanaged := newBMH("host-3", metal3v1alpha1.InspectionError, metal3v1alpha1.OperationalStatusError)
+			unmanaged.Labels = nil
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					newBMH("host-1", metal3v1alpha1.InspectionError, metal3v1alpha1.OperationalStatusError),
+					newBMH("host-2", "", metal3v1alpha1.OperationalStatusOK),
+					unmanaged,
+				).
+				Build()
+
+			reporter := &HardwareAlarmReporter{
+				Client:     c,
+				Logger:     slog.Default(),
+				URL:        server.URL + "/internal/v1/hardware-alerts/metal3-hwplugin",
+				HTTPClient: server.Client(),
+			}
+
+			Expect(reporter.report(ctx)).To(Succeed())
+			Expect(reporter.report(ctx)).To(Succeed())
+
+			Expect(received).To(HaveLen(2))
+			for _, payload := range received {
+				Expect(*payload.Complete).To(BeTrue())
+				Expect(payload.Alerts).To(HaveLen(1))
+				Expect(payload.Alerts[0].ResourceId).To(Equal("test-ns/host-1"))
+			}
+			Expect(received[1].Alerts[0].StartsAt).To(Equal(received[0].Alerts[0].StartsAt))
+		})
+
+		It("should return an error if the alarms server rejects the payload", func() {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			}))
+			defer server.Close()
+
+			reporter := &HardwareAlarmReporter{
+				Client:     fake.NewClientBuilder().WithScheme(scheme).Build(),
+				Logger:     slog.Default(),
+				URL:        server.URL,
+				HTTPClient: server.Client(),
+			}
+
+			Expect(reporter.report(ctx)).NotTo(Succeed())
+		})
+	})
+})
@@ -205,7 +205,8 @@ func createNode(ctx context.Context,
 	logger *slog.Logger,
 	pluginNamespace string,
 	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
-	nodename, nodeId, nodeNs, groupname, hwprofile string) error {
+	nodename, nodeId, nodeNs, groupname, hwprofile string,
+	rationale *ScoringRationale) error {
 	logger.InfoContext(ctx, "Ensuring AllocatedNode exists",
 		slog.String("nodegroup name", groupname),
 		slog.String("nodename", nodename),
@@ -253,6 +254,14 @@ func createNode(ctx context.Context,
 		},
 	}
 
+	if rationale != nil {
+		value, err := marshalScoringRationale(*rationale)
+		if err != nil {
+			return err
+		}
+		node.Annotations = map[string]string{AllocationScoringAnnotation: value}
+	}
+
 	if err := c.Create(ctx, node); err != nil {
 		return fmt.Errorf("failed to create AllocatedNode: %w", err)
 	}
@@ -668,6 +677,7 @@ func allocateBMHToNodeAllocationRequest(
 	logger *slog.Logger,
 	pluginNamespace string,
 	bmh *metal3v1alpha1.BareMetalHost,
+	rationale ScoringRationale,
 	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
 	group pluginsv1alpha1.NodeGroup,
 ) (ctrl.Result, error) {
@@ -689,7 +699,7 @@ func allocateBMHToNodeAllocationRequest(
 	nodeNs := bmh.Namespace
 
 	// Ensure node is created
-	if err := createNode(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeName, nodeId, nodeNs, group.NodeGroupData.Name, group.NodeGroupData.HwProfile); err != nil {
+	if err := createNode(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeName, nodeId, nodeNs, group.NodeGroupData.Name, group.NodeGroupData.HwProfile, &rationale); err != nil {
 		return ctrl.Result{}, fmt.Errorf("failed to create allocated node (%s): %w", nodeName, err)
 	}
 
@@ -825,32 +835,36 @@ func processNodeAllocationRequestAllocation(
 			return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("unable to fetch unallocated BMHs for site=%s, nodegroup=%s: %w",
 				nodeAllocationRequest.Spec.Site, nodeGroup.NodeGroupData.Name, err)
 		}
+		// Leave out the hosts held for other clusters, and prefer the ones held for this node group
+		unallocatedBMHs, held, err := applyReservations(ctx, noncachedClient, logger, pluginNamespace,
+			nodeAllocationRequest, nodeGroup.NodeGroupData.Name, pending, unallocatedBMHs)
+		if err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("unable to apply reservations for site=%s, nodegroup=%s: %w",
+				nodeAllocationRequest.Spec.Site, nodeGroup.NodeGroupData.Name, err)
+		}
 		if len(unallocatedBMHs.Items) == 0 {
 			// No capacity available; surface error to upper layer
 			return ctrl.Result{}, fmt.Errorf("no available nodes for site=%s, nodegroup=%s",
 				nodeAllocationRequest.Spec.Site, nodeGroup.NodeGroupData.Name)
 		}
 
-		// Allocate up to 'pending' nodes concurrently
-		need := pending
-		for i := range unallocatedBMHs.Items {
-			mu.Lock()
-			if need <= 0 {
-				mu.Unlock()
-				break
-			}
-			need--
-			mu.Unlock()
-
-			bmh := &unallocatedBMHs.Items[i] // address of slice element (avoid range-var bug)
+		// Rank the candidates with the scoring strategy of the node group
+		selected, err := ResourceSelectionScoring(ctx, noncachedClient, logger, pluginNamespace,
+			nodeAllocationRequest, nodeGroup, unallocatedBMHs, held, pending)
+		if err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("unable to score BMHs for site=%s, nodegroup=%s: %w",
+				nodeAllocationRequest.Spec.Site, nodeGroup.NodeGroupData.Name, err)
+		}
 
+		// Allocate up to 'pending' nodes concurrently
+		for _, candidate := range selected {
 			wg.Add(1)
-			go func(bmh *metal3v1alpha1.BareMetalHost) {
+			go func(candidate ScoredBMH) {
 				defer wg.Done()
 
 				res, err := allocateBMHToNodeAllocationRequest(
 					ctx, c, noncachedClient, logger, pluginNamespace,
-					bmh, nodeAllocationRequest, nodeGroup,
+					candidate.BMH, candidate.Rationale, nodeAllocationRequest, nodeGroup,
 				)
 
 				mu.Lock()
@@ -874,7 +888,7 @@ func processNodeAllocationRequestAllocation(
 						minBackoff = b
 					}
 				}
-			}(bmh)
+			}(candidate)
 		}
 	}
 
@@ -504,7 +504,7 @@ var _ = Describe("Helpers", func() {
 
 		It("should create a new AllocatedNode successfully", func() {
 			err := createNode(ctx, fakeClient, logger, pluginNamespace, nodeAllocationRequest,
-				"test-node", "test-node-id", "test-node-ns", "test-group", "test-profile")
+				"test-node", "test-node-id", "test-node-ns", "test-group", "test-profile", nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify node was created
@@ -519,6 +519,19 @@ var _ = Describe("Helpers", func() {
 			Expect(createdNode.Spec.HwMgrNodeId).To(Equal("test-node-id"))
 			Expect(createdNode.Spec.HwMgrNodeNs).To(Equal("test-node-ns"))
 			Expect(createdNode.Labels[hwmgrutils.HardwarePluginLabel]).To(Equal(hwmgrutils.Metal3HardwarePluginID))
+			Expect(createdNode.Annotations).NotTo(HaveKey(AllocationScoringAnnotation))
+		})
+
+		It("should record the scoring rationale on the new AllocatedNode", func() {
+			err := createNode(ctx, fakeClient, logger, pluginNamespace, nodeAllocationRequest,
+				"test-node", "test-node-id", "test-node-ns", "test-group", "test-profile",
+				&ScoringRationale{Strategy: "BestFit", Score: 100, Rank: 1, Candidates: 2, Reason: "smallest host"})
+			Expect(err).NotTo(HaveOccurred())
+
+			createdNode := &pluginsv1alpha1.AllocatedNode{}
+			Expect(fakeClient.Get(ctx, types.NamespacedName{Name: "test-node", Namespace: pluginNamespace}, createdNode)).To(Succeed())
+			Expect(createdNode.Annotations[AllocationScoringAnnotation]).To(MatchJSON(
+				`{"strategy":"BestFit","score":100,"rank":1,"candidates":2,"reason":"smallest host"}`))
 		})
 
 		It("should skip creation if AllocatedNode already exists", func() {
@@ -532,7 +545,7 @@ var _ = Describe("Helpers", func() {
 			Expect(fakeClient.Create(ctx, existingNode)).To(Succeed())
 
 			err := createNode(ctx, fakeClient, logger, pluginNamespace, nodeAllocationRequest,
-				"existing-node", "test-node-id", "test-node-ns", "test-group", "test-profile")
+				"existing-node", "test-node-id", "test-node-ns", "test-group", "test-profile", nil)
 			Expect(err).NotTo(HaveOccurred())
 
 			// Verify original node wasn't modified
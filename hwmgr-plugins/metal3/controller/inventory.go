@@ -10,9 +10,13 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"net/http"
 	"regexp"
 	"slices"
+	"strings"
 
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
@@ -37,6 +41,13 @@ const (
 	AnnotationsResourceInfoGroups       = AnnotationPrefixResourceInfo + "groups"
 )
 
+const (
+	// BareMetalHostResourcePoolIDKey indexes the BareMetalHost CRs by the value of their resource pool label
+	BareMetalHostResourcePoolIDKey = "metadata.labels.resourcePoolId"
+	// AllocatedNodeSpecHwMgrNodeKey indexes the AllocatedNode CRs by the namespace/name of their BareMetalHost
+	AllocatedNodeSpecHwMgrNodeKey = "spec.hwMgrNode"
+)
+
 // The following regex pattern is used to find interface labels
 var REPatternInterfaceLabel = regexp.MustCompile(`^` + LabelPrefixInterfaces + `(.*)`)
 
@@ -363,3 +374,147 @@ func GetResources(ctx context.Context,
 
 	return inventory.GetResources200JSONResponse(resp), nil
 }
+
+// SetupInventoryIndexers registers the field indexers used to look up the resources of a single pool, and the
+// AllocatedNode of a single BareMetalHost, without listing the whole inventory
+func SetupInventoryIndexers(ctx context.Context, indexer client.FieldIndexer) error {
+	if err := indexer.IndexField(ctx, &metal3v1alpha1.BareMetalHost{}, BareMetalHostResourcePoolIDKey, bmhIndexFunc); err != nil {
+		return fmt.Errorf("failed to setup BareMetalHost indexer: %w", err)
+	}
+
+	if err := indexer.IndexField(ctx, &pluginsv1alpha1.AllocatedNode{}, AllocatedNodeSpecHwMgrNodeKey, allocatedNodeIndexFunc); err != nil {
+		return fmt.Errorf("failed to setup AllocatedNode indexer: %w", err)
+	}
+	return nil
+}
+
+func bmhIndexFunc(obj client.Object) []string {
+	if poolID := obj.GetLabels()[LabelResourcePoolID]; poolID != "" {
+		return []string{poolID}
+	}
+	return nil
+}
+
+func allocatedNodeIndexFunc(obj client.Object) []string {
+	node := obj.(*pluginsv1alpha1.AllocatedNode)
+	if node.Spec.HwMgrNodeId == "" || node.Spec.HwMgrNodeNs == "" {
+		return nil
+	}
+	return []string{node.Spec.HwMgrNodeNs + "/" + node.Spec.HwMgrNodeId}
+}
+
+// getResourceForBMH builds the resource of a BareMetalHost from its HardwareData and AllocatedNode
+func getResourceForBMH(ctx context.Context, c client.Client, bmh *metal3v1alpha1.BareMetalHost) (inventory.ResourceInfo, error) {
+	key := client.ObjectKeyFromObject(bmh)
+
+	hwdata := &metal3v1alpha1.HardwareData{}
+	if err := c.Get(ctx, key, hwdata); err != nil && !errors.IsNotFound(err) {
+		return inventory.ResourceInfo{}, fmt.Errorf("failed to get HardwareData %s: %w", key, err)
+	}
+
+	var nodeList pluginsv1alpha1.AllocatedNodeList
+	if err := c.List(ctx, &nodeList, client.MatchingFields{AllocatedNodeSpecHwMgrNodeKey: key.String()}); err != nil {
+		return inventory.ResourceInfo{}, fmt.Errorf("failed to list AllocatedNodes for BareMetalHost %s: %w", key, err)
+	}
+
+	var node *pluginsv1alpha1.AllocatedNode
+	if len(nodeList.Items) > 0 {
+		node = &nodeList.Items[0]
+	}
+
+	return getResourceInfo(bmh, node, hwdata), nil
+}
+
+// listPoolBMHs returns the BareMetalHosts of a resource pool that are part of the inventory
+func listPoolBMHs(ctx context.Context, c client.Client, poolID string) ([]metal3v1alpha1.BareMetalHost, error) {
+	var bmhList metal3v1alpha1.BareMetalHostList
+	if err := c.List(ctx, &bmhList, client.MatchingFields{BareMetalHostResourcePoolIDKey: poolID}); err != nil {
+		return nil, fmt.Errorf("failed to list BareMetalHosts of resource pool '%s': %w", poolID, err)
+	}
+
+	var bmhs []metal3v1alpha1.BareMetalHost
+	for _, bmh := range bmhList.Items {
+		if includeInInventory(&bmh) {
+			bmhs = append(bmhs, bmh)
+		}
+	}
+	return bmhs, nil
+}
+
+func GetResourcePool(ctx context.Context, c client.Client, poolID string) (inventory.GetResourcePoolResponseObject, error) {
+	bmhs, err := listPoolBMHs(ctx, c, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bmhs) == 0 {
+		return inventory.GetResourcePool404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	siteID := bmhs[0].Labels[LabelSiteID]
+	return inventory.GetResourcePool200JSONResponse(inventory.ResourcePoolInfo{
+		ResourcePoolId: poolID,
+		Description:    poolID,
+		Name:           poolID,
+		SiteId:         &siteID,
+	}), nil
+}
+
+func GetResourcePoolResources(ctx context.Context, c client.Client, poolID string) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	bmhs, err := listPoolBMHs(ctx, c, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bmhs) == 0 {
+		return inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	resp := make([]inventory.ResourceInfo, 0, len(bmhs))
+	for _, bmh := range bmhs {
+		resource, err := getResourceForBMH(ctx, c, &bmh)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, resource)
+	}
+
+	return inventory.GetResourcePoolResources200JSONResponse(resp), nil
+}
+
+func GetResource(ctx context.Context, c client.Client, resourceID string) (inventory.GetResourceResponseObject, error) {
+	notFound := inventory.GetResource404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+		Detail: fmt.Sprintf("could not find resource '%s'", resourceID),
+		Status: http.StatusNotFound,
+	})
+
+	// Resource identifiers are the namespace/name of the BareMetalHost
+	namespace, name, found := strings.Cut(resourceID, "/")
+	if !found || namespace == "" || name == "" {
+		return notFound, nil
+	}
+
+	bmh := &metal3v1alpha1.BareMetalHost{}
+	if err := c.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, bmh); err != nil {
+		if errors.IsNotFound(err) {
+			return notFound, nil
+		}
+		return nil, fmt.Errorf("failed to get BareMetalHost %s: %w", resourceID, err)
+	}
+
+	if !includeInInventory(bmh) {
+		return notFound, nil
+	}
+
+	resource, err := getResourceForBMH(ctx, c, bmh)
+	if err != nil {
+		return nil, err
+	}
+	return inventory.GetResource200JSONResponse(resource), nil
+}
@@ -0,0 +1,443 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/openshift-kni/oran-o2ims/api/common"
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+)
+
+// InventorySubscriptionsConfigMapName is the name of the ConfigMap holding the inventory subscriptions of the Metal3
+// hardware plugin
+const InventorySubscriptionsConfigMapName = "metal3-hwplugin-inventory-subscriptions"
+
+const (
+	// inventoryDeliveryTimeout bounds each attempt to deliver an inventory notification
+	inventoryDeliveryTimeout = 10 * time.Second
+	// inventoryDeliveryAttempts is the number of attempts made to deliver each inventory notification
+	inventoryDeliveryAttempts = 5
+	// inventoryDeliveryBaseDelay and inventoryDeliveryMaxDelay bound the backoff between delivery attempts
+	inventoryDeliveryBaseDelay = 2 * time.Second
+	inventoryDeliveryMaxDelay  = 2 * time.Minute
+)
+
+// InventoryNotifier pushes the changes of the resources derived from the BareMetalHost, HardwareData and AllocatedNode
+// CRs to the inventory subscribers.  The last notified state of each resource is kept in memory so that only actual
+// changes are reported.  That state is rebuilt from the current inventory before the first change is processed so
+// that a restart does not report every resource again; changes made while the plugin was down are recovered by the
+// periodic resynchronization of the subscribers.
+//
+// The notifications are queued and delivered by the Start method so that slow or unreachable subscribers do not hold
+// up the reconciliation.  Failed deliveries are retried with a backoff, and a queued notification is dropped once a
+// newer notification for the same resource and subscription is queued.
+type InventoryNotifier struct {
+	Client        client.Client
+	Logger        *slog.Logger
+	Subscriptions *inventory.SubscriptionStore
+	HTTPClient    *http.Client
+	// resources tracks the last notified state of each resource, keyed by BareMetalHost namespace/name.  It is only
+	// accessed by the reconciler, which runs a single worker.
+	resources map[string]inventory.ResourceInfo
+	// seeded is set once resources has been rebuilt from the current inventory
+	seeded            bool
+	selectorEvaluator *search.SelectorEvaluator
+	deliveries        workqueue.TypedRateLimitingInterface[*inventoryDelivery]
+	// latestMutex protects latest from concurrent changes by the reconciler and the delivery worker
+	latestMutex sync.Mutex
+	// latest tracks the most recent notification queued for each subscription and resource
+	latest map[string]uuid.UUID
+}
+
+// inventoryDelivery defines a notification queued for delivery to a subscription
+type inventoryDelivery struct {
+	subscriptionID uuid.UUID
+	callback       string
+	notification   inventory.ResourceChangeNotification
+	// key identifies the subscription and resource that the notification refers to
+	key string
+}
+
+// NewInventoryNotifier creates a notifier that delivers the resource changes to the subscriptions persisted in the
+// given store
+func NewInventoryNotifier(c client.Client, logger *slog.Logger, subscriptions *inventory.SubscriptionStore) (*InventoryNotifier, error) {
+	selectorEvaluator, err := search.NewObjectSelectorEvaluator(logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subscription evaluator: %w", err)
+	}
+
+	return &InventoryNotifier{
+		Client:            c,
+		Logger:            logger,
+		Subscriptions:     subscriptions,
+		resources:         make(map[string]inventory.ResourceInfo),
+		selectorEvaluator: selectorEvaluator,
+		deliveries: workqueue.NewTypedRateLimitingQueue(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[*inventoryDelivery](
+				inventoryDeliveryBaseDelay, inventoryDeliveryMaxDelay)),
+		latest: make(map[string]uuid.UUID),
+	}, nil
+}
+
+// SetupWithManager sets up the notifier with the Manager.  HardwareData and AllocatedNode changes are mapped to the
+// BareMetalHost they describe.  The reconciliations are run one at a time, as they read and update the resources and
+// seeded fields without locking.
+func (r *InventoryNotifier) SetupWithManager(mgr ctrl.Manager) error {
+	if err := ctrl.NewControllerManagedBy(mgr).
+		Named("metal3_inventory_notifier").
+		For(&metal3v1alpha1.BareMetalHost{}).
+		Watches(&metal3v1alpha1.HardwareData{}, &handler.EnqueueRequestForObject{}).
+		Watches(&pluginsv1alpha1.AllocatedNode{}, handler.EnqueueRequestsFromMapFunc(mapAllocatedNodeToBMH)).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create inventory notifier controller: %w", err)
+	}
+
+	if err := mgr.Add(r); err != nil {
+		return fmt.Errorf("failed to add inventory notification worker: %w", err)
+	}
+
+	return nil
+}
+
+// NeedLeaderElection ensures that the notifications are delivered by the leader, which is the one queuing them
+func (r *InventoryNotifier) NeedLeaderElection() bool {
+	return true
+}
+
+// Start delivers the queued notifications until the context is cancelled.  It implements the manager.Runnable
+// interface.
+func (r *InventoryNotifier) Start(ctx context.Context) error {
+	if r.HTTPClient == nil {
+		httpClient, err := notifier.NewClientFactory(nil, constants.DefaultBackendTokenFile).NewClient(ctx, common.ServiceAccount)
+		if err != nil {
+			return fmt.Errorf("failed to create inventory notifier client: %w", err)
+		}
+		r.HTTPClient = httpClient
+	}
+
+	go func() {
+		<-ctx.Done()
+		r.deliveries.ShutDown()
+	}()
+
+	r.Logger.InfoContext(ctx, "Starting inventory notification worker")
+	for {
+		if !r.processNextDelivery(ctx) {
+			return nil
+		}
+	}
+}
+
+// mapAllocatedNodeToBMH returns the BareMetalHost backing an AllocatedNode
+func mapAllocatedNodeToBMH(_ context.Context, obj client.Object) []reconcile.Request {
+	node, ok := obj.(*pluginsv1alpha1.AllocatedNode)
+	if !ok || node.Spec.HwMgrNodeId == "" || node.Spec.HwMgrNodeNs == "" {
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{
+		Namespace: node.Spec.HwMgrNodeNs,
+		Name:      node.Spec.HwMgrNodeId,
+	}}}
+}
+
+// Reconcile compares the current state of the resource of a BareMetalHost with the last notified state and notifies
+// the subscribers of any difference
+func (r *InventoryNotifier) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if !r.seeded {
+		if err := r.seed(ctx); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	current, err := r.getResource(ctx, req.NamespacedName)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	key := req.String()
+	previous, known := r.resources[key]
+
+	var eventType inventory.ResourceChangeNotificationNotificationEventType
+	var object inventory.ResourceInfo
+	switch {
+	case current == nil && !known:
+		return ctrl.Result{}, nil
+	case current == nil:
+		eventType = inventory.DELETE
+		object = previous
+	case !known:
+		eventType = inventory.CREATE
+		object = *current
+	case reflect.DeepEqual(previous, *current):
+		return ctrl.Result{}, nil
+	default:
+		eventType = inventory.MODIFY
+		object = *current
+	}
+
+	if err := r.notify(ctx, eventType, object); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if current == nil {
+		delete(r.resources, key)
+	} else {
+		r.resources[key] = *current
+	}
+	return ctrl.Result{}, nil
+}
+
+// seed rebuilds the last notified state of the resources from the current inventory, without notifying them
+func (r *InventoryNotifier) seed(ctx context.Context) error {
+	response, err := GetResources(ctx, r.Logger, r.Client)
+	if err != nil {
+		return err
+	}
+
+	resources, ok := response.(inventory.GetResources200JSONResponse)
+	if !ok {
+		return fmt.Errorf("unexpected inventory response type: %T", response)
+	}
+
+	// The resource id of a BareMetalHost is its namespace/name, as are the reconcile requests
+	for _, resource := range resources {
+		r.resources[resource.ResourceId] = resource
+	}
+	r.seeded = true
+
+	r.Logger.InfoContext(ctx, "Rebuilt the notified inventory state", slog.Int("resources", len(resources)))
+	return nil
+}
+
+// getResource returns the current state of the resource of a BareMetalHost, or nil if the BareMetalHost does not
+// exist or is not part of the inventory
+func (r *InventoryNotifier) getResource(ctx context.Context, name types.NamespacedName) (*inventory.ResourceInfo, error) {
+	bmh := &metal3v1alpha1.BareMetalHost{}
+	if err := r.Client.Get(ctx, name, bmh); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get BareMetalHost %s: %w", name, err)
+	}
+
+	if !includeInInventory(bmh) {
+		return nil, nil
+	}
+
+	resource, err := getResourceForBMH(ctx, r.Client, bmh)
+	if err != nil {
+		return nil, err
+	}
+	return &resource, nil
+}
+
+// notify queues a resource change for delivery to the matching subscriptions
+func (r *InventoryNotifier) notify(ctx context.Context, eventType inventory.ResourceChangeNotificationNotificationEventType,
+	resource inventory.ResourceInfo) error {
+	subscriptions, err := r.Subscriptions.List(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get inventory subscriptions: %w", err)
+	}
+
+	if len(subscriptions) == 0 {
+		return nil
+	}
+
+	object, err := toObject(resource)
+	if err != nil {
+		return err
+	}
+
+	objectRef := fmt.Sprintf("%s/resources/%s", constants.HardwareManagerInventoryBaseURL, url.PathEscape(resource.ResourceId))
+	for _, subscription := range subscriptions {
+		if !r.matches(ctx, &subscription, object) {
+			continue
+		}
+
+		notification := inventory.ResourceChangeNotification{
+			ConsumerSubscriptionId: subscription.ConsumerSubscriptionId,
+			NotificationEventType:  eventType,
+			NotificationId:         uuid.New(),
+			Object:                 &object,
+		}
+		if eventType != inventory.DELETE {
+			notification.ObjectRef = &objectRef
+		}
+
+		r.enqueue(&inventoryDelivery{
+			subscriptionID: *subscription.SubscriptionId,
+			callback:       subscription.Callback,
+			notification:   notification,
+			key:            fmt.Sprintf("%s/%s", subscription.SubscriptionId, resource.ResourceId),
+		})
+	}
+
+	return nil
+}
+
+// enqueue queues a notification for delivery and marks it as the latest one for its subscription and resource
+func (r *InventoryNotifier) enqueue(delivery *inventoryDelivery) {
+	r.latestMutex.Lock()
+	r.latest[delivery.key] = delivery.notification.NotificationId
+	r.latestMutex.Unlock()
+
+	r.deliveries.Add(delivery)
+}
+
+// isSuperseded returns true if a newer notification was queued for the subscription and resource of a delivery.  The
+// latest delivery of each subscription and resource is forgotten once it is finished.
+func (r *InventoryNotifier) isSuperseded(delivery *inventoryDelivery, finished bool) bool {
+	r.latestMutex.Lock()
+	defer r.latestMutex.Unlock()
+
+	if r.latest[delivery.key] != delivery.notification.NotificationId {
+		return true
+	}
+	if finished {
+		delete(r.latest, delivery.key)
+	}
+	return false
+}
+
+// processNextDelivery delivers the next queued notification.  It returns false once the queue is shut down.
+func (r *InventoryNotifier) processNextDelivery(ctx context.Context) bool {
+	delivery, shutdown := r.deliveries.Get()
+	if shutdown {
+		return false
+	}
+	defer r.deliveries.Done(delivery)
+
+	logger := r.Logger.With(
+		slog.String("subscriptionId", delivery.subscriptionID.String()),
+		slog.String("notificationId", delivery.notification.NotificationId.String()))
+
+	if r.isSuperseded(delivery, false) {
+		logger.DebugContext(ctx, "Dropping superseded inventory notification")
+		r.deliveries.Forget(delivery)
+		return true
+	}
+
+	if r.deliveries.NumRequeues(delivery) > 0 {
+		// The subscription may have been deleted since the previous attempt
+		subscription, err := r.Subscriptions.Get(ctx, delivery.subscriptionID)
+		if err == nil && subscription == nil {
+			logger.InfoContext(ctx, "Dropping inventory notification of a deleted subscription")
+			r.isSuperseded(delivery, true)
+			r.deliveries.Forget(delivery)
+			return true
+		}
+	}
+
+	sendCtx, cancel := context.WithTimeout(ctx, inventoryDeliveryTimeout)
+	defer cancel()
+
+	err := r.send(sendCtx, delivery.callback, &delivery.notification)
+	switch {
+	case err == nil:
+		logger.DebugContext(ctx, "Delivered inventory notification",
+			slog.Int("eventType", int(delivery.notification.NotificationEventType)))
+	case r.deliveries.NumRequeues(delivery) < inventoryDeliveryAttempts-1:
+		logger.WarnContext(ctx, "Failed to deliver inventory notification; retrying", slog.String("error", err.Error()))
+		r.deliveries.AddRateLimited(delivery)
+		return true
+	default:
+		// Subscribers recover from lost notifications by periodically resynchronizing their view of the inventory
+		logger.WarnContext(ctx, "Failed to deliver inventory notification; giving up", slog.String("error", err.Error()))
+	}
+
+	r.isSuperseded(delivery, true)
+	r.deliveries.Forget(delivery)
+	return true
+}
+
+// matches evaluates the filter of a subscription against a resource.  Subscriptions with a filter that cannot be
+// evaluated receive no notifications.
+func (r *InventoryNotifier) matches(ctx context.Context, subscription *inventory.Subscription, object map[string]any) bool {
+	if subscription.Filter == nil || *subscription.Filter == "" {
+		return true
+	}
+
+	selector, err := search.ParseSelector(r.Logger, *subscription.Filter)
+	if err != nil {
+		r.Logger.WarnContext(ctx, "Failed to parse subscription filter",
+			slog.String("subscriptionId", subscription.SubscriptionId.String()), slog.String("error", err.Error()))
+		return false
+	}
+
+	result, err := r.selectorEvaluator.Evaluate(ctx, selector, object)
+	if err != nil {
+		r.Logger.WarnContext(ctx, "Failed to evaluate subscription filter",
+			slog.String("subscriptionId", subscription.SubscriptionId.String()), slog.String("error", err.Error()))
+		return false
+	}
+	return result
+}
+
+// send posts a notification to the callback of a subscription
+func (r *InventoryNotifier) send(ctx context.Context, callback string, notification *inventory.ResourceChangeNotification) error {
+	body, err := json.Marshal(notification)
+	if err != nil {
+		return fmt.Errorf("failed to marshal inventory notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callback, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create inventory notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := r.HTTPClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post inventory notification: %w", err)
+	}
+	defer resp.Body.Close() // nolint: errcheck
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code posting inventory notification: %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// toObject converts a resource to the generic object carried by the notifications and evaluated by the filters
+func toObject(resource inventory.ResourceInfo) (map[string]any, error) {
+	data, err := json.Marshal(resource)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource '%s': %w", resource.ResourceId, err)
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal(data, &object); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal resource '%s': %w", resource.ResourceId, err)
+	}
+	return object, nil
+}
@@ -0,0 +1,238 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+)
+
+var _ = Describe("InventoryNotifier", func() {
+	var (
+		ctx      context.Context
+		c        client.Client
+		store    *inventory.SubscriptionStore
+		notifier *InventoryNotifier
+		server   *httptest.Server
+		received []inventory.ResourceChangeNotification
+		request  ctrl.Request
+		bmh      *metal3v1alpha1.BareMetalHost
+	)
+
+	// deliver processes the notifications that are ready for delivery
+	deliver := func() {
+		for notifier.deliveries.Len() > 0 {
+			Expect(notifier.processNextDelivery(ctx)).To(BeTrue())
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+
+		bmh = &metal3v1alpha1.BareMetalHost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "host-1",
+				Namespace: "test-ns",
+				Labels: map[string]string{
+					LabelResourcePoolID: "pool-1",
+					LabelSiteID:         "site-1",
+				},
+			},
+			Status: metal3v1alpha1.BareMetalHostStatus{
+				Provisioning: metal3v1alpha1.ProvisionStatus{State: metal3v1alpha1.StateAvailable},
+			},
+		}
+		c = fake.NewClientBuilder().WithScheme(scheme).
+			WithIndex(&pluginsv1alpha1.AllocatedNode{}, AllocatedNodeSpecHwMgrNodeKey, allocatedNodeIndexFunc).
+			Build()
+		store = inventory.NewSubscriptionStore(c, c, "test-ns", InventorySubscriptionsConfigMapName)
+		request = ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "test-ns", Name: "host-1"}}
+
+		received = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			var notification inventory.ResourceChangeNotification
+			Expect(json.NewDecoder(r.Body).Decode(&notification)).To(Succeed())
+			received = append(received, notification)
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		notifier, err = NewInventoryNotifier(c, slog.Default(), store)
+		Expect(err).NotTo(HaveOccurred())
+		notifier.HTTPClient = server.Client()
+
+		// The host is created after the notifier has rebuilt its state from the (empty) inventory
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(c.Create(ctx, bmh)).To(Succeed())
+	})
+
+	It("should notify the creation, modification and deletion of a resource", func() {
+		_, err := store.Create(ctx, inventory.Subscription{Callback: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].NotificationEventType).To(Equal(inventory.CREATE))
+		Expect(*received[0].ObjectRef).To(HaveSuffix("/resources/test-ns%2Fhost-1"))
+		Expect(*received[0].Object).To(HaveKeyWithValue("resourceId", "test-ns/host-1"))
+
+		// Nothing changed so nothing is notified
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(1))
+
+		Expect(c.Get(ctx, request.NamespacedName, bmh)).To(Succeed())
+		bmh.Spec.Online = true
+		Expect(c.Update(ctx, bmh)).To(Succeed())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(2))
+		Expect(received[1].NotificationEventType).To(Equal(inventory.MODIFY))
+		Expect(*received[1].Object).To(HaveKeyWithValue("adminState", string(inventory.ResourceInfoAdminStateUNLOCKED)))
+
+		Expect(c.Delete(ctx, bmh)).To(Succeed())
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(3))
+		Expect(received[2].NotificationEventType).To(Equal(inventory.DELETE))
+		Expect(received[2].ObjectRef).To(BeNil())
+		Expect(*received[2].Object).To(HaveKeyWithValue("resourceId", "test-ns/host-1"))
+	})
+
+	It("should only notify the subscriptions whose filter matches the resource", func() {
+		matching := "(eq,resourcePoolId,pool-1)"
+		other := "(eq,resourcePoolId,pool-2)"
+		subscription, err := store.Create(ctx, inventory.Subscription{Callback: server.URL, Filter: &matching})
+		Expect(err).NotTo(HaveOccurred())
+		_, err = store.Create(ctx, inventory.Subscription{Callback: server.URL, Filter: &other})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].ConsumerSubscriptionId).To(Equal(subscription.ConsumerSubscriptionId))
+	})
+
+	It("should not notify resources that are not part of the inventory", func() {
+		_, err := store.Create(ctx, inventory.Subscription{Callback: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Get(ctx, request.NamespacedName, bmh)).To(Succeed())
+		bmh.Labels = nil
+		Expect(c.Update(ctx, bmh)).To(Succeed())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(BeEmpty())
+	})
+
+	It("should retry the deliveries that fail", func() {
+		attempts := 0
+		flaky := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			attempts++
+			if attempts == 1 {
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+			server.Config.Handler.ServeHTTP(w, r)
+		}))
+		DeferCleanup(flaky.Close)
+		notifier.HTTPClient = flaky.Client()
+		notifier.deliveries = workqueue.NewTypedRateLimitingQueue(
+			workqueue.NewTypedItemExponentialFailureRateLimiter[*inventoryDelivery](time.Millisecond, time.Millisecond))
+
+		_, err := store.Create(ctx, inventory.Subscription{Callback: flaky.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(notifier.resources).To(HaveKey(request.String()))
+		deliver()
+		Expect(received).To(BeEmpty())
+
+		Eventually(notifier.deliveries.Len).Should(Equal(1))
+		deliver()
+		Expect(attempts).To(Equal(2))
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].NotificationEventType).To(Equal(inventory.CREATE))
+	})
+
+	It("should only deliver the latest queued change of a resource", func() {
+		_, err := store.Create(ctx, inventory.Subscription{Callback: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(c.Delete(ctx, bmh)).To(Succeed())
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+
+		deliver()
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].NotificationEventType).To(Equal(inventory.DELETE))
+	})
+
+	It("should not notify the existing resources again after a restart", func() {
+		_, err := store.Create(ctx, inventory.Subscription{Callback: server.URL})
+		Expect(err).NotTo(HaveOccurred())
+
+		restarted, err := NewInventoryNotifier(c, slog.Default(), store)
+		Expect(err).NotTo(HaveOccurred())
+		restarted.HTTPClient = server.Client()
+		notifier = restarted
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(BeEmpty())
+
+		Expect(c.Get(ctx, request.NamespacedName, bmh)).To(Succeed())
+		bmh.Spec.Online = true
+		Expect(c.Update(ctx, bmh)).To(Succeed())
+
+		_, err = notifier.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		deliver()
+		Expect(received).To(HaveLen(1))
+		Expect(received[0].NotificationEventType).To(Equal(inventory.MODIFY))
+	})
+})
@@ -13,6 +13,7 @@ package controller
 import (
 	"context"
 	"log/slog"
+	"net/http"
 	"regexp"
 
 	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
@@ -20,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
@@ -121,6 +123,15 @@ API ENDPOINT FUNCTIONS:
 - GetResources: Tests resources API endpoint
   * Returns resources from BMHs included in inventory
   * Handles BMH without corresponding AllocatedNode
+- GetResourcePool: Tests single resource pool API endpoint
+  * Returns the pool using the resource pool index
+  * Returns 404 when no BMH in the inventory belongs to the pool
+- GetResourcePoolResources: Tests resource pool members API endpoint
+  * Returns only the resources of the requested pool
+  * Returns 404 for unknown pools
+- GetResource: Tests single resource API endpoint
+  * Returns the resource of the BMH identified by namespace/name
+  * Returns 404 for unknown, malformed or excluded resources
 
 REGEX PATTERN VALIDATION:
 - REPatternInterfaceLabel: Tests interface label pattern matching
@@ -1038,6 +1049,114 @@ var _ = Describe("Inventory", func() {
 		})
 	})
 
+	Describe("Single object lookups", func() {
+		var (
+			ctx    context.Context
+			scheme *runtime.Scheme
+			c      client.Client
+		)
+
+		BeforeEach(func() {
+			ctx = context.Background()
+			scheme = runtime.NewScheme()
+			Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+			Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+			bmh1 := createBMHWithLabels("bmh-1", "test-ns", map[string]string{
+				LabelResourcePoolID: "pool1",
+				LabelSiteID:         "site1",
+			})
+			bmh1.Status.Provisioning.State = metal3v1alpha1.StateAvailable
+
+			bmh2 := createBMHWithLabels("bmh-2", "test-ns", map[string]string{
+				LabelResourcePoolID: "pool2",
+				LabelSiteID:         "site2",
+			})
+			bmh2.Status.Provisioning.State = metal3v1alpha1.StateProvisioned
+
+			// Registering state so excluded from the inventory
+			bmh3 := createBMHWithLabels("bmh-3", "test-ns", map[string]string{
+				LabelResourcePoolID: "pool3",
+				LabelSiteID:         "site3",
+			})
+			bmh3.Status.Provisioning.State = metal3v1alpha1.StateRegistering
+
+			node := createAllocatedNode("test-node", "profile123")
+			node.Spec.HwMgrNodeId = "bmh-2"
+			node.Spec.HwMgrNodeNs = "test-ns"
+
+			c = fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(bmh1, bmh2, bmh3, createHardwareData("bmh-1", "test-ns"), node).
+				WithIndex(&metal3v1alpha1.BareMetalHost{}, BareMetalHostResourcePoolIDKey, bmhIndexFunc).
+				WithIndex(&pluginsv1alpha1.AllocatedNode{}, AllocatedNodeSpecHwMgrNodeKey, allocatedNodeIndexFunc).
+				Build()
+		})
+
+		It("should return a single resource pool", func() {
+			result, err := GetResourcePool(ctx, c, "pool1")
+			Expect(err).ToNot(HaveOccurred())
+
+			response, ok := result.(inventory.GetResourcePool200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response.ResourcePoolId).To(Equal("pool1"))
+			Expect(*response.SiteId).To(Equal("site1"))
+		})
+
+		It("should return 404 for unknown or excluded resource pools", func() {
+			for _, poolID := range []string{"unknown", "pool3"} {
+				result, err := GetResourcePool(ctx, c, poolID)
+				Expect(err).ToNot(HaveOccurred())
+
+				response, ok := result.(inventory.GetResourcePool404ApplicationProblemPlusJSONResponse)
+				Expect(ok).To(BeTrue())
+				Expect(response.Status).To(Equal(http.StatusNotFound))
+			}
+		})
+
+		It("should return only the resources of the requested pool", func() {
+			result, err := GetResourcePoolResources(ctx, c, "pool2")
+			Expect(err).ToNot(HaveOccurred())
+
+			response, ok := result.(inventory.GetResourcePoolResources200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response).To(HaveLen(1))
+			Expect(response[0].ResourceId).To(Equal("test-ns/bmh-2"))
+			Expect(response[0].HwProfile).To(Equal("profile123"))
+		})
+
+		It("should return 404 when listing the resources of an unknown pool", func() {
+			result, err := GetResourcePoolResources(ctx, c, "unknown")
+			Expect(err).ToNot(HaveOccurred())
+
+			_, ok := result.(inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse)
+			Expect(ok).To(BeTrue())
+		})
+
+		It("should return a single resource", func() {
+			result, err := GetResource(ctx, c, "test-ns/bmh-1")
+			Expect(err).ToNot(HaveOccurred())
+
+			response, ok := result.(inventory.GetResource200JSONResponse)
+			Expect(ok).To(BeTrue())
+			Expect(response.ResourceId).To(Equal("test-ns/bmh-1"))
+			Expect(response.ResourcePoolId).To(Equal("pool1"))
+			Expect(response.SerialNumber).To(Equal("ABC123456"))
+			Expect(response.HwProfile).To(Equal(""))
+		})
+
+		It("should return 404 for unknown, malformed or excluded resources", func() {
+			for _, resourceID := range []string{"test-ns/unknown", "bmh-1", "/bmh-1", "test-ns/bmh-3"} {
+				result, err := GetResource(ctx, c, resourceID)
+				Expect(err).ToNot(HaveOccurred())
+
+				response, ok := result.(inventory.GetResource404ApplicationProblemPlusJSONResponse)
+				Expect(ok).To(BeTrue(), resourceID)
+				Expect(response.Status).To(Equal(http.StatusNotFound))
+			}
+		})
+	})
+
 	Describe("Regex patterns", func() {
 		Describe("REPatternInterfaceLabel", func() {
 			It("should match interface labels correctly", func() {
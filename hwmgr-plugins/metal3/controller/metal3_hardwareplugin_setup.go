@@ -7,10 +7,14 @@ SPDX-License-Identifier: Apache-2.0
 package controller
 
 import (
+	"context"
 	"fmt"
 	"log/slog"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
 )
 
 // Metal3Controllers holds references to the metal3 controllers for lifecycle management
@@ -20,13 +24,19 @@ type Metal3Controllers struct {
 }
 
 func SetupMetal3Controllers(mgr ctrl.Manager, namespace string, baseLogger *slog.Logger) (*Metal3Controllers, error) {
+	narLogger := baseLogger.With("controller", "metal3_nodeallocationrequest_controller")
 	nodeAllocationReconciler := &NodeAllocationRequestReconciler{
 		Client:          mgr.GetClient(),
 		NoncachedClient: mgr.GetAPIReader(),
 		Scheme:          mgr.GetScheme(),
-		Logger:          baseLogger.With("controller", "metal3_nodeallocationrequest_controller"),
+		Logger:          narLogger,
 		PluginNamespace: namespace,
 		Manager:         mgr,
+		callbacks:       hwmgrutils.NewCallbackSender(mgr.GetClient(), narLogger),
+	}
+
+	if err := SetupInventoryIndexers(context.Background(), mgr.GetFieldIndexer()); err != nil {
+		return nil, fmt.Errorf("failed to setup inventory indexers: %w", err)
 	}
 
 	if err := nodeAllocationReconciler.SetupWithManager(mgr); err != nil {
@@ -46,6 +56,35 @@ func SetupMetal3Controllers(mgr ctrl.Manager, namespace string, baseLogger *slog
 		return nil, fmt.Errorf("failed to setup AllocatedNode controller: %w", err)
 	}
 
+	nodeReservationReconciler := &NodeReservationReconciler{
+		Client:          mgr.GetClient(),
+		NoncachedClient: mgr.GetAPIReader(),
+		Scheme:          mgr.GetScheme(),
+		Logger:          baseLogger.With("controller", "metal3_nodereservation_controller"),
+		PluginNamespace: namespace,
+	}
+
+	if err := nodeReservationReconciler.SetupWithManager(mgr); err != nil {
+		return nil, fmt.Errorf("failed to setup NodeReservation controller: %w", err)
+	}
+
+	hardwareAlarmReporter := NewHardwareAlarmReporter(mgr.GetClient(),
+		baseLogger.With("controller", "metal3_hardware_alarm_reporter"), namespace)
+	if err := mgr.Add(hardwareAlarmReporter); err != nil {
+		return nil, fmt.Errorf("failed to setup hardware alarm reporter: %w", err)
+	}
+
+	inventoryNotifier, err := NewInventoryNotifier(mgr.GetClient(),
+		baseLogger.With("controller", "metal3_inventory_notifier"),
+		inventory.NewSubscriptionStore(mgr.GetClient(), mgr.GetAPIReader(), namespace, InventorySubscriptionsConfigMapName))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create inventory notifier: %w", err)
+	}
+
+	if err := inventoryNotifier.SetupWithManager(mgr); err != nil {
+		return nil, fmt.Errorf("failed to setup inventory notifier: %w", err)
+	}
+
 	return &Metal3Controllers{
 		NodeAllocationReconciler: nodeAllocationReconciler,
 		AllocatedNodeReconciler:  allocatedReconciler,
@@ -10,9 +10,6 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"net/url"
-	"strings"
-	"sync"
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -27,77 +24,12 @@ import (
 
 	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
 	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
-	narcallbackclient "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/nar-callback"
 	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
-	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/logging"
 	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
 )
 
-const (
-	// maxCallbackRetries defines the maximum number of callback retry attempts
-	maxCallbackRetries = 3
-
-	// maxConcurrentCallbacks limits the number of concurrent callback goroutines
-	// to prevent resource exhaustion
-	maxConcurrentCallbacks = 20
-)
-
-// mapConditionToCallbackStatus maps hardware management condition types and reasons to callback status values
-func mapConditionToCallbackStatus(conditionType hwmgmtv1alpha1.ConditionType, conditionReason hwmgmtv1alpha1.ConditionReason) narcallbackclient.CallbackPayloadStatus {
-	switch conditionType {
-	case hwmgmtv1alpha1.Provisioned:
-		switch conditionReason {
-		case hwmgmtv1alpha1.InProgress:
-			return narcallbackclient.InProgress
-		case hwmgmtv1alpha1.Completed:
-			return narcallbackclient.Completed
-		case hwmgmtv1alpha1.Failed:
-			return narcallbackclient.Failed
-		case hwmgmtv1alpha1.TimedOut:
-			return narcallbackclient.TimedOut
-		case hwmgmtv1alpha1.Unprovisioned:
-			return narcallbackclient.Unprovisioned
-		case hwmgmtv1alpha1.NotInitialized:
-			return narcallbackclient.NotInitialized
-		case hwmgmtv1alpha1.InvalidInput:
-			return narcallbackclient.InvalidInput
-		}
-	case hwmgmtv1alpha1.Configured:
-		switch conditionReason {
-		case hwmgmtv1alpha1.InProgress:
-			return narcallbackclient.InProgress
-		case hwmgmtv1alpha1.Completed, hwmgmtv1alpha1.ConfigApplied:
-			return narcallbackclient.ConfigurationApplied
-		case hwmgmtv1alpha1.Failed:
-			return narcallbackclient.Failed
-		case hwmgmtv1alpha1.TimedOut:
-			return narcallbackclient.TimedOut
-		case hwmgmtv1alpha1.ConfigUpdate:
-			return narcallbackclient.ConfigurationUpdateRequested
-		case hwmgmtv1alpha1.InvalidInput:
-			return narcallbackclient.InvalidInput
-		}
-	case hwmgmtv1alpha1.Validation:
-		switch conditionReason {
-		case hwmgmtv1alpha1.InProgress:
-			return narcallbackclient.InProgress
-		case hwmgmtv1alpha1.Completed:
-			return narcallbackclient.Completed
-		case hwmgmtv1alpha1.Failed:
-			return narcallbackclient.Failed
-		case hwmgmtv1alpha1.InvalidInput:
-			return narcallbackclient.InvalidInput
-		}
-	case hwmgmtv1alpha1.Unknown:
-		return narcallbackclient.Pending
-	}
-
-	// Default fallback
-	return narcallbackclient.Pending
-}
-
 // updateConditionAndSendCallback updates the NodeAllocationRequest condition and sends a callback notification
 func (r *NodeAllocationRequestReconciler) updateConditionAndSendCallback(
 	ctx context.Context,
@@ -114,24 +46,10 @@ func (r *NodeAllocationRequestReconciler) updateConditionAndSendCallback(
 	}
 
 	// Send callback notification asynchronously (non-blocking)
-	callbackStatus := mapConditionToCallbackStatus(conditionType, conditionReason)
-	errorMsg := ""
-	if conditionStatus == metav1.ConditionFalse && (conditionReason == hwmgmtv1alpha1.Failed ||
-		conditionReason == hwmgmtv1alpha1.TimedOut || conditionReason == hwmgmtv1alpha1.InvalidInput) {
-		errorMsg = message
+	if r.callbacks == nil {
+		r.callbacks = hwmgrutils.NewCallbackSender(r.Client, r.Logger)
 	}
-
-	// Send callback with async retries to avoid blocking the controller
-	callbackCtx := r.callbackCtx
-	if callbackCtx == nil {
-		// Fallback to background context if not initialized (shouldn't happen in normal operation)
-		r.Logger.WarnContext(ctx, "Callback context not initialized, using background context")
-		callbackCtx = context.Background()
-	}
-
-	// Launch callback goroutine with semaphore-based rate limiting
-	r.activeCallbacks.Add(1)
-	go r.sendCallbackWithAsyncRetryRateLimited(callbackCtx, nodeAllocationRequest, callbackStatus, errorMsg)
+	r.callbacks.Send(ctx, nodeAllocationRequest, conditionType, conditionReason, conditionStatus, message)
 
 	return nil
 }
@@ -146,43 +64,22 @@ type NodeAllocationRequestReconciler struct {
 	indexerEnabled  bool
 	PluginNamespace string
 
-	// Goroutine management for callback retries
-	callbackCtx       context.Context
-	callbackCancel    context.CancelFunc
-	activeCallbacks   sync.WaitGroup
-	callbackSemaphore chan struct{} // Limits concurrent callback goroutines
+	// callbacks delivers the status changes to the callback of the NodeAllocationRequest
+	callbacks *hwmgrutils.CallbackSender
 }
 
 // InitializeCallbackContext sets up the long-lived context for callback goroutines
 func (r *NodeAllocationRequestReconciler) InitializeCallbackContext(ctx context.Context) {
-	r.callbackCtx, r.callbackCancel = context.WithCancel(ctx)
-	r.callbackSemaphore = make(chan struct{}, maxConcurrentCallbacks)
-	r.Logger.Info("Callback context initialized",
-		slog.Int("maxConcurrentCallbacks", maxConcurrentCallbacks))
+	if r.callbacks == nil {
+		r.callbacks = hwmgrutils.NewCallbackSender(r.Client, r.Logger)
+	}
+	r.callbacks.Initialize(ctx)
 }
 
 // ShutdownCallbacks gracefully shuts down all active callback goroutines
 func (r *NodeAllocationRequestReconciler) ShutdownCallbacks(timeout time.Duration) {
-	r.Logger.Info("Shutting down callback goroutines...")
-
-	// Cancel the callback context to signal all goroutines to stop
-	if r.callbackCancel != nil {
-		r.callbackCancel()
-	}
-
-	// Wait for all active callbacks to complete with timeout
-	done := make(chan struct{})
-	go func() {
-		r.activeCallbacks.Wait()
-		close(done)
-	}()
-
-	select {
-	case <-done:
-		r.Logger.Info("All callback goroutines terminated successfully")
-	case <-time.After(timeout):
-		r.Logger.Warn("Timeout waiting for callback goroutines to terminate",
-			slog.Duration("timeout", timeout))
+	if r.callbacks != nil {
+		r.callbacks.Shutdown(timeout)
 	}
 }
 
@@ -332,211 +229,6 @@ func (r *NodeAllocationRequestReconciler) SetupWithManager(mgr ctrl.Manager) err
 	return nil
 }
 
-// calculateBackoffDuration calculates exponential backoff duration for the given attempt
-func calculateBackoffDuration(attempt int) time.Duration {
-	shift := attempt - 1
-	if shift > 31 { // Prevent overflow for very large attempt values
-		shift = 31
-	}
-	if shift < 0 { // Safety check for negative values
-		shift = 0
-	}
-	// Convert to uint safely after bounds checking
-	uintShift := uint(shift) // #nosec G115 -- shift is bounds-checked above
-	return time.Duration(1<<uintShift) * time.Second
-}
-
-// sendCallbackWithAsyncRetry sends a callback notification with retry logic running asynchronously
-func (r *NodeAllocationRequestReconciler) sendCallbackWithAsyncRetry(ctx context.Context, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, status narcallbackclient.CallbackPayloadStatus, errorMsg string) {
-	if nodeAllocationRequest.Spec.Callback == nil || nodeAllocationRequest.Spec.Callback.CallbackURL == "" {
-		r.Logger.DebugContext(ctx, "No callback configuration provided, skipping callback")
-		return
-	}
-
-	callback := nodeAllocationRequest.Spec.Callback
-	callbackURLStr := callback.CallbackURL
-
-	// Parse the callback URL to extract the provisioning request name
-	callbackURL, err := url.Parse(callbackURLStr)
-	if err != nil {
-		r.Logger.WarnContext(ctx, "Failed to parse callback URL, skipping callback",
-			slog.String("callbackURL", callbackURLStr),
-			slog.String("error", err.Error()))
-		return
-	}
-
-	// Extract provisioning request name from the URL path pattern: /nar-callback/v1/provisioning-requests/{provisioningRequestName}
-	if !strings.HasPrefix(callbackURL.Path, constants.NarCallbackServicePath) {
-		r.Logger.WarnContext(ctx, "Callback URL does not match expected pattern, skipping callback",
-			slog.String("callbackURL", callbackURLStr),
-			slog.String("expectedPath", constants.NarCallbackServicePath+"/{provisioningRequestName}"),
-			slog.String("actualPath", callbackURL.Path))
-		return
-	}
-
-	provisioningRequestName := strings.TrimPrefix(callbackURL.Path, constants.NarCallbackServicePath+"/")
-	if provisioningRequestName == "" {
-		r.Logger.WarnContext(ctx, "Could not extract provisioning request name from callback URL, skipping callback",
-			slog.String("callbackURL", callbackURLStr))
-		return
-	}
-
-	// Create base URL for the callback client (without the path)
-	baseURL := fmt.Sprintf("%s://%s", callbackURL.Scheme, callbackURL.Host)
-	if callbackURL.Port() != "" {
-		baseURL = fmt.Sprintf("%s://%s:%s", callbackURL.Scheme, callbackURL.Hostname(), callbackURL.Port())
-	}
-
-	// Create a modified callback config with the base URL instead of the full URL
-	callbackForClient := &pluginsv1alpha1.Callback{
-		CallbackURL:      baseURL,
-		CaBundleName:     callback.CaBundleName,
-		AuthClientConfig: callback.AuthClientConfig,
-	}
-
-	narCallbackClient, err := narcallbackclient.NewNarCallbackClient(ctx, r.Client, r.Logger, callbackForClient)
-	if err != nil {
-		r.Logger.ErrorContext(ctx, "Unable to create NAR callback client",
-			slog.String("baseURL", baseURL),
-			slog.String("error", err.Error()))
-		return
-	}
-
-	// Create callback payload using the generated types
-	payload := narcallbackclient.CallbackPayload{
-		NodeAllocationRequestId: nodeAllocationRequest.Name,
-		Status:                  status,
-		Timestamp:               time.Now().UTC(),
-	}
-	if errorMsg != "" {
-		payload.Error = &errorMsg
-	}
-
-	// Execute retry logic asynchronously (doesn't block the controller)
-	r.executeAsyncRetry(ctx, narCallbackClient, provisioningRequestName, payload, nodeAllocationRequest.Name, status)
-}
-
-// sendCallbackWithAsyncRetryRateLimited wraps the callback with rate limiting
-func (r *NodeAllocationRequestReconciler) sendCallbackWithAsyncRetryRateLimited(ctx context.Context, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, status narcallbackclient.CallbackPayloadStatus, errorMsg string) {
-	defer r.activeCallbacks.Done()
-
-	// Acquire semaphore to limit concurrent callbacks
-	select {
-	case r.callbackSemaphore <- struct{}{}:
-		// Successfully acquired semaphore slot
-		defer func() { <-r.callbackSemaphore }() // Release when done
-	case <-ctx.Done():
-		// Context cancelled while waiting for semaphore
-		r.Logger.WarnContext(ctx, "Context cancelled while waiting for callback semaphore",
-			slog.String("nodeAllocationRequest", nodeAllocationRequest.Name))
-		return
-	}
-
-	// Execute the actual callback logic
-	r.sendCallbackWithAsyncRetry(ctx, nodeAllocationRequest, status, errorMsg)
-}
-
-// executeAsyncRetry runs the callback retry logic with exponential backoff in a goroutine
-func (r *NodeAllocationRequestReconciler) executeAsyncRetry(
-	ctx context.Context,
-	narCallbackClient *narcallbackclient.NarCallbackClient,
-	provisioningRequestName string,
-	payload narcallbackclient.CallbackPayload,
-	nodeAllocationRequestName string,
-	status narcallbackclient.CallbackPayloadStatus) {
-
-	var lastErr error
-
-	for attempt := 1; attempt <= maxCallbackRetries; attempt++ {
-		// Create a context with timeout for this specific attempt
-		attemptCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
-
-		// Try to send the callback
-		resp, err := narCallbackClient.Client.ProvisioningRequestCallback(attemptCtx, provisioningRequestName, payload)
-		cancel()
-
-		if err != nil {
-			lastErr = fmt.Errorf("attempt %d: %w", attempt, err)
-			r.Logger.WarnContext(ctx, "Callback attempt failed (async)",
-				slog.Int("attempt", attempt),
-				slog.Int("maxRetries", maxCallbackRetries),
-				slog.String("provisioningRequest", provisioningRequestName),
-				slog.String("nodeAllocationRequest", nodeAllocationRequestName),
-				slog.String("error", err.Error()))
-
-			if attempt < maxCallbackRetries {
-				// Calculate exponential backoff duration
-				backoffDuration := calculateBackoffDuration(attempt)
-				r.Logger.InfoContext(ctx, "Retrying callback after backoff (async)",
-					slog.Int("attempt", attempt+1),
-					slog.Duration("backoff", backoffDuration))
-
-				// Wait for backoff duration (safe since we're in a goroutine)
-				select {
-				case <-ctx.Done():
-					r.Logger.WarnContext(ctx, "Context cancelled during async callback retry",
-						slog.String("provisioningRequest", provisioningRequestName),
-						slog.String("nodeAllocationRequest", nodeAllocationRequestName))
-					return
-				case <-time.After(backoffDuration):
-					// Continue to next attempt
-				}
-				continue
-			}
-			continue
-		}
-
-		defer resp.Body.Close()
-
-		if resp.StatusCode < 200 || resp.StatusCode >= 300 {
-			lastErr = fmt.Errorf("attempt %d: received non-success status code %d", attempt, resp.StatusCode)
-			r.Logger.WarnContext(ctx, "Callback returned non-success status (async)",
-				slog.Int("attempt", attempt),
-				slog.Int("maxRetries", maxCallbackRetries),
-				slog.String("provisioningRequest", provisioningRequestName),
-				slog.String("nodeAllocationRequest", nodeAllocationRequestName),
-				slog.Int("statusCode", resp.StatusCode))
-
-			if attempt < maxCallbackRetries {
-				// Calculate exponential backoff duration
-				backoffDuration := calculateBackoffDuration(attempt)
-				r.Logger.InfoContext(ctx, "Retrying callback after backoff (async)",
-					slog.Int("attempt", attempt+1),
-					slog.Duration("backoff", backoffDuration))
-
-				// Wait for backoff duration (safe since we're in a goroutine)
-				select {
-				case <-ctx.Done():
-					r.Logger.WarnContext(ctx, "Context cancelled during async callback retry",
-						slog.String("provisioningRequest", provisioningRequestName),
-						slog.String("nodeAllocationRequest", nodeAllocationRequestName))
-					return
-				case <-time.After(backoffDuration):
-					// Continue to next attempt
-				}
-				continue
-			}
-			continue
-		}
-
-		// Success
-		r.Logger.InfoContext(ctx, "Callback sent successfully (async)",
-			slog.Int("attempt", attempt),
-			slog.String("provisioningRequest", provisioningRequestName),
-			slog.String("nodeAllocationRequest", nodeAllocationRequestName),
-			slog.String("status", string(status)))
-		return
-	}
-
-	// All attempts failed
-	r.Logger.ErrorContext(ctx, "Callback failed after all async retry attempts",
-		slog.Int("maxRetries", maxCallbackRetries),
-		slog.String("provisioningRequest", provisioningRequestName),
-		slog.String("nodeAllocationRequest", nodeAllocationRequestName),
-		slog.String("status", string(status)),
-		slog.String("lastError", lastErr.Error()))
-}
-
 // HandleNodeAllocationRequest processes the NodeAllocationRequest CR
 func (r *NodeAllocationRequestReconciler) HandleNodeAllocationRequest(
 	ctx context.Context, nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
@@ -611,6 +303,26 @@ func (r *NodeAllocationRequestReconciler) handleNodeAllocationRequestSpecChanged
 		}
 	}
 
+	// Bring the node groups to their size before configuring their nodes
+	result, scaled, err := scaleNodeAllocationRequest(ctx, r.Client, r.NoncachedClient, r.Logger, r.PluginNamespace, nodeAllocationRequest)
+	if err != nil {
+		reason := hwmgmtv1alpha1.Failed
+		if typederrors.IsInputError(err) {
+			reason = hwmgmtv1alpha1.InvalidInput
+		} else if typederrors.IsPlacementError(err) {
+			reason = hwmgmtv1alpha1.PlacementUnsatisfiable
+		}
+		if updateErr := r.updateConditionAndSendCallback(ctx, nodeAllocationRequest,
+			hwmgmtv1alpha1.Configured, reason, metav1.ConditionFalse, "Scaling failed: "+err.Error()); updateErr != nil {
+			return hwmgrutils.RequeueWithMediumInterval(),
+				fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, updateErr)
+		}
+		return result, fmt.Errorf("failed to scale NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+	if !scaled {
+		return result, nil
+	}
+
 	result, nodelist, err := handleNodeAllocationRequestConfiguring(ctx, r.Client, r.NoncachedClient, r.Logger, r.PluginNamespace, nodeAllocationRequest)
 
 	if nodelist != nil {
@@ -654,6 +366,8 @@ func (r *NodeAllocationRequestReconciler) handleNodeAllocationRequestProcessing(
 		reason := hwmgmtv1alpha1.Failed
 		if typederrors.IsInputError(err) {
 			reason = hwmgmtv1alpha1.InvalidInput
+		} else if typederrors.IsPlacementError(err) {
+			reason = hwmgmtv1alpha1.PlacementUnsatisfiable
 		}
 		if updateErr := r.updateConditionAndSendCallback(
 			ctx, nodeAllocationRequest,
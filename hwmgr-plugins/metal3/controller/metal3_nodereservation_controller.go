@@ -0,0 +1,344 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/logging"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+const NodeReservationFinalizer = "clcm.openshift.io/nodereservation-finalizer"
+
+// NodeReservationReconciler reconciles NodeReservation objects associated with the Metal3 H/W plugin
+type NodeReservationReconciler struct {
+	client.Client
+	NoncachedClient client.Reader
+	Scheme          *runtime.Scheme
+	Logger          *slog.Logger
+	PluginNamespace string
+}
+
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodereservations,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodereservations/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodereservations/finalizers,verbs=update
+
+func (r *NodeReservationReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, err error) {
+	startTime := time.Now()
+
+	// Add standard reconciliation context
+	ctx = ctlrutils.LogReconcileStart(ctx, r.Logger, req, "NodeReservation")
+
+	defer func() {
+		duration := time.Since(startTime)
+		if err != nil {
+			r.Logger.ErrorContext(ctx, "Reconciliation failed",
+				slog.Duration("duration", duration),
+				slog.String("error", err.Error()))
+		} else {
+			r.Logger.InfoContext(ctx, "Reconciliation completed",
+				slog.Duration("duration", duration),
+				slog.Duration("requeueAfter", result.RequeueAfter))
+		}
+	}()
+
+	ctx = logging.AppendCtx(ctx, slog.String("NodeReservation", req.Name))
+
+	// Fetch the NodeReservation, using non-caching client
+	nodeReservation := &pluginsv1alpha1.NodeReservation{}
+	if err := r.NoncachedClient.Get(ctx, req.NamespacedName, nodeReservation); err != nil {
+		if errors.IsNotFound(err) {
+			r.Logger.InfoContext(ctx, "NodeReservation not found, assuming deleted")
+			return hwmgrutils.DoNotRequeue(), nil
+		}
+		ctlrutils.LogError(ctx, r.Logger, "Unable to fetch NodeReservation", err)
+		return hwmgrutils.RequeueWithShortInterval(), nil
+	}
+	ctx = logging.AppendCtx(ctx, slog.String("ClusterID", nodeReservation.Spec.ClusterId))
+
+	if nodeReservation.GetDeletionTimestamp() != nil {
+		// Release the held hosts before letting the NodeReservation go
+		r.Logger.InfoContext(ctx, "NodeReservation is being deleted")
+		if !controllerutil.ContainsFinalizer(nodeReservation, NodeReservationFinalizer) {
+			return hwmgrutils.DoNotRequeue(), nil
+		}
+		if err := r.releaseHosts(ctx, nodeReservation); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), err
+		}
+		patch := client.MergeFrom(nodeReservation.DeepCopy())
+		controllerutil.RemoveFinalizer(nodeReservation, NodeReservationFinalizer)
+		if err := r.Patch(ctx, nodeReservation, patch); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to remove finalizer from NodeReservation %s: %w",
+				nodeReservation.Name, err)
+		}
+		return hwmgrutils.DoNotRequeue(), nil
+	}
+
+	if !controllerutil.ContainsFinalizer(nodeReservation, NodeReservationFinalizer) {
+		patch := client.MergeFrom(nodeReservation.DeepCopy())
+		controllerutil.AddFinalizer(nodeReservation, NodeReservationFinalizer)
+		if err := r.Patch(ctx, nodeReservation, patch); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to add finalizer to NodeReservation %s: %w",
+				nodeReservation.Name, err)
+		}
+	}
+
+	return r.handleNodeReservation(ctx, nodeReservation)
+}
+
+// handleNodeReservation holds the hosts of a live reservation, and releases them once it expires
+func (r *NodeReservationReconciler) handleNodeReservation(ctx context.Context,
+	nodeReservation *pluginsv1alpha1.NodeReservation) (ctrl.Result, error) {
+
+	remaining := time.Until(nodeReservation.Spec.ExpiresAt.Time)
+	if remaining <= 0 {
+		condition := meta.FindStatusCondition(nodeReservation.Status.Conditions, string(hwmgmtv1alpha1.Reserved))
+		if condition != nil && condition.Reason == string(hwmgmtv1alpha1.Expired) {
+			return hwmgrutils.DoNotRequeue(), nil
+		}
+
+		r.Logger.InfoContext(ctx, "NodeReservation expired, releasing the held hosts")
+		if err := r.releaseHosts(ctx, nodeReservation); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), err
+		}
+		return hwmgrutils.DoNotRequeue(), r.updateStatus(ctx, nodeReservation, hwmgmtv1alpha1.Expired,
+			metav1.ConditionFalse, "Reservation expired, the held hosts were released")
+	}
+
+	held, needed, err := r.holdHosts(ctx, nodeReservation)
+	if err != nil {
+		reason := hwmgmtv1alpha1.Failed
+		if typederrors.IsInputError(err) {
+			reason = hwmgmtv1alpha1.InvalidInput
+		}
+		if statusErr := r.updateStatus(ctx, nodeReservation, reason, metav1.ConditionFalse, err.Error()); statusErr != nil {
+			return hwmgrutils.RequeueWithShortInterval(), statusErr
+		}
+		if reason == hwmgmtv1alpha1.InvalidInput {
+			return hwmgrutils.DoNotRequeue(), nil
+		}
+		return hwmgrutils.RequeueWithMediumInterval(), nil
+	}
+
+	if held < needed {
+		// Wait for hosts to become free, but no longer than the reservation lives
+		if err := r.updateStatus(ctx, nodeReservation, hwmgmtv1alpha1.InProgress, metav1.ConditionFalse,
+			fmt.Sprintf("Holding %d of the %d requested hosts, waiting for free hosts", held, needed)); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), err
+		}
+		return hwmgrutils.RequeueWithCustomInterval(min(remaining, time.Minute)), nil
+	}
+
+	if err := r.updateStatus(ctx, nodeReservation, hwmgmtv1alpha1.Completed, metav1.ConditionTrue,
+		fmt.Sprintf("Holding the %d requested hosts until %s", needed,
+			nodeReservation.Spec.ExpiresAt.UTC().Format(time.RFC3339))); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), err
+	}
+	return hwmgrutils.RequeueWithCustomInterval(remaining), nil
+}
+
+// holdHosts sets the reservation label on free hosts matching the node groups of a reservation, until each group
+// holds as many hosts as its size. Returns the number of hosts held and requested.
+func (r *NodeReservationReconciler) holdHosts(ctx context.Context,
+	nodeReservation *pluginsv1alpha1.NodeReservation) (int, int, error) {
+
+	live, err := getLiveReservations(ctx, r.NoncachedClient, r.PluginNamespace)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	held, needed := 0, 0
+	for _, nodeGroup := range nodeReservation.Spec.NodeGroup {
+		groupName := nodeGroup.NodeGroupData.Name
+		needed += nodeGroup.Size
+
+		groupHeld := 0
+		for _, node := range nodeReservation.Status.ReservedNodes {
+			if node.GroupName == groupName {
+				groupHeld++
+			}
+		}
+		pending := nodeGroup.Size - groupHeld
+		if pending <= 0 {
+			held += nodeGroup.Size
+			continue
+		}
+
+		bmhList, err := fetchBMHList(ctx, r.NoncachedClient, r.Logger, nodeReservation.Spec.Site, nodeGroup.NodeGroupData)
+		if err != nil {
+			return 0, 0, fmt.Errorf("unable to fetch free BMHs for site=%s, nodegroup=%s: %w",
+				nodeReservation.Spec.Site, groupName, err)
+		}
+		// A host labeled for this reservation but missing from its status, such as after a failed status update,
+		// is free to hold again
+		var free metal3v1alpha1.BareMetalHostList
+		for _, bmh := range bmhList.Items {
+			owner := bmhReservation(&bmh, live)
+			if owner == nil || (owner.Name == nodeReservation.Name && !isBMHHeld(nodeReservation, &bmh)) {
+				free.Items = append(free.Items, bmh)
+			}
+		}
+
+		// The reservation has no allocated nodes yet, so the placement constraints only apply among the newly held hosts
+		selected, err := ResourceSelectionScoring(ctx, r.NoncachedClient, r.Logger, r.PluginNamespace,
+			&pluginsv1alpha1.NodeAllocationRequest{}, nodeGroup, free, 0, pending)
+		if err != nil && !typederrors.IsPlacementError(err) {
+			return 0, 0, fmt.Errorf("unable to select BMHs for nodegroup=%s: %w", groupName, err)
+		}
+
+		for _, candidate := range selected {
+			reserved, err := r.reserveBMH(ctx, nodeReservation, candidate.BMH)
+			if err != nil {
+				return 0, 0, err
+			}
+			if !reserved {
+				continue
+			}
+			nodeReservation.Status.ReservedNodes = append(nodeReservation.Status.ReservedNodes,
+				pluginsv1alpha1.ReservedNode{
+					GroupName:   groupName,
+					HwMgrNodeId: candidate.BMH.Name,
+					HwMgrNodeNs: candidate.BMH.Namespace,
+				})
+			groupHeld++
+		}
+		held += min(groupHeld, nodeGroup.Size)
+	}
+	return held, needed, nil
+}
+
+// reserveBMH sets the reservation label on a host, unless another live reservation or an allocation took it in
+// the meantime. The label is set with an optimistic lock, so that two reservations cannot both hold the host.
+func (r *NodeReservationReconciler) reserveBMH(ctx context.Context,
+	nodeReservation *pluginsv1alpha1.NodeReservation, bmh *metal3v1alpha1.BareMetalHost) (bool, error) {
+
+	var latest metal3v1alpha1.BareMetalHost
+	if err := r.NoncachedClient.Get(ctx, types.NamespacedName{Name: bmh.Name, Namespace: bmh.Namespace},
+		&latest); err != nil {
+		return false, fmt.Errorf("failed to get BMH %s/%s: %w", bmh.Namespace, bmh.Name, err)
+	}
+	if isBMHAllocated(&latest) {
+		return false, nil
+	}
+	if owner := latest.Labels[BmhReservationLabel]; owner != "" && owner != nodeReservation.Name {
+		live, err := getLiveReservations(ctx, r.NoncachedClient, r.PluginNamespace)
+		if err != nil {
+			return false, err
+		}
+		if _, taken := live[owner]; taken {
+			return false, nil
+		}
+	}
+
+	patch := client.MergeFromWithOptions(latest.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	if latest.Labels == nil {
+		latest.Labels = make(map[string]string)
+	}
+	latest.Labels[BmhReservationLabel] = nodeReservation.Name
+	if err := r.Patch(ctx, &latest, patch); err != nil {
+		if errors.IsConflict(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to set reservation label on BMH %s/%s: %w", bmh.Namespace, bmh.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "Holding BMH for the reservation", slog.String("bmh", bmh.Name))
+	return true, nil
+}
+
+// releaseHosts removes the reservation label from the hosts held by a reservation
+func (r *NodeReservationReconciler) releaseHosts(ctx context.Context,
+	nodeReservation *pluginsv1alpha1.NodeReservation) error {
+
+	var bmhList metal3v1alpha1.BareMetalHostList
+	if err := r.NoncachedClient.List(ctx, &bmhList,
+		client.MatchingLabels{BmhReservationLabel: nodeReservation.Name}); err != nil {
+		return fmt.Errorf("failed to list the BMHs held by NodeReservation %s: %w", nodeReservation.Name, err)
+	}
+
+	for _, bmh := range bmhList.Items {
+		name := types.NamespacedName{Name: bmh.Name, Namespace: bmh.Namespace}
+		if err := updateBMHMetaWithRetry(ctx, r.Client, r.Logger, name, MetaTypeLabel, BmhReservationLabel, "",
+			OpRemove); err != nil {
+			return fmt.Errorf("failed to release BMH %s held by NodeReservation %s: %w", bmh.Name,
+				nodeReservation.Name, err)
+		}
+	}
+	return nil
+}
+
+// updateStatus records the held hosts and the Reserved condition of a reservation
+func (r *NodeReservationReconciler) updateStatus(ctx context.Context,
+	nodeReservation *pluginsv1alpha1.NodeReservation,
+	reason hwmgmtv1alpha1.ConditionReason,
+	status metav1.ConditionStatus,
+	message string) error {
+
+	reservedNodes := nodeReservation.Status.ReservedNodes
+	if reason == hwmgmtv1alpha1.Expired {
+		reservedNodes = nil
+	}
+
+	// nolint: wrapcheck
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &pluginsv1alpha1.NodeReservation{}
+		if err := r.NoncachedClient.Get(ctx, client.ObjectKeyFromObject(nodeReservation), latest); err != nil {
+			return err
+		}
+		latest.Status.ReservedNodes = reservedNodes
+		hwmgrutils.SetStatusCondition(&latest.Status.Conditions, string(hwmgmtv1alpha1.Reserved), string(reason),
+			status, message)
+		return r.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update NodeReservation %s status: %w", nodeReservation.Name, err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeReservationReconciler) SetupWithManager(mgr ctrl.Manager) error {
+
+	// Only reconcile the NodeReservations pertaining to the Metal3 HardwarePlugin
+	pred, err := predicate.LabelSelectorPredicate(metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			hwmgrutils.HardwarePluginLabel: hwmgrutils.Metal3HardwarePluginID,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create label selector predicate: %w", err)
+	}
+
+	if err := ctrl.NewControllerManagedBy(mgr).
+		For(&pluginsv1alpha1.NodeReservation{}).
+		WithEventFilter(pred).
+		Complete(r); err != nil {
+		return fmt.Errorf("failed to create controller: %w", err)
+	}
+
+	return nil
+}
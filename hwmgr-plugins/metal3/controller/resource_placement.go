@@ -0,0 +1,186 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file provides the placement constraints of resource selection. Unlike the Spread
+// scoring strategy, which only prefers some BareMetalHosts over others, the constraints set
+// in the NodeGroupData of the HardwareTemplate are hard requirements:
+//
+//   - AntiAffinity: the nodes of the group must be allocated on hosts with distinct values of
+//     a label, such as one control-plane node per rack.
+//
+//   - TopologySpreadConstraints: the number of nodes of the group in any two failure domains,
+//     the values of a label, may differ by at most maxSkew.
+//
+// Hosts missing a constrained label are not eligible. When the free hosts cannot satisfy the
+// constraints, the allocation fails with a PlacementError rather than allocating some of them.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+// nodePlacement tracks the failure domains of the nodes of a group while its hosts are selected
+type nodePlacement struct {
+	antiAffinity []hwmgmtv1alpha1.NodeAntiAffinity
+	spread       []hwmgmtv1alpha1.NodeTopologySpreadConstraint
+
+	// nodes counts the nodes of the group in each failure domain, per topology key. The failure domains of the
+	// free hosts are included with a count of 0.
+	nodes map[string]map[string]int
+}
+
+// newNodePlacement validates the placement constraints of a node group, and records the failure domains of its
+// existing nodes and of the free hosts matching it.
+//
+// Returns an input error when the placement constraints of the node group are invalid.
+func newNodePlacement(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	nodeGroup pluginsv1alpha1.NodeGroup,
+	candidates []*metal3v1alpha1.BareMetalHost) (*nodePlacement, error) {
+
+	groupData := nodeGroup.NodeGroupData
+	placement := &nodePlacement{
+		antiAffinity: groupData.AntiAffinity,
+		spread:       groupData.TopologySpreadConstraints,
+		nodes:        make(map[string]map[string]int),
+	}
+	if !placement.constrained() {
+		return placement, nil
+	}
+
+	for _, rule := range placement.antiAffinity {
+		if rule.TopologyKey == "" {
+			return nil, typederrors.NewInputError("the antiAffinity rules of nodegroup=%s require a topologyKey",
+				groupData.Name)
+		}
+		placement.nodes[rule.TopologyKey] = make(map[string]int)
+	}
+	for _, constraint := range placement.spread {
+		if constraint.TopologyKey == "" {
+			return nil, typederrors.NewInputError(
+				"the topologySpreadConstraints of nodegroup=%s require a topologyKey", groupData.Name)
+		}
+		if constraint.MaxSkew < 1 {
+			return nil, typederrors.NewInputError(
+				"the topologySpreadConstraint on %s of nodegroup=%s requires a maxSkew of at least 1",
+				constraint.TopologyKey, groupData.Name)
+		}
+		placement.nodes[constraint.TopologyKey] = make(map[string]int)
+	}
+
+	members, err := getGroupBMHs(ctx, c, logger, pluginNamespace, nodeAllocationRequest, groupData.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	for key, domains := range placement.nodes {
+		for _, bmh := range candidates {
+			if value := bmhLabelValue(bmh, key); value != "" {
+				if _, exists := domains[value]; !exists {
+					domains[value] = 0
+				}
+			}
+		}
+	}
+	for _, bmh := range members {
+		placement.place(bmh)
+	}
+	return placement, nil
+}
+
+// constrained returns whether the node group has placement constraints
+func (p *nodePlacement) constrained() bool {
+	return len(p.antiAffinity) > 0 || len(p.spread) > 0
+}
+
+// allows returns whether a node of the group can be allocated on a host without violating its placement constraints
+func (p *nodePlacement) allows(bmh *metal3v1alpha1.BareMetalHost) bool {
+	for _, rule := range p.antiAffinity {
+		value := bmhLabelValue(bmh, rule.TopologyKey)
+		if value == "" || p.nodes[rule.TopologyKey][value] > 0 {
+			return false
+		}
+	}
+
+	for _, constraint := range p.spread {
+		value := bmhLabelValue(bmh, constraint.TopologyKey)
+		if value == "" {
+			return false
+		}
+
+		domains := p.nodes[constraint.TopologyKey]
+		fewest := domains[value]
+		for _, nodes := range domains {
+			fewest = min(fewest, nodes)
+		}
+		if domains[value]+1-fewest > constraint.MaxSkew {
+			return false
+		}
+	}
+	return true
+}
+
+// place records the allocation of a node of the group on a host
+func (p *nodePlacement) place(bmh *metal3v1alpha1.BareMetalHost) {
+	for key, domains := range p.nodes {
+		if value := bmhLabelValue(bmh, key); value != "" {
+			domains[value]++
+		}
+	}
+}
+
+// pick places up to `count` nodes of the group on the candidates, in order of preference. A candidate that the
+// constraints do not allow may become allowed once other failure domains hold more nodes, so the remaining
+// candidates are walked again until no more of them can be placed.
+func (p *nodePlacement) pick(candidates []*metal3v1alpha1.BareMetalHost, count int) []*metal3v1alpha1.BareMetalHost {
+	picked := make([]*metal3v1alpha1.BareMetalHost, 0, count)
+	remaining := append([]*metal3v1alpha1.BareMetalHost{}, candidates...)
+	for len(picked) < count {
+		next := slices.IndexFunc(remaining, p.allows)
+		if next < 0 {
+			break
+		}
+		picked = append(picked, remaining[next])
+		p.place(remaining[next])
+		remaining = slices.Delete(remaining, next, next+1)
+	}
+	return picked
+}
+
+// String describes the placement constraints, for the messages of the placement errors
+func (p *nodePlacement) String() string {
+	constraints := make([]string, 0, len(p.antiAffinity)+len(p.spread))
+	for _, rule := range p.antiAffinity {
+		constraints = append(constraints, fmt.Sprintf("antiAffinity on %s", rule.TopologyKey))
+	}
+	for _, constraint := range p.spread {
+		constraints = append(constraints, fmt.Sprintf("topologySpreadConstraint on %s with maxSkew %d",
+			constraint.TopologyKey, constraint.MaxSkew))
+	}
+	return strings.Join(constraints, ", ")
+}
+
+// unsatisfiable returns the PlacementError of a node group for which fewer hosts than needed could be selected
+func (p *nodePlacement) unsatisfiable(nodeGroup pluginsv1alpha1.NodeGroup, placed, needed, candidates int) error {
+	return typederrors.NewPlacementError(
+		"placement constraints of nodegroup=%s cannot be satisfied: only %d of the %d pending nodes can be "+
+			"allocated on the %d free hosts matching the node group (%s)",
+		nodeGroup.NodeGroupData.Name, placed, needed, candidates, p)
+}
@@ -0,0 +1,194 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+var _ = Describe("Resource Placement", func() {
+	const (
+		pluginNamespace = "hwmgr"
+		bmhNamespace    = "hosts"
+	)
+
+	var (
+		ctx                   context.Context
+		logger                *slog.Logger
+		scheme                *runtime.Scheme
+		nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = slog.New(slog.DiscardHandler)
+		scheme = runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hwmgmtv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		nodeAllocationRequest = &pluginsv1alpha1.NodeAllocationRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "nar-1", Namespace: pluginNamespace},
+		}
+	})
+
+	// newBMH creates a host in a rack, or without a rack label when rack is empty
+	newBMH := func(name, rack string, threads int) *metal3v1alpha1.BareMetalHost {
+		bmh := &metal3v1alpha1.BareMetalHost{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bmhNamespace},
+			Status: metal3v1alpha1.BareMetalHostStatus{
+				HardwareDetails: &metal3v1alpha1.HardwareDetails{
+					CPU:          metal3v1alpha1.CPU{Count: threads},
+					RAMMebibytes: 1024,
+				},
+			},
+		}
+		if rack != "" {
+			bmh.Labels = map[string]string{LabelPrefixResourceSelector + "rack": rack}
+		}
+		return bmh
+	}
+
+	bmhList := func(bmhs ...*metal3v1alpha1.BareMetalHost) metal3v1alpha1.BareMetalHostList {
+		var list metal3v1alpha1.BareMetalHostList
+		for _, bmh := range bmhs {
+			list.Items = append(list.Items, *bmh)
+		}
+		return list
+	}
+
+	nodeGroup := func(scoring *hwmgmtv1alpha1.NodeScoring, antiAffinity []hwmgmtv1alpha1.NodeAntiAffinity,
+		spread []hwmgmtv1alpha1.NodeTopologySpreadConstraint) pluginsv1alpha1.NodeGroup {
+		return pluginsv1alpha1.NodeGroup{
+			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
+				Name:                      "controller",
+				HwProfile:                 "profile-1",
+				Scoring:                   scoring,
+				AntiAffinity:              antiAffinity,
+				TopologySpreadConstraints: spread,
+			},
+			Size: 3,
+		}
+	}
+
+	names := func(selected []ScoredBMH) []string {
+		var result []string
+		for _, candidate := range selected {
+			result = append(result, candidate.BMH.Name)
+		}
+		return result
+	}
+
+	rackAntiAffinity := []hwmgmtv1alpha1.NodeAntiAffinity{{TopologyKey: "rack"}}
+
+	It("allocates the nodes of the group in distinct racks with an anti-affinity rule", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(newBMH("host-a", "r1", 8), newBMH("host-b", "r1", 8), newBMH("host-c", "", 8),
+			newBMH("host-d", "r2", 8), newBMH("host-e", "r3", 8))
+
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(nil, rackAntiAffinity, nil), list, 0, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(selected)).To(Equal([]string{"host-a", "host-d", "host-e"}))
+		Expect(selected[2].Rationale.Rank).To(Equal(3))
+	})
+
+	It("avoids the racks of the existing nodes of the group", func() {
+		existing := newBMH("host-0", "r1", 8)
+		node := &pluginsv1alpha1.AllocatedNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-0", Namespace: pluginNamespace},
+			Spec: pluginsv1alpha1.AllocatedNodeSpec{
+				GroupName:   "controller",
+				HwMgrNodeId: existing.Name,
+				HwMgrNodeNs: existing.Namespace,
+			},
+		}
+		nodeAllocationRequest.Status.Properties.NodeNames = []string{node.Name}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing, node).Build()
+
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyBestFit}, rackAntiAffinity, nil),
+			bmhList(newBMH("host-a", "r1", 8), newBMH("host-b", "r2", 16)), 0, 1)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(selected)).To(Equal([]string{"host-b"}))
+	})
+
+	It("bounds the skew between racks with a topology spread constraint", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(newBMH("host-a", "r1", 8), newBMH("host-b", "r1", 8), newBMH("host-c", "r1", 8),
+			newBMH("host-d", "r2", 64))
+
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyBestFit}, nil,
+				[]hwmgmtv1alpha1.NodeTopologySpreadConstraint{{TopologyKey: "rack", MaxSkew: 1}}), list, 0, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(selected)).To(Equal([]string{"host-a", "host-d", "host-b"}))
+	})
+
+	It("applies the placement constraints to the Spread scoring strategy", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		powered := func(bmh *metal3v1alpha1.BareMetalHost, power string) *metal3v1alpha1.BareMetalHost {
+			bmh.Labels["power"] = power
+			return bmh
+		}
+		list := bmhList(powered(newBMH("host-a", "r1", 8), "p1"), powered(newBMH("host-b", "r2", 8), "p1"),
+			powered(newBMH("host-c", "r2", 8), "p2"))
+
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategySpread, SpreadLabels: []string{"rack"}},
+				[]hwmgmtv1alpha1.NodeAntiAffinity{{TopologyKey: "power"}}, nil), list, 0, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(selected)).To(Equal([]string{"host-a", "host-c"}))
+	})
+
+	It("fails with a placement error when the constraints cannot be satisfied", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(newBMH("host-a", "r1", 8), newBMH("host-b", "r1", 8), newBMH("host-c", "r2", 8))
+
+		_, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(nil, rackAntiAffinity, nil), list, 0, 3)
+		Expect(typederrors.IsPlacementError(err)).To(BeTrue())
+		Expect(err.Error()).To(Equal("placement constraints of nodegroup=controller cannot be satisfied: only 2 of " +
+			"the 3 pending nodes can be allocated on the 3 free hosts matching the node group (antiAffinity on rack)"))
+	})
+
+	It("rejects invalid placement constraints", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(newBMH("host-a", "r1", 8))
+
+		for _, group := range []pluginsv1alpha1.NodeGroup{
+			nodeGroup(nil, []hwmgmtv1alpha1.NodeAntiAffinity{{}}, nil),
+			nodeGroup(nil, nil, []hwmgmtv1alpha1.NodeTopologySpreadConstraint{{TopologyKey: "rack"}}),
+		} {
+			_, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest, group, list, 0, 1)
+			Expect(typederrors.IsInputError(err)).To(BeTrue())
+		}
+	})
+
+	It("ignores the placement when the node group has no constraints", func() {
+		var c client.Reader = fake.NewClientBuilder().WithScheme(scheme).Build()
+		placement, err := newNodePlacement(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(nil, nil, nil), nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(placement.constrained()).To(BeFalse())
+		Expect(placement.allows(newBMH("host-a", "", 8))).To(BeTrue())
+	})
+})
@@ -0,0 +1,258 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file provides the hardware reservations of the metal3 plugin. A NodeReservation holds free
+// BareMetalHosts matching its node groups for a planned deployment, by setting the reservation label
+// on them, until it expires or is deleted:
+//
+//   - Hosts held by a live reservation are not allocated to the NodeAllocationRequests of other
+//     clusters, nor held by other reservations.
+//
+//   - The NodeAllocationRequests of the cluster the hardware is held for allocate the held hosts of
+//     their node group first, and only fall back to the free hosts when too few of them are held.
+//
+// The reservation label left on a host by an expired or deleted reservation is ignored, so that the
+// host is free even before the reservation controller removes it.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+)
+
+// BmhReservationLabel is set on a BareMetalHost held by a NodeReservation, to the name of the reservation
+const BmhReservationLabel = "clcm.openshift.io/reservation"
+
+// reservationIsLive returns whether a reservation still holds its hosts at the given time
+func reservationIsLive(nodeReservation *pluginsv1alpha1.NodeReservation, now time.Time) bool {
+	return nodeReservation.DeletionTimestamp == nil && now.Before(nodeReservation.Spec.ExpiresAt.Time)
+}
+
+// getLiveReservations returns the reservations of the plugin namespace that still hold their hosts, by name
+func getLiveReservations(ctx context.Context,
+	c client.Reader,
+	pluginNamespace string) (map[string]*pluginsv1alpha1.NodeReservation, error) {
+
+	var nodeReservations pluginsv1alpha1.NodeReservationList
+	if err := c.List(ctx, &nodeReservations, client.InNamespace(pluginNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list NodeReservations: %w", err)
+	}
+
+	now := time.Now()
+	live := make(map[string]*pluginsv1alpha1.NodeReservation)
+	for i := range nodeReservations.Items {
+		if reservationIsLive(&nodeReservations.Items[i], now) {
+			live[nodeReservations.Items[i].Name] = &nodeReservations.Items[i]
+		}
+	}
+	return live, nil
+}
+
+// bmhReservation returns the live reservation holding a host, or nil when the host is free
+func bmhReservation(bmh *metal3v1alpha1.BareMetalHost,
+	live map[string]*pluginsv1alpha1.NodeReservation) *pluginsv1alpha1.NodeReservation {
+	return live[bmh.Labels[BmhReservationLabel]]
+}
+
+// isBMHReservedFor returns whether a reservation holds a host for the given node group
+func isBMHReservedFor(nodeReservation *pluginsv1alpha1.NodeReservation,
+	bmh *metal3v1alpha1.BareMetalHost, groupName string) bool {
+	for _, node := range nodeReservation.Status.ReservedNodes {
+		if node.GroupName == groupName && node.HwMgrNodeId == bmh.Name && node.HwMgrNodeNs == bmh.Namespace {
+			return true
+		}
+	}
+	return false
+}
+
+// isBMHHeld returns whether a reservation holds a host for any of its node groups
+func isBMHHeld(nodeReservation *pluginsv1alpha1.NodeReservation, bmh *metal3v1alpha1.BareMetalHost) bool {
+	for _, nodeGroup := range nodeReservation.Spec.NodeGroup {
+		if isBMHReservedFor(nodeReservation, bmh, nodeGroup.NodeGroupData.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// applyReservations restricts the free hosts matching a node group of a NodeAllocationRequest to the ones it may
+// allocate. Hosts held for another cluster or node group are removed. When the live reservations of the cluster
+// hold at least `pending` hosts for the node group, only those hosts are returned; otherwise the held hosts come
+// first, followed by the hosts that no reservation holds. The number of held hosts is returned along with them.
+func applyReservations(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	groupName string,
+	pending int,
+	bmhList metal3v1alpha1.BareMetalHostList) (metal3v1alpha1.BareMetalHostList, int, error) {
+
+	live, err := getLiveReservations(ctx, c, pluginNamespace)
+	if err != nil {
+		return bmhList, 0, err
+	}
+	if len(live) == 0 {
+		return bmhList, 0, nil
+	}
+
+	var held, free []metal3v1alpha1.BareMetalHost
+	for i := range bmhList.Items {
+		bmh := &bmhList.Items[i]
+		nodeReservation := bmhReservation(bmh, live)
+		switch {
+		case nodeReservation == nil:
+			free = append(free, *bmh)
+		case nodeReservation.Spec.ClusterId == nodeAllocationRequest.Spec.ClusterId &&
+			isBMHReservedFor(nodeReservation, bmh, groupName):
+			held = append(held, *bmh)
+		default:
+			logger.DebugContext(ctx, "Skipping BMH held by a reservation",
+				slog.String("bmh", bmh.Name),
+				slog.String("reservation", nodeReservation.Name))
+		}
+	}
+
+	if len(held) > 0 {
+		logger.InfoContext(ctx, "Allocating hosts held by a reservation",
+			slog.String("nodegroup", groupName),
+			slog.Int("held", len(held)),
+			slog.Int("pending", pending))
+	}
+
+	filtered := metal3v1alpha1.BareMetalHostList{Items: held}
+	if len(held) < pending {
+		filtered.Items = append(filtered.Items, free...)
+	}
+	return filtered, len(held), nil
+}
+
+// GetCapacity counts the hosts of a site or resource pool that can be configured with a HardwareProfile by state:
+// allocated, held by a live reservation, or free.
+func GetCapacity(ctx context.Context,
+	c client.Reader,
+	pluginNamespace string,
+	params provisioning.GetCapacityParams) (provisioning.GetCapacityResponseObject, error) {
+
+	hwProfile := &hwmgmtv1alpha1.HardwareProfile{}
+	if err := c.Get(ctx, types.NamespacedName{Name: params.HwProfile, Namespace: pluginNamespace}, hwProfile); err != nil {
+		if errors.IsNotFound(err) {
+			return provisioning.GetCapacity404ApplicationProblemPlusJSONResponse(provisioning.ProblemDetails{
+				Detail: fmt.Sprintf("could not find HardwareProfile '%s'", params.HwProfile),
+				Status: http.StatusNotFound,
+			}), nil
+		}
+		return nil, fmt.Errorf("failed to get HardwareProfile '%s': %w", params.HwProfile, err)
+	}
+
+	matchingLabels := client.MatchingLabels{}
+	if params.Site != nil {
+		matchingLabels[LabelSiteID] = *params.Site
+	}
+	if params.ResourcePoolId != nil {
+		matchingLabels[LabelResourcePoolID] = *params.ResourcePoolId
+	}
+	var bmhList metal3v1alpha1.BareMetalHostList
+	if err := c.List(ctx, &bmhList, matchingLabels); err != nil {
+		return nil, fmt.Errorf("failed to list BareMetalHosts: %w", err)
+	}
+
+	live, err := getLiveReservations(ctx, c, pluginNamespace)
+	if err != nil {
+		return nil, err
+	}
+
+	capacity := provisioning.Capacity{
+		HwProfile:      params.HwProfile,
+		ResourcePoolId: params.ResourcePoolId,
+		Site:           params.Site,
+	}
+	for i := range bmhList.Items {
+		bmh := &bmhList.Items[i]
+		if !includeInInventory(bmh) {
+			continue
+		}
+		supported, err := isHardwareProfileSupported(ctx, c, bmh, hwProfile)
+		if err != nil {
+			return nil, err
+		}
+		if !supported {
+			continue
+		}
+		capacity.Total++
+		switch {
+		case isBMHAllocated(bmh):
+			capacity.Allocated++
+		case bmhReservation(bmh, live) != nil:
+			capacity.Reserved++
+		default:
+			capacity.Free++
+		}
+	}
+	return provisioning.GetCapacity200JSONResponse(capacity), nil
+}
+
+// isHardwareProfileSupported returns whether a host can be configured with a HardwareProfile: the host must have
+// the BIOS settings and the NIC slots that the profile configures, as reported by its HostFirmwareSettings and
+// HostFirmwareComponents. A host that was not inspected yet can't be counted.
+func isHardwareProfileSupported(ctx context.Context,
+	c client.Reader,
+	bmh *metal3v1alpha1.BareMetalHost,
+	hwProfile *hwmgmtv1alpha1.HardwareProfile) (bool, error) {
+
+	if len(hwProfile.Spec.Bios.Attributes) > 0 {
+		hfs, err := getHostFirmwareSettings(ctx, c, bmh.Name, bmh.Namespace)
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		for name := range hwProfile.Spec.Bios.Attributes {
+			if _, exists := hfs.Status.Settings[name]; !exists {
+				return false, nil
+			}
+		}
+	}
+
+	var slots []string
+	for _, nic := range hwProfile.Spec.NicFirmware {
+		if nic.Slot != "" {
+			slots = append(slots, "nic:"+nic.Slot)
+		}
+	}
+	if len(slots) == 0 {
+		return true, nil
+	}
+	hfc, err := getHostFirmwareComponents(ctx, c, bmh.Name, bmh.Namespace)
+	if errors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	for _, slot := range slots {
+		if !slices.ContainsFunc(hfc.Status.Components, func(component metal3v1alpha1.FirmwareComponentStatus) bool {
+			return component.Component == slot
+		}) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -0,0 +1,311 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+)
+
+var _ = Describe("Resource Reservation", func() {
+	const (
+		pluginNamespace = "hwmgr"
+		bmhNamespace    = "hosts"
+	)
+
+	var (
+		ctx    context.Context
+		logger *slog.Logger
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = slog.New(slog.DiscardHandler)
+		scheme = runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hwmgmtv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	// newBMH creates an available host of pool p1 at site s1, held by the given reservation unless it is empty
+	newBMH := func(name, reservation string) *metal3v1alpha1.BareMetalHost {
+		bmh := &metal3v1alpha1.BareMetalHost{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: bmhNamespace,
+				Labels: map[string]string{
+					LabelSiteID:         "s1",
+					LabelResourcePoolID: "p1",
+				},
+			},
+			Status: metal3v1alpha1.BareMetalHostStatus{
+				Provisioning: metal3v1alpha1.ProvisionStatus{State: metal3v1alpha1.StateAvailable},
+			},
+		}
+		if reservation != "" {
+			bmh.Labels[BmhReservationLabel] = reservation
+		}
+		return bmh
+	}
+
+	allocated := func(bmh *metal3v1alpha1.BareMetalHost) *metal3v1alpha1.BareMetalHost {
+		bmh.Labels[BmhAllocatedLabel] = ValueTrue
+		return bmh
+	}
+
+	newReservation := func(name, clusterID string, size int, expiresIn time.Duration,
+		held ...string) *pluginsv1alpha1.NodeReservation {
+		nodeReservation := &pluginsv1alpha1.NodeReservation{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pluginNamespace},
+			Spec: pluginsv1alpha1.NodeReservationSpec{
+				ClusterId:    clusterID,
+				LocationSpec: pluginsv1alpha1.LocationSpec{Site: "s1"},
+				NodeGroup: []pluginsv1alpha1.NodeGroup{{
+					NodeGroupData: hwmgmtv1alpha1.NodeGroupData{Name: "worker", HwProfile: "profile-1"},
+					Size:          size,
+				}},
+				ExpiresAt: metav1.NewTime(time.Now().Add(expiresIn)),
+			},
+		}
+		for _, name := range held {
+			nodeReservation.Status.ReservedNodes = append(nodeReservation.Status.ReservedNodes,
+				pluginsv1alpha1.ReservedNode{GroupName: "worker", HwMgrNodeId: name, HwMgrNodeNs: bmhNamespace})
+		}
+		return nodeReservation
+	}
+
+	names := func(bmhs []metal3v1alpha1.BareMetalHost) []string {
+		var result []string
+		for _, bmh := range bmhs {
+			result = append(result, bmh.Name)
+		}
+		return result
+	}
+
+	Describe("NodeReservationReconciler", func() {
+		var (
+			c          client.Client
+			reconciler *NodeReservationReconciler
+		)
+
+		// setup creates the objects, with the HardwareData of the hosts that the secondary filter requires
+		setup := func(objs ...client.Object) {
+			for _, obj := range objs {
+				if bmh, ok := obj.(*metal3v1alpha1.BareMetalHost); ok {
+					objs = append(objs, &metal3v1alpha1.HardwareData{
+						ObjectMeta: metav1.ObjectMeta{Name: bmh.Name, Namespace: bmh.Namespace},
+						Spec:       metal3v1alpha1.HardwareDataSpec{HardwareDetails: &metal3v1alpha1.HardwareDetails{}},
+					})
+				}
+			}
+			c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithStatusSubresource(&pluginsv1alpha1.NodeReservation{}).Build()
+			reconciler = &NodeReservationReconciler{
+				Client:          c,
+				NoncachedClient: c,
+				Scheme:          scheme,
+				Logger:          logger,
+				PluginNamespace: pluginNamespace,
+			}
+		}
+
+		reconcile := func(name string) ctrl.Result {
+			result, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{
+				Namespace: pluginNamespace, Name: name}})
+			Expect(err).NotTo(HaveOccurred())
+			return result
+		}
+
+		reservedCondition := func(name string) *metav1.Condition {
+			nodeReservation := &pluginsv1alpha1.NodeReservation{}
+			Expect(c.Get(ctx, client.ObjectKey{Namespace: pluginNamespace, Name: name}, nodeReservation)).To(Succeed())
+			return meta.FindStatusCondition(nodeReservation.Status.Conditions, string(hwmgmtv1alpha1.Reserved))
+		}
+
+		heldBy := func(name string) []string {
+			var bmhList metal3v1alpha1.BareMetalHostList
+			Expect(c.List(ctx, &bmhList, client.MatchingLabels{BmhReservationLabel: name})).To(Succeed())
+			return names(bmhList.Items)
+		}
+
+		It("holds free hosts for the node groups of the reservation until it expires", func() {
+			setup(newReservation("res-1", "cluster-1", 2, time.Hour),
+				allocated(newBMH("host-a", "")), newBMH("host-b", ""), newBMH("host-c", ""))
+
+			result := reconcile("res-1")
+			Expect(result.RequeueAfter).To(BeNumerically(">", 59*time.Minute))
+			Expect(heldBy("res-1")).To(ConsistOf("host-b", "host-c"))
+
+			condition := reservedCondition("res-1")
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(condition.Reason).To(Equal(string(hwmgmtv1alpha1.Completed)))
+		})
+
+		It("leaves the hosts held by other live reservations, and waits for free hosts", func() {
+			setup(newReservation("res-1", "cluster-1", 1, time.Hour, "host-a"),
+				newReservation("res-2", "cluster-2", 2, time.Hour),
+				newBMH("host-a", "res-1"), newBMH("host-b", ""))
+
+			result := reconcile("res-2")
+			Expect(result.RequeueAfter).To(Equal(time.Minute))
+			Expect(heldBy("res-2")).To(ConsistOf("host-b"))
+			Expect(heldBy("res-1")).To(ConsistOf("host-a"))
+
+			condition := reservedCondition("res-2")
+			Expect(condition.Status).To(Equal(metav1.ConditionFalse))
+			Expect(condition.Reason).To(Equal(string(hwmgmtv1alpha1.InProgress)))
+			Expect(condition.Message).To(Equal("Holding 1 of the 2 requested hosts, waiting for free hosts"))
+		})
+
+		It("releases the held hosts once the reservation expires", func() {
+			setup(newReservation("res-1", "cluster-1", 1, -time.Minute, "host-a"), newBMH("host-a", "res-1"))
+
+			Expect(reconcile("res-1")).To(Equal(ctrl.Result{}))
+			Expect(heldBy("res-1")).To(BeEmpty())
+
+			condition := reservedCondition("res-1")
+			Expect(condition.Reason).To(Equal(string(hwmgmtv1alpha1.Expired)))
+		})
+
+		It("releases the held hosts when the reservation is deleted", func() {
+			nodeReservation := newReservation("res-1", "cluster-1", 1, time.Hour)
+			setup(nodeReservation, newBMH("host-a", ""))
+			reconcile("res-1")
+			Expect(heldBy("res-1")).To(ConsistOf("host-a"))
+
+			Expect(c.Delete(ctx, nodeReservation)).To(Succeed())
+			reconcile("res-1")
+			Expect(heldBy("res-1")).To(BeEmpty())
+		})
+	})
+
+	Describe("applyReservations", func() {
+		var bmhList metal3v1alpha1.BareMetalHostList
+
+		BeforeEach(func() {
+			bmhList = metal3v1alpha1.BareMetalHostList{Items: []metal3v1alpha1.BareMetalHost{
+				*newBMH("host-a", "res-1"), *newBMH("host-b", "res-2"), *newBMH("host-c", ""), *newBMH("host-d", "res-3"),
+			}}
+		})
+
+		apply := func(clusterID string, pending int) []string {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				newReservation("res-1", "cluster-1", 1, time.Hour, "host-a"),
+				newReservation("res-2", "cluster-2", 1, time.Hour, "host-b"),
+				newReservation("res-3", "cluster-3", 1, -time.Minute, "host-d")).Build()
+			nodeAllocationRequest := &pluginsv1alpha1.NodeAllocationRequest{
+				Spec: pluginsv1alpha1.NodeAllocationRequestSpec{ClusterId: clusterID},
+			}
+
+			filtered, _, err := applyReservations(ctx, c, logger, pluginNamespace, nodeAllocationRequest, "worker",
+				pending, bmhList)
+			Expect(err).NotTo(HaveOccurred())
+			return names(filtered.Items)
+		}
+
+		It("only allocates the held hosts when they are enough", func() {
+			Expect(apply("cluster-1", 1)).To(Equal([]string{"host-a"}))
+		})
+
+		It("allocates the held hosts first, then the free ones", func() {
+			Expect(apply("cluster-1", 2)).To(Equal([]string{"host-a", "host-c", "host-d"}))
+		})
+
+		It("leaves the hosts held for other clusters", func() {
+			Expect(apply("cluster-4", 2)).To(Equal([]string{"host-c", "host-d"}))
+		})
+	})
+
+	Describe("GetCapacity", func() {
+		It("counts the allocated, reserved and free hosts of the pool", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+				&hwmgmtv1alpha1.HardwareProfile{ObjectMeta: metav1.ObjectMeta{Name: "profile-1", Namespace: pluginNamespace}},
+				newReservation("res-1", "cluster-1", 1, time.Hour, "host-b"),
+				allocated(newBMH("host-a", "")), newBMH("host-b", "res-1"), newBMH("host-c", ""),
+				newBMH("host-d", "expired-reservation")).Build()
+
+			pool := "p1"
+			response, err := GetCapacity(ctx, c, pluginNamespace, provisioning.GetCapacityParams{
+				HwProfile: "profile-1", ResourcePoolId: &pool})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(provisioning.GetCapacity200JSONResponse(provisioning.Capacity{
+				HwProfile:      "profile-1",
+				ResourcePoolId: &pool,
+				Total:          4,
+				Allocated:      1,
+				Reserved:       1,
+				Free:           2,
+			})))
+		})
+
+		It("only counts the hosts that can be configured with the HardwareProfile", func() {
+			hwProfile := &hwmgmtv1alpha1.HardwareProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "profile-1", Namespace: pluginNamespace},
+				Spec: hwmgmtv1alpha1.HardwareProfileSpec{
+					Bios: hwmgmtv1alpha1.Bios{Attributes: map[string]intstr.IntOrString{
+						"SriovGlobalEnable": intstr.FromString("Enabled"),
+					}},
+					NicFirmware: map[string]hwmgmtv1alpha1.Nic{"nic-1": {Slot: "1", Version: "1.2.3"}},
+				},
+			}
+			firmware := func(name string, settings map[string]string, components ...string) []client.Object {
+				hfs := &metal3v1alpha1.HostFirmwareSettings{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bmhNamespace},
+					Status:     metal3v1alpha1.HostFirmwareSettingsStatus{Settings: settings},
+				}
+				hfc := &metal3v1alpha1.HostFirmwareComponents{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bmhNamespace},
+				}
+				for _, component := range components {
+					hfc.Status.Components = append(hfc.Status.Components,
+						metal3v1alpha1.FirmwareComponentStatus{Component: component})
+				}
+				return []client.Object{hfs, hfc}
+			}
+
+			objects := []client.Object{hwProfile,
+				newBMH("host-a", ""), newBMH("host-b", ""), newBMH("host-c", ""), newBMH("host-d", "")}
+			objects = append(objects, firmware("host-a", map[string]string{"SriovGlobalEnable": "Disabled"}, "bios", "nic:1")...)
+			objects = append(objects, firmware("host-b", map[string]string{}, "bios", "nic:1")...)
+			objects = append(objects, firmware("host-c", map[string]string{"SriovGlobalEnable": "Enabled"}, "bios")...)
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+			response, err := GetCapacity(ctx, c, pluginNamespace, provisioning.GetCapacityParams{HwProfile: "profile-1"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(Equal(provisioning.GetCapacity200JSONResponse(provisioning.Capacity{
+				HwProfile: "profile-1",
+				Total:     1,
+				Free:      1,
+			})))
+		})
+
+		It("returns 404 for an unknown HardwareProfile", func() {
+			c := fake.NewClientBuilder().WithScheme(scheme).Build()
+			response, err := GetCapacity(ctx, c, pluginNamespace, provisioning.GetCapacityParams{HwProfile: "missing"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(response).To(BeAssignableToTypeOf(provisioning.GetCapacity404ApplicationProblemPlusJSONResponse{}))
+		})
+	})
+})
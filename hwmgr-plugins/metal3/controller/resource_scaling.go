@@ -0,0 +1,154 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file provides the scaling of the node groups of a provisioned NodeAllocationRequest. When the
+// size of a node group changes, the plugin brings the number of AllocatedNodes of the group to its new
+// size before configuring the nodes:
+//
+//   - A group that grew allocates the missing nodes the same way as on creation, honoring the
+//     reservations, scoring and placement constraints of the group.
+//
+//   - A group that shrank releases the surplus nodes listed in its nodesToRemove. Their AllocatedNodes
+//     are deleted, and the AllocatedNode finalizer returns their BareMetalHosts to the pool. The plugin
+//     never picks the nodes to release by itself, as they may still run workloads.
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+// selectNodesToRelease returns the nodes of a group to release so that it has no more nodes than its size.
+// The nodes are taken from the nodesToRemove of the group, and it is an input error when too few of the
+// surplus nodes are listed.
+func selectNodesToRelease(group pluginsv1alpha1.NodeGroup, nodes []*pluginsv1alpha1.AllocatedNode) (
+	[]*pluginsv1alpha1.AllocatedNode, error) {
+
+	surplus := len(nodes) - group.Size
+	if surplus <= 0 {
+		return nil, nil
+	}
+
+	var toRelease []*pluginsv1alpha1.AllocatedNode
+	for _, node := range nodes {
+		if len(toRelease) == surplus {
+			break
+		}
+		if slices.Contains(group.NodesToRemove, node.Name) {
+			toRelease = append(toRelease, node)
+		}
+	}
+	if len(toRelease) < surplus {
+		return nil, typederrors.NewInputError(
+			"nodegroup=%s has %d nodes for a size of %d, but only %d of them are listed in nodesToRemove",
+			group.NodeGroupData.Name, len(nodes), group.Size, len(toRelease))
+	}
+	return toRelease, nil
+}
+
+// releaseAllocatedNode deletes an AllocatedNode released by a node group, and removes it from the nodes of
+// the NodeAllocationRequest. The AllocatedNode finalizer deallocates its BareMetalHost.
+func releaseAllocatedNode(ctx context.Context,
+	c client.Client,
+	logger *slog.Logger,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	node *pluginsv1alpha1.AllocatedNode) error {
+
+	logger.InfoContext(ctx, "Releasing AllocatedNode removed from its node group",
+		slog.String("node", node.Name),
+		slog.String("nodegroup", node.Spec.GroupName))
+
+	if err := c.Delete(ctx, node); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete AllocatedNode %s: %w", node.Name, err)
+	}
+
+	nodeNames := slices.DeleteFunc(slices.Clone(nodeAllocationRequest.Status.Properties.NodeNames),
+		func(name string) bool { return name == node.Name })
+	if len(nodeNames) != len(nodeAllocationRequest.Status.Properties.NodeNames) {
+		nodeAllocationRequest.Status.Properties.NodeNames = nodeNames
+		if err := hwmgrutils.UpdateNodeAllocationRequestProperties(ctx, c, nodeAllocationRequest); err != nil {
+			return fmt.Errorf("failed to update NodeAllocationRequest properties after releasing node %s: %w",
+				node.Name, err)
+		}
+	}
+	return nil
+}
+
+// scaleNodeAllocationRequest brings the number of AllocatedNodes of each node group of a NodeAllocationRequest
+// to the size of the group, releasing the surplus nodes and allocating the missing ones. It returns true once
+// every group has exactly its size and no released node is still being deleted.
+func scaleNodeAllocationRequest(ctx context.Context,
+	c client.Client,
+	noncachedClient client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, bool, error) {
+
+	nodelist, err := hwmgrutils.GetChildNodes(ctx, logger, c, nodeAllocationRequest)
+	if err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), false,
+			fmt.Errorf("failed to get child nodes for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	releasing := false
+	groupNodes := make(map[string][]*pluginsv1alpha1.AllocatedNode)
+	for i := range nodelist.Items {
+		node := &nodelist.Items[i]
+		if node.DeletionTimestamp != nil {
+			releasing = true
+			continue
+		}
+		groupNodes[node.Spec.GroupName] = append(groupNodes[node.Spec.GroupName], node)
+	}
+
+	growing := false
+	for _, group := range nodeAllocationRequest.Spec.NodeGroup {
+		nodes := groupNodes[group.NodeGroupData.Name]
+		if len(nodes) < group.Size {
+			growing = true
+			continue
+		}
+
+		toRelease, err := selectNodesToRelease(group, nodes)
+		if err != nil {
+			return hwmgrutils.DoNotRequeue(), false, err
+		}
+		for _, node := range toRelease {
+			if err := releaseAllocatedNode(ctx, c, logger, nodeAllocationRequest, node); err != nil {
+				return hwmgrutils.RequeueWithShortInterval(), false, err
+			}
+			releasing = true
+		}
+	}
+
+	if growing {
+		logger.InfoContext(ctx, "Allocating the nodes added to the node groups")
+		res, err := processNodeAllocationRequestAllocation(ctx, c, noncachedClient, logger, pluginNamespace,
+			nodeAllocationRequest)
+		if err != nil || res.Requeue || res.RequeueAfter > 0 {
+			return res, false, err
+		}
+		// Check the allocated nodes again once the allocation is persisted
+		return hwmgrutils.RequeueImmediately(), false, nil
+	}
+
+	if releasing {
+		logger.InfoContext(ctx, "Waiting for the released nodes to be deleted")
+		return hwmgrutils.RequeueWithShortInterval(), false, nil
+	}
+
+	return ctrl.Result{}, true, nil
+}
@@ -0,0 +1,174 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+var _ = Describe("Resource Scaling", func() {
+	const pluginNamespace = "hwmgr"
+
+	var (
+		ctx    context.Context
+		logger *slog.Logger
+		scheme *runtime.Scheme
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = slog.New(slog.DiscardHandler)
+		scheme = runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hwmgmtv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	newGroup := func(size int, nodesToRemove ...string) pluginsv1alpha1.NodeGroup {
+		return pluginsv1alpha1.NodeGroup{
+			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{Name: "worker", HwProfile: "profile-1"},
+			Size:          size,
+			NodesToRemove: nodesToRemove,
+		}
+	}
+
+	newNode := func(name string) *pluginsv1alpha1.AllocatedNode {
+		return &pluginsv1alpha1.AllocatedNode{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: pluginNamespace},
+			Spec: pluginsv1alpha1.AllocatedNodeSpec{
+				NodeAllocationRequest: "nar-1",
+				GroupName:             "worker",
+			},
+		}
+	}
+
+	nodeNames := func(nodes []*pluginsv1alpha1.AllocatedNode) []string {
+		var result []string
+		for _, node := range nodes {
+			result = append(result, node.Name)
+		}
+		return result
+	}
+
+	Describe("selectNodesToRelease", func() {
+		nodes := func() []*pluginsv1alpha1.AllocatedNode {
+			return []*pluginsv1alpha1.AllocatedNode{newNode("node-a"), newNode("node-b"), newNode("node-c")}
+		}
+
+		It("releases nothing when the group is not above its size", func() {
+			toRelease, err := selectNodesToRelease(newGroup(3, "node-a"), nodes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(toRelease).To(BeEmpty())
+		})
+
+		It("releases the surplus nodes listed in nodesToRemove", func() {
+			toRelease, err := selectNodesToRelease(newGroup(2, "node-b", "released-node"), nodes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodeNames(toRelease)).To(Equal([]string{"node-b"}))
+		})
+
+		It("never releases more nodes than the surplus", func() {
+			toRelease, err := selectNodesToRelease(newGroup(2, "node-a", "node-c"), nodes())
+			Expect(err).NotTo(HaveOccurred())
+			Expect(nodeNames(toRelease)).To(Equal([]string{"node-a"}))
+		})
+
+		It("fails with an input error when too few surplus nodes are listed", func() {
+			_, err := selectNodesToRelease(newGroup(1, "node-a"), nodes())
+			Expect(err).To(HaveOccurred())
+			Expect(typederrors.IsInputError(err)).To(BeTrue())
+			Expect(err.Error()).To(ContainSubstring("only 1 of them are listed in nodesToRemove"))
+		})
+	})
+
+	Describe("scaleNodeAllocationRequest", func() {
+		var c client.Client
+
+		setup := func(group pluginsv1alpha1.NodeGroup, nodes ...string) *pluginsv1alpha1.NodeAllocationRequest {
+			nodeAllocationRequest := &pluginsv1alpha1.NodeAllocationRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "nar-1", Namespace: pluginNamespace},
+				Spec: pluginsv1alpha1.NodeAllocationRequestSpec{
+					NodeGroup: []pluginsv1alpha1.NodeGroup{group},
+				},
+				Status: pluginsv1alpha1.NodeAllocationRequestStatus{
+					Properties: pluginsv1alpha1.Properties{NodeNames: nodes},
+				},
+			}
+			objs := []client.Object{nodeAllocationRequest}
+			for _, name := range nodes {
+				objs = append(objs, newNode(name))
+			}
+			c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+				WithStatusSubresource(&pluginsv1alpha1.NodeAllocationRequest{}).
+				WithIndex(&pluginsv1alpha1.AllocatedNode{}, hwmgrutils.AllocatedNodeSpecNodeAllocationRequestKey,
+					func(obj client.Object) []string {
+						return []string{obj.(*pluginsv1alpha1.AllocatedNode).Spec.NodeAllocationRequest}
+					}).
+				Build()
+			return nodeAllocationRequest
+		}
+
+		It("reports the groups as scaled when every group has its size", func() {
+			nodeAllocationRequest := setup(newGroup(2), "node-a", "node-b")
+
+			result, scaled, err := scaleNodeAllocationRequest(ctx, c, c, logger, pluginNamespace, nodeAllocationRequest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaled).To(BeTrue())
+			Expect(result.RequeueAfter).To(BeZero())
+		})
+
+		It("releases the nodes removed from a group and waits for their deletion", func() {
+			nodeAllocationRequest := setup(newGroup(1, "node-a"), "node-a", "node-b")
+
+			result, scaled, err := scaleNodeAllocationRequest(ctx, c, c, logger, pluginNamespace, nodeAllocationRequest)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaled).To(BeFalse())
+			Expect(result).To(Equal(hwmgrutils.RequeueWithShortInterval()))
+
+			err = c.Get(ctx, client.ObjectKey{Namespace: pluginNamespace, Name: "node-a"}, &pluginsv1alpha1.AllocatedNode{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+
+			updated := &pluginsv1alpha1.NodeAllocationRequest{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(nodeAllocationRequest), updated)).To(Succeed())
+			Expect(updated.Status.Properties.NodeNames).To(Equal([]string{"node-b"}))
+
+			// Once the released node is gone, the group has its size
+			_, scaled, err = scaleNodeAllocationRequest(ctx, c, c, logger, pluginNamespace, updated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(scaled).To(BeTrue())
+		})
+
+		It("fails when the nodes to release are not listed", func() {
+			nodeAllocationRequest := setup(newGroup(1), "node-a", "node-b")
+
+			_, scaled, err := scaleNodeAllocationRequest(ctx, c, c, logger, pluginNamespace, nodeAllocationRequest)
+			Expect(err).To(HaveOccurred())
+			Expect(typederrors.IsInputError(err)).To(BeTrue())
+			Expect(scaled).To(BeFalse())
+
+			var nodes pluginsv1alpha1.AllocatedNodeList
+			Expect(c.List(ctx, &nodes)).To(Succeed())
+			Expect(nodes.Items).To(HaveLen(2))
+		})
+	})
+})
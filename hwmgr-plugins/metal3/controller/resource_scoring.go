@@ -0,0 +1,523 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file provides the scoring stage of resource selection. Once the primary and secondary
+// filters have reduced the BareMetalHosts to those matching a node group, the scoring stage
+// ranks them with the strategy selected in the NodeGroupData of the HardwareTemplate:
+//
+//   - BestFit: prefers the smallest hosts, by CPU threads and RAM, keeping larger hosts free
+//     for the node groups that need them.
+//
+//   - Spread: prefers the hosts sharing the fewest values of the spread labels, such as rack
+//     or chassis labels, with the other nodes of the group.
+//
+//   - FirmwareMatch: prefers the hosts already running the firmware versions and BIOS settings
+//     of the hardware profile, which avoids firmware updates during provisioning.
+//
+//   - AvoidErrors: prefers the hosts with no recent transient errors.
+//
+// Every decision is recorded on the AllocatedNode, so that the choice of a host can be
+// explained after the fact.
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"slices"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+// ============================================================================
+// Scoring Constants
+// ============================================================================
+
+const (
+	// AllocationScoringAnnotation records, as JSON, the ScoringRationale of the BareMetalHost allocated to an
+	// AllocatedNode
+	AllocationScoringAnnotation = "clcm.openshift.io/allocation-scoring"
+
+	// ScoringStrategyNone is reported in the rationale of the node groups without a scoring strategy
+	ScoringStrategyNone = "None"
+
+	// MaxScore is the score of the best possible candidate
+	MaxScore = 100
+)
+
+// ============================================================================
+// Types
+// ============================================================================
+
+// ScoringRationale explains why a BareMetalHost was chosen for an AllocatedNode
+type ScoringRationale struct {
+	// Strategy is the scoring strategy of the node group
+	Strategy string `json:"strategy"`
+	// Score of the BareMetalHost, from 0 to MaxScore
+	Score int `json:"score"`
+	// Rank of the BareMetalHost among the candidates, starting at 1
+	Rank int `json:"rank"`
+	// Candidates is the number of BareMetalHosts matching the node group that it could be allocated
+	Candidates int `json:"candidates"`
+	// Reason details the criteria behind the score
+	Reason string `json:"reason"`
+}
+
+// ScoredBMH is a BareMetalHost selected for a node group, along with the rationale of its selection
+type ScoredBMH struct {
+	BMH       *metal3v1alpha1.BareMetalHost
+	Rationale ScoringRationale
+}
+
+// bmhScore is the score of a single candidate
+type bmhScore struct {
+	score  int
+	reason string
+}
+
+// ============================================================================
+// Scoring Functions
+// ============================================================================
+
+// ResourceSelectionScoring ranks the BareMetalHosts that passed the primary and secondary filters
+// with the scoring strategy of the node group, and returns the best `count` of them in order of
+// preference. Ties are broken by BareMetalHost name, so that the selection is deterministic.
+//
+// Without a scoring strategy, the BareMetalHosts are returned in the order they were listed.
+//
+// The first `held` BareMetalHosts of the list are held for the node group by a reservation. They are ranked
+// before the other ones whatever their score, and the strategy only orders the hosts within each of the groups.
+//
+// The BareMetalHosts violating the anti-affinity or topology spread constraints of the node group are
+// skipped, and a placement error is returned when fewer than `count` of them can be selected.
+//
+// Example usage:
+//
+//	nodeGroup.NodeGroupData.Scoring = &hwmgmtv1alpha1.NodeScoring{
+//	  Strategy:     hwmgmtv1alpha1.ScoringStrategySpread,
+//	  SpreadLabels: []string{"rack", "chassis"},
+//	}
+//	selected, err := ResourceSelectionScoring(ctx, client, logger, pluginNamespace, nar, nodeGroup, bmhList, 0, 3)
+//
+// Returns an input error when the scoring or placement configuration of the node group is invalid.
+func ResourceSelectionScoring(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	nodeGroup pluginsv1alpha1.NodeGroup,
+	bmhList metal3v1alpha1.BareMetalHostList,
+	held int,
+	count int) ([]ScoredBMH, error) {
+
+	candidates := make([]*metal3v1alpha1.BareMetalHost, 0, len(bmhList.Items))
+	heldBMHs := make(map[*metal3v1alpha1.BareMetalHost]bool, held)
+	for i := range bmhList.Items {
+		candidates = append(candidates, &bmhList.Items[i])
+		if i < held {
+			heldBMHs[&bmhList.Items[i]] = true
+		}
+	}
+	count = min(count, len(candidates))
+
+	placement, err := newNodePlacement(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeGroup, candidates)
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selectCandidates(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeGroup, placement,
+		candidates, heldBMHs, count)
+	if err != nil {
+		return nil, err
+	}
+	if len(selected) < count {
+		return nil, placement.unsatisfiable(nodeGroup, len(selected), count, len(candidates))
+	}
+	return selected, nil
+}
+
+// selectCandidates returns up to `count` candidates in order of preference, skipping the ones that the placement
+// constraints of the node group do not allow. The held candidates are preferred to the other ones.
+func selectCandidates(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	nodeGroup pluginsv1alpha1.NodeGroup,
+	placement *nodePlacement,
+	candidates []*metal3v1alpha1.BareMetalHost,
+	held map[*metal3v1alpha1.BareMetalHost]bool,
+	count int) ([]ScoredBMH, error) {
+
+	scoring := nodeGroup.NodeGroupData.Scoring
+	if scoring == nil {
+		selected := make([]ScoredBMH, 0, count)
+		for _, bmh := range placement.pick(candidates, count) {
+			selected = append(selected, ScoredBMH{BMH: bmh, Rationale: ScoringRationale{
+				Strategy:   ScoringStrategyNone,
+				Rank:       len(selected) + 1,
+				Candidates: len(candidates),
+				Reason:     heldReason(held[bmh], "no scoring strategy, host taken in the order the hosts were listed"),
+			}})
+		}
+		return selected, nil
+	}
+
+	var (
+		// scores are keyed by bmhKey
+		scores map[string]bmhScore
+		err    error
+	)
+	switch scoring.Strategy {
+	case hwmgmtv1alpha1.ScoringStrategySpread:
+		return scoreSpread(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeGroup, placement, candidates,
+			held, count)
+	case hwmgmtv1alpha1.ScoringStrategyBestFit:
+		scores = scoreBestFit(candidates)
+	case hwmgmtv1alpha1.ScoringStrategyFirmwareMatch:
+		scores, err = scoreFirmwareMatch(ctx, c, pluginNamespace, nodeGroup.NodeGroupData.HwProfile, candidates)
+	case hwmgmtv1alpha1.ScoringStrategyAvoidErrors:
+		scores = scoreAvoidErrors(candidates)
+	default:
+		return nil, typederrors.NewInputError("unsupported scoring strategy '%s' for nodegroup=%s",
+			scoring.Strategy, nodeGroup.NodeGroupData.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if held[candidates[i]] != held[candidates[j]] {
+			return held[candidates[i]]
+		}
+		si, sj := scores[bmhKey(candidates[i])], scores[bmhKey(candidates[j])]
+		if si.score != sj.score {
+			return si.score > sj.score
+		}
+		return bmhKey(candidates[i]) < bmhKey(candidates[j])
+	})
+
+	selected := make([]ScoredBMH, 0, count)
+	for _, bmh := range placement.pick(candidates, count) {
+		score := scores[bmhKey(bmh)]
+		selected = append(selected, ScoredBMH{BMH: bmh, Rationale: ScoringRationale{
+			Strategy:   string(scoring.Strategy),
+			Score:      score.score,
+			Rank:       len(selected) + 1,
+			Candidates: len(candidates),
+			Reason:     heldReason(held[bmh], score.reason),
+		}})
+		logger.InfoContext(ctx, "Scored BareMetalHost for allocation",
+			slog.String("bmh", bmh.Name),
+			slog.String("nodegroup", nodeGroup.NodeGroupData.Name),
+			slog.String("strategy", string(scoring.Strategy)),
+			slog.Int("score", score.score),
+			slog.String("reason", score.reason))
+	}
+	return selected, nil
+}
+
+// scoreBestFit scores the hosts by their CPU threads and RAM, relative to the other candidates. The smallest
+// host scores MaxScore, and the largest host scores 0. Hosts without hardware details score 0.
+func scoreBestFit(candidates []*metal3v1alpha1.BareMetalHost) map[string]bmhScore {
+	minThreads, maxThreads, minRAM, maxRAM := -1, 0, -1, 0
+	for _, bmh := range candidates {
+		if details := bmh.Status.HardwareDetails; details != nil {
+			if minThreads < 0 || details.CPU.Count < minThreads {
+				minThreads = details.CPU.Count
+			}
+			if minRAM < 0 || details.RAMMebibytes < minRAM {
+				minRAM = details.RAMMebibytes
+			}
+			maxThreads = max(maxThreads, details.CPU.Count)
+			maxRAM = max(maxRAM, details.RAMMebibytes)
+		}
+	}
+
+	// relative returns how far a value is from the smallest one, between 0 and 1
+	relative := func(value, minValue, maxValue int) float64 {
+		if maxValue <= minValue {
+			return 0
+		}
+		return float64(value-minValue) / float64(maxValue-minValue)
+	}
+
+	scores := make(map[string]bmhScore, len(candidates))
+	for _, bmh := range candidates {
+		details := bmh.Status.HardwareDetails
+		if details == nil {
+			scores[bmhKey(bmh)] = bmhScore{reason: "no hardware details"}
+			continue
+		}
+		score := MaxScore * (1 - (relative(details.CPU.Count, minThreads, maxThreads)+
+			relative(details.RAMMebibytes, minRAM, maxRAM))/2)
+		scores[bmhKey(bmh)] = bmhScore{
+			score: int(score + 0.5),
+			reason: fmt.Sprintf("%d CPU threads (smallest %d), %d MiB RAM (smallest %d)",
+				details.CPU.Count, minThreads, details.RAMMebibytes, minRAM),
+		}
+	}
+	return scores
+}
+
+// scoreAvoidErrors scores the hosts by their error history. A host with a transient error in progress scores 0,
+// and the score of the other hosts decreases with the number of errors they encountered since their last
+// successful operation.
+func scoreAvoidErrors(candidates []*metal3v1alpha1.BareMetalHost) map[string]bmhScore {
+	scores := make(map[string]bmhScore, len(candidates))
+	for _, bmh := range candidates {
+		if since, exists := bmh.Annotations[BmhErrorTimestampAnnotation]; exists {
+			scores[bmhKey(bmh)] = bmhScore{reason: fmt.Sprintf("transient error since %s", since)}
+			continue
+		}
+
+		reason := fmt.Sprintf("%d errors since the last successful operation", bmh.Status.ErrorCount)
+		if bmh.Status.ErrorType != "" {
+			reason += fmt.Sprintf(", last error: %s", bmh.Status.ErrorType)
+		}
+		scores[bmhKey(bmh)] = bmhScore{score: MaxScore / (1 + bmh.Status.ErrorCount), reason: reason}
+	}
+	return scores
+}
+
+// scoreFirmwareMatch scores the hosts by the share of the firmware versions and BIOS settings of the hardware
+// profile they already run, as reported by their HostFirmwareComponents and HostFirmwareSettings.
+func scoreFirmwareMatch(ctx context.Context,
+	c client.Reader,
+	pluginNamespace, hwProfileName string,
+	candidates []*metal3v1alpha1.BareMetalHost) (map[string]bmhScore, error) {
+
+	prof := &hwmgmtv1alpha1.HardwareProfile{}
+	if err := c.Get(ctx, types.NamespacedName{Name: hwProfileName, Namespace: pluginNamespace}, prof); err != nil {
+		return nil, fmt.Errorf("get HardwareProfile %s/%s: %w", pluginNamespace, hwProfileName, err)
+	}
+
+	expected := map[string]string{}
+	if v := strings.TrimSpace(prof.Spec.BiosFirmware.Version); v != "" {
+		expected["bios"] = normalizeVersion(v)
+	}
+	if v := strings.TrimSpace(prof.Spec.BmcFirmware.Version); v != "" {
+		expected["bmc"] = normalizeVersion(v)
+	}
+
+	total := len(expected) + len(prof.Spec.Bios.Attributes)
+	scores := make(map[string]bmhScore, len(candidates))
+	for _, bmh := range candidates {
+		if total == 0 {
+			scores[bmhKey(bmh)] = bmhScore{score: MaxScore,
+				reason: fmt.Sprintf("HardwareProfile %s has no firmware versions or BIOS settings", hwProfileName)}
+			continue
+		}
+
+		firmwareMatches := 0
+		if len(expected) > 0 {
+			hfc, err := getHostFirmwareComponents(ctx, c, bmh.Name, bmh.Namespace)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if hfc != nil {
+				for _, comp := range hfc.Status.Components {
+					k := strings.ToLower(strings.TrimSpace(comp.Component))
+					if want, ok := expected[k]; ok && normalizeVersion(comp.CurrentVersion) == want {
+						firmwareMatches++
+					}
+				}
+			}
+		}
+
+		settingMatches := 0
+		if len(prof.Spec.Bios.Attributes) > 0 {
+			hfs, err := getHostFirmwareSettings(ctx, c, bmh.Name, bmh.Namespace)
+			if err != nil && !errors.IsNotFound(err) {
+				return nil, err
+			}
+			if hfs != nil {
+				for name, value := range prof.Spec.Bios.Attributes {
+					if actual, ok := hfs.Status.Settings[name]; ok && equalIntOrStringWithString(value, actual) {
+						settingMatches++
+					}
+				}
+			}
+		}
+
+		scores[bmhKey(bmh)] = bmhScore{
+			score: MaxScore * (firmwareMatches + settingMatches) / total,
+			reason: fmt.Sprintf("%d/%d firmware versions and %d/%d BIOS settings match HardwareProfile %s",
+				firmwareMatches, len(expected), settingMatches, len(prof.Spec.Bios.Attributes), hwProfileName),
+		}
+	}
+	return scores, nil
+}
+
+// bmhKey returns the namespace/name of a host, which identifies it among candidates listed across namespaces
+func bmhKey(bmh *metal3v1alpha1.BareMetalHost) string {
+	return client.ObjectKeyFromObject(bmh).String()
+}
+
+// bmhLabelValue returns the value of a spread label of a host. Like the resource selector, the label may be given
+// without the resource selector prefix.
+func bmhLabelValue(bmh *metal3v1alpha1.BareMetalHost, key string) string {
+	if value, exists := bmh.Labels[key]; exists {
+		return value
+	}
+	return bmh.Labels[LabelPrefixResourceSelector+key]
+}
+
+// getGroupBMHs returns the BareMetalHosts already allocated to the node group of a NodeAllocationRequest
+func getGroupBMHs(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	groupName string) ([]*metal3v1alpha1.BareMetalHost, error) {
+
+	var bmhs []*metal3v1alpha1.BareMetalHost
+	for _, nodeName := range nodeAllocationRequest.Status.Properties.NodeNames {
+		node, err := hwmgrutils.GetNode(ctx, logger, c, pluginNamespace, nodeName)
+		if err != nil || node == nil || node.Spec.GroupName != groupName {
+			continue
+		}
+		bmh, err := getBMHForNode(ctx, c, node)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get BMH for AllocatedNode %s: %w", node.Name, err)
+		}
+		bmhs = append(bmhs, bmh)
+	}
+	return bmhs, nil
+}
+
+// scoreSpread selects the hosts one at a time, each time preferring the host sharing the fewest values of the
+// spread labels with the hosts already in the node group, including the ones selected before it. The labels are
+// compared from the broadest failure domain to the narrowest, so that the nodes are spread across racks before
+// being spread across the chassis of a rack. Hosts that the placement constraints do not allow are skipped.
+func scoreSpread(ctx context.Context,
+	c client.Reader,
+	logger *slog.Logger,
+	pluginNamespace string,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	nodeGroup pluginsv1alpha1.NodeGroup,
+	placement *nodePlacement,
+	candidates []*metal3v1alpha1.BareMetalHost,
+	held map[*metal3v1alpha1.BareMetalHost]bool,
+	count int) ([]ScoredBMH, error) {
+
+	spreadLabels := nodeGroup.NodeGroupData.Scoring.SpreadLabels
+	if len(spreadLabels) == 0 {
+		return nil, typederrors.NewInputError("the Spread scoring strategy of nodegroup=%s requires spreadLabels",
+			nodeGroup.NodeGroupData.Name)
+	}
+
+	members, err := getGroupBMHs(ctx, c, logger, pluginNamespace, nodeAllocationRequest, nodeGroup.NodeGroupData.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	// used counts the hosts of the node group in each failure domain, per spread label
+	used := make([]map[string]int, len(spreadLabels))
+	for i, label := range spreadLabels {
+		used[i] = make(map[string]int)
+		for _, bmh := range members {
+			if value := bmhLabelValue(bmh, label); value != "" {
+				used[i][value]++
+			}
+		}
+	}
+
+	// shared returns the number of hosts of the node group sharing the failure domain of a candidate, per label
+	shared := func(bmh *metal3v1alpha1.BareMetalHost) []int {
+		counts := make([]int, len(spreadLabels))
+		for i, label := range spreadLabels {
+			if value := bmhLabelValue(bmh, label); value != "" {
+				counts[i] = used[i][value]
+			}
+		}
+		return counts
+	}
+
+	remaining := append([]*metal3v1alpha1.BareMetalHost{}, candidates...)
+	selected := make([]ScoredBMH, 0, count)
+	for rank := 1; rank <= count; rank++ {
+		sort.SliceStable(remaining, func(i, j int) bool {
+			if held[remaining[i]] != held[remaining[j]] {
+				return held[remaining[i]]
+			}
+			si, sj := shared(remaining[i]), shared(remaining[j])
+			for k := range si {
+				if si[k] != sj[k] {
+					return si[k] < sj[k]
+				}
+			}
+			return bmhKey(remaining[i]) < bmhKey(remaining[j])
+		})
+
+		next := slices.IndexFunc(remaining, placement.allows)
+		if next < 0 {
+			break
+		}
+		bmh := remaining[next]
+		remaining = slices.Delete(remaining, next, next+1)
+		placement.place(bmh)
+
+		counts := shared(bmh)
+		total := 0
+		domains := make([]string, 0, len(spreadLabels))
+		for i, label := range spreadLabels {
+			value := bmhLabelValue(bmh, label)
+			if value == "" {
+				domains = append(domains, fmt.Sprintf("%s unknown", label))
+				continue
+			}
+			domains = append(domains, fmt.Sprintf("%s=%s shared with %d nodes", label, value, counts[i]))
+			total += counts[i]
+			used[i][value]++
+		}
+
+		rationale := ScoringRationale{
+			Strategy:   string(hwmgmtv1alpha1.ScoringStrategySpread),
+			Score:      MaxScore / (1 + total),
+			Rank:       rank,
+			Candidates: len(candidates),
+			Reason:     heldReason(held[bmh], strings.Join(domains, ", ")),
+		}
+		selected = append(selected, ScoredBMH{BMH: bmh, Rationale: rationale})
+		logger.InfoContext(ctx, "Scored BareMetalHost for allocation",
+			slog.String("bmh", bmh.Name),
+			slog.String("nodegroup", nodeGroup.NodeGroupData.Name),
+			slog.String("strategy", rationale.Strategy),
+			slog.Int("score", rationale.Score),
+			slog.String("reason", rationale.Reason))
+	}
+	return selected, nil
+}
+
+// heldReason prefixes the reason of the score of a host held by a reservation, as it was preferred to the other
+// hosts whatever its score
+func heldReason(held bool, reason string) string {
+	if !held {
+		return reason
+	}
+	return "held by a reservation, " + reason
+}
+
+// marshalScoringRationale returns the value of the AllocationScoringAnnotation for a ScoringRationale
+func marshalScoringRationale(rationale ScoringRationale) (string, error) {
+	data, err := json.Marshal(rationale)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal scoring rationale: %w", err)
+	}
+	return string(data), nil
+}
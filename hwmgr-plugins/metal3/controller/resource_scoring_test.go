@@ -0,0 +1,275 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"log/slog"
+
+	metal3v1alpha1 "github.com/metal3-io/baremetal-operator/apis/metal3.io/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+var _ = Describe("Resource Scoring", func() {
+	const (
+		pluginNamespace = "hwmgr"
+		bmhNamespace    = "hosts"
+	)
+
+	var (
+		ctx                   context.Context
+		logger                *slog.Logger
+		scheme                *runtime.Scheme
+		nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		logger = slog.New(slog.DiscardHandler)
+		scheme = runtime.NewScheme()
+		Expect(metal3v1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(hwmgmtv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		nodeAllocationRequest = &pluginsv1alpha1.NodeAllocationRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "nar-1", Namespace: pluginNamespace},
+		}
+	})
+
+	newBMH := func(name string, labels map[string]string, threads, ram int) *metal3v1alpha1.BareMetalHost {
+		return &metal3v1alpha1.BareMetalHost{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bmhNamespace, Labels: labels},
+			Status: metal3v1alpha1.BareMetalHostStatus{
+				HardwareDetails: &metal3v1alpha1.HardwareDetails{
+					CPU:          metal3v1alpha1.CPU{Count: threads},
+					RAMMebibytes: ram,
+				},
+			},
+		}
+	}
+
+	bmhList := func(bmhs ...*metal3v1alpha1.BareMetalHost) metal3v1alpha1.BareMetalHostList {
+		var list metal3v1alpha1.BareMetalHostList
+		for _, bmh := range bmhs {
+			list.Items = append(list.Items, *bmh)
+		}
+		return list
+	}
+
+	nodeGroup := func(scoring *hwmgmtv1alpha1.NodeScoring) pluginsv1alpha1.NodeGroup {
+		return pluginsv1alpha1.NodeGroup{
+			NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
+				Name:      "controller",
+				HwProfile: "profile-1",
+				Scoring:   scoring,
+			},
+			Size: 3,
+		}
+	}
+
+	names := func(selected []ScoredBMH) []string {
+		var result []string
+		for _, candidate := range selected {
+			result = append(result, candidate.BMH.Name)
+		}
+		return result
+	}
+
+	score := func(c client.Reader, group pluginsv1alpha1.NodeGroup, list metal3v1alpha1.BareMetalHostList,
+		count int) []ScoredBMH {
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest, group, list, 0, count)
+		Expect(err).NotTo(HaveOccurred())
+		return selected
+	}
+
+	It("keeps the listed order without a scoring strategy", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		selected := score(c, nodeGroup(nil), bmhList(newBMH("host-b", nil, 8, 1024), newBMH("host-a", nil, 8, 1024)), 3)
+
+		Expect(names(selected)).To(Equal([]string{"host-b", "host-a"}))
+		Expect(selected[1].Rationale).To(Equal(ScoringRationale{
+			Strategy:   ScoringStrategyNone,
+			Rank:       2,
+			Candidates: 2,
+			Reason:     "no scoring strategy, host taken in the order the hosts were listed",
+		}))
+	})
+
+	It("ranks the held hosts first, and scores the held and the free hosts separately", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(
+			newBMH("held-large", nil, 64, 262144),
+			newBMH("held-small", nil, 16, 65536),
+			newBMH("free-large", nil, 64, 262144),
+			newBMH("free-small", nil, 8, 32768),
+		)
+
+		selected, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyBestFit}), list, 2, 3)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(names(selected)).To(Equal([]string{"held-small", "held-large", "free-small"}))
+		Expect(selected[0].Rationale.Reason).To(HavePrefix("held by a reservation, "))
+		Expect(selected[2].Rationale.Reason).NotTo(HavePrefix("held by a reservation"))
+	})
+
+	It("prefers the smallest hosts with BestFit", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		unknown := newBMH("host-unknown", nil, 0, 0)
+		unknown.Status.HardwareDetails = nil
+		list := bmhList(
+			newBMH("host-large", nil, 64, 262144),
+			unknown,
+			newBMH("host-small", nil, 16, 65536),
+			newBMH("host-medium", nil, 32, 65536),
+		)
+
+		selected := score(c, nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyBestFit}), list, 4)
+
+		Expect(names(selected)).To(Equal([]string{"host-small", "host-medium", "host-large", "host-unknown"}))
+		Expect(selected[0].Rationale.Score).To(Equal(MaxScore))
+		Expect(selected[0].Rationale.Reason).To(Equal("16 CPU threads (smallest 16), 65536 MiB RAM (smallest 65536)"))
+		Expect(selected[1].Rationale.Score).To(Equal(83))
+		Expect(selected[2].Rationale.Score).To(Equal(0))
+		Expect(selected[3].Rationale.Reason).To(Equal("no hardware details"))
+	})
+
+	It("prefers the hosts without recent errors with AvoidErrors", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		failing := newBMH("host-a", nil, 8, 1024)
+		failing.Annotations = map[string]string{BmhErrorTimestampAnnotation: "2026-01-01T00:00:00Z"}
+		flaky := newBMH("host-b", nil, 8, 1024)
+		flaky.Status.ErrorCount = 3
+		flaky.Status.ErrorType = metal3v1alpha1.PowerManagementError
+
+		selected := score(c, nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyAvoidErrors}),
+			bmhList(failing, flaky, newBMH("host-c", nil, 8, 1024)), 2)
+
+		Expect(names(selected)).To(Equal([]string{"host-c", "host-b"}))
+		Expect(selected[0].Rationale.Score).To(Equal(MaxScore))
+		Expect(selected[1].Rationale.Score).To(Equal(25))
+		Expect(selected[1].Rationale.Reason).To(Equal("3 errors since the last successful operation, last error: power management error"))
+	})
+
+	It("scores the hosts with the same name in different namespaces separately", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		flaky := newBMH("host-a", nil, 8, 1024)
+		flaky.Namespace = "rack-1"
+		flaky.Status.ErrorCount = 3
+		healthy := newBMH("host-a", nil, 8, 1024)
+		healthy.Namespace = "rack-2"
+
+		selected := score(c, nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyAvoidErrors}),
+			bmhList(flaky, healthy), 2)
+
+		Expect(selected).To(HaveLen(2))
+		Expect(selected[0].BMH.Namespace).To(Equal("rack-2"))
+		Expect(selected[0].Rationale.Score).To(Equal(MaxScore))
+		Expect(selected[1].BMH.Namespace).To(Equal("rack-1"))
+		Expect(selected[1].Rationale.Score).To(Equal(25))
+		Expect(selected[1].Rationale.Reason).To(Equal("3 errors since the last successful operation"))
+	})
+
+	It("prefers the hosts running the hardware profile with FirmwareMatch", func() {
+		profile := &hwmgmtv1alpha1.HardwareProfile{
+			ObjectMeta: metav1.ObjectMeta{Name: "profile-1", Namespace: pluginNamespace},
+			Spec: hwmgmtv1alpha1.HardwareProfileSpec{
+				Bios: hwmgmtv1alpha1.Bios{Attributes: map[string]intstr.IntOrString{
+					"SriovEnable": intstr.FromString("Enabled"),
+				}},
+				BiosFirmware: hwmgmtv1alpha1.Firmware{Version: "v2.1.0"},
+				BmcFirmware:  hwmgmtv1alpha1.Firmware{Version: "7.10"},
+			},
+		}
+		firmware := func(name, bios, bmc string) *metal3v1alpha1.HostFirmwareComponents {
+			return &metal3v1alpha1.HostFirmwareComponents{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: bmhNamespace},
+				Status: metal3v1alpha1.HostFirmwareComponentsStatus{Components: []metal3v1alpha1.FirmwareComponentStatus{
+					{Component: "bios", CurrentVersion: bios},
+					{Component: "bmc", CurrentVersion: bmc},
+				}},
+			}
+		}
+		settings := &metal3v1alpha1.HostFirmwareSettings{
+			ObjectMeta: metav1.ObjectMeta{Name: "host-c", Namespace: bmhNamespace},
+			Status:     metal3v1alpha1.HostFirmwareSettingsStatus{Settings: metal3v1alpha1.SettingsMap{"SriovEnable": "Enabled"}},
+		}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(profile, settings,
+			firmware("host-b", "2.0.0", "7.10"), firmware("host-c", "2.1.0", "7.10")).Build()
+
+		selected := score(c, nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategyFirmwareMatch}),
+			bmhList(newBMH("host-a", nil, 8, 1024), newBMH("host-b", nil, 8, 1024), newBMH("host-c", nil, 8, 1024)), 3)
+
+		Expect(names(selected)).To(Equal([]string{"host-c", "host-b", "host-a"}))
+		Expect(selected[0].Rationale.Score).To(Equal(MaxScore))
+		Expect(selected[1].Rationale.Score).To(Equal(33))
+		Expect(selected[1].Rationale.Reason).To(Equal("1/2 firmware versions and 0/1 BIOS settings match HardwareProfile profile-1"))
+		Expect(selected[2].Rationale.Score).To(Equal(0))
+	})
+
+	It("spreads the hosts of the node group across the spread labels", func() {
+		existing := newBMH("host-0", map[string]string{LabelPrefixResourceSelector + "rack": "r1", "chassis": "c1"}, 8, 1024)
+		node := &pluginsv1alpha1.AllocatedNode{
+			ObjectMeta: metav1.ObjectMeta{Name: "node-0", Namespace: pluginNamespace},
+			Spec: pluginsv1alpha1.AllocatedNodeSpec{
+				GroupName:   "controller",
+				HwMgrNodeId: existing.Name,
+				HwMgrNodeNs: existing.Namespace,
+			},
+		}
+		nodeAllocationRequest.Status.Properties.NodeNames = []string{node.Name}
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(existing, node).Build()
+
+		rack := func(rack, chassis string) map[string]string {
+			return map[string]string{LabelPrefixResourceSelector + "rack": rack, "chassis": chassis}
+		}
+		list := bmhList(
+			newBMH("host-a", rack("r1", "c2"), 8, 1024),
+			newBMH("host-b", rack("r2", "c1"), 8, 1024),
+			newBMH("host-c", rack("r2", "c3"), 8, 1024),
+			newBMH("host-d", rack("r3", "c4"), 8, 1024),
+		)
+
+		selected := score(c, nodeGroup(&hwmgmtv1alpha1.NodeScoring{
+			Strategy:     hwmgmtv1alpha1.ScoringStrategySpread,
+			SpreadLabels: []string{"rack", "chassis"},
+		}), list, 3)
+
+		Expect(names(selected)).To(Equal([]string{"host-c", "host-d", "host-a"}))
+		Expect(selected[0].Rationale.Score).To(Equal(MaxScore))
+		Expect(selected[2].Rationale).To(Equal(ScoringRationale{
+			Strategy:   string(hwmgmtv1alpha1.ScoringStrategySpread),
+			Score:      50,
+			Rank:       3,
+			Candidates: 4,
+			Reason:     "rack=r1 shared with 1 nodes, chassis=c2 shared with 0 nodes",
+		}))
+	})
+
+	It("rejects invalid scoring configurations", func() {
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+		list := bmhList(newBMH("host-a", nil, 8, 1024))
+
+		_, err := ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: hwmgmtv1alpha1.ScoringStrategySpread}), list, 0, 1)
+		Expect(typederrors.IsInputError(err)).To(BeTrue())
+
+		_, err = ResourceSelectionScoring(ctx, c, logger, pluginNamespace, nodeAllocationRequest,
+			nodeGroup(&hwmgmtv1alpha1.NodeScoring{Strategy: "Random"}), list, 0, 1)
+		Expect(typederrors.IsInputError(err)).To(BeTrue())
+	})
+})
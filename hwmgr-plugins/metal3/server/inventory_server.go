@@ -13,10 +13,11 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
 	metal3ctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/metal3/controller"
 )
 
-// Metal3PluginInventoryServer implements StricerServerInterface.
+// Metal3PluginInventoryServer implements StrictServerInterface.
 // This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
 var _ inventory.StrictServerInterface = (*Metal3PluginInventoryServer)(nil)
 
@@ -27,13 +28,12 @@ type Metal3PluginInventoryServer struct {
 // NewMetal3PluginServer creates a Metal3 HardwarePlugin inventory server
 func NewMetal3PluginInventoryServer(
 	hubClient client.Client,
+	noncachedClient client.Reader,
 	logger *slog.Logger,
 ) (*Metal3PluginInventoryServer, error) {
 	return &Metal3PluginInventoryServer{
-		InventoryServer: inventory.InventoryServer{
-			HubClient: hubClient,
-			Logger:    logger,
-		},
+		InventoryServer: inventory.NewInventoryServer(hubClient, noncachedClient, logger,
+			provisioning.GetMetal3HWPluginNamespace(), metal3ctrl.InventorySubscriptionsConfigMapName),
 	}, nil
 }
 
@@ -46,3 +46,18 @@ func (m *Metal3PluginInventoryServer) GetResources(ctx context.Context, request
 	// nolint: wrapcheck
 	return metal3ctrl.GetResources(ctx, m.Logger, m.HubClient)
 }
+
+func (m *Metal3PluginInventoryServer) GetResourcePool(ctx context.Context, request inventory.GetResourcePoolRequestObject) (inventory.GetResourcePoolResponseObject, error) {
+	// nolint: wrapcheck
+	return metal3ctrl.GetResourcePool(ctx, m.HubClient, request.ResourcePoolId)
+}
+
+func (m *Metal3PluginInventoryServer) GetResourcePoolResources(ctx context.Context, request inventory.GetResourcePoolResourcesRequestObject) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	// nolint: wrapcheck
+	return metal3ctrl.GetResourcePoolResources(ctx, m.HubClient, request.ResourcePoolId)
+}
+
+func (m *Metal3PluginInventoryServer) GetResource(ctx context.Context, request inventory.GetResourceRequestObject) (inventory.GetResourceResponseObject, error) {
+	// nolint: wrapcheck
+	return metal3ctrl.GetResource(ctx, m.HubClient, request.ResourceId)
+}
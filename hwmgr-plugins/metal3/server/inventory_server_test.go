@@ -69,6 +69,7 @@ Generated-By: Cursor/claude-4-sonnet
 // 8. Method Delegation Tests:
 //   - should properly delegate GetResourcePools to metal3ctrl package
 //   - should properly delegate GetResources to metal3ctrl package
+//   - should return 404 from GetResourcePool, GetResourcePoolResources and GetResource for unknown objects
 package server
 
 import (
@@ -76,9 +77,17 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
 )
@@ -111,14 +120,14 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("NewMetal3PluginInventoryServer", func() {
 		Context("with valid parameters", func() {
 			It("should create a Metal3PluginInventoryServer successfully", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
 			})
 
 			It("should properly initialize all fields", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server.InventoryServer.HubClient).To(Equal(mockClient))
@@ -126,7 +135,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 			})
 
 			It("should return the correct type", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).To(BeAssignableToTypeOf(&Metal3PluginInventoryServer{}))
@@ -135,7 +144,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 
 		Context("with nil parameters", func() {
 			It("should handle nil client gracefully", func() {
-				server, err := NewMetal3PluginInventoryServer(nil, logger)
+				server, err := NewMetal3PluginInventoryServer(nil, nil, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
@@ -143,7 +152,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 			})
 
 			It("should handle nil logger gracefully", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, nil)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, nil)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
@@ -151,7 +160,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 			})
 
 			It("should handle both nil client and logger gracefully", func() {
-				server, err := NewMetal3PluginInventoryServer(nil, nil)
+				server, err := NewMetal3PluginInventoryServer(nil, nil, nil)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
@@ -162,7 +171,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 
 		Context("return value validation", func() {
 			It("should return a pointer to Metal3PluginInventoryServer", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
@@ -173,7 +182,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 			})
 
 			It("should never return an error in current implementation", func() {
-				server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+				server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(server).ToNot(BeNil())
@@ -184,7 +193,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("Interface Compliance", func() {
 		BeforeEach(func() {
 			var err error
-			server, err = NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -217,7 +226,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("GetResourcePools", func() {
 		BeforeEach(func() {
 			var err error
-			server, err = NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Set up mock expectations for BareMetalHost List call
@@ -267,7 +276,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("GetResources", func() {
 		BeforeEach(func() {
 			var err error
-			server, err = NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Set up mock expectations for BareMetalHost and AllocatedNode List calls
@@ -317,7 +326,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("Embedded InventoryServer", func() {
 		BeforeEach(func() {
 			var err error
-			server, err = NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -351,7 +360,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 					localMockClient := NewMockClient(localCtrl)
 					localLogger := slog.Default()
 
-					server, err := NewMetal3PluginInventoryServer(localMockClient, localLogger)
+					server, err := NewMetal3PluginInventoryServer(localMockClient, localMockClient, localLogger)
 					if err != nil {
 						errors <- err
 						return
@@ -390,7 +399,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 
 			for i := 0; i < numInstances; i++ {
 				var err error
-				servers[i], err = NewMetal3PluginInventoryServer(mockClient, logger)
+				servers[i], err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 				Expect(err).ToNot(HaveOccurred())
 				Expect(servers[i]).ToNot(BeNil())
 			}
@@ -403,13 +412,13 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 		})
 
 		It("should properly handle server cleanup", func() {
-			server, err := NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 
 			Expect(err).ToNot(HaveOccurred())
 			Expect(server).ToNot(BeNil())
 
 			// Create a new server to test multiple instances
-			server2, err2 := NewMetal3PluginInventoryServer(mockClient, logger)
+			server2, err2 := NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err2).ToNot(HaveOccurred())
 			Expect(server2).ToNot(BeNil())
 		})
@@ -418,7 +427,7 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 	Describe("Method Delegation", func() {
 		BeforeEach(func() {
 			var err error
-			server, err = NewMetal3PluginInventoryServer(mockClient, logger)
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Set up mock expectations for all List calls
@@ -450,4 +459,109 @@ var _ = Describe("Metal3PluginInventoryServer", func() {
 			}).ToNot(Panic())
 		})
 	})
+
+	Describe("Single object lookups", func() {
+		BeforeEach(func() {
+			var err error
+			server, err = NewMetal3PluginInventoryServer(mockClient, mockClient, logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			mockClient.EXPECT().
+				List(gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(nil).
+				AnyTimes()
+			mockClient.EXPECT().
+				Get(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(apierrors.NewNotFound(schema.GroupResource{Resource: "baremetalhosts"}, "unknown")).
+				AnyTimes()
+		})
+
+		It("should return 404 for an unknown resource pool", func() {
+			result, err := server.GetResourcePool(ctx, inventory.GetResourcePoolRequestObject{ResourcePoolId: "unknown"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeAssignableToTypeOf(inventory.GetResourcePool404ApplicationProblemPlusJSONResponse{}))
+		})
+
+		It("should return 404 for the resources of an unknown resource pool", func() {
+			result, err := server.GetResourcePoolResources(ctx,
+				inventory.GetResourcePoolResourcesRequestObject{ResourcePoolId: "unknown"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeAssignableToTypeOf(inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse{}))
+		})
+
+		It("should return 404 for an unknown resource", func() {
+			result, err := server.GetResource(ctx, inventory.GetResourceRequestObject{ResourceId: "test-ns/unknown"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeAssignableToTypeOf(inventory.GetResource404ApplicationProblemPlusJSONResponse{}))
+		})
+	})
+
+	Describe("Subscriptions", func() {
+		var (
+			fakeClient client.Client
+			callback   = "https://consumer.test-ns.svc.cluster.local:8443/notifications"
+		)
+
+		BeforeEach(func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			fakeClient = fake.NewClientBuilder().WithScheme(scheme).Build()
+
+			var err error
+			server, err = NewMetal3PluginInventoryServer(fakeClient, fakeClient, logger)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should persist created subscriptions", func() {
+			consumerID := uuid.New()
+			resp, err := server.CreateSubscription(ctx, inventory.CreateSubscriptionRequestObject{
+				Body: &inventory.Subscription{Callback: callback, ConsumerSubscriptionId: &consumerID},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(inventory.CreateSubscription201JSONResponse{}))
+			created := resp.(inventory.CreateSubscription201JSONResponse)
+			Expect(created.SubscriptionId).ToNot(BeNil())
+
+			getResp, err := server.GetSubscription(ctx, inventory.GetSubscriptionRequestObject{
+				SubscriptionId: *created.SubscriptionId,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResp).To(Equal(inventory.GetSubscription200JSONResponse(created)))
+
+			listResp, err := server.GetSubscriptions(ctx, inventory.GetSubscriptionsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listResp).To(HaveLen(1))
+
+			deleteResp, err := server.DeleteSubscription(ctx, inventory.DeleteSubscriptionRequestObject{
+				SubscriptionId: *created.SubscriptionId,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleteResp).To(Equal(inventory.DeleteSubscription200Response{}))
+
+			listResp, err = server.GetSubscriptions(ctx, inventory.GetSubscriptionsRequestObject{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(listResp).To(BeEmpty())
+		})
+
+		It("should return 404 for unknown subscriptions", func() {
+			getResp, err := server.GetSubscription(ctx, inventory.GetSubscriptionRequestObject{SubscriptionId: uuid.New()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(getResp).To(BeAssignableToTypeOf(inventory.GetSubscription404ApplicationProblemPlusJSONResponse{}))
+
+			deleteResp, err := server.DeleteSubscription(ctx, inventory.DeleteSubscriptionRequestObject{SubscriptionId: uuid.New()})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleteResp).To(BeAssignableToTypeOf(inventory.DeleteSubscription404ApplicationProblemPlusJSONResponse{}))
+		})
+
+		DescribeTable("should reject invalid subscriptions",
+			func(subscription inventory.Subscription) {
+				resp, err := server.CreateSubscription(ctx, inventory.CreateSubscriptionRequestObject{Body: &subscription})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(inventory.CreateSubscription400ApplicationProblemPlusJSONResponse{}))
+			},
+			Entry("http callback", inventory.Subscription{Callback: "http://consumer.test-ns.svc.cluster.local/notifications"}),
+			Entry("callback outside of the cluster", inventory.Subscription{Callback: "https://consumer.example.com/notifications"}),
+			Entry("invalid filter", inventory.Subscription{Callback: callback, Filter: ptr.To("(eq,resourcePoolId")}),
+		)
+	})
 })
@@ -7,18 +7,20 @@ SPDX-License-Identifier: Apache-2.0
 package server
 
 import (
+	"context"
 	"log/slog"
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
 	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	metal3ctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/metal3/controller"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
 const Metal3ResourcePrefix = "metal3"
 
-// Metal3PluginServer implements StricerServerInterface.
+// Metal3PluginServer implements StrictServerInterface.
 // This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
 var _ provisioning.StrictServerInterface = (*Metal3PluginServer)(nil)
 
@@ -34,14 +36,16 @@ func NewMetal3PluginServer(
 	logger *slog.Logger,
 ) (*Metal3PluginServer, error) {
 	return &Metal3PluginServer{
-		HardwarePluginServer: provisioning.HardwarePluginServer{
-			CommonServerConfig: config,
-			HubClient:          hubClient,
-			NoncachedClient:    noncachedClient,
-			Logger:             logger,
-			Namespace:          provisioning.GetMetal3HWPluginNamespace(),
-			HardwarePluginID:   hwmgrutils.Metal3HardwarePluginID,
-			ResourcePrefix:     Metal3ResourcePrefix,
-		},
+		HardwarePluginServer: provisioning.NewHardwarePluginServer(config, hubClient, noncachedClient, logger,
+			provisioning.GetMetal3HWPluginNamespace(), hwmgrutils.Metal3HardwarePluginID, Metal3ResourcePrefix),
 	}, nil
 }
+
+// GetCapacity counts the BareMetalHosts available for a node group
+func (m *Metal3PluginServer) GetCapacity(
+	ctx context.Context,
+	request provisioning.GetCapacityRequestObject,
+) (provisioning.GetCapacityResponseObject, error) {
+	// nolint: wrapcheck
+	return metal3ctrl.GetCapacity(ctx, m.NoncachedClient, m.Namespace, request.Params)
+}
@@ -0,0 +1,456 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package bmc implements the subset of the Redfish API used by the Redfish hardware plugin: system discovery, power
+// control, BIOS attributes, firmware updates through the UpdateService, and virtual media boot.
+package bmc
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	redfishRoot      = "/redfish/v1"
+	systemsPath      = redfishRoot + "/Systems"
+	simpleUpdatePath = redfishRoot + "/UpdateService/Actions/UpdateService.SimpleUpdate"
+	tasksPath        = redfishRoot + "/TaskService/Tasks"
+)
+
+// Schemes of the BMC addresses that are reachable through Redfish, as used by metal3. The optional "+http" or
+// "+https" suffix selects the transport, which defaults to https.
+var redfishSchemes = []string{"redfish", "redfish-virtualmedia", "idrac-virtualmedia", "ilo5-virtualmedia"}
+
+// Error is returned when the BMC answers a request with an error status
+type Error struct {
+	StatusCode int
+	Message    string
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("redfish request failed with status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsNotFound returns whether the error is a Redfish 404 response
+func IsNotFound(err error) bool {
+	var redfishErr *Error
+	return errors.As(err, &redfishErr) && redfishErr.StatusCode == http.StatusNotFound
+}
+
+// Client sends Redfish requests to a single BMC
+type Client struct {
+	endpoint   string
+	systemPath string
+	username   string
+	password   string
+	httpClient *http.Client
+}
+
+// ParseAddress splits a BMC address, such as redfish-virtualmedia+https://10.0.0.1/redfish/v1/Systems/1, into the
+// endpoint of the BMC and the path of its system. The system path is empty when the address does not include one.
+func ParseAddress(address string) (string, string, error) {
+	u, err := url.Parse(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid BMC address '%s': %w", address, err)
+	}
+
+	scheme, transport, _ := strings.Cut(u.Scheme, "+")
+	switch {
+	case scheme == "http" || scheme == "https":
+		transport = scheme
+	case slices.Contains(redfishSchemes, scheme):
+		if transport == "" {
+			transport = "https"
+		}
+		if transport != "http" && transport != "https" {
+			return "", "", fmt.Errorf("unsupported transport '%s' in BMC address '%s'", transport, address)
+		}
+	default:
+		return "", "", fmt.Errorf("BMC address '%s' is not a Redfish address", address)
+	}
+
+	if u.Host == "" {
+		return "", "", fmt.Errorf("BMC address '%s' is missing a host", address)
+	}
+
+	return fmt.Sprintf("%s://%s", transport, u.Host), strings.TrimSuffix(u.Path, "/"), nil
+}
+
+// NewClient creates a client for the BMC at the given address
+func NewClient(address, username, password string, insecureSkipVerify bool) (*Client, error) {
+	endpoint, systemPath, err := ParseAddress(address)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Client{
+		endpoint:   endpoint,
+		systemPath: systemPath,
+		username:   username,
+		password:   password,
+		httpClient: &http.Client{
+			Timeout: 60 * time.Second,
+			Transport: &http.Transport{
+				Proxy: http.ProxyFromEnvironment,
+				TLSClientConfig: &tls.Config{
+					InsecureSkipVerify: insecureSkipVerify, // #nosec G402 -- BMCs commonly use self-signed certificates
+					MinVersion:         tls.VersionTLS12,
+				},
+			},
+		},
+	}, nil
+}
+
+// do sends a request to the BMC, decoding the JSON response into out when it is not nil, and returns the response
+// headers
+func (c *Client) do(ctx context.Context, method, path string, body, out interface{}) (http.Header, error) {
+	var reader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal request body: %w", err)
+		}
+		reader = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.endpoint+path, reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	req.SetBasicAuth(c.username, c.password)
+	req.Header.Set("Accept", "application/json")
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send %s %s: %w", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response of %s %s: %w", method, path, err)
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, &Error{StatusCode: resp.StatusCode, Message: errorMessage(data)}
+	}
+
+	if out != nil && len(data) > 0 {
+		if err := json.Unmarshal(data, out); err != nil {
+			return nil, fmt.Errorf("failed to parse response of %s %s: %w", method, path, err)
+		}
+	}
+
+	return resp.Header, nil
+}
+
+// errorMessage extracts the message of a Redfish error response
+func errorMessage(data []byte) string {
+	var redfishErr struct {
+		Error struct {
+			Message      string `json:"message"`
+			ExtendedInfo []struct {
+				Message string `json:"Message"`
+			} `json:"@Message.ExtendedInfo"`
+		} `json:"error"`
+	}
+	if err := json.Unmarshal(data, &redfishErr); err == nil {
+		if len(redfishErr.Error.ExtendedInfo) > 0 && redfishErr.Error.ExtendedInfo[0].Message != "" {
+			return redfishErr.Error.ExtendedInfo[0].Message
+		}
+		if redfishErr.Error.Message != "" {
+			return redfishErr.Error.Message
+		}
+	}
+	return strings.TrimSpace(string(data))
+}
+
+// SystemPath returns the path of the system managed through the client, discovering it when the BMC address does
+// not include one
+func (c *Client) SystemPath(ctx context.Context) (string, error) {
+	if c.systemPath != "" && c.systemPath != redfishRoot && c.systemPath != systemsPath {
+		return c.systemPath, nil
+	}
+
+	var systems Collection
+	if _, err := c.do(ctx, http.MethodGet, systemsPath, nil, &systems); err != nil {
+		return "", fmt.Errorf("failed to list systems: %w", err)
+	}
+	if len(systems.Members) != 1 {
+		return "", fmt.Errorf("BMC address must include the system path, as the BMC manages %d systems", len(systems.Members))
+	}
+
+	c.systemPath = systems.Members[0].ODataID
+	return c.systemPath, nil
+}
+
+// GetSystem returns the system managed through the client
+func (c *Client) GetSystem(ctx context.Context) (*System, error) {
+	path, err := c.SystemPath(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	system := &System{}
+	if _, err := c.do(ctx, http.MethodGet, path, nil, system); err != nil {
+		return nil, fmt.Errorf("failed to get system %s: %w", path, err)
+	}
+	return system, nil
+}
+
+// GetEthernetInterfaces returns the network interfaces of the system
+func (c *Client) GetEthernetInterfaces(ctx context.Context, system *System) ([]EthernetInterface, error) {
+	if system.EthernetInterfaces.ODataID == "" {
+		return nil, nil
+	}
+
+	var collection Collection
+	if _, err := c.do(ctx, http.MethodGet, system.EthernetInterfaces.ODataID, nil, &collection); err != nil {
+		return nil, fmt.Errorf("failed to list ethernet interfaces: %w", err)
+	}
+
+	interfaces := make([]EthernetInterface, 0, len(collection.Members))
+	for _, member := range collection.Members {
+		var iface EthernetInterface
+		if _, err := c.do(ctx, http.MethodGet, member.ODataID, nil, &iface); err != nil {
+			return nil, fmt.Errorf("failed to get ethernet interface %s: %w", member.ODataID, err)
+		}
+		interfaces = append(interfaces, iface)
+	}
+	return interfaces, nil
+}
+
+// Reset changes the power state of the system
+func (c *Client) Reset(ctx context.Context, system *System, resetType ResetType) error {
+	target := system.Actions.Reset.Target
+	if target == "" {
+		target = system.ODataID + "/Actions/ComputerSystem.Reset"
+	}
+
+	if _, err := c.do(ctx, http.MethodPost, target, map[string]interface{}{"ResetType": resetType}, nil); err != nil {
+		return fmt.Errorf("failed to reset system %s with %s: %w", system.ODataID, resetType, err)
+	}
+	return nil
+}
+
+// GetBios returns the current BIOS attributes of the system
+func (c *Client) GetBios(ctx context.Context, system *System) (*Bios, error) {
+	if system.Bios.ODataID == "" {
+		return nil, fmt.Errorf("system %s does not expose its BIOS", system.ODataID)
+	}
+
+	bios := &Bios{}
+	if _, err := c.do(ctx, http.MethodGet, system.Bios.ODataID, nil, bios); err != nil {
+		return nil, fmt.Errorf("failed to get BIOS of system %s: %w", system.ODataID, err)
+	}
+	return bios, nil
+}
+
+// SetBiosAttributes stages BIOS attributes, which are applied by the system on its next boot
+func (c *Client) SetBiosAttributes(ctx context.Context, system *System, attributes map[string]interface{}) error {
+	bios, err := c.GetBios(ctx, system)
+	if err != nil {
+		return err
+	}
+
+	target := bios.Settings.SettingsObject.ODataID
+	if target == "" {
+		target = system.Bios.ODataID + "/Settings"
+	}
+
+	if _, err := c.do(ctx, http.MethodPatch, target, map[string]interface{}{"Attributes": attributes}, nil); err != nil {
+		return fmt.Errorf("failed to set BIOS attributes of system %s: %w", system.ODataID, err)
+	}
+	return nil
+}
+
+// GetManager returns the manager, i.e. the BMC, of the system
+func (c *Client) GetManager(ctx context.Context, system *System) (*Manager, error) {
+	if len(system.Links.ManagedBy) == 0 {
+		return nil, fmt.Errorf("system %s does not have a manager", system.ODataID)
+	}
+
+	manager := &Manager{}
+	if _, err := c.do(ctx, http.MethodGet, system.Links.ManagedBy[0].ODataID, nil, manager); err != nil {
+		return nil, fmt.Errorf("failed to get manager of system %s: %w", system.ODataID, err)
+	}
+	return manager, nil
+}
+
+// SimpleUpdate asks the UpdateService to install the firmware image at the given URI, and returns the URI of the
+// task tracking the update, or an empty string when the BMC does not report one
+func (c *Client) SimpleUpdate(ctx context.Context, imageURI string) (string, error) {
+	var task Task
+	headers, err := c.do(ctx, http.MethodPost, simpleUpdatePath, map[string]interface{}{"ImageURI": imageURI}, &task)
+	if err != nil {
+		return "", fmt.Errorf("failed to update firmware with image %s: %w", imageURI, err)
+	}
+
+	if location := headers.Get("Location"); location != "" {
+		if u, err := url.Parse(location); err == nil {
+			return u.Path, nil
+		}
+	}
+	return task.ODataID, nil
+}
+
+// GetTask returns the state of a task
+func (c *Client) GetTask(ctx context.Context, taskURI string) (*Task, error) {
+	task := &Task{}
+	if _, err := c.do(ctx, http.MethodGet, taskURI, nil, task); err != nil {
+		return nil, fmt.Errorf("failed to get task %s: %w", taskURI, err)
+	}
+	return task, nil
+}
+
+// FindUpdateTask returns the URI of a firmware update task of the TaskService that installs, or installed, the image
+// at the given URI, or an empty string when there is none. Failed tasks are ignored.
+func (c *Client) FindUpdateTask(ctx context.Context, imageURI string) (string, error) {
+	var tasks Collection
+	if _, err := c.do(ctx, http.MethodGet, tasksPath, nil, &tasks); err != nil {
+		if IsNotFound(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to list tasks: %w", err)
+	}
+
+	for _, member := range tasks.Members {
+		task, err := c.GetTask(ctx, member.ODataID)
+		if err != nil {
+			return "", err
+		}
+		if strings.HasSuffix(task.Payload.TargetURI, simpleUpdatePath) &&
+			strings.Contains(task.Payload.JSONBody, imageURI) && !task.IsFailed() {
+			return task.ODataID, nil
+		}
+	}
+	return "", nil
+}
+
+// getVirtualMedia returns the virtual media devices of the manager of the system
+func (c *Client) getVirtualMedia(ctx context.Context, system *System) ([]VirtualMedia, error) {
+	manager, err := c.GetManager(ctx, system)
+	if err != nil {
+		return nil, err
+	}
+	if manager.VirtualMedia.ODataID == "" {
+		return nil, fmt.Errorf("manager %s does not support virtual media", manager.ODataID)
+	}
+
+	var collection Collection
+	if _, err := c.do(ctx, http.MethodGet, manager.VirtualMedia.ODataID, nil, &collection); err != nil {
+		return nil, fmt.Errorf("failed to list virtual media: %w", err)
+	}
+
+	media := make([]VirtualMedia, 0, len(collection.Members))
+	for _, member := range collection.Members {
+		var device VirtualMedia
+		if _, err := c.do(ctx, http.MethodGet, member.ODataID, nil, &device); err != nil {
+			return nil, fmt.Errorf("failed to get virtual media %s: %w", member.ODataID, err)
+		}
+		media = append(media, device)
+	}
+	return media, nil
+}
+
+// InsertVirtualMedia inserts the image at the given URL in the virtual CD or DVD drive of the system
+func (c *Client) InsertVirtualMedia(ctx context.Context, system *System, image string) error {
+	media, err := c.getVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range media {
+		if !slices.Contains(device.MediaTypes, "CD") && !slices.Contains(device.MediaTypes, "DVD") {
+			continue
+		}
+
+		if device.Inserted {
+			if device.Image == image {
+				return nil
+			}
+			if err := c.ejectMedia(ctx, &device); err != nil {
+				return err
+			}
+		}
+
+		target := device.Actions.InsertMedia.Target
+		if target == "" {
+			target = device.ODataID + "/Actions/VirtualMedia.InsertMedia"
+		}
+		body := map[string]interface{}{"Image": image, "Inserted": true, "WriteProtected": true}
+		if _, err := c.do(ctx, http.MethodPost, target, body, nil); err != nil {
+			return fmt.Errorf("failed to insert virtual media %s: %w", image, err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("system %s does not have a virtual CD or DVD drive", system.ODataID)
+}
+
+// EjectVirtualMedia ejects any image inserted in the virtual media devices of the system
+func (c *Client) EjectVirtualMedia(ctx context.Context, system *System) error {
+	media, err := c.getVirtualMedia(ctx, system)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range media {
+		if device.Inserted {
+			if err := c.ejectMedia(ctx, &device); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Client) ejectMedia(ctx context.Context, device *VirtualMedia) error {
+	target := device.Actions.EjectMedia.Target
+	if target == "" {
+		target = device.ODataID + "/Actions/VirtualMedia.EjectMedia"
+	}
+	if _, err := c.do(ctx, http.MethodPost, target, map[string]interface{}{}, nil); err != nil {
+		return fmt.Errorf("failed to eject virtual media %s: %w", device.ODataID, err)
+	}
+	return nil
+}
+
+// BootFromVirtualMedia inserts the image in the virtual CD drive, and restarts the system to boot it once
+func (c *Client) BootFromVirtualMedia(ctx context.Context, system *System, image string) error {
+	if err := c.InsertVirtualMedia(ctx, system, image); err != nil {
+		return err
+	}
+
+	boot := map[string]interface{}{
+		"Boot": map[string]interface{}{
+			"BootSourceOverrideTarget":  "Cd",
+			"BootSourceOverrideEnabled": "Once",
+		},
+	}
+	if _, err := c.do(ctx, http.MethodPatch, system.ODataID, boot, nil); err != nil {
+		return fmt.Errorf("failed to set boot override of system %s: %w", system.ODataID, err)
+	}
+
+	resetType := ResetTypeForceRestart
+	if system.PowerState == PowerStateOff {
+		resetType = ResetTypeOn
+	}
+	return c.Reset(ctx, system, resetType)
+}
@@ -0,0 +1,148 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bmc_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc/emulator"
+)
+
+var _ = DescribeTable("ParseAddress",
+	func(address, endpoint, systemPath string, valid bool) {
+		gotEndpoint, gotSystemPath, err := bmc.ParseAddress(address)
+		if !valid {
+			Expect(err).To(HaveOccurred())
+			return
+		}
+		Expect(err).ToNot(HaveOccurred())
+		Expect(gotEndpoint).To(Equal(endpoint))
+		Expect(gotSystemPath).To(Equal(systemPath))
+	},
+	Entry("redfish defaults to https", "redfish://10.0.0.1/redfish/v1/Systems/1", "https://10.0.0.1", "/redfish/v1/Systems/1", true),
+	Entry("virtual media over http", "redfish-virtualmedia+http://10.0.0.1:8000/redfish/v1/Systems/abc/",
+		"http://10.0.0.1:8000", "/redfish/v1/Systems/abc", true),
+	Entry("vendor virtual media", "idrac-virtualmedia://bmc.example.com/redfish/v1/Systems/System.Embedded.1",
+		"https://bmc.example.com", "/redfish/v1/Systems/System.Embedded.1", true),
+	Entry("plain https without a system", "https://10.0.0.1", "https://10.0.0.1", "", true),
+	Entry("ipmi is not supported", "ipmi://10.0.0.1", "", "", false),
+	Entry("unknown transport", "redfish+ftp://10.0.0.1", "", "", false),
+	Entry("missing host", "redfish:///redfish/v1/Systems/1", "", "", false),
+)
+
+var _ = Describe("Client", func() {
+	var (
+		ctx      context.Context
+		emulated *emulator.Emulator
+		server   *httptest.Server
+		client   *bmc.Client
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		emulated = emulator.New("admin", "password",
+			bmc.EthernetInterface{ID: "1", Name: "eno1", MACAddress: "00:00:5E:00:53:01"})
+		emulated.SetBiosAttribute("ProcTurboMode", "Disabled")
+		emulated.Firmware["http://images/bios-2.0.0.bin"] = emulator.FirmwareImage{
+			Component: emulator.FirmwareComponentBios, Version: "2.0.0"}
+		server = httptest.NewServer(emulated)
+		DeferCleanup(server.Close)
+
+		var err error
+		client, err = bmc.NewClient(strings.Replace(server.URL, "http://", "redfish+http://", 1), "admin", "password", false)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("discovers the system and its interfaces", func() {
+		system, err := client.GetSystem(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(system.ODataID).To(Equal(emulator.SystemPath))
+		Expect(system.PowerState).To(Equal(bmc.PowerStateOff))
+
+		interfaces, err := client.GetEthernetInterfaces(ctx, system)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(interfaces).To(ConsistOf(bmc.EthernetInterface{ID: "1", Name: "eno1", MACAddress: "00:00:5E:00:53:01"}))
+	})
+
+	It("reports Redfish errors", func() {
+		client, err := bmc.NewClient(server.URL+emulator.SystemPath, "admin", "wrong", false)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = client.GetSystem(ctx)
+		Expect(err).To(MatchError(ContainSubstring("invalid credentials")))
+
+		missing, err := bmc.NewClient(server.URL+"/redfish/v1/Systems/2", "admin", "password", false)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = missing.GetSystem(ctx)
+		Expect(bmc.IsNotFound(err)).To(BeTrue())
+	})
+
+	It("stages BIOS attributes until the system restarts", func() {
+		system, err := client.GetSystem(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.SetBiosAttributes(ctx, system, map[string]interface{}{"ProcTurboMode": "Enabled"})).To(Succeed())
+		Expect(emulated.BiosAttributes()).To(HaveKeyWithValue("ProcTurboMode", "Disabled"))
+
+		Expect(client.SetBiosAttributes(ctx, system, map[string]interface{}{"Unknown": 1})).
+			To(MatchError(ContainSubstring("unknown BIOS attribute")))
+
+		Expect(client.Reset(ctx, system, bmc.ResetTypeOn)).To(Succeed())
+		Expect(emulated.PowerState()).To(Equal(bmc.PowerStateOn))
+		bios, err := client.GetBios(ctx, system)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(bios.Attributes).To(HaveKeyWithValue("ProcTurboMode", "Enabled"))
+	})
+
+	It("tracks firmware updates through their task", func() {
+		taskURI, err := client.SimpleUpdate(ctx, "http://images/bios-2.0.0.bin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(taskURI).To(HavePrefix("/redfish/v1/TaskService/Tasks/"))
+
+		task, err := client.GetTask(ctx, taskURI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.IsFinished()).To(BeTrue())
+		Expect(task.IsFailed()).To(BeFalse())
+
+		found, err := client.FindUpdateTask(ctx, "http://images/bios-2.0.0.bin")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(Equal(taskURI))
+
+		emulated.FailUpdates = true
+		taskURI, err = client.SimpleUpdate(ctx, "http://images/bios-2.0.0.bin")
+		Expect(err).ToNot(HaveOccurred())
+		task, err = client.GetTask(ctx, taskURI)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(task.IsFailed()).To(BeTrue())
+		Expect(task.Message()).To(ContainSubstring("failed to install"))
+
+		system, err := client.GetSystem(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.Reset(ctx, system, bmc.ResetTypeForceRestart)).To(Succeed())
+		biosVersion, _ := emulated.FirmwareVersions()
+		Expect(biosVersion).To(Equal("2.0.0"))
+	})
+
+	It("boots from virtual media and ejects it", func() {
+		system, err := client.GetSystem(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(client.BootFromVirtualMedia(ctx, system, "http://images/discovery.iso")).To(Succeed())
+		Expect(emulated.InsertedImage()).To(Equal("http://images/discovery.iso"))
+		Expect(emulated.BootOverride()).To(Equal("Cd"))
+		Expect(emulated.Resets()).To(Equal([]bmc.ResetType{bmc.ResetTypeOn}))
+
+		// Inserting the same image again is a no-op
+		Expect(client.InsertVirtualMedia(ctx, system, "http://images/discovery.iso")).To(Succeed())
+
+		Expect(client.EjectVirtualMedia(ctx, system)).To(Succeed())
+		Expect(emulated.InsertedImage()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,367 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package emulator provides an in-memory Redfish BMC managing a single system, in the spirit of the sushy-tools
+// emulator, to test the Redfish hardware plugin without hardware. BIOS settings and firmware updates are staged, and
+// applied when the system is powered on or restarted, like on a real server.
+package emulator
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+)
+
+const (
+	SystemPath  = "/redfish/v1/Systems/1"
+	managerPath = "/redfish/v1/Managers/1"
+	mediaPath   = managerPath + "/VirtualMedia/Cd"
+	tasksPath   = "/redfish/v1/TaskService/Tasks"
+)
+
+type FirmwareComponent string
+
+const (
+	FirmwareComponentBios FirmwareComponent = "bios"
+	FirmwareComponentBMC  FirmwareComponent = "bmc"
+)
+
+// FirmwareImage describes the firmware installed by an image of the emulated UpdateService
+type FirmwareImage struct {
+	Component FirmwareComponent
+	Version   string
+}
+
+// Emulator is an http.Handler serving the Redfish API of a single emulated system
+type Emulator struct {
+	Username string
+	Password string
+
+	// Firmware maps the URI of the firmware images the UpdateService can install to their content
+	Firmware map[string]FirmwareImage
+
+	// FailUpdates makes the firmware update tasks end in the Exception state
+	FailUpdates bool
+
+	mutex              sync.Mutex
+	system             bmc.System
+	interfaces         []bmc.EthernetInterface
+	biosAttributes     map[string]interface{}
+	pendingBios        map[string]interface{}
+	bmcFirmwareVersion string
+	pendingFirmware    []FirmwareImage
+	media              bmc.VirtualMedia
+	tasks              map[string]*bmc.Task
+	resets             []bmc.ResetType
+}
+
+// New creates an emulator for a powered off system with the given network interfaces
+func New(username, password string, interfaces ...bmc.EthernetInterface) *Emulator {
+	e := &Emulator{
+		Username:           username,
+		Password:           password,
+		Firmware:           make(map[string]FirmwareImage),
+		interfaces:         interfaces,
+		biosAttributes:     make(map[string]interface{}),
+		pendingBios:        make(map[string]interface{}),
+		bmcFirmwareVersion: "1.0.0",
+		tasks:              make(map[string]*bmc.Task),
+	}
+
+	e.system = bmc.System{
+		ODataID:      SystemPath,
+		ID:           "1",
+		Name:         "Emulated System",
+		Manufacturer: "Emulator",
+		Model:        "Virtual Server",
+		SerialNumber: "EMU0001",
+		BiosVersion:  "1.0.0",
+		PowerState:   bmc.PowerStateOff,
+		Bios:         bmc.Link{ODataID: SystemPath + "/Bios"},
+		EthernetInterfaces: bmc.Link{
+			ODataID: SystemPath + "/EthernetInterfaces",
+		},
+	}
+	e.system.Status.State = "Enabled"
+	e.system.Status.Health = "OK"
+	e.system.MemorySummary.TotalSystemMemoryGiB = 64
+	e.system.ProcessorSummary.Count = 2
+	e.system.ProcessorSummary.Model = "Emulated CPU"
+	e.system.Links.ManagedBy = []bmc.Link{{ODataID: managerPath}}
+	e.system.Actions.Reset.Target = SystemPath + "/Actions/ComputerSystem.Reset"
+
+	e.media = bmc.VirtualMedia{
+		ODataID:    mediaPath,
+		ID:         "Cd",
+		MediaTypes: []string{"CD", "DVD"},
+	}
+	e.media.Actions.InsertMedia.Target = mediaPath + "/Actions/VirtualMedia.InsertMedia"
+	e.media.Actions.EjectMedia.Target = mediaPath + "/Actions/VirtualMedia.EjectMedia"
+
+	return e
+}
+
+// SetBiosAttribute sets the current value of a BIOS attribute
+func (e *Emulator) SetBiosAttribute(name string, value interface{}) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.biosAttributes[name] = value
+}
+
+// BiosAttributes returns the current BIOS attributes
+func (e *Emulator) BiosAttributes() map[string]interface{} {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return maps.Clone(e.biosAttributes)
+}
+
+// FirmwareVersions returns the current BIOS and BMC firmware versions
+func (e *Emulator) FirmwareVersions() (string, string) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.system.BiosVersion, e.bmcFirmwareVersion
+}
+
+// PowerState returns the current power state of the system
+func (e *Emulator) PowerState() bmc.PowerState {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.system.PowerState
+}
+
+// InsertedImage returns the image inserted in the virtual CD drive, if any
+func (e *Emulator) InsertedImage() string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.media.Image
+}
+
+// BootOverride returns the boot source override target of the system
+func (e *Emulator) BootOverride() string {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return e.system.Boot.BootSourceOverrideTarget
+}
+
+// UpdateTasks returns the number of firmware updates started so far
+func (e *Emulator) UpdateTasks() int {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return len(e.tasks)
+}
+
+// Resets returns the reset types requested so far
+func (e *Emulator) Resets() []bmc.ResetType {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	return append([]bmc.ResetType{}, e.resets...)
+}
+
+func writeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body) // nolint: errchkjson
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error": map[string]interface{}{"message": message},
+	})
+}
+
+// ServeHTTP implements the emulated Redfish API
+func (e *Emulator) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if username, password, ok := r.BasicAuth(); !ok || username != e.Username || password != e.Password {
+		writeError(w, http.StatusUnauthorized, "invalid credentials")
+		return
+	}
+
+	var body map[string]interface{}
+	if r.Method == http.MethodPost || r.Method == http.MethodPatch {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("invalid request body: %s", err.Error()))
+			return
+		}
+	}
+
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	path := strings.TrimSuffix(r.URL.Path, "/")
+	switch {
+	case r.Method == http.MethodGet && path == "/redfish/v1/Systems":
+		writeJSON(w, http.StatusOK, bmc.Collection{Members: []bmc.Link{{ODataID: SystemPath}}})
+	case r.Method == http.MethodGet && path == SystemPath:
+		writeJSON(w, http.StatusOK, e.system)
+	case r.Method == http.MethodPatch && path == SystemPath:
+		e.patchSystem(w, body)
+	case r.Method == http.MethodPost && path == e.system.Actions.Reset.Target:
+		e.reset(w, body)
+	case r.Method == http.MethodGet && path == e.system.EthernetInterfaces.ODataID:
+		members := []bmc.Link{}
+		for _, iface := range e.interfaces {
+			members = append(members, bmc.Link{ODataID: path + "/" + iface.ID})
+		}
+		writeJSON(w, http.StatusOK, bmc.Collection{Members: members})
+	case r.Method == http.MethodGet && strings.HasPrefix(path, e.system.EthernetInterfaces.ODataID+"/"):
+		e.getInterface(w, strings.TrimPrefix(path, e.system.EthernetInterfaces.ODataID+"/"))
+	case r.Method == http.MethodGet && path == e.system.Bios.ODataID:
+		bios := map[string]interface{}{
+			"Attributes":        e.biosAttributes,
+			"@Redfish.Settings": map[string]interface{}{"SettingsObject": bmc.Link{ODataID: path + "/Settings"}},
+		}
+		writeJSON(w, http.StatusOK, bios)
+	case r.Method == http.MethodPatch && path == e.system.Bios.ODataID+"/Settings":
+		e.patchBios(w, body)
+	case r.Method == http.MethodGet && path == managerPath:
+		writeJSON(w, http.StatusOK, bmc.Manager{
+			ODataID:         managerPath,
+			ID:              "1",
+			FirmwareVersion: e.bmcFirmwareVersion,
+			VirtualMedia:    bmc.Link{ODataID: managerPath + "/VirtualMedia"},
+		})
+	case r.Method == http.MethodGet && path == managerPath+"/VirtualMedia":
+		writeJSON(w, http.StatusOK, bmc.Collection{Members: []bmc.Link{{ODataID: mediaPath}}})
+	case r.Method == http.MethodGet && path == mediaPath:
+		writeJSON(w, http.StatusOK, e.media)
+	case r.Method == http.MethodPost && path == e.media.Actions.InsertMedia.Target:
+		image, _ := body["Image"].(string)
+		if image == "" {
+			writeError(w, http.StatusBadRequest, "missing Image")
+			return
+		}
+		e.media.Image = image
+		e.media.Inserted = true
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && path == e.media.Actions.EjectMedia.Target:
+		e.media.Image = ""
+		e.media.Inserted = false
+		w.WriteHeader(http.StatusNoContent)
+	case r.Method == http.MethodPost && path == "/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate":
+		e.simpleUpdate(w, body)
+	case r.Method == http.MethodGet && path == tasksPath:
+		members := []bmc.Link{}
+		for i := 1; i <= len(e.tasks); i++ {
+			members = append(members, bmc.Link{ODataID: fmt.Sprintf("%s/%d", tasksPath, i)})
+		}
+		writeJSON(w, http.StatusOK, bmc.Collection{Members: members})
+	case r.Method == http.MethodGet && strings.HasPrefix(path, tasksPath+"/"):
+		task, exists := e.tasks[path]
+		if !exists {
+			writeError(w, http.StatusNotFound, fmt.Sprintf("task %s not found", path))
+			return
+		}
+		writeJSON(w, http.StatusOK, task)
+	default:
+		writeError(w, http.StatusNotFound, fmt.Sprintf("%s %s is not supported", r.Method, path))
+	}
+}
+
+func (e *Emulator) getInterface(w http.ResponseWriter, id string) {
+	for _, iface := range e.interfaces {
+		if iface.ID == id {
+			writeJSON(w, http.StatusOK, iface)
+			return
+		}
+	}
+	writeError(w, http.StatusNotFound, fmt.Sprintf("interface %s not found", id))
+}
+
+func (e *Emulator) patchSystem(w http.ResponseWriter, body map[string]interface{}) {
+	if boot, ok := body["Boot"].(map[string]interface{}); ok {
+		if target, ok := boot["BootSourceOverrideTarget"].(string); ok {
+			e.system.Boot.BootSourceOverrideTarget = target
+		}
+		if enabled, ok := boot["BootSourceOverrideEnabled"].(string); ok {
+			e.system.Boot.BootSourceOverrideEnabled = enabled
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) patchBios(w http.ResponseWriter, body map[string]interface{}) {
+	attributes, ok := body["Attributes"].(map[string]interface{})
+	if !ok {
+		writeError(w, http.StatusBadRequest, "missing Attributes")
+		return
+	}
+	for name, value := range attributes {
+		if _, exists := e.biosAttributes[name]; !exists {
+			writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown BIOS attribute %s", name))
+			return
+		}
+		e.pendingBios[name] = value
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// reset changes the power state of the system, applying the staged BIOS settings and firmware when it boots
+func (e *Emulator) reset(w http.ResponseWriter, body map[string]interface{}) {
+	resetType := bmc.ResetType(fmt.Sprint(body["ResetType"]))
+	switch resetType {
+	case bmc.ResetTypeOn, bmc.ResetTypeForceRestart:
+		e.boot()
+	case bmc.ResetTypeForceOff:
+		e.system.PowerState = bmc.PowerStateOff
+	default:
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unsupported ResetType %s", resetType))
+		return
+	}
+	e.resets = append(e.resets, resetType)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (e *Emulator) boot() {
+	e.system.PowerState = bmc.PowerStateOn
+	maps.Copy(e.biosAttributes, e.pendingBios)
+	e.pendingBios = make(map[string]interface{})
+
+	for _, firmware := range e.pendingFirmware {
+		switch firmware.Component {
+		case FirmwareComponentBios:
+			e.system.BiosVersion = firmware.Version
+		case FirmwareComponentBMC:
+			e.bmcFirmwareVersion = firmware.Version
+		}
+	}
+	e.pendingFirmware = nil
+
+	if e.system.Boot.BootSourceOverrideEnabled == "Once" {
+		e.system.Boot.BootSourceOverrideEnabled = "Disabled"
+	}
+}
+
+func (e *Emulator) simpleUpdate(w http.ResponseWriter, body map[string]interface{}) {
+	imageURI, _ := body["ImageURI"].(string)
+	firmware, exists := e.Firmware[imageURI]
+	if !exists {
+		writeError(w, http.StatusBadRequest, fmt.Sprintf("unknown firmware image %s", imageURI))
+		return
+	}
+
+	taskPath := fmt.Sprintf("%s/%d", tasksPath, len(e.tasks)+1)
+	task := &bmc.Task{ODataID: taskPath, ID: fmt.Sprint(len(e.tasks) + 1), TaskState: bmc.TaskStateCompleted, TaskStatus: "OK"}
+	task.Payload.TargetURI = "/redfish/v1/UpdateService/Actions/UpdateService.SimpleUpdate"
+	if payload, err := json.Marshal(body); err == nil {
+		task.Payload.JSONBody = string(payload)
+	}
+	if e.FailUpdates {
+		task.TaskState = bmc.TaskStateException
+		task.TaskStatus = "Critical"
+		task.Messages = append(task.Messages, bmc.Message{Message: fmt.Sprintf("failed to install %s", imageURI)})
+	} else {
+		e.pendingFirmware = append(e.pendingFirmware, firmware)
+	}
+	e.tasks[taskPath] = task
+
+	w.Header().Set("Location", taskPath)
+	writeJSON(w, http.StatusAccepted, task)
+}
@@ -0,0 +1,174 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package bmc
+
+// Link references another Redfish resource
+type Link struct {
+	ODataID string `json:"@odata.id"`
+}
+
+// Collection is a Redfish resource collection
+type Collection struct {
+	Members []Link `json:"Members"`
+}
+
+// ActionTarget is the URI to post a Redfish action to
+type ActionTarget struct {
+	Target string `json:"target"`
+}
+
+type PowerState string
+
+const (
+	PowerStateOn  PowerState = "On"
+	PowerStateOff PowerState = "Off"
+)
+
+type ResetType string
+
+const (
+	ResetTypeOn           ResetType = "On"
+	ResetTypeForceOff     ResetType = "ForceOff"
+	ResetTypeForceRestart ResetType = "ForceRestart"
+)
+
+// System is a Redfish ComputerSystem
+type System struct {
+	ODataID      string     `json:"@odata.id"`
+	ID           string     `json:"Id"`
+	Name         string     `json:"Name"`
+	HostName     string     `json:"HostName,omitempty"`
+	Manufacturer string     `json:"Manufacturer,omitempty"`
+	Model        string     `json:"Model,omitempty"`
+	SerialNumber string     `json:"SerialNumber,omitempty"`
+	PartNumber   string     `json:"PartNumber,omitempty"`
+	BiosVersion  string     `json:"BiosVersion,omitempty"`
+	PowerState   PowerState `json:"PowerState,omitempty"`
+
+	Status struct {
+		State  string `json:"State,omitempty"`
+		Health string `json:"Health,omitempty"`
+	} `json:"Status"`
+
+	MemorySummary struct {
+		TotalSystemMemoryGiB float64 `json:"TotalSystemMemoryGiB,omitempty"`
+	} `json:"MemorySummary"`
+
+	ProcessorSummary struct {
+		Count int    `json:"Count,omitempty"`
+		Model string `json:"Model,omitempty"`
+	} `json:"ProcessorSummary"`
+
+	Boot struct {
+		BootSourceOverrideEnabled string `json:"BootSourceOverrideEnabled,omitempty"`
+		BootSourceOverrideTarget  string `json:"BootSourceOverrideTarget,omitempty"`
+	} `json:"Boot"`
+
+	Bios               Link `json:"Bios"`
+	EthernetInterfaces Link `json:"EthernetInterfaces"`
+
+	Links struct {
+		ManagedBy []Link `json:"ManagedBy"`
+	} `json:"Links"`
+
+	Actions struct {
+		Reset ActionTarget `json:"#ComputerSystem.Reset"`
+	} `json:"Actions"`
+}
+
+// EthernetInterface is a network interface of a Redfish ComputerSystem
+type EthernetInterface struct {
+	ID         string `json:"Id"`
+	Name       string `json:"Name"`
+	MACAddress string `json:"MACAddress"`
+}
+
+// Bios holds the current BIOS attributes of a Redfish ComputerSystem
+type Bios struct {
+	Attributes map[string]interface{} `json:"Attributes"`
+
+	Settings struct {
+		SettingsObject Link `json:"SettingsObject"`
+	} `json:"@Redfish.Settings"`
+}
+
+// Manager is the Redfish manager, i.e. the BMC, of a ComputerSystem
+type Manager struct {
+	ODataID         string `json:"@odata.id"`
+	ID              string `json:"Id"`
+	FirmwareVersion string `json:"FirmwareVersion,omitempty"`
+	VirtualMedia    Link   `json:"VirtualMedia"`
+}
+
+// VirtualMedia is a virtual media device of a Redfish manager
+type VirtualMedia struct {
+	ODataID    string   `json:"@odata.id"`
+	ID         string   `json:"Id"`
+	MediaTypes []string `json:"MediaTypes"`
+	Image      string   `json:"Image,omitempty"`
+	Inserted   bool     `json:"Inserted"`
+
+	Actions struct {
+		InsertMedia ActionTarget `json:"#VirtualMedia.InsertMedia"`
+		EjectMedia  ActionTarget `json:"#VirtualMedia.EjectMedia"`
+	} `json:"Actions"`
+}
+
+type TaskState string
+
+const (
+	TaskStateCompleted TaskState = "Completed"
+	TaskStateException TaskState = "Exception"
+	TaskStateKilled    TaskState = "Killed"
+	TaskStateCancelled TaskState = "Cancelled"
+)
+
+// Task tracks a long running Redfish operation, such as a firmware update
+type Task struct {
+	ODataID    string    `json:"@odata.id"`
+	ID         string    `json:"Id"`
+	TaskState  TaskState `json:"TaskState"`
+	TaskStatus string    `json:"TaskStatus,omitempty"`
+	Messages   []Message `json:"Messages,omitempty"`
+
+	// Payload is the request that started the task
+	Payload struct {
+		TargetURI string `json:"TargetUri,omitempty"`
+		JSONBody  string `json:"JsonBody,omitempty"`
+	} `json:"Payload"`
+}
+
+// Message is a message reported by a Redfish task
+type Message struct {
+	Message string `json:"Message"`
+}
+
+// IsFinished returns whether the task has reached a terminal state
+func (t *Task) IsFinished() bool {
+	switch t.TaskState {
+	case TaskStateCompleted, TaskStateException, TaskStateKilled, TaskStateCancelled:
+		return true
+	}
+	return false
+}
+
+// IsFailed returns whether the task has terminated without completing
+func (t *Task) IsFailed() bool {
+	return t.IsFinished() && t.TaskState != TaskStateCompleted
+}
+
+// Message returns the messages reported by the task
+func (t *Task) Message() string {
+	message := ""
+	for _, m := range t.Messages {
+		if message != "" {
+			message += "; "
+		}
+		message += m.Message
+	}
+	return message
+}
@@ -0,0 +1,199 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+)
+
+// HostsConfigKey is the key of the host inventory in its ConfigMap
+const HostsConfigKey = "hosts.yaml"
+
+// DefaultConfigurationTimeout bounds the firmware updates and BIOS changes applied to a host
+const DefaultConfigurationTimeout = time.Hour
+
+// HostInventory lists the servers managed by the Redfish HardwarePlugin
+type HostInventory struct {
+	Hosts []Host `json:"hosts"`
+
+	// BootImage is the URL of an ISO booted through virtual media once a host is allocated and configured, such as
+	// a discovery image. Hosts are left as they are when it is empty.
+	BootImage string `json:"bootImage,omitempty"`
+
+	// ConfigurationTimeout bounds the time a host may take to apply its hardware profile
+	ConfigurationTimeout *metav1.Duration `json:"configurationTimeout,omitempty"`
+}
+
+// HostBMC describes how to reach the Redfish API of a host
+type HostBMC struct {
+	// Address of the BMC, such as redfish-virtualmedia+https://10.0.0.1/redfish/v1/Systems/1
+	Address string `json:"address"`
+
+	// CredentialsName is the name of the secret, in the plugin namespace, holding the username and password of the BMC
+	CredentialsName string `json:"credentialsName"`
+
+	// DisableCertificateVerification skips the verification of the BMC certificate
+	DisableCertificateVerification bool `json:"disableCertificateVerification,omitempty"`
+}
+
+// Host describes a server managed through Redfish
+type Host struct {
+	// ID uniquely identifies the host, and is used as its resource identifier
+	ID string `json:"id"`
+
+	ResourcePoolID string `json:"resourcePoolId"`
+	SiteID         string `json:"siteId"`
+
+	// Hostname defaults to the host name reported by Redfish, then to the ID of the host
+	Hostname string `json:"hostname,omitempty"`
+
+	// Labels are matched against the resourceSelector of the node groups
+	Labels map[string]string `json:"labels,omitempty"`
+
+	BMC HostBMC `json:"bmc"`
+
+	// InterfaceLabels maps the Redfish ID, name or MAC address of the network interfaces to their label, such as the
+	// boot interface label of the NodeAllocationRequests
+	InterfaceLabels map[string]string `json:"interfaceLabels,omitempty"`
+
+	// BootImage overrides the boot image of the inventory for this host
+	BootImage string `json:"bootImage,omitempty"`
+}
+
+// MatchesSelector returns whether the host labels contain all the labels of the resource selector
+func (h *Host) MatchesSelector(selector map[string]string) bool {
+	for key, value := range selector {
+		if h.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// InterfaceLabel returns the label of a network interface of the host
+func (h *Host) InterfaceLabel(iface *bmc.EthernetInterface) string {
+	for _, key := range []string{iface.ID, iface.Name, strings.ToLower(iface.MACAddress)} {
+		if label, exists := h.InterfaceLabels[key]; exists {
+			return label
+		}
+	}
+	for key, label := range h.InterfaceLabels {
+		if strings.EqualFold(key, iface.MACAddress) {
+			return label
+		}
+	}
+	return ""
+}
+
+// GetBootImage returns the image booted through virtual media on the host once it is allocated
+func (i *HostInventory) GetBootImage(host *Host) string {
+	if host.BootImage != "" {
+		return host.BootImage
+	}
+	return i.BootImage
+}
+
+// GetConfigurationTimeout returns how long a host may take to apply its hardware profile
+func (i *HostInventory) GetConfigurationTimeout() time.Duration {
+	if i.ConfigurationTimeout != nil && i.ConfigurationTimeout.Duration > 0 {
+		return i.ConfigurationTimeout.Duration
+	}
+	return DefaultConfigurationTimeout
+}
+
+// GetHost returns the host with the given ID, or nil if there is none
+func (i *HostInventory) GetHost(id string) *Host {
+	for idx := range i.Hosts {
+		if i.Hosts[idx].ID == id {
+			return &i.Hosts[idx]
+		}
+	}
+	return nil
+}
+
+// Validate checks that the host inventory is usable
+func (i *HostInventory) Validate() error {
+	ids := make(map[string]bool)
+	for _, host := range i.Hosts {
+		if errs := validation.IsDNS1123Subdomain(host.ID); len(errs) > 0 {
+			return fmt.Errorf("host id '%s' is invalid: %s", host.ID, strings.Join(errs, ", "))
+		}
+		if ids[host.ID] {
+			return fmt.Errorf("host id '%s' is not unique", host.ID)
+		}
+		ids[host.ID] = true
+
+		if host.ResourcePoolID == "" || host.SiteID == "" {
+			return fmt.Errorf("host '%s' must have a resourcePoolId and a siteId", host.ID)
+		}
+		if _, _, err := bmc.ParseAddress(host.BMC.Address); err != nil {
+			return fmt.Errorf("host '%s' has an invalid BMC: %w", host.ID, err)
+		}
+		if host.BMC.CredentialsName == "" {
+			return fmt.Errorf("host '%s' is missing the credentialsName of its BMC", host.ID)
+		}
+	}
+	return nil
+}
+
+// ParseHostInventory parses and validates a YAML host inventory
+func ParseHostInventory(data []byte) (*HostInventory, error) {
+	inventory := &HostInventory{}
+	if err := yaml.UnmarshalStrict(data, inventory); err != nil {
+		return nil, fmt.Errorf("failed to parse host inventory: %w", err)
+	}
+
+	if err := inventory.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid host inventory: %w", err)
+	}
+	return inventory, nil
+}
+
+// GetHostInventory reads the host inventory from its ConfigMap
+func GetHostInventory(ctx context.Context, c client.Reader, namespace, name string) (*HostInventory, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: name}
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, key, cm); err != nil {
+		return nil, fmt.Errorf("failed to get host inventory ConfigMap %s: %w", key, err)
+	}
+
+	data, exists := cm.Data[HostsConfigKey]
+	if !exists {
+		return nil, fmt.Errorf("host inventory ConfigMap %s is missing the '%s' key", key, HostsConfigKey)
+	}
+
+	return ParseHostInventory([]byte(data))
+}
+
+// NewBMCClient creates a Redfish client for the BMC of a host, using the credentials from its secret
+func NewBMCClient(ctx context.Context, c client.Reader, namespace string, host *Host) (*bmc.Client, error) {
+	key := types.NamespacedName{Namespace: namespace, Name: host.BMC.CredentialsName}
+	secret := &corev1.Secret{}
+	if err := c.Get(ctx, key, secret); err != nil {
+		return nil, fmt.Errorf("failed to get BMC credentials %s of host '%s': %w", key, host.ID, err)
+	}
+
+	username, password := string(secret.Data["username"]), string(secret.Data["password"])
+	if username == "" || password == "" {
+		return nil, fmt.Errorf("BMC credentials %s of host '%s' must have a username and a password", key, host.ID)
+	}
+
+	// nolint: wrapcheck
+	return bmc.NewClient(host.BMC.Address, username, password, host.BMC.DisableCertificateVerification)
+}
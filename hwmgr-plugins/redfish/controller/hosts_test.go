@@ -0,0 +1,89 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+)
+
+var _ = Describe("Host inventory", func() {
+	It("parses a valid inventory", func() {
+		inventory, err := ParseHostInventory([]byte(`
+bootImage: http://images/discovery.iso
+configurationTimeout: 30m
+hosts:
+- id: host-1
+  resourcePoolId: pool-1
+  siteId: site-1
+  labels:
+    server-type: dell
+  bmc:
+    address: idrac-virtualmedia://10.0.0.1/redfish/v1/Systems/System.Embedded.1
+    credentialsName: host-1-bmc-secret
+  interfaceLabels:
+    eno1: bootable-interface
+- id: host-2
+  resourcePoolId: pool-1
+  siteId: site-1
+  bootImage: http://images/other.iso
+  bmc:
+    address: redfish+http://10.0.0.2:8000/redfish/v1/Systems/1
+    credentialsName: host-2-bmc-secret
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(inventory.Hosts).To(HaveLen(2))
+		Expect(inventory.GetConfigurationTimeout()).To(Equal(30 * time.Minute))
+
+		host := inventory.GetHost("host-1")
+		Expect(host).ToNot(BeNil())
+		Expect(host.MatchesSelector(map[string]string{"server-type": "dell"})).To(BeTrue())
+		Expect(host.MatchesSelector(map[string]string{"server-type": "hpe"})).To(BeFalse())
+		Expect(inventory.GetBootImage(host)).To(Equal("http://images/discovery.iso"))
+		Expect(inventory.GetBootImage(inventory.GetHost("host-2"))).To(Equal("http://images/other.iso"))
+		Expect(inventory.GetHost("host-3")).To(BeNil())
+	})
+
+	DescribeTable("rejects invalid inventories",
+		func(data, message string) {
+			_, err := ParseHostInventory([]byte(data))
+			Expect(err).To(MatchError(ContainSubstring(message)))
+		},
+		Entry("unknown field", "hosts:\n- id: host-1\n  unknown: true\n", "unknown field"),
+		Entry("invalid id", "hosts:\n- id: Host_1\n", "is invalid"),
+		Entry("duplicate id", `
+hosts:
+- {id: host-1, resourcePoolId: pool-1, siteId: site-1, bmc: {address: "redfish://10.0.0.1", credentialsName: secret}}
+- {id: host-1, resourcePoolId: pool-1, siteId: site-1, bmc: {address: "redfish://10.0.0.2", credentialsName: secret}}
+`, "not unique"),
+		Entry("missing pool", "hosts:\n- {id: host-1, siteId: site-1}\n", "must have a resourcePoolId"),
+		Entry("unsupported BMC", `
+hosts:
+- {id: host-1, resourcePoolId: pool-1, siteId: site-1, bmc: {address: "ipmi://10.0.0.1", credentialsName: secret}}
+`, "not a Redfish address"),
+		Entry("missing credentials", `
+hosts:
+- {id: host-1, resourcePoolId: pool-1, siteId: site-1, bmc: {address: "redfish://10.0.0.1"}}
+`, "missing the credentialsName"),
+	)
+
+	It("labels interfaces by ID, name or MAC address", func() {
+		host := &Host{InterfaceLabels: map[string]string{
+			"eno1":              "bootable-interface",
+			"NIC.Slot.1-1":      "data",
+			"00:00:5e:00:53:03": "storage",
+		}}
+		Expect(host.InterfaceLabel(&bmc.EthernetInterface{ID: "1", Name: "eno1"})).To(Equal("bootable-interface"))
+		Expect(host.InterfaceLabel(&bmc.EthernetInterface{ID: "NIC.Slot.1-1"})).To(Equal("data"))
+		Expect(host.InterfaceLabel(&bmc.EthernetInterface{ID: "3", MACAddress: "00:00:5E:00:53:03"})).To(Equal("storage"))
+		Expect(host.InterfaceLabel(&bmc.EthernetInterface{ID: "4"})).To(BeEmpty())
+	})
+})
@@ -0,0 +1,208 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+)
+
+// getAllocatedNodes maps the ID of each allocated host to its AllocatedNode
+func getAllocatedNodes(ctx context.Context, c client.Client, namespace string) (map[string]*pluginsv1alpha1.AllocatedNode, error) {
+	var nodelist pluginsv1alpha1.AllocatedNodeList
+	if err := c.List(ctx, &nodelist, client.InNamespace(namespace),
+		client.MatchingLabels{hwmgrutils.HardwarePluginLabel: hwmgrutils.RedfishHardwarePluginID}); err != nil {
+		return nil, fmt.Errorf("failed to list AllocatedNodes: %w", err)
+	}
+
+	nodes := make(map[string]*pluginsv1alpha1.AllocatedNode)
+	for i := range nodelist.Items {
+		nodes[nodelist.Items[i].Spec.HwMgrNodeId] = &nodelist.Items[i]
+	}
+	return nodes, nil
+}
+
+// getSystem reads the Redfish system of a host, returning nil when its BMC cannot be reached
+func getSystem(ctx context.Context, logger *slog.Logger, reader client.Reader, namespace string, host *Host) *bmc.System {
+	bmcClient, err := NewBMCClient(ctx, reader, namespace, host)
+	if err == nil {
+		var system *bmc.System
+		if system, err = bmcClient.GetSystem(ctx); err == nil {
+			return system
+		}
+	}
+
+	logger.WarnContext(ctx, "Failed to get Redfish system of host",
+		slog.String("host", host.ID), slog.String("error", err.Error()))
+	return nil
+}
+
+func getResourceInfo(host *Host, system *bmc.System, node *pluginsv1alpha1.AllocatedNode) inventory.ResourceInfo {
+	resource := inventory.ResourceInfo{
+		AdminState:       inventory.ResourceInfoAdminStateUNLOCKED,
+		Description:      host.ID,
+		Name:             host.ID,
+		OperationalState: inventory.ResourceInfoOperationalStateUNKNOWN,
+		Processors:       []inventory.ProcessorInfo{},
+		ResourceId:       host.ID,
+		ResourcePoolId:   host.ResourcePoolID,
+		UsageState:       inventory.IDLE,
+	}
+
+	if len(host.Labels) > 0 {
+		labels := make(map[string]string, len(host.Labels))
+		tags := make([]string, 0, len(host.Labels))
+		for key, value := range host.Labels {
+			labels[key] = value
+			tags = append(tags, fmt.Sprintf("%s: %s", key, value))
+		}
+		slices.Sort(tags)
+		resource.Labels = &labels
+		resource.Tags = &tags
+	}
+
+	if system != nil {
+		resource.OperationalState = inventory.ResourceInfoOperationalStateENABLED
+		if system.Status.State != "" && system.Status.State != "Enabled" {
+			resource.OperationalState = inventory.ResourceInfoOperationalStateDISABLED
+		}
+		resource.Vendor = system.Manufacturer
+		resource.Model = system.Model
+		resource.SerialNumber = system.SerialNumber
+		resource.PartNumber = system.PartNumber
+		resource.Memory = int(system.MemorySummary.TotalSystemMemoryGiB * 1024)
+		if system.ProcessorSummary.Model != "" {
+			model := system.ProcessorSummary.Model
+			resource.Processors = append(resource.Processors, inventory.ProcessorInfo{Model: &model})
+		}
+
+		powerState := inventory.OFF
+		if system.PowerState == bmc.PowerStateOn {
+			powerState = inventory.ON
+		}
+		resource.PowerState = &powerState
+	}
+
+	if node != nil {
+		resource.HwProfile = node.Status.HwProfile
+		resource.UsageState = inventory.ACTIVE
+	}
+
+	return resource
+}
+
+func getResourcePoolInfo(host *Host) inventory.ResourcePoolInfo {
+	siteID := host.SiteID
+	return inventory.ResourcePoolInfo{
+		ResourcePoolId: host.ResourcePoolID,
+		Description:    host.ResourcePoolID,
+		Name:           host.ResourcePoolID,
+		SiteId:         &siteID,
+	}
+}
+
+// getPoolResources returns the resources of the hosts of a resource pool. The BMC credentials are read with the
+// given reader, so that secrets do not need to be cached.
+func getPoolResources(ctx context.Context, logger *slog.Logger, c client.Client, reader client.Reader, namespace string,
+	hosts *HostInventory, poolID string) ([]inventory.ResourceInfo, error) {
+	nodes, err := getAllocatedNodes(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []inventory.ResourceInfo
+	for i := range hosts.Hosts {
+		host := &hosts.Hosts[i]
+		if poolID == "" || host.ResourcePoolID == poolID {
+			system := getSystem(ctx, logger, reader, namespace, host)
+			resources = append(resources, getResourceInfo(host, system, nodes[host.ID]))
+		}
+	}
+	return resources, nil
+}
+
+func GetResourcePools(hosts *HostInventory) (inventory.GetResourcePoolsResponseObject, error) {
+	var resp []inventory.ResourcePoolInfo
+	seen := make(map[string]bool)
+	for i := range hosts.Hosts {
+		if !seen[hosts.Hosts[i].ResourcePoolID] {
+			seen[hosts.Hosts[i].ResourcePoolID] = true
+			resp = append(resp, getResourcePoolInfo(&hosts.Hosts[i]))
+		}
+	}
+
+	return inventory.GetResourcePools200JSONResponse(resp), nil
+}
+
+func GetResources(ctx context.Context, logger *slog.Logger, c client.Client, reader client.Reader, namespace string,
+	hosts *HostInventory) (inventory.GetResourcesResponseObject, error) {
+	resources, err := getPoolResources(ctx, logger, c, reader, namespace, hosts, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory.GetResources200JSONResponse(resources), nil
+}
+
+func GetResourcePool(hosts *HostInventory, poolID string) (inventory.GetResourcePoolResponseObject, error) {
+	for i := range hosts.Hosts {
+		if hosts.Hosts[i].ResourcePoolID == poolID {
+			return inventory.GetResourcePool200JSONResponse(getResourcePoolInfo(&hosts.Hosts[i])), nil
+		}
+	}
+
+	return inventory.GetResourcePool404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+		Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+		Status: http.StatusNotFound,
+	}), nil
+}
+
+func GetResourcePoolResources(ctx context.Context, logger *slog.Logger, c client.Client, reader client.Reader, namespace string,
+	hosts *HostInventory, poolID string) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	resources, err := getPoolResources(ctx, logger, c, reader, namespace, hosts, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		return inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	return inventory.GetResourcePoolResources200JSONResponse(resources), nil
+}
+
+func GetResource(ctx context.Context, logger *slog.Logger, c client.Client, reader client.Reader, namespace string,
+	hosts *HostInventory, resourceID string) (inventory.GetResourceResponseObject, error) {
+	host := hosts.GetHost(resourceID)
+	if host == nil {
+		return inventory.GetResource404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource '%s'", resourceID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	nodes, err := getAllocatedNodes(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	system := getSystem(ctx, logger, reader, namespace, host)
+	return inventory.GetResource200JSONResponse(getResourceInfo(host, system, nodes[resourceID])), nil
+}
@@ -0,0 +1,322 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+const (
+	// ConfigAnnotation records the configuration step an AllocatedNode is in
+	ConfigAnnotation = "clcm.openshift.io/config-in-progress"
+
+	// ConfigStartedAnnotation records when the configuration of an AllocatedNode started, in RFC 3339 format
+	ConfigStartedAnnotation = "redfish.clcm.openshift.io/config-started"
+
+	// UpdateTasksAnnotation records the comma-separated URIs of the Redfish tasks of the firmware updates in progress.
+	// It is only set once the updates are started.
+	UpdateTasksAnnotation = "redfish.clcm.openshift.io/update-tasks"
+
+	// BootImageAnnotation records the image an AllocatedNode was booted from
+	BootImageAnnotation = "redfish.clcm.openshift.io/boot-image"
+)
+
+// Configuration steps recorded in the ConfigAnnotation
+const (
+	ConfigStepFirmwareUpdate = "firmware-update"
+	ConfigStepReboot         = "reboot"
+)
+
+// pendingChanges lists the changes needed for a host to match a hardware profile
+type pendingChanges struct {
+	biosAttributes map[string]interface{}
+	firmwareImages []string
+}
+
+func (p *pendingChanges) isEmpty() bool {
+	return len(p.biosAttributes) == 0 && len(p.firmwareImages) == 0
+}
+
+// biosValue converts a hardware profile BIOS attribute to its Redfish value
+func biosValue(value intstr.IntOrString) interface{} {
+	if value.Type == intstr.Int {
+		return value.IntValue()
+	}
+	return value.String()
+}
+
+// biosValueMatches compares a BIOS attribute reported by Redfish with its value in the hardware profile
+func biosValueMatches(current interface{}, value intstr.IntOrString) bool {
+	switch v := current.(type) {
+	case float64:
+		return value.Type == intstr.Int && v == float64(value.IntValue()) ||
+			value.Type == intstr.String && fmt.Sprintf("%v", v) == value.String()
+	case string:
+		return v == value.String()
+	case bool:
+		return fmt.Sprintf("%t", v) == strings.ToLower(value.String())
+	}
+	return false
+}
+
+// getHwProfile returns the hardware profile of a node group from the plugin namespace
+func getHwProfile(ctx context.Context, c client.Client, namespace, name string) (*hwmgmtv1alpha1.HardwareProfile, error) {
+	hwProfile := &hwmgmtv1alpha1.HardwareProfile{}
+	if err := c.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, hwProfile); err != nil {
+		return nil, fmt.Errorf("failed to get HardwareProfile %s/%s: %w", namespace, name, err)
+	}
+	return hwProfile, nil
+}
+
+// getPendingChanges compares the BIOS attributes and firmware versions of a system with a hardware profile
+func getPendingChanges(
+	ctx context.Context,
+	bmcClient *bmc.Client,
+	system *bmc.System,
+	hwProfile *hwmgmtv1alpha1.HardwareProfile) (*pendingChanges, error) {
+
+	pending := &pendingChanges{biosAttributes: make(map[string]interface{})}
+
+	if len(hwProfile.Spec.Bios.Attributes) > 0 {
+		bios, err := bmcClient.GetBios(ctx, system)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get BIOS attributes: %w", err)
+		}
+		for name, value := range hwProfile.Spec.Bios.Attributes {
+			current, exists := bios.Attributes[name]
+			if !exists {
+				return nil, typederrors.NewInputError("BIOS attribute '%s' of HardwareProfile %s is not supported by system %s",
+					name, hwProfile.Name, system.ODataID)
+			}
+			if !biosValueMatches(current, value) {
+				pending.biosAttributes[name] = biosValue(value)
+			}
+		}
+	}
+
+	if firmware := hwProfile.Spec.BiosFirmware; !firmware.IsEmpty() && firmware.Version != system.BiosVersion {
+		if firmware.URL == "" {
+			return nil, typederrors.NewInputError("BIOS firmware %s of HardwareProfile %s has no URL", firmware.Version, hwProfile.Name)
+		}
+		pending.firmwareImages = append(pending.firmwareImages, firmware.URL)
+	}
+
+	if firmware := hwProfile.Spec.BmcFirmware; !firmware.IsEmpty() {
+		manager, err := bmcClient.GetManager(ctx, system)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get BMC firmware version: %w", err)
+		}
+		if firmware.Version != manager.FirmwareVersion {
+			if firmware.URL == "" {
+				return nil, typederrors.NewInputError("BMC firmware %s of HardwareProfile %s has no URL", firmware.Version, hwProfile.Name)
+			}
+			pending.firmwareImages = append(pending.firmwareImages, firmware.URL)
+		}
+	}
+
+	return pending, nil
+}
+
+// configurationRestart restarts the system to apply its pending firmware and BIOS settings, or powers it on
+func configurationRestart(ctx context.Context, bmcClient *bmc.Client, system *bmc.System) error {
+	resetType := bmc.ResetTypeForceRestart
+	if system.PowerState == bmc.PowerStateOff {
+		resetType = bmc.ResetTypeOn
+	}
+	return bmcClient.Reset(ctx, system, resetType) // nolint: wrapcheck
+}
+
+func clearConfigAnnotations(node *pluginsv1alpha1.AllocatedNode) {
+	delete(node.Annotations, ConfigAnnotation)
+	delete(node.Annotations, ConfigStartedAnnotation)
+	delete(node.Annotations, UpdateTasksAnnotation)
+}
+
+// configureNode drives the host of an AllocatedNode towards a hardware profile, one step per call: it starts the
+// firmware updates and stages the BIOS attributes, restarts the system once the updates are installed, and then
+// waits for the system to report the expected settings. It returns true once the host matches the profile.
+// Each step is recorded before its BMC action, so that a failure to record it does not repeat the action.
+// A failed update, an unsupported profile or a timeout is reported as a non-retriable error.
+func (r *NodeAllocationRequestReconciler) configureNode(
+	ctx context.Context,
+	inventory *HostInventory,
+	bmcClient *bmc.Client,
+	node *pluginsv1alpha1.AllocatedNode,
+	hwProfileName string) (bool, error) {
+
+	hwProfile, err := getHwProfile(ctx, r.Client, r.PluginNamespace, hwProfileName)
+	if err != nil {
+		return false, err
+	}
+
+	system, err := bmcClient.GetSystem(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to get system of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	pending, err := getPendingChanges(ctx, bmcClient, system, hwProfile)
+	if err != nil {
+		if typederrors.IsInputError(err) {
+			return false, typederrors.NewNonRetriableError(err, "invalid hardware profile for AllocatedNode %s: %s",
+				node.Name, err.Error())
+		}
+		return false, err
+	}
+
+	step := node.Annotations[ConfigAnnotation]
+	if step == "" {
+		if pending.isEmpty() {
+			return true, nil
+		}
+		return false, r.startConfiguration(ctx, bmcClient, system, node, pending)
+	}
+
+	started, err := time.Parse(time.RFC3339, node.Annotations[ConfigStartedAnnotation])
+	if err == nil && r.CurrentTime().Sub(started) > inventory.GetConfigurationTimeout() {
+		return false, typederrors.NewNonRetriableError(nil,
+			"timed out applying HardwareProfile %s to AllocatedNode %s", hwProfileName, node.Name)
+	}
+
+	switch step {
+	case ConfigStepFirmwareUpdate:
+		tasks, started := node.Annotations[UpdateTasksAnnotation]
+		if !started {
+			return false, r.startUpdates(ctx, bmcClient, system, node, pending)
+		}
+
+		for _, taskURI := range strings.Split(tasks, ",") {
+			if taskURI == "" {
+				continue
+			}
+			task, err := bmcClient.GetTask(ctx, taskURI)
+			if err != nil {
+				return false, fmt.Errorf("failed to check firmware update of AllocatedNode %s: %w", node.Name, err)
+			}
+			if task.IsFailed() {
+				return false, typederrors.NewNonRetriableError(nil, "firmware update %s of AllocatedNode %s failed: %s",
+					taskURI, node.Name, task.Message())
+			}
+			if !task.IsFinished() {
+				r.Logger.InfoContext(ctx, "Firmware update in progress",
+					slog.String("nodename", node.Name), slog.String("task", taskURI))
+				return false, nil
+			}
+		}
+
+		// Record the restart before requesting it, so that the system is not restarted again when recording fails
+		node.Annotations[ConfigAnnotation] = ConfigStepReboot
+		delete(node.Annotations, UpdateTasksAnnotation)
+		if err := r.Client.Update(ctx, node); err != nil {
+			return false, fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+		}
+		if err := configurationRestart(ctx, bmcClient, system); err != nil {
+			// Go back to waiting for the updates, so that the restart is requested again
+			node.Annotations[ConfigAnnotation] = ConfigStepFirmwareUpdate
+			node.Annotations[UpdateTasksAnnotation] = tasks
+			if updateErr := r.Client.Update(ctx, node); updateErr != nil {
+				r.Logger.WarnContext(ctx, "Failed to revert the configuration step of AllocatedNode",
+					slog.String("nodename", node.Name), slog.String("error", updateErr.Error()))
+			}
+			return false, fmt.Errorf("failed to restart system of AllocatedNode %s: %w", node.Name, err)
+		}
+		return false, nil
+
+	default:
+		if !pending.isEmpty() {
+			r.Logger.InfoContext(ctx, "Waiting for the system to apply the hardware profile",
+				slog.String("nodename", node.Name), slog.String("hwProfile", hwProfileName))
+			return false, nil
+		}
+
+		clearConfigAnnotations(node)
+		if err := r.Client.Update(ctx, node); err != nil {
+			return false, fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+		}
+		return true, nil
+	}
+}
+
+// startConfiguration records the start of the configuration of a host, then starts its firmware updates and
+// stages its BIOS attributes. The system is restarted once the updates are installed.
+func (r *NodeAllocationRequestReconciler) startConfiguration(
+	ctx context.Context,
+	bmcClient *bmc.Client,
+	system *bmc.System,
+	node *pluginsv1alpha1.AllocatedNode,
+	pending *pendingChanges) error {
+
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[ConfigAnnotation] = ConfigStepFirmwareUpdate
+	node.Annotations[ConfigStartedAnnotation] = r.CurrentTime().UTC().Format(time.RFC3339)
+	delete(node.Annotations, UpdateTasksAnnotation)
+	if err := r.Client.Update(ctx, node); err != nil {
+		return fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	return r.startUpdates(ctx, bmcClient, system, node, pending)
+}
+
+// startUpdates starts the firmware updates and stages the BIOS attributes of a host, and records the tasks of the
+// updates. An update already started for an image, whose task could not be recorded, is not started again.
+func (r *NodeAllocationRequestReconciler) startUpdates(
+	ctx context.Context,
+	bmcClient *bmc.Client,
+	system *bmc.System,
+	node *pluginsv1alpha1.AllocatedNode,
+	pending *pendingChanges) error {
+
+	var tasks []string
+	for _, image := range pending.firmwareImages {
+		taskURI, err := bmcClient.FindUpdateTask(ctx, image)
+		if err != nil {
+			return fmt.Errorf("failed to check firmware updates of AllocatedNode %s: %w", node.Name, err)
+		}
+		if taskURI == "" {
+			if taskURI, err = bmcClient.SimpleUpdate(ctx, image); err != nil {
+				return fmt.Errorf("failed to start firmware update of AllocatedNode %s: %w", node.Name, err)
+			}
+		}
+		tasks = append(tasks, taskURI)
+	}
+
+	// Staging the BIOS attributes again only overwrites them with the same values
+	if len(pending.biosAttributes) > 0 {
+		if err := bmcClient.SetBiosAttributes(ctx, system, pending.biosAttributes); err != nil {
+			return fmt.Errorf("failed to set BIOS attributes of AllocatedNode %s: %w", node.Name, err)
+		}
+	}
+
+	attributes := make([]string, 0, len(pending.biosAttributes))
+	for name := range pending.biosAttributes {
+		attributes = append(attributes, name)
+	}
+	sort.Strings(attributes)
+	r.Logger.InfoContext(ctx, "Configuration of AllocatedNode started", slog.String("nodename", node.Name),
+		slog.Any("firmwareImages", pending.firmwareImages), slog.Any("biosAttributes", attributes))
+
+	node.Annotations[UpdateTasksAnnotation] = strings.Join(tasks, ",")
+	if err := r.Client.Update(ctx, node); err != nil {
+		return fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,37 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+)
+
+// RedfishControllers holds references to the Redfish controllers for lifecycle management
+type RedfishControllers struct {
+	NodeAllocationReconciler *NodeAllocationRequestReconciler
+}
+
+func SetupRedfishControllers(mgr ctrl.Manager, namespace, hostsConfigMap string, baseLogger *slog.Logger) (*RedfishControllers, error) {
+	narLogger := baseLogger.With("controller", "redfish_nodeallocationrequest_controller")
+	nodeAllocationReconciler := &NodeAllocationRequestReconciler{
+		NodeAllocationRequestReconcilerBase: hwmgrutils.NewNodeAllocationRequestReconcilerBase(mgr, namespace, narLogger),
+		HostsConfigMap:                      hostsConfigMap,
+	}
+
+	if err := nodeAllocationReconciler.SetupWithManager(mgr); err != nil {
+		return nil, fmt.Errorf("failed to setup NodeAllocationRequest controller: %w", err)
+	}
+
+	return &RedfishControllers{
+		NodeAllocationReconciler: nodeAllocationReconciler,
+	}, nil
+}
@@ -0,0 +1,606 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+// NodeAllocationRequestReconciler reconciles NodeAllocationRequest objects associated with the Redfish H/W plugin.
+// Hosts are allocated from the host inventory ConfigMap, and are discovered, configured and released through the
+// Redfish API of their BMC.
+type NodeAllocationRequestReconciler struct {
+	hwmgrutils.NodeAllocationRequestReconcilerBase
+
+	// HostsConfigMap is the name of the ConfigMap holding the host inventory, in the plugin namespace
+	HostsConfigMap string
+}
+
+// getNodeHwProfile returns the hardware profile requested for the node group of an AllocatedNode
+func getNodeHwProfile(nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, node *pluginsv1alpha1.AllocatedNode) string {
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		if nodeGroup.NodeGroupData.Name == node.Spec.GroupName {
+			return nodeGroup.NodeGroupData.HwProfile
+		}
+	}
+	return ""
+}
+
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests/finalizers,verbs=update
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=allocatednodes,verbs=get;create;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=allocatednodes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=clcm.openshift.io,resources=hardwareprofiles,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *NodeAllocationRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return hwmgrutils.ReconcileNodeAllocationRequest(ctx, &r.NodeAllocationRequestReconcilerBase, req, r)
+}
+
+// Load returns the host inventory used by the other steps of the reconciliation
+func (r *NodeAllocationRequestReconciler) Load(ctx context.Context) (*HostInventory, error) {
+	inventory, err := GetHostInventory(ctx, r.NoncachedClient, r.PluginNamespace, r.HostsConfigMap)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get host inventory: %w", err)
+	}
+	return inventory, nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleCreate(
+	ctx context.Context,
+	_ *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress, metav1.ConditionFalse, "Handling creation"); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	if err := hwmgrutils.UpdateNodeAllocationRequestPluginStatus(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update hwMgrPlugin observedGeneration Status: %w", err)
+	}
+
+	return hwmgrutils.RequeueImmediately(), nil
+}
+
+// failNodeAllocationRequest reports a non-retriable failure of an AllocatedNode and of its NodeAllocationRequest
+func (r *NodeAllocationRequestReconciler) failNodeAllocationRequest(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	node *pluginsv1alpha1.AllocatedNode,
+	conditionType hwmgmtv1alpha1.ConditionType,
+	reason hwmgmtv1alpha1.ConditionReason,
+	failure error) (ctrl.Result, error) {
+
+	r.Logger.InfoContext(ctx, "Operation failed", slog.String("condition", string(conditionType)),
+		slog.String("error", failure.Error()))
+	if node != nil {
+		// Forget the failed configuration, so that the next configuration transaction starts over
+		if node.Annotations[ConfigAnnotation] != "" {
+			clearConfigAnnotations(node)
+			if err := r.Client.Update(ctx, node); err != nil {
+				return hwmgrutils.RequeueWithShortInterval(),
+					fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+			}
+		}
+		if err := hwmgrutils.SetNodeFailedStatus(ctx, r.Client, r.Logger, node, string(conditionType), failure.Error()); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), err //nolint:wrapcheck
+		}
+	}
+
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		conditionType, reason, metav1.ConditionFalse, failure.Error()); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleProcessing(
+	ctx context.Context,
+	inventory *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	selected, err := r.selectHosts(ctx, inventory, nodeAllocationRequest)
+	if err != nil {
+		reason := hwmgmtv1alpha1.Failed
+		if typederrors.IsInputError(err) {
+			reason = hwmgmtv1alpha1.InvalidInput
+		}
+		return r.failNodeAllocationRequest(ctx, nodeAllocationRequest, nil, hwmgmtv1alpha1.Provisioned, reason, err)
+	}
+
+	var nodeNames []string
+	provisioned := true
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		for _, host := range selected[nodeGroup.NodeGroupData.Name] {
+			node, err := r.allocateNode(ctx, nodeAllocationRequest, &nodeGroup.NodeGroupData, host)
+			if err != nil {
+				return hwmgrutils.RequeueWithShortInterval(), err
+			}
+			nodeNames = append(nodeNames, node.Name)
+
+			done, err := r.provisionNode(ctx, inventory, host, node)
+			if err != nil {
+				if typederrors.IsNonRetriableError(err) {
+					return r.failNodeAllocationRequest(ctx, nodeAllocationRequest, node,
+						hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.Failed, err)
+				}
+				return hwmgrutils.RequeueWithShortInterval(), err
+			}
+			provisioned = provisioned && done
+		}
+	}
+
+	if !provisioned {
+		return hwmgrutils.RequeueWithShortInterval(), nil
+	}
+
+	nodeAllocationRequest.Status.Properties.NodeNames = nodeNames
+	if err := hwmgrutils.UpdateNodeAllocationRequestProperties(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update NodeAllocationRequest properties: %w", err)
+	}
+
+	r.Logger.InfoContext(ctx, "NodeAllocationRequest is fully allocated", slog.Any("nodes", nodeNames))
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.Completed, metav1.ConditionTrue, "Created"); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleSpecChanged(
+	ctx context.Context,
+	inventory *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	configuredCondition := meta.FindStatusCondition(nodeAllocationRequest.Status.Conditions, string(hwmgmtv1alpha1.Configured))
+	if configuredCondition != nil && configuredCondition.Reason == string(hwmgmtv1alpha1.Failed) &&
+		nodeAllocationRequest.Status.ObservedConfigTransactionId == nodeAllocationRequest.Spec.ConfigTransactionId {
+		// The configuration of this transaction has already failed; wait for a new one
+		return hwmgrutils.DoNotRequeue(), nil
+	}
+
+	if configuredCondition == nil || configuredCondition.Reason != string(hwmgmtv1alpha1.ConfigUpdate) {
+		if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+			hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.ConfigUpdate, metav1.ConditionFalse, string(hwmgmtv1alpha1.AwaitConfig)); err != nil {
+			return hwmgrutils.RequeueWithMediumInterval(),
+				fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+		}
+	}
+
+	nodelist, err := hwmgrutils.GetChildNodes(ctx, r.Logger, r.Client, nodeAllocationRequest)
+	if err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to get child nodes: %w", err)
+	}
+
+	configured := true
+	for i := range nodelist.Items {
+		node := &nodelist.Items[i]
+		done, err := r.reconfigureNode(ctx, inventory, nodeAllocationRequest, node)
+		if err != nil {
+			if typederrors.IsNonRetriableError(err) {
+				return r.failNodeAllocationRequest(ctx, nodeAllocationRequest, node,
+					hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.Failed, err)
+			}
+			return hwmgrutils.RequeueWithShortInterval(), err
+		}
+		configured = configured && done
+	}
+
+	if !configured {
+		return hwmgrutils.RequeueWithShortInterval(), nil
+	}
+
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.ConfigApplied, metav1.ConditionTrue, string(hwmgmtv1alpha1.ConfigSuccess)); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	if err := hwmgrutils.UpdateNodeAllocationRequestPluginStatus(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update hwMgrPlugin observedGeneration Status: %w", err)
+	}
+
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+// HandleDeletion ejects the virtual media and powers off the hosts of a deleted
+// NodeAllocationRequest, then releases them and removes its finalizer. Hosts removed from the inventory are released
+// without being touched.
+func (r *NodeAllocationRequestReconciler) HandleDeletion(
+	ctx context.Context,
+	inventory *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	nodelist, err := hwmgrutils.GetChildNodes(ctx, r.Logger, r.Client, nodeAllocationRequest)
+	if err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to get child nodes: %w", err)
+	}
+
+	for i := range nodelist.Items {
+		node := &nodelist.Items[i]
+		if host := inventory.GetHost(node.Spec.HwMgrNodeId); host != nil {
+			if err := r.releaseHost(ctx, host); err != nil {
+				r.Logger.WarnContext(ctx, "Failed to release host, retrying",
+					slog.String("host", host.ID), slog.String("error", err.Error()))
+				return hwmgrutils.RequeueWithShortInterval(), nil
+			}
+		}
+
+		if err := r.Client.Delete(ctx, node); client.IgnoreNotFound(err) != nil {
+			return hwmgrutils.RequeueWithShortInterval(),
+				fmt.Errorf("failed to delete AllocatedNode %s: %w", node.Name, err)
+		}
+	}
+
+	if err := hwmgrutils.NodeAllocationRequestRemoveFinalizer(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), err //nolint:wrapcheck
+	}
+
+	r.Logger.InfoContext(ctx, "Deletion handling complete, finalizer removed")
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+// selectHosts picks the hosts of each node group, keeping the hosts already allocated to the NodeAllocationRequest
+// and completing them with free hosts matching the resource pool, site and resource selector
+func (r *NodeAllocationRequestReconciler) selectHosts(
+	ctx context.Context,
+	inventory *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (map[string][]*Host, error) {
+
+	var nodelist pluginsv1alpha1.AllocatedNodeList
+	if err := r.Client.List(ctx, &nodelist, client.InNamespace(r.PluginNamespace),
+		client.MatchingLabels{hwmgrutils.HardwarePluginLabel: hwmgrutils.RedfishHardwarePluginID}); err != nil {
+		return nil, fmt.Errorf("failed to list AllocatedNodes: %w", err)
+	}
+
+	// Map each host in use to the node group it is allocated to, if it belongs to this request
+	inUse := make(map[string]bool)
+	owned := make(map[string]string)
+	for _, node := range nodelist.Items {
+		inUse[node.Spec.HwMgrNodeId] = true
+		if node.Spec.NodeAllocationRequest == nodeAllocationRequest.Name {
+			owned[node.Spec.HwMgrNodeId] = node.Spec.GroupName
+		}
+	}
+
+	selected := make(map[string][]*Host)
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		group := nodeGroup.NodeGroupData
+		for i := range inventory.Hosts {
+			host := &inventory.Hosts[i]
+			if owned[host.ID] == group.Name {
+				selected[group.Name] = append(selected[group.Name], host)
+			}
+		}
+
+		for i := range inventory.Hosts {
+			if len(selected[group.Name]) >= nodeGroup.Size {
+				break
+			}
+			host := &inventory.Hosts[i]
+			if inUse[host.ID] ||
+				(group.ResourcePoolId != "" && host.ResourcePoolID != group.ResourcePoolId) ||
+				(nodeAllocationRequest.Spec.Site != "" && host.SiteID != nodeAllocationRequest.Spec.Site) ||
+				!host.MatchesSelector(group.ResourceSelector) {
+				continue
+			}
+			inUse[host.ID] = true
+			selected[group.Name] = append(selected[group.Name], host)
+		}
+
+		if len(selected[group.Name]) < nodeGroup.Size {
+			return nil, typederrors.NewInputError(
+				"insufficient free hosts for node group '%s' in resource pool '%s': requested %d, available %d",
+				group.Name, group.ResourcePoolId, nodeGroup.Size, len(selected[group.Name]))
+		}
+	}
+
+	for _, hosts := range selected {
+		sort.Slice(hosts, func(i, j int) bool { return hosts[i].ID < hosts[j].ID })
+	}
+	return selected, nil
+}
+
+// allocateNode ensures the AllocatedNode of a host exists
+func (r *NodeAllocationRequestReconciler) allocateNode(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	group *hwmgmtv1alpha1.NodeGroupData,
+	host *Host) (*pluginsv1alpha1.AllocatedNode, error) {
+
+	nodename := hwmgrutils.GenerateNodeName(hwmgrutils.RedfishHardwarePluginID,
+		nodeAllocationRequest.Spec.ClusterId, r.PluginNamespace, host.ID)
+
+	node := &pluginsv1alpha1.AllocatedNode{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: nodename, Namespace: r.PluginNamespace}, node)
+	if err == nil {
+		return node, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, fmt.Errorf("failed to check if AllocatedNode exists: %w", err)
+	}
+
+	blockDeletion := true
+	node = &pluginsv1alpha1.AllocatedNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      nodename,
+			Namespace: r.PluginNamespace,
+			Labels: map[string]string{
+				hwmgrutils.HardwarePluginLabel: hwmgrutils.RedfishHardwarePluginID,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion:         nodeAllocationRequest.APIVersion,
+				Kind:               nodeAllocationRequest.Kind,
+				Name:               nodeAllocationRequest.Name,
+				UID:                nodeAllocationRequest.UID,
+				BlockOwnerDeletion: &blockDeletion,
+			}},
+		},
+		Spec: pluginsv1alpha1.AllocatedNodeSpec{
+			NodeAllocationRequest: nodeAllocationRequest.Name,
+			GroupName:             group.Name,
+			HwProfile:             group.HwProfile,
+			HardwarePluginRef:     nodeAllocationRequest.Spec.HardwarePluginRef,
+			HwMgrNodeId:           host.ID,
+			HwMgrNodeNs:           r.PluginNamespace,
+		},
+	}
+	if err := r.Client.Create(ctx, node); err != nil {
+		return nil, fmt.Errorf("failed to create AllocatedNode %s: %w", nodename, err)
+	}
+	r.Logger.InfoContext(ctx, "AllocatedNode created", slog.String("nodename", nodename), slog.String("hostId", host.ID))
+
+	return node, nil
+}
+
+// provisionNode discovers the host of an AllocatedNode, applies the hardware profile of its group and boots the
+// configured image. It returns true once the node is provisioned.
+func (r *NodeAllocationRequestReconciler) provisionNode(
+	ctx context.Context,
+	inventory *HostInventory,
+	host *Host,
+	node *pluginsv1alpha1.AllocatedNode) (bool, error) {
+
+	if meta.IsStatusConditionTrue(node.Status.Conditions, string(hwmgmtv1alpha1.Provisioned)) {
+		return true, nil
+	}
+
+	bmcClient, err := NewBMCClient(ctx, r.NoncachedClient, r.PluginNamespace, host)
+	if err != nil {
+		return false, err
+	}
+
+	if node.Status.BMC == nil {
+		if err := r.discoverNode(ctx, bmcClient, host, node); err != nil {
+			return false, err
+		}
+	}
+
+	if node.Spec.HwProfile != "" {
+		done, err := r.configureNode(ctx, inventory, bmcClient, node, node.Spec.HwProfile)
+		if err != nil || !done {
+			return false, err
+		}
+	}
+
+	if image := inventory.GetBootImage(host); image != "" && node.Annotations[BootImageAnnotation] != image {
+		if err := r.bootNode(ctx, bmcClient, node, image); err != nil {
+			return false, err
+		}
+	}
+
+	node.Status.HwProfile = node.Spec.HwProfile
+	hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Provisioned),
+		string(hwmgmtv1alpha1.Completed), metav1.ConditionTrue, "Provisioned")
+	if err := r.Client.Status().Update(ctx, node); err != nil {
+		return false, fmt.Errorf("failed to update status of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "AllocatedNode provisioned", slog.String("nodename", node.Name))
+	return true, nil
+}
+
+// bootNode boots the host of an AllocatedNode from the given image. The boot is recorded before it is requested, so
+// that the host is not booted again when the provisioning of the node fails to be recorded.
+func (r *NodeAllocationRequestReconciler) bootNode(
+	ctx context.Context,
+	bmcClient *bmc.Client,
+	node *pluginsv1alpha1.AllocatedNode,
+	image string) error {
+
+	if node.Annotations == nil {
+		node.Annotations = make(map[string]string)
+	}
+	node.Annotations[BootImageAnnotation] = image
+	if err := r.Client.Update(ctx, node); err != nil {
+		return fmt.Errorf("failed to update annotations of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	system, err := bmcClient.GetSystem(ctx)
+	if err == nil {
+		err = bmcClient.BootFromVirtualMedia(ctx, system, image)
+	}
+	if err != nil {
+		// Forget the boot, so that it is requested again
+		delete(node.Annotations, BootImageAnnotation)
+		if updateErr := r.Client.Update(ctx, node); updateErr != nil {
+			r.Logger.WarnContext(ctx, "Failed to revert the boot of AllocatedNode",
+				slog.String("nodename", node.Name), slog.String("error", updateErr.Error()))
+		}
+		return fmt.Errorf("failed to boot AllocatedNode %s from virtual media: %w", node.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "AllocatedNode booted from virtual media",
+		slog.String("nodename", node.Name), slog.String("image", image))
+	return nil
+}
+
+// discoverNode records the BMC, hostname and network interfaces of a host in the status of its AllocatedNode
+func (r *NodeAllocationRequestReconciler) discoverNode(
+	ctx context.Context,
+	bmcClient *bmc.Client,
+	host *Host,
+	node *pluginsv1alpha1.AllocatedNode) error {
+
+	system, err := bmcClient.GetSystem(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to discover system of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	interfaces, err := bmcClient.GetEthernetInterfaces(ctx, system)
+	if err != nil {
+		return fmt.Errorf("failed to discover interfaces of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	node.Status.BMC = &pluginsv1alpha1.BMC{
+		Address:         host.BMC.Address,
+		CredentialsName: host.BMC.CredentialsName,
+	}
+	node.Status.Interfaces = nil
+	for i := range interfaces {
+		node.Status.Interfaces = append(node.Status.Interfaces, &pluginsv1alpha1.Interface{
+			Name:       interfaces[i].Name,
+			Label:      host.InterfaceLabel(&interfaces[i]),
+			MACAddress: interfaces[i].MACAddress,
+		})
+	}
+
+	switch {
+	case host.Hostname != "":
+		node.Status.Hostname = host.Hostname
+	case system.HostName != "":
+		node.Status.Hostname = system.HostName
+	default:
+		node.Status.Hostname = host.ID
+	}
+
+	hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Provisioned),
+		string(hwmgmtv1alpha1.InProgress), metav1.ConditionFalse, "Configuring hardware profile")
+	if err := r.Client.Status().Update(ctx, node); err != nil {
+		return fmt.Errorf("failed to update status of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "AllocatedNode discovered", slog.String("nodename", node.Name),
+		slog.String("system", system.ODataID), slog.Int("interfaces", len(interfaces)))
+	return nil
+}
+
+// reconfigureNode applies the hardware profile requested for the group of an AllocatedNode. It returns true once the
+// host matches the profile.
+func (r *NodeAllocationRequestReconciler) reconfigureNode(
+	ctx context.Context,
+	inventory *HostInventory,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	node *pluginsv1alpha1.AllocatedNode) (bool, error) {
+
+	hwProfile := getNodeHwProfile(nodeAllocationRequest, node)
+	if hwProfile == "" {
+		return true, nil
+	}
+
+	if node.Spec.HwProfile != hwProfile {
+		node.Spec.HwProfile = hwProfile
+		if err := r.Client.Update(ctx, node); err != nil {
+			return false, fmt.Errorf("failed to update hwProfile of AllocatedNode %s: %w", node.Name, err)
+		}
+	}
+
+	if node.Status.HwProfile == hwProfile && node.Annotations[ConfigAnnotation] == "" {
+		return true, nil
+	}
+
+	host := inventory.GetHost(node.Spec.HwMgrNodeId)
+	if host == nil {
+		return false, typederrors.NewNonRetriableError(nil,
+			"host '%s' of AllocatedNode %s is no longer in the inventory", node.Spec.HwMgrNodeId, node.Name)
+	}
+
+	bmcClient, err := NewBMCClient(ctx, r.NoncachedClient, r.PluginNamespace, host)
+	if err != nil {
+		return false, err
+	}
+
+	condition := meta.FindStatusCondition(node.Status.Conditions, string(hwmgmtv1alpha1.Configured))
+	if condition == nil || condition.Reason != string(hwmgmtv1alpha1.ConfigUpdate) {
+		hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Configured),
+			string(hwmgmtv1alpha1.ConfigUpdate), metav1.ConditionFalse, string(hwmgmtv1alpha1.AwaitConfig))
+		if err := r.Client.Status().Update(ctx, node); err != nil {
+			return false, fmt.Errorf("failed to update status of AllocatedNode %s: %w", node.Name, err)
+		}
+	}
+
+	done, err := r.configureNode(ctx, inventory, bmcClient, node, hwProfile)
+	if err != nil || !done {
+		return false, err
+	}
+
+	node.Status.HwProfile = hwProfile
+	hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Configured),
+		string(hwmgmtv1alpha1.ConfigApplied), metav1.ConditionTrue, string(hwmgmtv1alpha1.ConfigSuccess))
+	if err := r.Client.Status().Update(ctx, node); err != nil {
+		return false, fmt.Errorf("failed to update status of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "Hardware profile applied",
+		slog.String("nodename", node.Name), slog.String("hwProfile", hwProfile))
+	return true, nil
+}
+
+// releaseHost ejects the virtual media of a released host and powers it off
+func (r *NodeAllocationRequestReconciler) releaseHost(ctx context.Context, host *Host) error {
+	bmcClient, err := NewBMCClient(ctx, r.NoncachedClient, r.PluginNamespace, host)
+	if err != nil {
+		return err
+	}
+
+	system, err := bmcClient.GetSystem(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get system of host '%s': %w", host.ID, err)
+	}
+
+	if err := bmcClient.EjectVirtualMedia(ctx, system); err != nil {
+		return fmt.Errorf("failed to eject virtual media of host '%s': %w", host.ID, err)
+	}
+
+	if system.PowerState != bmc.PowerStateOff {
+		if err := bmcClient.Reset(ctx, system, bmc.ResetTypeForceOff); err != nil {
+			return fmt.Errorf("failed to power off host '%s': %w", host.ID, err)
+		}
+	}
+
+	r.Logger.InfoContext(ctx, "Host released", slog.String("host", host.ID))
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeAllocationRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// nolint: wrapcheck
+	return r.SetupController(mgr, "redfish_nodeallocationrequest", hwmgrutils.RedfishHardwarePluginID, r)
+}
@@ -0,0 +1,403 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http/httptest"
+	"strings"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc/emulator"
+)
+
+const (
+	testNamespace      = "hwmgr"
+	testHostsConfigMap = "redfish-hosts"
+	testBiosImage      = "http://images/bios-2.0.0.bin"
+	testBootImage      = "http://images/discovery.iso"
+)
+
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	Expect(hwmgmtv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(hwmgrutils.InitNodeAllocationRequestUtils(scheme)).To(Succeed())
+	return scheme
+}
+
+func newTestClient(funcs interceptor.Funcs, objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&pluginsv1alpha1.NodeAllocationRequest{}, &pluginsv1alpha1.AllocatedNode{}).
+		WithIndex(&pluginsv1alpha1.AllocatedNode{}, hwmgrutils.AllocatedNodeSpecNodeAllocationRequestKey,
+			func(obj client.Object) []string {
+				return []string{obj.(*pluginsv1alpha1.AllocatedNode).Spec.NodeAllocationRequest}
+			}).
+		WithInterceptorFuncs(funcs).
+		Build()
+}
+
+func newTestHostsConfigMap(inventory *HostInventory) *corev1.ConfigMap {
+	data, err := yaml.Marshal(inventory)
+	Expect(err).ToNot(HaveOccurred())
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: testHostsConfigMap, Namespace: testNamespace},
+		Data:       map[string]string{HostsConfigKey: string(data)},
+	}
+}
+
+func newTestHwProfile(name string, attributes map[string]intstr.IntOrString, biosVersion string) *hwmgmtv1alpha1.HardwareProfile {
+	hwProfile := &hwmgmtv1alpha1.HardwareProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: hwmgmtv1alpha1.HardwareProfileSpec{
+			Bios: hwmgmtv1alpha1.Bios{Attributes: attributes},
+		},
+	}
+	if biosVersion != "" {
+		hwProfile.Spec.BiosFirmware = hwmgmtv1alpha1.Firmware{Version: biosVersion, URL: testBiosImage}
+	}
+	return hwProfile
+}
+
+func newTestNodeAllocationRequest(size int) *pluginsv1alpha1.NodeAllocationRequest {
+	return &pluginsv1alpha1.NodeAllocationRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "redfish-nar",
+			Namespace:  testNamespace,
+			Generation: 1,
+			Labels:     map[string]string{hwmgrutils.HardwarePluginLabel: hwmgrutils.RedfishHardwarePluginID},
+		},
+		Spec: pluginsv1alpha1.NodeAllocationRequestSpec{
+			ClusterId:         "cluster-1",
+			LocationSpec:      pluginsv1alpha1.LocationSpec{Site: "site-1"},
+			HardwarePluginRef: hwmgrutils.RedfishHardwarePluginID,
+			NodeGroup: []pluginsv1alpha1.NodeGroup{{
+				NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
+					Name:             "controller",
+					ResourcePoolId:   "pool-1",
+					HwProfile:        "profile-v1",
+					ResourceSelector: map[string]string{"server-type": "dell"},
+				},
+				Size: size,
+			}},
+		},
+	}
+}
+
+var _ = Describe("Redfish NodeAllocationRequestReconciler", func() {
+	var (
+		ctx        context.Context
+		c          client.Client
+		reconciler *NodeAllocationRequestReconciler
+		inventory  *HostInventory
+		emulators  map[string]*emulator.Emulator
+		now        time.Time
+		req        ctrl.Request
+		funcs      interceptor.Funcs
+	)
+
+	reconcile := func() ctrl.Result {
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	getNodeAllocationRequest := func() *pluginsv1alpha1.NodeAllocationRequest {
+		nar := &pluginsv1alpha1.NodeAllocationRequest{}
+		Expect(c.Get(ctx, req.NamespacedName, nar)).To(Succeed())
+		return nar
+	}
+
+	getCondition := func(conditionType hwmgmtv1alpha1.ConditionType) *metav1.Condition {
+		return meta.FindStatusCondition(getNodeAllocationRequest().Status.Conditions, string(conditionType))
+	}
+
+	listNodes := func() []pluginsv1alpha1.AllocatedNode {
+		var nodelist pluginsv1alpha1.AllocatedNodeList
+		Expect(c.List(ctx, &nodelist)).To(Succeed())
+		return nodelist.Items
+	}
+
+	// reconcileUntil reconciles until the condition of the NodeAllocationRequest leaves the given reason
+	reconcileUntil := func(conditionType hwmgmtv1alpha1.ConditionType, pendingReasons ...hwmgmtv1alpha1.ConditionReason) *metav1.Condition {
+		for range 10 {
+			reconcile()
+			condition := getCondition(conditionType)
+			pending := condition == nil
+			for _, reason := range pendingReasons {
+				pending = pending || condition.Reason == string(reason)
+			}
+			if !pending {
+				return condition
+			}
+		}
+		Fail("NodeAllocationRequest condition " + string(conditionType) + " did not settle")
+		return nil
+	}
+
+	// addHost emulates a host and adds it to the inventory, along with its BMC credentials
+	addHost := func(id string, labels map[string]string) client.Object {
+		emulated := emulator.New("admin", "password",
+			bmc.EthernetInterface{ID: "1", Name: "eno1", MACAddress: "00:00:5E:00:53:01"},
+			bmc.EthernetInterface{ID: "2", Name: "eno2", MACAddress: "00:00:5E:00:53:02"})
+		emulated.SetBiosAttribute("ProcTurboMode", "Disabled")
+		emulated.SetBiosAttribute("NumCores", float64(8))
+		emulated.Firmware[testBiosImage] = emulator.FirmwareImage{Component: emulator.FirmwareComponentBios, Version: "2.0.0"}
+		server := httptest.NewServer(emulated)
+		DeferCleanup(server.Close)
+		emulators[id] = emulated
+
+		inventory.Hosts = append(inventory.Hosts, Host{
+			ID:             id,
+			ResourcePoolID: "pool-1",
+			SiteID:         "site-1",
+			Labels:         labels,
+			BMC: HostBMC{
+				Address:         strings.Replace(server.URL, "http://", "redfish-virtualmedia+http://", 1) + emulator.SystemPath,
+				CredentialsName: id + "-bmc-secret",
+			},
+			InterfaceLabels: map[string]string{"eno1": "bootable-interface"},
+		})
+
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: id + "-bmc-secret", Namespace: testNamespace},
+			Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("password")},
+		}
+	}
+
+	setup := func(size int, objs ...client.Object) {
+		objs = append(objs,
+			addHost("host-1", map[string]string{"server-type": "dell"}),
+			addHost("host-2", map[string]string{"server-type": "hpe"}),
+			addHost("host-3", map[string]string{"server-type": "dell"}),
+			newTestNodeAllocationRequest(size),
+		)
+		objs = append(objs, newTestHostsConfigMap(inventory))
+
+		c = newTestClient(funcs, objs...)
+		reconciler = &NodeAllocationRequestReconciler{
+			NodeAllocationRequestReconcilerBase: hwmgrutils.NodeAllocationRequestReconcilerBase{
+				Client:          c,
+				NoncachedClient: c,
+				Logger:          slog.New(slog.DiscardHandler),
+				PluginNamespace: testNamespace,
+				Now:             func() time.Time { return now },
+			},
+			HostsConfigMap: testHostsConfigMap,
+		}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "redfish-nar", Namespace: testNamespace}}
+		inventory = &HostInventory{BootImage: testBootImage}
+		emulators = make(map[string]*emulator.Emulator)
+		funcs = interceptor.Funcs{}
+	})
+
+	It("allocates, configures and boots matching hosts", func() {
+		setup(2, newTestHwProfile("profile-v1", map[string]intstr.IntOrString{
+			"ProcTurboMode": intstr.FromString("Enabled"),
+			"NumCores":      intstr.FromInt32(8),
+		}, "2.0.0"))
+
+		provisioned := reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+		Expect(provisioned.Status).To(Equal(metav1.ConditionTrue))
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Completed)))
+
+		nodes := listNodes()
+		Expect(nodes).To(HaveLen(2))
+		Expect([]string{nodes[0].Spec.HwMgrNodeId, nodes[1].Spec.HwMgrNodeId}).To(ConsistOf("host-1", "host-3"))
+		Expect(getNodeAllocationRequest().Status.Properties.NodeNames).To(HaveLen(2))
+
+		for _, node := range nodes {
+			Expect(node.Labels).To(HaveKeyWithValue(hwmgrutils.HardwarePluginLabel, hwmgrutils.RedfishHardwarePluginID))
+			Expect(node.Annotations).ToNot(HaveKey(ConfigAnnotation))
+			Expect(node.Status.HwProfile).To(Equal("profile-v1"))
+			Expect(node.Status.Hostname).To(Equal(node.Spec.HwMgrNodeId))
+			Expect(node.Status.BMC.Address).To(HavePrefix("redfish-virtualmedia+http://"))
+			Expect(node.Status.BMC.CredentialsName).To(Equal(node.Spec.HwMgrNodeId + "-bmc-secret"))
+			Expect(node.Status.Interfaces).To(HaveLen(2))
+			Expect(node.Status.Interfaces[0].Label).To(Equal("bootable-interface"))
+			Expect(node.Status.Interfaces[0].MACAddress).To(Equal("00:00:5E:00:53:01"))
+
+			emulated := emulators[node.Spec.HwMgrNodeId]
+			Expect(emulated.BiosAttributes()).To(HaveKeyWithValue("ProcTurboMode", "Enabled"))
+			biosVersion, _ := emulated.FirmwareVersions()
+			Expect(biosVersion).To(Equal("2.0.0"))
+			Expect(emulated.InsertedImage()).To(Equal(testBootImage))
+			Expect(emulated.PowerState()).To(Equal(bmc.PowerStateOn))
+		}
+
+		// The host left out of the request is untouched
+		Expect(emulators["host-2"].Resets()).To(BeEmpty())
+	})
+
+	It("does not repeat the BMC actions when recording the AllocatedNode fails", func() {
+		// Every other update of an AllocatedNode fails
+		failUpdate := false
+		funcs.Update = func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.UpdateOption) error {
+			if _, ok := obj.(*pluginsv1alpha1.AllocatedNode); ok {
+				if failUpdate = !failUpdate; failUpdate {
+					return fmt.Errorf("injected update failure")
+				}
+			}
+			return c.Update(ctx, obj, opts...) // nolint: wrapcheck
+		}
+		funcs.SubResourceUpdate = func(ctx context.Context, c client.Client, subResource string, obj client.Object,
+			opts ...client.SubResourceUpdateOption) error {
+			if node, ok := obj.(*pluginsv1alpha1.AllocatedNode); ok &&
+				meta.IsStatusConditionTrue(node.Status.Conditions, string(hwmgmtv1alpha1.Provisioned)) {
+				if failUpdate = !failUpdate; failUpdate {
+					return fmt.Errorf("injected status update failure")
+				}
+			}
+			return c.SubResource(subResource).Update(ctx, obj, opts...) // nolint: wrapcheck
+		}
+		setup(1, newTestHwProfile("profile-v1", map[string]intstr.IntOrString{
+			"ProcTurboMode": intstr.FromString("Enabled"),
+		}, "2.0.0"))
+
+		for range 20 {
+			_, _ = reconciler.Reconcile(ctx, req)
+			if condition := getCondition(hwmgmtv1alpha1.Provisioned); condition != nil &&
+				condition.Status == metav1.ConditionTrue {
+				break
+			}
+		}
+		Expect(getCondition(hwmgmtv1alpha1.Provisioned).Status).To(Equal(metav1.ConditionTrue))
+
+		// One firmware update, one restart to apply the profile and one boot from virtual media
+		emulated := emulators["host-1"]
+		Expect(emulated.UpdateTasks()).To(Equal(1))
+		Expect(emulated.Resets()).To(Equal([]bmc.ResetType{bmc.ResetTypeOn, bmc.ResetTypeForceRestart}))
+		Expect(emulated.BiosAttributes()).To(HaveKeyWithValue("ProcTurboMode", "Enabled"))
+		Expect(emulated.InsertedImage()).To(Equal(testBootImage))
+	})
+
+	It("fails the allocation when there are not enough free matching hosts", func() {
+		setup(3, newTestHwProfile("profile-v1", nil, ""))
+
+		provisioned := reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.InvalidInput)))
+		Expect(provisioned.Message).To(ContainSubstring("insufficient free hosts"))
+		Expect(listNodes()).To(BeEmpty())
+	})
+
+	It("fails the allocation when a firmware update fails", func() {
+		setup(1, newTestHwProfile("profile-v1", nil, "2.0.0"))
+		emulators["host-1"].FailUpdates = true
+
+		provisioned := reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+		Expect(provisioned.Message).To(ContainSubstring("failed to install " + testBiosImage))
+
+		nodes := listNodes()
+		Expect(nodes).To(HaveLen(1))
+		Expect(nodes[0].Annotations).ToNot(HaveKey(ConfigAnnotation))
+		nodeProvisioned := meta.FindStatusCondition(nodes[0].Status.Conditions, string(hwmgmtv1alpha1.Provisioned))
+		Expect(nodeProvisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+	})
+
+	It("fails the allocation when the hardware profile uses an unsupported BIOS attribute", func() {
+		setup(1, newTestHwProfile("profile-v1", map[string]intstr.IntOrString{
+			"Unknown": intstr.FromString("Enabled"),
+		}, ""))
+
+		provisioned := reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+		Expect(provisioned.Message).To(ContainSubstring("BIOS attribute 'Unknown'"))
+	})
+
+	It("fails a configuration that does not complete in time", func() {
+		setup(1, newTestHwProfile("profile-v1", map[string]intstr.IntOrString{
+			"ProcTurboMode": intstr.FromString("Enabled"),
+		}, ""))
+		reconcile()
+		reconcile()
+		reconcile()
+
+		// Pretend the system did not apply the BIOS settings on its restart
+		node := listNodes()[0]
+		Expect(node.Annotations).To(HaveKeyWithValue(ConfigAnnotation, ConfigStepReboot))
+		emulators["host-1"].SetBiosAttribute("ProcTurboMode", "Disabled")
+
+		reconcile()
+		Expect(getCondition(hwmgmtv1alpha1.Provisioned).Reason).To(Equal(string(hwmgmtv1alpha1.InProgress)))
+
+		now = now.Add(DefaultConfigurationTimeout + time.Minute)
+		reconcile()
+		provisioned := getCondition(hwmgmtv1alpha1.Provisioned)
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+		Expect(provisioned.Message).To(ContainSubstring("timed out"))
+	})
+
+	It("applies a hardware profile change to the allocated hosts", func() {
+		setup(1,
+			newTestHwProfile("profile-v1", nil, ""),
+			newTestHwProfile("profile-v2", map[string]intstr.IntOrString{"NumCores": intstr.FromInt32(16)}, ""))
+		reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+
+		nar := getNodeAllocationRequest()
+		nar.Spec.NodeGroup[0].NodeGroupData.HwProfile = "profile-v2"
+		nar.Spec.ConfigTransactionId = 2
+		nar.Generation = 2
+		Expect(c.Update(ctx, nar)).To(Succeed())
+
+		configured := reconcileUntil(hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.ConfigUpdate)
+		Expect(configured.Status).To(Equal(metav1.ConditionTrue))
+		Expect(configured.Reason).To(Equal(string(hwmgmtv1alpha1.ConfigApplied)))
+		Expect(emulators["host-1"].BiosAttributes()).To(HaveKeyWithValue("NumCores", float64(16)))
+
+		nar = getNodeAllocationRequest()
+		Expect(nar.Status.ObservedConfigTransactionId).To(Equal(int64(2)))
+		Expect(nar.Status.HwMgrPlugin.ObservedGeneration).To(Equal(nar.Generation))
+		nodes := listNodes()
+		Expect(nodes[0].Spec.HwProfile).To(Equal("profile-v2"))
+		Expect(nodes[0].Status.HwProfile).To(Equal("profile-v2"))
+		nodeConfigured := meta.FindStatusCondition(nodes[0].Status.Conditions, string(hwmgmtv1alpha1.Configured))
+		Expect(nodeConfigured.Reason).To(Equal(string(hwmgmtv1alpha1.ConfigApplied)))
+	})
+
+	It("ejects the virtual media and powers off the hosts on deletion", func() {
+		setup(1, newTestHwProfile("profile-v1", nil, ""))
+		reconcileUntil(hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress)
+		Expect(emulators["host-1"].PowerState()).To(Equal(bmc.PowerStateOn))
+
+		Expect(c.Delete(ctx, getNodeAllocationRequest())).To(Succeed())
+		reconcile()
+
+		Expect(listNodes()).To(BeEmpty())
+		Expect(c.Get(ctx, req.NamespacedName, &pluginsv1alpha1.NodeAllocationRequest{})).ToNot(Succeed())
+		Expect(emulators["host-1"].PowerState()).To(Equal(bmc.PowerStateOff))
+		Expect(emulators["host-1"].InsertedImage()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,101 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	redfishctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/controller"
+)
+
+// InventorySubscriptionsConfigMapName is the name of the ConfigMap holding the inventory subscriptions of the
+// Redfish HardwarePlugin
+const InventorySubscriptionsConfigMapName = "redfish-hwplugin-inventory-subscriptions"
+
+// RedfishPluginInventoryServer implements StrictServerInterface.
+// This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
+var _ inventory.StrictServerInterface = (*RedfishPluginInventoryServer)(nil)
+
+type RedfishPluginInventoryServer struct {
+	inventory.InventoryServer
+	NoncachedClient client.Reader
+	Namespace       string
+	HostsConfigMap  string
+}
+
+// NewRedfishPluginInventoryServer creates a Redfish HardwarePlugin inventory server
+func NewRedfishPluginInventoryServer(
+	hubClient client.Client,
+	noncachedClient client.Reader,
+	hostsConfigMap string,
+	logger *slog.Logger,
+) (*RedfishPluginInventoryServer, error) {
+	namespace := provisioning.GetRedfishHWPluginNamespace()
+	return &RedfishPluginInventoryServer{
+		InventoryServer: inventory.NewInventoryServer(hubClient, noncachedClient, logger,
+			namespace, InventorySubscriptionsConfigMapName),
+		NoncachedClient: noncachedClient,
+		Namespace:       namespace,
+		HostsConfigMap:  hostsConfigMap,
+	}, nil
+}
+
+func (s *RedfishPluginInventoryServer) getHostInventory(ctx context.Context) (*redfishctrl.HostInventory, error) {
+	// nolint: wrapcheck
+	return redfishctrl.GetHostInventory(ctx, s.NoncachedClient, s.Namespace, s.HostsConfigMap)
+}
+
+func (s *RedfishPluginInventoryServer) GetResourcePools(ctx context.Context, request inventory.GetResourcePoolsRequestObject) (inventory.GetResourcePoolsResponseObject, error) {
+	hosts, err := s.getHostInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return redfishctrl.GetResourcePools(hosts)
+}
+
+func (s *RedfishPluginInventoryServer) GetResources(ctx context.Context, request inventory.GetResourcesRequestObject) (inventory.GetResourcesResponseObject, error) {
+	hosts, err := s.getHostInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return redfishctrl.GetResources(ctx, s.Logger, s.HubClient, s.NoncachedClient, s.Namespace, hosts)
+}
+
+func (s *RedfishPluginInventoryServer) GetResourcePool(ctx context.Context, request inventory.GetResourcePoolRequestObject) (inventory.GetResourcePoolResponseObject, error) {
+	hosts, err := s.getHostInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return redfishctrl.GetResourcePool(hosts, request.ResourcePoolId)
+}
+
+func (s *RedfishPluginInventoryServer) GetResourcePoolResources(ctx context.Context, request inventory.GetResourcePoolResourcesRequestObject) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	hosts, err := s.getHostInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return redfishctrl.GetResourcePoolResources(ctx, s.Logger, s.HubClient, s.NoncachedClient, s.Namespace, hosts,
+		request.ResourcePoolId)
+}
+
+func (s *RedfishPluginInventoryServer) GetResource(ctx context.Context, request inventory.GetResourceRequestObject) (inventory.GetResourceResponseObject, error) {
+	hosts, err := s.getHostInventory(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return redfishctrl.GetResource(ctx, s.Logger, s.HubClient, s.NoncachedClient, s.Namespace, hosts, request.ResourceId)
+}
@@ -0,0 +1,136 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/bmc/emulator"
+	redfishctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/controller"
+)
+
+var _ = Describe("RedfishPluginInventoryServer", func() {
+	var (
+		ctx    context.Context
+		server *RedfishPluginInventoryServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		bmcServer := httptest.NewServer(emulator.New("admin", "password"))
+		DeferCleanup(bmcServer.Close)
+		address := strings.Replace(bmcServer.URL, "http://", "redfish+http://", 1) + emulator.SystemPath
+
+		hosts := fmt.Sprintf(`
+hosts:
+- id: host-1
+  resourcePoolId: pool-1
+  siteId: site-1
+  labels:
+    server-type: dell
+  bmc:
+    address: %s
+    credentialsName: bmc-secret
+- id: host-2
+  resourcePoolId: pool-1
+  siteId: site-1
+  bmc:
+    address: redfish+http://127.0.0.1:1/redfish/v1/Systems/1
+    credentialsName: bmc-secret
+`, address)
+
+		scheme := runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "redfish-hosts", Namespace: "oran-o2ims"},
+				Data:       map[string]string{redfishctrl.HostsConfigKey: hosts},
+			},
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "bmc-secret", Namespace: "oran-o2ims"},
+				Data:       map[string][]byte{"username": []byte("admin"), "password": []byte("password")},
+			},
+			&pluginsv1alpha1.AllocatedNode{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "node-1",
+					Namespace: "oran-o2ims",
+					Labels:    map[string]string{hwmgrutils.HardwarePluginLabel: hwmgrutils.RedfishHardwarePluginID},
+				},
+				Spec:   pluginsv1alpha1.AllocatedNodeSpec{HwMgrNodeId: "host-1"},
+				Status: pluginsv1alpha1.AllocatedNodeStatus{HwProfile: "profile-v1"},
+			},
+		).Build()
+
+		GinkgoT().Setenv("HWMGR_PLUGIN_NAMESPACE", "oran-o2ims")
+		var err error
+		server, err = NewRedfishPluginInventoryServer(c, c, "redfish-hosts", slog.New(slog.DiscardHandler))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("serves the hosts of the inventory with their Redfish details", func() {
+		pools, err := server.GetResourcePools(ctx, inventory.GetResourcePoolsRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pools.(inventory.GetResourcePools200JSONResponse)).To(HaveLen(1))
+
+		resources, err := server.GetResourcePoolResources(ctx, inventory.GetResourcePoolResourcesRequestObject{ResourcePoolId: "pool-1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resources.(inventory.GetResourcePoolResources200JSONResponse)).To(HaveLen(2))
+
+		resp, err := server.GetResource(ctx, inventory.GetResourceRequestObject{ResourceId: "host-1"})
+		Expect(err).ToNot(HaveOccurred())
+		resource := resp.(inventory.GetResource200JSONResponse)
+		Expect(resource.UsageState).To(Equal(inventory.ACTIVE))
+		Expect(resource.HwProfile).To(Equal("profile-v1"))
+		Expect(resource.OperationalState).To(Equal(inventory.ResourceInfoOperationalStateENABLED))
+		Expect(resource.Vendor).To(Equal("Emulator"))
+		Expect(resource.Memory).To(Equal(64 * 1024))
+		Expect(*resource.PowerState).To(Equal(inventory.OFF))
+		Expect(*resource.Labels).To(HaveKeyWithValue("server-type", "dell"))
+	})
+
+	It("reports hosts with an unreachable BMC as unknown", func() {
+		resp, err := server.GetResource(ctx, inventory.GetResourceRequestObject{ResourceId: "host-2"})
+		Expect(err).ToNot(HaveOccurred())
+		resource := resp.(inventory.GetResource200JSONResponse)
+		Expect(resource.UsageState).To(Equal(inventory.IDLE))
+		Expect(resource.OperationalState).To(Equal(inventory.ResourceInfoOperationalStateUNKNOWN))
+		Expect(resource.PowerState).To(BeNil())
+	})
+
+	It("reports unknown resources and pools", func() {
+		resp, err := server.GetResource(ctx, inventory.GetResourceRequestObject{ResourceId: "host-3"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.(inventory.GetResource404ApplicationProblemPlusJSONResponse).Status).To(Equal(http.StatusNotFound))
+
+		pool, err := server.GetResourcePool(ctx, inventory.GetResourcePoolRequestObject{ResourcePoolId: "pool-2"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pool.(inventory.GetResourcePool404ApplicationProblemPlusJSONResponse).Status).To(Equal(http.StatusNotFound))
+	})
+
+	It("fails when the host inventory is unavailable", func() {
+		server.HostsConfigMap = "missing"
+		_, err := server.GetResources(ctx, inventory.GetResourcesRequestObject{})
+		Expect(err).To(MatchError(ContainSubstring("failed to get host inventory ConfigMap")))
+	})
+})
@@ -0,0 +1,32 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+)
+
+// Serve starts the Redfish HardwarePlugin API server and blocks until it terminates or context is canceled.
+func Serve(ctx context.Context, logger *slog.Logger, config svcutils.CommonServerConfig, hubClient client.Client,
+	noncachedClient client.Reader, hostsConfigMap string) error {
+	// nolint: wrapcheck
+	return api.BuildAndServe(ctx, logger, config, "Redfish HardwarePlugin",
+		func(serverLogger *slog.Logger) (provisioning.StrictServerInterface, error) {
+			return NewRedfishPluginServer(config, hubClient, noncachedClient, serverLogger)
+		},
+		func(serverLogger *slog.Logger) (inventory.StrictServerInterface, error) {
+			return NewRedfishPluginInventoryServer(hubClient, noncachedClient, hostsConfigMap, serverLogger)
+		})
+}
@@ -0,0 +1,277 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+// SimulatorConfigKey is the key of the simulator configuration in its ConfigMap
+const SimulatorConfigKey = "config.yaml"
+
+// Operation identifies a simulated hardware operation
+type Operation string
+
+const (
+	OperationAllocation    Operation = "allocation"
+	OperationConfiguration Operation = "configuration"
+	OperationDeallocation  Operation = "deallocation"
+)
+
+var operations = []Operation{OperationAllocation, OperationConfiguration, OperationDeallocation}
+
+// SimulatorConfig describes the simulated node inventory and how the simulated operations behave
+type SimulatorConfig struct {
+	// Nodes is the inventory of simulated nodes available for allocation
+	Nodes []SimulatedNode `json:"nodes"`
+
+	// Allocation controls the allocation of the nodes of a NodeAllocationRequest
+	Allocation OperationBehavior `json:"allocation,omitempty"`
+
+	// Configuration controls the BIOS and firmware configuration of the nodes when a hardware profile changes
+	Configuration OperationBehavior `json:"configuration,omitempty"`
+
+	// Deallocation controls the release of the nodes of a deleted NodeAllocationRequest
+	Deallocation OperationBehavior `json:"deallocation,omitempty"`
+}
+
+// OperationBehavior controls the duration and the failure injection of a simulated operation
+type OperationBehavior struct {
+	// Delay is how long the operation takes to complete
+	Delay metav1.Duration `json:"delay,omitempty"`
+
+	// FailureRate is the probability, between 0 and 1, that the operation fails
+	FailureRate float64 `json:"failureRate,omitempty"`
+
+	// FailureMessage is reported when the operation fails
+	FailureMessage string `json:"failureMessage,omitempty"`
+}
+
+// SimulatedBMC describes the BMC reported for a simulated node
+type SimulatedBMC struct {
+	Address  string `json:"address,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+}
+
+// SimulatedInterface describes a network interface of a simulated node
+type SimulatedInterface struct {
+	Name       string `json:"name"`
+	Label      string `json:"label,omitempty"`
+	MACAddress string `json:"macAddress"`
+}
+
+// SimulatedNode describes a node of the simulated inventory
+type SimulatedNode struct {
+	// ID uniquely identifies the node, and is used as its resource identifier
+	ID string `json:"id"`
+
+	ResourcePoolID string `json:"resourcePoolId"`
+	SiteID         string `json:"siteId"`
+
+	// Hostname defaults to the ID of the node
+	Hostname string `json:"hostname,omitempty"`
+
+	// Labels are matched against the resourceSelector of the node groups
+	Labels map[string]string `json:"labels,omitempty"`
+
+	BMC        SimulatedBMC         `json:"bmc,omitempty"`
+	Interfaces []SimulatedInterface `json:"interfaces,omitempty"`
+
+	Vendor       string `json:"vendor,omitempty"`
+	Model        string `json:"model,omitempty"`
+	SerialNumber string `json:"serialNumber,omitempty"`
+	Memory       int    `json:"memory,omitempty"`
+	Architecture string `json:"architecture,omitempty"`
+	Cores        int    `json:"cores,omitempty"`
+
+	// FailOperations lists the operations that always fail when they involve this node
+	FailOperations []Operation `json:"failOperations,omitempty"`
+}
+
+// GetHostname returns the hostname reported for the node
+func (n *SimulatedNode) GetHostname() string {
+	if n.Hostname != "" {
+		return n.Hostname
+	}
+	return n.ID
+}
+
+// GetBMCAddress returns the BMC address reported for the node
+func (n *SimulatedNode) GetBMCAddress() string {
+	if n.BMC.Address != "" {
+		return n.BMC.Address
+	}
+	return fmt.Sprintf("redfish+https://%s.simulator.invalid/redfish/v1/Systems/1", n.ID)
+}
+
+// GetBMCCredentials returns the BMC username and password reported for the node
+func (n *SimulatedNode) GetBMCCredentials() (string, string) {
+	username, password := n.BMC.Username, n.BMC.Password
+	if username == "" {
+		username = "admin"
+	}
+	if password == "" {
+		password = "password"
+	}
+	return username, password
+}
+
+// FailsOn returns whether the given operation always fails for the node
+func (n *SimulatedNode) FailsOn(operation Operation) bool {
+	return slices.Contains(n.FailOperations, operation)
+}
+
+// MatchesSelector returns whether the node labels contain all the labels of the resource selector
+func (n *SimulatedNode) MatchesSelector(selector map[string]string) bool {
+	for key, value := range selector {
+		if n.Labels[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+// GetNode returns the simulated node with the given ID, or nil if there is none
+func (c *SimulatorConfig) GetNode(id string) *SimulatedNode {
+	for i := range c.Nodes {
+		if c.Nodes[i].ID == id {
+			return &c.Nodes[i]
+		}
+	}
+	return nil
+}
+
+// Validate checks that the configuration is usable
+func (c *SimulatorConfig) Validate() error {
+	ids := make(map[string]bool)
+	for _, node := range c.Nodes {
+		if node.ID == "" {
+			return fmt.Errorf("node is missing an id")
+		}
+		if errs := validation.IsDNS1123Subdomain(node.ID); len(errs) > 0 {
+			return fmt.Errorf("node id '%s' is invalid: %s", node.ID, strings.Join(errs, ", "))
+		}
+		if ids[node.ID] {
+			return fmt.Errorf("node id '%s' is not unique", node.ID)
+		}
+		ids[node.ID] = true
+
+		if node.ResourcePoolID == "" || node.SiteID == "" {
+			return fmt.Errorf("node '%s' must have a resourcePoolId and a siteId", node.ID)
+		}
+
+		for _, iface := range node.Interfaces {
+			if _, err := net.ParseMAC(iface.MACAddress); err != nil {
+				return fmt.Errorf("interface '%s' of node '%s' has an invalid macAddress: %w", iface.Name, node.ID, err)
+			}
+		}
+
+		for _, operation := range node.FailOperations {
+			if !slices.Contains(operations, operation) {
+				return fmt.Errorf("node '%s' has an unknown failOperation '%s'", node.ID, operation)
+			}
+		}
+	}
+
+	for operation, behavior := range map[Operation]OperationBehavior{
+		OperationAllocation:    c.Allocation,
+		OperationConfiguration: c.Configuration,
+		OperationDeallocation:  c.Deallocation,
+	} {
+		if behavior.FailureRate < 0 || behavior.FailureRate > 1 {
+			return fmt.Errorf("%s failureRate must be between 0 and 1", operation)
+		}
+		if behavior.Delay.Duration < 0 {
+			return fmt.Errorf("%s delay must not be negative", operation)
+		}
+	}
+
+	return nil
+}
+
+// ParseConfig parses and validates a YAML simulator configuration
+func ParseConfig(data []byte) (*SimulatorConfig, error) {
+	config := &SimulatorConfig{}
+	if err := yaml.UnmarshalStrict(data, config); err != nil {
+		return nil, fmt.Errorf("failed to parse simulator configuration: %w", err)
+	}
+
+	if err := config.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid simulator configuration: %w", err)
+	}
+	return config, nil
+}
+
+// ConfigProvider returns the current simulator configuration
+type ConfigProvider interface {
+	GetConfig(ctx context.Context) (*SimulatorConfig, error)
+}
+
+// StaticConfigProvider always returns the same configuration
+type StaticConfigProvider struct {
+	Config *SimulatorConfig
+}
+
+func (p *StaticConfigProvider) GetConfig(_ context.Context) (*SimulatorConfig, error) {
+	return p.Config, nil
+}
+
+// NewFileConfigProvider loads the configuration from a YAML file once, at startup
+func NewFileConfigProvider(path string) (*StaticConfigProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read simulator configuration file '%s': %w", path, err)
+	}
+
+	config, err := ParseConfig(data)
+	if err != nil {
+		return nil, err
+	}
+	return &StaticConfigProvider{Config: config}, nil
+}
+
+// ConfigMapConfigProvider reads the configuration from a ConfigMap each time it is requested, so that the inventory
+// and the failure injection can be changed without restarting the simulator
+type ConfigMapConfigProvider struct {
+	Reader client.Reader
+	Key    types.NamespacedName
+}
+
+// NewConfigMapConfigProvider creates a provider for the configuration stored in the given ConfigMap
+func NewConfigMapConfigProvider(reader client.Reader, namespace, name string) *ConfigMapConfigProvider {
+	return &ConfigMapConfigProvider{
+		Reader: reader,
+		Key:    types.NamespacedName{Namespace: namespace, Name: name},
+	}
+}
+
+func (p *ConfigMapConfigProvider) GetConfig(ctx context.Context) (*SimulatorConfig, error) {
+	cm := &corev1.ConfigMap{}
+	if err := p.Reader.Get(ctx, p.Key, cm); err != nil {
+		return nil, fmt.Errorf("failed to get simulator configuration ConfigMap %s: %w", p.Key, err)
+	}
+
+	data, exists := cm.Data[SimulatorConfigKey]
+	if !exists {
+		return nil, fmt.Errorf("simulator configuration ConfigMap %s is missing the '%s' key", p.Key, SimulatorConfigKey)
+	}
+
+	return ParseConfig([]byte(data))
+}
@@ -0,0 +1,133 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testConfig = `
+nodes:
+- id: node-1
+  resourcePoolId: pool-1
+  siteId: site-1
+  labels:
+    server-type: dell
+  interfaces:
+  - name: eno1
+    label: bootable-interface
+    macAddress: "00:00:5e:00:53:01"
+allocation:
+  delay: 30s
+  failureRate: 0.25
+`
+
+var _ = Describe("Simulator configuration", func() {
+	Describe("ParseConfig", func() {
+		It("parses the inventory and the operation behaviors", func() {
+			config, err := ParseConfig([]byte(testConfig))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Nodes).To(HaveLen(1))
+			Expect(config.Nodes[0].Labels).To(HaveKeyWithValue("server-type", "dell"))
+			Expect(config.Allocation.Delay.Duration).To(Equal(30 * time.Second))
+			Expect(config.Allocation.FailureRate).To(Equal(0.25))
+			Expect(config.Deallocation.Delay.Duration).To(BeZero())
+		})
+
+		DescribeTable("rejects invalid configurations",
+			func(data, expected string) {
+				_, err := ParseConfig([]byte(data))
+				Expect(err).To(MatchError(ContainSubstring(expected)))
+			},
+			Entry("unknown field", "nodes: []\nunknown: true", "unknown"),
+			Entry("missing id", "nodes:\n- resourcePoolId: pool-1\n  siteId: site-1", "missing an id"),
+			Entry("duplicate id",
+				"nodes:\n- {id: node-1, resourcePoolId: p, siteId: s}\n- {id: node-1, resourcePoolId: p, siteId: s}",
+				"not unique"),
+			Entry("missing pool", "nodes:\n- {id: node-1, siteId: s}", "resourcePoolId"),
+			Entry("invalid MAC address",
+				"nodes:\n- {id: node-1, resourcePoolId: p, siteId: s, interfaces: [{name: eno1, macAddress: bad}]}",
+				"invalid macAddress"),
+			Entry("unknown failure operation",
+				"nodes:\n- {id: node-1, resourcePoolId: p, siteId: s, failOperations: [reboot]}",
+				"unknown failOperation"),
+			Entry("failure rate out of range", "configuration:\n  failureRate: 1.5", "between 0 and 1"),
+		)
+	})
+
+	Describe("SimulatedNode", func() {
+		It("defaults the hostname and BMC details", func() {
+			node := SimulatedNode{ID: "node-1"}
+			Expect(node.GetHostname()).To(Equal("node-1"))
+			Expect(node.GetBMCAddress()).To(ContainSubstring("node-1"))
+			username, password := node.GetBMCCredentials()
+			Expect(username).ToNot(BeEmpty())
+			Expect(password).ToNot(BeEmpty())
+		})
+
+		It("matches the resource selector against its labels", func() {
+			node := SimulatedNode{ID: "node-1", Labels: map[string]string{"server-type": "dell", "rack": "r1"}}
+			Expect(node.MatchesSelector(nil)).To(BeTrue())
+			Expect(node.MatchesSelector(map[string]string{"server-type": "dell"})).To(BeTrue())
+			Expect(node.MatchesSelector(map[string]string{"server-type": "hpe"})).To(BeFalse())
+		})
+	})
+
+	Describe("ConfigProviders", func() {
+		It("loads the configuration from a file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "config.yaml")
+			Expect(os.WriteFile(path, []byte(testConfig), 0o600)).To(Succeed())
+
+			provider, err := NewFileConfigProvider(path)
+			Expect(err).ToNot(HaveOccurred())
+			config, err := provider.GetConfig(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Nodes).To(HaveLen(1))
+		})
+
+		It("reads the configuration from a ConfigMap on each use", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "simulator-config", Namespace: "hwmgr"},
+				Data:       map[string]string{SimulatorConfigKey: testConfig},
+			}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+			provider := NewConfigMapConfigProvider(c, "hwmgr", "simulator-config")
+
+			config, err := provider.GetConfig(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Allocation.FailureRate).To(Equal(0.25))
+
+			cm.Data[SimulatorConfigKey] = "nodes: []\nallocation:\n  failureRate: 1\n"
+			Expect(c.Update(context.Background(), cm)).To(Succeed())
+			config, err = provider.GetConfig(context.Background())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.Allocation.FailureRate).To(Equal(1.0))
+		})
+
+		It("fails when the ConfigMap is missing the configuration key", func() {
+			scheme := runtime.NewScheme()
+			Expect(corev1.AddToScheme(scheme)).To(Succeed())
+			cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "simulator-config", Namespace: "hwmgr"}}
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build()
+
+			_, err := NewConfigMapConfigProvider(c, "hwmgr", "simulator-config").GetConfig(context.Background())
+			Expect(err).To(MatchError(ContainSubstring(SimulatorConfigKey)))
+		})
+	})
+})
@@ -0,0 +1,180 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+)
+
+// getAllocatedNodes maps the ID of each allocated simulated node to its AllocatedNode
+func getAllocatedNodes(ctx context.Context, c client.Client, namespace string) (map[string]*pluginsv1alpha1.AllocatedNode, error) {
+	var nodelist pluginsv1alpha1.AllocatedNodeList
+	if err := c.List(ctx, &nodelist, client.InNamespace(namespace),
+		client.MatchingLabels{hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID}); err != nil {
+		return nil, fmt.Errorf("failed to list AllocatedNodes: %w", err)
+	}
+
+	nodes := make(map[string]*pluginsv1alpha1.AllocatedNode)
+	for i := range nodelist.Items {
+		nodes[nodelist.Items[i].Spec.HwMgrNodeId] = &nodelist.Items[i]
+	}
+	return nodes, nil
+}
+
+func getResourceInfo(simulated *SimulatedNode, node *pluginsv1alpha1.AllocatedNode) inventory.ResourceInfo {
+	resource := inventory.ResourceInfo{
+		AdminState:       inventory.ResourceInfoAdminStateUNLOCKED,
+		Description:      simulated.ID,
+		Memory:           simulated.Memory,
+		Model:            simulated.Model,
+		Name:             simulated.ID,
+		OperationalState: inventory.ResourceInfoOperationalStateENABLED,
+		Processors:       []inventory.ProcessorInfo{},
+		ResourceId:       simulated.ID,
+		ResourcePoolId:   simulated.ResourcePoolID,
+		SerialNumber:     simulated.SerialNumber,
+		UsageState:       inventory.IDLE,
+		Vendor:           simulated.Vendor,
+	}
+
+	if len(simulated.Labels) > 0 {
+		labels := make(map[string]string, len(simulated.Labels))
+		tags := make([]string, 0, len(simulated.Labels))
+		for key, value := range simulated.Labels {
+			labels[key] = value
+			tags = append(tags, fmt.Sprintf("%s: %s", key, value))
+		}
+		slices.Sort(tags)
+		resource.Labels = &labels
+		resource.Tags = &tags
+	}
+
+	if simulated.Architecture != "" || simulated.Cores != 0 {
+		architecture, cores := simulated.Architecture, simulated.Cores
+		resource.Processors = append(resource.Processors, inventory.ProcessorInfo{
+			Architecture: &architecture,
+			Cores:        &cores,
+		})
+	}
+
+	powerState := inventory.OFF
+	if node != nil {
+		powerState = inventory.ON
+		resource.HwProfile = node.Status.HwProfile
+		resource.UsageState = inventory.ACTIVE
+	}
+	resource.PowerState = &powerState
+
+	return resource
+}
+
+func getResourcePoolInfo(simulated *SimulatedNode) inventory.ResourcePoolInfo {
+	siteID := simulated.SiteID
+	return inventory.ResourcePoolInfo{
+		ResourcePoolId: simulated.ResourcePoolID,
+		Description:    simulated.ResourcePoolID,
+		Name:           simulated.ResourcePoolID,
+		SiteId:         &siteID,
+	}
+}
+
+// getPoolResources returns the resources of the simulated nodes of a resource pool
+func getPoolResources(ctx context.Context, c client.Client, namespace string, config *SimulatorConfig,
+	poolID string) ([]inventory.ResourceInfo, error) {
+	nodes, err := getAllocatedNodes(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	var resources []inventory.ResourceInfo
+	for i := range config.Nodes {
+		if poolID == "" || config.Nodes[i].ResourcePoolID == poolID {
+			resources = append(resources, getResourceInfo(&config.Nodes[i], nodes[config.Nodes[i].ID]))
+		}
+	}
+	return resources, nil
+}
+
+func GetResourcePools(config *SimulatorConfig) (inventory.GetResourcePoolsResponseObject, error) {
+	var resp []inventory.ResourcePoolInfo
+	seen := make(map[string]bool)
+	for i := range config.Nodes {
+		if !seen[config.Nodes[i].ResourcePoolID] {
+			seen[config.Nodes[i].ResourcePoolID] = true
+			resp = append(resp, getResourcePoolInfo(&config.Nodes[i]))
+		}
+	}
+
+	return inventory.GetResourcePools200JSONResponse(resp), nil
+}
+
+func GetResources(ctx context.Context, c client.Client, namespace string,
+	config *SimulatorConfig) (inventory.GetResourcesResponseObject, error) {
+	resources, err := getPoolResources(ctx, c, namespace, config, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory.GetResources200JSONResponse(resources), nil
+}
+
+func GetResourcePool(config *SimulatorConfig, poolID string) (inventory.GetResourcePoolResponseObject, error) {
+	for i := range config.Nodes {
+		if config.Nodes[i].ResourcePoolID == poolID {
+			return inventory.GetResourcePool200JSONResponse(getResourcePoolInfo(&config.Nodes[i])), nil
+		}
+	}
+
+	return inventory.GetResourcePool404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+		Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+		Status: http.StatusNotFound,
+	}), nil
+}
+
+func GetResourcePoolResources(ctx context.Context, c client.Client, namespace string, config *SimulatorConfig,
+	poolID string) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	resources, err := getPoolResources(ctx, c, namespace, config, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resources) == 0 {
+		return inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource pool '%s'", poolID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	return inventory.GetResourcePoolResources200JSONResponse(resources), nil
+}
+
+func GetResource(ctx context.Context, c client.Client, namespace string, config *SimulatorConfig,
+	resourceID string) (inventory.GetResourceResponseObject, error) {
+	simulated := config.GetNode(resourceID)
+	if simulated == nil {
+		return inventory.GetResource404ApplicationProblemPlusJSONResponse(inventory.ProblemDetails{
+			Detail: fmt.Sprintf("could not find resource '%s'", resourceID),
+			Status: http.StatusNotFound,
+		}), nil
+	}
+
+	nodes, err := getAllocatedNodes(ctx, c, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	return inventory.GetResource200JSONResponse(getResourceInfo(simulated, nodes[resourceID])), nil
+}
@@ -0,0 +1,104 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+)
+
+var _ = Describe("Simulator inventory", func() {
+	var (
+		ctx    context.Context
+		config *SimulatorConfig
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		config = &SimulatorConfig{
+			Nodes: []SimulatedNode{
+				{ID: "node-1", ResourcePoolID: "pool-1", SiteID: "site-1", Labels: map[string]string{"server-type": "dell"},
+					Memory: 65536, Architecture: "x86_64", Cores: 32, Vendor: "Dell"},
+				{ID: "node-2", ResourcePoolID: "pool-1", SiteID: "site-1"},
+				{ID: "node-3", ResourcePoolID: "pool-2", SiteID: "site-2"},
+			},
+		}
+	})
+
+	allocatedNode := &pluginsv1alpha1.AllocatedNode{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "allocated-node-1",
+			Namespace: testNamespace,
+			Labels:    map[string]string{hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID},
+		},
+		Spec:   pluginsv1alpha1.AllocatedNodeSpec{HwMgrNodeId: "node-1", HwMgrNodeNs: testNamespace},
+		Status: pluginsv1alpha1.AllocatedNodeStatus{HwProfile: "profile-v1"},
+	}
+
+	It("lists each resource pool once", func() {
+		resp, err := GetResourcePools(config)
+		Expect(err).ToNot(HaveOccurred())
+		pools := resp.(inventory.GetResourcePools200JSONResponse)
+		Expect(pools).To(HaveLen(2))
+		Expect(pools[0].ResourcePoolId).To(Equal("pool-1"))
+		Expect(*pools[1].SiteId).To(Equal("site-2"))
+	})
+
+	It("reports allocated nodes as active with their hardware profile", func() {
+		c := newTestClient(allocatedNode)
+		resp, err := GetResources(ctx, c, testNamespace, config)
+		Expect(err).ToNot(HaveOccurred())
+		resources := resp.(inventory.GetResources200JSONResponse)
+		Expect(resources).To(HaveLen(3))
+
+		Expect(resources[0].UsageState).To(Equal(inventory.ACTIVE))
+		Expect(resources[0].HwProfile).To(Equal("profile-v1"))
+		Expect(resources[0].Memory).To(Equal(65536))
+		Expect(*resources[0].Processors[0].Cores).To(Equal(32))
+		Expect(*resources[0].Tags).To(ConsistOf("server-type: dell"))
+		Expect(resources[1].UsageState).To(Equal(inventory.IDLE))
+		Expect(resources[1].Processors).To(BeEmpty())
+	})
+
+	It("looks up a single resource pool and its resources", func() {
+		c := newTestClient()
+		poolResp, err := GetResourcePool(config, "pool-2")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(poolResp.(inventory.GetResourcePool200JSONResponse).ResourcePoolId).To(Equal("pool-2"))
+
+		resourcesResp, err := GetResourcePoolResources(ctx, c, testNamespace, config, "pool-1")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resourcesResp.(inventory.GetResourcePoolResources200JSONResponse)).To(HaveLen(2))
+
+		resourceResp, err := GetResource(ctx, c, testNamespace, config, "node-3")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resourceResp.(inventory.GetResource200JSONResponse).ResourcePoolId).To(Equal("pool-2"))
+	})
+
+	It("returns not found for unknown pools and resources", func() {
+		c := newTestClient()
+		poolResp, err := GetResourcePool(config, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(poolResp.(inventory.GetResourcePool404ApplicationProblemPlusJSONResponse).Status).To(Equal(http.StatusNotFound))
+
+		resourcesResp, err := GetResourcePoolResources(ctx, c, testNamespace, config, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resourcesResp).To(BeAssignableToTypeOf(inventory.GetResourcePoolResources404ApplicationProblemPlusJSONResponse{}))
+
+		resourceResp, err := GetResource(ctx, c, testNamespace, config, "missing")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resourceResp).To(BeAssignableToTypeOf(inventory.GetResource404ApplicationProblemPlusJSONResponse{}))
+	})
+})
@@ -0,0 +1,37 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"fmt"
+	"log/slog"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+)
+
+// SimulatorControllers holds references to the simulator controllers for lifecycle management
+type SimulatorControllers struct {
+	NodeAllocationReconciler *NodeAllocationRequestReconciler
+}
+
+func SetupSimulatorControllers(mgr ctrl.Manager, namespace string, config ConfigProvider, baseLogger *slog.Logger) (*SimulatorControllers, error) {
+	narLogger := baseLogger.With("controller", "simulator_nodeallocationrequest_controller")
+	nodeAllocationReconciler := &NodeAllocationRequestReconciler{
+		NodeAllocationRequestReconcilerBase: hwmgrutils.NewNodeAllocationRequestReconcilerBase(mgr, namespace, narLogger),
+		Config:                              config,
+	}
+
+	if err := nodeAllocationReconciler.SetupWithManager(mgr); err != nil {
+		return nil, fmt.Errorf("failed to setup NodeAllocationRequest controller: %w", err)
+	}
+
+	return &SimulatorControllers{
+		NodeAllocationReconciler: nodeAllocationReconciler,
+	}, nil
+}
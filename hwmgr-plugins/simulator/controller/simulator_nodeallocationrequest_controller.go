@@ -0,0 +1,558 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	typederrors "github.com/openshift-kni/oran-o2ims/internal/typed-errors"
+)
+
+// NodeAllocationRequestReconciler reconciles NodeAllocationRequest objects associated with the simulator H/W plugin.
+// Nodes are allocated from the simulated inventory of the configuration, and each operation completes once its
+// configured delay has elapsed, unless a failure is injected.
+type NodeAllocationRequestReconciler struct {
+	hwmgrutils.NodeAllocationRequestReconcilerBase
+	Config ConfigProvider
+
+	// Random returns a number in [0, 1) used for failure injection. Along with Now, it can be replaced to make the
+	// simulation deterministic.
+	Random func() float64
+
+	// operationStarts records when each in-flight simulated operation started
+	operationStarts map[string]time.Time
+	mutex           sync.Mutex
+}
+
+func (r *NodeAllocationRequestReconciler) random() float64 {
+	if r.Random != nil {
+		return r.Random()
+	}
+	return rand.Float64() // #nosec G404 -- failure injection does not need a secure random number
+}
+
+func operationKey(nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest, operation Operation) string {
+	return fmt.Sprintf("%s/%s", nodeAllocationRequest.Name, operation)
+}
+
+// remainingDelay starts the operation clock on first use, and returns how long the operation must still run
+func (r *NodeAllocationRequestReconciler) remainingDelay(key string, delay time.Duration) time.Duration {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.operationStarts == nil {
+		r.operationStarts = make(map[string]time.Time)
+	}
+
+	started, exists := r.operationStarts[key]
+	if !exists {
+		started = r.CurrentTime()
+		r.operationStarts[key] = started
+	}
+
+	if elapsed := r.CurrentTime().Sub(started); elapsed < delay {
+		return delay - elapsed
+	}
+	return 0
+}
+
+// finishOperation forgets the start time of a completed operation, so that a retry is delayed again
+func (r *NodeAllocationRequestReconciler) finishOperation(key string) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	delete(r.operationStarts, key)
+}
+
+// injectFailure decides whether a simulated operation fails, either because one of its nodes always fails the
+// operation or because of the configured failure rate
+func (r *NodeAllocationRequestReconciler) injectFailure(
+	operation Operation, behavior OperationBehavior, nodes []*SimulatedNode) (string, bool) {
+
+	for _, node := range nodes {
+		if node.FailsOn(operation) {
+			return fmt.Sprintf("simulated %s failure of node '%s'", operation, node.ID), true
+		}
+	}
+
+	if behavior.FailureRate > 0 && r.random() < behavior.FailureRate {
+		if behavior.FailureMessage != "" {
+			return behavior.FailureMessage, true
+		}
+		return fmt.Sprintf("simulated %s failure", operation), true
+	}
+
+	return "", false
+}
+
+func requeueAfter(delay time.Duration) ctrl.Result {
+	if delay > 0 {
+		return hwmgrutils.RequeueWithCustomInterval(delay)
+	}
+	return hwmgrutils.RequeueImmediately()
+}
+
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests,verbs=get;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=nodeallocationrequests/finalizers,verbs=update
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=allocatednodes,verbs=get;create;list;watch;update;patch;delete
+//+kubebuilder:rbac:groups=plugins.clcm.openshift.io,resources=allocatednodes/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;create;update;patch;watch;delete
+
+func (r *NodeAllocationRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return hwmgrutils.ReconcileNodeAllocationRequest(ctx, &r.NodeAllocationRequestReconcilerBase, req, r)
+}
+
+// Load returns the simulator configuration used by the other steps of the reconciliation
+func (r *NodeAllocationRequestReconciler) Load(ctx context.Context) (*SimulatorConfig, error) {
+	config, err := r.Config.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get simulator configuration: %w", err)
+	}
+	return config, nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleCreate(
+	ctx context.Context,
+	config *SimulatorConfig,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.InProgress, metav1.ConditionFalse, "Handling creation"); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	if err := hwmgrutils.UpdateNodeAllocationRequestPluginStatus(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update hwMgrPlugin observedGeneration Status: %w", err)
+	}
+
+	return requeueAfter(r.remainingDelay(operationKey(nodeAllocationRequest, OperationAllocation), config.Allocation.Delay.Duration)), nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleProcessing(
+	ctx context.Context,
+	config *SimulatorConfig,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	key := operationKey(nodeAllocationRequest, OperationAllocation)
+	if remaining := r.remainingDelay(key, config.Allocation.Delay.Duration); remaining > 0 {
+		r.Logger.InfoContext(ctx, "Simulated allocation in progress", slog.Duration("remaining", remaining))
+		return hwmgrutils.RequeueWithCustomInterval(remaining), nil
+	}
+
+	selected, err := r.selectNodes(ctx, config, nodeAllocationRequest)
+	if err == nil {
+		var nodes []*SimulatedNode
+		for _, groupNodes := range selected {
+			nodes = append(nodes, groupNodes...)
+		}
+		if message, failed := r.injectFailure(OperationAllocation, config.Allocation, nodes); failed {
+			err = fmt.Errorf("%s", message)
+		}
+	}
+
+	if err != nil {
+		r.finishOperation(key)
+		reason := hwmgmtv1alpha1.Failed
+		if typederrors.IsInputError(err) {
+			reason = hwmgmtv1alpha1.InvalidInput
+		}
+		r.Logger.InfoContext(ctx, "Simulated allocation failed", slog.String("error", err.Error()))
+		if updateErr := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+			hwmgmtv1alpha1.Provisioned, reason, metav1.ConditionFalse, err.Error()); updateErr != nil {
+			return hwmgrutils.RequeueWithMediumInterval(),
+				fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, updateErr)
+		}
+		return hwmgrutils.DoNotRequeue(), nil
+	}
+
+	var nodeNames []string
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		for _, node := range selected[nodeGroup.NodeGroupData.Name] {
+			nodename, err := r.allocateNode(ctx, nodeAllocationRequest, &nodeGroup.NodeGroupData, node)
+			if err != nil {
+				return hwmgrutils.RequeueWithShortInterval(), err
+			}
+			nodeNames = append(nodeNames, nodename)
+		}
+	}
+
+	nodeAllocationRequest.Status.Properties.NodeNames = nodeNames
+	if err := hwmgrutils.UpdateNodeAllocationRequestProperties(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update NodeAllocationRequest properties: %w", err)
+	}
+
+	r.finishOperation(key)
+	r.Logger.InfoContext(ctx, "NodeAllocationRequest is fully allocated", slog.Any("nodes", nodeNames))
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Provisioned, hwmgmtv1alpha1.Completed, metav1.ConditionTrue, "Created"); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+func (r *NodeAllocationRequestReconciler) HandleSpecChanged(
+	ctx context.Context,
+	config *SimulatorConfig,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	configuredCondition := meta.FindStatusCondition(nodeAllocationRequest.Status.Conditions, string(hwmgmtv1alpha1.Configured))
+	if configuredCondition != nil && configuredCondition.Reason == string(hwmgmtv1alpha1.Failed) &&
+		nodeAllocationRequest.Status.ObservedConfigTransactionId == nodeAllocationRequest.Spec.ConfigTransactionId {
+		// The configuration of this transaction has already failed; wait for a new one
+		return hwmgrutils.DoNotRequeue(), nil
+	}
+
+	if configuredCondition == nil || configuredCondition.Reason != string(hwmgmtv1alpha1.ConfigUpdate) {
+		if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+			hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.ConfigUpdate, metav1.ConditionFalse, string(hwmgmtv1alpha1.AwaitConfig)); err != nil {
+			return hwmgrutils.RequeueWithMediumInterval(),
+				fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+		}
+	}
+
+	key := operationKey(nodeAllocationRequest, OperationConfiguration)
+	if remaining := r.remainingDelay(key, config.Configuration.Delay.Duration); remaining > 0 {
+		r.Logger.InfoContext(ctx, "Simulated configuration in progress", slog.Duration("remaining", remaining))
+		return hwmgrutils.RequeueWithCustomInterval(remaining), nil
+	}
+
+	nodelist, err := hwmgrutils.GetChildNodes(ctx, r.Logger, r.Client, nodeAllocationRequest)
+	if err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to get child nodes: %w", err)
+	}
+
+	var nodes []*SimulatedNode
+	for _, node := range nodelist.Items {
+		if simulated := config.GetNode(node.Spec.HwMgrNodeId); simulated != nil {
+			nodes = append(nodes, simulated)
+		}
+	}
+
+	r.finishOperation(key)
+	if message, failed := r.injectFailure(OperationConfiguration, config.Configuration, nodes); failed {
+		r.Logger.InfoContext(ctx, "Simulated configuration failed", slog.String("error", message))
+		for i := range nodelist.Items {
+			if err := hwmgrutils.SetNodeFailedStatus(ctx, r.Client, r.Logger, &nodelist.Items[i],
+				string(hwmgmtv1alpha1.Configured), message); err != nil {
+				return hwmgrutils.RequeueWithShortInterval(), err //nolint:wrapcheck
+			}
+		}
+		if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+			hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.Failed, metav1.ConditionFalse, message); err != nil {
+			return hwmgrutils.RequeueWithMediumInterval(),
+				fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+		}
+		return hwmgrutils.DoNotRequeue(), nil
+	}
+
+	for i := range nodelist.Items {
+		if err := r.applyHwProfile(ctx, nodeAllocationRequest, &nodelist.Items[i]); err != nil {
+			return hwmgrutils.RequeueWithShortInterval(), err
+		}
+	}
+
+	if err := r.UpdateConditionAndSendCallback(ctx, nodeAllocationRequest,
+		hwmgmtv1alpha1.Configured, hwmgmtv1alpha1.ConfigApplied, metav1.ConditionTrue, string(hwmgmtv1alpha1.ConfigSuccess)); err != nil {
+		return hwmgrutils.RequeueWithMediumInterval(),
+			fmt.Errorf("failed to update status for NodeAllocationRequest %s: %w", nodeAllocationRequest.Name, err)
+	}
+
+	if err := hwmgrutils.UpdateNodeAllocationRequestPluginStatus(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to update hwMgrPlugin observedGeneration Status: %w", err)
+	}
+
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+// HandleDeletion releases the nodes of a deleted NodeAllocationRequest and removes its finalizer
+func (r *NodeAllocationRequestReconciler) HandleDeletion(
+	ctx context.Context,
+	config *SimulatorConfig,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (ctrl.Result, error) {
+
+	key := operationKey(nodeAllocationRequest, OperationDeallocation)
+	if remaining := r.remainingDelay(key, config.Deallocation.Delay.Duration); remaining > 0 {
+		r.Logger.InfoContext(ctx, "Simulated deallocation in progress", slog.Duration("remaining", remaining))
+		return hwmgrutils.RequeueWithCustomInterval(remaining), nil
+	}
+
+	nodelist, err := hwmgrutils.GetChildNodes(ctx, r.Logger, r.Client, nodeAllocationRequest)
+	if err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), fmt.Errorf("failed to get child nodes: %w", err)
+	}
+
+	var nodes []*SimulatedNode
+	for _, node := range nodelist.Items {
+		if simulated := config.GetNode(node.Spec.HwMgrNodeId); simulated != nil {
+			nodes = append(nodes, simulated)
+		}
+	}
+
+	r.finishOperation(key)
+	if message, failed := r.injectFailure(OperationDeallocation, config.Deallocation, nodes); failed {
+		// The deallocation is retried, and delayed again, until it succeeds
+		r.Logger.WarnContext(ctx, "Simulated deallocation failed, retrying", slog.String("error", message))
+		return hwmgrutils.RequeueWithShortInterval(), nil
+	}
+
+	for i := range nodelist.Items {
+		if err := r.Client.Delete(ctx, &nodelist.Items[i]); client.IgnoreNotFound(err) != nil {
+			return hwmgrutils.RequeueWithShortInterval(),
+				fmt.Errorf("failed to delete AllocatedNode %s: %w", nodelist.Items[i].Name, err)
+		}
+	}
+
+	if err := hwmgrutils.NodeAllocationRequestRemoveFinalizer(ctx, r.Client, nodeAllocationRequest); err != nil {
+		return hwmgrutils.RequeueWithShortInterval(), err //nolint:wrapcheck
+	}
+
+	// Forget any operation interrupted by the deletion
+	for _, operation := range operations {
+		r.finishOperation(operationKey(nodeAllocationRequest, operation))
+	}
+
+	r.Logger.InfoContext(ctx, "Deletion handling complete, finalizer removed")
+	return hwmgrutils.DoNotRequeue(), nil
+}
+
+// selectNodes picks the simulated nodes of each node group, keeping the nodes already allocated to the
+// NodeAllocationRequest and completing them with free nodes matching the resource pool, site and resource selector
+func (r *NodeAllocationRequestReconciler) selectNodes(
+	ctx context.Context,
+	config *SimulatorConfig,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest) (map[string][]*SimulatedNode, error) {
+
+	var nodelist pluginsv1alpha1.AllocatedNodeList
+	if err := r.Client.List(ctx, &nodelist, client.InNamespace(r.PluginNamespace),
+		client.MatchingLabels{hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID}); err != nil {
+		return nil, fmt.Errorf("failed to list AllocatedNodes: %w", err)
+	}
+
+	// Map each simulated node in use to the node group it is allocated to, if it belongs to this request
+	inUse := make(map[string]bool)
+	owned := make(map[string]string)
+	for _, node := range nodelist.Items {
+		inUse[node.Spec.HwMgrNodeId] = true
+		if node.Spec.NodeAllocationRequest == nodeAllocationRequest.Name {
+			owned[node.Spec.HwMgrNodeId] = node.Spec.GroupName
+		}
+	}
+
+	selected := make(map[string][]*SimulatedNode)
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		group := nodeGroup.NodeGroupData
+		for i := range config.Nodes {
+			node := &config.Nodes[i]
+			if owned[node.ID] == group.Name {
+				selected[group.Name] = append(selected[group.Name], node)
+			}
+		}
+
+		for i := range config.Nodes {
+			if len(selected[group.Name]) >= nodeGroup.Size {
+				break
+			}
+			node := &config.Nodes[i]
+			if inUse[node.ID] ||
+				(group.ResourcePoolId != "" && node.ResourcePoolID != group.ResourcePoolId) ||
+				(nodeAllocationRequest.Spec.Site != "" && node.SiteID != nodeAllocationRequest.Spec.Site) ||
+				!node.MatchesSelector(group.ResourceSelector) {
+				continue
+			}
+			inUse[node.ID] = true
+			selected[group.Name] = append(selected[group.Name], node)
+		}
+
+		if len(selected[group.Name]) < nodeGroup.Size {
+			return nil, typederrors.NewInputError(
+				"insufficient free simulated nodes for node group '%s' in resource pool '%s': requested %d, available %d",
+				group.Name, group.ResourcePoolId, nodeGroup.Size, len(selected[group.Name]))
+		}
+	}
+
+	for _, nodes := range selected {
+		sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	}
+	return selected, nil
+}
+
+// allocateNode ensures the AllocatedNode and BMC secret of a simulated node exist, and reports the node as
+// provisioned
+func (r *NodeAllocationRequestReconciler) allocateNode(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	group *hwmgmtv1alpha1.NodeGroupData,
+	simulated *SimulatedNode) (string, error) {
+
+	nodename := hwmgrutils.GenerateNodeName(hwmgrutils.SimulatorHardwarePluginID,
+		nodeAllocationRequest.Spec.ClusterId, r.PluginNamespace, simulated.ID)
+
+	node := &pluginsv1alpha1.AllocatedNode{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: nodename, Namespace: r.PluginNamespace}, node)
+	if err != nil && !errors.IsNotFound(err) {
+		return "", fmt.Errorf("failed to check if AllocatedNode exists: %w", err)
+	}
+
+	if errors.IsNotFound(err) {
+		blockDeletion := true
+		node = &pluginsv1alpha1.AllocatedNode{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nodename,
+				Namespace: r.PluginNamespace,
+				Labels: map[string]string{
+					hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID,
+				},
+				OwnerReferences: []metav1.OwnerReference{{
+					APIVersion:         nodeAllocationRequest.APIVersion,
+					Kind:               nodeAllocationRequest.Kind,
+					Name:               nodeAllocationRequest.Name,
+					UID:                nodeAllocationRequest.UID,
+					BlockOwnerDeletion: &blockDeletion,
+				}},
+			},
+			Spec: pluginsv1alpha1.AllocatedNodeSpec{
+				NodeAllocationRequest: nodeAllocationRequest.Name,
+				GroupName:             group.Name,
+				HwProfile:             group.HwProfile,
+				HardwarePluginRef:     nodeAllocationRequest.Spec.HardwarePluginRef,
+				HwMgrNodeId:           simulated.ID,
+				HwMgrNodeNs:           r.PluginNamespace,
+			},
+		}
+		if err := r.Client.Create(ctx, node); err != nil {
+			return "", fmt.Errorf("failed to create AllocatedNode %s: %w", nodename, err)
+		}
+		r.Logger.InfoContext(ctx, "AllocatedNode created", slog.String("nodename", nodename), slog.String("nodeId", simulated.ID))
+	}
+
+	secretName, err := r.ensureBMCSecret(ctx, node, simulated)
+	if err != nil {
+		return "", err
+	}
+
+	node.Status.BMC = &pluginsv1alpha1.BMC{
+		Address:         simulated.GetBMCAddress(),
+		CredentialsName: secretName,
+	}
+	node.Status.Interfaces = nil
+	for _, iface := range simulated.Interfaces {
+		node.Status.Interfaces = append(node.Status.Interfaces, &pluginsv1alpha1.Interface{
+			Name:       iface.Name,
+			Label:      iface.Label,
+			MACAddress: iface.MACAddress,
+		})
+	}
+	node.Status.Hostname = simulated.GetHostname()
+	node.Status.HwProfile = group.HwProfile
+	hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Provisioned),
+		string(hwmgmtv1alpha1.Completed), metav1.ConditionTrue, "Provisioned")
+
+	if err := r.Client.Status().Update(ctx, node); err != nil {
+		return "", fmt.Errorf("failed to update status of AllocatedNode %s: %w", nodename, err)
+	}
+
+	return nodename, nil
+}
+
+// ensureBMCSecret creates the BMC credentials secret of a simulated node, owned by its AllocatedNode so that it is
+// removed with it
+func (r *NodeAllocationRequestReconciler) ensureBMCSecret(
+	ctx context.Context,
+	node *pluginsv1alpha1.AllocatedNode,
+	simulated *SimulatedNode) (string, error) {
+
+	secretName := fmt.Sprintf("%s-bmc-secret", simulated.ID)
+	username, password := simulated.GetBMCCredentials()
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secretName,
+			Namespace: r.PluginNamespace,
+			Labels: map[string]string{
+				hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID,
+			},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: pluginsv1alpha1.GroupVersion.String(),
+				Kind:       "AllocatedNode",
+				Name:       node.Name,
+				UID:        node.UID,
+			}},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte(username),
+			"password": []byte(password),
+		},
+	}
+
+	if err := r.Client.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create BMC secret %s: %w", secretName, err)
+	}
+	return secretName, nil
+}
+
+// applyHwProfile simulates the BIOS and firmware configuration of a node for the hardware profile of its group
+func (r *NodeAllocationRequestReconciler) applyHwProfile(
+	ctx context.Context,
+	nodeAllocationRequest *pluginsv1alpha1.NodeAllocationRequest,
+	node *pluginsv1alpha1.AllocatedNode) error {
+
+	hwProfile := ""
+	for _, nodeGroup := range nodeAllocationRequest.Spec.NodeGroup {
+		if nodeGroup.NodeGroupData.Name == node.Spec.GroupName {
+			hwProfile = nodeGroup.NodeGroupData.HwProfile
+		}
+	}
+	if hwProfile == "" {
+		return nil
+	}
+
+	if node.Spec.HwProfile != hwProfile {
+		node.Spec.HwProfile = hwProfile
+		if err := r.Client.Update(ctx, node); err != nil {
+			return fmt.Errorf("failed to update hwProfile of AllocatedNode %s: %w", node.Name, err)
+		}
+	}
+
+	node.Status.HwProfile = hwProfile
+	hwmgrutils.SetStatusCondition(&node.Status.Conditions, string(hwmgmtv1alpha1.Configured),
+		string(hwmgmtv1alpha1.ConfigApplied), metav1.ConditionTrue, string(hwmgmtv1alpha1.ConfigSuccess))
+	if err := r.Client.Status().Update(ctx, node); err != nil {
+		return fmt.Errorf("failed to update status of AllocatedNode %s: %w", node.Name, err)
+	}
+
+	r.Logger.InfoContext(ctx, "Simulated hardware profile applied",
+		slog.String("nodename", node.Name), slog.String("hwProfile", hwProfile))
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *NodeAllocationRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// nolint: wrapcheck
+	return r.SetupController(mgr, "simulator_nodeallocationrequest", hwmgrutils.SimulatorHardwarePluginID, r)
+}
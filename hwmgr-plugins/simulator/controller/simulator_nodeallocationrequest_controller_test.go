@@ -0,0 +1,299 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controller
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+)
+
+const testNamespace = "hwmgr"
+
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+	Expect(hwmgrutils.InitNodeAllocationRequestUtils(scheme)).To(Succeed())
+	return scheme
+}
+
+func newTestClient(objs ...client.Object) client.Client {
+	return fake.NewClientBuilder().
+		WithScheme(newTestScheme()).
+		WithObjects(objs...).
+		WithStatusSubresource(&pluginsv1alpha1.NodeAllocationRequest{}, &pluginsv1alpha1.AllocatedNode{}).
+		WithIndex(&pluginsv1alpha1.AllocatedNode{}, hwmgrutils.AllocatedNodeSpecNodeAllocationRequestKey,
+			func(obj client.Object) []string {
+				return []string{obj.(*pluginsv1alpha1.AllocatedNode).Spec.NodeAllocationRequest}
+			}).
+		Build()
+}
+
+func newTestNodeAllocationRequest(size int) *pluginsv1alpha1.NodeAllocationRequest {
+	return &pluginsv1alpha1.NodeAllocationRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "simulator-nar",
+			Namespace:  testNamespace,
+			Generation: 1,
+			Labels:     map[string]string{hwmgrutils.HardwarePluginLabel: hwmgrutils.SimulatorHardwarePluginID},
+		},
+		Spec: pluginsv1alpha1.NodeAllocationRequestSpec{
+			ClusterId:         "cluster-1",
+			LocationSpec:      pluginsv1alpha1.LocationSpec{Site: "site-1"},
+			HardwarePluginRef: hwmgrutils.SimulatorHardwarePluginID,
+			NodeGroup: []pluginsv1alpha1.NodeGroup{{
+				NodeGroupData: hwmgmtv1alpha1.NodeGroupData{
+					Name:             "controller",
+					ResourcePoolId:   "pool-1",
+					HwProfile:        "profile-v1",
+					ResourceSelector: map[string]string{"server-type": "dell"},
+				},
+				Size: size,
+			}},
+		},
+	}
+}
+
+var _ = Describe("Simulator NodeAllocationRequestReconciler", func() {
+	var (
+		ctx        context.Context
+		c          client.Client
+		reconciler *NodeAllocationRequestReconciler
+		config     *SimulatorConfig
+		now        time.Time
+		random     float64
+		req        ctrl.Request
+	)
+
+	reconcile := func() ctrl.Result {
+		result, err := reconciler.Reconcile(ctx, req)
+		Expect(err).ToNot(HaveOccurred())
+		return result
+	}
+
+	getNodeAllocationRequest := func() *pluginsv1alpha1.NodeAllocationRequest {
+		nar := &pluginsv1alpha1.NodeAllocationRequest{}
+		Expect(c.Get(ctx, req.NamespacedName, nar)).To(Succeed())
+		return nar
+	}
+
+	getCondition := func(conditionType hwmgmtv1alpha1.ConditionType) *metav1.Condition {
+		return meta.FindStatusCondition(getNodeAllocationRequest().Status.Conditions, string(conditionType))
+	}
+
+	listNodes := func() []pluginsv1alpha1.AllocatedNode {
+		var nodelist pluginsv1alpha1.AllocatedNodeList
+		Expect(c.List(ctx, &nodelist)).To(Succeed())
+		return nodelist.Items
+	}
+
+	setup := func(size int) {
+		c = newTestClient(newTestNodeAllocationRequest(size))
+		reconciler = &NodeAllocationRequestReconciler{
+			NodeAllocationRequestReconcilerBase: hwmgrutils.NodeAllocationRequestReconcilerBase{
+				Client:          c,
+				NoncachedClient: c,
+				Logger:          slog.New(slog.DiscardHandler),
+				PluginNamespace: testNamespace,
+				Now:             func() time.Time { return now },
+			},
+			Config: &StaticConfigProvider{Config: config},
+			Random: func() float64 { return random },
+		}
+	}
+
+	allocate := func() {
+		reconcile()
+		now = now.Add(config.Allocation.Delay.Duration)
+		reconcile()
+		reconcile()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		now = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+		random = 0.5
+		req = ctrl.Request{NamespacedName: types.NamespacedName{Name: "simulator-nar", Namespace: testNamespace}}
+		config = &SimulatorConfig{
+			Nodes: []SimulatedNode{
+				{
+					ID: "node-1", ResourcePoolID: "pool-1", SiteID: "site-1",
+					Labels:     map[string]string{"server-type": "dell"},
+					Interfaces: []SimulatedInterface{{Name: "eno1", Label: "bootable-interface", MACAddress: "00:00:5e:00:53:01"}},
+				},
+				{ID: "node-2", ResourcePoolID: "pool-1", SiteID: "site-1", Labels: map[string]string{"server-type": "hpe"}},
+				{ID: "node-3", ResourcePoolID: "pool-2", SiteID: "site-1", Labels: map[string]string{"server-type": "dell"}},
+				{ID: "node-4", ResourcePoolID: "pool-1", SiteID: "site-1", Labels: map[string]string{"server-type": "dell"}},
+			},
+			Allocation:    OperationBehavior{Delay: metav1.Duration{Duration: time.Minute}},
+			Configuration: OperationBehavior{Delay: metav1.Duration{Duration: 2 * time.Minute}},
+			Deallocation:  OperationBehavior{Delay: metav1.Duration{Duration: 30 * time.Second}},
+		}
+	})
+
+	It("allocates matching nodes once the allocation delay has elapsed", func() {
+		setup(2)
+
+		result := reconcile()
+		Expect(result.RequeueAfter).To(Equal(time.Minute))
+		Expect(getCondition(hwmgmtv1alpha1.Provisioned).Reason).To(Equal(string(hwmgmtv1alpha1.InProgress)))
+
+		now = now.Add(30 * time.Second)
+		result = reconcile()
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		Expect(listNodes()).To(BeEmpty())
+
+		now = now.Add(30 * time.Second)
+		reconcile()
+		provisioned := getCondition(hwmgmtv1alpha1.Provisioned)
+		Expect(provisioned.Status).To(Equal(metav1.ConditionTrue))
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Completed)))
+
+		nodes := listNodes()
+		Expect(nodes).To(HaveLen(2))
+		Expect([]string{nodes[0].Spec.HwMgrNodeId, nodes[1].Spec.HwMgrNodeId}).To(ConsistOf("node-1", "node-4"))
+		Expect(getNodeAllocationRequest().Status.Properties.NodeNames).To(HaveLen(2))
+
+		for _, node := range nodes {
+			Expect(node.Labels).To(HaveKeyWithValue(hwmgrutils.HardwarePluginLabel, hwmgrutils.SimulatorHardwarePluginID))
+			Expect(node.Spec.HwProfile).To(Equal("profile-v1"))
+			Expect(node.Status.HwProfile).To(Equal("profile-v1"))
+			Expect(node.Status.BMC).ToNot(BeNil())
+			Expect(node.Status.Hostname).To(Equal(node.Spec.HwMgrNodeId))
+
+			secret := &corev1.Secret{}
+			Expect(c.Get(ctx, types.NamespacedName{Name: node.Status.BMC.CredentialsName, Namespace: testNamespace}, secret)).To(Succeed())
+			Expect(secret.Data).To(HaveKey("username"))
+			Expect(secret.OwnerReferences[0].Name).To(Equal(node.Name))
+		}
+	})
+
+	It("fails the allocation when there are not enough free matching nodes", func() {
+		setup(3)
+		allocate()
+
+		provisioned := getCondition(hwmgmtv1alpha1.Provisioned)
+		Expect(provisioned.Status).To(Equal(metav1.ConditionFalse))
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.InvalidInput)))
+		Expect(provisioned.Message).To(ContainSubstring("insufficient free simulated nodes"))
+		Expect(listNodes()).To(BeEmpty())
+	})
+
+	It("injects allocation failures at the configured rate", func() {
+		config.Allocation.FailureRate = 0.6
+		config.Allocation.FailureMessage = "BMC unreachable"
+		setup(1)
+		allocate()
+
+		provisioned := getCondition(hwmgmtv1alpha1.Provisioned)
+		Expect(provisioned.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+		Expect(provisioned.Message).To(Equal("BMC unreachable"))
+		Expect(listNodes()).To(BeEmpty())
+	})
+
+	It("applies a hardware profile change once the configuration delay has elapsed", func() {
+		setup(1)
+		allocate()
+
+		nar := getNodeAllocationRequest()
+		nar.Spec.NodeGroup[0].NodeGroupData.HwProfile = "profile-v2"
+		nar.Spec.ConfigTransactionId = 2
+		nar.Generation = 2
+		Expect(c.Update(ctx, nar)).To(Succeed())
+
+		result := reconcile()
+		Expect(result.RequeueAfter).To(Equal(2 * time.Minute))
+		Expect(getCondition(hwmgmtv1alpha1.Configured).Reason).To(Equal(string(hwmgmtv1alpha1.ConfigUpdate)))
+
+		now = now.Add(2 * time.Minute)
+		reconcile()
+		configured := getCondition(hwmgmtv1alpha1.Configured)
+		Expect(configured.Status).To(Equal(metav1.ConditionTrue))
+		Expect(configured.Reason).To(Equal(string(hwmgmtv1alpha1.ConfigApplied)))
+
+		nar = getNodeAllocationRequest()
+		Expect(nar.Status.ObservedConfigTransactionId).To(Equal(int64(2)))
+		Expect(nar.Status.HwMgrPlugin.ObservedGeneration).To(Equal(nar.Generation))
+		nodes := listNodes()
+		Expect(nodes[0].Spec.HwProfile).To(Equal("profile-v2"))
+		Expect(nodes[0].Status.HwProfile).To(Equal("profile-v2"))
+	})
+
+	It("fails the configuration of nodes configured to fail it", func() {
+		config.Nodes[0].FailOperations = []Operation{OperationConfiguration}
+		config.Configuration.Delay.Duration = 0
+		setup(1)
+		allocate()
+
+		nar := getNodeAllocationRequest()
+		nar.Spec.NodeGroup[0].NodeGroupData.HwProfile = "profile-v2"
+		nar.Spec.ConfigTransactionId = 2
+		nar.Generation = 2
+		Expect(c.Update(ctx, nar)).To(Succeed())
+
+		reconcile()
+		configured := getCondition(hwmgmtv1alpha1.Configured)
+		Expect(configured.Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+		Expect(configured.Message).To(ContainSubstring("node-1"))
+		Expect(listNodes()[0].Spec.HwProfile).To(Equal("profile-v1"))
+
+		// The failed transaction is not retried
+		reconcile()
+		Expect(getCondition(hwmgmtv1alpha1.Configured).Reason).To(Equal(string(hwmgmtv1alpha1.Failed)))
+	})
+
+	It("releases the nodes once the deallocation delay has elapsed", func() {
+		setup(1)
+		allocate()
+		Expect(listNodes()).To(HaveLen(1))
+
+		Expect(c.Delete(ctx, getNodeAllocationRequest())).To(Succeed())
+		result := reconcile()
+		Expect(result.RequeueAfter).To(Equal(30 * time.Second))
+		Expect(listNodes()).To(HaveLen(1))
+
+		now = now.Add(30 * time.Second)
+		reconcile()
+		Expect(listNodes()).To(BeEmpty())
+		Expect(c.Get(ctx, req.NamespacedName, &pluginsv1alpha1.NodeAllocationRequest{})).ToNot(Succeed())
+	})
+
+	It("retries a failed deallocation", func() {
+		config.Deallocation.FailureRate = 1
+		setup(1)
+		allocate()
+
+		Expect(c.Delete(ctx, getNodeAllocationRequest())).To(Succeed())
+		now = now.Add(30 * time.Second)
+		reconcile()
+		now = now.Add(30 * time.Second)
+		reconcile()
+		Expect(listNodes()).To(HaveLen(1))
+
+		config.Deallocation.FailureRate = 0
+		reconcile()
+		now = now.Add(30 * time.Second)
+		reconcile()
+		Expect(listNodes()).To(BeEmpty())
+	})
+})
@@ -0,0 +1,103 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	simulatorctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/simulator/controller"
+)
+
+// InventorySubscriptionsConfigMapName is the name of the ConfigMap holding the inventory subscriptions of the
+// simulator HardwarePlugin. The simulated inventory only changes with its configuration, so no change notifications
+// are sent to the subscribers.
+const InventorySubscriptionsConfigMapName = "simulator-hwplugin-inventory-subscriptions"
+
+// SimulatorPluginInventoryServer implements StrictServerInterface.
+// This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
+var _ inventory.StrictServerInterface = (*SimulatorPluginInventoryServer)(nil)
+
+type SimulatorPluginInventoryServer struct {
+	inventory.InventoryServer
+	Namespace string
+	Config    simulatorctrl.ConfigProvider
+}
+
+// NewSimulatorPluginInventoryServer creates a simulator HardwarePlugin inventory server
+func NewSimulatorPluginInventoryServer(
+	hubClient client.Client,
+	noncachedClient client.Reader,
+	config simulatorctrl.ConfigProvider,
+	logger *slog.Logger,
+) (*SimulatorPluginInventoryServer, error) {
+	namespace := provisioning.GetSimulatorHWPluginNamespace()
+	return &SimulatorPluginInventoryServer{
+		InventoryServer: inventory.NewInventoryServer(hubClient, noncachedClient, logger,
+			namespace, InventorySubscriptionsConfigMapName),
+		Namespace: namespace,
+		Config:    config,
+	}, nil
+}
+
+func (s *SimulatorPluginInventoryServer) getConfig(ctx context.Context) (*simulatorctrl.SimulatorConfig, error) {
+	config, err := s.Config.GetConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get simulator configuration: %w", err)
+	}
+	return config, nil
+}
+
+func (s *SimulatorPluginInventoryServer) GetResourcePools(ctx context.Context, request inventory.GetResourcePoolsRequestObject) (inventory.GetResourcePoolsResponseObject, error) {
+	config, err := s.getConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return simulatorctrl.GetResourcePools(config)
+}
+
+func (s *SimulatorPluginInventoryServer) GetResources(ctx context.Context, request inventory.GetResourcesRequestObject) (inventory.GetResourcesResponseObject, error) {
+	config, err := s.getConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return simulatorctrl.GetResources(ctx, s.HubClient, s.Namespace, config)
+}
+
+func (s *SimulatorPluginInventoryServer) GetResourcePool(ctx context.Context, request inventory.GetResourcePoolRequestObject) (inventory.GetResourcePoolResponseObject, error) {
+	config, err := s.getConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return simulatorctrl.GetResourcePool(config, request.ResourcePoolId)
+}
+
+func (s *SimulatorPluginInventoryServer) GetResourcePoolResources(ctx context.Context, request inventory.GetResourcePoolResourcesRequestObject) (inventory.GetResourcePoolResourcesResponseObject, error) {
+	config, err := s.getConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return simulatorctrl.GetResourcePoolResources(ctx, s.HubClient, s.Namespace, config, request.ResourcePoolId)
+}
+
+func (s *SimulatorPluginInventoryServer) GetResource(ctx context.Context, request inventory.GetResourceRequestObject) (inventory.GetResourceResponseObject, error) {
+	config, err := s.getConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// nolint: wrapcheck
+	return simulatorctrl.GetResource(ctx, s.HubClient, s.Namespace, config, request.ResourceId)
+}
@@ -0,0 +1,72 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	pluginsv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/plugins/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	simulatorctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/simulator/controller"
+)
+
+type failingConfigProvider struct{}
+
+func (failingConfigProvider) GetConfig(_ context.Context) (*simulatorctrl.SimulatorConfig, error) {
+	return nil, errors.New("configmap not found")
+}
+
+var _ = Describe("SimulatorPluginInventoryServer", func() {
+	var (
+		ctx    context.Context
+		server *SimulatorPluginInventoryServer
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		scheme := runtime.NewScheme()
+		Expect(pluginsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		c := fake.NewClientBuilder().WithScheme(scheme).Build()
+
+		var err error
+		server, err = NewSimulatorPluginInventoryServer(c, c, &simulatorctrl.StaticConfigProvider{
+			Config: &simulatorctrl.SimulatorConfig{
+				Nodes: []simulatorctrl.SimulatedNode{
+					{ID: "node-1", ResourcePoolID: "pool-1", SiteID: "site-1"},
+				},
+			},
+		}, slog.New(slog.DiscardHandler))
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("serves the simulated inventory", func() {
+		pools, err := server.GetResourcePools(ctx, inventory.GetResourcePoolsRequestObject{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pools.(inventory.GetResourcePools200JSONResponse)).To(HaveLen(1))
+
+		resources, err := server.GetResourcePoolResources(ctx, inventory.GetResourcePoolResourcesRequestObject{ResourcePoolId: "pool-1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resources.(inventory.GetResourcePoolResources200JSONResponse)).To(HaveLen(1))
+
+		resource, err := server.GetResource(ctx, inventory.GetResourceRequestObject{ResourceId: "node-1"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.(inventory.GetResource200JSONResponse).UsageState).To(Equal(inventory.IDLE))
+	})
+
+	It("fails when the configuration is unavailable", func() {
+		server.Config = failingConfigProvider{}
+		_, err := server.GetResources(ctx, inventory.GetResourcesRequestObject{})
+		Expect(err).To(MatchError(ContainSubstring("failed to get simulator configuration")))
+	})
+})
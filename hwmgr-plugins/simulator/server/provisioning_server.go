@@ -0,0 +1,40 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	hwmgrutils "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/controller/utils"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+)
+
+const SimulatorResourcePrefix = "simulator"
+
+// SimulatorPluginServer implements StrictServerInterface.
+// This ensures that we've conformed to the `StrictServerInterface` with a compile-time check.
+var _ provisioning.StrictServerInterface = (*SimulatorPluginServer)(nil)
+
+type SimulatorPluginServer struct {
+	provisioning.HardwarePluginServer
+}
+
+// NewSimulatorPluginServer creates a simulator HardwarePlugin server
+func NewSimulatorPluginServer(
+	config svcutils.CommonServerConfig,
+	hubClient client.Client,
+	noncachedClient client.Reader,
+	logger *slog.Logger,
+) (*SimulatorPluginServer, error) {
+	return &SimulatorPluginServer{
+		HardwarePluginServer: provisioning.NewHardwarePluginServer(config, hubClient, noncachedClient, logger,
+			provisioning.GetSimulatorHWPluginNamespace(), hwmgrutils.SimulatorHardwarePluginID, SimulatorResourcePrefix),
+	}, nil
+}
@@ -0,0 +1,33 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"context"
+	"log/slog"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/inventory"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/server/provisioning"
+	simulatorctrl "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/simulator/controller"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+)
+
+// Serve starts the simulator HardwarePlugin API server and blocks until it terminates or context is canceled.
+func Serve(ctx context.Context, logger *slog.Logger, config svcutils.CommonServerConfig, hubClient client.Client,
+	noncachedClient client.Reader, simulatorConfig simulatorctrl.ConfigProvider) error {
+	// nolint: wrapcheck
+	return api.BuildAndServe(ctx, logger, config, "Simulator HardwarePlugin",
+		func(serverLogger *slog.Logger) (provisioning.StrictServerInterface, error) {
+			return NewSimulatorPluginServer(config, hubClient, noncachedClient, serverLogger)
+		},
+		func(serverLogger *slog.Logger) (inventory.StrictServerInterface, error) {
+			return NewSimulatorPluginInventoryServer(hubClient, noncachedClient, simulatorConfig, serverLogger)
+		})
+}
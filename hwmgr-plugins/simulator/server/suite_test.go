@@ -0,0 +1,19 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package server
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestSimulatorServer(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Simulator Server Suite")
+}
@@ -288,6 +288,21 @@ func (c *ControllerManagerCommand) run(cmd *cobra.Command, argv []string) error
 		return exit.Error(1)
 	}
 
+	// Start the Fleet Upgrade controller.
+	if err = (&controllers.FleetUpgradeReconciler{
+		Client:       mgr.GetClient(),
+		Logger:       logger.With("controller", "FleetUpgrade"),
+		AlarmCounter: controllers.NewAlarmServerCriticalAlarmCounter(),
+	}).SetupWithManager(mgr); err != nil {
+		logger.ErrorContext(
+			ctx,
+			"Unable to create controller",
+			slog.String("controller", "FleetUpgrade"),
+			slog.String("error", err.Error()),
+		)
+		return exit.Error(1)
+	}
+
 	narCallbackServer := narcallback.NewNodeAllocationRequestCallbackServer(
 		mgr.GetClient(),
 		logger.With("Callback", "NodeAllocationRequest"),
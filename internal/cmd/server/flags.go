@@ -128,6 +128,7 @@ const (
 	APIListenerAddressFlagName        = "api-listener-address"
 	APIListenerTLSCertFlagName        = "api-listener-tls-crt"
 	APIListenerTLSKeyFlagName         = "api-listener-tls-key"
+	ArchiveRetentionPeriodFlagName    = "archive-retention-period"
 	backendTokenFileFlagName          = "backend-token-file"
 	backendTokenFlagName              = "backend-token"
 	backendTypeFlagName               = "backend-type"
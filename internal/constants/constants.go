@@ -51,6 +51,9 @@ const (
 
 	// Monitoring/Alarms API paths
 	AlarmsPath = "/alarms"
+
+	// Provisioning API paths
+	ProvisioningRequestsPath = "/provisioningRequests"
 )
 
 // Command line argument constants
@@ -77,8 +80,10 @@ const (
 
 // Hardware plugin command names
 const (
-	HardwarePluginManagerCmd       = "hardwareplugin-manager"
-	Metal3HardwarePluginManagerCmd = "metal3-hardwareplugin-manager"
+	HardwarePluginManagerCmd          = "hardwareplugin-manager"
+	Metal3HardwarePluginManagerCmd    = "metal3-hardwareplugin-manager"
+	SimulatorHardwarePluginManagerCmd = "simulator-hardwareplugin-manager"
+	RedfishHardwarePluginManagerCmd   = "redfish-hardwareplugin-manager"
 )
 
 // TLS/Certificate field names
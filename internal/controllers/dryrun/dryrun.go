@@ -0,0 +1,167 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package dryrun holds the outcome of the dry-run of a ProvisioningRequest and the helpers comparing the rendered
+// resources with the applied ones. It is shared by the ProvisioningRequest controller, which renders the resources,
+// and the provisioning server, which reports the outcome, so that the server does not depend on the controllers.
+package dryrun
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/diff"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	hwmgrpluginapi "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/provisioning"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// Plan holds the resources rendered from a ProvisioningRequest by a dry-run, and how they differ from the
+// applied ones.
+type Plan struct {
+	ClusterInstance *unstructured.Unstructured
+	// NodeAllocationRequest is nil when the ClusterTemplate skips hardware provisioning
+	NodeAllocationRequest *hwmgrpluginapi.NodeAllocationRequest
+	PolicyConfigMaps      []*corev1.ConfigMap
+	Resources             []provisioningv1alpha1.DryRunResource
+}
+
+// Func validates a ProvisioningRequest and renders its resources into a Plan, without creating or updating
+// anything. Errors caused by the content of the ProvisioningRequest or of its templates are input errors.
+type Func func(ctx context.Context, c client.Client, logger *slog.Logger,
+	object *provisioningv1alpha1.ProvisioningRequest) (*Plan, error)
+
+// NodeAllocationRequestGetter gets an applied NodeAllocationRequest from its hardware plugin
+type NodeAllocationRequestGetter interface {
+	GetNodeAllocationRequest(ctx context.Context,
+		nodeAllocationRequestID string) (*hwmgrpluginapi.NodeAllocationRequestResponse, bool, error)
+}
+
+// DiffClusterInstance compares the rendered ClusterInstance with the applied one. Only the fields set by the
+// rendered ClusterInstance are compared, as the applied one also holds the defaults set by the API server.
+func DiffClusterInstance(ctx context.Context, c client.Client,
+	rendered *unstructured.Unstructured) (provisioningv1alpha1.DryRunResource, error) {
+
+	resource := provisioningv1alpha1.DryRunResource{
+		Kind:      rendered.GetKind(),
+		Name:      rendered.GetName(),
+		Namespace: rendered.GetNamespace(),
+	}
+
+	existing := &unstructured.Unstructured{}
+	existing.SetGroupVersionKind(rendered.GroupVersionKind())
+	exists, err := ctlrutils.DoesK8SResourceExist(ctx, c, rendered.GetName(), rendered.GetNamespace(), existing)
+	if err != nil {
+		return resource, fmt.Errorf("failed to get ClusterInstance (%s): %w", rendered.GetName(), err)
+	}
+	if !exists {
+		resource.Action = provisioningv1alpha1.DryRunActionCreate
+		return resource, nil
+	}
+
+	setDiff(&resource, pruneToFields(existing.Object["spec"], rendered.Object["spec"]), rendered.Object["spec"])
+	return resource, nil
+}
+
+// DiffNodeAllocationRequest compares the node groups of the rendered NodeAllocationRequest with the applied
+// ones, as they are the only part of an applied NodeAllocationRequest that is updated. An empty identifier
+// means the NodeAllocationRequest is not applied yet.
+func DiffNodeAllocationRequest(ctx context.Context, getter NodeAllocationRequestGetter, nodeAllocationRequestID string,
+	rendered *hwmgrpluginapi.NodeAllocationRequest) (provisioningv1alpha1.DryRunResource, error) {
+
+	resource := provisioningv1alpha1.DryRunResource{
+		Kind: "NodeAllocationRequest",
+		Name: nodeAllocationRequestID,
+	}
+	if resource.Name == "" {
+		resource.Action = provisioningv1alpha1.DryRunActionCreate
+		return resource, nil
+	}
+
+	existing, exists, err := getter.GetNodeAllocationRequest(ctx, resource.Name)
+	if err != nil {
+		return resource, fmt.Errorf("failed to get NodeAllocationRequest '%s': %w", resource.Name, err)
+	}
+	if !exists || existing.NodeAllocationRequest == nil {
+		resource.Action = provisioningv1alpha1.DryRunActionCreate
+		return resource, nil
+	}
+
+	setDiff(&resource, existing.NodeAllocationRequest.NodeGroup, rendered.NodeGroup)
+	return resource, nil
+}
+
+// DiffConfigMap compares the data of a rendered ConfigMap with the applied one.
+func DiffConfigMap(ctx context.Context, c client.Client,
+	rendered *corev1.ConfigMap) (provisioningv1alpha1.DryRunResource, error) {
+
+	resource := provisioningv1alpha1.DryRunResource{
+		Kind:      "ConfigMap",
+		Name:      rendered.Name,
+		Namespace: rendered.Namespace,
+	}
+
+	existing := &corev1.ConfigMap{}
+	exists, err := ctlrutils.DoesK8SResourceExist(ctx, c, rendered.Name, rendered.Namespace, existing)
+	if err != nil {
+		return resource, fmt.Errorf("failed to get ConfigMap %s: %w", rendered.Name, err)
+	}
+	if !exists {
+		resource.Action = provisioningv1alpha1.DryRunActionCreate
+		return resource, nil
+	}
+
+	setDiff(&resource, existing.Data, rendered.Data)
+	return resource, nil
+}
+
+// setDiff sets the action of a resource that exists, and the diff when its applied content differs from
+// the rendered one.
+func setDiff(resource *provisioningv1alpha1.DryRunResource, applied, rendered any) {
+	if equality.Semantic.DeepEqual(applied, rendered) {
+		resource.Action = provisioningv1alpha1.DryRunActionUnchanged
+		return
+	}
+	resource.Action = provisioningv1alpha1.DryRunActionUpdate
+	resource.Diff = diff.Diff(applied, rendered)
+}
+
+// pruneToFields returns the parts of an applied object that are set in the desired one. Lists of the same
+// length are pruned element by element; any other value is returned as is.
+func pruneToFields(applied, desired any) any {
+	switch desiredValue := desired.(type) {
+	case map[string]any:
+		appliedMap, ok := applied.(map[string]any)
+		if !ok {
+			return applied
+		}
+		pruned := make(map[string]any, len(desiredValue))
+		for key, value := range desiredValue {
+			if appliedValue, found := appliedMap[key]; found {
+				pruned[key] = pruneToFields(appliedValue, value)
+			}
+		}
+		return pruned
+	case []any:
+		appliedList, ok := applied.([]any)
+		if !ok || len(appliedList) != len(desiredValue) {
+			return applied
+		}
+		pruned := make([]any, len(appliedList))
+		for i := range appliedList {
+			pruned[i] = pruneToFields(appliedList[i], desiredValue[i])
+		}
+		return pruned
+	default:
+		return applied
+	}
+}
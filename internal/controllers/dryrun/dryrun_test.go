@@ -0,0 +1,95 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Test Cases for the Dry-Run Diff Helpers
+
+1. pruneToFields - Prunes the applied object to the fields set in the desired one
+2. setDiff - Sets the action and the diff of an existing resource
+3. DiffConfigMap - Reports the ConfigMaps to create and the applied ones that would be updated
+*/
+
+package dryrun
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+)
+
+func TestDryRun(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dry-Run Suite")
+}
+
+var _ = Describe("Dry-run diff helpers", func() {
+	It("Prunes the applied object to the fields set in the desired one", func() {
+		applied := map[string]any{
+			"name":     "cluster-1",
+			"defaults": "set-by-the-server",
+			"nodes": []any{
+				map[string]any{"hostName": "node1", "role": "master", "status": "ok"},
+			},
+		}
+		desired := map[string]any{
+			"name": "cluster-1",
+			"nodes": []any{
+				map[string]any{"hostName": "node1", "role": "worker"},
+			},
+		}
+		Expect(pruneToFields(applied, desired)).To(Equal(map[string]any{
+			"name": "cluster-1",
+			"nodes": []any{
+				map[string]any{"hostName": "node1", "role": "master"},
+			},
+		}))
+	})
+
+	It("Keeps lists of a different length as they are", func() {
+		applied := []any{"a", "b"}
+		Expect(pruneToFields(applied, []any{"a"})).To(Equal(applied))
+	})
+
+	It("Sets the action and the diff of an existing resource", func() {
+		resource := provisioningv1alpha1.DryRunResource{Kind: "ConfigMap"}
+		setDiff(&resource, map[string]string{"a": "1"}, map[string]string{"a": "1"})
+		Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionUnchanged))
+		Expect(resource.Diff).To(BeEmpty())
+
+		setDiff(&resource, map[string]string{"a": "1"}, map[string]string{"a": "2"})
+		Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionUpdate))
+		Expect(resource.Diff).ToNot(BeEmpty())
+	})
+
+	It("Compares a rendered ConfigMap with the applied one", func() {
+		ctx := context.Background()
+		rendered := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "policy-data", Namespace: "ztp-cluster-1"},
+			Data:       map[string]string{"key": "new"},
+		}
+		c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).Build()
+
+		resource, err := DiffConfigMap(ctx, c, rendered)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionCreate))
+
+		applied := rendered.DeepCopy()
+		applied.Data = map[string]string{"key": "old"}
+		Expect(c.Create(ctx, applied)).To(Succeed())
+		resource, err = DiffConfigMap(ctx, c, rendered)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionUpdate))
+		Expect(resource.Diff).To(ContainSubstring("new"))
+	})
+})
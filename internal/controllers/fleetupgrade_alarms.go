@@ -0,0 +1,131 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"k8s.io/client-go/transport"
+
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients"
+)
+
+// criticalSeverity is the value of the perceivedSeverity attribute of the critical alarms
+const criticalSeverity = 0
+
+// CriticalAlarmCounter counts the active critical alarms raised for a node cluster.
+type CriticalAlarmCounter interface {
+	// CountCriticalAlarms returns the number of active critical alarms of the node cluster, including those of
+	// the hardware of its nodes. It may stop counting once the limit is exceeded.
+	CountCriticalAlarms(ctx context.Context, nodeClusterID string, limit int) (int, error)
+}
+
+// alarmServerCriticalAlarmCounter counts the critical alarms through the alarm server API.
+type alarmServerCriticalAlarmCounter struct {
+	url          string
+	tokenPath    string
+	newTransport func() (http.RoundTripper, error)
+}
+
+// NewAlarmServerCriticalAlarmCounter creates a CriticalAlarmCounter that queries the alarm server.
+func NewAlarmServerCriticalAlarmCounter() CriticalAlarmCounter {
+	return &alarmServerCriticalAlarmCounter{
+		url:          ctlrutils.GetServiceURL(ctlrutils.InventoryAlarmServerName),
+		tokenPath:    constants.DefaultBackendTokenFile,
+		newTransport: ctlrutils.GetDefaultBackendTransport,
+	}
+}
+
+// CountCriticalAlarms pages through the critical alarms of the node cluster. The alarm server selects those raised
+// against the node cluster and those raised against the hardware resources of its nodes. Cleared alarms have their
+// severity changed to cleared, so the query returns only the active ones.
+func (c *alarmServerCriticalAlarmCounter) CountCriticalAlarms(ctx context.Context, nodeClusterID string, limit int) (int, error) {
+	base, err := url.Parse(c.url)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse alarm server URL '%s': %w", c.url, err)
+	}
+
+	tr, err := c.newTransport()
+	if err != nil {
+		return 0, fmt.Errorf("failed to create http transport: %w", err)
+	}
+	hc := &http.Client{Transport: tr, Timeout: clients.ListRequestTimeout}
+	editor := clients.AuthorizationEditor{
+		Source: transport.NewCachedFileTokenSource(c.tokenPath),
+	}
+
+	query := url.Values{}
+	query.Set("node_cluster_id", nodeClusterID)
+	query.Set("filter", fmt.Sprintf("(eq,perceivedSeverity,%d)", criticalSeverity))
+	next := base.ResolveReference(&url.URL{
+		Path:     constants.O2IMSMonitoringBaseURL + constants.AlarmsPath,
+		RawQuery: query.Encode(),
+	})
+
+	count := 0
+	for next != nil && count <= limit {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, next.String(), nil)
+		if err != nil {
+			return 0, fmt.Errorf("failed to create alarms request: %w", err)
+		}
+		if err := editor.Editor(ctx, req); err != nil {
+			return 0, fmt.Errorf("failed to set authorization header on alarms request: %w", err)
+		}
+
+		resp, err := hc.Do(req)
+		if err != nil {
+			return 0, fmt.Errorf("failed to get alarms: %w", err)
+		}
+
+		var alarms []struct {
+			AlarmEventRecordID string `json:"alarmEventRecordId"`
+		}
+		err = func() error {
+			defer resp.Body.Close() // nolint: errcheck
+			if resp.StatusCode != http.StatusOK {
+				return fmt.Errorf("unexpected status code %d getting alarms", resp.StatusCode)
+			}
+			if err := json.NewDecoder(resp.Body).Decode(&alarms); err != nil {
+				return fmt.Errorf("failed to decode alarms: %w", err)
+			}
+			return nil
+		}()
+		if err != nil {
+			return 0, err
+		}
+		count += len(alarms)
+
+		next, err = nextPageURL(base, resp.Header.Get("Link"))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	return count, nil
+}
+
+// nextPageURL extracts the URL of the next page from a Link header, resolved against the server URL.
+func nextPageURL(base *url.URL, link string) (*url.URL, error) {
+	start := strings.Index(link, "<")
+	end := strings.Index(link, ">")
+	if start < 0 || end <= start {
+		return nil, nil // nolint: nilnil
+	}
+
+	ref, err := url.Parse(link[start+1 : end])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse next page link '%s': %w", link, err)
+	}
+	return base.ResolveReference(ref), nil
+}
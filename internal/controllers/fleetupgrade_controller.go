@@ -0,0 +1,912 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// FleetUpgradeReconciler reconciles a FleetUpgrade object
+type FleetUpgradeReconciler struct {
+	client.Client
+	Logger       *slog.Logger
+	AlarmCounter CriticalAlarmCounter
+}
+
+type fleetUpgradeReconcilerTask struct {
+	logger       *slog.Logger
+	client       client.Client
+	object       *provisioningv1alpha1.FleetUpgrade
+	alarmCounter CriticalAlarmCounter
+}
+
+//+kubebuilder:rbac:groups=clcm.openshift.io,resources=fleetupgrades,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=clcm.openshift.io,resources=fleetupgrades/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=clcm.openshift.io,resources=fleetupgrades/finalizers,verbs=update
+//+kubebuilder:rbac:groups=clcm.openshift.io,resources=provisioningrequests,verbs=get;list;watch;update;patch
+//+kubebuilder:rbac:urls="/o2ims-infrastructureMonitoring/v1/alarms",verbs=get;list
+
+// Reconcile moves the ProvisioningRequests selected by a FleetUpgrade to the target ClusterTemplate
+// version, one batch at a time.
+func (r *FleetUpgradeReconciler) Reconcile(ctx context.Context, req ctrl.Request) (
+	result ctrl.Result, err error) {
+	_ = log.FromContext(ctx)
+	startTime := time.Now()
+	result = doNotRequeue()
+
+	// Add standard reconciliation context
+	ctx = ctlrutils.LogReconcileStart(ctx, r.Logger, req, "FleetUpgrade")
+
+	defer func() {
+		duration := time.Since(startTime)
+		if err != nil {
+			r.Logger.ErrorContext(ctx, "Reconciliation failed",
+				slog.Duration("duration", duration),
+				slog.String("error", err.Error()))
+		} else {
+			r.Logger.InfoContext(ctx, "Reconciliation completed",
+				slog.Duration("duration", duration),
+				slog.Bool("requeue", result.Requeue),
+				slog.Duration("requeueAfter", result.RequeueAfter))
+		}
+	}()
+
+	// Fetch the object:
+	object := &provisioningv1alpha1.FleetUpgrade{}
+	if err = r.Client.Get(ctx, req.NamespacedName, object); err != nil {
+		if errors.IsNotFound(err) {
+			r.Logger.InfoContext(ctx, "FleetUpgrade not found, assuming deleted")
+			err = nil
+			return
+		}
+		ctlrutils.LogError(ctx, r.Logger, "Unable to fetch FleetUpgrade", err)
+		return
+	}
+
+	// Add object-specific context
+	ctx = ctlrutils.AddObjectContext(ctx, object)
+
+	// Create and run the task:
+	task := &fleetUpgradeReconcilerTask{
+		logger:       r.Logger,
+		client:       r.Client,
+		object:       object,
+		alarmCounter: r.AlarmCounter,
+	}
+	result, err = task.run(ctx)
+	return
+}
+
+func (t *fleetUpgradeReconcilerTask) run(ctx context.Context) (ctrl.Result, error) {
+	if t.object.Status.Phase == provisioningv1alpha1.FleetUpgradeCompleted ||
+		t.object.Status.Phase == provisioningv1alpha1.FleetUpgradeFailed {
+		return doNotRequeue(), nil
+	}
+
+	// The batches are planned once, when the upgrade starts
+	if t.object.Status.Batches == nil {
+		valid, err := t.validateFleetUpgrade(ctx)
+		if err != nil {
+			return requeueWithError(err)
+		}
+		if !valid {
+			t.object.Status.Phase = provisioningv1alpha1.FleetUpgradePending
+			if err := t.updateStatus(ctx); err != nil {
+				return requeueWithError(err)
+			}
+			return requeueWithLongInterval(), nil
+		}
+
+		if err := t.planBatches(ctx); err != nil {
+			return requeueWithError(err)
+		}
+	}
+
+	result, err := t.progress(ctx)
+
+	// The status is updated even on errors, so the changes already made to the ProvisioningRequests
+	// are not lost
+	t.updatePhase()
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
+		return requeueWithError(updateErr)
+	}
+	if err != nil {
+		return requeueWithError(err)
+	}
+	return result, nil
+}
+
+// validateFleetUpgrade checks that the target version of the ClusterTemplate exists and is valid, and
+// updates the Validated status condition.
+func (t *fleetUpgradeReconcilerTask) validateFleetUpgrade(ctx context.Context) (bool, error) {
+	targetName := GetClusterTemplateRefName(t.object.Spec.TemplateName, t.object.Spec.TargetVersion)
+
+	clusterTemplates := &provisioningv1alpha1.ClusterTemplateList{}
+	if err := t.client.List(ctx, clusterTemplates); err != nil {
+		return false, fmt.Errorf("failed to list ClusterTemplates: %w", err)
+	}
+
+	valid := slices.ContainsFunc(clusterTemplates.Items, func(ct provisioningv1alpha1.ClusterTemplate) bool {
+		return ct.Name == targetName && meta.IsStatusConditionTrue(ct.Status.Conditions,
+			string(provisioningv1alpha1.CTconditionTypes.Validated))
+	})
+	if !valid {
+		t.logger.InfoContext(ctx, "Target ClusterTemplate is missing or not valid",
+			slog.String("clusterTemplate", targetName))
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Validated,
+			provisioningv1alpha1.FUconditionReasons.Failed,
+			metav1.ConditionFalse,
+			fmt.Sprintf("A valid ClusterTemplate (%s) does not exist in any namespace", targetName),
+		)
+		return false, nil
+	}
+
+	ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.FUconditionTypes.Validated,
+		provisioningv1alpha1.FUconditionReasons.Completed,
+		metav1.ConditionTrue,
+		"The FleetUpgrade is valid",
+	)
+	return true, nil
+}
+
+// planBatches selects the ProvisioningRequests to upgrade and splits them into batches, sorted by name.
+func (t *fleetUpgradeReconcilerTask) planBatches(ctx context.Context) error {
+	selector := labels.Everything()
+	if t.object.Spec.Selector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(t.object.Spec.Selector)
+		if err != nil {
+			return fmt.Errorf("failed to parse the ProvisioningRequest selector: %w", err)
+		}
+	}
+
+	prs := &provisioningv1alpha1.ProvisioningRequestList{}
+	if err := t.client.List(ctx, prs, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return fmt.Errorf("failed to list ProvisioningRequests: %w", err)
+	}
+
+	var names []string
+	for _, pr := range prs.Items {
+		if pr.Spec.TemplateName != t.object.Spec.TemplateName ||
+			pr.Spec.TemplateVersion == t.object.Spec.TargetVersion ||
+			(t.object.Spec.SourceVersion != "" && pr.Spec.TemplateVersion != t.object.Spec.SourceVersion) ||
+			!pr.DeletionTimestamp.IsZero() {
+			continue
+		}
+		names = append(names, pr.Name)
+	}
+	slices.Sort(names)
+
+	batches := []provisioningv1alpha1.FleetUpgradeBatch{}
+	for len(names) > 0 {
+		size := t.object.Spec.BatchSize
+		canary := len(batches) == 0 && t.object.Spec.CanaryBatchSize > 0
+		if canary {
+			size = t.object.Spec.CanaryBatchSize
+		}
+		size = max(1, min(size, len(names)))
+
+		batch := provisioningv1alpha1.FleetUpgradeBatch{
+			Canary: canary,
+			State:  provisioningv1alpha1.BatchPending,
+		}
+		for _, name := range names[:size] {
+			batch.ProvisioningRequests = append(batch.ProvisioningRequests, provisioningv1alpha1.FleetUpgradeTarget{
+				Name:  name,
+				State: provisioningv1alpha1.TargetPending,
+			})
+		}
+		batches = append(batches, batch)
+		names = names[size:]
+	}
+
+	t.logger.InfoContext(ctx, "Planned the FleetUpgrade batches", slog.Int("batches", len(batches)))
+	t.object.Status.Batches = batches
+	return nil
+}
+
+// progress moves the current batch forward, and follows the rollbacks of the previous batches.
+func (t *fleetUpgradeReconcilerTask) progress(ctx context.Context) (ctrl.Result, error) {
+	result := doNotRequeue()
+
+	// Rollbacks go on whatever batch is processed, and even if the upgrade is paused
+	rollingBack, err := t.monitorRollbacks(ctx)
+	if err != nil {
+		return result, err
+	}
+	if rollingBack {
+		result = requeueWithMediumInterval()
+	}
+
+	t.resumeAfterFailedBatch(ctx)
+	t.updatePausedCondition()
+
+	batch := t.currentBatch()
+	if batch == nil {
+		return result, nil
+	}
+
+	var batchResult ctrl.Result
+	switch batch.State {
+	case provisioningv1alpha1.BatchPending:
+		if t.isPaused() {
+			return result, nil
+		}
+		now := metav1.Now()
+		batch.StartedAt = &now
+		batch.State = provisioningv1alpha1.BatchUpgrading
+		t.logger.InfoContext(ctx, "Starting FleetUpgrade batch", slog.Bool("canary", batch.Canary))
+		batchResult, err = t.monitorBatch(ctx, batch)
+	case provisioningv1alpha1.BatchUpgrading:
+		batchResult, err = t.monitorBatch(ctx, batch)
+	case provisioningv1alpha1.BatchSoaking:
+		batchResult, err = t.soakBatch(ctx, batch)
+	}
+	if err != nil {
+		return result, err
+	}
+
+	if batchResult.Requeue ||
+		(batchResult.RequeueAfter > 0 && (result.RequeueAfter == 0 || batchResult.RequeueAfter < result.RequeueAfter)) {
+		result = batchResult
+	}
+	return result, nil
+}
+
+// currentBatch returns the first batch that is not completed or failed.
+func (t *fleetUpgradeReconcilerTask) currentBatch() *provisioningv1alpha1.FleetUpgradeBatch {
+	for i := range t.object.Status.Batches {
+		batch := &t.object.Status.Batches[i]
+		if batch.State != provisioningv1alpha1.BatchCompleted && batch.State != provisioningv1alpha1.BatchFailed {
+			return batch
+		}
+	}
+	return nil
+}
+
+// monitorBatch starts the upgrade of the ProvisioningRequests of the batch and follows them until they
+// are all upgraded, then starts the soak time.
+func (t *fleetUpgradeReconcilerTask) monitorBatch(
+	ctx context.Context, batch *provisioningv1alpha1.FleetUpgradeBatch) (ctrl.Result, error) {
+	inProgress := false
+	var failed []string
+	upgraded := false
+
+	for i := range batch.ProvisioningRequests {
+		target := &batch.ProvisioningRequests[i]
+
+		switch target.State {
+		case provisioningv1alpha1.TargetPending:
+			if err := t.startUpgrade(ctx, target); err != nil {
+				return ctrl.Result{}, err
+			}
+		case provisioningv1alpha1.TargetUpgrading:
+			if err := t.checkUpgrade(ctx, batch, target); err != nil {
+				return ctrl.Result{}, err
+			}
+		}
+
+		switch target.State {
+		case provisioningv1alpha1.TargetPending, provisioningv1alpha1.TargetUpgrading:
+			inProgress = true
+		case provisioningv1alpha1.TargetFailed:
+			failed = append(failed, target.Name)
+		case provisioningv1alpha1.TargetUpgraded:
+			upgraded = true
+		}
+	}
+
+	// Wait for all the upgrades of the batch to end
+	if inProgress {
+		return requeueWithMediumInterval(), nil
+	}
+
+	if len(failed) > 0 {
+		return doNotRequeue(), t.failBatch(ctx, batch, provisioningv1alpha1.FUconditionReasons.UpgradeFailed, failed)
+	}
+
+	now := metav1.Now()
+	if !upgraded {
+		// All the ProvisioningRequests of the batch were skipped
+		batch.State = provisioningv1alpha1.BatchCompleted
+		batch.CompletedAt = &now
+		return requeueImmediately(), nil
+	}
+
+	t.logger.InfoContext(ctx, "FleetUpgrade batch upgraded, starting the soak time",
+		slog.Duration("soakTime", t.object.Spec.SoakTime.Duration))
+	batch.State = provisioningv1alpha1.BatchSoaking
+	batch.SoakStartedAt = &now
+	if t.object.Spec.SoakTime.Duration <= 0 {
+		return requeueImmediately(), nil
+	}
+	return requeueWithCustomInterval(t.object.Spec.SoakTime.Duration), nil
+}
+
+// startUpgrade moves a ProvisioningRequest to the target version of the ClusterTemplate.
+func (t *fleetUpgradeReconcilerTask) startUpgrade(ctx context.Context, target *provisioningv1alpha1.FleetUpgradeTarget) error {
+	pr := &provisioningv1alpha1.ProvisioningRequest{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: target.Name}, pr); err != nil {
+		if errors.IsNotFound(err) {
+			target.State = provisioningv1alpha1.TargetSkipped
+			target.Message = "The ProvisioningRequest no longer exists"
+			return nil
+		}
+		return fmt.Errorf("failed to get ProvisioningRequest %s: %w", target.Name, err)
+	}
+
+	switch {
+	case !pr.DeletionTimestamp.IsZero():
+		target.State = provisioningv1alpha1.TargetSkipped
+		target.Message = "The ProvisioningRequest is being deleted"
+		return nil
+	case pr.Spec.TemplateVersion == t.object.Spec.TargetVersion:
+		// Moved to the target version by someone else, only follow its upgrade
+		target.State = provisioningv1alpha1.TargetUpgrading
+		target.Generation = pr.Generation
+		target.Message = "The ProvisioningRequest already uses the target version"
+		return nil
+	case pr.Status.ProvisioningStatus.ProvisioningPhase != provisioningv1alpha1.StateFulfilled:
+		target.State = provisioningv1alpha1.TargetSkipped
+		target.Message = fmt.Sprintf("The ProvisioningRequest is not fulfilled (%s)",
+			pr.Status.ProvisioningStatus.ProvisioningPhase)
+		return nil
+	}
+
+	sourceVersion := pr.Spec.TemplateVersion
+	patch := client.MergeFrom(pr.DeepCopy())
+	pr.Spec.TemplateVersion = t.object.Spec.TargetVersion
+	if err := t.client.Patch(ctx, pr, patch); err != nil {
+		return fmt.Errorf("failed to update the template version of ProvisioningRequest %s: %w", pr.Name, err)
+	}
+
+	t.logger.InfoContext(ctx, "Started the upgrade of ProvisioningRequest",
+		slog.String("provisioningRequest", pr.Name),
+		slog.String("sourceVersion", sourceVersion),
+		slog.String("targetVersion", t.object.Spec.TargetVersion))
+	target.State = provisioningv1alpha1.TargetUpgrading
+	target.SourceVersion = sourceVersion
+	target.Generation = pr.Generation
+	target.Message = fmt.Sprintf("Upgrading from version %s", sourceVersion)
+	return nil
+}
+
+// checkUpgrade follows the upgrade of a ProvisioningRequest, which is over once the controller processed
+// the new version, the upgrade of the cluster completed and the request is fulfilled or failed again.
+func (t *fleetUpgradeReconcilerTask) checkUpgrade(ctx context.Context,
+	batch *provisioningv1alpha1.FleetUpgradeBatch, target *provisioningv1alpha1.FleetUpgradeTarget) error {
+	pr := &provisioningv1alpha1.ProvisioningRequest{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: target.Name}, pr); err != nil {
+		if errors.IsNotFound(err) {
+			target.State = provisioningv1alpha1.TargetFailed
+			target.Message = "The ProvisioningRequest no longer exists"
+			return nil
+		}
+		return fmt.Errorf("failed to get ProvisioningRequest %s: %w", target.Name, err)
+	}
+
+	if pr.Status.ObservedGeneration < target.Generation {
+		return nil
+	}
+
+	switch pr.Status.ProvisioningStatus.ProvisioningPhase {
+	case provisioningv1alpha1.StateFulfilled:
+		upgraded, err := t.isClusterUpgraded(ctx, pr, batch)
+		if err != nil {
+			return err
+		}
+		if upgraded {
+			target.State = provisioningv1alpha1.TargetUpgraded
+			target.Message = fmt.Sprintf("Upgraded to version %s", t.object.Spec.TargetVersion)
+		}
+	case provisioningv1alpha1.StateFailed:
+		target.State = provisioningv1alpha1.TargetFailed
+		target.Message = getProvisioningFailureMessage(pr)
+	}
+	return nil
+}
+
+// isClusterUpgraded checks if the cluster of a ProvisioningRequest runs the release of the target version.
+// The ProvisioningRequest controller records the new generation as observed when it validates the request,
+// before it starts the upgrade of the cluster, so the generation and the phase are not enough: the upgrade
+// must have completed since the batch started, or the cluster must not need one.
+func (t *fleetUpgradeReconcilerTask) isClusterUpgraded(ctx context.Context,
+	pr *provisioningv1alpha1.ProvisioningRequest, batch *provisioningv1alpha1.FleetUpgradeBatch) (bool, error) {
+	condition := meta.FindStatusCondition(pr.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+	if isUpgradeAttempted(pr, batch) {
+		return condition.Status == metav1.ConditionTrue, nil
+	}
+	if condition != nil && condition.Status != metav1.ConditionTrue {
+		// An upgrade started before the batch is still running
+		return false, nil
+	}
+	if pr.Status.Extensions.ClusterDetails == nil {
+		return false, nil
+	}
+
+	// No upgrade was seen since the batch started, which is only over if the cluster does not need one
+	upgradeRequested, err := t.provisioningRequestTask(pr).IsUpgradeRequested(ctx,
+		pr.Status.Extensions.ClusterDetails.Name)
+	if err != nil {
+		return false, fmt.Errorf("failed to check the upgrade of ProvisioningRequest %s: %w", pr.Name, err)
+	}
+	return !upgradeRequested, nil
+}
+
+// isClusterUpgradeFinalized checks if the upgrade of the cluster of a ProvisioningRequest to the target
+// version was finalized, after which the cluster can no longer be rolled back.
+func (t *fleetUpgradeReconcilerTask) isClusterUpgradeFinalized(ctx context.Context,
+	pr *provisioningv1alpha1.ProvisioningRequest) (bool, error) {
+	if pr.Status.Extensions.ClusterDetails == nil {
+		return false, nil
+	}
+	upgradeIBGU, completed, err := t.provisioningRequestTask(pr).getUpgradeIBGU(ctx,
+		pr.Status.Extensions.ClusterDetails.Name, t.object.Spec.TargetVersion)
+	if err != nil {
+		return false, fmt.Errorf("failed to get the upgrade of ProvisioningRequest %s: %w", pr.Name, err)
+	}
+	return upgradeIBGU != nil && isUpgradeFinalized(upgradeIBGU, completed), nil
+}
+
+// provisioningRequestTask returns a ProvisioningRequest reconciler task, to reuse its upgrade checks
+func (t *fleetUpgradeReconcilerTask) provisioningRequestTask(
+	pr *provisioningv1alpha1.ProvisioningRequest) *provisioningRequestReconcilerTask {
+	return &provisioningRequestReconcilerTask{
+		logger: t.logger,
+		client: t.client,
+		object: pr,
+	}
+}
+
+// soakBatch waits for the soak time of the batch to be over, then checks the health gates.
+func (t *fleetUpgradeReconcilerTask) soakBatch(ctx context.Context, batch *provisioningv1alpha1.FleetUpgradeBatch) (ctrl.Result, error) {
+	if batch.SoakStartedAt != nil {
+		if remaining := t.object.Spec.SoakTime.Duration - time.Since(batch.SoakStartedAt.Time); remaining > 0 {
+			return requeueWithCustomInterval(remaining), nil
+		}
+	}
+
+	// Check all the targets before changing any of them, so an error does not leave the batch half done
+	messages := map[string]string{}
+	for _, target := range batch.ProvisioningRequests {
+		if target.State != provisioningv1alpha1.TargetUpgraded {
+			continue
+		}
+		message, err := t.checkHealthGates(ctx, target.Name)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		messages[target.Name] = message
+	}
+
+	var failed []string
+	for i := range batch.ProvisioningRequests {
+		target := &batch.ProvisioningRequests[i]
+		message, ok := messages[target.Name]
+		switch {
+		case !ok:
+			continue
+		case message != "":
+			target.State = provisioningv1alpha1.TargetFailed
+			target.Message = message
+			failed = append(failed, target.Name)
+		default:
+			target.State = provisioningv1alpha1.TargetCompleted
+			target.Message = fmt.Sprintf("Upgraded to version %s and passed the health gates", t.object.Spec.TargetVersion)
+		}
+	}
+
+	if len(failed) > 0 {
+		return doNotRequeue(), t.failBatch(ctx, batch, provisioningv1alpha1.FUconditionReasons.HealthGateFailed, failed)
+	}
+
+	t.logger.InfoContext(ctx, "FleetUpgrade batch passed the health gates")
+	now := metav1.Now()
+	batch.State = provisioningv1alpha1.BatchCompleted
+	batch.CompletedAt = &now
+	return requeueImmediately(), nil
+}
+
+// checkHealthGates checks that an upgraded ProvisioningRequest is healthy. It returns why it is not, or an
+// empty string if it is.
+func (t *fleetUpgradeReconcilerTask) checkHealthGates(ctx context.Context, name string) (string, error) {
+	pr := &provisioningv1alpha1.ProvisioningRequest{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: name}, pr); err != nil {
+		if errors.IsNotFound(err) {
+			return "The ProvisioningRequest no longer exists", nil
+		}
+		return "", fmt.Errorf("failed to get ProvisioningRequest %s: %w", name, err)
+	}
+
+	if pr.Status.ProvisioningStatus.ProvisioningPhase != provisioningv1alpha1.StateFulfilled {
+		return fmt.Sprintf("The ProvisioningRequest is no longer fulfilled (%s)",
+			pr.Status.ProvisioningStatus.ProvisioningPhase), nil
+	}
+
+	gates := t.object.Spec.HealthGates
+	if !gates.IgnorePolicyCompliance {
+		condition := meta.FindStatusCondition(pr.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied))
+		if condition == nil || condition.Status != metav1.ConditionTrue {
+			return "The cluster is not compliant with its policies", nil
+		}
+	}
+
+	if !gates.IgnoreCriticalAlarms {
+		nodeClusterID := ""
+		if pr.Status.ProvisioningStatus.ProvisionedResources != nil {
+			nodeClusterID = pr.Status.ProvisioningStatus.ProvisionedResources.OCloudNodeClusterId
+		}
+		if nodeClusterID == "" {
+			return "The O-Cloud node cluster of the ProvisioningRequest is unknown, its alarms cannot be checked", nil
+		}
+
+		count, err := t.alarmCounter.CountCriticalAlarms(ctx, nodeClusterID, gates.MaxCriticalAlarms)
+		if err != nil {
+			return "", fmt.Errorf("failed to count the critical alarms of ProvisioningRequest %s: %w", name, err)
+		}
+		if count > gates.MaxCriticalAlarms {
+			return fmt.Sprintf("The cluster has %d active critical alarms, more than the %d allowed",
+				count, gates.MaxCriticalAlarms), nil
+		}
+	}
+
+	return "", nil
+}
+
+// failBatch marks the batch as failed, starts the rollback of its failed ProvisioningRequests if
+// requested, and pauses the upgrade.
+func (t *fleetUpgradeReconcilerTask) failBatch(ctx context.Context, batch *provisioningv1alpha1.FleetUpgradeBatch,
+	reason provisioningv1alpha1.ConditionReason, failed []string) error {
+	t.logger.InfoContext(ctx, "FleetUpgrade batch failed, pausing the upgrade",
+		slog.String("reason", string(reason)),
+		slog.String("provisioningRequests", strings.Join(failed, ",")))
+
+	now := metav1.Now()
+	batch.State = provisioningv1alpha1.BatchFailed
+	batch.CompletedAt = &now
+
+	if t.object.Spec.RollbackPolicy == provisioningv1alpha1.RollbackPolicyAutomatic {
+		for i := range batch.ProvisioningRequests {
+			target := &batch.ProvisioningRequests[i]
+			if target.State != provisioningv1alpha1.TargetFailed {
+				continue
+			}
+			if err := t.startRollback(ctx, batch, target); err != nil {
+				return err
+			}
+		}
+	}
+
+	ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+		provisioningv1alpha1.FUconditionTypes.Paused,
+		reason,
+		metav1.ConditionTrue,
+		fmt.Sprintf("Batch %d failed for ProvisioningRequests %s. Set spec.resumeAfterBatch to %d to resume with "+
+			"the next batch", t.batchNumber(batch), strings.Join(failed, ", "), t.batchNumber(batch)),
+	)
+
+	// The pause is kept in the status, as the spec may be owned by a GitOps tool that would revert it
+	t.object.Status.PausedAtBatch = t.batchNumber(batch)
+	return nil
+}
+
+// resumeAfterFailedBatch lifts the pause set by a failed batch once spec.resumeAfterBatch
+// acknowledges that batch.
+func (t *fleetUpgradeReconcilerTask) resumeAfterFailedBatch(ctx context.Context) {
+	if t.object.Status.PausedAtBatch == 0 || t.object.Spec.ResumeAfterBatch != t.object.Status.PausedAtBatch {
+		return
+	}
+	t.logger.InfoContext(ctx, "Resuming the FleetUpgrade after the failed batch",
+		slog.Int("batch", t.object.Status.PausedAtBatch))
+	t.object.Status.PausedAtBatch = 0
+}
+
+// isPaused returns true when no new batch should be started, either because the user paused the
+// upgrade or because a batch failed.
+func (t *fleetUpgradeReconcilerTask) isPaused() bool {
+	return t.object.Spec.Paused || t.object.Status.PausedAtBatch != 0
+}
+
+// startRollback moves a failed ProvisioningRequest back to its previous version of the ClusterTemplate,
+// and requests the rollback of its cluster if the upgrade reached it.
+func (t *fleetUpgradeReconcilerTask) startRollback(ctx context.Context,
+	batch *provisioningv1alpha1.FleetUpgradeBatch, target *provisioningv1alpha1.FleetUpgradeTarget) error {
+	sourceVersion := target.SourceVersion
+	if sourceVersion == "" {
+		sourceVersion = t.object.Spec.SourceVersion
+	}
+	if sourceVersion == "" {
+		target.State = provisioningv1alpha1.TargetRollbackFailed
+		target.Message = "The previous version of the ClusterTemplate is unknown"
+		return nil
+	}
+
+	pr := &provisioningv1alpha1.ProvisioningRequest{}
+	if err := t.client.Get(ctx, types.NamespacedName{Name: target.Name}, pr); err != nil {
+		if errors.IsNotFound(err) {
+			target.State = provisioningv1alpha1.TargetRollbackFailed
+			target.Message = "The ProvisioningRequest no longer exists"
+			return nil
+		}
+		return fmt.Errorf("failed to get ProvisioningRequest %s: %w", target.Name, err)
+	}
+
+	upgradeAttempted := isUpgradeAttempted(pr, batch)
+	if upgradeAttempted {
+		finalized, err := t.isClusterUpgradeFinalized(ctx, pr)
+		if err != nil {
+			return err
+		}
+		if finalized {
+			// Moving the ProvisioningRequest back would only request a rollback the cluster cannot do
+			target.State = provisioningv1alpha1.TargetRollbackFailed
+			target.Message = fmt.Sprintf(
+				"The upgrade was finalized and can no longer be rolled back, after: %s", target.Message)
+			return nil
+		}
+	}
+
+	patch := client.MergeFrom(pr.DeepCopy())
+	pr.Spec.TemplateVersion = sourceVersion
+	if upgradeAttempted {
+		if pr.Annotations == nil {
+			pr.Annotations = map[string]string{}
+		}
+		pr.Annotations[provisioningv1alpha1.UpgradeRollbackAnnotation] = t.object.Spec.TargetVersion
+	}
+	if err := t.client.Patch(ctx, pr, patch); err != nil {
+		return fmt.Errorf("failed to roll back the template version of ProvisioningRequest %s: %w", pr.Name, err)
+	}
+
+	t.logger.InfoContext(ctx, "Started the rollback of ProvisioningRequest",
+		slog.String("provisioningRequest", pr.Name),
+		slog.String("sourceVersion", sourceVersion))
+	target.State = provisioningv1alpha1.TargetRollingBack
+	target.Generation = pr.Generation
+	target.Message = fmt.Sprintf("Rolling back to version %s after: %s", sourceVersion, target.Message)
+	return nil
+}
+
+// isUpgradeAttempted checks if the upgrade of the cluster was started since the batch started, in which
+// case the cluster must be rolled back and not only its ProvisioningRequest.
+func isUpgradeAttempted(pr *provisioningv1alpha1.ProvisioningRequest, batch *provisioningv1alpha1.FleetUpgradeBatch) bool {
+	condition := meta.FindStatusCondition(pr.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+	if condition == nil || batch.StartedAt == nil {
+		return false
+	}
+	// Condition times have a precision of a second
+	return !condition.LastTransitionTime.Time.Before(batch.StartedAt.Time.Truncate(time.Second))
+}
+
+// monitorRollbacks follows the ProvisioningRequests being rolled back. It returns true while some are.
+func (t *fleetUpgradeReconcilerTask) monitorRollbacks(ctx context.Context) (bool, error) {
+	rollingBack := false
+	for i := range t.object.Status.Batches {
+		for j := range t.object.Status.Batches[i].ProvisioningRequests {
+			target := &t.object.Status.Batches[i].ProvisioningRequests[j]
+			if target.State != provisioningv1alpha1.TargetRollingBack {
+				continue
+			}
+
+			pr := &provisioningv1alpha1.ProvisioningRequest{}
+			if err := t.client.Get(ctx, types.NamespacedName{Name: target.Name}, pr); err != nil {
+				if errors.IsNotFound(err) {
+					target.State = provisioningv1alpha1.TargetRollbackFailed
+					target.Message = "The ProvisioningRequest no longer exists"
+					continue
+				}
+				return rollingBack, fmt.Errorf("failed to get ProvisioningRequest %s: %w", target.Name, err)
+			}
+
+			_, rollbackRequested := pr.GetAnnotations()[provisioningv1alpha1.UpgradeRollbackAnnotation]
+			switch {
+			case pr.Status.ObservedGeneration < target.Generation:
+				rollingBack = true
+			case pr.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateFailed:
+				target.State = provisioningv1alpha1.TargetRollbackFailed
+				target.Message = getProvisioningFailureMessage(pr)
+			case pr.Status.ProvisioningStatus.ProvisioningPhase == provisioningv1alpha1.StateFulfilled && !rollbackRequested:
+				target.State = provisioningv1alpha1.TargetRolledBack
+				target.Message = fmt.Sprintf("Rolled back to version %s", pr.Spec.TemplateVersion)
+			default:
+				rollingBack = true
+			}
+		}
+	}
+	return rollingBack, nil
+}
+
+// getProvisioningFailureMessage returns the reason of the failure of a ProvisioningRequest, preferring
+// the message of a failed upgrade.
+func getProvisioningFailureMessage(pr *provisioningv1alpha1.ProvisioningRequest) string {
+	condition := meta.FindStatusCondition(pr.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+	if condition != nil && condition.Reason == string(provisioningv1alpha1.CRconditionReasons.Failed) {
+		return condition.Message
+	}
+	return pr.Status.ProvisioningStatus.ProvisioningDetails
+}
+
+// updatePausedCondition reflects the pause in the Paused condition, keeping the failure reason set
+// when a failed batch paused the upgrade.
+func (t *fleetUpgradeReconcilerTask) updatePausedCondition() {
+	paused := meta.IsStatusConditionTrue(t.object.Status.Conditions, string(provisioningv1alpha1.FUconditionTypes.Paused))
+	switch {
+	case t.isPaused() && !paused:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Paused,
+			provisioningv1alpha1.FUconditionReasons.UserRequested,
+			metav1.ConditionTrue,
+			"The upgrade is paused",
+		)
+	case !t.isPaused() && paused:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Paused,
+			provisioningv1alpha1.FUconditionReasons.Resumed,
+			metav1.ConditionFalse,
+			"The upgrade is resumed",
+		)
+	}
+}
+
+// updatePhase sets the phase of the upgrade and the Completed condition from the state of the batches.
+func (t *fleetUpgradeReconcilerTask) updatePhase() {
+	total := 0
+	rollingBack := false
+	var failed []string
+	for _, batch := range t.object.Status.Batches {
+		for _, target := range batch.ProvisioningRequests {
+			total++
+			switch target.State {
+			case provisioningv1alpha1.TargetRollingBack:
+				rollingBack = true
+			case provisioningv1alpha1.TargetFailed, provisioningv1alpha1.TargetRolledBack,
+				provisioningv1alpha1.TargetRollbackFailed:
+				failed = append(failed, target.Name)
+			}
+		}
+	}
+
+	switch batch := t.currentBatch(); {
+	case batch != nil:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Completed,
+			provisioningv1alpha1.FUconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			fmt.Sprintf("Batch %d of %d is %s", t.batchNumber(batch), len(t.object.Status.Batches), batch.State),
+		)
+		t.object.Status.Phase = provisioningv1alpha1.FleetUpgradeProgressing
+		if t.isPaused() {
+			t.object.Status.Phase = provisioningv1alpha1.FleetUpgradePaused
+		}
+	case rollingBack:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Completed,
+			provisioningv1alpha1.FUconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Waiting for the rollbacks to complete",
+		)
+		t.object.Status.Phase = provisioningv1alpha1.FleetUpgradeProgressing
+	case len(failed) > 0:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Completed,
+			provisioningv1alpha1.FUconditionReasons.Failed,
+			metav1.ConditionFalse,
+			fmt.Sprintf("%d of %d ProvisioningRequests failed to upgrade: %s",
+				len(failed), total, strings.Join(failed, ", ")),
+		)
+		t.object.Status.Phase = provisioningv1alpha1.FleetUpgradeFailed
+	default:
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.FUconditionTypes.Completed,
+			provisioningv1alpha1.FUconditionReasons.Completed,
+			metav1.ConditionTrue,
+			fmt.Sprintf("The upgrade to version %s is completed", t.object.Spec.TargetVersion),
+		)
+		t.object.Status.Phase = provisioningv1alpha1.FleetUpgradeCompleted
+	}
+}
+
+// batchNumber returns the position of the batch, starting at 1.
+func (t *fleetUpgradeReconcilerTask) batchNumber(batch *provisioningv1alpha1.FleetUpgradeBatch) int {
+	for i := range t.object.Status.Batches {
+		if &t.object.Status.Batches[i] == batch {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+func (t *fleetUpgradeReconcilerTask) updateStatus(ctx context.Context) error {
+	t.object.Status.ObservedGeneration = t.object.Generation
+	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return fmt.Errorf("failed to update status for FleetUpgrade %s: %w", t.object.Name, err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *FleetUpgradeReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	//nolint:wrapcheck
+	return ctrl.NewControllerManagedBy(mgr).
+		Named("o2ims-fleet-upgrade").
+		For(&provisioningv1alpha1.FleetUpgrade{},
+			// Watch for create and spec update events for FleetUpgrade.
+			builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&provisioningv1alpha1.ProvisioningRequest{},
+			handler.EnqueueRequestsFromMapFunc(r.enqueueFleetUpgradesForProvisioningRequest),
+			builder.WithPredicates(predicate.Funcs{
+				UpdateFunc: func(e event.UpdateEvent) bool {
+					prOld := e.ObjectOld.(*provisioningv1alpha1.ProvisioningRequest)
+					prNew := e.ObjectNew.(*provisioningv1alpha1.ProvisioningRequest)
+
+					// Reconcile when the upgrade or rollback of the request moves forward
+					_, oldRollback := prOld.GetAnnotations()[provisioningv1alpha1.UpgradeRollbackAnnotation]
+					_, newRollback := prNew.GetAnnotations()[provisioningv1alpha1.UpgradeRollbackAnnotation]
+					return prOld.Status.ObservedGeneration != prNew.Status.ObservedGeneration ||
+						prOld.Status.ProvisioningStatus.ProvisioningPhase != prNew.Status.ProvisioningStatus.ProvisioningPhase ||
+						oldRollback != newRollback
+				},
+				CreateFunc:  func(ce event.CreateEvent) bool { return false },
+				GenericFunc: func(ge event.GenericEvent) bool { return false },
+				DeleteFunc:  func(de event.DeleteEvent) bool { return true },
+			})).
+		WithOptions(controller.Options{MaxConcurrentReconciles: 1}).
+		Complete(r)
+}
+
+// enqueueFleetUpgradesForProvisioningRequest enqueues the FleetUpgrades that upgrade a given ProvisioningRequest.
+func (r *FleetUpgradeReconciler) enqueueFleetUpgradesForProvisioningRequest(ctx context.Context, obj client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+
+	fleetUpgrades := &provisioningv1alpha1.FleetUpgradeList{}
+	if err := r.Client.List(ctx, fleetUpgrades); err != nil {
+		r.Logger.ErrorContext(ctx, "Unable to list FleetUpgrade resources", slog.String("error", err.Error()))
+		return nil
+	}
+
+	for _, fleetUpgrade := range fleetUpgrades.Items {
+		for _, batch := range fleetUpgrade.Status.Batches {
+			if slices.ContainsFunc(batch.ProvisioningRequests, func(target provisioningv1alpha1.FleetUpgradeTarget) bool {
+				return target.Name == obj.GetName()
+			}) {
+				requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: fleetUpgrade.Name}})
+				break
+			}
+		}
+	}
+
+	return requests
+}
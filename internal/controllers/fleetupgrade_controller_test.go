@@ -0,0 +1,621 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Test Cases for FleetUpgrade Controller
+
+This file contains unit tests for the FleetUpgrade controller, which moves the ProvisioningRequests
+of a ClusterTemplate to a new version of the template in batches.
+
+Test Suites:
+
+1. FleetUpgradeReconciler - Tests for the batch orchestration:
+   • Waits for the target ClusterTemplate to be valid
+   • Plans a canary batch followed by the regular batches
+   • Waits for the cluster upgrade, not only for the new version to be observed
+   • Soaks the upgraded batch before checking the health gates
+   • Upgrades all the batches one after the other
+   • Skips the ProvisioningRequests that are not fulfilled
+   • Pauses the upgrade when a batch fails to upgrade or fails the health gates
+   • Counts the critical alarms of the hardware of the upgraded clusters in the health gates
+   • Rolls back the failed ProvisioningRequests with the Automatic rollback policy
+   • Does not roll back the clusters whose upgrade was finalized
+   • Keeps the failure pause in the status, without writing the spec
+   • Resumes with the next batch once spec.resumeAfterBatch acknowledges the failed batch
+
+2. enqueueFleetUpgradesForProvisioningRequest - Tests for mapping ProvisioningRequests to FleetUpgrades
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// fakeCriticalAlarmCounter returns a fixed number of critical alarms per node cluster.
+type fakeCriticalAlarmCounter struct {
+	counts map[string]int
+}
+
+func (f *fakeCriticalAlarmCounter) CountCriticalAlarms(_ context.Context, nodeClusterID string, _ int) (int, error) {
+	return f.counts[nodeClusterID], nil
+}
+
+var _ = Describe("FleetUpgradeReconciler", func() {
+	var (
+		c            client.Client
+		ctx          context.Context
+		reconciler   *FleetUpgradeReconciler
+		alarmCounter *fakeCriticalAlarmCounter
+		fleetUpgrade *provisioningv1alpha1.FleetUpgrade
+		tName        = "clustertemplate-a"
+		ctNamespace  = "clustertemplate-a-v4-16"
+		fuName       = "clustertemplate-a-v2"
+	)
+
+	newClusterTemplate := func(version string) *provisioningv1alpha1.ClusterTemplate {
+		return &provisioningv1alpha1.ClusterTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      GetClusterTemplateRefName(tName, version),
+				Namespace: ctNamespace,
+			},
+			Spec: provisioningv1alpha1.ClusterTemplateSpec{
+				Name:    tName,
+				Version: version,
+			},
+			Status: provisioningv1alpha1.ClusterTemplateStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(provisioningv1alpha1.CTconditionTypes.Validated),
+						Reason: string(provisioningv1alpha1.CTconditionReasons.Completed),
+						Status: metav1.ConditionTrue,
+					},
+				},
+			},
+		}
+	}
+
+	newProvisioningRequest := func(name, templateName, version string, labels map[string]string) *provisioningv1alpha1.ProvisioningRequest {
+		return &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: labels,
+			},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateName:    templateName,
+				TemplateVersion: version,
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   string(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied),
+						Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+						Status: metav1.ConditionTrue,
+					},
+				},
+				ProvisioningStatus: provisioningv1alpha1.ProvisioningStatus{
+					ProvisioningPhase: provisioningv1alpha1.StateFulfilled,
+					ProvisionedResources: &provisioningv1alpha1.ProvisionedResources{
+						OCloudNodeClusterId: "node-cluster-" + name,
+					},
+				},
+			},
+		}
+	}
+
+	reconcileFleetUpgrade := func() ctrl.Result {
+		result, err := reconciler.Reconcile(ctx, reconcile.Request{NamespacedName: types.NamespacedName{Name: fuName}})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(c.Get(ctx, types.NamespacedName{Name: fuName}, fleetUpgrade)).To(Succeed())
+		return result
+	}
+
+	getProvisioningRequest := func(name string) *provisioningv1alpha1.ProvisioningRequest {
+		pr := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, types.NamespacedName{Name: name}, pr)).To(Succeed())
+		return pr
+	}
+
+	// completeUpgrades simulates the ProvisioningRequest controller finishing the upgrade of the
+	// requests moved to the target version.
+	completeUpgrades := func(names ...string) {
+		for _, name := range names {
+			pr := getProvisioningRequest(name)
+			Expect(pr.Spec.TemplateVersion).To(Equal("v2.0.0"))
+			pr.Status.ObservedGeneration = pr.Generation
+			pr.Status.ProvisioningStatus.ProvisioningPhase = provisioningv1alpha1.StateFulfilled
+			utils.SetStatusCondition(&pr.Status.Conditions,
+				provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.Completed,
+				metav1.ConditionTrue,
+				"Upgrade is completed",
+			)
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+		}
+	}
+
+	batchNames := func() [][]string {
+		var names [][]string
+		for _, batch := range fleetUpgrade.Status.Batches {
+			var batchNames []string
+			for _, target := range batch.ProvisioningRequests {
+				batchNames = append(batchNames, target.Name)
+			}
+			names = append(names, batchNames)
+		}
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		alarmCounter = &fakeCriticalAlarmCounter{counts: map[string]int{}}
+
+		fleetUpgrade = &provisioningv1alpha1.FleetUpgrade{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fuName,
+			},
+			Spec: provisioningv1alpha1.FleetUpgradeSpec{
+				TemplateName:    tName,
+				SourceVersion:   "v1.0.0",
+				TargetVersion:   "v2.0.0",
+				Selector:        &metav1.LabelSelector{MatchLabels: map[string]string{"region": "east"}},
+				CanaryBatchSize: 1,
+				BatchSize:       2,
+				RollbackPolicy:  provisioningv1alpha1.RollbackPolicyNone,
+			},
+		}
+	})
+
+	JustBeforeEach(func() {
+		east := map[string]string{"region": "east"}
+		objs := []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: ctNamespace}},
+			newClusterTemplate("v1.0.0"),
+			newClusterTemplate("v2.0.0"),
+			newProvisioningRequest("cluster-1", tName, "v1.0.0", east),
+			newProvisioningRequest("cluster-2", tName, "v1.0.0", east),
+			newProvisioningRequest("cluster-3", tName, "v1.0.0", east),
+			newProvisioningRequest("cluster-4", tName, "v1.0.0", east),
+			// Not selected: other region, already upgraded, other template
+			newProvisioningRequest("cluster-5", tName, "v1.0.0", map[string]string{"region": "west"}),
+			newProvisioningRequest("cluster-6", tName, "v2.0.0", east),
+			newProvisioningRequest("cluster-7", "clustertemplate-b", "v1.0.0", east),
+			fleetUpgrade,
+		}
+		c = getFakeClientFromObjects(objs...)
+		reconciler = &FleetUpgradeReconciler{
+			Client:       c,
+			Logger:       slog.New(slog.DiscardHandler),
+			AlarmCounter: alarmCounter,
+		}
+	})
+
+	Context("when the target ClusterTemplate is not valid", func() {
+		BeforeEach(func() {
+			fleetUpgrade.Spec.TargetVersion = "v3.0.0"
+		})
+
+		It("should not start the upgrade", func() {
+			result := reconcileFleetUpgrade()
+			Expect(result.RequeueAfter).To(Equal(requeueWithLongInterval().RequeueAfter))
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradePending))
+			Expect(fleetUpgrade.Status.Batches).To(BeNil())
+
+			validatedCond := meta.FindStatusCondition(fleetUpgrade.Status.Conditions,
+				string(provisioningv1alpha1.FUconditionTypes.Validated))
+			Expect(validatedCond).ToNot(BeNil())
+			Expect(validatedCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(validatedCond.Message).To(ContainSubstring("clustertemplate-a.v3.0.0"))
+		})
+	})
+
+	Context("when the upgrade starts", func() {
+		It("should plan a canary batch and upgrade it first", func() {
+			reconcileFleetUpgrade()
+			Expect(batchNames()).To(Equal([][]string{{"cluster-1"}, {"cluster-2", "cluster-3"}, {"cluster-4"}}))
+			Expect(fleetUpgrade.Status.Batches[0].Canary).To(BeTrue())
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchUpgrading))
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchPending))
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradeProgressing))
+
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetUpgrading))
+			Expect(target.SourceVersion).To(Equal("v1.0.0"))
+
+			Expect(getProvisioningRequest("cluster-1").Spec.TemplateVersion).To(Equal("v2.0.0"))
+			Expect(getProvisioningRequest("cluster-2").Spec.TemplateVersion).To(Equal("v1.0.0"))
+		})
+	})
+
+	Context("when the ProvisioningRequest controller validates the new version before upgrading the cluster", func() {
+		It("should wait for the cluster upgrade to complete", func() {
+			ct := &provisioningv1alpha1.ClusterTemplate{}
+			Expect(c.Get(ctx, types.NamespacedName{
+				Name: GetClusterTemplateRefName(tName, "v2.0.0"), Namespace: ctNamespace}, ct)).To(Succeed())
+			ct.Spec.Release = "4.17.0"
+			Expect(c.Update(ctx, ct)).To(Succeed())
+			Expect(c.Create(ctx, &clusterv1.ManagedCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "cluster-1",
+					Labels: map[string]string{"openshiftVersion": "4.16.0"},
+				},
+			})).To(Succeed())
+
+			reconcileFleetUpgrade()
+
+			// The validation of the new version is written first, with the request still fulfilled
+			pr := getProvisioningRequest("cluster-1")
+			pr.Status.ObservedGeneration = pr.Generation
+			pr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{Name: "cluster-1"}
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].ProvisioningRequests[0].State).To(Equal(provisioningv1alpha1.TargetUpgrading))
+
+			// Then the cluster upgrade starts
+			pr = getProvisioningRequest("cluster-1")
+			utils.SetStatusCondition(&pr.Status.Conditions,
+				provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.InProgress,
+				metav1.ConditionFalse,
+				"Upgrade is initiated",
+			)
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].ProvisioningRequests[0].State).To(Equal(provisioningv1alpha1.TargetUpgrading))
+
+			completeUpgrades("cluster-1")
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].ProvisioningRequests[0].State).To(Equal(provisioningv1alpha1.TargetUpgraded))
+		})
+	})
+
+	Context("when the upgraded batch soaks", func() {
+		BeforeEach(func() {
+			fleetUpgrade.Spec.SoakTime = metav1.Duration{Duration: time.Hour}
+		})
+
+		It("should wait for the soak time before starting the next batch", func() {
+			reconcileFleetUpgrade()
+			completeUpgrades("cluster-1")
+
+			result := reconcileFleetUpgrade()
+			Expect(result.RequeueAfter).To(BeNumerically("~", time.Hour, time.Minute))
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchSoaking))
+			Expect(fleetUpgrade.Status.Batches[0].SoakStartedAt).ToNot(BeNil())
+			Expect(fleetUpgrade.Status.Batches[0].ProvisioningRequests[0].State).To(Equal(provisioningv1alpha1.TargetUpgraded))
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchSoaking))
+			Expect(getProvisioningRequest("cluster-2").Spec.TemplateVersion).To(Equal("v1.0.0"))
+		})
+	})
+
+	Context("when all the batches pass the health gates", func() {
+		It("should upgrade the batches one after the other and complete", func() {
+			for i := 0; i < 10 && fleetUpgrade.Status.Phase != provisioningv1alpha1.FleetUpgradeCompleted; i++ {
+				reconcileFleetUpgrade()
+				for _, batch := range fleetUpgrade.Status.Batches {
+					for _, target := range batch.ProvisioningRequests {
+						if target.State == provisioningv1alpha1.TargetUpgrading {
+							completeUpgrades(target.Name)
+						}
+					}
+				}
+			}
+
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradeCompleted))
+			for _, batch := range fleetUpgrade.Status.Batches {
+				Expect(batch.State).To(Equal(provisioningv1alpha1.BatchCompleted))
+			}
+			for _, name := range []string{"cluster-1", "cluster-2", "cluster-3", "cluster-4"} {
+				Expect(getProvisioningRequest(name).Spec.TemplateVersion).To(Equal("v2.0.0"))
+			}
+			Expect(getProvisioningRequest("cluster-5").Spec.TemplateVersion).To(Equal("v1.0.0"))
+			Expect(getProvisioningRequest("cluster-7").Spec.TemplateVersion).To(Equal("v1.0.0"))
+
+			completedCond := meta.FindStatusCondition(fleetUpgrade.Status.Conditions,
+				string(provisioningv1alpha1.FUconditionTypes.Completed))
+			Expect(completedCond).ToNot(BeNil())
+			Expect(completedCond.Status).To(Equal(metav1.ConditionTrue))
+		})
+	})
+
+	Context("when a ProvisioningRequest is not fulfilled", func() {
+		It("should skip it", func() {
+			pr := getProvisioningRequest("cluster-1")
+			pr.Status.ProvisioningStatus.ProvisioningPhase = provisioningv1alpha1.StateProgressing
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetSkipped))
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchCompleted))
+			Expect(getProvisioningRequest("cluster-1").Spec.TemplateVersion).To(Equal("v1.0.0"))
+
+			// The next batch starts right away
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchUpgrading))
+		})
+	})
+
+	Context("when a ProvisioningRequest fails to upgrade", func() {
+		It("should fail the batch and pause the upgrade", func() {
+			reconcileFleetUpgrade()
+
+			pr := getProvisioningRequest("cluster-1")
+			pr.Status.ObservedGeneration = pr.Generation
+			pr.Status.ProvisioningStatus.ProvisioningPhase = provisioningv1alpha1.StateFailed
+			utils.SetStatusCondition(&pr.Status.Conditions,
+				provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+				provisioningv1alpha1.CRconditionReasons.Failed,
+				metav1.ConditionFalse,
+				"Upgrade Failed: Action Prep failed: pre-cache failed",
+			)
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Spec.Paused).To(BeFalse())
+			Expect(fleetUpgrade.Status.PausedAtBatch).To(Equal(1))
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradePaused))
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchFailed))
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetFailed))
+			Expect(target.Message).To(ContainSubstring("pre-cache failed"))
+
+			pausedCond := meta.FindStatusCondition(fleetUpgrade.Status.Conditions,
+				string(provisioningv1alpha1.FUconditionTypes.Paused))
+			Expect(pausedCond).ToNot(BeNil())
+			Expect(pausedCond.Status).To(Equal(metav1.ConditionTrue))
+			Expect(pausedCond.Reason).To(Equal(string(provisioningv1alpha1.FUconditionReasons.UpgradeFailed)))
+
+			// Without rollback policy the ProvisioningRequest is left as it is
+			Expect(getProvisioningRequest("cluster-1").Spec.TemplateVersion).To(Equal("v2.0.0"))
+
+			// The next batch does not start while paused
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchPending))
+		})
+	})
+
+	Context("when a ProvisioningRequest is not compliant with its policies after the upgrade", func() {
+		It("should fail the health gates", func() {
+			reconcileFleetUpgrade()
+			completeUpgrades("cluster-1")
+
+			pr := getProvisioningRequest("cluster-1")
+			utils.SetStatusCondition(&pr.Status.Conditions,
+				provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+				provisioningv1alpha1.CRconditionReasons.InProgress,
+				metav1.ConditionFalse,
+				"The configuration is still being applied",
+			)
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchFailed))
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetFailed))
+			Expect(target.Message).To(Equal("The cluster is not compliant with its policies"))
+		})
+	})
+
+	Context("when an upgraded cluster raises critical alarms", func() {
+		BeforeEach(func() {
+			fleetUpgrade.Spec.RollbackPolicy = provisioningv1alpha1.RollbackPolicyAutomatic
+			alarmCounter.counts["node-cluster-cluster-1"] = 2
+		})
+
+		It("should roll it back, pause, and resume with the next batch once the failure is acknowledged", func() {
+			reconcileFleetUpgrade()
+			completeUpgrades("cluster-1")
+
+			// Soaking, then health gates
+			reconcileFleetUpgrade()
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchFailed))
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradePaused))
+			Expect(fleetUpgrade.Spec.Paused).To(BeFalse())
+			Expect(fleetUpgrade.Status.PausedAtBatch).To(Equal(1))
+
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetRollingBack))
+			Expect(target.Message).To(ContainSubstring("2 active critical alarms"))
+
+			pausedCond := meta.FindStatusCondition(fleetUpgrade.Status.Conditions,
+				string(provisioningv1alpha1.FUconditionTypes.Paused))
+			Expect(pausedCond).ToNot(BeNil())
+			Expect(pausedCond.Reason).To(Equal(string(provisioningv1alpha1.FUconditionReasons.HealthGateFailed)))
+			Expect(pausedCond.Message).To(ContainSubstring("Batch 1 failed for ProvisioningRequests cluster-1"))
+			Expect(pausedCond.Message).To(ContainSubstring("Set spec.resumeAfterBatch to 1"))
+
+			// The ProvisioningRequest is moved back, and its cluster rollback is requested
+			pr := getProvisioningRequest("cluster-1")
+			Expect(pr.Spec.TemplateVersion).To(Equal("v1.0.0"))
+			Expect(pr.Annotations).To(HaveKeyWithValue(provisioningv1alpha1.UpgradeRollbackAnnotation, "v2.0.0"))
+
+			// Simulate the ProvisioningRequest controller completing the rollback
+			delete(pr.Annotations, provisioningv1alpha1.UpgradeRollbackAnnotation)
+			Expect(c.Update(ctx, pr)).To(Succeed())
+			pr.Status.ObservedGeneration = pr.Generation
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			target = fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetRolledBack))
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchPending))
+
+			// Acknowledging another batch does not resume
+			fleetUpgrade.Spec.ResumeAfterBatch = 2
+			Expect(c.Update(ctx, fleetUpgrade)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradePaused))
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchPending))
+
+			// Resume
+			fleetUpgrade.Spec.ResumeAfterBatch = 1
+			Expect(c.Update(ctx, fleetUpgrade)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.PausedAtBatch).To(BeZero())
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradeProgressing))
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchUpgrading))
+			Expect(getProvisioningRequest("cluster-2").Spec.TemplateVersion).To(Equal("v2.0.0"))
+
+			pausedCond = meta.FindStatusCondition(fleetUpgrade.Status.Conditions,
+				string(provisioningv1alpha1.FUconditionTypes.Paused))
+			Expect(pausedCond.Status).To(Equal(metav1.ConditionFalse))
+			Expect(pausedCond.Reason).To(Equal(string(provisioningv1alpha1.FUconditionReasons.Resumed)))
+		})
+	})
+
+	Context("when the hardware of an upgraded cluster raises a critical alarm", func() {
+		const hwResourceID = "3a1b7f1e-0c7e-4c56-9a43-9a6f3f2c1d10"
+
+		var alarmServer *httptest.Server
+
+		BeforeEach(func() {
+			// Simulate the alarm server, which selects the alarms of the hardware resources of a node cluster
+			// through its node_cluster_resource mapping
+			nodeClusterResources := map[string]string{hwResourceID: "node-cluster-cluster-1"}
+			alarmServer = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				defer GinkgoRecover()
+				Expect(r.URL.Path).To(Equal(constants.O2IMSMonitoringBaseURL + constants.AlarmsPath))
+				Expect(r.Header.Get("Authorization")).To(Equal("Bearer token"))
+				Expect(r.URL.Query().Get("filter")).To(Equal("(eq,perceivedSeverity,0)"))
+
+				alarms := []map[string]any{}
+				if nodeClusterResources[hwResourceID] == r.URL.Query().Get("node_cluster_id") {
+					alarms = append(alarms, map[string]any{
+						"alarmEventRecordId": "6e7bd8e3-64a4-4a0e-8dd9-5f4e8fb31a2c",
+						"resourceID":         hwResourceID,
+						"perceivedSeverity":  criticalSeverity,
+					})
+				}
+				Expect(json.NewEncoder(w).Encode(alarms)).To(Succeed())
+			}))
+			DeferCleanup(alarmServer.Close)
+		})
+
+		It("should fail the health gates and pause", func() {
+			tokenPath := filepath.Join(GinkgoT().TempDir(), "token")
+			Expect(os.WriteFile(tokenPath, []byte("token"), 0o600)).To(Succeed())
+			reconciler.AlarmCounter = &alarmServerCriticalAlarmCounter{
+				url:       alarmServer.URL,
+				tokenPath: tokenPath,
+				newTransport: func() (http.RoundTripper, error) {
+					return http.DefaultTransport, nil
+				},
+			}
+
+			reconcileFleetUpgrade()
+			completeUpgrades("cluster-1")
+
+			// Soaking, then health gates
+			reconcileFleetUpgrade()
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchFailed))
+			Expect(fleetUpgrade.Status.Phase).To(Equal(provisioningv1alpha1.FleetUpgradePaused))
+			Expect(fleetUpgrade.Status.PausedAtBatch).To(Equal(1))
+			Expect(fleetUpgrade.Status.Batches[1].State).To(Equal(provisioningv1alpha1.BatchPending))
+
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetFailed))
+			Expect(target.Message).To(ContainSubstring("1 active critical alarms"))
+		})
+	})
+
+	Context("when an upgraded cluster fails the health gates after its upgrade was finalized", func() {
+		BeforeEach(func() {
+			fleetUpgrade.Spec.RollbackPolicy = provisioningv1alpha1.RollbackPolicyAutomatic
+			alarmCounter.counts["node-cluster-cluster-1"] = 1
+		})
+
+		It("should fail the rollback without moving the ProvisioningRequest back", func() {
+			Expect(c.Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "upgrade-defaults", Namespace: ctNamespace},
+				Data: map[string]string{
+					utils.UpgradeDefaultsConfigmapKey: `
+ibuSpec:
+  seedImageRef:
+    image: "image"
+    version: "4.17.0"
+  oadpContent:
+  - name: "test"
+    namespace: "test"
+plan:
+- actions: ["Prep"]
+- actions: ["Upgrade"]
+- actions: ["FinalizeUpgrade"]
+`,
+				},
+			})).To(Succeed())
+			ct := &provisioningv1alpha1.ClusterTemplate{}
+			Expect(c.Get(ctx, types.NamespacedName{
+				Name: GetClusterTemplateRefName(tName, "v2.0.0"), Namespace: ctNamespace}, ct)).To(Succeed())
+			ct.Spec.Templates.UpgradeDefaults = "upgrade-defaults"
+			Expect(c.Update(ctx, ct)).To(Succeed())
+
+			reconcileFleetUpgrade()
+			pr := getProvisioningRequest("cluster-1")
+			pr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{Name: "cluster-1"}
+			Expect(c.Status().Update(ctx, pr)).To(Succeed())
+			completeUpgrades("cluster-1")
+
+			// Soaking, then health gates
+			reconcileFleetUpgrade()
+			reconcileFleetUpgrade()
+			Expect(fleetUpgrade.Status.Batches[0].State).To(Equal(provisioningv1alpha1.BatchFailed))
+			target := fleetUpgrade.Status.Batches[0].ProvisioningRequests[0]
+			Expect(target.State).To(Equal(provisioningv1alpha1.TargetRollbackFailed))
+			Expect(target.Message).To(ContainSubstring("can no longer be rolled back"))
+
+			pr = getProvisioningRequest("cluster-1")
+			Expect(pr.Spec.TemplateVersion).To(Equal("v2.0.0"))
+			Expect(pr.Annotations).ToNot(HaveKey(provisioningv1alpha1.UpgradeRollbackAnnotation))
+		})
+	})
+
+	Describe("enqueueFleetUpgradesForProvisioningRequest", func() {
+		It("should enqueue the FleetUpgrades that upgrade the ProvisioningRequest", func() {
+			reconcileFleetUpgrade()
+
+			for _, name := range []string{"cluster-1", "cluster-4"} {
+				requests := reconciler.enqueueFleetUpgradesForProvisioningRequest(ctx, getProvisioningRequest(name))
+				Expect(requests).To(Equal([]reconcile.Request{{NamespacedName: types.NamespacedName{Name: fuName}}}),
+					fmt.Sprintf("ProvisioningRequest %s", name))
+			}
+			Expect(reconciler.enqueueFleetUpgradesForProvisioningRequest(ctx, getProvisioningRequest("cluster-5"))).To(BeEmpty())
+		})
+	})
+})
@@ -85,6 +85,52 @@ func compareHardwareTemplateWithNodeAllocationRequest(hardwareTemplate *hwmgmtv1
 	return changesDetected, nil
 }
 
+// newNodeScoring converts the scoring of a HardwareTemplate node group to its hardware plugin API representation
+func newNodeScoring(scoring *hwmgmtv1alpha1.NodeScoring) *hwmgrpluginapi.NodeScoring {
+	if scoring == nil {
+		return nil
+	}
+
+	nodeScoring := &hwmgrpluginapi.NodeScoring{Strategy: hwmgrpluginapi.NodeScoringStrategy(scoring.Strategy)}
+	if len(scoring.SpreadLabels) > 0 {
+		spreadLabels := scoring.SpreadLabels
+		nodeScoring.SpreadLabels = &spreadLabels
+	}
+	return nodeScoring
+}
+
+// newNodeAntiAffinity converts the anti-affinity rules of a HardwareTemplate node group to their hardware plugin API
+// representation
+func newNodeAntiAffinity(rules []hwmgmtv1alpha1.NodeAntiAffinity) *[]hwmgrpluginapi.NodeAntiAffinity {
+	if len(rules) == 0 {
+		return nil
+	}
+
+	antiAffinity := make([]hwmgrpluginapi.NodeAntiAffinity, 0, len(rules))
+	for _, rule := range rules {
+		antiAffinity = append(antiAffinity, hwmgrpluginapi.NodeAntiAffinity{TopologyKey: rule.TopologyKey})
+	}
+	return &antiAffinity
+}
+
+// newNodeTopologySpreadConstraints converts the topology spread constraints of a HardwareTemplate node group to their
+// hardware plugin API representation
+func newNodeTopologySpreadConstraints(
+	constraints []hwmgmtv1alpha1.NodeTopologySpreadConstraint) *[]hwmgrpluginapi.NodeTopologySpreadConstraint {
+	if len(constraints) == 0 {
+		return nil
+	}
+
+	spreadConstraints := make([]hwmgrpluginapi.NodeTopologySpreadConstraint, 0, len(constraints))
+	for _, constraint := range constraints {
+		spreadConstraints = append(spreadConstraints, hwmgrpluginapi.NodeTopologySpreadConstraint{
+			TopologyKey: constraint.TopologyKey,
+			MaxSkew:     constraint.MaxSkew,
+		})
+	}
+	return &spreadConstraints
+}
+
 // newNodeGroup populates NodeGroup
 func newNodeGroup(group hwmgrpluginapi.NodeGroupData, roleCounts map[string]int) hwmgrpluginapi.NodeGroup {
 	var nodeGroup hwmgrpluginapi.NodeGroup
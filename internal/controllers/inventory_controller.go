@@ -59,19 +59,24 @@ import (
 //+kubebuilder:rbac:groups="",resources=serviceaccounts,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterroles,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=clusterrolebindings,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=roles,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups="rbac.authorization.k8s.io",resources=rolebindings,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="cluster.open-cluster-management.io",resources=managedclusters,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=secrets,verbs=get;delete;list;watch;update
 //+kubebuilder:rbac:groups="",resources=persistentvolumeclaims,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups="",resources=pods,verbs=get;list;watch
 //+kubebuilder:rbac:groups="config.openshift.io",resources=clusterversions,verbs=get;list;watch
 //+kubebuilder:rbac:urls="/internal/v1/caas-alerts/alertmanager",verbs=create;post
+//+kubebuilder:rbac:urls="/internal/v1/hardware-alerts/*",verbs=create;post
+//+kubebuilder:rbac:urls="/internal/v1/hardware-inventory/*",verbs=create;post
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/nodeClusterTypes",verbs=get;list
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/nodeClusters",verbs=get;list
+//+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/clusterResources",verbs=get;list
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/alarmDictionaries",verbs=get;list
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/nodeClusterTypes/*",verbs=get
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/nodeClusters/*",verbs=get
 //+kubebuilder:rbac:urls="/o2ims-infrastructureCluster/v1/alarmDictionaries/*",verbs=get
-//+kubebuilder:rbac:urls="/hardware-manager/inventory/*",verbs=get;list
+//+kubebuilder:rbac:urls="/hardware-manager/inventory/*",verbs=get;list;create;post
 //+kubebuilder:rbac:groups="batch",resources=cronjobs,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=route.openshift.io,resources=routes,verbs=get;list;watch
 //+kubebuilder:rbac:groups="",resources=events,verbs=create;patch;update
@@ -533,6 +538,16 @@ func (t *reconcilerTask) setupProvisioningServerConfig(ctx context.Context, defa
 		return
 	}
 
+	err = t.createProvisioningServerRole(ctx)
+	if err != nil {
+		t.logger.ErrorContext(
+			ctx,
+			"Failed to create provisioning role",
+			slog.String("error", err.Error()),
+		)
+		return
+	}
+
 	// Create the role binding needed to allow the server to interact with the API server to validate incoming API
 	// requests from clients.
 	err = t.createServerRbacClusterRoleBinding(ctx, ctlrutils.InventoryProvisioningServerName)
@@ -1118,6 +1133,8 @@ func (t *reconcilerTask) createResourceServerClusterRole(ctx context.Context) er
 				Verbs: []string{
 					"get",
 					"list",
+					"create",
+					"post",
 				},
 			},
 		},
@@ -1225,6 +1242,59 @@ func (t *reconcilerTask) createProvisioningServerClusterRole(ctx context.Context
 					"delete",
 				},
 			},
+			// The dry-run of a provisioning request reads its templates and the applied resources, and
+			// validates the rendered ClusterInstance with a server-side dry-run.
+			{
+				APIGroups: []string{
+					"clcm.openshift.io",
+				},
+				Resources: []string{
+					"clustertemplates",
+					"hardwaretemplates",
+					"hardwareplugins",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"configmaps",
+					"namespaces",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"siteconfig.open-cluster-management.io",
+				},
+				Resources: []string{
+					"clusterinstances",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+					"patch",
+				},
+			},
+			{
+				NonResourceURLs: []string{
+					"/hardware-manager/provisioning/*",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
 		},
 	}
 
@@ -1235,6 +1305,61 @@ func (t *reconcilerTask) createProvisioningServerClusterRole(ctx context.Context
 	return nil
 }
 
+// createProvisioningServerRole creates the role and role binding that let the provisioning server read the secrets
+// of the hardware plugins, which authenticate the dry-run requests sent to the plugins. The access is restricted to the
+// namespace of the hardware plugins rather than granted through the provisioning server cluster role.
+func (t *reconcilerTask) createProvisioningServerRole(ctx context.Context) error {
+	name := fmt.Sprintf("%s-%s", t.object.Namespace, ctlrutils.InventoryProvisioningServerName)
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ctlrutils.GetHwMgrPluginNS(),
+		},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"secrets",
+				},
+				Verbs: []string{
+					"get",
+				},
+			},
+		},
+	}
+
+	if err := ctlrutils.CreateK8sCR(ctx, t.client, role, t.object, ctlrutils.UPDATE); err != nil {
+		return fmt.Errorf("failed to create Provisioning Server role: %w", err)
+	}
+
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ctlrutils.GetHwMgrPluginNS(),
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     "Role",
+			Name:     name,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      rbacv1.ServiceAccountKind,
+				Namespace: t.object.Namespace,
+				Name:      ctlrutils.InventoryProvisioningServerName,
+			},
+		},
+	}
+
+	if err := ctlrutils.CreateK8sCR(ctx, t.client, binding, t.object, ctlrutils.UPDATE); err != nil {
+		return fmt.Errorf("failed to create Provisioning Server role binding: %w", err)
+	}
+
+	return nil
+}
+
 func (t *reconcilerTask) createAlarmServerClusterRole(ctx context.Context) error {
 	role := &rbacv1.ClusterRole{
 		ObjectMeta: metav1.ObjectMeta{
@@ -1256,6 +1381,19 @@ func (t *reconcilerTask) createAlarmServerClusterRole(ctx context.Context) error
 					"watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					"clcm.openshift.io",
+				},
+				Resources: []string{
+					"provisioningrequests",
+				},
+				Verbs: []string{
+					"get",
+					"list",
+					"watch",
+				},
+			},
 			{
 				APIGroups: []string{
 					"",
@@ -1349,6 +1487,7 @@ func (t *reconcilerTask) createAlarmServerClusterRole(ctx context.Context) error
 				NonResourceURLs: []string{
 					"/o2ims-infrastructureCluster/v1/nodeClusterTypes",
 					"/o2ims-infrastructureCluster/v1/nodeClusters",
+					"/o2ims-infrastructureCluster/v1/clusterResources",
 					"/o2ims-infrastructureCluster/v1/alarmDictionaries",
 				},
 				Verbs: []string{
@@ -1611,18 +1750,6 @@ func (t *reconcilerTask) deployServer(ctx context.Context, serverName string) (c
 		},
 	}...)
 
-	// Server specific env var
-	if serverName == ctlrutils.InventoryAlarmServerName {
-		postgresImage := os.Getenv(constants.PostgresImageName)
-		if postgresImage == "" {
-			return "", fmt.Errorf("missing %s environment variable value", constants.PostgresImageName)
-		}
-		envVars = append(envVars, corev1.EnvVar{
-			Name:  constants.PostgresImageName,
-			Value: postgresImage,
-		})
-	}
-
 	// Build the deployment's spec.
 	deploymentSpec := appsv1.DeploymentSpec{
 		Replicas: k8sptr.To(int32(1)),
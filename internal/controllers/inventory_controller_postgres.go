@@ -217,10 +217,11 @@ func (t *reconcilerTask) createPasswords(ctx context.Context, serverName string)
 	if errors.IsNotFound(err) {
 		// Does not already exist; create it.
 		err = ctlrutils.CreateSecretFromLiterals(ctx, t.client, t.object, t.object.Namespace, passwordSecretName, map[string][]byte{
-			ctlrutils.AdminPasswordEnvName:     []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.AdminPasswordEnvName)),
-			ctlrutils.AlarmsPasswordEnvName:    []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.AlarmsPasswordEnvName)),
-			ctlrutils.ResourcesPasswordEnvName: []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ResourcesPasswordEnvName)),
-			ctlrutils.ClustersPasswordEnvName:  []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ClustersPasswordEnvName)),
+			ctlrutils.AdminPasswordEnvName:        []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.AdminPasswordEnvName)),
+			ctlrutils.AlarmsPasswordEnvName:       []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.AlarmsPasswordEnvName)),
+			ctlrutils.ResourcesPasswordEnvName:    []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ResourcesPasswordEnvName)),
+			ctlrutils.ClustersPasswordEnvName:     []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ClustersPasswordEnvName)),
+			ctlrutils.ProvisioningPasswordEnvName: []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ProvisioningPasswordEnvName)),
 		})
 		if err != nil {
 			return fmt.Errorf("failed to create passwords: %w", err)
@@ -243,6 +244,9 @@ func (t *reconcilerTask) createPasswords(ctx context.Context, serverName string)
 		if _, ok := existing.Data[ctlrutils.ClustersPasswordEnvName]; !ok {
 			existing.Data[ctlrutils.ClustersPasswordEnvName] = []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ClustersPasswordEnvName))
 		}
+		if _, ok := existing.Data[ctlrutils.ProvisioningPasswordEnvName]; !ok {
+			existing.Data[ctlrutils.ProvisioningPasswordEnvName] = []byte(ctlrutils.GetPasswordOrRandom(ctlrutils.ProvisioningPasswordEnvName))
+		}
 
 		err = ctlrutils.CreateK8sCR(ctx, t.client, &existing, t.object, ctlrutils.UPDATE)
 		if err != nil {
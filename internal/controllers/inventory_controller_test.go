@@ -26,6 +26,11 @@ Test Cases in this file:
      * Artifacts server
      * Provisioning server
    - Validates complete inventory service deployment
+
+3. "Provisioning server reads secrets only in the hardware plugin namespace"
+   - Creates an Inventory resource and verifies the provisioning server cluster role grants no access to secrets
+   - Checks that a role bound to the provisioning server service account grants it the secrets of the
+     hardware plugin namespace
 */
 
 package controllers
@@ -42,6 +47,7 @@ import (
 	openshiftoperatorv1 "github.com/openshift/api/operator/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -294,5 +300,57 @@ var _ = Describe("Inventory Controller", func() {
 				Expect(err).ToNot(HaveOccurred())
 			},
 		),
+		Entry(
+			"Provisioning server reads secrets only in the hardware plugin namespace",
+			[]client.Object{
+				&inventoryv1alpha1.Inventory{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:              "oran-o2ims-sample-1",
+						Namespace:         ctlrutils.InventoryNamespace,
+						CreationTimestamp: metav1.Now(),
+					},
+					Spec: inventoryv1alpha1.InventorySpec{
+						Image: &ServerTestImage,
+					},
+				},
+			},
+			reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Namespace: ctlrutils.InventoryNamespace,
+					Name:      "oran-o2ims-sample-1",
+				},
+			},
+			func(result ctrl.Result, reconciler *Reconciler) {
+				name := ctlrutils.InventoryNamespace + "-" + ctlrutils.InventoryProvisioningServerName
+
+				clusterRole := &rbacv1.ClusterRole{}
+				err := reconciler.Client.Get(context.TODO(), types.NamespacedName{Name: name}, clusterRole)
+				Expect(err).ToNot(HaveOccurred())
+				for _, rule := range clusterRole.Rules {
+					Expect(rule.Resources).ToNot(ContainElement("secrets"))
+				}
+
+				role := &rbacv1.Role{}
+				err = reconciler.Client.Get(context.TODO(),
+					types.NamespacedName{Name: name, Namespace: ctlrutils.GetHwMgrPluginNS()}, role)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(role.Rules).To(ConsistOf(rbacv1.PolicyRule{
+					APIGroups: []string{""},
+					Resources: []string{"secrets"},
+					Verbs:     []string{"get"},
+				}))
+
+				binding := &rbacv1.RoleBinding{}
+				err = reconciler.Client.Get(context.TODO(),
+					types.NamespacedName{Name: name, Namespace: ctlrutils.GetHwMgrPluginNS()}, binding)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(binding.RoleRef.Name).To(Equal(name))
+				Expect(binding.Subjects).To(ConsistOf(rbacv1.Subject{
+					Kind:      rbacv1.ServiceAccountKind,
+					Namespace: ctlrutils.InventoryNamespace,
+					Name:      ctlrutils.InventoryProvisioningServerName,
+				}))
+			},
+		),
 	)
 })
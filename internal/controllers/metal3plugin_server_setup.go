@@ -280,6 +280,26 @@ func (t *reconcilerTask) createMetal3PluginServerClusterRole(ctx context.Context
 					"delete",
 				},
 			},
+			// Hardware alerts
+			{
+				NonResourceURLs: []string{
+					"/internal/v1/hardware-alerts/*",
+				},
+				Verbs: []string{
+					"create",
+					"post",
+				},
+			},
+			// Hardware inventory notifications
+			{
+				NonResourceURLs: []string{
+					"/internal/v1/hardware-inventory/*",
+				},
+				Verbs: []string{
+					"create",
+					"post",
+				},
+			},
 		},
 	}
 
@@ -96,7 +96,7 @@ func (t *provisioningRequestReconcilerTask) updateConfigurationAppliedStatus(
 	defer func() {
 		t.object.Status.Extensions.Policies = targetPolicies
 		// Update the current policy status.
-		if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		if updateErr := t.updateStatus(ctx); updateErr != nil {
 			err = fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 		} else {
 			err = nil
@@ -231,7 +231,7 @@ func (t *provisioningRequestReconcilerTask) updateZTPStatus(ctx context.Context,
 		}
 	}
 
-	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err := t.updateStatus(ctx); err != nil {
 		return fmt.Errorf("failed to update the ZTP status for ProvisioningRequest %s: %w", t.object.Name, err)
 	}
 	return nil
@@ -12,6 +12,7 @@ import (
 	"log/slog"
 	"slices"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -58,11 +59,20 @@ func (t *provisioningRequestReconcilerTask) buildClusterInstance(
 		return nil, fmt.Errorf("failed to build unstructured ClusterInstance %s: %w", t.clusterInput.clusterInstanceData["clusterName"].(string), err)
 	}
 
-	// Create the ClusterInstance namespace if it does not exist.
+	// Create the ClusterInstance namespace if it does not exist. A dry-run does not create it,
+	// and cannot validate the ClusterInstance with the API server until it exists.
 	ciName := renderedCIUnstructured.GetName()
-	err = t.createClusterInstanceNamespace(ctx, ciName)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create cluster namespace %s: %w", ciName, err)
+	namespaceExists := true
+	if t.dryRun {
+		namespaceExists, err = ctlrutils.DoesK8SResourceExist(ctx, t.client, ciName, "", &corev1.Namespace{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get cluster namespace %s: %w", ciName, err)
+		}
+	} else {
+		err = t.createClusterInstanceNamespace(ctx, ciName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create cluster namespace %s: %w", ciName, err)
+		}
 	}
 
 	// We want to add the disable-auto-import annotation to the
@@ -89,6 +99,14 @@ func (t *provisioningRequestReconcilerTask) buildClusterInstance(
 		if !t.isHardwareProvisionSkipped() {
 			nodesInfo := extractNodeDetails(existingCIUnstructured)
 			assignNodeDetails(renderedCIUnstructured, nodesInfo)
+
+			// Keep the nodes removed from a provisioned cluster until they are drained
+			if ctlrutils.IsClusterProvisionCompleted(t.object) {
+				if err = t.retainRemovedNodes(existingCIUnstructured, renderedCIUnstructured); err != nil {
+					return nil, fmt.Errorf("failed to retain the removed nodes in the rendered ClusterInstance (%s): %w",
+						ciName, err)
+				}
+			}
 		}
 	}
 
@@ -104,10 +122,12 @@ func (t *provisioningRequestReconcilerTask) buildClusterInstance(
 	// ClusterInstance CRD will be applied by the APIserver after the dry-run.
 	// NOTE: ClusterInstance immutable field validation is handled by ACM 2.13+
 	// admission webhook, so no additional validation is needed here.
-	isDryRun := true
-	err = t.applyClusterInstance(ctx, renderedCIUnstructured, isDryRun)
-	if err != nil {
-		return nil, fmt.Errorf("failed to validate the rendered ClusterInstance with dry-run: %w", err)
+	if namespaceExists {
+		isDryRun := true
+		err = t.applyClusterInstance(ctx, renderedCIUnstructured, isDryRun)
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate the rendered ClusterInstance with dry-run: %w", err)
+		}
 	}
 
 	// Convert unstructured to siteconfig.ClusterInstance type
@@ -268,7 +288,7 @@ func (t *provisioningRequestReconcilerTask) checkClusterProvisionStatus(
 	t.updateClusterInstanceProcessedStatus(clusterInstance)
 	t.updateClusterProvisionStatus(clusterInstance)
 
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -303,9 +323,12 @@ func (t *provisioningRequestReconcilerTask) applyClusterInstance(ctx context.Con
 	// any modifications to the original object that might be used elsewhere.
 	patchObj := unstructuredObj.DeepCopy()
 
-	// Set controller reference to ensure proper ownership and enable watch functionality
-	if err := ctrl.SetControllerReference(t.object, patchObj, t.client.Scheme()); err != nil {
-		return fmt.Errorf("failed to set controller reference: %w", err)
+	// Set controller reference to ensure proper ownership and enable watch functionality.
+	// A ProvisioningRequest planned with dry-run before it is created cannot own the ClusterInstance.
+	if !isDryRun || t.object.UID != "" {
+		if err := ctrl.SetControllerReference(t.object, patchObj, t.client.Scheme()); err != nil {
+			return fmt.Errorf("failed to set controller reference: %w", err)
+		}
 	}
 
 	// Build Server-Side Apply Options
@@ -607,10 +630,10 @@ func extractNodeDetails(existingCI *unstructured.Unstructured) map[string]nodeIn
 			}
 		}
 
-		hostRef, ok := nodeMap["hostRef"].(map[string]string)
+		hostRef, ok := nodeMap["hostRef"].(map[string]any)
 		if ok {
-			extractedNodeInfo.HwMgrNodeId = hostRef["name"]
-			extractedNodeInfo.HwMgrNodeNs = hostRef["namespace"]
+			extractedNodeInfo.HwMgrNodeId, _ = hostRef["name"].(string)
+			extractedNodeInfo.HwMgrNodeNs, _ = hostRef["namespace"].(string)
 		}
 
 		// Extract interface macAddress by interface name
@@ -671,7 +694,7 @@ func assignNodeDetails(renderedCI *unstructured.Unstructured, nodesInfo map[stri
 				}
 			}
 			if extractedNode.HwMgrNodeId != "" && extractedNode.HwMgrNodeNs != "" {
-				nodeMap["hostRef"] = map[string]string{
+				nodeMap["hostRef"] = map[string]any{
 					"name":      extractedNode.HwMgrNodeId,
 					"namespace": extractedNode.HwMgrNodeNs,
 				}
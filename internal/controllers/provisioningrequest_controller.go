@@ -50,6 +50,10 @@ type provisioningRequestReconcilerTask struct {
 	ctDetails      *clusterTemplateDetails
 	timeouts       *timeouts
 	callbackConfig *ctlrutils.NarCallbackConfig
+	// dryRun makes the task render the resources of the request without creating or updating anything
+	dryRun bool
+	// reconcileStartTime is the start time of the reconciliation, given to the provisioning phases it starts
+	reconcileStartTime time.Time
 }
 
 // clusterInput holds the merged input data for a cluster
@@ -170,13 +174,24 @@ func (r *ProvisioningRequestReconciler) Reconcile(
 
 	// Create and run the task:
 	task := &provisioningRequestReconcilerTask{
-		logger:         r.Logger,
-		client:         r.Client,
-		object:         object,
-		clusterInput:   &clusterInput{},
-		ctDetails:      &clusterTemplateDetails{},
-		timeouts:       &timeouts{},
-		callbackConfig: r.CallbackConfig,
+		logger:             r.Logger,
+		client:             r.Client,
+		object:             object,
+		clusterInput:       &clusterInput{},
+		ctDetails:          &clusterTemplateDetails{},
+		timeouts:           &timeouts{},
+		callbackConfig:     r.CallbackConfig,
+		reconcileStartTime: startTime,
+	}
+	// The dry-runs don't provision anything, so they are not recorded in the provisioning timeline
+	if object.GetAnnotations()[provisioningv1alpha1.DryRunAnnotation] == "true" {
+		task.dryRun = true
+		result, err = task.runDryRun(ctx)
+		return
+	}
+	if err = task.clearDryRunResult(ctx); err != nil {
+		result = requeueWithShortInterval()
+		return
 	}
 	result, err = task.run(ctx)
 	return
@@ -285,6 +300,12 @@ func (t *provisioningRequestReconcilerTask) executeHardwareProvisioningPhase(ctx
 		return result, errors.New("hwpluginClient is not initialized")
 	}
 
+	// Track the nodes added to or removed from a provisioned cluster
+	if err := t.handleNodeScaling(ctx, unstructuredClusterInstance); err != nil {
+		ctlrutils.LogError(ctx, t.logger, "Node scaling handling failed", err)
+		return requeueWithShortInterval(), err
+	}
+
 	res, proceed, err := t.handleNodeAllocationRequestProvisioning(ctx, unstructuredClusterInstance)
 	if err != nil || (res == doNotRequeue() && !proceed) || res.RequeueAfter > 0 {
 		if err != nil {
@@ -368,7 +389,7 @@ func (t *provisioningRequestReconcilerTask) handlePostProvisioning(ctx context.C
 	}
 
 	// Check if we need to requeue for ongoing operations
-	if !ctlrutils.IsClusterProvisionCompleted(t.object) || requeueForConfig {
+	if !ctlrutils.IsClusterProvisionCompleted(t.object) || requeueForConfig || t.isNodeScalingInProgress() {
 		return requeueWithLongInterval(), nil
 	}
 
@@ -412,7 +433,7 @@ func (t *provisioningRequestReconcilerTask) checkOverallProvisioningTimeout(ctx
 			ctlrutils.SetProvisioningStateFailed(t.object,
 				fmt.Sprintf("Overall provisioning timed out after %v", overallTimeout))
 
-			if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+			if err := t.updateStatus(ctx); err != nil {
 				t.logger.WarnContext(ctx, "Failed to update status for overall provisioning timeout", slog.String("error", err.Error()))
 			}
 			return requeueWithMediumInterval()
@@ -439,7 +460,7 @@ func (t *provisioningRequestReconcilerTask) checkOverallProvisioningTimeout(ctx
 				ctlrutils.SetProvisioningStateFailed(t.object,
 					fmt.Sprintf("Cluster installation timed out after %v", t.timeouts.clusterProvisioning))
 
-				if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+				if err := t.updateStatus(ctx); err != nil {
 					t.logger.WarnContext(ctx, "Failed to update status for cluster installation timeout", slog.String("error", err.Error()))
 				}
 				return requeueWithMediumInterval()
@@ -461,7 +482,7 @@ func (t *provisioningRequestReconcilerTask) checkOverallProvisioningTimeout(ctx
 				ctlrutils.SetProvisioningStateFailed(t.object,
 					fmt.Sprintf("Cluster configuration timed out after %v", t.timeouts.clusterConfiguration))
 
-				if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+				if err := t.updateStatus(ctx); err != nil {
 					t.logger.WarnContext(ctx, "Failed to update status for cluster configuration timeout", slog.String("error", err.Error()))
 				}
 				return requeueWithMediumInterval()
@@ -488,6 +509,15 @@ func (t *provisioningRequestReconcilerTask) initializeHardwarePluginIfNeeded(ctx
 
 // handleClusterUpgrades handles cluster upgrade logic
 func (t *provisioningRequestReconcilerTask) handleClusterUpgrades(ctx context.Context, clusterName string) (ctrl.Result, error) {
+	// A rollback of a failed upgrade is requested, typically by a FleetUpgrade
+	if _, ok := t.object.GetAnnotations()[provisioningv1alpha1.UpgradeRollbackAnnotation]; ok {
+		rollbackCtrlResult, proceed, err := t.handleUpgradeRollback(ctx, clusterName)
+		if rollbackCtrlResult.RequeueAfter > 0 || !proceed || err != nil {
+			return rollbackCtrlResult, err
+		}
+		return ctrl.Result{}, nil
+	}
+
 	shouldUpgrade, err := t.IsUpgradeRequested(ctx, clusterName)
 	if err != nil {
 		result, _ := requeueWithError(err)
@@ -543,7 +573,7 @@ func (t *provisioningRequestReconcilerTask) checkHardwareProvisioningTimeout(ctx
 	ctlrutils.SetProvisioningStateFailed(t.object,
 		fmt.Sprintf("Hardware provisioning timed out after %v", t.timeouts.hardwareProvisioning))
 
-	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err := t.updateStatus(ctx); err != nil {
 		t.logger.WarnContext(ctx, "Failed to update status for hardware provisioning timeout", slog.String("error", err.Error()))
 	}
 
@@ -557,7 +587,7 @@ func (t *provisioningRequestReconcilerTask) handlePreProvisioning(ctx context.Co
 	// Set the provisioning state to pending if spec changes are observed
 	if t.object.Status.ObservedGeneration != t.object.Generation {
 		ctlrutils.SetProvisioningStatePending(t.object, ctlrutils.ValidationMessage)
-		if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		if updateErr := t.updateStatus(ctx); updateErr != nil {
 			t.logger.WarnContext(ctx, "Status update failed, will retry", slog.String("error", updateErr.Error()))
 			return nil, requeueWithShortInterval(), fmt.Errorf(
 				"failed to update status for ProvisioningRequest %s: %w",
@@ -863,7 +893,7 @@ func (t *provisioningRequestReconcilerTask) checkClusterInstallationTimeout(ctx
 
 		// Attempt to persist the timeout status, but don't fail if it doesn't work
 		// The next reconciliation will detect the timeout again if status update fails
-		if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		if updateErr := t.updateStatus(ctx); updateErr != nil {
 			t.logger.WarnContext(ctx, "Failed to update timeout status, will retry next reconciliation",
 				slog.String("error", updateErr.Error()),
 				slog.String("name", t.object.Name))
@@ -894,7 +924,7 @@ func (t *provisioningRequestReconcilerTask) checkResourcePreparationStatus(ctx c
 		}
 	}
 
-	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err := t.updateStatus(ctx); err != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
 	}
 	return nil
@@ -923,7 +953,7 @@ func (t *provisioningRequestReconcilerTask) handleValidation(ctx context.Context
 		)
 	}
 
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -961,7 +991,7 @@ func (t *provisioningRequestReconcilerTask) handleRenderClusterInstance(ctx cont
 		)
 	}
 
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return nil, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -1001,7 +1031,7 @@ func (t *provisioningRequestReconcilerTask) handleClusterResources(ctx context.C
 			"Cluster resources applied",
 		)
 	}
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -1040,7 +1070,7 @@ func (t *provisioningRequestReconcilerTask) renderHardwareTemplate(ctx context.C
 		)
 	}
 
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return nil, fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -1277,7 +1307,7 @@ func (t *provisioningRequestReconcilerTask) finalizeProvisioningIfComplete(ctx c
 			return err
 		}
 
-		if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		if err := t.updateStatus(ctx); err != nil {
 			return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
 		}
 
@@ -1444,7 +1474,7 @@ func (t *provisioningRequestReconcilerTask) resetHardwareTimers() bool {
 
 func (t *provisioningRequestReconcilerTask) resetHardwareTimersAndPersist(ctx context.Context) error {
 	if t.resetHardwareTimers() {
-		if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		if err := t.updateStatus(ctx); err != nil {
 			return fmt.Errorf("failed to persist NAR timer reset: %w", err)
 		}
 	}
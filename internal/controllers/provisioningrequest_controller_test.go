@@ -87,6 +87,7 @@ Hardware Plugin Integration (Mock-Based):
 
 Upgrade Management:
 - handleUpgrade: IBU creation and monitoring
+- handleUpgradeRollback: rollback IBGU creation, monitoring and cleanup, finalized upgrades
 - IBGU status checking (progressing, failed, completed)
 - Upgrade timeout and failure scenarios
 - Version validation and compatibility checking
@@ -168,6 +169,7 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -3152,6 +3154,301 @@ plan:
 			})
 		})
 
+		Describe("handleUpgradeRollback", func() {
+			var (
+				testPR      *provisioningv1alpha1.ProvisioningRequest
+				rollbackKey types.NamespacedName
+			)
+
+			BeforeEach(func() {
+				clusterNamespace := &corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterName,
+					},
+				}
+
+				// The cluster was upgraded with version v2.0.0 of the ClusterTemplate
+				upgradedTemplate := clusterTemplate.DeepCopy()
+				upgradedTemplate.Name = "test-template.v2.0.0"
+				upgradedTemplate.ResourceVersion = ""
+
+				testPR = cr.DeepCopy()
+				testPR.Name = clusterName
+				testPR.ResourceVersion = ""
+				testPR.Annotations = map[string]string{
+					provisioningv1alpha1.UpgradeRollbackAnnotation: "v2.0.0",
+				}
+
+				c = fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					testPR, clusterTemplate, upgradedTemplate, upgradeDefaults, clusterNamespace,
+				).WithStatusSubresource(&provisioningv1alpha1.ProvisioningRequest{}).Build()
+				reconciler.Client = c
+				task.client = c
+				task.object = testPR
+
+				rollbackKey = types.NamespacedName{Name: getRollbackIBGUName(clusterName), Namespace: clusterName}
+			})
+
+			Context("when the upgrade IBGU booted the new release", func() {
+				BeforeEach(func() {
+					upgradeIBGU := &ibgu.ImageBasedGroupUpgrade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      clusterName,
+							Namespace: clusterName,
+						},
+						Spec: ibgu.ImageBasedGroupUpgradeSpec{
+							Plan: []ibgu.PlanItem{
+								{
+									Actions:         []string{ibgu.Prep, ibgu.Upgrade},
+									RolloutStrategy: ibgu.RolloutStrategy{MaxConcurrency: 5, Timeout: 60},
+								},
+							},
+						},
+						Status: ibgu.ImageBasedGroupUpgradeStatus{
+							Clusters: []ibgu.ClusterState{
+								{
+									Name:             clusterName,
+									CompletedActions: []ibgu.ActionMessage{{Action: ibgu.Prep}, {Action: ibgu.Upgrade}},
+								},
+							},
+						},
+					}
+					Expect(c.Create(ctx, upgradeIBGU)).To(Succeed())
+				})
+
+				It("should create a rollback IBGU and set status to InProgress", func() {
+					result, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+					Expect(result.RequeueAfter).To(Equal(requeueWithMediumInterval().RequeueAfter))
+
+					rollbackIBGU := &ibgu.ImageBasedGroupUpgrade{}
+					Expect(c.Get(ctx, rollbackKey, rollbackIBGU)).To(Succeed())
+					Expect(rollbackIBGU.Spec.Plan).To(HaveLen(1))
+					Expect(rollbackIBGU.Spec.Plan[0].Actions).To(Equal([]string{ibgu.Rollback, ibgu.FinalizeRollback}))
+					Expect(rollbackIBGU.Spec.Plan[0].RolloutStrategy.MaxConcurrency).To(Equal(5))
+
+					upgradeCond := meta.FindStatusCondition(task.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+					Expect(upgradeCond).ToNot(BeNil())
+					Expect(upgradeCond.Status).To(Equal(metav1.ConditionFalse))
+					Expect(upgradeCond.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.InProgress)))
+					Expect(upgradeCond.Message).To(Equal("Upgrade rollback is in progress"))
+				})
+			})
+
+			Context("when the upgrade IBGU did not boot the new release", func() {
+				BeforeEach(func() {
+					upgradeIBGU := &ibgu.ImageBasedGroupUpgrade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      clusterName,
+							Namespace: clusterName,
+						},
+						Spec: ibgu.ImageBasedGroupUpgradeSpec{
+							Plan: []ibgu.PlanItem{{Actions: []string{ibgu.Prep}}},
+						},
+						Status: ibgu.ImageBasedGroupUpgradeStatus{
+							Clusters: []ibgu.ClusterState{
+								{
+									Name:          clusterName,
+									FailedActions: []ibgu.ActionMessage{{Action: ibgu.Prep, Message: "pre-cache failed"}},
+								},
+							},
+						},
+					}
+					Expect(c.Create(ctx, upgradeIBGU)).To(Succeed())
+				})
+
+				It("should create a rollback IBGU that aborts the upgrade", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+
+					rollbackIBGU := &ibgu.ImageBasedGroupUpgrade{}
+					Expect(c.Get(ctx, rollbackKey, rollbackIBGU)).To(Succeed())
+					Expect(rollbackIBGU.Spec.Plan).To(HaveLen(1))
+					Expect(rollbackIBGU.Spec.Plan[0].Actions).To(Equal([]string{ibgu.Abort}))
+				})
+			})
+
+			Context("when the completed upgrade IBGU was deleted", func() {
+				BeforeEach(func() {
+					utils.SetStatusCondition(&task.object.Status.Conditions,
+						provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+						provisioningv1alpha1.CRconditionReasons.Completed,
+						metav1.ConditionTrue,
+						"Upgrade is completed",
+					)
+
+					// The upgrade is not finalized, so that the previous release is kept
+					notFinalized := upgradeDefaults.DeepCopy()
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(upgradeDefaults), notFinalized)).To(Succeed())
+					notFinalized.Data[utils.UpgradeDefaultsConfigmapKey] = strings.Replace(
+						notFinalized.Data[utils.UpgradeDefaultsConfigmapKey], `- actions: ["FinalizeUpgrade"]`, "", 1)
+					Expect(c.Update(ctx, notFinalized)).To(Succeed())
+				})
+
+				It("should render the rollback IBGU from the upgrade defaults of the upgraded version", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+
+					rollbackIBGU := &ibgu.ImageBasedGroupUpgrade{}
+					Expect(c.Get(ctx, rollbackKey, rollbackIBGU)).To(Succeed())
+					Expect(rollbackIBGU.Spec.IBUSpec.SeedImageRef.Version).To(Equal("4.17.0"))
+					Expect(rollbackIBGU.Spec.Plan[0].Actions).To(Equal([]string{ibgu.Rollback, ibgu.FinalizeRollback}))
+				})
+			})
+
+			Context("when the completed upgrade was finalized", func() {
+				BeforeEach(func() {
+					utils.SetStatusCondition(&task.object.Status.Conditions,
+						provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+						provisioningv1alpha1.CRconditionReasons.Completed,
+						metav1.ConditionTrue,
+						"Upgrade is completed",
+					)
+				})
+
+				It("should fail the rollback without creating a rollback IBGU", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+
+					Expect(c.Get(ctx, rollbackKey, &ibgu.ImageBasedGroupUpgrade{})).ToNot(Succeed())
+					Expect(task.object.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+					Expect(task.object.Status.ProvisioningStatus.ProvisioningDetails).To(ContainSubstring("can no longer be rolled back"))
+					upgradeCond := meta.FindStatusCondition(task.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+					Expect(upgradeCond.Status).To(Equal(metav1.ConditionTrue))
+				})
+			})
+
+			Context("when the upgrade IBGU finalized the upgrade", func() {
+				BeforeEach(func() {
+					Expect(c.Create(ctx, &ibgu.ImageBasedGroupUpgrade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      clusterName,
+							Namespace: clusterName,
+						},
+						Status: ibgu.ImageBasedGroupUpgradeStatus{
+							Clusters: []ibgu.ClusterState{
+								{
+									Name: clusterName,
+									CompletedActions: []ibgu.ActionMessage{
+										{Action: ibgu.Prep}, {Action: ibgu.Upgrade}, {Action: ibgu.FinalizeUpgrade},
+									},
+								},
+							},
+						},
+					})).To(Succeed())
+				})
+
+				It("should fail the rollback without creating a rollback IBGU", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+
+					Expect(c.Get(ctx, rollbackKey, &ibgu.ImageBasedGroupUpgrade{})).ToNot(Succeed())
+					Expect(task.object.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+				})
+			})
+
+			Context("when the cluster upgrade was never started", func() {
+				It("should remove the annotation and proceed", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeTrue())
+
+					Expect(c.Get(ctx, rollbackKey, &ibgu.ImageBasedGroupUpgrade{})).ToNot(Succeed())
+
+					updatedPR := &provisioningv1alpha1.ProvisioningRequest{}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(testPR), updatedPR)).To(Succeed())
+					Expect(updatedPR.Annotations).ToNot(HaveKey(provisioningv1alpha1.UpgradeRollbackAnnotation))
+				})
+			})
+
+			Context("when the rollback IBGU has failed", func() {
+				BeforeEach(func() {
+					rollbackIBGU := &ibgu.ImageBasedGroupUpgrade{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      rollbackKey.Name,
+							Namespace: rollbackKey.Namespace,
+						},
+						Status: ibgu.ImageBasedGroupUpgradeStatus{
+							Clusters: []ibgu.ClusterState{
+								{
+									Name:          clusterName,
+									FailedActions: []ibgu.ActionMessage{{Action: ibgu.Rollback, Message: "rollback failed"}},
+								},
+							},
+							Conditions: []metav1.Condition{
+								{
+									Type:   "Progressing",
+									Status: metav1.ConditionFalse,
+								},
+							},
+						},
+					}
+					Expect(c.Create(ctx, rollbackIBGU)).To(Succeed())
+				})
+
+				It("should set status to Failed and not proceed", func() {
+					result, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeFalse())
+					Expect(result.RequeueAfter).To(BeZero())
+
+					Expect(task.object.Status.ProvisioningStatus.ProvisioningPhase).To(Equal(provisioningv1alpha1.StateFailed))
+					upgradeCond := meta.FindStatusCondition(task.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+					Expect(upgradeCond).ToNot(BeNil())
+					Expect(upgradeCond.Reason).To(Equal(string(provisioningv1alpha1.CRconditionReasons.Failed)))
+					Expect(upgradeCond.Message).To(ContainSubstring("Action Rollback failed: rollback failed"))
+				})
+			})
+
+			Context("when the rollback IBGU is completed", func() {
+				BeforeEach(func() {
+					for _, name := range []string{clusterName, rollbackKey.Name} {
+						Expect(c.Create(ctx, &ibgu.ImageBasedGroupUpgrade{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      name,
+								Namespace: clusterName,
+							},
+							Status: ibgu.ImageBasedGroupUpgradeStatus{
+								Conditions: []metav1.Condition{
+									{
+										Type:   "Progressing",
+										Status: metav1.ConditionFalse,
+									},
+								},
+							},
+						})).To(Succeed())
+					}
+					utils.SetStatusCondition(&task.object.Status.Conditions,
+						provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+						provisioningv1alpha1.CRconditionReasons.Completed,
+						metav1.ConditionTrue,
+						"Upgrade is completed",
+					)
+				})
+
+				It("should delete the IBGUs, clear the upgrade state and proceed", func() {
+					_, proceed, err := task.handleUpgradeRollback(ctx, clusterName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(proceed).To(BeTrue())
+
+					Expect(c.Get(ctx, types.NamespacedName{Name: clusterName, Namespace: clusterName},
+						&ibgu.ImageBasedGroupUpgrade{})).ToNot(Succeed())
+					Expect(c.Get(ctx, rollbackKey, &ibgu.ImageBasedGroupUpgrade{})).ToNot(Succeed())
+
+					updatedPR := &provisioningv1alpha1.ProvisioningRequest{}
+					Expect(c.Get(ctx, client.ObjectKeyFromObject(testPR), updatedPR)).To(Succeed())
+					Expect(updatedPR.Annotations).ToNot(HaveKey(provisioningv1alpha1.UpgradeRollbackAnnotation))
+					Expect(meta.FindStatusCondition(updatedPR.Status.Conditions,
+						string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))).To(BeNil())
+				})
+			})
+		})
+
 		Describe("IBGU Status Helper Functions", func() {
 			Context("isIBGUProgressing behavior", func() {
 				Context("when IBGU has Progressing condition True", func() {
@@ -0,0 +1,162 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	hwmgrpluginapi "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/provisioning"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/dryrun"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// DryRunProvisioningRequest validates a ProvisioningRequest and renders its ClusterInstance, NodeAllocationRequest
+// and policy ConfigMaps, without creating or updating anything. The ProvisioningRequest does not need to exist;
+// when it does, its status locates the applied NodeAllocationRequest. Errors caused by the content of the
+// ProvisioningRequest or of its templates are input errors.
+func DryRunProvisioningRequest(ctx context.Context, c client.Client, logger *slog.Logger,
+	object *provisioningv1alpha1.ProvisioningRequest) (*dryrun.Plan, error) {
+	task := &provisioningRequestReconcilerTask{
+		logger:       logger,
+		client:       c,
+		object:       object,
+		clusterInput: &clusterInput{},
+		ctDetails:    &clusterTemplateDetails{},
+		timeouts:     &timeouts{},
+		dryRun:       true,
+	}
+	return task.buildDryRunPlan(ctx)
+}
+
+// runDryRun performs the dry-run requested through the dry-run annotation, and records its outcome in the status.
+func (t *provisioningRequestReconcilerTask) runDryRun(ctx context.Context) (ctrl.Result, error) {
+	plan, err := t.buildDryRunPlan(ctx)
+	if err != nil {
+		ctlrutils.LogError(ctx, t.logger, "Dry-run of the ProvisioningRequest failed", err,
+			slog.String("name", t.object.Name))
+		t.object.Status.Extensions.DryRun = nil
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.DryRunCompleted,
+			provisioningv1alpha1.CRconditionReasons.Failed,
+			metav1.ConditionFalse,
+			"Dry-run failed: "+err.Error(),
+		)
+	} else {
+		t.logger.InfoContext(ctx, "Completed the dry-run of the ProvisioningRequest",
+			slog.String("name", t.object.Name))
+		t.object.Status.Extensions.DryRun = &provisioningv1alpha1.DryRunResult{
+			ObservedGeneration: t.object.Generation,
+			Resources:          plan.Resources,
+		}
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.DryRunCompleted,
+			provisioningv1alpha1.CRconditionReasons.Completed,
+			metav1.ConditionTrue,
+			"Dry-run completed, no resources were created or updated",
+		)
+	}
+
+	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+		return requeueWithShortInterval(), fmt.Errorf(
+			"failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
+	}
+
+	if err != nil && !ctlrutils.IsInputError(err) {
+		// internal error that might recover - requeue to allow recovery
+		return requeueWithMediumInterval(), err
+	}
+	return doNotRequeue(), nil
+}
+
+// clearDryRunResult removes the outcome of a previous dry-run from the status, once the dry-run annotation is removed.
+func (t *provisioningRequestReconcilerTask) clearDryRunResult(ctx context.Context) error {
+	if t.object.Status.Extensions.DryRun == nil &&
+		meta.FindStatusCondition(t.object.Status.Conditions,
+			string(provisioningv1alpha1.PRconditionTypes.DryRunCompleted)) == nil {
+		return nil
+	}
+
+	t.object.Status.Extensions.DryRun = nil
+	meta.RemoveStatusCondition(&t.object.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.DryRunCompleted))
+	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+	}
+	return nil
+}
+
+// buildDryRunPlan runs the validation and rendering steps of the provisioning, and compares the rendered
+// resources with the applied ones.
+func (t *provisioningRequestReconcilerTask) buildDryRunPlan(ctx context.Context) (*dryrun.Plan, error) {
+	if err := t.validateProvisioningRequestCR(ctx); err != nil {
+		return nil, fmt.Errorf("failed to validate the ProvisioningRequest: %w", err)
+	}
+
+	renderedClusterInstance, err := t.buildClusterInstance(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to render and validate ClusterInstance: %w", err)
+	}
+	unstructuredClusterInstance, err := ctlrutils.ConvertToUnstructured(*renderedClusterInstance)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert ClusterInstance to unstructured: %w", err)
+	}
+
+	plan := &dryrun.Plan{ClusterInstance: unstructuredClusterInstance}
+	resource, err := dryrun.DiffClusterInstance(ctx, t.client, unstructuredClusterInstance)
+	if err != nil {
+		return nil, err
+	}
+	plan.Resources = append(plan.Resources, resource)
+
+	if !t.isHardwareProvisionSkipped() {
+		if t.getNodeAllocationRequestID() != "" && t.hwpluginClient == nil {
+			hwclient, err := getHardwarePluginClient(ctx, t.client, t.logger, t.object)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get HardwarePlugin client: %w", err)
+			}
+			t.hwpluginClient = hwmgrpluginapi.NewHardwarePluginClientAdapter(hwclient)
+		}
+
+		plan.NodeAllocationRequest, err = t.handleRenderHardwareTemplate(ctx, unstructuredClusterInstance)
+		if err != nil {
+			return nil, fmt.Errorf("failed to render the Hardware template: %w", err)
+		}
+		resource, err = dryrun.DiffNodeAllocationRequest(ctx, t.hwpluginClient, t.getNodeAllocationRequestID(),
+			plan.NodeAllocationRequest)
+		if err != nil {
+			return nil, err
+		}
+		plan.Resources = append(plan.Resources, resource)
+	}
+
+	clusterLabels := renderedClusterInstance.Spec.ExtraLabels["ManagedCluster"]
+	if err := checkClusterLabelsForPolicies(renderedClusterInstance.Name, clusterLabels); err != nil {
+		return nil, fmt.Errorf("failed to check cluster labels: %w", err)
+	}
+	if len(t.clusterInput.policyTemplateData) != 0 {
+		policyTemplateConfigMap, err := t.buildPolicyTemplateConfigMap(renderedClusterInstance.Name)
+		if err != nil {
+			return nil, err
+		}
+		plan.PolicyConfigMaps = append(plan.PolicyConfigMaps, policyTemplateConfigMap)
+
+		resource, err = dryrun.DiffConfigMap(ctx, t.client, policyTemplateConfigMap)
+		if err != nil {
+			return nil, err
+		}
+		plan.Resources = append(plan.Resources, resource)
+	}
+
+	return plan, nil
+}
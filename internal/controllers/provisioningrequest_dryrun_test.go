@@ -0,0 +1,331 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Test Cases for ProvisioningRequest Dry-Run
+
+This file contains unit tests for the dry-run of ProvisioningRequests, which validates and renders
+the resources of a ProvisioningRequest without creating or updating them.
+
+Test Suites:
+
+1. DryRunProvisioningRequest - Tests for rendering the resources of a ProvisioningRequest:
+   • Renders the resources to create without creating them
+   • Reports the applied resources that would be updated, with their diff
+   • Reports an input error for invalid template parameters
+
+2. ProvisioningRequest dry-run annotation - Tests for the dry-run requested through the annotation:
+   • Records the dry-run result in the status without provisioning
+   • Clears the dry-run result once the annotation is removed
+*/
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	testutils "github.com/openshift-kni/oran-o2ims/test/utils"
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
+)
+
+var _ = Describe("DryRunProvisioningRequest", func() {
+	var (
+		c            client.Client
+		ctx          context.Context
+		pr           *provisioningv1alpha1.ProvisioningRequest
+		tName        = "clustertemplate-a"
+		tVersion     = "v1.0.0"
+		ctNamespace  = "clustertemplate-a-v4-16"
+		ciDefaultsCm = "clusterinstance-defaults-v1"
+		ptDefaultsCm = "policytemplate-defaults-v1"
+		hwTemplate   = "hwTemplate-v1"
+		prName       = "cluster-1"
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+
+		clusterInstanceCRD, err := utils.BuildTestClusterInstanceCRD(utils.TestClusterInstanceSpecOk)
+		Expect(err).ToNot(HaveOccurred())
+		pr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       prName,
+				Finalizers: []string{provisioningv1alpha1.ProvisioningRequestFinalizer},
+			},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateName:    tName,
+				TemplateVersion: tVersion,
+				TemplateParameters: runtime.RawExtension{
+					Raw: []byte(testutils.TestFullTemplateParameters),
+				},
+			},
+		}
+		crs := []client.Object{
+			// Cluster Template Namespace.
+			&corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: ctNamespace,
+				},
+			},
+			// Cluster Template.
+			&provisioningv1alpha1.ClusterTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      GetClusterTemplateRefName(tName, tVersion),
+					Namespace: ctNamespace,
+				},
+				Spec: provisioningv1alpha1.ClusterTemplateSpec{
+					Name:       tName,
+					Version:    tVersion,
+					TemplateID: "57b39bda-ac56-4143-9b10-d1a71517d04f",
+					Templates: provisioningv1alpha1.Templates{
+						ClusterInstanceDefaults: ciDefaultsCm,
+						PolicyTemplateDefaults:  ptDefaultsCm,
+						HwTemplate:              hwTemplate,
+					},
+					TemplateParameterSchema: runtime.RawExtension{Raw: []byte(testutils.TestFullTemplateSchema)},
+				},
+			},
+			// ConfigMaps.
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ciDefaultsCm,
+					Namespace: ctNamespace,
+				},
+				Data: map[string]string{
+					utils.ClusterInstanceTemplateDefaultsConfigmapKey: `
+clusterImageSetNameRef: "4.15"
+pullSecretRef:
+  name: "pull-secret"
+templateRefs:
+- name: "ai-cluster-templates-v1"
+  namespace: "siteconfig-operator"
+nodes:
+- hostName: "node1"
+  role: master
+  ironicInspect: ""
+  automatedCleaningMode: "disabled"
+  bootMode: "UEFI"
+  nodeNetwork:
+    interfaces:
+    - name: eno1
+      label: bootable-interface
+    - name: eth0
+      label: base-interface
+    - name: eth1
+      label: data-interface
+  templateRefs:
+  - name: "ai-node-templates-v1"
+    namespace: "siteconfig-operator"
+`,
+				},
+			},
+			&corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      ptDefaultsCm,
+					Namespace: ctNamespace,
+				},
+				Data: map[string]string{
+					utils.PolicyTemplateDefaultsConfigmapKey: `
+cpu-isolated: "2-31"
+cpu-reserved: "0-1"
+defaultHugepagesSize: "1G"`,
+				},
+			},
+			// Hardware template.
+			&hwmgmtv1alpha1.HardwareTemplate{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      hwTemplate,
+					Namespace: utils.InventoryNamespace,
+				},
+				Spec: hwmgmtv1alpha1.HardwareTemplateSpec{
+					HardwarePluginRef:           utils.UnitTestHwPluginRef,
+					BootInterfaceLabel:          "bootable-interface",
+					HardwareProvisioningTimeout: "1m",
+					NodeGroupData: []hwmgmtv1alpha1.NodeGroupData{
+						{
+							Name:           "controller",
+							Role:           "master",
+							ResourcePoolId: "xyz",
+							HwProfile:      "profile-spr-single-processor-64G",
+						},
+						{
+							Name:           "worker",
+							Role:           "worker",
+							ResourcePoolId: "xyz",
+							HwProfile:      "profile-spr-dual-processor-128G",
+						},
+					},
+				},
+			},
+			// Pull secret.
+			&corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "pull-secret",
+					Namespace: ctNamespace,
+				},
+			},
+			// ClusterInstance CRD.
+			clusterInstanceCRD,
+		}
+
+		c = getFakeClientFromObjects(crs...)
+
+		// Validate the ClusterTemplate.
+		ctReconciler := &ClusterTemplateReconciler{
+			Client: c,
+			Logger: logger,
+		}
+		_, err = ctReconciler.Reconcile(ctx, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      GetClusterTemplateRefName(tName, tVersion),
+				Namespace: ctNamespace,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("Renders the resources to create without creating them", func() {
+		plan, err := DryRunProvisioningRequest(ctx, c, logger, pr)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(plan.ClusterInstance).ToNot(BeNil())
+		Expect(plan.ClusterInstance.GetKind()).To(Equal(siteconfig.ClusterInstanceKind))
+		Expect(plan.NodeAllocationRequest).ToNot(BeNil())
+		Expect(plan.NodeAllocationRequest.NodeGroup).To(HaveLen(2))
+		Expect(plan.PolicyConfigMaps).To(HaveLen(1))
+
+		clusterName := plan.ClusterInstance.GetName()
+		Expect(plan.PolicyConfigMaps[0].Name).To(Equal(clusterName + "-pg"))
+		Expect(plan.PolicyConfigMaps[0].Namespace).To(Equal("ztp-" + ctNamespace))
+
+		Expect(plan.Resources).To(HaveLen(3))
+		for _, resource := range plan.Resources {
+			Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionCreate))
+			Expect(resource.Diff).To(BeEmpty())
+		}
+
+		// Nothing was created.
+		exists, err := utils.DoesK8SResourceExist(ctx, c, clusterName, "", &corev1.Namespace{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		exists, err = utils.DoesK8SResourceExist(ctx, c, clusterName, clusterName, &siteconfig.ClusterInstance{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+		exists, err = utils.DoesK8SResourceExist(
+			ctx, c, clusterName+"-pg", "ztp-"+ctNamespace, &corev1.ConfigMap{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(exists).To(BeFalse())
+	})
+
+	It("Reports the applied resources that would be updated, with their diff", func() {
+		plan, err := DryRunProvisioningRequest(ctx, c, logger, pr)
+		Expect(err).ToNot(HaveOccurred())
+
+		// Apply the rendered policy ConfigMap with different data.
+		applied := plan.PolicyConfigMaps[0].DeepCopy()
+		applied.Data = map[string]string{"cpu-isolated": "0-1"}
+		Expect(c.Create(ctx, &corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{Name: applied.Namespace},
+		})).To(Succeed())
+		Expect(c.Create(ctx, applied)).To(Succeed())
+
+		plan, err = DryRunProvisioningRequest(ctx, c, logger, pr)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(plan.Resources).To(HaveLen(3))
+		resource := plan.Resources[2]
+		Expect(resource.Kind).To(Equal("ConfigMap"))
+		Expect(resource.Name).To(Equal(applied.Name))
+		Expect(resource.Action).To(Equal(provisioningv1alpha1.DryRunActionUpdate))
+		Expect(resource.Diff).To(ContainSubstring("cpu-isolated"))
+
+		// The applied ConfigMap is left untouched.
+		current := &corev1.ConfigMap{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(applied), current)).To(Succeed())
+		Expect(current.Data).To(Equal(applied.Data))
+	})
+
+	It("Reports an input error for invalid template parameters", func() {
+		pr.Spec.TemplateParameters = runtime.RawExtension{Raw: []byte(`{"oCloudSiteId": "local-123"}`)}
+
+		_, err := DryRunProvisioningRequest(ctx, c, logger, pr)
+		Expect(err).To(HaveOccurred())
+		Expect(utils.IsInputError(err)).To(BeTrue())
+	})
+
+	Context("When the dry-run is requested through the annotation", func() {
+		var reconciler *ProvisioningRequestReconciler
+
+		BeforeEach(func() {
+			pr.Annotations = map[string]string{provisioningv1alpha1.DryRunAnnotation: "true"}
+			Expect(c.Create(ctx, pr)).To(Succeed())
+
+			reconciler = &ProvisioningRequestReconciler{
+				Client:         c,
+				Logger:         logger,
+				CallbackConfig: utils.NewNarCallbackConfig(constants.DefaultNarCallbackServicePort),
+			}
+		})
+
+		It("Records the dry-run result in the status without provisioning", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: prName}}
+			result, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(Equal(doNotRequeue()))
+
+			current := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, current)).To(Succeed())
+			condition := meta.FindStatusCondition(current.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.DryRunCompleted))
+			Expect(condition).ToNot(BeNil())
+			Expect(condition.Status).To(Equal(metav1.ConditionTrue))
+			Expect(current.Status.Extensions.DryRun).ToNot(BeNil())
+			Expect(current.Status.Extensions.DryRun.ObservedGeneration).To(Equal(current.Generation))
+			Expect(current.Status.Extensions.DryRun.Resources).To(HaveLen(3))
+
+			// The provisioning did not start.
+			Expect(meta.FindStatusCondition(current.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered))).To(BeNil())
+			Expect(current.Status.Extensions.Timeline).To(BeEmpty())
+			clusterName := current.Status.Extensions.DryRun.Resources[0].Name
+			exists, err := utils.DoesK8SResourceExist(ctx, c, clusterName, "", &corev1.Namespace{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeFalse())
+		})
+
+		It("Clears the dry-run result once the annotation is removed", func() {
+			req := reconcile.Request{NamespacedName: types.NamespacedName{Name: prName}}
+			_, err := reconciler.Reconcile(ctx, req)
+			Expect(err).ToNot(HaveOccurred())
+
+			current := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, req.NamespacedName, current)).To(Succeed())
+			delete(current.Annotations, provisioningv1alpha1.DryRunAnnotation)
+			Expect(c.Update(ctx, current)).To(Succeed())
+
+			_, _ = reconciler.Reconcile(ctx, req)
+
+			Expect(c.Get(ctx, req.NamespacedName, current)).To(Succeed())
+			Expect(current.Status.Extensions.DryRun).To(BeNil())
+			Expect(meta.FindStatusCondition(current.Status.Conditions,
+				string(provisioningv1alpha1.PRconditionTypes.DryRunCompleted))).To(BeNil())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 	"maps"
+	"slices"
 	"strings"
 
 	"k8s.io/apimachinery/pkg/api/equality"
@@ -65,7 +66,7 @@ func (t *provisioningRequestReconcilerTask) createOrUpdateNodeAllocationRequest(
 		currentTime := metav1.Now()
 		t.object.Status.Extensions.NodeAllocationRequestRef.HardwareConfiguringCheckStart = &currentTime
 
-		err = ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object)
+		err = t.updateStatus(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
 		}
@@ -103,7 +104,7 @@ func (t *provisioningRequestReconcilerTask) createNodeAllocationRequestResources
 	currentTime := metav1.Now()
 	t.object.Status.Extensions.NodeAllocationRequestRef.HardwareProvisioningCheckStart = &currentTime
 
-	err = ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object)
+	err = t.updateStatus(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
 	}
@@ -198,7 +199,7 @@ func (t *provisioningRequestReconcilerTask) updateClusterInstance(ctx context.Co
 			"Node configuration has been applied to the rendered ClusterInstance")
 	}
 
-	if updateErr := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); updateErr != nil {
+	if updateErr := t.updateStatus(ctx); updateErr != nil {
 		return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, updateErr)
 	}
 
@@ -313,7 +314,9 @@ func (t *provisioningRequestReconcilerTask) checkNodeAllocationRequestConfigStat
 	return &status, timedOutOrFailed, nil
 }
 
-// applyNodeConfiguration updates the clusterInstance with BMC details, interface MACAddress and bootMACAddress
+// applyNodeConfiguration updates the clusterInstance with BMC details, interface MACAddress and bootMACAddress.
+// A host keeps the AllocatedNode it was assigned, and the other hosts are assigned the free AllocatedNodes of
+// their group. The nodes added to a provisioned cluster are left out until their AllocatedNode is allocated.
 func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 	ctx context.Context,
 	hwNodes map[string][]ctlrutils.NodeInfo,
@@ -323,6 +326,8 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 
 	// Create a map to track unmatched nodes
 	unmatchedNodes := make(map[int]string)
+	// Track the added nodes waiting for their AllocatedNode
+	var pendingNodes []int
 
 	roleToNodeGroupName := getRoleToGroupNameMap(nar.NodeAllocationRequest)
 
@@ -345,28 +350,38 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 		hostName, _, _ := unstructured.NestedString(nodeMap, "hostName")
 		groupName := roleToNodeGroupName[role]
 
-		nodeInfos, exists := hwNodes[groupName]
-		if !exists || len(nodeInfos) == 0 {
+		nodeInfos := hwNodes[groupName]
+		idx := t.findNodeInfoForHost(nodeInfos, hostName)
+		addedNode := t.findNodeScaling(hostName, provisioningv1alpha1.NodeScalingActionAdd)
+		if addedNode != nil && addedNode.State != provisioningv1alpha1.NodeScalingStateAllocating {
+			addedNode = nil
+		}
+		if idx < 0 {
+			if addedNode != nil {
+				pendingNodes = append(pendingNodes, i)
+				continue
+			}
 			unmatchedNodes[i] = hostName
 			continue
 		}
+		nodeInfo := nodeInfos[idx]
 
 		// Make a copy of the nodeMap before mutating
 		updatedNode := maps.Clone(nodeMap)
 
 		// Set BMC info
-		updatedNode["bmcAddress"] = nodeInfos[0].BmcAddress
+		updatedNode["bmcAddress"] = nodeInfo.BmcAddress
 		updatedNode["bmcCredentialsName"] = map[string]interface{}{
-			"name": nodeInfos[0].BmcCredentials,
+			"name": nodeInfo.BmcCredentials,
 		}
 
-		if nodeInfos[0].HwMgrNodeId != "" && nodeInfos[0].HwMgrNodeNs != "" {
+		if nodeInfo.HwMgrNodeId != "" && nodeInfo.HwMgrNodeNs != "" {
 			hostRef, ok := updatedNode["hostRef"].(map[string]interface{})
 			if !ok {
 				hostRef = make(map[string]interface{})
 			}
-			hostRef["name"] = nodeInfos[0].HwMgrNodeId
-			hostRef["namespace"] = nodeInfos[0].HwMgrNodeNs
+			hostRef["name"] = nodeInfo.HwMgrNodeId
+			hostRef["namespace"] = nodeInfo.HwMgrNodeNs
 			updatedNode["hostRef"] = hostRef
 		}
 		// Boot MAC
@@ -383,7 +398,7 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 				return fmt.Errorf("failed to get the HardwareTemplate %s resource: %w ", hwTemplateName, err)
 			}
 			bootInterfaceLabel := hwTemplate.Spec.BootInterfaceLabel
-			bootMAC, err = ctlrutils.GetBootMacAddress(nodeInfos[0].Interfaces, bootInterfaceLabel)
+			bootMAC, err = ctlrutils.GetBootMacAddress(nodeInfo.Interfaces, bootInterfaceLabel)
 			if err != nil {
 				return fmt.Errorf("failed to get boot MAC for node '%s': %w", hostName, err)
 			}
@@ -393,12 +408,19 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 		updatedNode["bootMACAddress"] = bootMAC
 
 		// Assign MACs to interfaces
-		if err := ctlrutils.AssignMacAddress(t.clusterInput.clusterInstanceData, nodeInfos[0].Interfaces, updatedNode); err != nil {
+		if err := ctlrutils.AssignMacAddress(t.clusterInput.clusterInstanceData, nodeInfo.Interfaces, updatedNode); err != nil {
 			return fmt.Errorf("failed to assign MACs for node '%s': %w", hostName, err)
 		}
 
+		// The added node is allocated, its installation follows
+		if addedNode != nil {
+			addedNode.AllocatedNodeID = nodeInfo.NodeID
+			setNodeScalingState(addedNode, provisioningv1alpha1.NodeScalingStateInstalling,
+				"Installing the node and joining it to the cluster")
+		}
+
 		// Update AllocatedNodeHostMap
-		if err := t.updateAllocatedNodeHostMap(ctx, nodeInfos[0].NodeID, hostName); err != nil {
+		if err := t.updateAllocatedNodeHostMap(ctx, nodeInfo.NodeID, hostName); err != nil {
 			return fmt.Errorf("failed to update status for node '%s': %w", hostName, err)
 		}
 
@@ -406,7 +428,12 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 		nodes[i] = updatedNode
 
 		// Consume the nodeInfo
-		hwNodes[groupName] = nodeInfos[1:]
+		hwNodes[groupName] = slices.Delete(slices.Clone(nodeInfos), idx, idx+1)
+	}
+
+	// Leave out the added nodes waiting for their AllocatedNode
+	for _, i := range slices.Backward(pendingNodes) {
+		nodes = slices.Delete(nodes, i, i+1)
 	}
 
 	// Final write back to clusterInstance
@@ -425,6 +452,27 @@ func (t *provisioningRequestReconcilerTask) applyNodeConfiguration(
 	return nil
 }
 
+// findNodeInfoForHost returns the index of the AllocatedNode to assign to a host: the one already assigned
+// to the host, or else the first one not assigned to another host. It returns -1 if there is none.
+func (t *provisioningRequestReconcilerTask) findNodeInfoForHost(nodeInfos []ctlrutils.NodeInfo, hostName string) int {
+	allocatedNodeHostMap := t.object.Status.Extensions.AllocatedNodeHostMap
+	for nodeID, host := range allocatedNodeHostMap {
+		if host != hostName {
+			continue
+		}
+		if idx := slices.IndexFunc(nodeInfos, func(nodeInfo ctlrutils.NodeInfo) bool {
+			return nodeInfo.NodeID == nodeID
+		}); idx >= 0 {
+			return idx
+		}
+	}
+
+	return slices.IndexFunc(nodeInfos, func(nodeInfo ctlrutils.NodeInfo) bool {
+		host, assigned := allocatedNodeHostMap[nodeInfo.NodeID]
+		return !assigned || host == hostName
+	})
+}
+
 func (t *provisioningRequestReconcilerTask) updateAllocatedNodeHostMap(ctx context.Context, allocatedNodeID, hostName string) error {
 
 	if allocatedNodeID == "" || hostName == "" {
@@ -448,7 +496,7 @@ func (t *provisioningRequestReconcilerTask) updateAllocatedNodeHostMap(ctx conte
 	t.object.Status.Extensions.AllocatedNodeHostMap[allocatedNodeID] = hostName
 
 	// Update the CR status for the ProvisioningRequest.
-	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err := t.updateStatus(ctx); err != nil {
 		return fmt.Errorf("failed to update AllocatedNodeHostMap: %w", err)
 	}
 
@@ -474,8 +522,11 @@ func (t *provisioningRequestReconcilerTask) processExistingHardwareCondition(
 		t.object.Status.Extensions.NodeAllocationRequestRef.HardwareConfiguringCheckStart = &currentTime
 	}
 
+	// A node group whose placement constraints cannot be satisfied fails the hardware provisioning
+	failed := reason == string(hwmgmtv1alpha1.Failed) || reason == string(hwmgmtv1alpha1.PlacementUnsatisfiable)
+
 	// Unknown or in progress hardware status, check if it timed out
-	if status != metav1.ConditionTrue && reason != string(hwmgmtv1alpha1.Failed) {
+	if status != metav1.ConditionTrue && !failed {
 		// Handle timeout logic
 		timedOutOrFailed, reason, message = ctlrutils.HandleHardwareTimeout(
 			condition,
@@ -495,11 +546,14 @@ func (t *provisioningRequestReconcilerTask) processExistingHardwareCondition(
 		message = fmt.Sprintf("Hardware %s is in progress", ctlrutils.GetStatusMessage(condition))
 		ctlrutils.SetProvisioningStateInProgress(t.object, message)
 
-		if reason == string(hwmgmtv1alpha1.Failed) || reason == string(hwmgmtv1alpha1.TimedOut) {
+		if failed || reason == string(hwmgmtv1alpha1.TimedOut) {
 			timedOutOrFailed = true
-			if reason == string(hwmgmtv1alpha1.TimedOut) {
+			switch reason {
+			case string(hwmgmtv1alpha1.TimedOut):
 				message = fmt.Sprintf("Hardware %s timed out", ctlrutils.GetStatusMessage(condition))
-			} else {
+			case string(hwmgmtv1alpha1.PlacementUnsatisfiable):
+				message = fmt.Sprintf("Hardware %s failed: %s", ctlrutils.GetStatusMessage(condition), hwCondition.Message)
+			default:
 				message = fmt.Sprintf("Hardware %s failed", ctlrutils.GetStatusMessage(condition))
 			}
 			ctlrutils.SetProvisioningStateFailed(t.object, message)
@@ -577,7 +631,7 @@ func (t *provisioningRequestReconcilerTask) updateHardwareStatus(
 		nodeAllocationRequestID, ctlrutils.GetStatusMessage(condition), message))
 
 	// Update the CR status for the ProvisioningRequest.
-	if err = ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err = t.updateStatus(ctx); err != nil {
 		err = fmt.Errorf("failed to update Hardware %s status: %w", ctlrutils.GetStatusMessage(condition), err)
 	}
 
@@ -693,13 +747,19 @@ func (t *provisioningRequestReconcilerTask) buildNodeAllocationRequest(clusterIn
 	nodeGroups := []hwmgrpluginapi.NodeGroup{}
 	for _, group := range hwTemplate.Spec.NodeGroupData {
 		ngd := hwmgrpluginapi.NodeGroupData{
-			HwProfile:        group.HwProfile,
-			Name:             group.Name,
-			ResourceGroupId:  group.ResourcePoolId,
-			ResourceSelector: group.ResourceSelector,
-			Role:             group.Role,
+			HwProfile:                 group.HwProfile,
+			Name:                      group.Name,
+			ResourceGroupId:           group.ResourcePoolId,
+			ResourceSelector:          group.ResourceSelector,
+			Role:                      group.Role,
+			Scoring:                   newNodeScoring(group.Scoring),
+			AntiAffinity:              newNodeAntiAffinity(group.AntiAffinity),
+			TopologySpreadConstraints: newNodeTopologySpreadConstraints(group.TopologySpreadConstraints),
 		}
 		nodeGroup := newNodeGroup(ngd, roleCounts)
+		if nodesToRemove := t.getNodesToRemove(group.Role); len(nodesToRemove) > 0 {
+			nodeGroup.NodesToRemove = &nodesToRemove
+		}
 		nodeGroups = append(nodeGroups, nodeGroup)
 	}
 
@@ -715,8 +775,6 @@ func (t *provisioningRequestReconcilerTask) buildNodeAllocationRequest(clusterIn
 		return nil, fmt.Errorf("failed to get %s from templateParameters: %w", ctlrutils.TemplateParamNodeClusterName, err)
 	}
 
-	callbackURL := t.callbackConfig.BuildCallbackURL(t.object.Name)
-
 	nodeAllocationRequest := &hwmgrpluginapi.NodeAllocationRequest{}
 	nodeAllocationRequest.Site = siteID.(string)
 	nodeAllocationRequest.ClusterId = clusterId.(string)
@@ -724,12 +782,15 @@ func (t *provisioningRequestReconcilerTask) buildNodeAllocationRequest(clusterIn
 	nodeAllocationRequest.BootInterfaceLabel = hwTemplate.Spec.BootInterfaceLabel
 	nodeAllocationRequest.ConfigTransactionId = t.object.Generation
 
-	// Create callback configuration with the callback URL
-	nodeAllocationRequest.Callback = &hwmgrpluginapi.Callback{
-		CallbackURL: callbackURL,
-		// Note: CaBundleName and AuthClientConfig are optional and can be added later if needed
-		// CaBundleName: nil,
-		// AuthClientConfig: nil,
+	// Create callback configuration with the callback URL. A dry-run outside of the controller has
+	// no callback service.
+	if t.callbackConfig != nil {
+		nodeAllocationRequest.Callback = &hwmgrpluginapi.Callback{
+			CallbackURL: t.callbackConfig.BuildCallbackURL(t.object.Name),
+			// Note: CaBundleName and AuthClientConfig are optional and can be added later if needed
+			// CaBundleName: nil,
+			// AuthClientConfig: nil,
+		}
 	}
 
 	return nodeAllocationRequest, nil
@@ -753,7 +814,7 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 		nodeAllocationRequestID := t.object.Status.Extensions.NodeAllocationRequestRef.NodeAllocationRequestID
 		if _, err := t.checkExistingNodeAllocationRequest(ctx, hwTemplate, nodeAllocationRequestID); err != nil {
 			if ctlrutils.IsInputError(err) {
-				updateErr := ctlrutils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwmgmtv1alpha1.Validation),
+				updateErr := t.updateHardwareTemplateValidation(ctx, hwTemplate,
 					provisioningv1alpha1.ConditionReason(hwmgmtv1alpha1.Failed), metav1.ConditionFalse, err.Error())
 				if updateErr != nil {
 					// nolint: wrapcheck
@@ -766,7 +827,7 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 
 	hwplugin := &hwmgmtv1alpha1.HardwarePlugin{}
 	if err := t.client.Get(ctx, types.NamespacedName{Namespace: ctlrutils.GetHwMgrPluginNS(), Name: hwTemplate.Spec.HardwarePluginRef}, hwplugin); err != nil {
-		updateErr := ctlrutils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwmgmtv1alpha1.Validation),
+		updateErr := t.updateHardwareTemplateValidation(ctx, hwTemplate,
 			provisioningv1alpha1.ConditionReason(hwmgmtv1alpha1.Failed), metav1.ConditionFalse,
 			"Unable to find specified HardwarePlugin: "+hwTemplate.Spec.HardwarePluginRef)
 		if updateErr != nil {
@@ -776,7 +837,7 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 	}
 
 	// The HardwareTemplate is validated by the CRD schema and no additional validation is needed
-	updateErr := ctlrutils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate, provisioningv1alpha1.ConditionType(hwmgmtv1alpha1.Validation),
+	updateErr := t.updateHardwareTemplateValidation(ctx, hwTemplate,
 		provisioningv1alpha1.ConditionReason(hwmgmtv1alpha1.Completed), metav1.ConditionTrue, "Validated")
 	if updateErr != nil {
 		// nolint: wrapcheck
@@ -790,3 +851,15 @@ func (t *provisioningRequestReconcilerTask) handleRenderHardwareTemplate(ctx con
 
 	return nodeAllocationRequest, nil
 }
+
+// updateHardwareTemplateValidation sets the Validation condition of the HardwareTemplate. A dry-run leaves it unchanged.
+func (t *provisioningRequestReconcilerTask) updateHardwareTemplateValidation(ctx context.Context,
+	hwTemplate *hwmgmtv1alpha1.HardwareTemplate, reason provisioningv1alpha1.ConditionReason,
+	status metav1.ConditionStatus, message string) error {
+	if t.dryRun {
+		return nil
+	}
+	// nolint: wrapcheck
+	return ctlrutils.UpdateHardwareTemplateStatusCondition(ctx, t.client, hwTemplate,
+		provisioningv1alpha1.ConditionType(hwmgmtv1alpha1.Validation), reason, status, message)
+}
@@ -1883,6 +1883,107 @@ var _ = Describe("applyNodeConfiguration", func() {
 		// Second node should get second hardware node
 		Expect(masterNode2["bmcAddress"]).To(Equal("192.168.1.102"))
 	})
+
+	It("keeps the hardware node already assigned to a host", func() {
+		hwNodes["controller"] = append(hwNodes["controller"], utils.NodeInfo{
+			BmcAddress:     "192.168.1.102",
+			BmcCredentials: "master-02-bmc-secret",
+			NodeID:         "node-master-02",
+			HwMgrNodeId:    "bmh-master-02",
+			HwMgrNodeNs:    "hardware-ns",
+			Interfaces: []*pluginsv1alpha1.Interface{
+				{
+					Name:       "eno1",
+					MACAddress: "aa:bb:cc:dd:ee:03",
+					Label:      "bootable-interface",
+				},
+				{
+					Name:       "eno2",
+					MACAddress: "aa:bb:cc:dd:ee:04",
+					Label:      "data-interface",
+				},
+			},
+		})
+		cr.Status.Extensions.AllocatedNodeHostMap = map[string]string{"node-master-02": "master-01"}
+
+		err := task.applyNodeConfiguration(ctx, hwNodes, nar, ci)
+		Expect(err).ToNot(HaveOccurred())
+
+		nodes, found, err := unstructured.NestedSlice(ci.Object, "spec", "nodes")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+		masterNode := nodes[0].(map[string]interface{})
+		Expect(masterNode["bmcAddress"]).To(Equal("192.168.1.102"))
+		Expect(hwNodes["controller"]).To(HaveLen(1))
+		Expect(hwNodes["controller"][0].NodeID).To(Equal("node-master-01"))
+	})
+
+	It("leaves out an added node until its hardware node is allocated", func() {
+		cr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+			HostName: "worker-02",
+			Role:     "worker",
+			Action:   provisioningv1alpha1.NodeScalingActionAdd,
+			State:    provisioningv1alpha1.NodeScalingStateAllocating,
+		}}
+		nodes, _, err := unstructured.NestedSlice(ci.Object, "spec", "nodes")
+		Expect(err).ToNot(HaveOccurred())
+		addedNode := map[string]interface{}{
+			"hostName": "worker-02",
+			"role":     "worker",
+			"nodeNetwork": map[string]interface{}{
+				"interfaces": []interface{}{
+					map[string]interface{}{
+						"name":       "eno1",
+						"label":      "bootable-interface",
+						"macAddress": "",
+					},
+				},
+			},
+		}
+		Expect(unstructured.SetNestedSlice(ci.Object, append(nodes, addedNode), "spec", "nodes")).To(Succeed())
+
+		// The hardware nodes are collected again on each reconciliation
+		collectedNodes := func() map[string][]utils.NodeInfo {
+			collected := map[string][]utils.NodeInfo{}
+			for group, nodeInfos := range hwNodes {
+				collected[group] = append([]utils.NodeInfo{}, nodeInfos...)
+			}
+			return collected
+		}
+
+		// The added node has no hardware node yet
+		err = task.applyNodeConfiguration(ctx, collectedNodes(), nar, ci)
+		Expect(err).ToNot(HaveOccurred())
+		nodes, _, err = unstructured.NestedSlice(ci.Object, "spec", "nodes")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodes).To(HaveLen(2))
+		Expect(cr.Status.Extensions.NodeScaling[0].State).To(Equal(provisioningv1alpha1.NodeScalingStateAllocating))
+
+		// The hardware node of the added node is allocated
+		Expect(unstructured.SetNestedSlice(ci.Object, append(nodes, addedNode), "spec", "nodes")).To(Succeed())
+		hwNodes["worker"] = append(hwNodes["worker"], utils.NodeInfo{
+			BmcAddress:     "192.168.1.111",
+			BmcCredentials: "worker-02-bmc-secret",
+			NodeID:         "node-worker-02",
+			HwMgrNodeId:    "bmh-worker-02",
+			HwMgrNodeNs:    "hardware-ns",
+			Interfaces: []*pluginsv1alpha1.Interface{
+				{
+					Name:       "eno1",
+					MACAddress: "aa:bb:cc:dd:ee:12",
+					Label:      "bootable-interface",
+				},
+			},
+		})
+		err = task.applyNodeConfiguration(ctx, collectedNodes(), nar, ci)
+		Expect(err).ToNot(HaveOccurred())
+		nodes, _, err = unstructured.NestedSlice(ci.Object, "spec", "nodes")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodes).To(HaveLen(3))
+		Expect(nodes[2].(map[string]interface{})["bmcAddress"]).To(Equal("192.168.1.111"))
+		Expect(cr.Status.Extensions.NodeScaling[0].State).To(Equal(provisioningv1alpha1.NodeScalingStateInstalling))
+		Expect(cr.Status.Extensions.NodeScaling[0].AllocatedNodeID).To(Equal("node-worker-02"))
+	})
 })
 
 func VerifyHardwareTemplateStatus(ctx context.Context, c client.Client, templateName string, expectedCon metav1.Condition) {
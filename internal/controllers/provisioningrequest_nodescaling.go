@@ -0,0 +1,384 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file tracks the worker nodes added to or removed from a provisioned cluster through the
+// templateParameters of its ProvisioningRequest:
+//
+//   - An added node is Allocating until the hardware plugin allocates a host for it, then Installing
+//     until its Agent joins the cluster.
+//
+//   - A removed node is kept in the ClusterInstance, with its BareMetalHost pruned, while it is Draining
+//     and deleted from the cluster. Once it is gone, the host is Releasing until the hardware plugin
+//     returns it to the pool.
+package controllers
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log/slog"
+	"slices"
+
+	assistedservicev1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
+)
+
+const (
+	// removeAgentAndNodeOnDeleteAnnotation makes the deletion of a BareMetalHost drain and delete its node
+	// from the cluster, and delete its Agent.
+	removeAgentAndNodeOnDeleteAnnotation = "bmac.agent-install.openshift.io/remove-agent-and-node-on-delete"
+	// agentBMHLabel is the label of an Agent holding the name of its BareMetalHost
+	agentBMHLabel = "agent-install.openshift.io/bmh"
+)
+
+// findNodeScaling returns the node scaling status of a host for the given action, or nil if there is none.
+func (t *provisioningRequestReconcilerTask) findNodeScaling(
+	hostName string, action provisioningv1alpha1.NodeScalingAction) *provisioningv1alpha1.NodeScalingStatus {
+	for i := range t.object.Status.Extensions.NodeScaling {
+		nodeScaling := &t.object.Status.Extensions.NodeScaling[i]
+		if nodeScaling.HostName == hostName && nodeScaling.Action == action {
+			return nodeScaling
+		}
+	}
+	return nil
+}
+
+// isNodeScalingInProgress returns true while nodes are being added to or removed from the cluster.
+func (t *provisioningRequestReconcilerTask) isNodeScalingInProgress() bool {
+	return slices.ContainsFunc(t.object.Status.Extensions.NodeScaling,
+		func(nodeScaling provisioningv1alpha1.NodeScalingStatus) bool {
+			return nodeScaling.State != provisioningv1alpha1.NodeScalingStateCompleted &&
+				nodeScaling.State != provisioningv1alpha1.NodeScalingStateFailed
+		})
+}
+
+// setNodeScalingState moves a node to the given state, and returns true if it changed.
+func setNodeScalingState(nodeScaling *provisioningv1alpha1.NodeScalingStatus,
+	state provisioningv1alpha1.NodeScalingState, message string) bool {
+	if nodeScaling.State == state && nodeScaling.Message == message {
+		return false
+	}
+	if nodeScaling.State != state {
+		nodeScaling.LastTransitionTime = metav1.Now()
+	}
+	nodeScaling.State = state
+	nodeScaling.Message = message
+	return true
+}
+
+// handleNodeScaling records the nodes added to or removed from a provisioned cluster, and updates their
+// progress in the ProvisioningRequest status.
+func (t *provisioningRequestReconcilerTask) handleNodeScaling(ctx context.Context,
+	renderedClusterInstance *unstructured.Unstructured) error {
+
+	if t.dryRun || !ctlrutils.IsClusterProvisionCompleted(t.object) {
+		return nil
+	}
+
+	ciName := renderedClusterInstance.GetName()
+	existingCI := &siteconfig.ClusterInstance{}
+	exists, err := ctlrutils.DoesK8SResourceExist(ctx, t.client, ciName, ciName, existingCI)
+	if err != nil {
+		return fmt.Errorf("failed to get ClusterInstance (%s): %w", ciName, err)
+	}
+	if !exists {
+		return nil
+	}
+
+	detected := t.detectNodeScaling(ctx, existingCI)
+	progressed, err := t.updateNodeScalingProgress(ctx, existingCI)
+	if err != nil {
+		return err
+	}
+
+	if detected || progressed {
+		if err := t.updateStatus(ctx); err != nil {
+			return fmt.Errorf("failed to update status for ProvisioningRequest %s: %w", t.object.Name, err)
+		}
+	}
+	return nil
+}
+
+// detectNodeScaling compares the nodes of the ProvisioningRequest with the nodes of the applied
+// ClusterInstance, and records the nodes that are added or removed. It returns true if any node was
+// recorded. The nodes of a previous scaling that is done are forgotten.
+func (t *provisioningRequestReconcilerTask) detectNodeScaling(ctx context.Context,
+	existingCI *siteconfig.ClusterInstance) bool {
+
+	desiredRoles := make(map[string]string)
+	if nodes, ok := t.clusterInput.clusterInstanceData["nodes"].([]any); ok {
+		for _, node := range nodes {
+			nodeMap, ok := node.(map[string]any)
+			if !ok {
+				continue
+			}
+			if hostName, ok := nodeMap["hostName"].(string); ok {
+				desiredRoles[hostName], _ = nodeMap["role"].(string)
+			}
+		}
+	}
+
+	hostNodeIDs := make(map[string]string)
+	for nodeID, hostName := range t.object.Status.Extensions.AllocatedNodeHostMap {
+		hostNodeIDs[hostName] = nodeID
+	}
+
+	now := metav1.Now()
+	var detected []provisioningv1alpha1.NodeScalingStatus
+	existingHosts := make(map[string]bool)
+	for _, node := range existingCI.Spec.Nodes {
+		existingHosts[node.HostName] = true
+		nodeID, provisioned := hostNodeIDs[node.HostName]
+		if _, desired := desiredRoles[node.HostName]; desired || !provisioned ||
+			t.findNodeScaling(node.HostName, provisioningv1alpha1.NodeScalingActionRemove) != nil {
+			continue
+		}
+		detected = append(detected, provisioningv1alpha1.NodeScalingStatus{
+			HostName:           node.HostName,
+			Role:               node.Role,
+			Action:             provisioningv1alpha1.NodeScalingActionRemove,
+			State:              provisioningv1alpha1.NodeScalingStateDraining,
+			AllocatedNodeID:    nodeID,
+			Message:            "Draining the node and deleting it from the cluster",
+			LastTransitionTime: now,
+		})
+	}
+
+	for hostName, role := range desiredRoles {
+		if existingHosts[hostName] ||
+			t.findNodeScaling(hostName, provisioningv1alpha1.NodeScalingActionAdd) != nil {
+			continue
+		}
+		detected = append(detected, provisioningv1alpha1.NodeScalingStatus{
+			HostName:           hostName,
+			Role:               role,
+			Action:             provisioningv1alpha1.NodeScalingActionAdd,
+			State:              provisioningv1alpha1.NodeScalingStateAllocating,
+			Message:            "Waiting for the hardware plugin to allocate a host for the node",
+			LastTransitionTime: now,
+		})
+	}
+
+	if len(detected) == 0 {
+		return false
+	}
+
+	// Sort the nodes, for a stable status
+	slices.SortFunc(detected, func(a, b provisioningv1alpha1.NodeScalingStatus) int {
+		return cmp.Or(cmp.Compare(a.Action, b.Action), cmp.Compare(a.HostName, b.HostName))
+	})
+
+	for _, nodeScaling := range detected {
+		t.logger.InfoContext(ctx, "Detected a node scaling of the provisioned cluster",
+			slog.String("hostName", nodeScaling.HostName),
+			slog.String("action", string(nodeScaling.Action)))
+	}
+
+	nodeScalings := slices.DeleteFunc(t.object.Status.Extensions.NodeScaling,
+		func(nodeScaling provisioningv1alpha1.NodeScalingStatus) bool {
+			return nodeScaling.State == provisioningv1alpha1.NodeScalingStateCompleted ||
+				nodeScaling.State == provisioningv1alpha1.NodeScalingStateFailed
+		})
+	t.object.Status.Extensions.NodeScaling = append(nodeScalings, detected...)
+	return true
+}
+
+// updateNodeScalingProgress moves the nodes being added or removed to their next state, and returns true
+// if any of them changed. The move of an added node from Allocating to Installing is done when its host
+// is assigned in applyNodeConfiguration.
+func (t *provisioningRequestReconcilerTask) updateNodeScalingProgress(ctx context.Context,
+	existingCI *siteconfig.ClusterInstance) (bool, error) {
+
+	var (
+		allocatedNodeIDs map[string]bool
+		agents           *assistedservicev1beta1.AgentList
+		changed          bool
+	)
+
+	for i := range t.object.Status.Extensions.NodeScaling {
+		nodeScaling := &t.object.Status.Extensions.NodeScaling[i]
+
+		switch nodeScaling.State {
+		case provisioningv1alpha1.NodeScalingStateDraining:
+			manifest := findRenderedManifest(existingCI, "BareMetalHost", nodeScaling.HostName)
+			switch {
+			case manifest == nil ||
+				(manifest.Status != siteconfig.ManifestRenderedSuccess &&
+					manifest.Status != siteconfig.ManifestRenderedValidated &&
+					manifest.Status != siteconfig.ManifestPruneFailure):
+				changed = setNodeScalingState(nodeScaling, provisioningv1alpha1.NodeScalingStateReleasing,
+					"Waiting for the hardware plugin to return the host to the pool") || changed
+			case manifest.Status == siteconfig.ManifestPruneFailure:
+				changed = setNodeScalingState(nodeScaling, provisioningv1alpha1.NodeScalingStateFailed,
+					"Failed to delete the node from the cluster: "+manifest.Message) || changed
+			}
+
+		case provisioningv1alpha1.NodeScalingStateReleasing:
+			if allocatedNodeIDs == nil {
+				ids, err := t.getAllocatedNodeIDs(ctx)
+				if err != nil {
+					return changed, err
+				}
+				allocatedNodeIDs = ids
+			}
+			if !allocatedNodeIDs[nodeScaling.AllocatedNodeID] {
+				changed = setNodeScalingState(nodeScaling, provisioningv1alpha1.NodeScalingStateCompleted,
+					"The node was removed and its host was returned to the pool") || changed
+				delete(t.object.Status.Extensions.AllocatedNodeHostMap, nodeScaling.AllocatedNodeID)
+			}
+
+		case provisioningv1alpha1.NodeScalingStateInstalling:
+			if agents == nil {
+				agents = &assistedservicev1beta1.AgentList{}
+				if err := t.client.List(ctx, agents, client.InNamespace(existingCI.Name)); err != nil {
+					return changed, fmt.Errorf("failed to list Agents in the %s namespace: %w", existingCI.Name, err)
+				}
+			}
+			installed := findAgentInstalledCondition(agents, nodeScaling.HostName)
+			switch {
+			case installed == nil:
+				// The Agent of the node has not registered yet
+			case installed.Status == corev1.ConditionTrue:
+				changed = setNodeScalingState(nodeScaling, provisioningv1alpha1.NodeScalingStateCompleted,
+					"The node joined the cluster") || changed
+			case installed.Reason == assistedservicev1beta1.InstallationFailedReason:
+				changed = setNodeScalingState(nodeScaling, provisioningv1alpha1.NodeScalingStateFailed,
+					"Failed to install the node: "+installed.Message) || changed
+			}
+		}
+	}
+
+	return changed, nil
+}
+
+// getAllocatedNodeIDs returns the identifiers of the AllocatedNodes of the NodeAllocationRequest.
+func (t *provisioningRequestReconcilerTask) getAllocatedNodeIDs(ctx context.Context) (map[string]bool, error) {
+	nodeAllocationRequestID := t.getNodeAllocationRequestID()
+	if nodeAllocationRequestID == "" {
+		return nil, fmt.Errorf("missing nodeAllocationRequest identifier")
+	}
+
+	nodes, err := t.hwpluginClient.GetAllocatedNodesFromNodeAllocationRequest(ctx, nodeAllocationRequestID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get AllocatedNodes for NodeAllocationRequest '%s': %w",
+			nodeAllocationRequestID, err)
+	}
+
+	ids := make(map[string]bool)
+	if nodes != nil {
+		for _, node := range *nodes {
+			ids[node.Id] = true
+		}
+	}
+	return ids, nil
+}
+
+// getNodesToRemove returns the AllocatedNodes of the given role whose nodes are deleted from the cluster,
+// for the hardware plugin to release them.
+func (t *provisioningRequestReconcilerTask) getNodesToRemove(role string) []string {
+	var nodesToRemove []string
+	for _, nodeScaling := range t.object.Status.Extensions.NodeScaling {
+		if nodeScaling.Action == provisioningv1alpha1.NodeScalingActionRemove && nodeScaling.Role == role &&
+			nodeScaling.AllocatedNodeID != "" &&
+			(nodeScaling.State == provisioningv1alpha1.NodeScalingStateReleasing ||
+				nodeScaling.State == provisioningv1alpha1.NodeScalingStateCompleted) {
+			nodesToRemove = append(nodesToRemove, nodeScaling.AllocatedNodeID)
+		}
+	}
+	return nodesToRemove
+}
+
+// retainRemovedNodes adds back to the rendered ClusterInstance the provisioned nodes removed from the
+// ProvisioningRequest, until they are deleted from the cluster. The BareMetalHost of a retained node is
+// pruned, and deleting it drains and deletes the node from the cluster.
+func (t *provisioningRequestReconcilerTask) retainRemovedNodes(existingCI, renderedCI *unstructured.Unstructured) error {
+	existingNodes, _, err := unstructured.NestedSlice(existingCI.Object, "spec", "nodes")
+	if err != nil {
+		return fmt.Errorf("failed to extract nodes from the existing ClusterInstance: %w", err)
+	}
+	renderedNodes, ok := renderedCI.Object["spec"].(map[string]any)["nodes"].([]any)
+	if !ok {
+		// Unexpected nodes structure, caught by the dry-run validation
+		return nil
+	}
+
+	renderedHosts := make(map[string]bool)
+	for _, node := range renderedNodes {
+		if nodeMap, ok := node.(map[string]any); ok {
+			if hostName, ok := nodeMap["hostName"].(string); ok {
+				renderedHosts[hostName] = true
+			}
+		}
+	}
+
+	provisionedHosts := make(map[string]bool)
+	for _, hostName := range t.object.Status.Extensions.AllocatedNodeHostMap {
+		provisionedHosts[hostName] = true
+	}
+
+	for _, node := range existingNodes {
+		nodeMap, ok := node.(map[string]any)
+		if !ok {
+			continue
+		}
+		hostName, _ := nodeMap["hostName"].(string)
+		if hostName == "" || renderedHosts[hostName] || !provisionedHosts[hostName] {
+			continue
+		}
+		if nodeScaling := t.findNodeScaling(hostName, provisioningv1alpha1.NodeScalingActionRemove); nodeScaling != nil &&
+			(nodeScaling.State == provisioningv1alpha1.NodeScalingStateReleasing ||
+				nodeScaling.State == provisioningv1alpha1.NodeScalingStateCompleted) {
+			// The node is already deleted from the cluster
+			continue
+		}
+
+		retainedNode := runtime.DeepCopyJSONValue(nodeMap).(map[string]any)
+		if err := unstructured.SetNestedField(retainedNode, "true",
+			"extraAnnotations", "BareMetalHost", removeAgentAndNodeOnDeleteAnnotation); err != nil {
+			return fmt.Errorf("failed to set the annotations of the removed node %s: %w", hostName, err)
+		}
+		retainedNode["pruneManifests"] = []any{
+			map[string]any{"apiVersion": "metal3.io/v1alpha1", "kind": "BareMetalHost"},
+		}
+		renderedNodes = append(renderedNodes, retainedNode)
+	}
+
+	renderedCI.Object["spec"].(map[string]any)["nodes"] = renderedNodes
+	return nil
+}
+
+// findRenderedManifest returns the manifest of the given kind and name rendered for the ClusterInstance,
+// or nil if there is none.
+func findRenderedManifest(ci *siteconfig.ClusterInstance, kind, name string) *siteconfig.ManifestReference {
+	for i := range ci.Status.ManifestsRendered {
+		manifest := &ci.Status.ManifestsRendered[i]
+		if manifest.Kind == kind && manifest.Name == name {
+			return manifest
+		}
+	}
+	return nil
+}
+
+// findAgentInstalledCondition returns the Installed condition of the Agent of a host, or nil if the Agent
+// or its condition does not exist.
+func findAgentInstalledCondition(agents *assistedservicev1beta1.AgentList, hostName string) *conditionsv1.Condition {
+	for i := range agents.Items {
+		agent := &agents.Items[i]
+		if agent.Labels[agentBMHLabel] == hostName || agent.Spec.Hostname == hostName {
+			return conditionsv1.FindStatusCondition(agent.Status.Conditions, assistedservicev1beta1.InstalledCondition)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,341 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Test Cases for ProvisioningRequest Node Scaling
+
+This file contains unit tests for the worker nodes added to or removed from a provisioned cluster.
+
+Test Suites:
+
+1. handleNodeScaling - Tests for the detection and progress of the scaled nodes:
+   • Records the added and removed nodes, forgetting the nodes of a previous scaling
+   • Moves a removed node to Releasing once its BareMetalHost is pruned, then to Completed once released
+   • Moves an added node to Completed once its Agent is installed
+
+2. retainRemovedNodes - Tests for keeping the removed nodes in the ClusterInstance until they are drained
+
+3. buildNodeAllocationRequest - Tests for the AllocatedNodes the hardware plugin must release
+*/
+
+package controllers
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	assistedservicev1beta1 "github.com/openshift/assisted-service/api/v1beta1"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hwmgmtv1alpha1 "github.com/openshift-kni/oran-o2ims/api/hardwaremanagement/v1alpha1"
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	hwmgrpluginapi "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/provisioning"
+	"github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/provisioning/mocks"
+	siteconfig "github.com/stolostron/siteconfig/api/v1alpha1"
+)
+
+var _ = Describe("ProvisioningRequest node scaling", func() {
+	const (
+		clusterName = "cluster-1"
+		narID       = "nar-1"
+	)
+
+	var (
+		ctx      context.Context
+		c        client.Client
+		mockCtrl *gomock.Controller
+		hwplugin *mocks.MockHardwarePluginClientInterface
+		pr       *provisioningv1alpha1.ProvisioningRequest
+		task     *provisioningRequestReconcilerTask
+	)
+
+	// desiredNodes sets the nodes of the ClusterInstance input of the ProvisioningRequest
+	desiredNodes := func(hostNames ...string) {
+		var nodes []any
+		for _, hostName := range hostNames {
+			nodes = append(nodes, map[string]any{"hostName": hostName, "role": "worker"})
+		}
+		task.clusterInput.clusterInstanceData = map[string]any{"nodes": nodes}
+	}
+
+	newClusterInstance := func(hostNames ...string) *siteconfig.ClusterInstance {
+		ci := &siteconfig.ClusterInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName, Namespace: clusterName},
+		}
+		for _, hostName := range hostNames {
+			ci.Spec.Nodes = append(ci.Spec.Nodes, siteconfig.NodeSpec{HostName: hostName, Role: "worker"})
+			ci.Status.ManifestsRendered = append(ci.Status.ManifestsRendered, siteconfig.ManifestReference{
+				Kind: "BareMetalHost", Name: hostName, Status: siteconfig.ManifestRenderedSuccess})
+		}
+		return ci
+	}
+
+	renderedClusterInstance := func() *unstructured.Unstructured {
+		ci := &unstructured.Unstructured{}
+		ci.SetName(clusterName)
+		ci.SetNamespace(clusterName)
+		return ci
+	}
+
+	setup := func(objs ...client.Object) {
+		c = getFakeClientFromObjects(append(objs, pr)...)
+		task = &provisioningRequestReconcilerTask{
+			logger:         logger,
+			client:         c,
+			object:         pr,
+			clusterInput:   &clusterInput{},
+			hwpluginClient: hwplugin,
+		}
+	}
+
+	nodeScaling := func(hostName string) provisioningv1alpha1.NodeScalingStatus {
+		for _, nodeScaling := range pr.Status.Extensions.NodeScaling {
+			if nodeScaling.HostName == hostName {
+				return nodeScaling
+			}
+		}
+		Fail("no node scaling status for " + hostName)
+		return provisioningv1alpha1.NodeScalingStatus{}
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		mockCtrl = gomock.NewController(GinkgoT())
+		hwplugin = mocks.NewMockHardwarePluginClientInterface(mockCtrl)
+		pr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Conditions: []metav1.Condition{{
+					Type:   string(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned),
+					Status: metav1.ConditionTrue,
+					Reason: string(provisioningv1alpha1.CRconditionReasons.Completed),
+				}},
+				Extensions: provisioningv1alpha1.Extensions{
+					NodeAllocationRequestRef: &provisioningv1alpha1.NodeAllocationRequestRef{
+						NodeAllocationRequestID: narID,
+					},
+					AllocatedNodeHostMap: map[string]string{
+						"node-1": "worker-1",
+						"node-2": "worker-2",
+					},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		mockCtrl.Finish()
+	})
+
+	Describe("handleNodeScaling", func() {
+		It("records the added and removed nodes", func() {
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+				HostName: "worker-0", Action: provisioningv1alpha1.NodeScalingActionAdd,
+				State: provisioningv1alpha1.NodeScalingStateCompleted,
+			}}
+			setup(newClusterInstance("worker-1", "worker-2"))
+			desiredNodes("worker-1", "worker-3")
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+
+			Expect(pr.Status.Extensions.NodeScaling).To(HaveLen(2))
+			added := nodeScaling("worker-3")
+			Expect(added.Action).To(Equal(provisioningv1alpha1.NodeScalingActionAdd))
+			Expect(added.State).To(Equal(provisioningv1alpha1.NodeScalingStateAllocating))
+			Expect(added.Role).To(Equal("worker"))
+			removed := nodeScaling("worker-2")
+			Expect(removed.Action).To(Equal(provisioningv1alpha1.NodeScalingActionRemove))
+			Expect(removed.State).To(Equal(provisioningv1alpha1.NodeScalingStateDraining))
+			Expect(removed.AllocatedNodeID).To(Equal("node-2"))
+
+			updated := &provisioningv1alpha1.ProvisioningRequest{}
+			Expect(c.Get(ctx, client.ObjectKeyFromObject(pr), updated)).To(Succeed())
+			Expect(updated.Status.Extensions.NodeScaling).To(HaveLen(2))
+		})
+
+		It("ignores the scaling until the cluster is provisioned", func() {
+			pr.Status.Conditions = nil
+			setup(newClusterInstance("worker-1", "worker-2"))
+			desiredNodes("worker-1")
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(pr.Status.Extensions.NodeScaling).To(BeEmpty())
+		})
+
+		It("releases a removed node once its BareMetalHost is pruned", func() {
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+				HostName: "worker-2", Role: "worker", Action: provisioningv1alpha1.NodeScalingActionRemove,
+				State: provisioningv1alpha1.NodeScalingStateDraining, AllocatedNodeID: "node-2",
+			}}
+			ci := newClusterInstance("worker-1", "worker-2")
+			ci.Status.ManifestsRendered = ci.Status.ManifestsRendered[:1]
+			setup(ci)
+			desiredNodes("worker-1")
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(nodeScaling("worker-2").State).To(Equal(provisioningv1alpha1.NodeScalingStateReleasing))
+			Expect(task.getNodesToRemove("worker")).To(Equal([]string{"node-2"}))
+			Expect(task.getNodesToRemove("master")).To(BeEmpty())
+
+			// The node is still allocated
+			hwplugin.EXPECT().GetAllocatedNodesFromNodeAllocationRequest(gomock.Any(), narID).
+				Return(&[]hwmgrpluginapi.AllocatedNode{{Id: "node-1"}, {Id: "node-2"}}, nil)
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(nodeScaling("worker-2").State).To(Equal(provisioningv1alpha1.NodeScalingStateReleasing))
+
+			// The node is released
+			hwplugin.EXPECT().GetAllocatedNodesFromNodeAllocationRequest(gomock.Any(), narID).
+				Return(&[]hwmgrpluginapi.AllocatedNode{{Id: "node-1"}}, nil)
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(nodeScaling("worker-2").State).To(Equal(provisioningv1alpha1.NodeScalingStateCompleted))
+			Expect(pr.Status.Extensions.AllocatedNodeHostMap).To(Equal(map[string]string{"node-1": "worker-1"}))
+			Expect(task.isNodeScalingInProgress()).To(BeFalse())
+		})
+
+		It("fails a removed node whose BareMetalHost cannot be pruned", func() {
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+				HostName: "worker-2", Role: "worker", Action: provisioningv1alpha1.NodeScalingActionRemove,
+				State: provisioningv1alpha1.NodeScalingStateDraining, AllocatedNodeID: "node-2",
+			}}
+			ci := newClusterInstance("worker-1", "worker-2")
+			ci.Status.ManifestsRendered[1].Status = siteconfig.ManifestPruneFailure
+			ci.Status.ManifestsRendered[1].Message = "timed out"
+			setup(ci)
+			desiredNodes("worker-1")
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			failed := nodeScaling("worker-2")
+			Expect(failed.State).To(Equal(provisioningv1alpha1.NodeScalingStateFailed))
+			Expect(failed.Message).To(Equal("Failed to delete the node from the cluster: timed out"))
+		})
+
+		It("completes an added node once its Agent is installed", func() {
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+				HostName: "worker-2", Role: "worker", Action: provisioningv1alpha1.NodeScalingActionAdd,
+				State: provisioningv1alpha1.NodeScalingStateInstalling, AllocatedNodeID: "node-2",
+			}}
+			agent := &assistedservicev1beta1.Agent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "agent-2", Namespace: clusterName, Labels: map[string]string{agentBMHLabel: "worker-2"},
+				},
+				Status: assistedservicev1beta1.AgentStatus{
+					Conditions: []conditionsv1.Condition{{
+						Type:   assistedservicev1beta1.InstalledCondition,
+						Status: corev1.ConditionFalse,
+						Reason: assistedservicev1beta1.InstallationInProgressReason,
+					}},
+				},
+			}
+			setup(newClusterInstance("worker-1", "worker-2"), agent)
+			desiredNodes("worker-1", "worker-2")
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(nodeScaling("worker-2").State).To(Equal(provisioningv1alpha1.NodeScalingStateInstalling))
+			Expect(task.isNodeScalingInProgress()).To(BeTrue())
+
+			agent.Status.Conditions[0].Status = corev1.ConditionTrue
+			agent.Status.Conditions[0].Reason = assistedservicev1beta1.InstalledReason
+			Expect(c.Update(ctx, agent)).To(Succeed())
+
+			Expect(task.handleNodeScaling(ctx, renderedClusterInstance())).To(Succeed())
+			Expect(nodeScaling("worker-2").State).To(Equal(provisioningv1alpha1.NodeScalingStateCompleted))
+		})
+	})
+
+	Describe("retainRemovedNodes", func() {
+		var existingCI, renderedCI *unstructured.Unstructured
+
+		BeforeEach(func() {
+			existingCI = &unstructured.Unstructured{Object: map[string]any{
+				"spec": map[string]any{"nodes": []any{
+					map[string]any{"hostName": "worker-1", "role": "worker"},
+					map[string]any{"hostName": "worker-2", "role": "worker", "bmcAddress": "192.168.1.2"},
+				}},
+			}}
+			renderedCI = &unstructured.Unstructured{Object: map[string]any{
+				"spec": map[string]any{"nodes": []any{
+					map[string]any{"hostName": "worker-1", "role": "worker"},
+				}},
+			}}
+			setup()
+		})
+
+		It("keeps a removed node, pruning its BareMetalHost", func() {
+			Expect(task.retainRemovedNodes(existingCI, renderedCI)).To(Succeed())
+
+			nodes := renderedCI.Object["spec"].(map[string]any)["nodes"].([]any)
+			Expect(nodes).To(HaveLen(2))
+			Expect(nodes[1]).To(Equal(map[string]any{
+				"hostName":   "worker-2",
+				"role":       "worker",
+				"bmcAddress": "192.168.1.2",
+				"extraAnnotations": map[string]any{
+					"BareMetalHost": map[string]any{removeAgentAndNodeOnDeleteAnnotation: "true"},
+				},
+				"pruneManifests": []any{
+					map[string]any{"apiVersion": "metal3.io/v1alpha1", "kind": "BareMetalHost"},
+				},
+			}))
+		})
+
+		It("drops a removed node once it is deleted from the cluster", func() {
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{{
+				HostName: "worker-2", Action: provisioningv1alpha1.NodeScalingActionRemove,
+				State: provisioningv1alpha1.NodeScalingStateReleasing, AllocatedNodeID: "node-2",
+			}}
+			Expect(task.retainRemovedNodes(existingCI, renderedCI)).To(Succeed())
+			Expect(renderedCI.Object["spec"].(map[string]any)["nodes"]).To(HaveLen(1))
+		})
+
+		It("does not keep the nodes without hardware provisioned by the ProvisioningRequest", func() {
+			pr.Status.Extensions.AllocatedNodeHostMap = nil
+			Expect(task.retainRemovedNodes(existingCI, renderedCI)).To(Succeed())
+			Expect(renderedCI.Object["spec"].(map[string]any)["nodes"]).To(HaveLen(1))
+		})
+	})
+
+	Describe("buildNodeAllocationRequest", func() {
+		It("lists the AllocatedNodes to release in their node group", func() {
+			pr.Spec.TemplateParameters.Raw = []byte(`{"oCloudSiteId": "site-1", "nodeClusterName": "cluster-1"}`)
+			pr.Status.Extensions.NodeScaling = []provisioningv1alpha1.NodeScalingStatus{
+				{HostName: "worker-2", Role: "worker", Action: provisioningv1alpha1.NodeScalingActionRemove,
+					State: provisioningv1alpha1.NodeScalingStateReleasing, AllocatedNodeID: "node-2"},
+				{HostName: "worker-3", Role: "worker", Action: provisioningv1alpha1.NodeScalingActionRemove,
+					State: provisioningv1alpha1.NodeScalingStateDraining, AllocatedNodeID: "node-3"},
+			}
+			setup()
+			ci := &unstructured.Unstructured{Object: map[string]any{
+				"spec": map[string]any{"nodes": []any{
+					map[string]any{"hostName": "master-1", "role": "master"},
+					map[string]any{"hostName": "worker-1", "role": "worker"},
+					map[string]any{"hostName": "worker-3", "role": "worker"},
+				}},
+			}}
+			hwTemplate := &hwmgmtv1alpha1.HardwareTemplate{
+				Spec: hwmgmtv1alpha1.HardwareTemplateSpec{
+					NodeGroupData: []hwmgmtv1alpha1.NodeGroupData{
+						{Name: "controller", Role: "master", HwProfile: "profile-1"},
+						{Name: "worker", Role: "worker", HwProfile: "profile-1"},
+					},
+				},
+			}
+
+			nodeAllocationRequest, err := task.buildNodeAllocationRequest(ci, hwTemplate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(nodeAllocationRequest.NodeGroup).To(HaveLen(2))
+			Expect(nodeAllocationRequest.NodeGroup[0].NodesToRemove).To(BeNil())
+			Expect(nodeAllocationRequest.NodeGroup[1].NodeGroupData.Size).To(Equal(2))
+			Expect(nodeAllocationRequest.NodeGroup[1].NodesToRemove).To(Equal(&[]string{"node-2"}))
+		})
+	})
+})
@@ -176,9 +176,8 @@ func (t *provisioningRequestReconcilerTask) createPoliciesConfigMap(
 	return t.createPolicyTemplateConfigMap(ctx, clusterInstance.Name)
 }
 
-// createPolicyTemplateConfigMap updates the keys of the default ConfigMap to match the
-// clusterTemplate and the cluster version and creates/updates the ConfigMap for the
-// required version of the policy template.
+// createPolicyTemplateConfigMap creates/updates the ConfigMap for the required version
+// of the policy template.
 func (t *provisioningRequestReconcilerTask) createPolicyTemplateConfigMap(
 	ctx context.Context, clusterName string) error {
 
@@ -188,12 +187,28 @@ func (t *provisioningRequestReconcilerTask) createPolicyTemplateConfigMap(
 		return nil
 	}
 
+	policyTemplateConfigMap, err := t.buildPolicyTemplateConfigMap(clusterName)
+	if err != nil {
+		return err
+	}
+
+	if err := ctlrutils.CreateK8sCR(ctx, t.client, policyTemplateConfigMap, t.object, ctlrutils.UPDATE); err != nil {
+		return fmt.Errorf("failed to create Kubernetes CR: %w", err)
+	}
+
+	return nil
+}
+
+// buildPolicyTemplateConfigMap updates the keys of the default ConfigMap to match the
+// clusterTemplate and the cluster version and builds the ConfigMap for the required
+// version of the policy template.
+func (t *provisioningRequestReconcilerTask) buildPolicyTemplateConfigMap(clusterName string) (*corev1.ConfigMap, error) {
 	// Update the keys to match the ClusterTemplate name and the version.
 	finalPolicyTemplateData := make(map[string]string)
 	for key, value := range t.clusterInput.policyTemplateData {
 		data, ok := value.(string)
 		if !ok {
-			return ctlrutils.NewInputError(
+			return nil, ctlrutils.NewInputError(
 				"policyTemplateParameters/policyTemplateSchema for the %s key (%v) is not a string",
 				key, value)
 		}
@@ -203,19 +218,17 @@ func (t *provisioningRequestReconcilerTask) createPolicyTemplateConfigMap(
 	// Put all the data from the mergedPolicyTemplateData in a configMap in the same
 	// namespace as the templated ACM policies.
 	// The namespace is: ztp + <clustertemplate-namespace>
-	policyTemplateConfigMap := &corev1.ConfigMap{
+	return &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-pg", clusterName),
 			Namespace: fmt.Sprintf("ztp-%s", t.ctDetails.namespace),
 		},
 		Data: finalPolicyTemplateData,
-	}
-
-	if err := ctlrutils.CreateK8sCR(ctx, t.client, policyTemplateConfigMap, t.object, ctlrutils.UPDATE); err != nil {
-		return fmt.Errorf("failed to create Kubernetes CR: %w", err)
-	}
-
-	return nil
+	}, nil
 }
 
 // checkClusterLabelsForPolicies checks if the cluster_version
@@ -49,7 +49,7 @@ func (r *ProvisioningRequestReconciler) SetupWithManager(mgr ctrl.Manager) error
 		For(
 			&provisioningv1alpha1.ProvisioningRequest{},
 			// Watch for create and update events for ProvisioningRequest.
-			// Trigger on spec changes OR callback annotation changes.
+			// Trigger on spec changes OR callback, dry-run and upgrade rollback annotation changes.
 			builder.WithPredicates(predicate.Funcs{
 				UpdateFunc: func(e event.UpdateEvent) bool {
 					// Trigger on generation changes (spec updates)
@@ -57,15 +57,17 @@ func (r *ProvisioningRequestReconciler) SetupWithManager(mgr ctrl.Manager) error
 						return true
 					}
 
-					// Trigger on callback annotation changes
+					// Trigger on callback, dry-run and upgrade rollback annotation changes
 					oldAnnotations := e.ObjectOld.GetAnnotations()
 					newAnnotations := e.ObjectNew.GetAnnotations()
 
-					// Check if callback annotations were added or changed
+					// Check if callback, dry-run or upgrade rollback annotations were added or changed
 					callbackAnnotations := []string{
 						ctlrutils.CallbackReceivedAnnotation,
 						ctlrutils.CallbackStatusAnnotation,
 						ctlrutils.CallbackNodeAllocationRequestIdAnnotation,
+						provisioningv1alpha1.DryRunAnnotation,
+						provisioningv1alpha1.UpgradeRollbackAnnotation,
 					}
 
 					for _, annotation := range callbackAnnotations {
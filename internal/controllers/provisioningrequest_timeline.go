@@ -0,0 +1,271 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// This file records the provisioning timeline of a ProvisioningRequest. Each provisioning phase is tracked
+// from the status conditions set by the phase:
+//   - A phase starts when one of its conditions is first set, and is in progress until its last condition is true
+//   - A phase fails when one of its conditions reports a failure or a timeout
+//   - A phase that starts again after it finished, because of a retry or a day-2 change, counts a new attempt
+//
+// The times are those at which the controller observed the transitions. The durations of the finished
+// attempts are also exported as Prometheus histograms, so the phases can be compared across sites.
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// provisioningPhaseDuration records the duration of the finished attempts of the provisioning phases
+var provisioningPhaseDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Name: "o2ims_provisioning_phase_duration_seconds",
+	Help: "Duration of the provisioning phases of the ProvisioningRequests by phase, result and site.",
+	// From one second to about nine hours
+	Buckets: prometheus.ExponentialBuckets(1, 2, 16),
+}, []string{"phase", "result", "site"})
+
+func init() {
+	metrics.Registry.MustRegister(provisioningPhaseDuration)
+}
+
+// timelinePhase maps a provisioning phase to the conditions set by the phase
+type timelinePhase struct {
+	phase provisioningv1alpha1.ProvisioningTimelinePhase
+	// conditionTypes are the conditions set by the phase, the last one being true once the phase is done
+	conditionTypes []provisioningv1alpha1.ConditionType
+	// optionalConditionTypes are only set when the phase is rerun for a day-2 change
+	optionalConditionTypes []provisioningv1alpha1.ConditionType
+}
+
+// timelinePhases are the provisioning phases, in the order they are run
+var timelinePhases = []timelinePhase{
+	{
+		phase: provisioningv1alpha1.TimelinePhaseValidation,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.Validated,
+			provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhaseResourceCreation,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhaseHardwareProvisioning,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.HardwareTemplateRendered,
+			provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhaseHardwareConfiguration,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.HardwareNodeConfigApplied,
+		},
+		optionalConditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.HardwareConfigured,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhaseClusterInstallation,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.ClusterInstanceProcessed,
+			provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhasePolicyConfiguration,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+		},
+	},
+	{
+		phase: provisioningv1alpha1.TimelinePhaseUpgrade,
+		conditionTypes: []provisioningv1alpha1.ConditionType{
+			provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+		},
+	},
+}
+
+// conditionTimelineResult returns the result of a phase reported by one of its conditions, and false when
+// the condition does not show the phase has started
+func conditionTimelineResult(cond *metav1.Condition) (provisioningv1alpha1.ProvisioningTimelineResult, bool) {
+	if cond == nil {
+		return "", false
+	}
+	if cond.Status == metav1.ConditionTrue {
+		return provisioningv1alpha1.TimelineResultSucceeded, true
+	}
+
+	switch provisioningv1alpha1.ConditionReason(cond.Reason) {
+	case provisioningv1alpha1.CRconditionReasons.ClusterNotReady:
+		// The phase is waiting for the cluster to be installed
+		return "", false
+	case provisioningv1alpha1.CRconditionReasons.TimedOut:
+		return provisioningv1alpha1.TimelineResultTimedOut, true
+	case provisioningv1alpha1.CRconditionReasons.Failed, provisioningv1alpha1.CRconditionReasons.NotApplied:
+		return provisioningv1alpha1.TimelineResultFailed, true
+	}
+	return provisioningv1alpha1.TimelineResultInProgress, true
+}
+
+// phaseTimelineResult returns the result of a phase from the conditions of the ProvisioningRequest, and
+// false when the phase has not started
+func (t *provisioningRequestReconcilerTask) phaseTimelineResult(
+	phase timelinePhase) (provisioningv1alpha1.ProvisioningTimelineResult, bool) {
+
+	started := false
+	inProgress := false
+	conditionTypes := append(append([]provisioningv1alpha1.ConditionType{}, phase.conditionTypes...),
+		phase.optionalConditionTypes...)
+	for _, conditionType := range conditionTypes {
+		result, found := conditionTimelineResult(
+			meta.FindStatusCondition(t.object.Status.Conditions, string(conditionType)))
+		if !found {
+			continue
+		}
+		started = true
+		switch result {
+		case provisioningv1alpha1.TimelineResultFailed, provisioningv1alpha1.TimelineResultTimedOut:
+			return result, true
+		case provisioningv1alpha1.TimelineResultInProgress:
+			inProgress = true
+		}
+	}
+	if !started {
+		return "", false
+	}
+
+	// The phase is done once its last condition is true
+	lastCondition := meta.FindStatusCondition(t.object.Status.Conditions,
+		string(phase.conditionTypes[len(phase.conditionTypes)-1]))
+	if inProgress || lastCondition == nil || lastCondition.Status != metav1.ConditionTrue {
+		return provisioningv1alpha1.TimelineResultInProgress, true
+	}
+	return provisioningv1alpha1.TimelineResultSucceeded, true
+}
+
+// isTimelineFailure returns true if the result is a failure of the phase
+func isTimelineFailure(result provisioningv1alpha1.ProvisioningTimelineResult) bool {
+	return result == provisioningv1alpha1.TimelineResultFailed || result == provisioningv1alpha1.TimelineResultTimedOut
+}
+
+// findTimelineEntry returns the timeline entry of a phase, or nil if the phase has not started
+func (t *provisioningRequestReconcilerTask) findTimelineEntry(
+	phase provisioningv1alpha1.ProvisioningTimelinePhase) *provisioningv1alpha1.ProvisioningTimelineEntry {
+
+	for i := range t.object.Status.Extensions.Timeline {
+		if t.object.Status.Extensions.Timeline[i].Phase == phase {
+			return &t.object.Status.Extensions.Timeline[i]
+		}
+	}
+	return nil
+}
+
+// updateTimelineEntry applies the result of a phase observed in this reconciliation to its timeline entry.
+// The phase attempts started by this reconciliation are given its start time. It returns true if an attempt
+// of the phase finished.
+func updateTimelineEntry(entry *provisioningv1alpha1.ProvisioningTimelineEntry,
+	result provisioningv1alpha1.ProvisioningTimelineResult, reconcileStartTime, now metav1.Time) bool {
+
+	switch {
+	case entry.Result == result:
+		return false
+	case entry.Result == provisioningv1alpha1.TimelineResultInProgress:
+		// The attempt finished
+	case isTimelineFailure(entry.Result) && isTimelineFailure(result):
+		// The failure of the attempt changed, such as a timeout reported as a failure
+		entry.Result = result
+		return false
+	default:
+		// The phase finished before and started again
+		entry.Attempts++
+		entry.StartTime = reconcileStartTime
+	}
+
+	entry.Result = result
+	if result == provisioningv1alpha1.TimelineResultInProgress {
+		entry.EndTime = nil
+		return false
+	}
+	entry.EndTime = &now
+	return true
+}
+
+// recordProvisioningTimeline records in the status the progress of the provisioning phases observed in the
+// current reconciliation, and returns the attempts that finished. The phase attempts started by this
+// reconciliation are given its start time.
+func (t *provisioningRequestReconcilerTask) recordProvisioningTimeline() []provisioningv1alpha1.ProvisioningTimelineEntry {
+	now := metav1.Now()
+	startTime := now
+	if !t.reconcileStartTime.IsZero() {
+		startTime = metav1.NewTime(t.reconcileStartTime)
+	}
+
+	var finished []provisioningv1alpha1.ProvisioningTimelineEntry
+	for _, phase := range timelinePhases {
+		result, started := t.phaseTimelineResult(phase)
+		if !started {
+			continue
+		}
+
+		entry := t.findTimelineEntry(phase.phase)
+		if entry == nil {
+			t.object.Status.Extensions.Timeline = append(t.object.Status.Extensions.Timeline,
+				provisioningv1alpha1.ProvisioningTimelineEntry{
+					Phase:     phase.phase,
+					StartTime: startTime,
+					Result:    provisioningv1alpha1.TimelineResultInProgress,
+					Attempts:  1,
+				})
+			entry = &t.object.Status.Extensions.Timeline[len(t.object.Status.Extensions.Timeline)-1]
+		}
+
+		if updateTimelineEntry(entry, result, startTime, now) {
+			finished = append(finished, *entry)
+		}
+	}
+	return finished
+}
+
+// updateStatus updates the status of the ProvisioningRequest, with the provisioning timeline recorded from its
+// conditions. The durations of the attempts that finished are exported once the status is written, so that
+// they are not counted again.
+func (t *provisioningRequestReconcilerTask) updateStatus(ctx context.Context) error {
+	finished := t.recordProvisioningTimeline()
+	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		//nolint:wrapcheck
+		return err
+	}
+
+	site := t.getTimelineSite()
+	for _, entry := range finished {
+		provisioningPhaseDuration.WithLabelValues(string(entry.Phase), string(entry.Result), site).
+			Observe(entry.EndTime.Sub(entry.StartTime.Time).Seconds())
+	}
+	return nil
+}
+
+// getTimelineSite returns the identifier of the site of the cluster, or an empty string if it is not set
+func (t *provisioningRequestReconcilerTask) getTimelineSite() string {
+	siteID, err := provisioningv1alpha1.ExtractMatchingInput(
+		t.object.Spec.TemplateParameters.Raw, ctlrutils.TemplateParamOCloudSiteId)
+	if err != nil {
+		return ""
+	}
+	return fmt.Sprint(siteID)
+}
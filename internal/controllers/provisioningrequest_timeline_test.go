@@ -0,0 +1,203 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+/*
+Test Cases for the ProvisioningRequest Provisioning Timeline
+
+This file contains unit tests for the provisioning timeline recorded in the status of a ProvisioningRequest.
+
+Test Suites:
+
+1. updateStatus - Tests for the phases recorded from the status conditions when the status is updated:
+   • Records a phase started and finished in one reconciliation
+   • Keeps a phase in progress until its last condition is true, then exports its duration
+   • Counts a new attempt for a phase started again after a failure
+   • Does not start the policy configuration while the cluster is not ready
+   • Does not export a finished attempt again on the next status update
+   • Does not export the durations when the status update fails
+*/
+
+package controllers
+
+import (
+	"context"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+var _ = Describe("ProvisioningRequest provisioning timeline", func() {
+	const siteID = "timeline-site"
+
+	var (
+		ctx  context.Context
+		c    client.Client
+		pr   *provisioningv1alpha1.ProvisioningRequest
+		task *provisioningRequestReconcilerTask
+	)
+
+	setCondition := func(conditionType provisioningv1alpha1.ConditionType,
+		reason provisioningv1alpha1.ConditionReason, status metav1.ConditionStatus) {
+		ctlrutils.SetStatusCondition(&pr.Status.Conditions, conditionType, reason, status, "")
+	}
+
+	timelineEntry := func(phase provisioningv1alpha1.ProvisioningTimelinePhase) provisioningv1alpha1.ProvisioningTimelineEntry {
+		for _, entry := range pr.Status.Extensions.Timeline {
+			if entry.Phase == phase {
+				return entry
+			}
+		}
+		Fail("no timeline entry for " + string(phase))
+		return provisioningv1alpha1.ProvisioningTimelineEntry{}
+	}
+
+	observedDurations := func(phase provisioningv1alpha1.ProvisioningTimelinePhase,
+		result provisioningv1alpha1.ProvisioningTimelineResult) uint64 {
+		metric := &dto.Metric{}
+		observer := provisioningPhaseDuration.WithLabelValues(string(phase), string(result), siteID)
+		Expect(observer.(prometheus.Metric).Write(metric)).To(Succeed())
+		return metric.GetHistogram().GetSampleCount()
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		provisioningPhaseDuration.Reset()
+		pr = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				TemplateParameters: runtime.RawExtension{Raw: []byte(`{"oCloudSiteId": "` + siteID + `"}`)},
+			},
+		}
+		c = getFakeClientFromObjects(pr)
+		task = &provisioningRequestReconcilerTask{
+			logger: logger,
+			client: c,
+			object: pr,
+		}
+	})
+
+	It("records a phase started and finished in one reconciliation", func() {
+		reconcileStartTime := time.Now().Add(-time.Second).Truncate(time.Second)
+		setCondition(provisioningv1alpha1.PRconditionTypes.Validated,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterInstanceRendered,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+
+		task.reconcileStartTime = reconcileStartTime
+		Expect(task.updateStatus(ctx)).To(Succeed())
+
+		Expect(pr.Status.Extensions.Timeline).To(HaveLen(1))
+		validation := timelineEntry(provisioningv1alpha1.TimelinePhaseValidation)
+		Expect(validation.Result).To(Equal(provisioningv1alpha1.TimelineResultSucceeded))
+		Expect(validation.Attempts).To(Equal(1))
+		Expect(validation.StartTime.Time).To(Equal(reconcileStartTime))
+		Expect(validation.EndTime).ToNot(BeNil())
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseValidation,
+			provisioningv1alpha1.TimelineResultSucceeded)).To(Equal(uint64(1)))
+
+		updated := &provisioningv1alpha1.ProvisioningRequest{}
+		Expect(c.Get(ctx, client.ObjectKeyFromObject(pr), updated)).To(Succeed())
+		Expect(updated.Status.Extensions.Timeline).To(HaveLen(1))
+	})
+
+	It("keeps a phase in progress until its last condition is true", func() {
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterInstanceProcessed,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		installation := timelineEntry(provisioningv1alpha1.TimelinePhaseClusterInstallation)
+		Expect(installation.Result).To(Equal(provisioningv1alpha1.TimelineResultInProgress))
+		Expect(installation.EndTime).To(BeNil())
+
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		installation = timelineEntry(provisioningv1alpha1.TimelinePhaseClusterInstallation)
+		Expect(installation.Result).To(Equal(provisioningv1alpha1.TimelineResultSucceeded))
+		Expect(installation.Attempts).To(Equal(1))
+		Expect(installation.EndTime).ToNot(BeNil())
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseClusterInstallation,
+			provisioningv1alpha1.TimelineResultSucceeded)).To(Equal(uint64(1)))
+	})
+
+	It("counts a new attempt for a phase started again after a failure", func() {
+		setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.TimedOut, metav1.ConditionFalse)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		hardware := timelineEntry(provisioningv1alpha1.TimelinePhaseHardwareProvisioning)
+		Expect(hardware.Result).To(Equal(provisioningv1alpha1.TimelineResultTimedOut))
+		Expect(hardware.Attempts).To(Equal(1))
+
+		// The hardware provisioning is retried
+		retryStartTime := time.Now().Add(time.Minute).Truncate(time.Second)
+		setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+		task.reconcileStartTime = retryStartTime
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		hardware = timelineEntry(provisioningv1alpha1.TimelinePhaseHardwareProvisioning)
+		Expect(hardware.Result).To(Equal(provisioningv1alpha1.TimelineResultInProgress))
+		Expect(hardware.Attempts).To(Equal(2))
+		Expect(hardware.StartTime.Time).To(Equal(retryStartTime))
+		Expect(hardware.EndTime).To(BeNil())
+
+		setCondition(provisioningv1alpha1.PRconditionTypes.HardwareProvisioned,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		hardware = timelineEntry(provisioningv1alpha1.TimelinePhaseHardwareProvisioning)
+		Expect(hardware.Result).To(Equal(provisioningv1alpha1.TimelineResultSucceeded))
+		Expect(hardware.Attempts).To(Equal(2))
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseHardwareProvisioning,
+			provisioningv1alpha1.TimelineResultTimedOut)).To(Equal(uint64(1)))
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseHardwareProvisioning,
+			provisioningv1alpha1.TimelineResultSucceeded)).To(Equal(uint64(1)))
+	})
+
+	It("does not start the policy configuration while the cluster is not ready", func() {
+		setCondition(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.ClusterNotReady, metav1.ConditionFalse)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		Expect(pr.Status.Extensions.Timeline).To(BeEmpty())
+
+		setCondition(provisioningv1alpha1.PRconditionTypes.ConfigurationApplied,
+			provisioningv1alpha1.CRconditionReasons.InProgress, metav1.ConditionFalse)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		Expect(timelineEntry(provisioningv1alpha1.TimelinePhasePolicyConfiguration).Result).
+			To(Equal(provisioningv1alpha1.TimelineResultInProgress))
+	})
+
+	It("does not export a finished attempt again on the next status update", func() {
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		Expect(task.updateStatus(ctx)).To(Succeed())
+		Expect(task.updateStatus(ctx)).To(Succeed())
+
+		Expect(timelineEntry(provisioningv1alpha1.TimelinePhaseResourceCreation).Attempts).To(Equal(1))
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseResourceCreation,
+			provisioningv1alpha1.TimelineResultSucceeded)).To(Equal(uint64(1)))
+	})
+
+	It("does not export the durations when the status update fails", func() {
+		// The status update fails for a ProvisioningRequest that does not exist
+		Expect(c.Delete(ctx, pr)).To(Succeed())
+		setCondition(provisioningv1alpha1.PRconditionTypes.ClusterResourcesCreated,
+			provisioningv1alpha1.CRconditionReasons.Completed, metav1.ConditionTrue)
+		Expect(task.updateStatus(ctx)).ToNot(Succeed())
+
+		Expect(observedDurations(provisioningv1alpha1.TimelinePhaseResourceCreation,
+			provisioningv1alpha1.TimelineResultSucceeded)).To(Equal(uint64(0)))
+	})
+})
@@ -9,6 +9,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/coreos/go-semver/semver"
 	ibgu "github.com/openshift-kni/cluster-group-upgrades-operator/pkg/api/imagebasedgroupupgrades/v1alpha1"
@@ -20,6 +21,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	clusterv1 "open-cluster-management.io/api/cluster/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 // IsUpgradeRequested retruns true if cluster template release version is higher than
@@ -112,7 +114,7 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(ctx context.Context, c
 			metav1.ConditionFalse,
 			"Upgrade is initiated",
 		)
-		if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+		if err := t.updateStatus(ctx); err != nil {
 			return nextReconcile, proceed, fmt.Errorf("failed to update ProvisioningRequest CR status: %w", err)
 		}
 	}
@@ -154,13 +156,257 @@ func (t *provisioningRequestReconcilerTask) handleUpgrade(ctx context.Context, c
 		proceed = true
 	}
 
-	if err := ctlrutils.UpdateK8sCRStatus(ctx, t.client, t.object); err != nil {
+	if err := t.updateStatus(ctx); err != nil {
 		return nextReconcile, proceed, fmt.Errorf("failed to update ProvisioningRequest CR status: %w", err)
 	}
 
 	return nextReconcile, proceed, nil
 }
 
+// handleUpgradeRollback rolls back the failed upgrade of the cluster through a dedicated IBGU, when
+// requested with the upgrade rollback annotation. It returns a ctrl.Result to indicate if/when to
+// requeue, a bool to indicate whether to process with further processing and an error if any issues occur.
+func (t *provisioningRequestReconcilerTask) handleUpgradeRollback(ctx context.Context, clusterName string) (ctrl.Result, bool, error) {
+	nextReconcile := ctrl.Result{}
+	proceed := false
+
+	t.logger.InfoContext(
+		ctx,
+		"Start handling upgrade rollback",
+	)
+
+	rollbackIBGU := &ibgu.ImageBasedGroupUpgrade{}
+	err := t.client.Get(ctx, types.NamespacedName{Name: getRollbackIBGUName(t.object.Name), Namespace: clusterName}, rollbackIBGU)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nextReconcile, proceed, fmt.Errorf("failed to get rollback IBGU: %w", err)
+		}
+
+		upgradeIBGU, completed, err := t.getUpgradeIBGU(ctx, clusterName,
+			t.object.GetAnnotations()[provisioningv1alpha1.UpgradeRollbackAnnotation])
+		if err != nil {
+			return nextReconcile, proceed, err
+		}
+
+		if upgradeIBGU == nil {
+			// The cluster upgrade was never started, so there is nothing to roll back
+			return nextReconcile, true, t.completeUpgradeRollback(ctx)
+		}
+
+		if isUpgradeFinalized(upgradeIBGU, completed) {
+			// The previous release is removed from the cluster when the upgrade is finalized
+			ctlrutils.SetProvisioningStateFailed(t.object,
+				"Cluster upgrade rollback is failed: the upgrade was finalized and can no longer be rolled back")
+			if err := t.updateStatus(ctx); err != nil {
+				return nextReconcile, proceed, fmt.Errorf("failed to update ProvisioningRequest CR status: %w", err)
+			}
+			return nextReconcile, proceed, nil
+		}
+
+		// Create the rollback IBGU if it doesn't exist
+		rollbackIBGU = newRollbackIBGU(upgradeIBGU, completed || isIBGUActionCompleted(upgradeIBGU, ibgu.Upgrade))
+		if err := ctlrutils.CreateK8sCR(ctx, t.client, rollbackIBGU, t.object, ctlrutils.UPDATE); err != nil {
+			return nextReconcile, proceed, fmt.Errorf("failed to create rollback IBGU: %w", err)
+		}
+
+		t.logger.InfoContext(
+			ctx,
+			fmt.Sprintf(
+				"Upgrade rollback initiated. Created IBGU %s in the namespace %s",
+				rollbackIBGU.GetName(),
+				rollbackIBGU.GetNamespace(),
+			),
+		)
+	}
+
+	if isIBGUProgressing(rollbackIBGU) {
+		t.logger.InfoContext(
+			ctx,
+			"Wait for upgrade rollback to be completed",
+		)
+
+		ctlrutils.SetProvisioningStateInProgress(t.object, "Cluster upgrade rollback is in progress")
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+			provisioningv1alpha1.CRconditionReasons.InProgress,
+			metav1.ConditionFalse,
+			"Upgrade rollback is in progress",
+		)
+		nextReconcile = requeueWithMediumInterval()
+	} else if failed, message := isIBGUFailed(rollbackIBGU); failed {
+		ctlrutils.SetProvisioningStateFailed(t.object, "Cluster upgrade rollback is failed")
+		ctlrutils.SetStatusCondition(&t.object.Status.Conditions,
+			provisioningv1alpha1.PRconditionTypes.UpgradeCompleted,
+			provisioningv1alpha1.CRconditionReasons.Failed,
+			metav1.ConditionFalse,
+			message,
+		)
+	} else {
+		// Proceed to further processing only when the rollback is completed
+		upgradeIBGU := &ibgu.ImageBasedGroupUpgrade{
+			ObjectMeta: metav1.ObjectMeta{Name: t.object.Name, Namespace: clusterName},
+		}
+		return nextReconcile, true, t.completeUpgradeRollback(ctx, upgradeIBGU, rollbackIBGU)
+	}
+
+	if err := t.updateStatus(ctx); err != nil {
+		return nextReconcile, proceed, fmt.Errorf("failed to update ProvisioningRequest CR status: %w", err)
+	}
+
+	return nextReconcile, proceed, nil
+}
+
+// getUpgradeIBGU returns the IBGU that upgraded the cluster to the given version of the ClusterTemplate,
+// and true if the upgrade completed. It returns nil if the cluster upgrade was never started.
+func (t *provisioningRequestReconcilerTask) getUpgradeIBGU(
+	ctx context.Context, clusterName, templateVersion string,
+) (*ibgu.ImageBasedGroupUpgrade, bool, error) {
+	upgradeIBGU := &ibgu.ImageBasedGroupUpgrade{}
+	err := t.client.Get(ctx, types.NamespacedName{Name: t.object.Name, Namespace: clusterName}, upgradeIBGU)
+	if err == nil {
+		return upgradeIBGU, false, nil
+	}
+	if !errors.IsNotFound(err) {
+		return nil, false, fmt.Errorf("failed to get IBGU: %w", err)
+	}
+	if !ctlrutils.IsClusterUpgradeCompleted(t.object) {
+		return nil, false, nil
+	}
+
+	// The upgrade IBGU is deleted once the upgrade completes, so it is rendered again from the
+	// upgrade defaults of the ClusterTemplate version the cluster was upgraded to
+	upgradeIBGU, err = t.renderUpgradeIBGU(ctx, clusterName, templateVersion)
+	if err != nil {
+		return nil, false, err
+	}
+	return upgradeIBGU, upgradeIBGU != nil, nil
+}
+
+// isUpgradeFinalized checks if the upgrade run by the IBGU was finalized, after which the cluster can no
+// longer be rolled back. All the actions of the plan of a completed upgrade are done.
+func isUpgradeFinalized(cr *ibgu.ImageBasedGroupUpgrade, completed bool) bool {
+	if isIBGUActionCompleted(cr, ibgu.FinalizeUpgrade) {
+		return true
+	}
+	if !completed {
+		return false
+	}
+	return slices.ContainsFunc(cr.Spec.Plan, func(item ibgu.PlanItem) bool {
+		return slices.Contains(item.Actions, ibgu.FinalizeUpgrade)
+	})
+}
+
+// completeUpgradeRollback cleans up the IBGUs and the UpgradeCompleted condition once the cluster is back
+// to its previous release, and removes the upgrade rollback annotation.
+func (t *provisioningRequestReconcilerTask) completeUpgradeRollback(
+	ctx context.Context, ibgus ...*ibgu.ImageBasedGroupUpgrade,
+) error {
+	for _, cr := range ibgus {
+		if cr == nil {
+			continue
+		}
+		if err := t.client.Delete(ctx, cr); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to cleanup IBGU %s: %w", cr.GetName(), err)
+		}
+	}
+
+	// The cluster runs the release of its ClusterTemplate again, so it is no longer considered as upgraded
+	meta.RemoveStatusCondition(&t.object.Status.Conditions,
+		string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+	if err := t.updateStatus(ctx); err != nil {
+		return fmt.Errorf("failed to update ProvisioningRequest CR status: %w", err)
+	}
+
+	patch := client.MergeFrom(t.object.DeepCopy())
+	delete(t.object.Annotations, provisioningv1alpha1.UpgradeRollbackAnnotation)
+	if err := t.client.Patch(ctx, t.object, patch); err != nil {
+		return fmt.Errorf("failed to remove the upgrade rollback annotation: %w", err)
+	}
+
+	t.logger.InfoContext(
+		ctx,
+		"Upgrade rollback is completed",
+	)
+	return nil
+}
+
+// renderUpgradeIBGU renders the IBGU that upgraded the cluster to the given version of the ClusterTemplate.
+// It returns nil if the version is unknown.
+func (t *provisioningRequestReconcilerTask) renderUpgradeIBGU(
+	ctx context.Context, clusterName, templateVersion string,
+) (*ibgu.ImageBasedGroupUpgrade, error) {
+	if templateVersion == "" {
+		return nil, nil // nolint: nilnil
+	}
+
+	upgradedRequest := t.object.DeepCopy()
+	upgradedRequest.Spec.TemplateVersion = templateVersion
+	clusterTemplate, err := upgradedRequest.GetClusterTemplateRef(ctx, t.client)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get upgraded clusterTemplate: %w", err)
+	}
+	if clusterTemplate.Spec.Templates.UpgradeDefaults == "" {
+		return nil, nil // nolint: nilnil
+	}
+
+	upgradeIBGU, err := ctlrutils.GetIBGUFromUpgradeDefaultsConfigmap(
+		ctx, t.client, clusterTemplate.Spec.Templates.UpgradeDefaults,
+		clusterTemplate.Namespace, ctlrutils.UpgradeDefaultsConfigmapKey,
+		clusterName, t.object.Name, clusterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate IBGU for cluster: %w", err)
+	}
+	return upgradeIBGU, nil
+}
+
+// getRollbackIBGUName returns the name of the IBGU rolling back the upgrade of a ProvisioningRequest.
+func getRollbackIBGUName(prName string) string {
+	return prName + "-rollback"
+}
+
+// newRollbackIBGU builds the IBGU rolling back the cluster targeted by the given upgrade IBGU. The
+// upgrade is rolled back once the new release was booted, and aborted otherwise.
+func newRollbackIBGU(upgrade *ibgu.ImageBasedGroupUpgrade, upgraded bool) *ibgu.ImageBasedGroupUpgrade {
+	actions := []string{ibgu.Abort}
+	if upgraded {
+		actions = []string{ibgu.Rollback, ibgu.FinalizeRollback}
+	}
+
+	strategy := ibgu.RolloutStrategy{MaxConcurrency: 1}
+	if len(upgrade.Spec.Plan) > 0 {
+		strategy = upgrade.Spec.Plan[0].RolloutStrategy
+	}
+
+	return &ibgu.ImageBasedGroupUpgrade{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      getRollbackIBGUName(upgrade.Name),
+			Namespace: upgrade.Namespace,
+		},
+		Spec: ibgu.ImageBasedGroupUpgradeSpec{
+			IBUSpec:               *upgrade.Spec.IBUSpec.DeepCopy(),
+			ClusterLabelSelectors: upgrade.Spec.ClusterLabelSelectors,
+			Plan: []ibgu.PlanItem{
+				{
+					Actions:         actions,
+					RolloutStrategy: strategy,
+				},
+			},
+		},
+	}
+}
+
+// isIBGUActionCompleted checks if the given action of the IBGU completed for any of its clusters
+func isIBGUActionCompleted(cr *ibgu.ImageBasedGroupUpgrade, action string) bool {
+	for _, cluster := range cr.Status.Clusters {
+		for _, completed := range cluster.CompletedActions {
+			if completed.Action == action {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func isIBGUFailed(cr *ibgu.ImageBasedGroupUpgrade) (bool, string) {
 	for _, cluster := range cr.Status.Clusters {
 		if len(cluster.FailedActions) == 0 {
@@ -284,10 +284,11 @@ var (
 
 // Postgres values
 const (
-	AdminPasswordEnvName     = "POSTGRESQL_ADMIN_PASSWORD"     // nolint: gosec
-	AlarmsPasswordEnvName    = "ORAN_O2IMS_ALARMS_PASSWORD"    // nolint: gosec
-	ResourcesPasswordEnvName = "ORAN_O2IMS_RESOURCES_PASSWORD" // nolint: gosec
-	ClustersPasswordEnvName  = "ORAN_O2IMS_CLUSTERS_PASSWORD"  // nolint: gosec
+	AdminPasswordEnvName        = "POSTGRESQL_ADMIN_PASSWORD"        // nolint: gosec
+	AlarmsPasswordEnvName       = "ORAN_O2IMS_ALARMS_PASSWORD"       // nolint: gosec
+	ResourcesPasswordEnvName    = "ORAN_O2IMS_RESOURCES_PASSWORD"    // nolint: gosec
+	ClustersPasswordEnvName     = "ORAN_O2IMS_CLUSTERS_PASSWORD"     // nolint: gosec
+	ProvisioningPasswordEnvName = "ORAN_O2IMS_PROVISIONING_PASSWORD" // nolint: gosec
 
 	DatabaseHostnameEnvVar = "POSTGRES_HOSTNAME"
 )
@@ -314,6 +315,10 @@ const (
 // AlarmDefinitionSeverityField severity field within additional fields of alarm definition
 const AlarmDefinitionSeverityField = "severity"
 
+// AlarmDefinitionClearingTypeAnnotation annotation of a prometheus rule setting the clearing type of its alarm definition.
+// Rules annotated with "MANUAL" raise alarms that must be cleared through the API.
+const AlarmDefinitionClearingTypeAnnotation = "clearing_type"
+
 // Alertmanager values
 const (
 	AlertmanagerObjectName                      = "alertmanager"
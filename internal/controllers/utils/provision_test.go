@@ -303,6 +303,56 @@ var _ = Describe("FindClusterInstanceImmutableFieldUpdates", func() {
 		Expect(updatedFields).To(BeEmpty())
 		Expect(scalingNodes).To(ContainElement("nodes.0"))
 	})
+
+	Context("with several nodes", func() {
+		var oldSpec, newSpec map[string]any
+
+		BeforeEach(func() {
+			oldSpec = oldClusterInstance.Object["spec"].(map[string]any)
+			newSpec = newClusterInstance.Object["spec"].(map[string]any)
+			for _, spec := range []map[string]any{oldSpec, newSpec} {
+				spec["nodes"] = []any{
+					map[string]any{"hostName": "master1", "role": "master"},
+					map[string]any{"hostName": "worker1", "role": "worker"},
+					map[string]any{"hostName": "worker2", "role": "worker"},
+				}
+			}
+		})
+
+		It("should match the nodes by hostName when they are reordered", func() {
+			nodes := newSpec["nodes"].([]any)
+			nodes[1], nodes[2] = nodes[2], nodes[1]
+
+			updatedFields, scalingNodes, err := provisioningv1alpha1.FindClusterInstanceImmutableFieldUpdates(
+				oldSpec, newSpec, IgnoredClusterInstanceFields, provisioningv1alpha1.AllowedClusterInstanceFields)
+			Expect(err).To(BeNil())
+			Expect(updatedFields).To(BeEmpty())
+			Expect(scalingNodes).To(BeEmpty())
+		})
+
+		It("should only detect the removal when a node in the middle is removed", func() {
+			nodes := newSpec["nodes"].([]any)
+			newSpec["nodes"] = []any{nodes[0], nodes[2]}
+
+			updatedFields, scalingNodes, err := provisioningv1alpha1.FindClusterInstanceImmutableFieldUpdates(
+				oldSpec, newSpec, IgnoredClusterInstanceFields, provisioningv1alpha1.AllowedClusterInstanceFields)
+			Expect(err).To(BeNil())
+			Expect(updatedFields).To(BeEmpty())
+			Expect(scalingNodes).To(Equal([]string{"nodes.1"}))
+		})
+
+		It("should report the changes of a node that moved under its new index", func() {
+			nodes := newSpec["nodes"].([]any)
+			nodes[2].(map[string]any)["role"] = "master"
+			newSpec["nodes"] = []any{nodes[0], nodes[2], map[string]any{"hostName": "worker3", "role": "worker"}}
+
+			updatedFields, scalingNodes, err := provisioningv1alpha1.FindClusterInstanceImmutableFieldUpdates(
+				oldSpec, newSpec, IgnoredClusterInstanceFields, provisioningv1alpha1.AllowedClusterInstanceFields)
+			Expect(err).To(BeNil())
+			Expect(updatedFields).To(Equal([]string{"nodes.1.role"}))
+			Expect(scalingNodes).To(ConsistOf("nodes.2", "nodes.1"))
+		})
+	})
 })
 
 var _ = Describe("ClusterIsReadyForPolicyConfig", func() {
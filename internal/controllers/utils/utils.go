@@ -175,7 +175,8 @@ func HasApiEndpoints(serverName string) bool {
 func HasDatabase(serverName string) bool {
 	return serverName == InventoryResourceServerName ||
 		serverName == InventoryClusterServerName ||
-		serverName == InventoryAlarmServerName
+		serverName == InventoryAlarmServerName ||
+		serverName == InventoryProvisioningServerName
 }
 
 // RequiresInternalListener determines whether a server expects its API to be accessed by another server.  If this
@@ -386,6 +387,8 @@ func GetServerDatabasePasswordName(serverName string) (string, error) {
 		return ResourcesPasswordEnvName, nil
 	case InventoryClusterServerName:
 		return ClustersPasswordEnvName, nil
+	case InventoryProvisioningServerName:
+		return ProvisioningPasswordEnvName, nil
 	default:
 		return "", fmt.Errorf("database name not found for server '%s'", serverName)
 	}
@@ -434,8 +437,13 @@ func GetServerArgs(inventory *inventoryv1alpha1.Inventory, serverName string) (r
 		result = slices.Clone(AlarmServerArgs)
 		result = append(
 			result,
+			fmt.Sprintf("--cloud-id=%s", inventory.Status.ClusterID),
 			fmt.Sprintf("--global-cloud-id=%s", cloudId))
 
+		if config := inventory.Spec.AlarmServerConfig; config != nil && config.ArchiveRetentionPeriod > 0 {
+			result = append(result, fmt.Sprintf("--archive-retention-period=%d", config.ArchiveRetentionPeriod))
+		}
+
 		// Add OAuth command line arguments
 		result = addArgsForOAuth(inventory, result)
 		return
@@ -91,6 +91,22 @@ var _ = Describe("ExtensionUtils", func() {
 		)
 		Expect(actualArgs).To(Equal(expectedArgs))
 	})
+
+	It("The alarm server args contain the archive retention period", func() {
+		Inventory := &inventoryv1alpha1.Inventory{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "oran-o2ims-sample-1",
+				Namespace: InventoryNamespace,
+			},
+			Spec: inventoryv1alpha1.InventorySpec{
+				AlarmServerConfig: &inventoryv1alpha1.AlarmServerConfig{ArchiveRetentionPeriod: 90},
+			},
+		}
+
+		actualArgs, err := GetServerArgs(Inventory, InventoryAlarmServerName)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(actualArgs).To(ContainElement("--archive-retention-period=90"))
+	})
 })
 
 var _ = Describe("DoesK8SResourceExist", func() {
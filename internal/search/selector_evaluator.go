@@ -113,6 +113,29 @@ func (b *SelectorEvaluatorBuilder) Build() (result *SelectorEvaluator, err error
 	return
 }
 
+// NewObjectSelectorEvaluator creates an evaluator for the objects that filters and subscription
+// selectors are evaluated against, e.g., API responses and notification payloads. Optional
+// attributes are often missing from those objects, so a missing attribute is evaluated as nil
+// instead of failing the evaluation.
+func NewObjectSelectorEvaluator(logger *slog.Logger) (*SelectorEvaluator, error) {
+	pathEvaluator, err := NewPathEvaluator().
+		SetLogger(logger).
+		SetAllowMissingFields(true).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build path evaluator: %w", err)
+	}
+
+	selectorEvaluator, err := NewSelectorEvaluator().
+		SetLogger(logger).
+		SetPathEvaluator(pathEvaluator.Evaluate).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build selector evaluator: %w", err)
+	}
+	return selectorEvaluator, nil
+}
+
 // Evaluate evaluates the filter expression on the given object. It returns true if the object
 // matches the expression, and false otherwise.
 func (e *SelectorEvaluator) Evaluate(ctx context.Context, selector *Selector,
@@ -52,6 +52,16 @@ var _ = Describe("Selector evaluator", func() {
 			Expect(msg).To(ContainSubstring("evaluator"))
 			Expect(msg).To(ContainSubstring("mandatory"))
 		})
+
+		It("Can be created for objects with missing attributes", func() {
+			evaluator, err := NewObjectSelectorEvaluator(logger)
+			Expect(err).ToNot(HaveOccurred())
+			selector, err := ParseSelector(logger, "(eq,extensions/site,a)")
+			Expect(err).ToNot(HaveOccurred())
+			result, err := evaluator.Evaluate(context.Background(), selector, map[string]any{"name": "a"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeFalse())
+		})
 	})
 
 	DescribeTable(
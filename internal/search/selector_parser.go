@@ -61,6 +61,21 @@ func (b *SelectorParserBuilder) Build() (result *SelectorParser, err error) {
 	return
 }
 
+// ParseSelector parses a filter expression, e.g., the filter query parameter of a list request or the
+// selector of a subscription, with a parser that writes its log messages to the given logger.
+func ParseSelector(logger *slog.Logger, text string) (*Selector, error) {
+	parser, err := NewSelectorParser().SetLogger(logger).Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build selector parser: %w", err)
+	}
+
+	selector, err := parser.Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syntax in '%s': %w", text, err)
+	}
+	return selector, nil
+}
+
 // Parse parses the give filter expression. If it succeeds it returns the object representing
 // that expression. If it fails it returns an error.
 func (p *SelectorParser) Parse(text string) (selector *Selector, err error) {
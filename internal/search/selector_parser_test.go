@@ -342,4 +342,10 @@ var _ = Describe("Selector parser", func() {
 			},
 		),
 	)
+
+	It("Reports the text that can't be parsed", func() {
+		selector, err := ParseSelector(logger, "(eq,myattr")
+		Expect(err).To(MatchError(ContainSubstring("invalid syntax in '(eq,myattr'")))
+		Expect(selector).To(BeNil())
+	})
 })
@@ -0,0 +1,284 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+)
+
+// ColumnType is the type of the values stored in a database column. It is used to convert the
+// values of the selector terms, which are always strings, to the type expected by the database.
+type ColumnType int
+
+const (
+	// ColumnTypeText is used for columns containing strings.
+	ColumnTypeText ColumnType = iota
+
+	// ColumnTypeUUID is used for columns containing UUIDs.
+	ColumnTypeUUID
+
+	// ColumnTypeTime is used for columns containing timestamps. Values must use the RFC 3339
+	// format.
+	ColumnTypeTime
+
+	// ColumnTypeBool is used for columns containing booleans.
+	ColumnTypeBool
+
+	// ColumnTypeInt is used for columns containing integers.
+	ColumnTypeInt
+
+	// ColumnTypeJSON is used for JSONB columns. The rest of the path of the term is used as the
+	// path inside the JSON document, and the value found there is compared as text.
+	ColumnTypeJSON
+)
+
+// ErrUntranslatableTerm is returned when a selector term can't be translated into SQL and the caller doesn't
+// support evaluating it in memory.
+var ErrUntranslatableTerm = errors.New("filter can't be evaluated by the database")
+
+// Column describes the database column that stores the value of an attribute of an API object.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// SQLCompilerBuilder contains the logic and data needed to create selector compilers. Don't create
+// instances of this type directly, use the NewSQLCompiler function instead.
+type SQLCompilerBuilder struct {
+	logger  *slog.Logger
+	columns map[string]Column
+}
+
+// SQLCompiler knows how to translate selectors into SQL expressions. Don't create instances of
+// this type directly, use the NewSQLCompiler function instead.
+type SQLCompiler struct {
+	logger  *slog.Logger
+	columns map[string]Column
+}
+
+// NewSQLCompiler creates a builder that can then be used to configure and create selector
+// compilers.
+func NewSQLCompiler() *SQLCompilerBuilder {
+	return &SQLCompilerBuilder{}
+}
+
+// SetLogger sets the logger that the compiler will use to write log messages. This is mandatory.
+func (b *SQLCompilerBuilder) SetLogger(value *slog.Logger) *SQLCompilerBuilder {
+	b.logger = value
+	return b
+}
+
+// SetColumns sets the map from the names of the attributes of the API object to the columns
+// storing their values. This is mandatory.
+func (b *SQLCompilerBuilder) SetColumns(value map[string]Column) *SQLCompilerBuilder {
+	b.columns = value
+	return b
+}
+
+// Build uses the configuration stored in the builder to create a new compiler.
+func (b *SQLCompilerBuilder) Build() (result *SQLCompiler, err error) {
+	// Check parameters:
+	if b.logger == nil {
+		err = errors.New("logger is mandatory")
+		return
+	}
+	if b.columns == nil {
+		err = errors.New("columns are mandatory")
+		return
+	}
+
+	// Create and populate the object:
+	result = &SQLCompiler{
+		logger:  b.logger,
+		columns: b.columns,
+	}
+	return
+}
+
+// Compile translates the terms of the selector into a SQL expression. Terms that can't be
+// translated, because the attribute isn't stored in a column or because the operator or the values
+// aren't supported for that column, are returned in the remaining selector so that the caller can
+// evaluate them in memory. The returned expression will be nil if no term could be translated.
+func (c *SQLCompiler) Compile(ctx context.Context, selector *Selector) (result bob.Expression,
+	remaining *Selector) {
+	remaining = &Selector{}
+	if selector == nil {
+		return
+	}
+	exprs := make([]bob.Expression, 0, len(selector.Terms))
+	for _, term := range selector.Terms {
+		expr, err := c.compileTerm(term)
+		if err != nil {
+			c.logger.DebugContext(
+				ctx,
+				"Term will be evaluated in memory",
+				"term", term.String(),
+				"reason", err.Error(),
+			)
+			remaining.Terms = append(remaining.Terms, term)
+			continue
+		}
+		exprs = append(exprs, expr)
+	}
+	if len(exprs) > 0 {
+		result = psql.And(exprs...)
+	}
+	return
+}
+
+// CompileAll translates all the terms of the selector into a SQL expression, and fails if any of them can't be
+// translated. It is intended for queries that are paged by the database, as evaluating some terms in memory after
+// the database has limited the results would return pages with missing items. The returned error wraps
+// ErrUntranslatableTerm.
+func (c *SQLCompiler) CompileAll(ctx context.Context, selector *Selector) (result bob.Expression, err error) {
+	result, remaining := c.Compile(ctx, selector)
+	if len(remaining.Terms) > 0 {
+		result = nil
+		err = fmt.Errorf("%w: %s", ErrUntranslatableTerm, remaining.String())
+	}
+	return
+}
+
+func (c *SQLCompiler) compileTerm(term *Term) (result bob.Expression, err error) {
+	if len(term.Path) == 0 {
+		err = errors.New("path is empty")
+		return
+	}
+	column, ok := c.columns[term.Path[0]]
+	if !ok {
+		err = fmt.Errorf("attribute '%s' isn't stored in a column", term.Path[0])
+		return
+	}
+	if len(term.Values) == 0 {
+		err = fmt.Errorf("operator '%s' requires at least one value", term.Operator)
+		return
+	}
+
+	var expr psql.Expression
+	columnType := column.Type
+	switch {
+	case columnType == ColumnTypeJSON && len(term.Path) == 2:
+		expr = psql.Raw(fmt.Sprintf("%q->>?", column.Name), term.Path[1])
+		columnType = ColumnTypeText
+	case columnType == ColumnTypeJSON && len(term.Path) > 2:
+		expr = psql.Raw(fmt.Sprintf("%q#>>?", column.Name), term.Path[1:])
+		columnType = ColumnTypeText
+	case columnType != ColumnTypeJSON && len(term.Path) == 1:
+		expr = psql.Quote(column.Name)
+	default:
+		err = fmt.Errorf("path '%s' isn't supported", strings.Join(term.Path, "/"))
+		return
+	}
+
+	switch term.Operator {
+	case Cont, Ncont:
+		result, err = c.compileCont(term, expr, columnType)
+		return
+	case Gt, Gte, Lt, Lte:
+		// Text is compared by the in memory evaluator using the byte ordering of Go strings, so
+		// the database is asked to use the same ordering instead of the one of the locale:
+		if columnType == ColumnTypeText {
+			expr = psql.Raw("? COLLATE \"C\"", expr)
+		}
+	}
+
+	values, err := c.convertValues(columnType, term.Values)
+	if err != nil {
+		return
+	}
+
+	switch term.Operator {
+	case Eq:
+		// Text that looks like an UUID is compared ignoring case, like the in memory evaluator
+		// does:
+		if id, uuidErr := uuid.Parse(fmt.Sprint(values[0])); columnType == ColumnTypeText && uuidErr == nil {
+			result = psql.Raw("lower(?)", expr).EQ(psql.Arg(id.String()))
+			return
+		}
+		result = expr.EQ(psql.Arg(values[0]))
+	case Neq:
+		result = expr.IsDistinctFrom(psql.Arg(values[0]))
+	case Gt:
+		result = expr.GT(psql.Arg(values[0]))
+	case Gte:
+		result = expr.GTE(psql.Arg(values[0]))
+	case Lt:
+		result = expr.LT(psql.Arg(values[0]))
+	case Lte:
+		result = expr.LTE(psql.Arg(values[0]))
+	case In:
+		result = expr.In(psql.Arg(values...))
+	case Nin:
+		result = psql.Or(expr.IsNull(), expr.NotIn(psql.Arg(values...)))
+	default:
+		err = fmt.Errorf("operator '%s' isn't supported", term.Operator)
+	}
+	return
+}
+
+func (c *SQLCompiler) compileCont(term *Term, expr psql.Expression,
+	columnType ColumnType) (result bob.Expression, err error) {
+	if columnType != ColumnTypeText {
+		err = fmt.Errorf("operator '%s' is only supported for text", term.Operator)
+		return
+	}
+
+	// The term matches if the attribute contains any of the values:
+	likes := make([]bob.Expression, 0, len(term.Values))
+	for _, value := range term.Values {
+		pattern := "%" + escapeLike(fmt.Sprint(value)) + "%"
+		likes = append(likes, expr.Like(psql.Arg(pattern)))
+	}
+	if term.Operator == Cont {
+		result = psql.Or(likes...)
+	} else {
+		result = psql.Or(expr.IsNull(), psql.Not(psql.Group(psql.Or(likes...))))
+	}
+	return
+}
+
+func (c *SQLCompiler) convertValues(columnType ColumnType, values []any) (result []any,
+	err error) {
+	result = make([]any, len(values))
+	for i, value := range values {
+		text := fmt.Sprint(value)
+		switch columnType {
+		case ColumnTypeUUID:
+			result[i], err = uuid.Parse(text)
+		case ColumnTypeTime:
+			result[i], err = time.Parse(time.RFC3339Nano, text)
+		case ColumnTypeBool:
+			result[i], err = strconv.ParseBool(text)
+		case ColumnTypeInt:
+			result[i], err = strconv.Atoi(text)
+		default:
+			result[i] = text
+		}
+		if err != nil {
+			err = fmt.Errorf("value '%s' can't be converted: %w", text, err)
+			return
+		}
+	}
+	return
+}
+
+// escapeLike escapes the characters that have a special meaning in a LIKE pattern.
+func escapeLike(value string) string {
+	return likeReplacer.Replace(value)
+}
+
+var likeReplacer = strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
@@ -0,0 +1,221 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package search
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2/dsl/core"
+	. "github.com/onsi/ginkgo/v2/dsl/table"
+	. "github.com/onsi/gomega"
+	"github.com/stephenafamo/bob/dialect/psql"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
+)
+
+var _ = Describe("SQL compiler", func() {
+	columns := map[string]Column{
+		"name":       {Name: "name", Type: ColumnTypeText},
+		"id":         {Name: "object_id", Type: ColumnTypeUUID},
+		"raised":     {Name: "raised_time", Type: ColumnTypeTime},
+		"ack":        {Name: "acknowledged", Type: ColumnTypeBool},
+		"severity":   {Name: "severity", Type: ColumnTypeInt},
+		"extensions": {Name: "extensions", Type: ColumnTypeJSON},
+	}
+
+	var (
+		ctx      context.Context
+		compiler *SQLCompiler
+	)
+
+	BeforeEach(func() {
+		var err error
+		ctx = context.Background()
+		compiler, err = NewSQLCompiler().
+			SetLogger(logger).
+			SetColumns(columns).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	// compile parses the selector, compiles it and renders the resulting where clause:
+	compile := func(src string) (where string, args []any, remaining string) {
+		parser, err := NewSelectorParser().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		selector, err := parser.Parse(src)
+		Expect(err).ToNot(HaveOccurred())
+		expr, rest := compiler.Compile(ctx, selector)
+		Expect(rest).ToNot(BeNil())
+		remaining = rest.String()
+		if expr == nil {
+			return
+		}
+		query, args, err := psql.Select(sm.From("t"), sm.Where(expr)).Build(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		where = strings.TrimSpace(query[strings.Index(query, "WHERE ")+len("WHERE "):])
+		return
+	}
+
+	Describe("Creation", func() {
+		It("Can be created with a logger and columns", func() {
+			Expect(compiler).ToNot(BeNil())
+		})
+
+		It("Can't be created without a logger", func() {
+			result, err := NewSQLCompiler().
+				SetColumns(columns).
+				Build()
+			Expect(err).To(MatchError(ContainSubstring("logger is mandatory")))
+			Expect(result).To(BeNil())
+		})
+
+		It("Can't be created without columns", func() {
+			result, err := NewSQLCompiler().
+				SetLogger(logger).
+				Build()
+			Expect(err).To(MatchError(ContainSubstring("columns are mandatory")))
+			Expect(result).To(BeNil())
+		})
+	})
+
+	It("Returns nil for a nil selector", func() {
+		expr, remaining := compiler.Compile(ctx, nil)
+		Expect(expr).To(BeNil())
+		Expect(remaining.Terms).To(BeEmpty())
+	})
+
+	It("Converts the values to the type of the column", func() {
+		id := uuid.New()
+		raised := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+		where, args, remaining := compile(
+			"(eq,id," + id.String() + ");(gt,raised,2025-01-02T03:04:05Z);(eq,ack,true);(in,severity,1,2)",
+		)
+		Expect(where).To(Equal(
+			`(("object_id" = $1) AND ("raised_time" > $2) AND ("acknowledged" = $3) AND ` +
+				`("severity" IN ($4, $5)))`,
+		))
+		Expect(args).To(Equal([]any{id, raised, true, 1, 2}))
+		Expect(remaining).To(BeEmpty())
+	})
+
+	DescribeTable(
+		"Translates operators",
+		func(src string, where string, args []any) {
+			actual, actualArgs, remaining := compile(src)
+			Expect(actual).To(Equal(where))
+			Expect(actualArgs).To(Equal(args))
+			Expect(remaining).To(BeEmpty())
+		},
+		Entry(
+			"Equal",
+			"(eq,name,a)",
+			`(("name" = $1))`,
+			[]any{"a"},
+		),
+		Entry(
+			"Not equal",
+			"(neq,name,a)",
+			`(("name" IS DISTINCT FROM $1))`,
+			[]any{"a"},
+		),
+		Entry(
+			"Not in",
+			"(nin,severity,1,2)",
+			`((("severity" IS NULL) OR ("severity" NOT IN ($1, $2))))`,
+			[]any{1, 2},
+		),
+		Entry(
+			"Contains",
+			"(cont,name,a%,b)",
+			`((("name" LIKE $1) OR ("name" LIKE $2)))`,
+			[]any{`%a\%%`, "%b%"},
+		),
+		Entry(
+			"Doesn't contain",
+			"(ncont,name,a)",
+			`((("name" IS NULL) OR NOT ((("name" LIKE $1)))))`,
+			[]any{"%a%"},
+		),
+		Entry(
+			"JSON attribute",
+			"(eq,extensions/cluster,a)",
+			`(("extensions"->>$1 = $2))`,
+			[]any{"cluster", "a"},
+		),
+		Entry(
+			"Nested JSON attribute",
+			"(in,extensions/labels/site,a,b)",
+			`(("extensions"#>>$1 IN ($2, $3)))`,
+			[]any{[]string{"labels", "site"}, "a", "b"},
+		),
+		Entry(
+			"Text containing an UUID",
+			"(eq,extensions/cluster,6BA7B810-9DAD-11D1-80B4-00C04FD430C8)",
+			`((lower("extensions"->>$1) = $2))`,
+			[]any{"cluster", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"},
+		),
+		Entry(
+			"UUID in upper case",
+			"(neq,id,6BA7B810-9DAD-11D1-80B4-00C04FD430C8)",
+			`(("object_id" IS DISTINCT FROM $1))`,
+			[]any{uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")},
+		),
+		Entry(
+			"Text ordering",
+			"(gt,name,a)",
+			`(("name" COLLATE "C" > $1))`,
+			[]any{"a"},
+		),
+		Entry(
+			"JSON ordering",
+			"(lte,extensions/size,10)",
+			`(("extensions"->>$1 COLLATE "C" <= $2))`,
+			[]any{"size", "10"},
+		),
+	)
+
+	DescribeTable(
+		"Leaves terms that can't be translated for evaluation in memory",
+		func(src string) {
+			where, args, remaining := compile("(eq,name,a);" + src)
+			Expect(where).To(Equal(`(("name" = $1))`))
+			Expect(args).To(Equal([]any{"a"}))
+			Expect(remaining).To(Equal(src))
+		},
+		Entry("Unknown attribute", "(eq,color,'red')"),
+		Entry("Whole JSON column", "(eq,extensions,'a')"),
+		Entry("Sub-field of a regular column", "(eq,name/first,'a')"),
+		Entry("Invalid UUID", "(eq,id,'a')"),
+		Entry("Invalid time", "(gt,raised,'yesterday')"),
+		Entry("Invalid integer", "(eq,severity,'high')"),
+		Entry("Contains on a non text column", "(cont,severity,'1')"),
+	)
+
+	It("Translates all the terms or fails", func() {
+		parser, err := NewSelectorParser().
+			SetLogger(logger).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		selector, err := parser.Parse("(eq,name,a);(eq,ack,true)")
+		Expect(err).ToNot(HaveOccurred())
+		expr, err := compiler.CompileAll(ctx, selector)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(expr).ToNot(BeNil())
+
+		selector, err = parser.Parse("(eq,name,a);(eq,color,'red')")
+		Expect(err).ToNot(HaveOccurred())
+		expr, err = compiler.CompileAll(ctx, selector)
+		Expect(err).To(MatchError(ErrUntranslatableTerm))
+		Expect(err.Error()).To(ContainSubstring("color"))
+		Expect(expr).To(BeNil())
+	})
+})
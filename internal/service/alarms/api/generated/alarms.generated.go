@@ -37,6 +37,12 @@ const (
 	AlarmEventNotificationNotificationEventTypeNEW         AlarmEventNotificationNotificationEventType = 0
 )
 
+// Defines values for AlarmSubscriptionInfoCorrelation.
+const (
+	CORRELATED AlarmSubscriptionInfoCorrelation = "CORRELATED"
+	RAW        AlarmSubscriptionInfoCorrelation = "RAW"
+)
+
 // Defines values for AlarmSubscriptionInfoFilter.
 const (
 	AlarmSubscriptionInfoFilterACKNOWLEDGE AlarmSubscriptionInfoFilter = "ACKNOWLEDGE"
@@ -51,6 +57,13 @@ const (
 	Resolved AlertmanagerNotificationStatus = "resolved"
 )
 
+// Defines values for MaintenanceWindowStatus.
+const (
+	ACTIVE    MaintenanceWindowStatus = "ACTIVE"
+	CLOSED    MaintenanceWindowStatus = "CLOSED"
+	SCHEDULED MaintenanceWindowStatus = "SCHEDULED"
+)
+
 // Defines values for PerceivedSeverity.
 const (
 	CLEARED       PerceivedSeverity = 5
@@ -61,6 +74,48 @@ const (
 	WARNING       PerceivedSeverity = 3
 )
 
+// AlarmCorrelationRule Rule correlating the firing alarms of an object to a root cause alarm of the same object.
+type AlarmCorrelationRule struct {
+	// CorrelatedAlarms Alarms correlated to the root cause alarm, identified the same way as rootCause. All the alarms of the object are correlated if not set.
+	CorrelatedAlarms *[]string `json:"correlatedAlarms,omitempty"`
+
+	// MatchExtensions Extensions that must have the same value in the root cause alarm and in the correlated alarms.
+	MatchExtensions *[]string `json:"matchExtensions,omitempty"`
+
+	// Name Name of the rule.
+	Name string `json:"name"`
+
+	// RootCause Root cause alarm, identified by the alertname extension of a CaaS alarm or by the ID of the alarm definition of an alarm of any source.
+	RootCause string `json:"rootCause"`
+
+	// Window Maximum number of seconds between the raise of the root cause alarm and of a correlated alarm.
+	Window *int `json:"window,omitempty"`
+}
+
+// AlarmEventHistoryRecord defines model for AlarmEventHistoryRecord.
+type AlarmEventHistoryRecord struct {
+	// AlarmAcknowledgedBy Identity of the user who acknowledged the alarm. Only set for ACKNOWLEDGE transitions.
+	AlarmAcknowledgedBy *string `json:"alarmAcknowledgedBy,omitempty"`
+
+	// AlarmChangedTime Date/Time stamp value of the transition.
+	AlarmChangedTime time.Time `json:"alarmChangedTime"`
+
+	// AlarmClearedBy Identity of the user who cleared the alarm. Only set for CLEAR transitions of alarms with a MANUAL clearing type.
+	AlarmClearedBy *string `json:"alarmClearedBy,omitempty"`
+
+	// AlarmEventRecordId Identifier of the AlarmEventRecord that went through the transition.
+	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
+
+	// NotificationEventType Type of the notification event generated by the transition.
+	NotificationEventType AlarmSubscriptionInfoFilter `json:"notificationEventType"`
+
+	// PerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
+	PerceivedSeverity PerceivedSeverity `json:"perceivedSeverity"`
+
+	// PreviousPerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
+	PreviousPerceivedSeverity *PerceivedSeverity `json:"previousPerceivedSeverity,omitempty"`
+}
+
 // AlarmEventNotification Alarm Event Notification sent to subscribers
 type AlarmEventNotification struct {
 	// AlarmAcknowledgeTime Date/Time stamp value when any value of the AlarmEventRecord has been modified.
@@ -166,6 +221,11 @@ type AlarmEventRecordModifications struct {
 
 // AlarmServiceConfiguration defines model for AlarmServiceConfiguration.
 type AlarmServiceConfiguration struct {
+	// CorrelationRules Rules grouping the alarms caused by a same root cause. A firing alarm matching a rule references its root
+	// cause alarm in the parentAlarmEventRecordId extension, and the root cause alarm references its correlated
+	// alarms in the childAlarmEventRecordIds extension. The alarms are not correlated if not set.
+	CorrelationRules *[]AlarmCorrelationRule `json:"correlationRules,omitempty"`
+
 	// Extensions List of metadata key-value pairs used to associate meaningful metadata to the related alarm service
 	Extensions *map[string]string `json:"extensions,omitempty"`
 
@@ -185,13 +245,36 @@ type AlarmSubscriptionInfo struct {
 	// ConsumerSubscriptionId Identifier for the consumer of events sent due to the Subscription.
 	ConsumerSubscriptionId *openapi_types.UUID `json:"consumerSubscriptionId,omitempty"`
 
+	// Correlation Selects whether the subscriber is notified of all the alarms (RAW), or only of the alarms that are not
+	// correlated to a root cause alarm (CORRELATED).
+	Correlation *AlarmSubscriptionInfoCorrelation `json:"correlation,omitempty"`
+
 	// Filter Criteria for events which do not need to be reported or will be filtered by the subscription
 	// notification service. Therefore, if a filter is not provided then all events are reported.
 	// It can be filtered by criteria based on the type of notification of fields of the
 	// AlarmEventRecord.
 	Filter *AlarmSubscriptionInfoFilter `json:"filter,omitempty"`
+
+	// Selector Filter expression, in the same syntax as the filter query parameter, selecting the alarms notified to the
+	// subscriber. The expression can refer to the perceivedSeverity, resourceTypeID, resourceID, nodeClusterID,
+	// alarmDefinitionID and probableCauseID attributes of the alarm, and to its extensions (e.g.
+	// extensions/namespace). The nodeClusterID attribute is only set for the alarms raised by node clusters.
+	Selector *string `json:"selector,omitempty"`
+
+	// SigningSecret Optional shared secret used to sign the notifications sent to the subscriber. When set, each notification
+	// carries an HMAC-SHA256 signature in the X-O2ims-Signature header. The secret is never returned.
+	SigningSecret *string `json:"signingSecret,omitempty"`
+
+	// VerifyCallback Requests a verification handshake with the callback before the subscription is created. A
+	// CallbackVerification challenge is POSTed to the callback, and the subscription is rejected unless the
+	// challenge is echoed back.
+	VerifyCallback *bool `json:"verifyCallback,omitempty"`
 }
 
+// AlarmSubscriptionInfoCorrelation Selects whether the subscriber is notified of all the alarms (RAW), or only of the alarms that are not
+// correlated to a root cause alarm (CORRELATED).
+type AlarmSubscriptionInfoCorrelation string
+
 // AlarmSubscriptionInfoFilter Criteria for events which do not need to be reported or will be filtered by the subscription
 // notification service. Therefore, if a filter is not provided then all events are reported.
 // It can be filtered by criteria based on the type of notification of fields of the
@@ -246,12 +329,99 @@ type AlertmanagerNotification struct {
 // AlertmanagerNotificationStatus Alertmanager notification status
 type AlertmanagerNotificationStatus string
 
-// HardwareAlert Placeholder for hardware alert schema
-type HardwareAlert = map[string]interface{}
+// HardwareAlert Hardware alert notification sent by a hardware plugin
+type HardwareAlert struct {
+	Alerts []HardwareAlertEvent `json:"alerts"`
+
+	// Complete When true, the alerts list contains the complete set of faults currently active for the hardware plugin.
+	// Any previously reported fault that is not included is resolved.
+	Complete *bool `json:"complete,omitempty"`
+}
+
+// HardwareAlertEvent A single hardware fault reported by a hardware plugin (e.g. a BMC/Redfish event)
+type HardwareAlertEvent struct {
+	// AlarmName Name of the fault. Used with the severity to look up the matching alarm definition.
+	AlarmName string `json:"alarmName"`
+
+	// EndsAt Time at which the fault was cleared
+	EndsAt *time.Time `json:"endsAt,omitempty"`
+
+	// Extensions Additional information about the fault (e.g. the raw BMC message)
+	Extensions *map[string]string `json:"extensions,omitempty"`
+
+	// Fingerprint Identifies the fault at the source. It must be stable for the lifetime of the fault.
+	Fingerprint string `json:"fingerprint"`
+
+	// Model Model of the faulty resource. Used to derive the resource type.
+	Model *string `json:"model,omitempty"`
+
+	// ResourceId Identifier of the faulty resource as reported by the hardware plugin inventory API
+	ResourceId string `json:"resourceId"`
+
+	// Severity Severity of the fault (critical, major, minor, warning)
+	Severity string `json:"severity"`
+
+	// StartsAt Time at which the fault was first observed
+	StartsAt time.Time `json:"startsAt"`
+
+	// Status Alertmanager notification status
+	Status AlertmanagerNotificationStatus `json:"status"`
+
+	// Vendor Vendor of the faulty resource. Used to derive the resource type.
+	Vendor *string `json:"vendor,omitempty"`
+}
+
+// MaintenanceWindow Period during which the notifications of the alarms raised by a node cluster are held back. A window is opened
+// automatically for the node cluster of a ProvisioningRequest while its upgrade is in progress.
+type MaintenanceWindow struct {
+	// AlarmDefinitionIds Alarm definitions covered by the window. All the alarms of the node cluster are covered if not set.
+	AlarmDefinitionIds *[]openapi_types.UUID `json:"alarmDefinitionIds,omitempty"`
+
+	// Description Free text describing the maintenance.
+	Description *string `json:"description,omitempty"`
+
+	// EndTime Time at which the window closes. It is required when the window is created. It is not set for windows
+	// opened by a ProvisioningRequest upgrade until the upgrade completes.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// MaintenanceWindowId Identifier of the maintenance window. This identifier is allocated by the O-Cloud.
+	MaintenanceWindowId *openapi_types.UUID `json:"maintenanceWindowId,omitempty"`
+
+	// NodeClusterId Identifier of the node cluster whose alarms are covered by the window.
+	NodeClusterId openapi_types.UUID `json:"nodeClusterId"`
+
+	// ProvisioningRequestName Name of the ProvisioningRequest whose upgrade opened the window.
+	ProvisioningRequestName *string `json:"provisioningRequestName,omitempty"`
+
+	// ReleasedNotifications Number of notifications sent when the window closed. Alarms raised and cleared during the window are not
+	// notified.
+	ReleasedNotifications *int `json:"releasedNotifications,omitempty"`
+
+	// StartTime Time at which notifications start being held back.
+	StartTime time.Time `json:"startTime"`
+
+	// Status Whether the window is yet to start, holding back notifications, or closed.
+	Status *MaintenanceWindowStatus `json:"status,omitempty"`
+
+	// SummarySentAt Time at which the notifications held back by the window were sent.
+	SummarySentAt *time.Time `json:"summarySentAt,omitempty"`
+
+	// SuppressedAlarms Number of alarms whose notifications were held back. Set when the window is closed.
+	SuppressedAlarms *int `json:"suppressedAlarms,omitempty"`
+}
+
+// MaintenanceWindowStatus Whether the window is yet to start, holding back notifications, or closed.
+type MaintenanceWindowStatus string
 
 // PerceivedSeverity This is an enumerated set of values which identify the perceived severity of the alarm.
 type PerceivedSeverity int
 
+// NextpageOpaqueMarker defines model for nextpageOpaqueMarker.
+type NextpageOpaqueMarker = string
+
+// NodeClusterId defines model for nodeClusterId.
+type NodeClusterId = openapi_types.UUID
+
 // GetSubscriptionsParams defines parameters for GetSubscriptions.
 type GetSubscriptionsParams struct {
 	// AllFields This URI query parameter requests that all complex attributes are included in the response.
@@ -434,6 +604,106 @@ type GetAlarmsParams struct {
 	//
 	// When this parameter isn't used all the results will be returned.
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
+
+	// NextpageOpaqueMarker Marker to obtain the next page of a paged response, as defined in section 5.4.2.3 of ETSI GS NFV-SOL 013.  The
+	// value is opaque and must be copied from the `Link` header of the previous page.
+	NextpageOpaqueMarker *NextpageOpaqueMarker `form:"nextpage_opaque_marker,omitempty" json:"nextpage_opaque_marker,omitempty"`
+
+	// NodeClusterId Only return the alarms of a node cluster: those raised against the node cluster itself and those raised
+	// against the hardware resources of its nodes.
+	NodeClusterId *NodeClusterId `form:"node_cluster_id,omitempty" json:"node_cluster_id,omitempty"`
+}
+
+// GetMaintenanceWindowsParams defines parameters for GetMaintenanceWindows.
+type GetMaintenanceWindowsParams struct {
+	// AllFields This URI query parameter requests that all complex attributes are included in the response.
+	//
+	// ```
+	// all_fields
+	// ```
+	AllFields *externalRef0.AllFields `form:"all_fields,omitempty" json:"all_fields,omitempty"`
+
+	// ExcludeFields Comma separated list of field references to exclude from the result.
+	//
+	// Each field reference is a field name, or a sequence of field names separated by slashes. For
+	// example, to exclude the `country` subfield of the `extensions` field:
+	//
+	// ```
+	// exclude_fields=extensions/country
+	// ```
+	//
+	// When this parameter isn't used no field will be excluded.
+	//
+	// Fields in this list will be excluded even if they are explicitly included using the
+	// `fields` parameter.
+	ExcludeFields *externalRef0.ExcludeFields `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
+
+	// Fields Comma separated list of field references to include in the result.
+	//
+	// Each field reference is a field name, or a sequence of field names separated by slashes. For
+	// example, to get the `name` field and the `country` subfield of the `extensions` field:
+	//
+	// ```
+	// fields=name,extensions/country
+	// ```
+	//
+	// When this parameter isn't used all the fields will be returned.
+	Fields *externalRef0.Fields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Filter Search criteria.
+	//
+	// Contains one or more search criteria, separated by semicolons. Each search criteria is a
+	// tuple containing an operator, a field reference and one or more values. The operator can
+	// be any of the following strings:
+	//
+	// | Operator | Meaning                                                     |
+	// |----------|-------------------------------------------------------------|
+	// | `cont`   | Matches if the field contains the value                     |
+	// | `eq`     | Matches if the field is equal to the value                  |
+	// | `gt`     | Matches if the field is greater than the value              |
+	// | `gte`    | Matches if the field is greater than or equal to the value  |
+	// | `in`     | Matches if the field is one of the values                   |
+	// | `lt`     | Matches if the field is less than the value                 |
+	// | `lte`    | Matches if the field is less than or equal to the the value |
+	// | `ncont`  | Matches if the field does not contain the value             |
+	// | `neq`    | Matches if the field is not equal to the value              |
+	// | `nin`    | Matches if the field is not one of the values               |
+	//
+	// The field reference is the name of one of the fields of the object, or a sequence of
+	// name of fields separated by slashes. For example, to use the `country` sub-field inside
+	// the `extensions` field:
+	//
+	// ```
+	// filter=(eq,extensions/country,EQ)
+	// ```
+	//
+	// The values are the arguments of the operator. For example, the `eq` operator compares
+	// checks if the value of the field is equal to the value.
+	//
+	// The `in` and `nin` operators support multiple values. For example, to check if the `country`
+	// sub-field inside the `extensions` field is either `ES` or `US:
+	//
+	// ```
+	// filter=(in,extensions/country,ES,US)
+	// ```
+	//
+	// When values contain commas, slashes or spaces they need to be surrounded by single quotes.
+	// For example, to check if the `name` field is the string `my cluster`:
+	//
+	// ```
+	// filter=(eq,name,'my cluster')
+	// ```
+	//
+	// When multiple criteria separated by semicolons are used, all of them must match for the
+	// complete condition to match. For example, the following will check if the `name` is
+	// `my cluster` *and* the `country` extension is `ES`:
+	//
+	// ```
+	// filter=(eq,name,'my cluster');(eq,extensions/country,ES)
+	// ```
+	//
+	// When this parameter isn't used all the results will be returned.
+	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
 // AmNotificationJSONRequestBody defines body for AmNotification for application/json ContentType.
@@ -451,9 +721,15 @@ type UpdateAlarmServiceConfigurationJSONRequestBody = AlarmServiceConfiguration
 // CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
 type CreateSubscriptionJSONRequestBody = AlarmSubscriptionInfo
 
+// RotateSubscriptionSigningSecretJSONRequestBody defines body for RotateSubscriptionSigningSecret for application/json ContentType.
+type RotateSubscriptionSigningSecretJSONRequestBody = externalRef0.SigningSecretRotation
+
 // PatchAlarmApplicationMergePatchPlusJSONRequestBody defines body for PatchAlarm for application/merge-patch+json ContentType.
 type PatchAlarmApplicationMergePatchPlusJSONRequestBody = AlarmEventRecordModifications
 
+// CreateMaintenanceWindowJSONRequestBody defines body for CreateMaintenanceWindow for application/json ContentType.
+type CreateMaintenanceWindowJSONRequestBody = MaintenanceWindow
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Receive Alertmanager notifications
@@ -486,6 +762,15 @@ type ServerInterface interface {
 	// Retrieve exactly one subscription
 	// (GET /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId})
 	GetSubscription(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID)
 	// Retrieve the list of alarms
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms)
 	GetAlarms(w http.ResponseWriter, r *http.Request, params GetAlarmsParams)
@@ -495,9 +780,24 @@ type ServerInterface interface {
 	// Modify an individual alarm record
 	// (PATCH /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId})
 	PatchAlarm(w http.ResponseWriter, r *http.Request, alarmEventRecordId openapi_types.UUID)
+	// Retrieve the state transitions of an alarm
+	// (GET /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}/history)
+	GetAlarmHistory(w http.ResponseWriter, r *http.Request, alarmEventRecordId openapi_types.UUID)
 	// Get minor API versions
 	// (GET /o2ims-infrastructureMonitoring/v1/api_versions)
 	GetMinorVersions(w http.ResponseWriter, r *http.Request)
+	// Retrieve the list of maintenance windows
+	// (GET /o2ims-infrastructureMonitoring/v1/maintenanceWindows)
+	GetMaintenanceWindows(w http.ResponseWriter, r *http.Request, params GetMaintenanceWindowsParams)
+	// Create a maintenance window
+	// (POST /o2ims-infrastructureMonitoring/v1/maintenanceWindows)
+	CreateMaintenanceWindow(w http.ResponseWriter, r *http.Request)
+	// Delete a maintenance window
+	// (DELETE /o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId})
+	DeleteMaintenanceWindow(w http.ResponseWriter, r *http.Request, maintenanceWindowId openapi_types.UUID)
+	// Retrieve exactly one maintenance window
+	// (GET /o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId})
+	GetMaintenanceWindow(w http.ResponseWriter, r *http.Request, maintenanceWindowId openapi_types.UUID)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -779,6 +1079,99 @@ func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "alarmSubscriptionId" -------------
+	var alarmSubscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alarmSubscriptionId", r.PathValue("alarmSubscriptionId"), &alarmSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alarmSubscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionDeadLetters(w, r, alarmSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "alarmSubscriptionId" -------------
+	var alarmSubscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alarmSubscriptionId", r.PathValue("alarmSubscriptionId"), &alarmSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alarmSubscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaySubscriptionDeadLetters(w, r, alarmSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "alarmSubscriptionId" -------------
+	var alarmSubscriptionId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alarmSubscriptionId", r.PathValue("alarmSubscriptionId"), &alarmSubscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alarmSubscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSubscriptionSigningSecret(w, r, alarmSubscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAlarms operation middleware
 func (siw *ServerInterfaceWrapper) GetAlarms(w http.ResponseWriter, r *http.Request) {
 
@@ -825,6 +1218,22 @@ func (siw *ServerInterfaceWrapper) GetAlarms(w http.ResponseWriter, r *http.Requ
 		return
 	}
 
+	// ------------- Optional query parameter "nextpage_opaque_marker" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nextpage_opaque_marker", r.URL.Query(), &params.NextpageOpaqueMarker)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "nextpage_opaque_marker", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "node_cluster_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "node_cluster_id", r.URL.Query(), &params.NodeClusterId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "node_cluster_id", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		siw.Handler.GetAlarms(w, r, params)
 	}))
@@ -898,12 +1307,43 @@ func (siw *ServerInterfaceWrapper) PatchAlarm(w http.ResponseWriter, r *http.Req
 	handler.ServeHTTP(w, r)
 }
 
-// GetMinorVersions operation middleware
-func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
+// GetAlarmHistory operation middleware
+func (siw *ServerInterfaceWrapper) GetAlarmHistory(w http.ResponseWriter, r *http.Request) {
 
-	ctx := r.Context()
+	var err error
 
-	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+	// ------------- Path parameter "alarmEventRecordId" -------------
+	var alarmEventRecordId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "alarmEventRecordId", r.PathValue("alarmEventRecordId"), &alarmEventRecordId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "alarmEventRecordId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAlarmHistory(w, r, alarmEventRecordId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMinorVersions operation middleware
+func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
 
 	r = r.WithContext(ctx)
 
@@ -918,6 +1358,145 @@ func (siw *ServerInterfaceWrapper) GetMinorVersions(w http.ResponseWriter, r *ht
 	handler.ServeHTTP(w, r)
 }
 
+// GetMaintenanceWindows operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceWindows(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMaintenanceWindowsParams
+
+	// ------------- Optional query parameter "all_fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "all_fields", r.URL.Query(), &params.AllFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all_fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "exclude_fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "filter" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceWindows(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMaintenanceWindow operation middleware
+func (siw *ServerInterfaceWrapper) CreateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMaintenanceWindow(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMaintenanceWindow operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "maintenanceWindowId" -------------
+	var maintenanceWindowId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "maintenanceWindowId", r.PathValue("maintenanceWindowId"), &maintenanceWindowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maintenanceWindowId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMaintenanceWindow(w, r, maintenanceWindowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMaintenanceWindow operation middleware
+func (siw *ServerInterfaceWrapper) GetMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "maintenanceWindowId" -------------
+	var maintenanceWindowId openapi_types.UUID
+
+	err = runtime.BindStyledParameterWithOptions("simple", "maintenanceWindowId", r.PathValue("maintenanceWindowId"), &maintenanceWindowId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "maintenanceWindowId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMaintenanceWindow(w, r, maintenanceWindowId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1048,10 +1627,18 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions", wrapper.CreateSubscription)
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}", wrapper.DeleteSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}", wrapper.GetSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters", wrapper.GetSubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters/replay", wrapper.ReplaySubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/signingSecret/rotate", wrapper.RotateSubscriptionSigningSecret)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms", wrapper.GetAlarms)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}", wrapper.GetAlarm)
 	m.HandleFunc("PATCH "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}", wrapper.PatchAlarm)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}/history", wrapper.GetAlarmHistory)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/api_versions", wrapper.GetMinorVersions)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/maintenanceWindows", wrapper.GetMaintenanceWindows)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/maintenanceWindows", wrapper.CreateMaintenanceWindow)
+	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId}", wrapper.DeleteMaintenanceWindow)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId}", wrapper.GetMaintenanceWindow)
 
 	return m
 }
@@ -1587,257 +2174,742 @@ func (response GetSubscription500ApplicationProblemPlusJSONResponse) VisitGetSub
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarmsRequestObject struct {
-	Params GetAlarmsParams
+type GetSubscriptionDeadLettersRequestObject struct {
+	AlarmSubscriptionId openapi_types.UUID `json:"alarmSubscriptionId"`
 }
 
-type GetAlarmsResponseObject interface {
-	VisitGetAlarmsResponse(w http.ResponseWriter) error
+type GetSubscriptionDeadLettersResponseObject interface {
+	VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type GetAlarms200JSONResponse []AlarmEventRecord
+type GetSubscriptionDeadLetters200JSONResponse externalRef0.DeadLetterQueue
 
-func (response GetAlarms200JSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters200JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarms400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarms400ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarms401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarms401ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarms403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarms403ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarms500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarms500ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarmRequestObject struct {
-	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
+type GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarmResponseObject interface {
-	VisitGetAlarmResponse(w http.ResponseWriter) error
+type ReplaySubscriptionDeadLettersRequestObject struct {
+	AlarmSubscriptionId openapi_types.UUID `json:"alarmSubscriptionId"`
 }
 
-type GetAlarm200JSONResponse AlarmEventRecord
+type ReplaySubscriptionDeadLettersResponseObject interface {
+	VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
 
-func (response GetAlarm200JSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type ReplaySubscriptionDeadLetters202Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response ReplaySubscriptionDeadLetters202Response) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
 }
 
-type GetAlarm400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarm400ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarm401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarm401ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarm403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarm403ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarm404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarm404ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetAlarm500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetAlarm500ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarmRequestObject struct {
-	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
-	Body               *PatchAlarmApplicationMergePatchPlusJSONRequestBody
+type RotateSubscriptionSigningSecretRequestObject struct {
+	AlarmSubscriptionId openapi_types.UUID `json:"alarmSubscriptionId"`
+	Body                *RotateSubscriptionSigningSecretJSONRequestBody
 }
 
-type PatchAlarmResponseObject interface {
-	VisitPatchAlarmResponse(w http.ResponseWriter) error
+type RotateSubscriptionSigningSecretResponseObject interface {
+	VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error
 }
 
-type PatchAlarm200JSONResponse AlarmEventRecordModifications
+type RotateSubscriptionSigningSecret200JSONResponse externalRef0.SigningSecretStatus
 
-func (response PatchAlarm200JSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret200JSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response PatchAlarm400ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response PatchAlarm401ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response PatchAlarm403ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response PatchAlarm404ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm409ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response PatchAlarm409ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(409)
+	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type PatchAlarm412ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
-
-func (response PatchAlarm412ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(412)
-
-	return json.NewEncoder(w).Encode(response)
+type GetAlarmsRequestObject struct {
+	Params GetAlarmsParams
 }
 
-type PatchAlarm500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
-
-func (response PatchAlarm500ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(500)
-
-	return json.NewEncoder(w).Encode(response)
+type GetAlarmsResponseObject interface {
+	VisitGetAlarmsResponse(w http.ResponseWriter) error
 }
 
-type GetMinorVersionsRequestObject struct {
+type GetAlarms200ResponseHeaders struct {
+	Link string
 }
 
-type GetMinorVersionsResponseObject interface {
-	VisitGetMinorVersionsResponse(w http.ResponseWriter) error
+type GetAlarms200JSONResponse struct {
+	Body    []AlarmEventRecord
+	Headers GetAlarms200ResponseHeaders
 }
 
-type GetMinorVersions200JSONResponse externalRef0.APIVersions
-
-func (response GetMinorVersions200JSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+func (response GetAlarms200JSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Link", fmt.Sprint(response.Headers.Link))
 	w.WriteHeader(200)
 
-	return json.NewEncoder(w).Encode(response)
+	return json.NewEncoder(w).Encode(response.Body)
 }
 
-type GetMinorVersions400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetAlarms400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetMinorVersions400ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+func (response GetAlarms400ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMinorVersions401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetAlarms401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetMinorVersions401ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+func (response GetAlarms401ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMinorVersions403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetAlarms403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetMinorVersions403ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+func (response GetAlarms403ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetMinorVersions500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetAlarms500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetMinorVersions500ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+func (response GetAlarms500ApplicationProblemPlusJSONResponse) VisitGetAlarmsResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// Receive Alertmanager notifications
+type GetAlarmRequestObject struct {
+	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
+}
+
+type GetAlarmResponseObject interface {
+	VisitGetAlarmResponse(w http.ResponseWriter) error
+}
+
+type GetAlarm200JSONResponse AlarmEventRecord
+
+func (response GetAlarm200JSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarm400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarm400ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarm401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarm401ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarm403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarm403ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarm404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarm404ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarm500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarm500ApplicationProblemPlusJSONResponse) VisitGetAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarmRequestObject struct {
+	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
+	Body               *PatchAlarmApplicationMergePatchPlusJSONRequestBody
+}
+
+type PatchAlarmResponseObject interface {
+	VisitPatchAlarmResponse(w http.ResponseWriter) error
+}
+
+type PatchAlarm200JSONResponse AlarmEventRecordModifications
+
+func (response PatchAlarm200JSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm400ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm401ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm403ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm404ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm409ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm409ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm412ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm412ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchAlarm500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response PatchAlarm500ApplicationProblemPlusJSONResponse) VisitPatchAlarmResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistoryRequestObject struct {
+	AlarmEventRecordId openapi_types.UUID `json:"alarmEventRecordId"`
+}
+
+type GetAlarmHistoryResponseObject interface {
+	VisitGetAlarmHistoryResponse(w http.ResponseWriter) error
+}
+
+type GetAlarmHistory200JSONResponse []AlarmEventHistoryRecord
+
+func (response GetAlarmHistory200JSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistory400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarmHistory400ApplicationProblemPlusJSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistory401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarmHistory401ApplicationProblemPlusJSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistory403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarmHistory403ApplicationProblemPlusJSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistory404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarmHistory404ApplicationProblemPlusJSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAlarmHistory500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetAlarmHistory500ApplicationProblemPlusJSONResponse) VisitGetAlarmHistoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMinorVersionsRequestObject struct {
+}
+
+type GetMinorVersionsResponseObject interface {
+	VisitGetMinorVersionsResponse(w http.ResponseWriter) error
+}
+
+type GetMinorVersions200JSONResponse externalRef0.APIVersions
+
+func (response GetMinorVersions200JSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMinorVersions400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMinorVersions400ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMinorVersions401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMinorVersions401ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMinorVersions403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMinorVersions403ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMinorVersions500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMinorVersions500ApplicationProblemPlusJSONResponse) VisitGetMinorVersionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindowsRequestObject struct {
+	Params GetMaintenanceWindowsParams
+}
+
+type GetMaintenanceWindowsResponseObject interface {
+	VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error
+}
+
+type GetMaintenanceWindows200JSONResponse []MaintenanceWindow
+
+func (response GetMaintenanceWindows200JSONResponse) VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindows400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindows400ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindows401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindows401ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindows403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindows403ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindows500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindows500ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceWindowRequestObject struct {
+	Body *CreateMaintenanceWindowJSONRequestBody
+}
+
+type CreateMaintenanceWindowResponseObject interface {
+	VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error
+}
+
+type CreateMaintenanceWindow201JSONResponse MaintenanceWindow
+
+func (response CreateMaintenanceWindow201JSONResponse) VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse) VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceWindow401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateMaintenanceWindow401ApplicationProblemPlusJSONResponse) VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceWindow403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateMaintenanceWindow403ApplicationProblemPlusJSONResponse) VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMaintenanceWindow500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateMaintenanceWindow500ApplicationProblemPlusJSONResponse) VisitCreateMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceWindowRequestObject struct {
+	MaintenanceWindowId openapi_types.UUID `json:"maintenanceWindowId"`
+}
+
+type DeleteMaintenanceWindowResponseObject interface {
+	VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error
+}
+
+type DeleteMaintenanceWindow200Response struct {
+}
+
+func (response DeleteMaintenanceWindow200Response) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteMaintenanceWindow400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteMaintenanceWindow400ApplicationProblemPlusJSONResponse) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceWindow401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteMaintenanceWindow401ApplicationProblemPlusJSONResponse) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceWindow403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteMaintenanceWindow403ApplicationProblemPlusJSONResponse) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceWindow404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteMaintenanceWindow404ApplicationProblemPlusJSONResponse) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMaintenanceWindow500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteMaintenanceWindow500ApplicationProblemPlusJSONResponse) VisitDeleteMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindowRequestObject struct {
+	MaintenanceWindowId openapi_types.UUID `json:"maintenanceWindowId"`
+}
+
+type GetMaintenanceWindowResponseObject interface {
+	VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error
+}
+
+type GetMaintenanceWindow200JSONResponse MaintenanceWindow
+
+func (response GetMaintenanceWindow200JSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindow400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindow400ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindow401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindow401ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindow403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindow403ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindow404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindow404ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMaintenanceWindow500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetMaintenanceWindow500ApplicationProblemPlusJSONResponse) VisitGetMaintenanceWindowResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// Receive Alertmanager notifications
 	// (POST /internal/v1/caas-alerts/alertmanager)
 	AmNotification(ctx context.Context, request AmNotificationRequestObject) (AmNotificationResponseObject, error)
 	// Receive hardware alerts
@@ -1867,6 +2939,15 @@ type StrictServerInterface interface {
 	// Retrieve exactly one subscription
 	// (GET /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId})
 	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(ctx context.Context, request GetSubscriptionDeadLettersRequestObject) (GetSubscriptionDeadLettersResponseObject, error)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(ctx context.Context, request ReplaySubscriptionDeadLettersRequestObject) (ReplaySubscriptionDeadLettersResponseObject, error)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureMonitoring/v1/alarmSubscriptions/{alarmSubscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(ctx context.Context, request RotateSubscriptionSigningSecretRequestObject) (RotateSubscriptionSigningSecretResponseObject, error)
 	// Retrieve the list of alarms
 	// (GET /o2ims-infrastructureMonitoring/v1/alarms)
 	GetAlarms(ctx context.Context, request GetAlarmsRequestObject) (GetAlarmsResponseObject, error)
@@ -1876,9 +2957,24 @@ type StrictServerInterface interface {
 	// Modify an individual alarm record
 	// (PATCH /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId})
 	PatchAlarm(ctx context.Context, request PatchAlarmRequestObject) (PatchAlarmResponseObject, error)
+	// Retrieve the state transitions of an alarm
+	// (GET /o2ims-infrastructureMonitoring/v1/alarms/{alarmEventRecordId}/history)
+	GetAlarmHistory(ctx context.Context, request GetAlarmHistoryRequestObject) (GetAlarmHistoryResponseObject, error)
 	// Get minor API versions
 	// (GET /o2ims-infrastructureMonitoring/v1/api_versions)
 	GetMinorVersions(ctx context.Context, request GetMinorVersionsRequestObject) (GetMinorVersionsResponseObject, error)
+	// Retrieve the list of maintenance windows
+	// (GET /o2ims-infrastructureMonitoring/v1/maintenanceWindows)
+	GetMaintenanceWindows(ctx context.Context, request GetMaintenanceWindowsRequestObject) (GetMaintenanceWindowsResponseObject, error)
+	// Create a maintenance window
+	// (POST /o2ims-infrastructureMonitoring/v1/maintenanceWindows)
+	CreateMaintenanceWindow(ctx context.Context, request CreateMaintenanceWindowRequestObject) (CreateMaintenanceWindowResponseObject, error)
+	// Delete a maintenance window
+	// (DELETE /o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId})
+	DeleteMaintenanceWindow(ctx context.Context, request DeleteMaintenanceWindowRequestObject) (DeleteMaintenanceWindowResponseObject, error)
+	// Retrieve exactly one maintenance window
+	// (GET /o2ims-infrastructureMonitoring/v1/maintenanceWindows/{maintenanceWindowId})
+	GetMaintenanceWindow(ctx context.Context, request GetMaintenanceWindowRequestObject) (GetMaintenanceWindowResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -2193,6 +3289,91 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID) {
+	var request GetSubscriptionDeadLettersRequestObject
+
+	request.AlarmSubscriptionId = alarmSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionDeadLetters(ctx, request.(GetSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (sh *strictHandler) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID) {
+	var request ReplaySubscriptionDeadLettersRequestObject
+
+	request.AlarmSubscriptionId = alarmSubscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaySubscriptionDeadLetters(ctx, request.(ReplaySubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaySubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaySubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitReplaySubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (sh *strictHandler) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, alarmSubscriptionId openapi_types.UUID) {
+	var request RotateSubscriptionSigningSecretRequestObject
+
+	request.AlarmSubscriptionId = alarmSubscriptionId
+
+	var body RotateSubscriptionSigningSecretJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateSubscriptionSigningSecret(ctx, request.(RotateSubscriptionSigningSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateSubscriptionSigningSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateSubscriptionSigningSecretResponseObject); ok {
+		if err := validResponse.VisitRotateSubscriptionSigningSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAlarms operation middleware
 func (sh *strictHandler) GetAlarms(w http.ResponseWriter, r *http.Request, params GetAlarmsParams) {
 	var request GetAlarmsRequestObject
@@ -2278,6 +3459,32 @@ func (sh *strictHandler) PatchAlarm(w http.ResponseWriter, r *http.Request, alar
 	}
 }
 
+// GetAlarmHistory operation middleware
+func (sh *strictHandler) GetAlarmHistory(w http.ResponseWriter, r *http.Request, alarmEventRecordId openapi_types.UUID) {
+	var request GetAlarmHistoryRequestObject
+
+	request.AlarmEventRecordId = alarmEventRecordId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAlarmHistory(ctx, request.(GetAlarmHistoryRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAlarmHistory")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAlarmHistoryResponseObject); ok {
+		if err := validResponse.VisitGetAlarmHistoryResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetMinorVersions operation middleware
 func (sh *strictHandler) GetMinorVersions(w http.ResponseWriter, r *http.Request) {
 	var request GetMinorVersionsRequestObject
@@ -2302,102 +3509,278 @@ func (sh *strictHandler) GetMinorVersions(w http.ResponseWriter, r *http.Request
 	}
 }
 
+// GetMaintenanceWindows operation middleware
+func (sh *strictHandler) GetMaintenanceWindows(w http.ResponseWriter, r *http.Request, params GetMaintenanceWindowsParams) {
+	var request GetMaintenanceWindowsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMaintenanceWindows(ctx, request.(GetMaintenanceWindowsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMaintenanceWindows")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMaintenanceWindowsResponseObject); ok {
+		if err := validResponse.VisitGetMaintenanceWindowsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateMaintenanceWindow operation middleware
+func (sh *strictHandler) CreateMaintenanceWindow(w http.ResponseWriter, r *http.Request) {
+	var request CreateMaintenanceWindowRequestObject
+
+	var body CreateMaintenanceWindowJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateMaintenanceWindow(ctx, request.(CreateMaintenanceWindowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateMaintenanceWindow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateMaintenanceWindowResponseObject); ok {
+		if err := validResponse.VisitCreateMaintenanceWindowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteMaintenanceWindow operation middleware
+func (sh *strictHandler) DeleteMaintenanceWindow(w http.ResponseWriter, r *http.Request, maintenanceWindowId openapi_types.UUID) {
+	var request DeleteMaintenanceWindowRequestObject
+
+	request.MaintenanceWindowId = maintenanceWindowId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteMaintenanceWindow(ctx, request.(DeleteMaintenanceWindowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteMaintenanceWindow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteMaintenanceWindowResponseObject); ok {
+		if err := validResponse.VisitDeleteMaintenanceWindowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetMaintenanceWindow operation middleware
+func (sh *strictHandler) GetMaintenanceWindow(w http.ResponseWriter, r *http.Request, maintenanceWindowId openapi_types.UUID) {
+	var request GetMaintenanceWindowRequestObject
+
+	request.MaintenanceWindowId = maintenanceWindowId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetMaintenanceWindow(ctx, request.(GetMaintenanceWindowRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetMaintenanceWindow")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetMaintenanceWindowResponseObject); ok {
+		if err := validResponse.VisitGetMaintenanceWindowResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9eXPbttb3V8Hw3pkm94paqcV+p/OO6ziJbmPHj+3czjyRp4aIQwk1CSgAaEdN/d2f",
-	"AcBVohYvSdxW+SeRBAIHZ/2dgwPmi+PzaMYZMCWd/S/ODAscgQJhPvk8ijj7Fc/or3wGTP+Nw/A1hZCY",
-	"3wlIX9CZopw5+87FlEr04WyIPsUg5iibCgn4FINUEqkpVgiHIdKLhvAZYaUEHccKJMICEGV+GBMgiDKk",
-	"poAEyBlnEuojNmJXV1cjhsPw18Csn3zh1ByqFzdrOjWH4QicfScf59Qc6U8hwpbgAMehcvadAIcS9Pg4",
-	"DPE4BGdfiRhqjprP9PNSCcomzt1drYoJ8NnQuYoRhzyKMJKgOaCAoJBKhXiADEFIQAACmA8SKY6SqVAg",
-	"eJTuOQ6V2fER9qeLDyEqEU6+1HutIS6QXuxTbH7OltE/ygIR4zmSIZZTkHX0mosRg89YC6FWpEITcOXz",
-	"mCkxv0IyHtu5eGB/gc8KmKScySu7yn4mmGSGhOk/5iMbyXTJuBH7ZQpaulQWNIRK9oNCsQSCGE82cEvD",
-	"EI0hpY0YlliWW/2g0nJ2cSCCG2CIGprnRq/g8yykPlXhPFexWFI20UNG7MoSfZUTVDeKlXDI2TdaVVve",
-	"0wrlK/OipIBbqVfwBHqV7LNgSd9eqyagrN7opxKNQZiRR6hZol4r5LGtjmkXpFeys2UKJEDFghlNe4T0",
-	"Hy71UIFYlvo5YOFPkS+oAkGxkeEhZwpTJhFnoEUVcQFIlgfWFsQEEfV5yJmsI6MCC8ONCoyYimchIN/O",
-	"ry0EM8RnILDiopbpSK44WpxFIm5wGGtluJhC9hzyMRuxsR48T4Uc8DDkt3oByxVpZPwHep8+8wc6Bmwo",
-	"eMifP0bsDzf7U/jnA/7oubS6MnWlZ0bHWPlTkImHSTjipxLRXxkmrKQLXcGnK/upei4qEXyKcahtaM10",
-	"dq6J2jTXRADWBqCmmK2aL50Lru4xFxeVdNq5KNtEl1GbIH9SruRXuHGPIUi5doOFuTbtMZ9rcYP53HYu",
-	"lijFirkIB4kYV6lyrKAtmStRitV06Zk26UUyV8L89XNt4v8f2iIvsqdKwUI/pP2dnqAwT+JQk098/Bv4",
-	"ajmWjFj6aDJ+ZTxBxXASywqA4iZbYpISGLHN8UM72R9fwKcKh147+p+XWQi5yNmiIYSeGItJHGmknG0w",
-	"cVaLtBoiPl0VHCCPZliAHDF/Cv51Jg8rQb7R+OspRcastM+1Mk4XkEjGsxkXCkVxqKh24akjXuSiISBd",
-	"P2PliC3yckUoNvRRNQWBro7Or7Rsrz6cLzOYskoGn9c+nL8sh+mEyamN6MiIZS1VA72AnGGDajScYwBE",
-	"b2MMSMZC8JiRRG0om4SAPsVcgayP2Pp9FxFJos42DqGraI78MJYKxFWl3hg08EM+6oeF/WQSyCLrijhs",
-	"9ErjkZoBJFYLIhTFUqFI2y0KuLAI1eZLygRmQjUw0Fsygyp0L4+tBtlU7Zzq/KmwU/QvzMi/FswrE6Bm",
-	"kZb2lvz4f6vMa1H0mxGaxa2bIVpGSE7Hy5X4zOCsdfjsLv3RgPCDEIvo6AaYOuGKBtTHFpktAjUzDpmB",
-	"qDgSSf2N4tpd6fFjnV3XnJnQ1qsomEWwfvjAv2b8NgQygQuqiV1c4hVW0NA/IalwNEv8x63mpcZWJXeS",
-	"k30GPhcETbFEY9AaygkNKJB6iYXtptd2m3233bpot/c77f324H+dmhNwEWGdMROswFWarKUsubZEPlmm",
-	"/SfOQ8CJvSPKiGEPm2h6MYowwxPQ3hXJuVQQGXJxYUbrgvU6JbpLafvYrpFRdDjFbALk+zKz9SBmvoKA",
-	"MmPqw1cVulYIx0mgsOqXP4aEpTTBHcnP1Ne/YTFHWEruU+OWbqmaJuaWTErQGUgeCx8u5jMo7w13cLfZ",
-	"9fvuwCO+6/lB4I5xMHA7vaDTGXeg3wz84l7jmJKV2yzwdEiqakqAPpy9K+2xKAYLMcr0dYN+bwCDPbeN",
-	"oeV6/T5x9/b6A7fV7zS7vVbXI12yNX1nmMoHKNB6nfENil6jMs37qozPmYwjEOfWxRgKV/HTkjkT/IYm",
-	"sVNTm86Q6osszFQmtEOI32q1By5uecT1egTcQZNgt+e1vHFrr+3DXrANf/MIYRwgsYENh6clx7j02NKG",
-	"JNhIyuQMfGOL6IXGt1JhRrAg9HcgL1HubtGLa5jLl+h2Sv2pBXeYhlzkvLgBRrhAXIxYBuFMlUxBUr2g",
-	"zG5P21nGSTzmsa14vHcPQx4TqwI2ViX7sAqr9zEJ+RiHZlyVhWtJ2SHIN3NRAkwHFRDadOmE5fSeH7+v",
-	"Q0lGBHu9vcEYu/4A912vvUdcDK2e6w28oNUN2oO2391GRqwQx4wqX5gRi8SWwh2YCKinSrCdpozFkbP/",
-	"sVlr1dq1zmWB1Ga2KmUKJiYyf3b1ePcGC1NvcvY/OidHvzg15/DtwcmbI/2Pd0cHZ07NOTj8+eT9L++O",
-	"Xr05ci7vagl7zyB4Glcy5VJpEuo+jxq8TSPpUhYILJWIfRULOOaMKq651bhpNYzHkI1x0AkGQRvcXtDp",
-	"u95g0Hb3epi4fnO850PQC/ymV8XsGQgf6A2Qc7gBQdVcb+KfQm/G+UcjL9Q3EnDSOF164M7gijEeh3CI",
-	"Ywlbxo7T4jOlmFfmx7jjk5avXT90fddrB+BiHHTd7l5A9jzodPAAb6NWIgkuW5KXDtepicL6B2u7PjZg",
-	"sRoYOJ1xELT6HeLiPdJ1vVa37w66g8AN9jpe0AsI+F73PsRq1d+SYKP8PMgJ34bevRbpkpbXdCHotl2v",
-	"1eu74x7x3b1+F5PWYK8ftDub6TUEf4qp0Bjs44KXWWXQlcF4aecluVXBlGXlW46iFcisCj5WWUMpZFxW",
-	"uNRFmzYnWmthdkWINJkJTlFoAYFKhJmVWw1RlaSNGt0bcH9x9uFoIStZC02LRFTDC3OelqWoMz6Lwxyt",
-	"YbQBfZhFCvkiLaPpldijNXgQXF2LtB++kwyIj9gqJE5ljsEXssLitvYetq0QsPhmAvLtamu2cX9k+KyS",
-	"CWSyiYXtdTu9vQCaPRcTGLveXq/njgdB4Hp93As84vmwHVbZJp8Y5iBKp50MAVNiXtpWYYb6iL3jPg7D",
-	"OYoZ/aSlR9U0Bcg+t04eswzvpfFpcYv9btv3oN12m90+0a69rVOSsevjDrQD4gVBt/MkKcnjVLLKtjbl",
-	"Kq3+fTXyb477/wQoz+sGPS8Atwdk4HregLiDIOi7QXfgNZt7Tdzsd78myvt2YOlPDu4qQdujYNn9MNh6",
-	"hLgNQjs2kdvCUfkguFb4NcNnJdabTp8qGPYUhni3ao/nIG6oD4ecBXQSi6xkXN7fk7jCd0kbSAQKE6ww",
-	"uoa5mxR5MBXSVtQVz4M0iuzRehCH+VOZFdqIYeGJtNuo8mMClI6lnJ2CoLxCMidxNLZxluC5NIcZdtIp",
-	"lYqLeXKMI0Bhakv6JnpZyqdYIh8z7c/HYDB2yG/Tg+cWekHw/OVClO0slxIWDWaR5pUqWqqjsYCvUM1N",
-	"5bYC3EjOchLoVHywjsy+C/UdjdXDkPvpmVEhtJS9SqtHuoHf9VwfoOl63U7b3Ru0e25bQ6pB22tCa1zh",
-	"VQRg8p6F8xVNbzVHY54x9q+rqyhBrBHRpxiHNup+OBsa9cpriDPBfSCxyH0js99JiTA65VZhy5CjWEOq",
-	"l4gW9DElzwoZZHTywFarpD2jIXHm5M9Xlj8fyPMl+lf1+xymx4aa2oQ6y0XCzbl94QhUwIwLrSRcZEdk",
-	"dt5ccYqF3BFj5YMpY92mW0dAwAXUEA1Ml09oD+TMghnQMfkuDsOULI2hUhLqIzZURtALNGTHoGOs/RBn",
-	"pSBaoifvCLDaMWIViDyvJm5VFCyKzo5fH1Mz1a/2DSBUhS/QbM1j2NZ+fGl+YEQemAW2g9IBZRMQM0GZ",
-	"Wlak1/mPpiPQ2sE8QSh6IxUzToBZ4Pvh7N0aW7JH5vqDmtu23hL8sZNvsuAQjyF8JMekwkLdi2dSYRXL",
-	"TbHeSNoeSoqiZzq3T68I/NXPVB0V5yPLJjDD85BjgrBE9pExEKStE02Vmsn9RmMmeARqCrGsU94g3JcN",
-	"w3DKJg0duqVq+EXU0fjHLYynnF//ar+uOHoGYbu/qYJoO84UZIGFwHMn62k8eCpbsNO9ewId0ShLMBxW",
-	"qvRP2L8OKbvOKx+5aLbQ4Yng8exnmC9P/DPMM5tLWo2RGW1qBobn6AXUJ3W9MgESz0KtBPBy5TJPwQsB",
-	"BsKKytFPYxo1J290WGbL+5klvNANUcyqkwBU/FFDx5iRJb1NPfUHES4vk5z06DEmkBojy+RQIHCTiKuY",
-	"qETMDDg7yEynvPpbfosizOapnKf4BuzRb/ZoCjZGToQ//2rHjRxnGcLWnBsQMnEj62NXOrCglplQC6Kv",
-	"pSZ/eQ8ndp7pxrauLFs4Ddc6NwxvTGIZULOBywpVf4sFucUCslhbXu80xD5MeUgSPDdNhltWo6Spp2Jj",
-	"p1XpXkX1jEpbF9QA0QhKJx88SPvULBQrBdMskUQymTpFt3k2Wjr/rHm1bhGYtLY7Az08G14MDw/eOTXn",
-	"+OA/7zXUOR6emL9/OTg7GZ68cWrO8OTV0cXR2fHw5OAiA0VHr8zpaEXX+cHp8L+5ei1E+0Kxy9a4MEpU",
-	"LIPvp0MLycqmWdDYAnCuN+vN7QxsLaFyO0rT2xEJLXIDyXhGi/NnZH8s7CbZwt1lbbtQuZ7fFSE0FvRU",
-	"QEA/lzlXefQ8ZBoWczFv3LQezNVTwcchRK90Dh5WFWCyQHOQXdV6RKnigM0Ry8oC+SSFi2A1C30Cyuw9",
-	"MIyS8m6aIwidVGCGqGZOBExlGePShonZVlVRbxpHmLk6D8bj0F4QwixxW8lyFhBQibjvx0Kk92CMwVuu",
-	"lfPCQ84Y+GlvJsEK64QHaRhKEI8r0XZ6XlBFos6qC7VHjbFpGX5nlK6mEJmsLMJzNDeHAkEsTO9usY5N",
-	"A0QgP6yxxrEJ98gV4eBiCujtxcVp4v2RzwkkefcmVi4HPkVVWMkbOeVC1RalKOMowmK+MLVJNetoqPRT",
-	"cUhsR745rrS37gpEKb6axJq55AYzZbYzi8WMSzBeJeQ+DunvVg/RMLDJLZVoQm/MGSZB3HDdFK9GjvFQ",
-	"++MQs+uRU7OcyQwAyanGLDiUJsNPc+/SYcIivtukPNjXubMBPxwNjy5eo7PXh6izN+ihj53LSt1aYh6V",
-	"CJjPY4FtLya2Jx56oYRGOWILAiHcjzMLzaov6dQW/Jp7eG8vjt+9tOdQJVVEeZtwBNG4WJIACUzVRoyq",
-	"tGSouShlHGXVkQVOLx7KFRIqo4IFHtZ9Hm2DDIvgK4M7iddZBlfabMCPNUA41xHCukyOYzVtrwAjB6dD",
-	"FGs1e38Qqylq56erfkiBKeQLMILDoURByG9N5SzktxYhmzGH+RD9pTm3NP8SPIR9G1oiTE37vUbszvv2",
-	"8PgcHWdfoTMe6lS6MF6LuDD2zHysGFfMBJKx5zm0N+O1FvNrYAbGZ0K5hrkfcnxdT+RlOrAE4DCSDS4w",
-	"01JT3OdhQ4cySlzfut+GmasU/yx/TVd3mgm+4n6F73rvnh2cIDzTGYd2DOZz/Zc3vbqh3B2eXBydvT44",
-	"PHLPms2Oe9Ps1ZtN9OI/MQPUbrY9nbnFpV2UQpesc1dgVudi0iD8luk8//9T8mOv79lYYMvM5iKEb5Bv",
-	"0rF+BgS9xWpp9tvb27oAMsXK6OtytD0dGqOzfB+W0APKO9dsDVZrbuJvne0e0Mrp1JbRUc1J0IWz73Tq",
-	"zXpHYy2spobjDe3etQgaN62Gj7F0bS5iaxhp3q1xCJcV6P/M5jAyTayMnEopiPa2Ng0BWey4N3nlDabm",
-	"tre2EnvCmxSNnYOoVLGxpg1S/cTJPJUK2CobntlMnXLW+E1alJvfJXhI5myVM3cmSsRgzybN9XfDuHaz",
-	"uaHvMsnvCJKx74OUpkyvxeFVPfoTJunFfD2mWzVmmMjKFIpBIBCC20OVJNTmEkEr80CjWHhiMphU+M6l",
-	"nqSkC2kel+rDl+ntf81x/AmO4G4LjSgngjILN3l3gD3eX5b929sF2RffgvDxi71FojU4v0RSJM5ZFN26",
-	"qyWXX0ezyknzQ9XpbTmX/r4KtSDP1Vq0oS9Xpzk3hcxuApVapGKR3N7NLlulGaT2o1kWmaUdhROW5BjF",
-	"4Iuyar0BdRCGWWJZLYQn0YANKbNRiYWL5QWpIj62p7DVHEh3r7eYy38F3Qme+vej6V9ITiu2sKBzXrP1",
-	"POj6wDTo4IL+DsQS1nkehL3mYkwJAVYw0e9PVaVbqJcAs3HEKVT+WISYmESUVaLTS+1tc8fyBlTJlAse",
-	"JW2C2M6jpJ3+q3o8Eg+z5Akqx39Fl7C6EWWtN8heu7Mz9Z2pfxNTf7Sl11bksgse4AyUoHADhf7rJHYj",
-	"f8EoU88gq8zn8s5kNP502cxP9derDW9b9BeBmIBr1vj3kxr9VqjwWXif+nN1P/Wd/7m3//Fa7edB1amA",
-	"/MpFgGkIpP5XdpCrnaFpvZ2bi/g3lMQ4XHh7S9KomPjHkgXXt3KQcQUK+jAjWMHj/ePOJ+584s4n7nzi",
-	"1/GJpmnoCZ3hfXLKQreuXJtMlgYu1SyrZJAPaax9s+td7SHPl1+K+rA5gsc9bBqqbYn1EQ58yy7IqlsC",
-	"Sy0duzx7l2f/hfLsdfl0WitO8uoF95R5yNL3BicmxzppO6c9FC90/uvPX/6ZAo0xJ/N/NPKDvXqhDbR0",
-	"TPTVgOTyq8Aeet5ymJCe3o35Fkctd4bYcjg5NHd8i97sqwLxJa+5Dfta34KIla7a3IJOmvZ2rvqvAb+b",
-	"e8+DKo0WQ+qrv3L8KPQBLcQQ63sQRgxuK2LHmtDxUFTd+FJxd/POetAQFCzD7Vfm+wX/uLlJoOqK6Lpe",
-	"gU2Xvy+3iSkFp2W2s3Nafy2n5T0Pqk64Sq7l/C29lvUICD5jX4Vz8wrsLb1WbauMftm/FF4mM2gOWmTQ",
-	"dbv9Ts/12q2uiwM8dvv9ttdrdT0Pes303bffxSF9N5S2S6h3Dm/n8L5emv8Ad3cPkLa23Jn1KO/qnN++",
-	"zll8peKuxLkrcf6dugZX1ziLZc3ki/v5uyQRLb3A626jE/yK0HD5/a/PFhmWXNLOBe1A4Xeh6qJ0v2Tp",
-	"9Zm32N5WNLix/nd2nkXkiBMvtuQ7t2iq/PM4v2/Q5bnyPYrfo6tpPTG7zqadf/6eSXv9WZ741HdtYM+6",
-	"DYwVu2Pt2ZR9D/ojkP99byOmKUdEGRerryJmr9iI8G9crHxNz1JKcaynfdb3E3dXDnfFgz/zlcNlw11x",
-	"8fAeS9tlDOUWBVe9yCJ5S8O5GVZ6ecR+o2HeWTPlUu0Pmk37SquEpur/1jD///kWYLOtYlY9Utktmz9d",
-	"2Su7aq5iWbmSlnLd+e7y7v8CAAD//+edMs/agAAA",
+	"H4sIAAAAAAAC/+y9CXMbN7I4/lVQ3Fe18XsciqdEaWvrlSLLsXYt2SvJyb/+ocsCBz0k4iFAAxjJfIm/",
+	"+69wzAzmIqnDjpPQVYkkEoNpdDe6G33h11bIF0vOgCnZOvq1tcQCL0CBMH+FfLHg7D1e0vd8CUz/xHH8",
+	"gkJMzPcEZCjoUlHOWket6zmV6O3lGfqYgFihbCok4GMCUkmk5lghHMdIvzSGTwgrJeg0USARFoAoC+OE",
+	"AEGUITUHJEAuOZPQmbAJu7m5mTAcx+8j8373Qavdovrl5p2tdovhBbSOWvm4VrslwzkssAU4wkmsWket",
+	"CMcS9PgkjvE0htaREgm0W2q11M9LJSibtT5/btchAT4ZOJsQccIXC4wkaAwoICimUiEeIQMQEhCBABaC",
+	"RIojNxWKBF+ka05iZVZ8isN5+SFEJcLuQ73WNuIC6Zd9TMzXPPK+lB4Q0xWSMZZzkB30gosJg09YE6Ht",
+	"Q6EBuAl5wpRY3SCZTO1cPLLfwCcFTFLO5I19y1FGGDeDQ/o/85F7bjo3bsJ+moOmLpUeh1DJ/q5QIoEg",
+	"xt0C7mgcoymksBGDEotyyx9UWsyWByK4BYaogXll+Ao+LWMaUhWvchZLJGUzPWTCbizQNzlAHcNYDkOt",
+	"I8NV7eqaGpiviIsCA27FXtET8JVbp7eTvj5XzUBZvtFPOY5BmJFHsJljrwZ6bMtjWgTpN9nZMgYSoBLB",
+	"gDyO+g+neqxAVKl+BViEcxQKqkBQbGh4wpnClEnEGWhSLbgAJIsD2yUywYKGPOZMdpBhgdJwwwITppJl",
+	"DCi08+sdghniSxBYcdFGuMI4mpw+ELc4TjQzXM8hew6FmE3YVA9epUSOeBzzO/0CixVpaPwbep0+8xs6",
+	"B2wgeMi/3ybstyD75/36gH96Ls2uTN3omdE5VuEcpJMwDiNhShE1d0hohAvdwMcbhJrnohLBxwTHeg+t",
+	"mc7ONVOb5poJwAqE1r6sab50Lri5x1xc1MJp56JsE1yGbaL8SdmIr3jjGmOQcu0CvbngZtu5ygvM57Zz",
+	"MccUDXMRDhIxrlLmaIDNzeWYohkuPdMmvnBzUbbFXJvw/5vekddzqOx5arlcyzs9gTePE6juLz79BUJV",
+	"1SUTlj7qxjfqE+Srk0TWGCiBWxKTlMCEbdYfsQLxz+/gY41Ab5/+51mmQq5ztGBhX4zFLFkAU/kCnbAq",
+	"w2qA+HjjCUC+WGIBcsLCOYQfMnpYCvKNm7+TQmS2lZa5lsbpCySSyXLJhUKLJFZ0GbvnarBoAEjfn6Fy",
+	"wsq4bFDFBj6q5iDQzenVjabtzdurKoIpq0XwVfvt1bOimnZITveI1oxYtlM20C+QS2ysGm3OMQCilzEF",
+	"JBMheMKIYxvKZjGgjwlXIDsTtn7dvkXi2NnqIXSzWKEwTqQCcVPLN/rR9t/zUX8vrSejQKZZG/Sw4Stt",
+	"j7SNQWK5YIEWiVRoofctiriwFqo9LymjmAlVlDO9JDOohvdy3Wosm7qVU31+8laK/hsz8t+l7ZURUKNI",
+	"U3tLfPyjaXtdPbuvhWbt1s0mWgZIDsezRvtMg77BPmPwSS3xDF4v8ccEzrH4UGea2c81Kfg0E/D6UaSf",
+	"1RTF5jeSnWTbCEtEIKLMnnIlhIaao86w0+8M9COn11dn6IcrdPHix+Dq9SvU7Q06SNtTE2bFhVadBiwj",
+	"CAy7TDVnLCmQ/Bx584qyDzdoDpiASEXMUsAt5Yk0UHUaT8/p6t/b97xf2PVvQBkncGJRf0aquHrN4pUj",
+	"nxWmMRYLaXGkH03JdoTUnEtAAlPDCDNMmbTnCH8YokpCHLnjRP7AhPlPzLEgd1gYPuKJ0FKER/pRM5cs",
+	"c9FgTMLp/mAaTHtRNxiSPg4OyZAEA9gPe2E/7EbDwyaUcQLvHWzvKSngKuJigVXrqJUk5psy7j6ng82Z",
+	"71gj5oQLATHWuLtMYqiiU3+KwnSUPcmiiOoZfdQyp4Q1j2IkuDZGsFakZkzKFtKoYzOw02q3lkLrFUXB",
+	"OYHsS4AYyGrOpfZzlA9MdVf5fW1ECTBFI82p2Yvv8ErvCj34RI/toGO3+/OF5OaEkZreq2hkjBkJquPT",
+	"8ufWv5MpxKCe8ztNZP3XG04uuLoETFatd+0WVWBXU6JHRiAsBF7pv42gPc0EWhUD+XfWx2U25RzfQr5K",
+	"t3dZLWIMG7vvvLXZ9ZfWpTntfuBbFi3DfOFsMANPEkOneOblBALGVSAMutqtBWWvgM3UvHXUa1ffmVGv",
+	"hlXXccF05SgNQmk4PaVjRMMJxlcps4p09NnzFHD7jRGoVKUPsZy79YnT7vzi8jQ7XHACGT9sXOAdZYTf",
+	"FZyIg263XdEIn+giWSCWLKZW7ErQOluiKag7AEd+TGWO+zpeMGsvc0JhCeblC8r063yIKVMwA2FEsna8",
+	"UgHEsI1mAp9O77JH7MbSizQ7+fQWmHpJpeJidQkhF0aaF4WCgec4/MD4XQxkBuT7VZXwZ4bMKjvzJxIE",
+	"uptzhL0HczJ2kNEREpQxfI5P/n3x+qdXp89/OEVKYCYNgYu7oSVXUsHiSIK4pSHg0JgaR3LBj8KYAlOt",
+	"Gkqal53MMZsBuaZ1W+M5VrCnv0JS4cWyaKbnsBRB6XeH/aB7EPR7173x0aB/1B///612LvwJVhAouoBm",
+	"mGLA4p6oDO0zjVg8eXV6fOnjz7CWlat3VM0RRufHF2+PX9mZjCZZLeGJsGxYyfLQGWlaVURzA+W49JQV",
+	"p3fAtD4XPJnN19LgYNQPh9DvB93RAQmGvf1+cHh4MA1CPIB+RIZRNBq02psUsrZlNFih0b8GmmszohLx",
+	"WC0ztvAfMR5ohWbAILP7a8Bmeuf+3Lo4/anVbp28PL744VT/oinWarc8/m+98xfpf1GGvd36FMx44D40",
+	"2LxKphnMZyziL6z9+7ndWoIIgd4CuYJbEFQZvvsvAVHrqPW3vTwwtOesk703lQf0LM6mfPMEs5VkVg0P",
+	"NdGmZlvXLXC90Lvwpm4wc5AZiPyRSOpPFNfuCD1+CkJWrKiywLyP4LmbAzOarCCHKltljrWWAYYWnBjl",
+	"2iif+v0HySdf3ldh/57zGDDLDB1i0MNmVpctMMMzWGhMWWliwG1QBD7chbDc1L7jMVL86ZHZexAyn2c2",
+	"y9nzGl7z3G2K5yCi/DEkLKSU+V9Tc57EQpvUkofUiB8j6O1x2k1K0KU7EV1XpD0e4FF3FB4E4yEJg2EY",
+	"RcEUR+NgsB8NBtMBHHSjcBshuo0G0D6tt5evCmv0yZAfSnL4RtHB/hjGh0EfQy8YHhwQLeTHQe9g0B3t",
+	"90ZDMiJbw3dpTo33ZqD1PBMK0HhvZpnufVkm5EwmCxAFYd6ATwvmUvBbSnLdk86Q8ov0ZioCOiAk7PX6",
+	"4wD3hiQY7hMIxl2Cg/1hbzjtHfZDOIy2wS8UDkyYWMcVjt8UBGPlscqCJFhPGZNLCO2p4Ttz5FOYESwI",
+	"/T8gz1AubtF3H2Aln6G7OQ3n5lGFacxFjotbYIQLpOOVmYvWRMEVuOgkZXZ5ep9lmMRTnli/wuvgJOYJ",
+	"sSxgvQgVtTKL+RTHZtzZ83pK2SEoNHPR3BjCUtIZy+G9On/dgQKNCB7uH46nOAjH+CAY9g9JgKG3HwzH",
+	"w6g3ivrjfjh6QkPnomrc6Kmc7za3ZbrtXrvfHvjmSrdyMtE2ih4f3GJh4snbGkGf2w69lxBVYXyIKJlz",
+	"aU6dnZAv9nifLmRAWSSwVCIJVSLgnDOquMbW3m1vz9rNe9NoEI2jPgT70eAgGI7H/eBwH5Mg7E4PQ4j2",
+	"o7A7rEP2U9lbfIqnMZhT3Ja6443/TEHnFfExHYSkF2rRD6MwGPYjCDCORsHoMCKHQxgM8Bhvw1apu21L",
+	"8NLhiDK9qUNwe9ecipsMg9ZgGkW9gwEJ8CEZBcPe6CAYj8ZREB0OhtF+RCAcju4DrGb9LQFWzvDPAN8G",
+	"3sMeGZHesBtANOrrw8lBMN0nYXB4MMKkNz48iPpbHE5KJnJRymywjsumdGnlBbrVmSlV5qtq0VpDvGo+",
+	"1u2GgspYb6lv7ZeoktNEHnBqhXoWqMw8R21ElQsLMXOSVhxdX749LfmL15qmPhD15oXJl8tCUEu+TOLc",
+	"WsNog/VhXuLFg2jRmn5y38Q6S/vhK8kM8QlrssSpzG3wCWtc1uFjXC5fiUDOWbNmGfe3DL+pwwQyp4nS",
+	"8kaD/cMIuvsBJjANhof7+8F0HEXB8ADvR0MyDGE7W+X+HiXMEOjIY2FZ3gydCXvFQxzHK5QwqiNqenFu",
+	"sAy5FfKYZfZeqp/KS3wqv9PGI8njWLJub206q/QO7suRf3G7/w9g5Q1H0f4wgmAfyDgYDsckGEfRQRCN",
+	"xsNu97CLuwejL2nlfT1j6Q9u3NUabY8yy+5ng623ELex0M45ycxR+SBzzfs2s88KqDeZ/HVm2PJpHNL1",
+	"a7yysZATziI6S0TmMq6PnbswvqyP4+u0Sp4s0yi+i844fpuuELYR5DxQ2EHHhVi/zQQyf5pQrp8LTpUN",
+	"rU+YH2N0Om6JBTB1XGGzPArbzhK2K3HK0kvyaOWEuSW4t4RzGpPqS2T+Fpsv7J7CAlzWZF2Q3yatpLHv",
+	"deSsTaSoCY8/ib565XLxF6AwwQqjD7AKnCcOUyFtWpPiuSWFFja/OUri/KlMVHphX+TibnXKRoACpkF4",
+	"A4Lymu1zkQWiCV5JExK0k85tgNfl0glQmNq8KmNiWMi1QzPETKN+CuYgFPO7NPu3h74jePWsZAoNuhsj",
+	"0WWYG+VIOXLVID82+UQ9m9Al1Dn71n9QcyCVvhOOSoTjmId+AM/p/6Lo7+2TURSOhkEI0A2Go0E/OBz3",
+	"94O+tnvH/WEXetMa0S8AEx2qbag8are0YTrF4Yd6V1eUaLNVZ4pa00gXXmn2yh29S8FDIInIFRizn0mJ",
+	"MHrDLcMW7ULf0dcpAC3oY/zSNTTI4OSRdSlKG0gjSaaJrxp91A/EeQ38mXgo1oddHmt/ZLkSI4ZQJyPO",
+	"wSTAem70qWUY630Bm79RTGL67vL4p2cmF5rr+Lyfv5IWxlm5N2EpUE5gVCXvdyevLy9PXx1fnz5P95+L",
+	"JVuw86+LkWPv8xpcNBWgnKR5rJpyjlKWowg3YtnLyRWw5EIDzkWWs2nnzTeRH3mYMFaMpBpJZ9SBgIgL",
+	"aGvZj90cDsW5ZW4cNBrRDiwschA6E3amDNOXYMjycqdYy2TOClZfAZ48Rd3Sa8JqjpCPCOXb8RVKSMNo",
+	"vIYWNnivC9oESKuf0+Mq1qe+FVP4E8LSpQOawaVyzDay05fMjYx17d4zCeGOs612zt9pkGq0vxuMKqZW",
+	"GxUtx/xv/bufKvq8PWEVk9YYHSWj1i8W9XePs1C4MUJyXY6+g86sM2H5J3sm2LDEITyzKyqAkU9va1O8",
+	"HBoPSy4rdboqJKPKunTkKk66NjO6uPqwD3BIujgY4qH+XzgMDiE6CIakC70o6kHUnz6rZRI60+bDFYQC",
+	"VE2+7dJaMUjOTYKQNOMyM0Q/XclbkVkqQ1G0ddBPzhPaRoDDeeEhbVgKQa379OX58Ulw9fK4P9o3r8BK",
+	"6x/Hov9f8NqEWK6yL2x2sqWGA1BvcY2wUqr3An9Ks/P6o/1itt5+NQvmTlAFuXb93G5pGkSrk4JOdcLe",
+	"nSBKhnlatIyReTQVCXPMiJzjD5A7wlJFjaZGZFWEnO9mQccTlgLxoz9vOMdxDGxm2O/N66vrbCtm8+e2",
+	"eHl2AdpwAoIS5qqXdNGAPyOEc64ZF4cfCn6L9LxURVnJakuBaDDXQKga80xLd6zubVpX5gdG5LEq5FKv",
+	"dUFFlM1ALAVlqk6GZl8asWFNEy8PtW5Gl8vFxdvLV2vMGyt4Xa6e0eoFt4GdfJNRFeMpxI/EmFRYqHvh",
+	"TCqski0OVSCUTeYRvrF4ZZ9uODDXP1OXYpWPLGriJV7FHBNbPmHFEkFz0DJEqaU82ttbCr4ANYdEdijf",
+	"IzyUewbhOnYbYwVS7YX+aX3vb3cwnXP+4b39uCZlC4TtirDlcVNTt+Z8aWt9j59qL9jpXj0Bj2jVKBiO",
+	"a1n6exx+iCn7kEcMctJswcPGo/FvqEll/Tessj2X2iBmtDWaNc6t7tZvJkCSZayZAJ41vuYpcCHAKGtR",
+	"O/pptka7lWvUdfraO1Hk3mhnBxePG5Euf6sWbDhJ/VbE1de4DAk9xtg2jPt08ADcROI6JCqRsNBWiqRb",
+	"p/j2l/wOLXTMz9HZlEiYlKns0fT8N9Eq/70dN2m1ql4Fo9KlEyPr/ajpQI8tM6J6pG+nW/7dPYTYVcYb",
+	"24qy7MXpqUGA5PGtcchar54HQM6EL10tU6ZrS6h1X1vUlt4ITFlHYlYQtYyTGWWPlXkFmMypqEEAmurF",
+	"zUaXrQrU7pBcYbouH4UC+3RGY6LrQxo2VYJhIgQwFa8QDhW9hcx6Ly27M2HHbJVVw8Wr7NBoZ7Ka2x02",
+	"86Y0EqWUqjWhqt77RmaqwVuVgdKS1gx4C1sGah1BneTE6Pvzk71LIBGVc3s4flafknyxsTbIvLWD3ko/",
+	"9ivdmUbv1JjzDyhZms9zR3SpMKfovnmj3YjnWUrwqRBc1IYSM7uv5ACjC0BYOTdEBiW6w1mQ/euGK4+z",
+	"xwohxDxyaOFzes2UAN1pGqEFSIlnvnLLuWStFVsyOe382L7MVTyhM5WVh0qlj9LZhohpBIqWiVyg0QIU",
+	"jgfB/M5y1t50MQ+Y3CMQx4E4GHaD3t62ZFxwAjW66Fx/XIBglbkJHMMZE0BQV0iXfllTomJAOdVBosuD",
+	"YXdttHKrQpQSOKZA0dt4NUIFUab3mfapH785KwZcK6ir9/rkYaqy49F+UwAOfRcKqmiI4zZa4F+4aKMF",
+	"ZfrHHRbaM/CsAEM6uMH0zw4L22+0iAqpEJ9qp9099ttTGVM2mF8F+UcX5H8StnoOcYzOWNjZGKvNtLq/",
+	"a9uekPUIXGBGD/11quIcU6aAYRbCT14Jor9iG0dBJBGm5j8jVdG1w6N6R1ax/Nq4UOcQO1cBOka28NFW",
+	"nQMz0b1EcS3gbOZMKlIKs5iajzfaTaslK2Uz507R0MVgXHXJciYwMc4JagITMwHSedJqFJXnHiRNNcie",
+	"vtHxyFvf62yX0VRbXEFB+nSxvDgzijYGFso2UAHaikdCACAFn1R6tk3t8UVO/CJfvnXIUxwNO71xg+5s",
+	"yCGq7GlH4jDmEqRRG8bWscydpw/lnJB5s84yMyl1ltpB0mTYsJTD6jghpX/CFLUkST9JrTu5Pt+v2z3q",
+	"drdPS1qUN9J2isB7LOOhB4cKh2Q0HUAfB/tRLwyGeAzB4fSABP2wB93oEI+nB+FDQoUbei9UV1Xg9zvT",
+	"P8GLvtdvnS+Sn7OscsZmk7ResHCZs5BjviL0G9EoIAYsgfgKR66Lqdc4z8u7xWwq7fctyF3MSFa66+S2",
+	"90wWCUyDMuUge+NavNOx0SrbSIDSIvRjaAoapFwPrMkOvOc2lA3n5Z+8oGouaFY2+doA1UZzHhMNlwap",
+	"CLYJrDpUeyfrq5OXp8/fvjLxzuOT67MfbVTu9VU5Mpp9uZFHZLJYYLG6Aqa2M5iK6M1QWtxc6A4EGAbq",
+	"rJd4g2ZUbwH60oTwmjt55JydloibXVVcggHVMxGuQNXqiJwa6XJ6/c2MW25bUJBsPlfXGUu1Ncg1GbRU",
+	"2tzgZOEKtJ0fwfWissQrBAayaF5+9PUNqk6lBqo9bI98FuttVwd1cnl2fXZy/KrVbp0f/+u1jh6fn12Y",
+	"nz8dX16cXfzQarfOLp6fXp9enp9dHF9ncWbN0vWdJY/fnP2Yu8pKqqFyWjXxrrQBh/H8vjmrs8o875uX",
+	"l9HpdrrbOQvXAiq3gzTtgOpgkRtAxkvqz583VfFW45bw+d2WWWbr8V1jCSaCvhEQ0U9FzNWWn52lR8q9",
+	"296DsfocMHkFSm0MvhSdhjZ6xZOYIJcARiCm1jAwaSlefLiKaaVgsVRrRYwWWzKddFXbR2Guj5mYxmU5",
+	"UqfvnE3aKJQzEVV4gz7IYkLyYOvHBBLobK3OSIbc7QyvQj2CfhjF5un8vV6BGx5G43AIQbcPo2CIB+Ng",
+	"OoqGwbBPYAyjKRng4TY2Voyl883UZpKB/ioLt6d6ST+UE8fRs9wjyEOkJdMRGnUHmwpN68GoC/eZdmlU",
+	"oTvDhnm0oCFNoc6J5k+7HYm4oDPKcFyAqFSCPu2HZKqLPzEMgyEM+8FUF+IODnG4Pz2Y9nGvtw1l0qaY",
+	"dYBdue+8XkLbQTfs122PZEnWbg8erSf7NtuhpLsLe6NCicLqi9+22rkA8dnX3+T+it7dVxD+R2+2zWwo",
+	"7yUEUUmA0oJkodJuccQ1TXEcT1gZzS4eZneSsWiNzLK1iO7gYP0ndTkgMpFLYCQ9LBTlMSufaB6q2xqU",
+	"SY2ek9unhDreK6zIYJ7fuYBPjXQ8GHfHPTIeBaODwX4w7PdGAY7wNDg46A91F4gh7He32oMp3t4yReO6",
+	"fai2wTrCkfEd5ZtH0zER0Ck0i8k9b2muEJVGE05YmvdeL9zMkVEPsTxFqIBQR7oKaqviL+mPgm4vGHSv",
+	"e/37HdTK3s0iMdslhtpyA+oCpRgWz0FhGteVg2RRlOMs1+8xQRm28oRnPomXSVjux4mRKzZLE0BNzznM",
+	"ENUoXQBTmbytLJiYZdXZVfNkgZnppGeCMPo6AszsC9LXZUKChzaKGULetNNgrcj9J5wx1ztUcUSwwlMs",
+	"wXASQTypzWFKqxfrQNTp414llIl/FiNMGaTNECKTcrvAK7QyJYpRIsyZ3g+J0QgRyN7khNWmbJImp4EW",
+	"2C+vr9+4mDoKOUljXJtQWdWQiqq4Fjdyzo33oUhF5wgoTW1jCNovKueZ2ghN8bTtzeoBpXgziG1zpQYs",
+	"rVN1mYgllzbxVfsZY/p/lg/RWWTeaHqz01tgtnug86RghiYtc1Y6msaYfZi02hYz2QZAUicJIhxLk76d",
+	"JlYX4ttlp/Ym5sFhyIXx0yiOzk6vX6DLFydocDjeRz8P3tXyVgV5VCJgIU8Etp2hXGBTv8jBKCesRBDC",
+	"wyTboVlYIp3ahl7NrR8vr89fPbO6tcCKKG9KvIDF1M83BwlMtSeMqrQ2RmNR6gKGNPW9hOmyLPbS1AwL",
+	"ejjU3Ue2ybepDTc5qbOlBL7yk4YvXUJagyEEdw/LHS4aRX5qr0leiHEI0qYzsVV7YnroU5bYa0umkPZ/",
+	"5myWtSHXoHBmd3Z/iOY8ERJJnvNF/kKbm87jGGkftk0LrzOINmRP1yKgKIHHpBse4HHUg/1oiPvTw3BA",
+	"RnAQHeLedBCOyH2TlisULkD4EPperZGatrF23oNUZQUPBp8FunMv8yyrxSmiM02lsS8+/bSkYlVvStEa",
+	"W2+OiX6DfZuXRJ2CVTahQiyEKYvMEsm18ZqkhfD2GoAUpIx7XZzJ2VuNtlLv3rbSY23dL2HXrrffqtyk",
+	"FwFhoh2bV9r6t2TlOFHzfoMT9fjNmd6sEr0+TtQc9b2E+JgCUygUYNaNY4mi2MZg9E8ztR1zkg/RH5qe",
+	"C+Y3wWM4si4xE4HDlIHQaZL9s/MrdJ59hC55bPvaZuMFYOKNvTR/1ozzszHd2KvsIzte6zz+AZhJpcxE",
+	"+AdYhTHHHzqOaKZ7lAAcL+QeF5hpGa94yOM9vS0pCUJrrO2ZuQp+O4vfz59dHpJgOH7Ow5o9+zq4PL5A",
+	"eEmlNSPM352fftjvGMiDs4vr08sXxyenwWW3Owhuu/udbhd996+EAep3+0OdDJIUVlEwdGWHBwKzDhez",
+	"PcLvmHa+/C8l/9w/GFrL0VZfmiS80MhL1/f8Egh6iVVl9ru7u44AMsfKaLeqbf7mzIhzi/ezgtcT5V23",
+	"XNislVlnre0ecCk4Fa9uu+UkpXavdbod7ataYjU3GN+jzJJA9/oKMZaBTeHbw14yih645LJGZ1zaPFKZ",
+	"pi0aOhXSQDEjTmeC9LuFGmV4i6m5iU7vEtudwgmT1vHiougccRfqfc/JKqWKSyHES5strfPbf5FWteeN",
+	"6B+ScGOZM5clrpwmvdDAIK7f7W7oGedybAmSSRiClKZ6VZNjWPfo95iklwbqMaO6MWeOVqZmEIT1Ylo5",
+	"Zg3znCKoMRfXMBaemchLSvzWOz1JgRfSTK+UH36d39ksIx2U/rwFR8wLybkyv6Uhb21SziZ7IK+8vCvx",
+	"in+j48/rguppTuob+36b7ZYXCWq407sP9I7Jrz7wkdEqs8q6OyPefRlOLiZKP5R9S/nUvy8Dl/inmWs3",
+	"9DDUJuKtFwGbQS3XqkSUE63TSJuW2+kMuVPEK+51FbwTVmHNH0Adx3EWgKsnwpNwwIbQomGJkmXqUdVd",
+	"5uLSRyoYSFevl5jTvwFud9r7n0fDX3Kd1SyhxHPDbu/bgOst00YOF7pdkwVs8G0A9oKLKSUEmLdFf3+o",
+	"asVCp2CgG0GemuY/+yYtJgtT1FG1ht99fucLlh9AFbayJ1HSXiTbSZS0K2pTPxwnYSqSoHb8FxQJzU17",
+	"1kqD7OKm3VbfbfWvstUfvdPbDWfnkgS4BCUouAz4Qp8hFJY2ZSoZZN320TlHS6zCeXWbv9EfN2+8ba2/",
+	"BYgZBOYd//Okm34rq/CbkD6db1X8dHby597yZ9jrfxtQvRGQt6dN06z+xAKyWRiaNoUrc2nJLSUJjks3",
+	"2bp+YU4+FnZwZysBmdRYQW9N/srj5eNOJu5k4k4m7mTil5GJOH5aYXifM6UXtJJrD5OFgRWfZx0N8iF1",
+	"ZMBx/MKsufW5/ZDn4ZPpGfCoOaLHPWwveXv3SAG+faPVSrPOSkrg7py9O2f/ic7Z687Tqa/YnatL4imT",
+	"kIXPjZ3owkhpCx8bhPeaTuq/f/2v1NCYcrL6256XbeO1/imEpb6YIVm9NvGh8Za0KV7aovZrhFo+G2CL",
+	"6uREAFbgS7MvaohXpOY26Ot9DSAaRbXJwXdJ3ztR/ecwv7uH3wZU2lqMaaj+zPojl9dlHWJlD8Im9bCq",
+	"O9aojoda1Xu/1rRQ/2wlaNqyqygfn5vPS/KxZHDXJAnUvGZtrsCm9LZ32+gUT2iZ5eyE1p9LaA2/Dagu",
+	"uHKtGP+SUstKBASfsClI4gy2lVrtrU70Vfly72TZ308g/W5W2u5AvRN4O4H35Y75DxB3T2uk7eXl1dvl",
+	"8t2rsrlYVWM7z6f3nriB1JbslC6LsA2kskasparktYL+ubegv7zM36J+/V55jJVeE7WVKLucxp2+eHqo",
+	"rguZ7u52BN15haWKpPMXzcFs3Jn491cqe6ZYc9Vc3nDKiCsNN0Le7xnlQ5nVeholYlYo6/uTUiVrsBHp",
+	"+1rTrgodVKoFtFc+LbjOzc/KKrxOG5lWq1NAl2aFfzId1N+msUneS8fgimgsZ60rdmpgpwZ2auDJ1cC6",
+	"ZFy39b49hVAoQ98zFdnQrBKuQMmaCvIH3jpWlfR6gqzKe8pdvbFxl7ursUpl37nyeVDzADf7hNnZanWI",
+	"QYmPtkIR/h9Iizx9jHPrDhQN0kITVqZo/HqpiVs2VmgScWaM6V1oFKzdM2SnVHdKdadUv6ZSNfvOini7",
+	"exsbmjyNOl3rhmtIDpKICwLCP7wsuLT1xsaBZtvFdRC6Th+lEi3xDMg/bAujBRd+8/YJy6rD08stjJDM",
+	"L1DC6OYVZR9u3O2bedsO3V0p7cIKn5R5S2Mhr2vLsEu1TFMtNz+skapx+nqJPyZwjsWHLZ8r9MD+ijmd",
+	"3j3HD03nbLcsl5kXarar2xxZ2zleZL58l5T79EnX+27UGXb6nYEeeHp9dYZ+uEIXL34Mrl6/Qt3eoINs",
+	"L7EJM/f5ujZetdvG66lQagw0SbrdQZj2NTGioND4ZVvh8L8p+d9zQ//3C8MA/4TVv7pnv3B6/svx6uKq",
+	"e3eu//vxP3fnz7n97wWn0X8MFPAPJCD+56SlpzKX4jU3V/i8szV2ibR/SL/oGmXp6Wn3wf0UtDvjenLN",
+	"ZTs15SEYMfgFj3AFSL7t/IOCMtilHuyOO9/AcafMmLugUm1+AnZSrCI7tyjd/+MIv6/QS8CD2JTHZW3E",
+	"fo/a2fXA7Opnd/L590wN63yTdQWdXbHxN11szPweDLYCQliT80tY/ntzKhUXK+8EUJ8+B+ZCB2n9iQIz",
+	"Sf1uyWbmNqId6CDA4dz0Fzb3qnodMM0l3MY+mQFzt47pkBRVbeP6s02LeUxAZrEwzxO4zg/30q3ir3BM",
+	"uafzyqHmcT6s3TFmpyZ3x5jfzwdUlrsmVwyz5mPNlorhvs1QU1+UuWa9uRNqdv+IuZW98TbFiiw/19N+",
+	"0+1RdxHsnVf5j5xtW924j+l7WrlQfG2PmvPq6F309Cs3qqnQYGcR7qTgX75JjSfH0F0mmjKxWJVbfqea",
+	"UtN8HhNprzevTfDPTsv5NYgrc1AmgEKb4YCmoO4AGMpu+japncCI/t3eJ2WnsDcmSkXj2DkJgNgaA4wk",
+	"ZbO4fKcsCPukubfRnMapmpuigxgrkMoZmrU3+Ms6o832a6hKlS+TTFkjvb5us5gGAHaNYnbi8s/rmcx6",
+	"slTF5CYp+VAzcu/XymeVpixFbNgWDLIWSisyS9U/EBMrpt21HXaoK6aKAWvRHFEhs/va3IA5lhNmrrTU",
+	"QpGgVX1OvIWnTjB+AQ9lDbK+ZmuZeGWby7g62xoC7OTfzoH4ZR2IVab7w7kQv4TstmLoQbK7vf1B/g8t",
+	"1X4Xw3B3jt4Jxp1g/JY62DzAuL0HVBYCs6a6Wwjt3abu4s4rM6xwn+jR3p659HzOpToad7td4y500JUn",
+	"M9Mge9njQpOzGMm2fsq6R2obmudP17Yzb5qrcLtxHSzFKqPqNFfJcilApoEjA3rJhieJluxoGWPGipy+",
+	"RonI1ud3n//fABxFHQb14wAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
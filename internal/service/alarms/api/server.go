@@ -12,22 +12,27 @@ import (
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
+	"slices"
 	"sync"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgerrcode"
 	"github.com/jackc/pgx/v5/pgconn"
+	k8srequest "k8s.io/apiserver/pkg/endpoints/request"
 
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/alertmanager"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/hardware"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/serviceconfig"
 	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
 	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
@@ -35,18 +40,27 @@ import (
 const (
 	DefaultRetentionPeriod = 1 // Default retention of resolved alarms in days
 	minRetentionPeriod     = 1 // Minimum retention of resolved alarms in days
+
+	alarmsPageSize      = 1000 // Maximum number of alarms returned in a single page
+	nextPageMarkerParam = "nextpage_opaque_marker"
+	nodeClusterIDParam  = "node_cluster_id"
 )
 
 // AlarmsServerConfig defines the configuration attributes for the alarms server
 type AlarmsServerConfig struct {
 	svcutils.CommonServerConfig
 	Address       string
+	CloudID       string
 	GlobalCloudID string
+	// ArchiveRetentionPeriod is the number of days archived alarm event records are kept before being deleted
+	ArchiveRetentionPeriod int
 }
 
 type AlarmsServer struct {
 	// GlobalCloudID is the global O-Cloud identifier. Create subscription requests are blocked if the global O-Cloud identifier is not set
 	GlobalCloudID uuid.UUID
+	// CloudID is the local O-Cloud identifier. It is used to derive the resource identifiers of hardware alarms
+	CloudID uuid.UUID
 	// AlarmsRepository is the repository for the alarms
 	AlarmsRepository repo.AlarmRepositoryInterface
 	// Infrastructure clients
@@ -55,8 +69,8 @@ type AlarmsServer struct {
 	Wg sync.WaitGroup
 	// SubscriptionEventHandler to notify subscribers with new events
 	SubscriptionEventHandler notifier.SubscriptionEventHandler
-	// ServiceConfig config needed to manage ServiceConfig
-	ServiceConfig serviceconfig.Config
+	// RetentionJob enforces the retention period of the Alarm Service Configuration
+	RetentionJob *serviceconfig.RetentionJob
 }
 
 // AlarmsServer implements StrictServerInterface. This ensures that we've conformed to the `StrictServerInterface` with a compile-time check
@@ -130,6 +144,18 @@ func (a *AlarmsServer) CreateSubscription(ctx context.Context, request api.Creat
 	}
 
 	// Validate the subscription
+	if request.Body.Selector != nil {
+		if _, err := models.ParseAlarmSubscriptionSelector(*request.Body.Selector); err != nil {
+			return api.CreateSubscription400ApplicationProblemPlusJSONResponse{
+				AdditionalAttributes: &map[string]string{
+					"selector": *request.Body.Selector,
+				},
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}, nil
+		}
+	}
+
 	if err := commonapi.ValidateCallbackURL(ctx, a.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback); err != nil {
 		return api.CreateSubscription400ApplicationProblemPlusJSONResponse{
 			AdditionalAttributes: &map[string]string{
@@ -140,6 +166,18 @@ func (a *AlarmsServer) CreateSubscription(ctx context.Context, request api.Creat
 		}, nil
 	}
 
+	if request.Body.VerifyCallback != nil && *request.Body.VerifyCallback {
+		if err := commonapi.VerifyCallback(ctx, a.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback, request.Body.SigningSecret); err != nil {
+			return api.CreateSubscription400ApplicationProblemPlusJSONResponse{
+				AdditionalAttributes: &map[string]string{
+					"callback": request.Body.Callback,
+				},
+				Detail: fmt.Sprintf("callback verification failed: %s", err.Error()),
+				Status: http.StatusBadRequest,
+			}, nil
+		}
+	}
+
 	record, err := a.AlarmsRepository.CreateAlarmSubscription(ctx, models.ConvertSubscriptionAPIToModel(request.Body))
 	if err != nil {
 		var pgErr *pgconn.PgError
@@ -218,6 +256,196 @@ func (a *AlarmsServer) GetSubscription(ctx context.Context, request api.GetSubsc
 	return api.GetSubscription200JSONResponse(models.ConvertSubscriptionModelToApi(*record)), nil
 }
 
+// GetSubscriptionDeadLetters handles an API request to retrieve the dead letter queue of an Alarm Subscription
+func (a *AlarmsServer) GetSubscriptionDeadLetters(ctx context.Context, request api.GetSubscriptionDeadLettersRequestObject) (api.GetSubscriptionDeadLettersResponseObject, error) {
+	record, err := a.AlarmsRepository.GetAlarmSubscription(ctx, request.AlarmSubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"alarmSubscriptionId": request.AlarmSubscriptionId.String(),
+			},
+			Detail: "requested Alarm Subscription not found",
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Alarm Subscription: %w", err)
+	}
+
+	records, err := a.AlarmsRepository.GetDeadLetterNotifications(ctx, request.AlarmSubscriptionId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letter queue of Alarm Subscription: %w", err)
+	}
+
+	return api.GetSubscriptionDeadLetters200JSONResponse(
+		commonmodels.DeadLetterQueueToModel(request.AlarmSubscriptionId, record.SuspendedUntil, records)), nil
+}
+
+// ReplaySubscriptionDeadLetters handles an API request to redeliver the dead letter queue of an Alarm Subscription
+func (a *AlarmsServer) ReplaySubscriptionDeadLetters(ctx context.Context, request api.ReplaySubscriptionDeadLettersRequestObject) (api.ReplaySubscriptionDeadLettersResponseObject, error) {
+	_, err := a.AlarmsRepository.GetAlarmSubscription(ctx, request.AlarmSubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"alarmSubscriptionId": request.AlarmSubscriptionId.String(),
+			},
+			Detail: "requested Alarm Subscription not found",
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Alarm Subscription: %w", err)
+	}
+
+	// Signal the notifier to redeliver the notifications
+	a.SubscriptionEventHandler.ReplayDeadLetters(ctx, request.AlarmSubscriptionId)
+	slog.Info("Dead letter queue replay requested", "alarmSubscriptionId", request.AlarmSubscriptionId.String())
+	return api.ReplaySubscriptionDeadLetters202Response{}, nil
+}
+
+// RotateSubscriptionSigningSecret handles an API request to rotate the secret used to sign the notifications of an
+// alarm subscription
+func (a *AlarmsServer) RotateSubscriptionSigningSecret(ctx context.Context, request api.RotateSubscriptionSigningSecretRequestObject) (api.RotateSubscriptionSigningSecretResponseObject, error) {
+	record, err := a.AlarmsRepository.GetAlarmSubscription(ctx, request.AlarmSubscriptionId)
+	if err == nil {
+		secrets := notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry).
+			Rotate(request.Body.SigningSecret, time.Now())
+		record.SigningSecret = &secrets.Current
+		record.PreviousSigningSecret = secrets.Previous
+		record.PreviousSigningSecretExpiry = secrets.PreviousExpiry
+		err = a.AlarmsRepository.UpdateSubscriptionSigningSecret(ctx, *record)
+	}
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"alarmSubscriptionId": request.AlarmSubscriptionId.String(),
+			},
+			Detail: "requested Alarm Subscription not found",
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to rotate Alarm Subscription signing secret: %w", err)
+	}
+
+	// Signal the notifier so that the next deliveries are signed with the new secret
+	a.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed:      false,
+		Subscription: models.ConvertAlertSubToNotificationSub(record),
+	})
+
+	slog.Info("Subscription signing secret rotated", "alarmSubscriptionId", request.AlarmSubscriptionId.String())
+	return api.RotateSubscriptionSigningSecret200JSONResponse(common.SigningSecretStatus{
+		SubscriptionId:       request.AlarmSubscriptionId,
+		PreviousSecretExpiry: record.PreviousSigningSecretExpiry,
+	}), nil
+}
+
+// GetMaintenanceWindows handles an API request to fetch Maintenance Windows
+func (a *AlarmsServer) GetMaintenanceWindows(ctx context.Context, request api.GetMaintenanceWindowsRequestObject) (api.GetMaintenanceWindowsResponseObject, error) {
+	options := commonapi.NewFieldOptions(request.Params.AllFields, request.Params.Fields, request.Params.ExcludeFields)
+	if err := options.Validate(api.MaintenanceWindow{}); err != nil {
+		return api.GetMaintenanceWindows400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	records, err := a.AlarmsRepository.GetMaintenanceWindows(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Maintenance Windows: %w", err)
+	}
+
+	now := time.Now()
+	objects := make([]api.MaintenanceWindow, 0, len(records))
+	for _, record := range records {
+		objects = append(objects, models.ConvertMaintenanceWindowModelToApi(record, now))
+	}
+
+	return api.GetMaintenanceWindows200JSONResponse(objects), nil
+}
+
+// CreateMaintenanceWindow handles an API request to create a Maintenance Window
+func (a *AlarmsServer) CreateMaintenanceWindow(ctx context.Context, request api.CreateMaintenanceWindowRequestObject) (api.CreateMaintenanceWindowResponseObject, error) {
+	// Only windows opened by a ProvisioningRequest upgrade can be open-ended since they are closed automatically
+	if request.Body.EndTime == nil {
+		return api.CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			Detail: "endTime is required",
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
+	if !request.Body.EndTime.After(request.Body.StartTime) {
+		return api.CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"startTime": request.Body.StartTime.Format(time.RFC3339),
+				"endTime":   request.Body.EndTime.Format(time.RFC3339),
+			},
+			Detail: "endTime must be after startTime",
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
+	if !request.Body.EndTime.After(time.Now()) {
+		return api.CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"endTime": request.Body.EndTime.Format(time.RFC3339),
+			},
+			Detail: "endTime must be in the future",
+			Status: http.StatusBadRequest,
+		}), nil
+	}
+
+	record, err := a.AlarmsRepository.CreateMaintenanceWindow(ctx, models.ConvertMaintenanceWindowAPIToModel(request.Body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Maintenance Window: %w", err)
+	}
+
+	slog.Info("Successfully created Maintenance Window", "maintenanceWindowId", record.MaintenanceWindowID, "nodeClusterId", record.NodeClusterID)
+	return api.CreateMaintenanceWindow201JSONResponse(models.ConvertMaintenanceWindowModelToApi(*record, time.Now())), nil
+}
+
+// GetMaintenanceWindow handles an API request to retrieve a Maintenance Window
+func (a *AlarmsServer) GetMaintenanceWindow(ctx context.Context, request api.GetMaintenanceWindowRequestObject) (api.GetMaintenanceWindowResponseObject, error) {
+	record, err := a.AlarmsRepository.GetMaintenanceWindow(ctx, request.MaintenanceWindowId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetMaintenanceWindow404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"maintenanceWindowId": request.MaintenanceWindowId.String(),
+			},
+			Detail: "requested Maintenance Window not found",
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Maintenance Window: %w", err)
+	}
+
+	return api.GetMaintenanceWindow200JSONResponse(models.ConvertMaintenanceWindowModelToApi(*record, time.Now())), nil
+}
+
+// DeleteMaintenanceWindow handles an API request to delete a Maintenance Window.  The notifications held back by the
+// window are released first so that subscribers are not left with a stale view of the alarms.
+func (a *AlarmsServer) DeleteMaintenanceWindow(ctx context.Context, request api.DeleteMaintenanceWindowRequestObject) (api.DeleteMaintenanceWindowResponseObject, error) {
+	deleted, err := a.AlarmsRepository.DeleteMaintenanceWindow(ctx, request.MaintenanceWindowId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.DeleteMaintenanceWindow404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+			AdditionalAttributes: &map[string]string{
+				"maintenanceWindowId": request.MaintenanceWindowId.String(),
+			},
+			Detail: "requested Maintenance Window not found",
+			Status: http.StatusNotFound,
+		}), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to delete Maintenance Window: %w", err)
+	}
+
+	slog.Info("Successfully deleted Maintenance Window", "maintenanceWindowId", request.MaintenanceWindowId.String(),
+		"suppressedAlarms", deleted.SuppressedAlarms, "releasedNotifications", deleted.ReleasedNotifications)
+	return api.DeleteMaintenanceWindow200Response{}, nil
+}
+
 // GetAlarms handles an API request to fetch Alarm Event Records
 func (a *AlarmsServer) GetAlarms(ctx context.Context, request api.GetAlarmsRequestObject) (api.GetAlarmsResponseObject, error) {
 	options := commonapi.NewFieldOptions(request.Params.AllFields, request.Params.Fields, request.Params.ExcludeFields)
@@ -228,17 +456,87 @@ func (a *AlarmsServer) GetAlarms(ctx context.Context, request api.GetAlarmsReque
 		}, nil
 	}
 
-	records, err := a.AlarmsRepository.GetAlarmEventRecords(ctx)
+	selector, err := commonapi.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return api.GetAlarms400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	var marker *models.AlarmEventRecordMarker
+	if request.Params.NextpageOpaqueMarker != nil {
+		marker, err = models.DecodeAlarmEventRecordMarker(*request.Params.NextpageOpaqueMarker)
+		if err != nil {
+			return api.GetAlarms400ApplicationProblemPlusJSONResponse{
+				Detail: fmt.Sprintf("invalid nextpage_opaque_marker: %s", err.Error()),
+				Status: http.StatusBadRequest,
+			}, nil
+		}
+	}
+
+	// Fetch one extra record to find out if there is a next page
+	records, err := a.AlarmsRepository.GetAlarmEventRecords(ctx, selector, request.Params.NodeClusterId, marker, alarmsPageSize+1)
+	if errors.Is(err, repo.ErrInvalidFilter) {
+		return api.GetAlarms400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
 	if err != nil {
 		return nil, fmt.Errorf("failed to get Alarm Event Records: %w", err)
 	}
 
+	var headers api.GetAlarms200ResponseHeaders
+	if len(records) > alarmsPageSize {
+		records = records[:alarmsPageSize]
+		next := models.NewAlarmEventRecordMarker(records[len(records)-1])
+		headers.Link = makeNextPageLink(request.Params, next)
+	}
+
+	ids := make([]uuid.UUID, 0, len(records))
+	for _, record := range records {
+		ids = append(ids, record.AlarmEventRecordID)
+	}
+	children, err := a.AlarmsRepository.GetChildAlarmEventRecordIDs(ctx, ids)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get correlated Alarm Event Records: %w", err)
+	}
+
 	objects := make([]api.AlarmEventRecord, 0, len(records))
 	for _, record := range records {
-		objects = append(objects, models.ConvertAlarmEventRecordModelToApi(record))
+		object := models.ConvertAlarmEventRecordModelToApi(record)
+		models.SetAlarmEventRecordChildren(&object, children[record.AlarmEventRecordID])
+		objects = append(objects, object)
 	}
 
-	return api.GetAlarms200JSONResponse(objects), nil
+	return api.GetAlarms200JSONResponse{
+		Body:    objects,
+		Headers: headers,
+	}, nil
+}
+
+// makeNextPageLink builds the value of the Link header pointing at the next page of alarms.  The query parameters of
+// the current request are carried over so that the next page is selected and rendered in the same way.
+func makeNextPageLink(params api.GetAlarmsParams, marker models.AlarmEventRecordMarker) string {
+	query := url.Values{}
+	query.Set(nextPageMarkerParam, marker.Encode())
+	if params.Filter != nil {
+		query.Set("filter", *params.Filter)
+	}
+	if params.NodeClusterId != nil {
+		query.Set(nodeClusterIDParam, params.NodeClusterId.String())
+	}
+	if params.Fields != nil {
+		query.Set("fields", *params.Fields)
+	}
+	if params.ExcludeFields != nil {
+		query.Set("exclude_fields", *params.ExcludeFields)
+	}
+	if params.AllFields != nil {
+		query.Set("all_fields", *params.AllFields)
+	}
+	return fmt.Sprintf("<%s%s?%s>; rel=\"next\"", baseURL, constants.AlarmsPath, query.Encode())
 }
 
 // GetAlarm handles an API request to retrieve an Alarm Event Record
@@ -258,7 +556,42 @@ func (a *AlarmsServer) GetAlarm(ctx context.Context, request api.GetAlarmRequest
 		return nil, fmt.Errorf("failed to get Alarm Event Record: %w", err)
 	}
 
-	return api.GetAlarm200JSONResponse(models.ConvertAlarmEventRecordModelToApi(*record)), nil
+	children, err := a.AlarmsRepository.GetChildAlarmEventRecordIDs(ctx, []uuid.UUID{record.AlarmEventRecordID})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get correlated Alarm Event Records: %w", err)
+	}
+
+	object := models.ConvertAlarmEventRecordModelToApi(*record)
+	models.SetAlarmEventRecordChildren(&object, children[record.AlarmEventRecordID])
+	return api.GetAlarm200JSONResponse(object), nil
+}
+
+// GetAlarmHistory handles an API request to retrieve the state transitions of an Alarm Event Record
+func (a *AlarmsServer) GetAlarmHistory(ctx context.Context, request api.GetAlarmHistoryRequestObject) (api.GetAlarmHistoryResponseObject, error) {
+	if _, err := a.AlarmsRepository.GetAlarmEventRecord(ctx, request.AlarmEventRecordId); err != nil {
+		if errors.Is(err, svcutils.ErrNotFound) {
+			return api.GetAlarmHistory404ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"alarmEventRecordId": request.AlarmEventRecordId.String(),
+				},
+				Detail: "requested Alarm Event Record not found",
+				Status: http.StatusNotFound,
+			}), nil
+		}
+		return nil, fmt.Errorf("failed to get Alarm Event Record: %w", err)
+	}
+
+	records, err := a.AlarmsRepository.GetAlarmEventHistory(ctx, request.AlarmEventRecordId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get Alarm Event Record history: %w", err)
+	}
+
+	objects := make([]api.AlarmEventHistoryRecord, 0, len(records))
+	for _, record := range records {
+		objects = append(objects, models.ConvertAlarmEventHistoryModelToApi(record))
+	}
+
+	return api.GetAlarmHistory200JSONResponse(objects), nil
 }
 
 // PatchAlarm handles an API request to patch an Alarm Event Record
@@ -318,16 +651,49 @@ func (a *AlarmsServer) PatchAlarm(ctx context.Context, request api.PatchAlarmReq
 			}), nil
 		}
 
-		// All our alarms have AUTOMATIC clearing type
-		// TODO: support clearing type MANUAL alarms
+		// Alarms with AUTOMATIC clearing type are only cleared by their source
+		if record.ClearingType != string(common.MANUAL) {
+			return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"alarmEventRecordId": request.AlarmEventRecordId.String(),
+				},
+				Detail: "cannot clear an alarm with clearing type other than MANUAL",
+				Status: http.StatusConflict,
+			}), nil
+		}
 
-		return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
-			AdditionalAttributes: &map[string]string{
-				"alarmEventRecordId": request.AlarmEventRecordId.String(),
-			},
-			Detail: "cannot clear an alarm with clearing type other than MANUAL",
-			Status: http.StatusConflict,
-		}), nil
+		// Check if the Alarm Event Record has already been cleared
+		if record.AlarmStatus == string(api.Resolved) {
+			return api.PatchAlarm409ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"alarmEventRecordId": request.AlarmEventRecordId.String(),
+				},
+				Detail: "Alarm record is already cleared",
+				Status: http.StatusConflict,
+			}), nil
+		}
+
+		// Clear the Alarm Event Record.  The trigger sets the alarm_changed_time and queues the CLEAR notification.
+		record.AlarmStatus = string(api.Resolved)
+		record.PerceivedSeverity = api.CLEARED
+		currentTime := time.Now()
+		record.AlarmClearedTime = &currentTime
+
+		// Keep track of who cleared the alarm for the history
+		if user, ok := k8srequest.UserFrom(ctx); ok {
+			name := user.GetName()
+			record.AlarmClearedBy = &name
+		}
+
+		cleared, err := a.AlarmsRepository.ClearAlarmEventRecord(ctx, request.AlarmEventRecordId, record)
+		if err != nil {
+			return nil, fmt.Errorf("failed to clear Alarm Event Record: %w", err)
+		}
+
+		slog.Debug("Alarm cleared", "alarmEventRecordId", cleared.AlarmEventRecordID, "alarmClearedTime", cleared.AlarmClearedTime,
+			"alarmClearedBy", cleared.AlarmClearedBy, "alarmChangedTime", cleared.AlarmChangedTime)
+
+		return api.PatchAlarm200JSONResponse{PerceivedSeverity: request.Body.PerceivedSeverity}, nil
 	}
 
 	// Patch alarmAcknowledged
@@ -362,6 +728,12 @@ func (a *AlarmsServer) PatchAlarm(ctx context.Context, request api.PatchAlarmReq
 		record.AlarmAcknowledged = alarmAcknowledged
 		currentTime := time.Now()
 		record.AlarmAcknowledgedTime = &currentTime
+
+		// Keep track of who acknowledged the alarm for the history
+		if user, ok := k8srequest.UserFrom(ctx); ok {
+			name := user.GetName()
+			record.AlarmAcknowledgedBy = &name
+		}
 	}
 
 	// Update the Alarm Event Record
@@ -412,7 +784,7 @@ func (a *AlarmsServer) PatchAlarmServiceConfiguration(ctx context.Context, reque
 	// Patch the Alarm Service Configuration
 	if request.Body.RetentionPeriod != 0 {
 		// Check if the retention period is valid
-		if serviceConfigRecord.RetentionPeriod < minRetentionPeriod {
+		if request.Body.RetentionPeriod < minRetentionPeriod {
 			return api.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
 				Detail: fmt.Sprintf("retentionPeriod must be greater than or equal to %d", minRetentionPeriod),
 				Status: http.StatusBadRequest,
@@ -426,17 +798,24 @@ func (a *AlarmsServer) PatchAlarmServiceConfiguration(ctx context.Context, reque
 		serviceConfigRecord.Extensions = *request.Body.Extensions
 	}
 
+	if request.Body.CorrelationRules != nil {
+		if err := validateCorrelationRules(*request.Body.CorrelationRules); err != nil {
+			return api.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}), nil
+		}
+		serviceConfigRecord.CorrelationRules = *request.Body.CorrelationRules
+	}
+
 	// Patch the Alarm Service Configuration
 	patched, err := a.AlarmsRepository.UpdateServiceConfiguration(ctx, serviceConfigRecord.ID, &serviceConfigRecord)
 	if err != nil {
 		return nil, fmt.Errorf("failed to patch Alarm Service Configuration: %w", err)
 	}
 
-	// TODO make it event-driven with PG listen/notify
-	// Update Cronjob
-	if err := a.ServiceConfig.EnsureCleanupCronJob(ctx, patched); err != nil {
-		return nil, fmt.Errorf("failed to start cleanup cronjob during AlarmServiceConfiguration patch: %w", err)
-	}
+	// Apply the new retention period immediately
+	a.RetentionJob.Trigger()
 
 	slog.Debug("Alarm Service Configuration patched", "retentionPeriod", patched.RetentionPeriod, "extensions", patched.Extensions)
 	return api.PatchAlarmServiceConfiguration200JSONResponse(models.ConvertServiceConfigurationToAPI(*patched)), nil
@@ -471,23 +850,49 @@ func (a *AlarmsServer) UpdateAlarmServiceConfiguration(ctx context.Context, requ
 		serviceConfigRecord.Extensions = *request.Body.Extensions
 	}
 
-	// TODO make it event-driven with PG listen/notify
+	serviceConfigRecord.CorrelationRules = nil
+	if request.Body.CorrelationRules != nil {
+		if err := validateCorrelationRules(*request.Body.CorrelationRules); err != nil {
+			return api.UpdateAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}), nil
+		}
+		serviceConfigRecord.CorrelationRules = *request.Body.CorrelationRules
+	}
+
 	// Update the Alarm Service Configuration
 	updated, err := a.AlarmsRepository.UpdateServiceConfiguration(ctx, serviceConfigRecord.ID, &serviceConfigRecord)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update Alarm Service Configuration: %w", err)
 	}
 
-	// Update Cronjob
-	if err := a.ServiceConfig.EnsureCleanupCronJob(ctx, updated); err != nil {
-		return nil, fmt.Errorf("failed to start cleanup cronjob during AlarmServiceConfiguration update: %w", err)
-	}
+	// Apply the new retention period immediately
+	a.RetentionJob.Trigger()
 
 	slog.Debug("Alarm Service Configuration updated", "retentionPeriod", updated.RetentionPeriod, "extensions", updated.Extensions)
 	return api.UpdateAlarmServiceConfiguration200JSONResponse(models.ConvertServiceConfigurationToAPI(*updated)), nil
 
 }
 
+// validateCorrelationRules checks the constraints of the correlation rules that can't be expressed in the OpenAPI
+// specification
+func validateCorrelationRules(rules []api.AlarmCorrelationRule) error {
+	names := make(map[string]bool, len(rules))
+	for _, rule := range rules {
+		if names[rule.Name] {
+			return fmt.Errorf("correlation rule names must be unique: %s", rule.Name)
+		}
+		names[rule.Name] = true
+
+		if rule.CorrelatedAlarms != nil && slices.Contains(*rule.CorrelatedAlarms, rule.RootCause) {
+			return fmt.Errorf("correlation rule %s: the root cause alarm can't be correlated to itself", rule.Name)
+		}
+	}
+
+	return nil
+}
+
 // AmNotification handles an API request coming from Alertmanager with CaaS alerts. This api is used internally.
 // Note: the errors returned can also be view under alertmanager pod logs but also logging here for convenience
 func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotificationRequestObject) (api.AmNotificationResponseObject, error) {
@@ -504,7 +909,20 @@ func (a *AlarmsServer) AmNotification(ctx context.Context, request api.AmNotific
 	return api.AmNotification200Response{}, nil
 }
 
+// HwNotification handles an API request coming from a hardware plugin with hardware alerts. This api is used internally.
 func (a *AlarmsServer) HwNotification(ctx context.Context, request api.HwNotificationRequestObject) (api.HwNotificationResponseObject, error) {
-	// TODO implement me
-	return nil, fmt.Errorf("not implemented")
+	if request.Body == nil {
+		slog.Error("hardware alerts payload is missing", "hwPluginName", request.HwVendorName)
+		return api.HwNotification400Response{}, nil
+	}
+
+	if err := hardware.HandleAlerts(ctx, a.AlarmsRepository, a.CloudID, request.HwVendorName, request.Body); err != nil {
+		msg := "failed to handle hardware alerts"
+		slog.Error(msg, "hwPluginName", request.HwVendorName, "error", err)
+		return nil, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	// Subscriber notification sent async
+	slog.Info("Successfully handled all hardware alerts", "hwPluginName", request.HwVendorName)
+	return api.HwNotification200Response{}, nil
 }
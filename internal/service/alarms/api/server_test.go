@@ -10,16 +10,26 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	"k8s.io/apiserver/pkg/authentication/user"
+	k8srequest "k8s.io/apiserver/pkg/endpoints/request"
 
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api"
 	alarmapi "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/serviceconfig"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
@@ -62,6 +72,9 @@ var _ = Describe("AlarmsServer", func() {
 				mockRepo.EXPECT().
 					GetAlarmEventRecord(ctx, testUUID).
 					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID}, nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, []uuid.UUID{testUUID}).
+					Return(map[uuid.UUID][]uuid.UUID{}, nil)
 
 				resp, err := server.GetAlarm(ctx, alarmapi.GetAlarmRequestObject{
 					AlarmEventRecordId: testUUID,
@@ -72,6 +85,47 @@ var _ = Describe("AlarmsServer", func() {
 			})
 		})
 
+		When("alarm is a root cause", func() {
+			It("returns the correlated alarms in the extensions", func() {
+				children := []uuid.UUID{uuid.New(), uuid.New()}
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID}, nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, []uuid.UUID{testUUID}).
+					Return(map[uuid.UUID][]uuid.UUID{testUUID: children}, nil)
+
+				resp, err := server.GetAlarm(ctx, alarmapi.GetAlarmRequestObject{
+					AlarmEventRecordId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				record := resp.(alarmapi.GetAlarm200JSONResponse)
+				Expect(record.Extensions).To(HaveKeyWithValue(models.ChildAlarmEventRecordIDsExtension,
+					children[0].String()+","+children[1].String()))
+			})
+		})
+
+		When("alarm is correlated to a root cause", func() {
+			It("returns the root cause in the extensions", func() {
+				parent := uuid.New()
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID, CorrelationParentID: &parent}, nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, []uuid.UUID{testUUID}).
+					Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+				resp, err := server.GetAlarm(ctx, alarmapi.GetAlarmRequestObject{
+					AlarmEventRecordId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				record := resp.(alarmapi.GetAlarm200JSONResponse)
+				Expect(record.Extensions).To(HaveKeyWithValue(models.ParentAlarmEventRecordIDExtension, parent.String()))
+			})
+		})
+
 		When("repository is unavailable", func() {
 			It("returns error", func() {
 				mockRepo.EXPECT().
@@ -87,4 +141,615 @@ var _ = Describe("AlarmsServer", func() {
 			})
 		})
 	})
+
+	Describe("GetAlarmHistory", func() {
+		When("alarm not found", func() {
+			It("returns 404 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.GetAlarmHistory(ctx, alarmapi.GetAlarmHistoryRequestObject{
+					AlarmEventRecordId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				problemResp := resp.(alarmapi.GetAlarmHistory404ApplicationProblemPlusJSONResponse)
+				Expect(problemResp.Status).To(Equal(http.StatusNotFound))
+			})
+		})
+
+		When("alarm is found", func() {
+			It("returns 200 response with the transitions", func() {
+				actor := "smo-user"
+				changed := time.Now()
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID}, nil)
+				mockRepo.EXPECT().
+					GetAlarmEventHistory(ctx, testUUID).
+					Return([]models.AlarmEventHistory{
+						{AlarmEventRecordID: testUUID, NotificationEventType: alarmapi.AlarmSubscriptionInfoFilterNEW, PerceivedSeverity: alarmapi.MAJOR, AlarmChangedTime: &changed},
+						{AlarmEventRecordID: testUUID, NotificationEventType: alarmapi.AlarmSubscriptionInfoFilterACKNOWLEDGE, PerceivedSeverity: alarmapi.MAJOR, AlarmAcknowledgedBy: &actor, AlarmChangedTime: &changed},
+					}, nil)
+
+				resp, err := server.GetAlarmHistory(ctx, alarmapi.GetAlarmHistoryRequestObject{
+					AlarmEventRecordId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				history := resp.(alarmapi.GetAlarmHistory200JSONResponse)
+				Expect(history).To(HaveLen(2))
+				Expect(history[0].NotificationEventType).To(Equal(alarmapi.AlarmSubscriptionInfoFilterNEW))
+				Expect(history[0].AlarmChangedTime).To(Equal(changed))
+				Expect(history[1].AlarmAcknowledgedBy).To(Equal(&actor))
+			})
+		})
+	})
+
+	Describe("PatchAlarm", func() {
+		It("records the user who acknowledged the alarm", func() {
+			ctx := k8srequest.WithUser(ctx, &user.DefaultInfo{Name: "smo-user"})
+			mockRepo.EXPECT().
+				GetAlarmEventRecord(ctx, testUUID).
+				Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID}, nil)
+			mockRepo.EXPECT().
+				PatchAlarmEventRecordACK(ctx, testUUID, gomock.Any()).
+				DoAndReturn(func(_ context.Context, _ uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
+					Expect(record.AlarmAcknowledged).To(BeTrue())
+					Expect(record.AlarmAcknowledgedBy).To(HaveValue(Equal("smo-user")))
+					return record, nil
+				})
+
+			acknowledged := true
+			resp, err := server.PatchAlarm(ctx, alarmapi.PatchAlarmRequestObject{
+				AlarmEventRecordId: testUUID,
+				Body:               &alarmapi.AlarmEventRecordModifications{AlarmAcknowledged: &acknowledged},
+			})
+
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarm200JSONResponse{}))
+		})
+
+		When("the alarm has an AUTOMATIC clearing type", func() {
+			It("returns 409 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID, AlarmStatus: string(alarmapi.Firing), ClearingType: "AUTOMATIC"}, nil)
+
+				cleared := alarmapi.CLEARED
+				resp, err := server.PatchAlarm(ctx, alarmapi.PatchAlarmRequestObject{
+					AlarmEventRecordId: testUUID,
+					Body:               &alarmapi.AlarmEventRecordModifications{PerceivedSeverity: &cleared},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarm409ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("the alarm has a MANUAL clearing type", func() {
+			It("clears the alarm and records the user who cleared it", func() {
+				ctx := k8srequest.WithUser(ctx, &user.DefaultInfo{Name: "smo-user"})
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID, AlarmStatus: string(alarmapi.Firing), ClearingType: "MANUAL"}, nil)
+				mockRepo.EXPECT().
+					ClearAlarmEventRecord(ctx, testUUID, gomock.Any()).
+					DoAndReturn(func(_ context.Context, _ uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
+						Expect(record.AlarmStatus).To(Equal(string(alarmapi.Resolved)))
+						Expect(record.PerceivedSeverity).To(Equal(alarmapi.CLEARED))
+						Expect(record.AlarmClearedTime).NotTo(BeNil())
+						Expect(record.AlarmClearedBy).To(HaveValue(Equal("smo-user")))
+						return record, nil
+					})
+
+				cleared := alarmapi.CLEARED
+				resp, err := server.PatchAlarm(ctx, alarmapi.PatchAlarmRequestObject{
+					AlarmEventRecordId: testUUID,
+					Body:               &alarmapi.AlarmEventRecordModifications{PerceivedSeverity: &cleared},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.(alarmapi.PatchAlarm200JSONResponse).PerceivedSeverity).To(HaveValue(Equal(alarmapi.CLEARED)))
+			})
+
+			It("returns 409 response if the alarm is already cleared", func() {
+				mockRepo.EXPECT().
+					GetAlarmEventRecord(ctx, testUUID).
+					Return(&models.AlarmEventRecord{AlarmEventRecordID: testUUID, AlarmStatus: string(alarmapi.Resolved), ClearingType: "MANUAL"}, nil)
+
+				cleared := alarmapi.CLEARED
+				resp, err := server.PatchAlarm(ctx, alarmapi.PatchAlarmRequestObject{
+					AlarmEventRecordId: testUUID,
+					Body:               &alarmapi.AlarmEventRecordModifications{PerceivedSeverity: &cleared},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarm409ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+	})
+
+	Describe("GetAlarms", func() {
+		When("there are more alarms than fit in a page", func() {
+			It("returns a link to the next page", func() {
+				now := time.Now().UTC()
+				records := make([]models.AlarmEventRecord, 1001)
+				for i := range records {
+					records[i] = models.AlarmEventRecord{
+						AlarmEventRecordID: uuid.New(),
+						AlarmRaisedTime:    now.Add(-time.Duration(i) * time.Second),
+					}
+				}
+				filter := "(eq,perceivedSeverity,1)"
+
+				mockRepo.EXPECT().
+					GetAlarmEventRecords(ctx, gomock.Not(gomock.Nil()), gomock.Nil(), gomock.Nil(), 1001).
+					Return(records, nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, gomock.Len(1000)).
+					Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+				resp, err := server.GetAlarms(ctx, alarmapi.GetAlarmsRequestObject{
+					Params: alarmapi.GetAlarmsParams{Filter: &filter},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				page := resp.(alarmapi.GetAlarms200JSONResponse)
+				Expect(page.Body).To(HaveLen(1000))
+				Expect(page.Headers.Link).To(HaveSuffix(`>; rel="next"`))
+
+				link, err := url.Parse(strings.TrimSuffix(strings.TrimPrefix(page.Headers.Link, "<"), `>; rel="next"`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(link.Query().Get("filter")).To(Equal(filter))
+				value := link.Query().Get("nextpage_opaque_marker")
+				marker, err := models.DecodeAlarmEventRecordMarker(value)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(marker.AlarmEventRecordID).To(Equal(records[999].AlarmEventRecordID))
+				Expect(marker.AlarmRaisedTime.Equal(records[999].AlarmRaisedTime)).To(BeTrue())
+
+				mockRepo.EXPECT().
+					GetAlarmEventRecords(ctx, gomock.Not(gomock.Nil()), gomock.Nil(), marker, 1001).
+					Return(records[1000:], nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, gomock.Len(1)).
+					Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+				resp, err = server.GetAlarms(ctx, alarmapi.GetAlarmsRequestObject{
+					Params: alarmapi.GetAlarmsParams{Filter: &filter, NextpageOpaqueMarker: &value},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				page = resp.(alarmapi.GetAlarms200JSONResponse)
+				Expect(page.Body).To(HaveLen(1))
+				Expect(page.Headers.Link).To(BeEmpty())
+			})
+		})
+
+		When("a node cluster is provided", func() {
+			It("gets the alarms of the node cluster and keeps it in the link to the next page", func() {
+				nodeClusterID := uuid.New()
+				records := make([]models.AlarmEventRecord, 1001)
+				for i := range records {
+					records[i] = models.AlarmEventRecord{AlarmEventRecordID: uuid.New()}
+				}
+
+				mockRepo.EXPECT().
+					GetAlarmEventRecords(ctx, gomock.Any(), &nodeClusterID, gomock.Nil(), 1001).
+					Return(records, nil)
+				mockRepo.EXPECT().
+					GetChildAlarmEventRecordIDs(ctx, gomock.Len(1000)).
+					Return(map[uuid.UUID][]uuid.UUID{}, nil)
+
+				resp, err := server.GetAlarms(ctx, alarmapi.GetAlarmsRequestObject{
+					Params: alarmapi.GetAlarmsParams{NodeClusterId: &nodeClusterID},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				page := resp.(alarmapi.GetAlarms200JSONResponse)
+				Expect(page.Body).To(HaveLen(1000))
+
+				link, err := url.Parse(strings.TrimSuffix(strings.TrimPrefix(page.Headers.Link, "<"), `>; rel="next"`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(link.Query().Get("node_cluster_id")).To(Equal(nodeClusterID.String()))
+			})
+		})
+
+		When("the marker is invalid", func() {
+			It("returns 400 response", func() {
+				value := "not-a-marker"
+				resp, err := server.GetAlarms(ctx, alarmapi.GetAlarmsRequestObject{
+					Params: alarmapi.GetAlarmsParams{NextpageOpaqueMarker: &value},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.GetAlarms400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("the filter can't be translated", func() {
+			It("returns 400 response", func() {
+				filter := "(eq,unknown,1)"
+				mockRepo.EXPECT().
+					GetAlarmEventRecords(ctx, gomock.Any(), gomock.Nil(), gomock.Nil(), 1001).
+					Return(nil, fmt.Errorf("%w: unsupported field", repo.ErrInvalidFilter))
+
+				resp, err := server.GetAlarms(ctx, alarmapi.GetAlarmsRequestObject{
+					Params: alarmapi.GetAlarmsParams{Filter: &filter},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.GetAlarms400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+	})
+
+	Describe("GetSubscriptionDeadLetters", func() {
+		When("subscription not found", func() {
+			It("returns 404 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.GetSubscriptionDeadLetters(ctx, alarmapi.GetSubscriptionDeadLettersRequestObject{
+					AlarmSubscriptionId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("subscription is suspended", func() {
+			It("returns 200 response with the queue and the end of the suspension", func() {
+				suspendedUntil := time.Now().Add(time.Hour)
+				deadLetterID := uuid.New()
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(&models.AlarmSubscription{SubscriptionID: testUUID, SuspendedUntil: &suspendedUntil}, nil)
+				mockRepo.EXPECT().
+					GetDeadLetterNotifications(ctx, testUUID).
+					Return([]commonmodels.DeadLetterNotification{{
+						DeadLetterID:   &deadLetterID,
+						SubscriptionID: testUUID,
+						NotificationID: uuid.New(),
+						SequenceID:     3,
+						Payload:        map[string]interface{}{"alarmEventRecordId": "a"},
+						Attempts:       1,
+						LastError:      "notification failed: 503",
+					}}, nil)
+
+				resp, err := server.GetSubscriptionDeadLetters(ctx, alarmapi.GetSubscriptionDeadLettersRequestObject{
+					AlarmSubscriptionId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				queue := resp.(alarmapi.GetSubscriptionDeadLetters200JSONResponse)
+				Expect(queue.SubscriptionId).To(Equal(testUUID))
+				Expect(queue.SuspendedUntil).To(Equal(&suspendedUntil))
+				Expect(queue.Notifications).To(HaveLen(1))
+				Expect(queue.Notifications[0].DeadLetterId).To(Equal(deadLetterID))
+				Expect(queue.Notifications[0].SequenceId).To(Equal(3))
+				Expect(queue.Notifications[0].LastError).To(Equal("notification failed: 503"))
+			})
+		})
+	})
+
+	Describe("ReplaySubscriptionDeadLetters", func() {
+		var handler *fakeSubscriptionEventHandler
+
+		BeforeEach(func() {
+			handler = &fakeSubscriptionEventHandler{}
+			server.SubscriptionEventHandler = handler
+		})
+
+		When("subscription not found", func() {
+			It("returns 404 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.ReplaySubscriptionDeadLetters(ctx, alarmapi.ReplaySubscriptionDeadLettersRequestObject{
+					AlarmSubscriptionId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{}))
+				Expect(handler.replayed).To(BeEmpty())
+			})
+		})
+
+		When("subscription is found", func() {
+			It("signals the notifier and returns 202 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(&models.AlarmSubscription{SubscriptionID: testUUID}, nil)
+
+				resp, err := server.ReplaySubscriptionDeadLetters(ctx, alarmapi.ReplaySubscriptionDeadLettersRequestObject{
+					AlarmSubscriptionId: testUUID,
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.ReplaySubscriptionDeadLetters202Response{}))
+				Expect(handler.replayed).To(Equal([]uuid.UUID{testUUID}))
+			})
+		})
+	})
+
+	Describe("RotateSubscriptionSigningSecret", func() {
+		var handler *fakeSubscriptionEventHandler
+
+		BeforeEach(func() {
+			handler = &fakeSubscriptionEventHandler{}
+			server.SubscriptionEventHandler = handler
+		})
+
+		When("subscription not found", func() {
+			It("returns 404 response", func() {
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.RotateSubscriptionSigningSecret(ctx, alarmapi.RotateSubscriptionSigningSecretRequestObject{
+					AlarmSubscriptionId: testUUID,
+					Body:                &common.SigningSecretRotation{SigningSecret: "new-secret-value"},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse{}))
+				Expect(handler.events).To(BeEmpty())
+			})
+		})
+
+		When("subscription has a secret", func() {
+			It("keeps the previous secret and signals the notifier", func() {
+				current := "old-secret-value"
+				mockRepo.EXPECT().
+					GetAlarmSubscription(ctx, testUUID).
+					Return(&models.AlarmSubscription{SubscriptionID: testUUID, SigningSecret: &current}, nil)
+				mockRepo.EXPECT().
+					UpdateSubscriptionSigningSecret(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, record models.AlarmSubscription) error {
+						Expect(*record.SigningSecret).To(Equal("new-secret-value"))
+						Expect(*record.PreviousSigningSecret).To(Equal("old-secret-value"))
+						Expect(record.PreviousSigningSecretExpiry).ToNot(BeNil())
+						return nil
+					})
+
+				resp, err := server.RotateSubscriptionSigningSecret(ctx, alarmapi.RotateSubscriptionSigningSecretRequestObject{
+					AlarmSubscriptionId: testUUID,
+					Body:                &common.SigningSecretRotation{SigningSecret: "new-secret-value"},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				status := resp.(alarmapi.RotateSubscriptionSigningSecret200JSONResponse)
+				Expect(status.SubscriptionId).To(Equal(testUUID))
+				Expect(status.PreviousSecretExpiry).ToNot(BeNil())
+				Expect(handler.events).To(HaveLen(1))
+				Expect(handler.events[0].Removed).To(BeFalse())
+				Expect(handler.events[0].Subscription.SigningSecrets.Current).To(Equal("new-secret-value"))
+			})
+		})
+	})
+
+	Describe("PatchAlarmServiceConfiguration", func() {
+		var record models.ServiceConfiguration
+
+		BeforeEach(func() {
+			server.RetentionJob = serviceconfig.NewRetentionJob(mockRepo, time.Hour, serviceconfig.DefaultArchiveRetentionPeriod)
+			record = models.ServiceConfiguration{ID: testUUID, RetentionPeriod: 7}
+			mockRepo.EXPECT().GetServiceConfigurations(ctx).Return([]models.ServiceConfiguration{record}, nil)
+		})
+
+		When("the retention period is invalid", func() {
+			It("returns 400 response", func() {
+				resp, err := server.PatchAlarmServiceConfiguration(ctx, alarmapi.PatchAlarmServiceConfigurationRequestObject{
+					Body: &alarmapi.AlarmServiceConfiguration{RetentionPeriod: -1},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("the retention period is valid", func() {
+			It("returns 200 response with the new retention period", func() {
+				patched := record
+				patched.RetentionPeriod = 1
+				mockRepo.EXPECT().UpdateServiceConfiguration(ctx, testUUID, gomock.Any()).Return(&patched, nil)
+
+				resp, err := server.PatchAlarmServiceConfiguration(ctx, alarmapi.PatchAlarmServiceConfigurationRequestObject{
+					Body: &alarmapi.AlarmServiceConfiguration{RetentionPeriod: 1},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp.(alarmapi.PatchAlarmServiceConfiguration200JSONResponse).RetentionPeriod).To(Equal(1))
+			})
+		})
+
+		When("correlation rules have the same name", func() {
+			It("returns 400 response", func() {
+				rules := []alarmapi.AlarmCorrelationRule{
+					{Name: "node-down", RootCause: "NodeNotReady"},
+					{Name: "node-down", RootCause: "KubeNodeUnreachable"},
+				}
+				resp, err := server.PatchAlarmServiceConfiguration(ctx, alarmapi.PatchAlarmServiceConfigurationRequestObject{
+					Body: &alarmapi.AlarmServiceConfiguration{CorrelationRules: &rules},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("a correlation rule identifies its alarms by their alarm definition", func() {
+			It("returns 200 response with the rule", func() {
+				rules := []alarmapi.AlarmCorrelationRule{
+					{Name: "bmc-down", RootCause: uuid.NewString(), CorrelatedAlarms: &[]string{uuid.NewString(), "KubeNodeNotReady"}},
+				}
+				patched := record
+				patched.CorrelationRules = rules
+				mockRepo.EXPECT().UpdateServiceConfiguration(ctx, testUUID, gomock.Any()).Return(&patched, nil)
+
+				resp, err := server.PatchAlarmServiceConfiguration(ctx, alarmapi.PatchAlarmServiceConfigurationRequestObject{
+					Body: &alarmapi.AlarmServiceConfiguration{CorrelationRules: &rules},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*resp.(alarmapi.PatchAlarmServiceConfiguration200JSONResponse).CorrelationRules).To(Equal(rules))
+			})
+		})
+
+		When("a correlation rule correlates its root cause to itself", func() {
+			It("returns 400 response", func() {
+				rules := []alarmapi.AlarmCorrelationRule{
+					{Name: "node-down", RootCause: "NodeNotReady", CorrelatedAlarms: &[]string{"NodeNotReady"}},
+				}
+				resp, err := server.PatchAlarmServiceConfiguration(ctx, alarmapi.PatchAlarmServiceConfigurationRequestObject{
+					Body: &alarmapi.AlarmServiceConfiguration{CorrelationRules: &rules},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.PatchAlarmServiceConfiguration400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+	})
+
+	Describe("CreateSubscription", func() {
+		BeforeEach(func() {
+			server.GlobalCloudID = uuid.New()
+		})
+
+		DescribeTable("returns 400 response for an invalid selector",
+			func(selector, detail string) {
+				resp, err := server.CreateSubscription(ctx, alarmapi.CreateSubscriptionRequestObject{
+					Body: &alarmapi.AlarmSubscriptionInfo{Callback: "https://smo.example.com/notifications", Selector: &selector},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				problemResp := resp.(alarmapi.CreateSubscription400ApplicationProblemPlusJSONResponse)
+				Expect(problemResp.Detail).To(ContainSubstring(detail))
+				Expect(*problemResp.AdditionalAttributes).To(HaveKeyWithValue("selector", selector))
+			},
+			Entry("syntax error", "(eq,perceivedSeverity", "invalid selector: invalid syntax"),
+			Entry("unknown attribute", "(eq,alarmRaisedTime,0)", "invalid attribute 'alarmRaisedTime'"),
+			Entry("nested attribute", "(eq,perceivedSeverity/value,0)", "invalid attribute 'perceivedSeverity/value'"),
+			Entry("non integer severity", "(in,perceivedSeverity,0,MAJOR)", "it must be an integer"),
+		)
+	})
+
+	Describe("CreateMaintenanceWindow", func() {
+		var start time.Time
+
+		BeforeEach(func() {
+			start = time.Now().Truncate(time.Second)
+		})
+
+		When("the window has no end", func() {
+			It("returns 400 response", func() {
+				resp, err := server.CreateMaintenanceWindow(ctx, alarmapi.CreateMaintenanceWindowRequestObject{
+					Body: &alarmapi.MaintenanceWindow{NodeClusterId: testUUID, StartTime: start},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("the window ends before it starts", func() {
+			It("returns 400 response", func() {
+				end := start.Add(-time.Hour)
+				resp, err := server.CreateMaintenanceWindow(ctx, alarmapi.CreateMaintenanceWindowRequestObject{
+					Body: &alarmapi.MaintenanceWindow{NodeClusterId: testUUID, StartTime: start, EndTime: &end},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				problemResp := resp.(alarmapi.CreateMaintenanceWindow400ApplicationProblemPlusJSONResponse)
+				Expect(problemResp.Detail).To(ContainSubstring("endTime must be after startTime"))
+			})
+		})
+
+		When("the window is valid", func() {
+			It("returns 201 response with an active window", func() {
+				end := start.Add(time.Hour)
+				mockRepo.EXPECT().
+					CreateMaintenanceWindow(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, record models.MaintenanceWindow) (*models.MaintenanceWindow, error) {
+						Expect(record.NodeClusterID).To(Equal(testUUID))
+						Expect(record.AlarmDefinitionIDs).To(BeNil())
+						record.MaintenanceWindowID = uuid.New()
+						return &record, nil
+					})
+
+				resp, err := server.CreateMaintenanceWindow(ctx, alarmapi.CreateMaintenanceWindowRequestObject{
+					Body: &alarmapi.MaintenanceWindow{NodeClusterId: testUUID, StartTime: start, EndTime: &end},
+				})
+
+				Expect(err).NotTo(HaveOccurred())
+				window := resp.(alarmapi.CreateMaintenanceWindow201JSONResponse)
+				Expect(window.MaintenanceWindowId).ToNot(BeNil())
+				Expect(window.Status).To(HaveValue(Equal(alarmapi.ACTIVE)))
+				Expect(window.SuppressedAlarms).To(BeNil())
+			})
+		})
+	})
+
+	Describe("GetMaintenanceWindow", func() {
+		It("returns the summary of a closed window", func() {
+			start := time.Now().Add(-2 * time.Hour)
+			end := start.Add(time.Hour)
+			mockRepo.EXPECT().
+				GetMaintenanceWindow(ctx, testUUID).
+				Return(&models.MaintenanceWindow{
+					MaintenanceWindowID: testUUID, StartTime: start, EndTime: &end, SummarySentAt: &end,
+					SuppressedAlarms: 12, ReleasedNotifications: 3,
+				}, nil)
+
+			resp, err := server.GetMaintenanceWindow(ctx, alarmapi.GetMaintenanceWindowRequestObject{MaintenanceWindowId: testUUID})
+
+			Expect(err).NotTo(HaveOccurred())
+			window := resp.(alarmapi.GetMaintenanceWindow200JSONResponse)
+			Expect(window.Status).To(HaveValue(Equal(alarmapi.CLOSED)))
+			Expect(window.SuppressedAlarms).To(HaveValue(Equal(12)))
+			Expect(window.ReleasedNotifications).To(HaveValue(Equal(3)))
+		})
+	})
+
+	Describe("DeleteMaintenanceWindow", func() {
+		When("window not found", func() {
+			It("returns 404 response", func() {
+				mockRepo.EXPECT().DeleteMaintenanceWindow(ctx, testUUID).Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.DeleteMaintenanceWindow(ctx, alarmapi.DeleteMaintenanceWindowRequestObject{MaintenanceWindowId: testUUID})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.DeleteMaintenanceWindow404ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+
+		When("window exists", func() {
+			It("deletes the window", func() {
+				mockRepo.EXPECT().DeleteMaintenanceWindow(ctx, testUUID).Return(&models.MaintenanceWindow{MaintenanceWindowID: testUUID}, nil)
+
+				resp, err := server.DeleteMaintenanceWindow(ctx, alarmapi.DeleteMaintenanceWindowRequestObject{MaintenanceWindowId: testUUID})
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(BeAssignableToTypeOf(alarmapi.DeleteMaintenanceWindow200Response{}))
+			})
+		})
+	})
 })
+
+// fakeSubscriptionEventHandler records the requests sent to the notifier
+type fakeSubscriptionEventHandler struct {
+	replayed []uuid.UUID
+	events   []*notifier.SubscriptionEvent
+}
+
+func (f *fakeSubscriptionEventHandler) SubscriptionEvent(ctx context.Context, event *notifier.SubscriptionEvent) {
+	f.events = append(f.events, event)
+}
+
+func (f *fakeSubscriptionEventHandler) ReplayDeadLetters(ctx context.Context, subscriptionID uuid.UUID) {
+	f.replayed = append(f.replayed, subscriptionID)
+}
+
+func (f *fakeSubscriptionEventHandler) GetClientFactory() notifier.ClientProvider {
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/cmd/server"
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/serviceconfig"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
@@ -57,12 +58,29 @@ func setServerFlags(cmd *cobra.Command) error {
 	if err := svcutils.SetCommonServerFlags(cmd, &config.CommonServerConfig); err != nil {
 		return fmt.Errorf("could not set common server flags: %w", err)
 	}
+	flags.StringVar(
+		&config.CloudID,
+		server.CloudIDFlagName,
+		"",
+		"The local O-Cloud identifier.",
+	)
 	flags.StringVar(
 		&config.GlobalCloudID,
 		server.GlobalCloudIDFlagName,
 		constants.DefaultOCloudID,
 		"The global O-Cloud identifier.",
 	)
+	flags.IntVar(
+		&config.ArchiveRetentionPeriod,
+		server.ArchiveRetentionPeriodFlagName,
+		serviceconfig.DefaultArchiveRetentionPeriod,
+		"The number of days archived alarm event records are kept before being deleted.",
+	)
+
+	// The O-Cloud ID is needed to derive the resource identifiers of hardware alarms
+	if err := cmd.MarkFlagRequired(server.CloudIDFlagName); err != nil {
+		return fmt.Errorf("failed to mark required flag %s: %w", server.CloudIDFlagName, err)
+	}
 	return nil
 }
 
@@ -16,13 +16,14 @@ import (
 	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 )
 
 // ConvertAmToAlarmEventRecordModels get alarmEventRecords based on the alertmanager notification and AlarmDefinition
 func ConvertAmToAlarmEventRecordModels(ctx context.Context, alerts *[]api.Alert, infrastructureClient infrastructure.Client) []models.AlarmEventRecord {
 	records := make([]models.AlarmEventRecord, 0, len(*alerts))
 	for _, alert := range *alerts {
-		record := models.AlarmEventRecord{}
+		record := models.AlarmEventRecord{ClearingType: string(common.AUTOMATIC)}
 
 		// Validate startsAt is always there
 		if alert.StartsAt != nil && !alert.StartsAt.IsZero() {
@@ -91,6 +92,7 @@ func ConvertAmToAlarmEventRecordModels(ctx context.Context, alerts *[]api.Alert,
 				slog.Warn("Could not get alarm definition ID", "objectTypeID", *record.ObjectTypeID, "name", getAlertName(labels), "severity", severity, "err", err.Error())
 			} else {
 				record.AlarmDefinitionID = &alarmDefinitionID
+				record.ClearingType = string(infrastructureClient.GetAlarmDefinitionClearingType(alarmDefinitionID))
 			}
 		}
 
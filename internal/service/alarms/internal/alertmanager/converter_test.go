@@ -14,6 +14,7 @@ import (
 	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/alertmanager"
 	mockinfrastructure "github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure/generated"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	"go.uber.org/mock/gomock"
 )
 
@@ -54,6 +55,10 @@ var _ = Describe("Alertmanager Functions", func() {
 				GetAlarmDefinitionID(gomock.Any(), objectTypeIDUUID, "TestAlert", "critical").
 				Return(alarmDefUUID, nil)
 
+			mockInfraClient.EXPECT().
+				GetAlarmDefinitionClearingType(alarmDefUUID).
+				Return(common.MANUAL)
+
 			// Create the alert
 			firing := api.Firing
 			labels := map[string]string{
@@ -91,6 +96,7 @@ var _ = Describe("Alertmanager Functions", func() {
 			Expect(*record.ObjectID).To(Equal(clusterUUID))
 			Expect(record.AlarmDefinitionID).NotTo(BeNil())
 			Expect(*record.AlarmDefinitionID).To(Equal(alarmDefUUID))
+			Expect(record.ClearingType).To(Equal(string(common.MANUAL)))
 		})
 
 		It("should handle resolved alerts correctly", func() {
@@ -131,6 +137,10 @@ var _ = Describe("Alertmanager Functions", func() {
 				GetAlarmDefinitionID(gomock.Any(), objectTypeIDUUID, "TestAlert", "critical").
 				Return(alarmDefUUID, nil)
 
+			mockInfraClient.EXPECT().
+				GetAlarmDefinitionClearingType(alarmDefUUID).
+				Return(common.AUTOMATIC)
+
 			records := alertmanager.ConvertAmToAlarmEventRecordModels(ctx, &alerts, mockInfraClient)
 
 			// Assert
@@ -247,6 +257,10 @@ var _ = Describe("Alertmanager Functions", func() {
 					GetAlarmDefinitionID(gomock.Any(), objectTypeIDUUID, "TestAlert", inputSeverity).
 					Return(alarmDefUUID, nil)
 
+				mockInfraClient.EXPECT().
+					GetAlarmDefinitionClearingType(alarmDefUUID).
+					Return(common.AUTOMATIC)
+
 				// Create alert with this severity
 				firing := api.Firing
 				labels := map[string]string{
@@ -0,0 +1,5 @@
+-- Drop the dead_letter_notification table
+DROP TABLE IF EXISTS dead_letter_notification;
+
+-- Drop the suspension of the subscriptions
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS suspended_until;
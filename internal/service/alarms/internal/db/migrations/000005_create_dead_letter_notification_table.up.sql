@@ -0,0 +1,20 @@
+-- Set while deliveries to the subscriber are suspended after a notification could not be delivered
+ALTER TABLE alarm_subscription_info ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ NULL;
+
+-- Stores the notifications that could not be delivered to a subscriber so that they can be redelivered
+CREATE TABLE IF NOT EXISTS dead_letter_notification (
+    dead_letter_id UUID PRIMARY KEY DEFAULT gen_random_uuid(), -- Unique identifier for each entry
+    subscription_id UUID NOT NULL, -- Subscription the notification was sent to
+    notification_id UUID NOT NULL, -- data_change_id of the original notification
+    sequence_id INTEGER NOT NULL, -- sequence_id of the original notification
+    payload JSONB NOT NULL, -- AlarmEventNotification as it would have been sent to the subscriber
+    attempts INTEGER NOT NULL DEFAULT 1, -- Number of failed deliveries
+    last_error TEXT NOT NULL, -- Error returned by the last delivery
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+    updated_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP, -- Time of the last delivery
+
+    CONSTRAINT fk_dead_letter_subscription FOREIGN KEY (subscription_id) REFERENCES alarm_subscription_info (subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letter_notification_subscription ON dead_letter_notification (subscription_id, sequence_id);
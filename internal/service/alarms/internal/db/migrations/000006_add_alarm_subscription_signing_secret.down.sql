@@ -0,0 +1,3 @@
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS previous_signing_secret_expiry;
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS previous_signing_secret;
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS signing_secret;
@@ -0,0 +1 @@
+DROP TABLE IF EXISTS alarm_event_record_archive;
@@ -0,0 +1,12 @@
+-- Table: alarm_event_record_archive
+-- Description: resolved alarm event records removed from alarm_event_record once their retention period has elapsed.
+-- Each record is kept as a JSONB document, which postgres compresses (TOAST) since archived rows are rarely read.
+CREATE TABLE IF NOT EXISTS alarm_event_record_archive
+(
+    alarm_event_record_id UUID PRIMARY KEY, -- alarm_event_record_id of the archived record
+    alarm_cleared_time    TIMESTAMPTZ,      -- alarm_cleared_time of the archived record
+    record                JSONB NOT NULL,   -- alarm_event_record row as it was before being archived
+    archived_at           TIMESTAMPTZ NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS idx_alarm_event_record_archive_archived_at ON alarm_event_record_archive (archived_at);
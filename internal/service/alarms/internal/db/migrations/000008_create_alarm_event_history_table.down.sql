@@ -0,0 +1,32 @@
+-- Restore the AFTER trigger function without the history
+CREATE OR REPLACE FUNCTION manage_alarm_event_after()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.should_create_data_change_event THEN
+       INSERT INTO data_change_event (
+           object_type,
+           object_id,
+           before_state,
+           after_state
+       )
+       VALUES (
+           'alarm_event_record',
+           NEW.alarm_event_record_id,
+           CASE WHEN TG_OP = 'UPDATE' THEN row_to_json(OLD) ELSE NULL END,
+           row_to_json(NEW)
+       );
+
+       -- Multiple identical payloads in same transaction collapse to one notification
+       -- So with one notification we are able to serially forward the call using high watermark in code
+       PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+           'batch_update', true
+       )::text);
+   END IF;
+
+   RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS alarm_event_history;
+
+ALTER TABLE alarm_event_record DROP COLUMN IF EXISTS alarm_acknowledged_by;
@@ -0,0 +1,70 @@
+-- Identity of the user who acknowledged the alarm, taken from the authenticated PATCH request
+ALTER TABLE alarm_event_record ADD COLUMN IF NOT EXISTS alarm_acknowledged_by TEXT NULL;
+
+-- Audit trail of the state transitions of each alarm event record.  A row is added by the alarm_event_after_trigger
+-- for every transition that results in a notification, i.e. NEW, CHANGE, CLEAR and ACKNOWLEDGE.
+CREATE TABLE IF NOT EXISTS alarm_event_history (
+    history_id UUID PRIMARY KEY DEFAULT gen_random_uuid(), -- Unique identifier for each transition
+    alarm_event_record_id UUID NOT NULL, -- Alarm event record that went through the transition
+    notification_event_type VARCHAR(20) NOT NULL, -- Type of transition (same as alarm_event_record.notification_event_type)
+    alarm_status VARCHAR(20) NOT NULL, -- Status of the alarm after the transition
+    previous_perceived_severity INT NULL, -- Severity before the transition. NULL when the alarm is first raised
+    perceived_severity INT NOT NULL, -- Severity after the transition
+    alarm_acknowledged_by TEXT NULL, -- User who acknowledged the alarm for ACKNOWLEDGE transitions
+    alarm_changed_time TIMESTAMPTZ, -- alarm_changed_time of the alarm after the transition
+    sequence_id BIGSERIAL, -- track insertion order rather than rely on timestamp since precision may cause ambiguity
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+
+    -- The history is retained, and archived, along with the alarm event record
+    CONSTRAINT fk_alarm_event_history_record FOREIGN KEY (alarm_event_record_id) REFERENCES alarm_event_record (alarm_event_record_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_alarm_event_history_record ON alarm_event_history (alarm_event_record_id, sequence_id);
+
+-- AFTER trigger function: Insert into outbox and history if should_create_data_change_event
+CREATE OR REPLACE FUNCTION manage_alarm_event_after()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.should_create_data_change_event THEN
+       INSERT INTO data_change_event (
+           object_type,
+           object_id,
+           before_state,
+           after_state
+       )
+       VALUES (
+           'alarm_event_record',
+           NEW.alarm_event_record_id,
+           CASE WHEN TG_OP = 'UPDATE' THEN row_to_json(OLD) ELSE NULL END,
+           row_to_json(NEW)
+       );
+
+       INSERT INTO alarm_event_history (
+           alarm_event_record_id,
+           notification_event_type,
+           alarm_status,
+           previous_perceived_severity,
+           perceived_severity,
+           alarm_acknowledged_by,
+           alarm_changed_time
+       )
+       VALUES (
+           NEW.alarm_event_record_id,
+           NEW.notification_event_type,
+           NEW.alarm_status,
+           CASE WHEN TG_OP = 'UPDATE' THEN OLD.perceived_severity ELSE NULL END,
+           NEW.perceived_severity,
+           CASE WHEN NEW.notification_event_type = 'ACKNOWLEDGE' THEN NEW.alarm_acknowledged_by ELSE NULL END,
+           NEW.alarm_changed_time
+       );
+
+       -- Multiple identical payloads in same transaction collapse to one notification
+       -- So with one notification we are able to serially forward the call using high watermark in code
+       PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+           'batch_update', true
+       )::text);
+   END IF;
+
+   RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,53 @@
+DROP FUNCTION IF EXISTS release_maintenance_window(UUID);
+
+-- Restore the AFTER trigger function without the maintenance windows
+CREATE OR REPLACE FUNCTION manage_alarm_event_after()
+RETURNS TRIGGER AS $$
+BEGIN
+   IF NEW.should_create_data_change_event THEN
+       INSERT INTO data_change_event (
+           object_type,
+           object_id,
+           before_state,
+           after_state
+       )
+       VALUES (
+           'alarm_event_record',
+           NEW.alarm_event_record_id,
+           CASE WHEN TG_OP = 'UPDATE' THEN row_to_json(OLD) ELSE NULL END,
+           row_to_json(NEW)
+       );
+
+       INSERT INTO alarm_event_history (
+           alarm_event_record_id,
+           notification_event_type,
+           alarm_status,
+           previous_perceived_severity,
+           perceived_severity,
+           alarm_acknowledged_by,
+           alarm_changed_time
+       )
+       VALUES (
+           NEW.alarm_event_record_id,
+           NEW.notification_event_type,
+           NEW.alarm_status,
+           CASE WHEN TG_OP = 'UPDATE' THEN OLD.perceived_severity ELSE NULL END,
+           NEW.perceived_severity,
+           CASE WHEN NEW.notification_event_type = 'ACKNOWLEDGE' THEN NEW.alarm_acknowledged_by ELSE NULL END,
+           NEW.alarm_changed_time
+       );
+
+       -- Multiple identical payloads in same transaction collapse to one notification
+       -- So with one notification we are able to serially forward the call using high watermark in code
+       PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+           'batch_update', true
+       )::text);
+   END IF;
+
+   RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+DROP TABLE IF EXISTS maintenance_window_suppression;
+
+DROP TABLE IF EXISTS maintenance_window;
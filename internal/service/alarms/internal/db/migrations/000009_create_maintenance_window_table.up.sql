@@ -0,0 +1,162 @@
+-- Maintenance windows hold back the notifications of the alarms raised by a node cluster during planned work such as
+-- an upgrade.  Alarms are still recorded, and a single notification per alarm is released once the window closes.
+CREATE TABLE IF NOT EXISTS maintenance_window (
+    maintenance_window_id UUID PRIMARY KEY DEFAULT gen_random_uuid(), -- Unique identifier for each window
+    node_cluster_id UUID NOT NULL, -- Node cluster covered by the window (same as alarm_event_record.object_id)
+    start_time TIMESTAMPTZ NOT NULL, -- Time at which notifications start being held back
+    end_time TIMESTAMPTZ NULL, -- Time at which the window closes. NULL while the upgrade of a ProvisioningRequest is in progress
+    alarm_definition_ids UUID[] NULL, -- Alarm definitions covered by the window. NULL means all the alarms of the node cluster
+    description TEXT NULL, -- Free text set by the client
+    provisioning_request TEXT NULL, -- Name of the ProvisioningRequest whose upgrade opened the window
+    suppressed_alarms INTEGER NOT NULL DEFAULT 0, -- Number of alarms for which notifications were held back
+    released_notifications INTEGER NOT NULL DEFAULT 0, -- Number of notifications released when the window closed
+    summary_sent_at TIMESTAMPTZ NULL, -- Time at which the held notifications were released
+
+    created_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP, -- Record creation timestamp
+
+    CONSTRAINT chk_maintenance_window_time_range CHECK (end_time IS NULL OR end_time > start_time)
+);
+
+CREATE INDEX IF NOT EXISTS idx_maintenance_window_node_cluster ON maintenance_window (node_cluster_id) WHERE summary_sent_at IS NULL;
+
+-- A single window is opened for each upgrade of a ProvisioningRequest
+CREATE UNIQUE INDEX IF NOT EXISTS idx_maintenance_window_provisioning_request ON maintenance_window (provisioning_request) WHERE end_time IS NULL;
+
+-- Alarms for which notifications were held back by a maintenance window
+CREATE TABLE IF NOT EXISTS maintenance_window_suppression (
+    maintenance_window_id UUID NOT NULL, -- Window that held back the notifications
+    alarm_event_record_id UUID NOT NULL, -- Alarm whose notifications were held back
+    raised_during_window BOOLEAN NOT NULL, -- The NEW notification of the alarm was held back
+    suppressed_count INTEGER NOT NULL DEFAULT 1, -- Number of notifications held back
+    first_suppressed_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    last_suppressed_at TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+
+    PRIMARY KEY (maintenance_window_id, alarm_event_record_id),
+    CONSTRAINT fk_maintenance_window_suppression_window FOREIGN KEY (maintenance_window_id) REFERENCES maintenance_window (maintenance_window_id) ON DELETE CASCADE,
+    CONSTRAINT fk_maintenance_window_suppression_record FOREIGN KEY (alarm_event_record_id) REFERENCES alarm_event_record (alarm_event_record_id) ON DELETE CASCADE
+);
+
+-- AFTER trigger function: Insert into history, and into outbox unless the notification is held back by an active
+-- maintenance window.
+CREATE OR REPLACE FUNCTION manage_alarm_event_after()
+RETURNS TRIGGER AS $$
+DECLARE
+    window_id UUID;
+BEGIN
+   IF NEW.should_create_data_change_event THEN
+       INSERT INTO alarm_event_history (
+           alarm_event_record_id,
+           notification_event_type,
+           alarm_status,
+           previous_perceived_severity,
+           perceived_severity,
+           alarm_acknowledged_by,
+           alarm_changed_time
+       )
+       VALUES (
+           NEW.alarm_event_record_id,
+           NEW.notification_event_type,
+           NEW.alarm_status,
+           CASE WHEN TG_OP = 'UPDATE' THEN OLD.perceived_severity ELSE NULL END,
+           NEW.perceived_severity,
+           CASE WHEN NEW.notification_event_type = 'ACKNOWLEDGE' THEN NEW.alarm_acknowledged_by ELSE NULL END,
+           NEW.alarm_changed_time
+       );
+
+       SELECT maintenance_window_id INTO window_id
+       FROM maintenance_window
+       WHERE node_cluster_id = NEW.object_id
+         AND summary_sent_at IS NULL
+         AND start_time <= now()
+         AND (end_time IS NULL OR end_time > now())
+         AND (alarm_definition_ids IS NULL OR NEW.alarm_definition_id = ANY (alarm_definition_ids))
+       ORDER BY start_time
+       LIMIT 1;
+
+       IF window_id IS NOT NULL THEN
+           INSERT INTO maintenance_window_suppression (maintenance_window_id, alarm_event_record_id, raised_during_window)
+           VALUES (window_id, NEW.alarm_event_record_id, NEW.notification_event_type = 'NEW')
+           ON CONFLICT (maintenance_window_id, alarm_event_record_id) DO UPDATE
+           SET suppressed_count = maintenance_window_suppression.suppressed_count + 1,
+               last_suppressed_at = CURRENT_TIMESTAMP;
+
+           RETURN NEW;
+       END IF;
+
+       INSERT INTO data_change_event (
+           object_type,
+           object_id,
+           before_state,
+           after_state
+       )
+       VALUES (
+           'alarm_event_record',
+           NEW.alarm_event_record_id,
+           CASE WHEN TG_OP = 'UPDATE' THEN row_to_json(OLD) ELSE NULL END,
+           row_to_json(NEW)
+       );
+
+       -- Multiple identical payloads in same transaction collapse to one notification
+       -- So with one notification we are able to serially forward the call using high watermark in code
+       PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+           'batch_update', true
+       )::text);
+   END IF;
+
+   RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+/*
+Releases the notifications held back by a maintenance window.
+
+A single notification is queued in the outbox for each alarm with its current state.  Alarms that were raised and
+cleared while the window was active are not notified since the subscribers never saw them.  Alarms raised during the
+window are notified as NEW whatever the last transition.  Each notification carries the maintenanceWindowId extension
+so that subscribers can correlate them.
+
+The window is returned with its summary, or nothing if it was already released.
+*/
+CREATE OR REPLACE FUNCTION release_maintenance_window(window_id UUID)
+RETURNS SETOF maintenance_window AS $$
+DECLARE
+    released INTEGER;
+BEGIN
+    -- Lock the window so that concurrent releases queue a single set of notifications
+    PERFORM 1 FROM maintenance_window WHERE maintenance_window_id = window_id AND summary_sent_at IS NULL FOR UPDATE;
+    IF NOT FOUND THEN
+        RETURN;
+    END IF;
+
+    INSERT INTO data_change_event (object_type, object_id, before_state, after_state)
+    SELECT 'alarm_event_record',
+           r.alarm_event_record_id,
+           NULL,
+           (to_jsonb(r)
+               || CASE WHEN s.raised_during_window THEN jsonb_build_object('notification_event_type', 'NEW') ELSE '{}'::jsonb END
+               || jsonb_build_object('extensions', COALESCE(r.extensions, '{}'::jsonb) || jsonb_build_object('maintenanceWindowId', window_id::text))
+           )::json
+    FROM maintenance_window_suppression s
+    JOIN alarm_event_record r ON r.alarm_event_record_id = s.alarm_event_record_id
+    WHERE s.maintenance_window_id = window_id
+      AND NOT (s.raised_during_window AND r.alarm_status = 'resolved')
+    ORDER BY s.first_suppressed_at;
+
+    GET DIAGNOSTICS released = ROW_COUNT;
+
+    IF released > 0 THEN
+        PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+            'batch_update', true
+        )::text);
+    END IF;
+
+    RETURN QUERY
+    UPDATE maintenance_window
+    SET summary_sent_at = CURRENT_TIMESTAMP,
+        end_time = CASE WHEN end_time IS NULL OR end_time > CURRENT_TIMESTAMP THEN GREATEST(CURRENT_TIMESTAMP, start_time + interval '1 microsecond') ELSE end_time END,
+        suppressed_alarms = (SELECT count(*) FROM maintenance_window_suppression WHERE maintenance_window_id = window_id),
+        released_notifications = released
+    WHERE maintenance_window_id = window_id
+    RETURNING *;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1,15 @@
+DROP TRIGGER IF EXISTS correlate_alarm_event_after ON alarm_event_record;
+DROP FUNCTION IF EXISTS correlate_alarm_event_after();
+DROP TRIGGER IF EXISTS correlate_alarm_event ON alarm_event_record;
+DROP FUNCTION IF EXISTS correlate_alarm_event();
+DROP FUNCTION IF EXISTS is_alarm_event_correlated(JSONB, alarm_event_record, alarm_event_record);
+
+ALTER TABLE alarm_subscription_info DROP CONSTRAINT IF EXISTS chk_correlation;
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS correlation;
+
+DROP INDEX IF EXISTS idx_alarm_event_record_firing_object;
+DROP INDEX IF EXISTS idx_alarm_event_record_correlation_parent;
+ALTER TABLE alarm_event_record DROP CONSTRAINT IF EXISTS fk_alarm_event_record_correlation_parent;
+ALTER TABLE alarm_event_record DROP COLUMN IF EXISTS correlation_parent_id;
+
+ALTER TABLE alarm_service_configuration DROP COLUMN IF EXISTS correlation_rules;
@@ -0,0 +1,110 @@
+-- Rules grouping the alarms caused by a same root cause.  Each element is an AlarmCorrelationRule object as exposed by
+-- the alarm service configuration API.  NULL or an empty array disables the correlation.
+ALTER TABLE alarm_service_configuration ADD COLUMN IF NOT EXISTS correlation_rules JSONB NULL;
+
+-- Root cause alarm the alarm was correlated to
+ALTER TABLE alarm_event_record ADD COLUMN IF NOT EXISTS correlation_parent_id UUID NULL;
+ALTER TABLE alarm_event_record ADD CONSTRAINT fk_alarm_event_record_correlation_parent
+    FOREIGN KEY (correlation_parent_id) REFERENCES alarm_event_record (alarm_event_record_id) ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS idx_alarm_event_record_correlation_parent ON alarm_event_record (correlation_parent_id) WHERE correlation_parent_id IS NOT NULL;
+CREATE INDEX IF NOT EXISTS idx_alarm_event_record_firing_object ON alarm_event_record (object_id) WHERE alarm_status = 'firing';
+
+-- Subscribers receiving the notifications of the root cause alarms only
+ALTER TABLE alarm_subscription_info ADD COLUMN IF NOT EXISTS correlation VARCHAR(20) NULL; -- Can be ['RAW', 'CORRELATED'], NULL means RAW
+ALTER TABLE alarm_subscription_info ADD CONSTRAINT chk_correlation CHECK (correlation IN ('RAW', 'CORRELATED'));
+
+/*
+Returns true if a firing alarm is correlated to a root cause alarm by a rule:
+- both alarms are raised by the same object
+- the name of the root cause alarm is the rule rootCause
+- the name of the alarm is in the rule correlatedAlarms, or the list is empty
+- both alarms were raised within the rule window (in seconds, 300 by default)
+- both alarms have the same value for each of the rule matchExtensions
+*/
+CREATE OR REPLACE FUNCTION is_alarm_event_correlated(rule JSONB, alarm alarm_event_record, root_cause alarm_event_record)
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN alarm.alarm_event_record_id <> root_cause.alarm_event_record_id
+        AND alarm.object_id = root_cause.object_id
+        AND root_cause.extensions->>'alertname' = rule->>'rootCause'
+        AND alarm.extensions->>'alertname' IS DISTINCT FROM rule->>'rootCause'
+        AND (jsonb_array_length(COALESCE(rule->'correlatedAlarms', '[]'::jsonb)) = 0
+             OR COALESCE(rule->'correlatedAlarms' ? (alarm.extensions->>'alertname'), FALSE))
+        AND abs(extract(epoch FROM alarm.alarm_raised_time - root_cause.alarm_raised_time)) <= COALESCE((rule->>'window')::int, 300)
+        AND NOT EXISTS (
+            SELECT 1 FROM jsonb_array_elements_text(COALESCE(rule->'matchExtensions', '[]'::jsonb)) AS k(key)
+            WHERE alarm.extensions->>k.key IS DISTINCT FROM root_cause.extensions->>k.key
+        );
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+-- BEFORE trigger function: Correlate a firing alarm to the oldest firing root cause alarm matching one of the rules.
+-- The correlation is set before the outbox entry is created so that the notification carries it.
+CREATE OR REPLACE FUNCTION correlate_alarm_event()
+RETURNS TRIGGER AS $$
+DECLARE
+    rule JSONB;
+    parent_id UUID;
+BEGIN
+    IF NEW.alarm_status <> 'firing' OR NEW.correlation_parent_id IS NOT NULL OR NEW.object_id IS NULL THEN
+        RETURN NEW;
+    END IF;
+
+    FOR rule IN SELECT jsonb_array_elements(correlation_rules) FROM alarm_service_configuration WHERE jsonb_typeof(correlation_rules) = 'array' LOOP
+        SELECT p.alarm_event_record_id INTO parent_id
+        FROM alarm_event_record p
+        WHERE p.object_id = NEW.object_id
+          AND p.alarm_status = 'firing'
+          AND is_alarm_event_correlated(rule, NEW, p)
+        ORDER BY p.alarm_raised_time
+        LIMIT 1;
+
+        IF parent_id IS NOT NULL THEN
+            NEW.correlation_parent_id := parent_id;
+            RETURN NEW;
+        END IF;
+    END LOOP;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER correlate_alarm_event
+    BEFORE INSERT OR UPDATE
+    ON alarm_event_record
+    FOR EACH ROW
+    EXECUTE FUNCTION correlate_alarm_event();
+
+-- AFTER trigger function: Correlate the firing alarms raised before their root cause alarm.  Their notifications were
+-- already sent, so the correlation is set without creating outbox entries.
+CREATE OR REPLACE FUNCTION correlate_alarm_event_after()
+RETURNS TRIGGER AS $$
+DECLARE
+    rule JSONB;
+BEGIN
+    IF NEW.alarm_status <> 'firing' OR NEW.object_id IS NULL THEN
+        RETURN NEW;
+    END IF;
+    IF TG_OP = 'UPDATE' AND OLD.alarm_status = NEW.alarm_status THEN
+        RETURN NEW;
+    END IF;
+
+    FOR rule IN SELECT jsonb_array_elements(correlation_rules) FROM alarm_service_configuration WHERE jsonb_typeof(correlation_rules) = 'array' LOOP
+        UPDATE alarm_event_record c
+        SET correlation_parent_id = NEW.alarm_event_record_id
+        WHERE c.object_id = NEW.object_id
+          AND c.alarm_status = 'firing'
+          AND c.correlation_parent_id IS NULL
+          AND is_alarm_event_correlated(rule, c, NEW);
+    END LOOP;
+
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER correlate_alarm_event_after
+    AFTER INSERT OR UPDATE
+    ON alarm_event_record
+    FOR EACH ROW
+    EXECUTE FUNCTION correlate_alarm_event_after();
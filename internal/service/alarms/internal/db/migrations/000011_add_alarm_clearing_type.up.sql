@@ -0,0 +1,80 @@
+-- Clearing type of the alarm definition of the alarm.  MANUAL alarms are cleared by an operator through the API since
+-- their source may never report them as resolved.
+ALTER TABLE alarm_event_record ADD COLUMN IF NOT EXISTS clearing_type VARCHAR(20) NOT NULL DEFAULT 'AUTOMATIC'; -- Can be ['AUTOMATIC', 'MANUAL']
+ALTER TABLE alarm_event_record ADD CONSTRAINT chk_clearing_type CHECK (clearing_type IN ('AUTOMATIC', 'MANUAL'));
+
+-- Identity of the user who cleared the alarm, taken from the authenticated PATCH request
+ALTER TABLE alarm_event_record ADD COLUMN IF NOT EXISTS alarm_cleared_by TEXT NULL;
+ALTER TABLE alarm_event_history ADD COLUMN IF NOT EXISTS alarm_cleared_by TEXT NULL; -- User who cleared the alarm for CLEAR transitions
+
+-- AFTER trigger function: Same as before, but the history also records who cleared the alarm
+CREATE OR REPLACE FUNCTION manage_alarm_event_after()
+RETURNS TRIGGER AS $$
+DECLARE
+    window_id UUID;
+BEGIN
+   IF NEW.should_create_data_change_event THEN
+       INSERT INTO alarm_event_history (
+           alarm_event_record_id,
+           notification_event_type,
+           alarm_status,
+           previous_perceived_severity,
+           perceived_severity,
+           alarm_acknowledged_by,
+           alarm_cleared_by,
+           alarm_changed_time
+       )
+       VALUES (
+           NEW.alarm_event_record_id,
+           NEW.notification_event_type,
+           NEW.alarm_status,
+           CASE WHEN TG_OP = 'UPDATE' THEN OLD.perceived_severity ELSE NULL END,
+           NEW.perceived_severity,
+           CASE WHEN NEW.notification_event_type = 'ACKNOWLEDGE' THEN NEW.alarm_acknowledged_by ELSE NULL END,
+           CASE WHEN NEW.notification_event_type = 'CLEAR' THEN NEW.alarm_cleared_by ELSE NULL END,
+           NEW.alarm_changed_time
+       );
+
+       SELECT maintenance_window_id INTO window_id
+       FROM maintenance_window
+       WHERE node_cluster_id = NEW.object_id
+         AND summary_sent_at IS NULL
+         AND start_time <= now()
+         AND (end_time IS NULL OR end_time > now())
+         AND (alarm_definition_ids IS NULL OR NEW.alarm_definition_id = ANY (alarm_definition_ids))
+       ORDER BY start_time
+       LIMIT 1;
+
+       IF window_id IS NOT NULL THEN
+           INSERT INTO maintenance_window_suppression (maintenance_window_id, alarm_event_record_id, raised_during_window)
+           VALUES (window_id, NEW.alarm_event_record_id, NEW.notification_event_type = 'NEW')
+           ON CONFLICT (maintenance_window_id, alarm_event_record_id) DO UPDATE
+           SET suppressed_count = maintenance_window_suppression.suppressed_count + 1,
+               last_suppressed_at = CURRENT_TIMESTAMP;
+
+           RETURN NEW;
+       END IF;
+
+       INSERT INTO data_change_event (
+           object_type,
+           object_id,
+           before_state,
+           after_state
+       )
+       VALUES (
+           'alarm_event_record',
+           NEW.alarm_event_record_id,
+           CASE WHEN TG_OP = 'UPDATE' THEN row_to_json(OLD) ELSE NULL END,
+           row_to_json(NEW)
+       );
+
+       -- Multiple identical payloads in same transaction collapse to one notification
+       -- So with one notification we are able to serially forward the call using high watermark in code
+       PERFORM pg_notify('alarm_event_record_outbox_queued', json_build_object(
+           'batch_update', true
+       )::text);
+   END IF;
+
+   RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
@@ -0,0 +1 @@
+ALTER TABLE alarm_subscription_info DROP COLUMN IF EXISTS selector;
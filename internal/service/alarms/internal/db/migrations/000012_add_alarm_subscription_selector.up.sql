@@ -0,0 +1,2 @@
+-- Filter expression, in the same syntax as the 'filter' query parameter, selecting the alarms notified to a subscriber
+ALTER TABLE alarm_subscription_info ADD COLUMN IF NOT EXISTS selector TEXT NULL;
@@ -0,0 +1,19 @@
+-- Restore the correlation on the alertname extension only
+CREATE OR REPLACE FUNCTION is_alarm_event_correlated(rule JSONB, alarm alarm_event_record, root_cause alarm_event_record)
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN alarm.alarm_event_record_id <> root_cause.alarm_event_record_id
+        AND alarm.object_id = root_cause.object_id
+        AND root_cause.extensions->>'alertname' = rule->>'rootCause'
+        AND alarm.extensions->>'alertname' IS DISTINCT FROM rule->>'rootCause'
+        AND (jsonb_array_length(COALESCE(rule->'correlatedAlarms', '[]'::jsonb)) = 0
+             OR COALESCE(rule->'correlatedAlarms' ? (alarm.extensions->>'alertname'), FALSE))
+        AND abs(extract(epoch FROM alarm.alarm_raised_time - root_cause.alarm_raised_time)) <= COALESCE((rule->>'window')::int, 300)
+        AND NOT EXISTS (
+            SELECT 1 FROM jsonb_array_elements_text(COALESCE(rule->'matchExtensions', '[]'::jsonb)) AS k(key)
+            WHERE alarm.extensions->>k.key IS DISTINCT FROM root_cause.extensions->>k.key
+        );
+END;
+$$ LANGUAGE plpgsql STABLE;
+
+DROP FUNCTION IF EXISTS is_alarm_event_named(alarm_event_record, TEXT);
@@ -0,0 +1,37 @@
+-- Returns true if the alarm is identified by the given name of a correlation rule, which is either the alertname
+-- extension of a CaaS alarm or the alarm definition ID of an alarm of any source, e.g., a hardware alarm
+CREATE OR REPLACE FUNCTION is_alarm_event_named(alarm alarm_event_record, name TEXT)
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN COALESCE(alarm.extensions->>'alertname' = name, FALSE)
+        OR COALESCE(alarm.alarm_definition_id::text = lower(name), FALSE);
+END;
+$$ LANGUAGE plpgsql IMMUTABLE;
+
+/*
+Returns true if a firing alarm is correlated to a root cause alarm by a rule:
+- both alarms are raised by the same object
+- the root cause alarm is identified by the rule rootCause
+- the alarm is identified by one of the rule correlatedAlarms, or the list is empty
+- both alarms were raised within the rule window (in seconds, 300 by default)
+- both alarms have the same value for each of the rule matchExtensions
+*/
+CREATE OR REPLACE FUNCTION is_alarm_event_correlated(rule JSONB, alarm alarm_event_record, root_cause alarm_event_record)
+RETURNS BOOLEAN AS $$
+BEGIN
+    RETURN alarm.alarm_event_record_id <> root_cause.alarm_event_record_id
+        AND alarm.object_id = root_cause.object_id
+        AND is_alarm_event_named(root_cause, rule->>'rootCause')
+        AND NOT is_alarm_event_named(alarm, rule->>'rootCause')
+        AND (jsonb_array_length(COALESCE(rule->'correlatedAlarms', '[]'::jsonb)) = 0
+             OR EXISTS (
+                 SELECT 1 FROM jsonb_array_elements_text(rule->'correlatedAlarms') AS c(name)
+                 WHERE is_alarm_event_named(alarm, c.name)
+             ))
+        AND abs(extract(epoch FROM alarm.alarm_raised_time - root_cause.alarm_raised_time)) <= COALESCE((rule->>'window')::int, 300)
+        AND NOT EXISTS (
+            SELECT 1 FROM jsonb_array_elements_text(COALESCE(rule->'matchExtensions', '[]'::jsonb)) AS k(key)
+            WHERE alarm.extensions->>k.key IS DISTINCT FROM root_cause.extensions->>k.key
+        );
+END;
+$$ LANGUAGE plpgsql STABLE;
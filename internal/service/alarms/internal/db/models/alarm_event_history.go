@@ -0,0 +1,46 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+)
+
+// AlarmEventHistory represents a record in the alarm_event_history table.  Records are added by the database
+// trigger for each state transition of an alarm event record.
+type AlarmEventHistory struct {
+	HistoryID                 uuid.UUID                             `db:"history_id"`
+	AlarmEventRecordID        uuid.UUID                             `db:"alarm_event_record_id"`
+	NotificationEventType     generated.AlarmSubscriptionInfoFilter `db:"notification_event_type"`
+	AlarmStatus               string                                `db:"alarm_status"`
+	PreviousPerceivedSeverity *generated.PerceivedSeverity          `db:"previous_perceived_severity"`
+	PerceivedSeverity         generated.PerceivedSeverity           `db:"perceived_severity"`
+	AlarmAcknowledgedBy       *string                               `db:"alarm_acknowledged_by"`
+	AlarmClearedBy            *string                               `db:"alarm_cleared_by"`
+	AlarmChangedTime          *time.Time                            `db:"alarm_changed_time"`
+	SequenceID                int64                                 `db:"sequence_id"`
+	CreatedAt                 time.Time                             `db:"created_at"`
+}
+
+// TableName returns the name of the table in the database
+func (r AlarmEventHistory) TableName() string {
+	return "alarm_event_history"
+}
+
+// PrimaryKey returns the primary key of the table
+func (r AlarmEventHistory) PrimaryKey() string {
+	return "history_id"
+}
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r AlarmEventHistory) OnConflict() string {
+	return ""
+}
@@ -7,12 +7,34 @@ SPDX-License-Identifier: Apache-2.0
 package models
 
 import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 )
 
+// Values of the alarm_source column
+const (
+	AlarmSourceAlertmanager = "alertmanager"
+	AlarmSourceHardware     = "hardware"
+)
+
+// HwPluginNameExtension is the extension key holding the name of the hardware plugin that reported a hardware alarm
+const HwPluginNameExtension = "hwPluginName"
+
+// Extension keys exposing the correlation of the alarms caused by a same root cause
+const (
+	// ParentAlarmEventRecordIDExtension holds the identifier of the root cause alarm of a correlated alarm
+	ParentAlarmEventRecordIDExtension = "parentAlarmEventRecordId"
+	// ChildAlarmEventRecordIDsExtension holds the comma separated identifiers of the alarms correlated to a root cause
+	// alarm
+	ChildAlarmEventRecordIDsExtension = "childAlarmEventRecordIds"
+)
+
 // AlarmEventRecord represents a record in the alarm_event_record table.
 type AlarmEventRecord struct {
 	AlarmEventRecordID    uuid.UUID                             `db:"alarm_event_record_id" json:"alarm_event_record_id"`
@@ -23,6 +45,8 @@ type AlarmEventRecord struct {
 	AlarmClearedTime      *time.Time                            `db:"alarm_cleared_time" json:"alarm_cleared_time,omitempty"`
 	AlarmAcknowledgedTime *time.Time                            `db:"alarm_acknowledged_time" json:"alarm_acknowledged_time,omitempty"`
 	AlarmAcknowledged     bool                                  `db:"alarm_acknowledged" json:"alarm_acknowledged"`
+	AlarmAcknowledgedBy   *string                               `db:"alarm_acknowledged_by" json:"alarm_acknowledged_by,omitempty"`
+	AlarmClearedBy        *string                               `db:"alarm_cleared_by" json:"alarm_cleared_by,omitempty"`
 	PerceivedSeverity     generated.PerceivedSeverity           `db:"perceived_severity" json:"perceived_severity"`
 	Extensions            map[string]string                     `db:"extensions" json:"extensions"`
 	ObjectID              *uuid.UUID                            `db:"object_id" json:"object_id,omitempty"`           // nullable since ACM may not provide the cluster ID. please manually track them and let ACM know about this.
@@ -32,6 +56,8 @@ type AlarmEventRecord struct {
 	Fingerprint           string                                `db:"fingerprint" json:"fingerprint"`
 	GenerationID          int                                   `db:"generation_id" json:"generation_id"`
 	AlarmSource           string                                `db:"alarm_source" json:"alarm_source"`
+	CorrelationParentID   *uuid.UUID                            `db:"correlation_parent_id" json:"correlation_parent_id,omitempty"` // root cause alarm set by the correlation rules
+	ClearingType          string                                `db:"clearing_type" json:"clearing_type"`                           // clearing type of the alarm definition, MANUAL alarms can be cleared through the API
 }
 
 // TableName returns the name of the table in the database
@@ -48,3 +74,63 @@ func (r AlarmEventRecord) PrimaryKey() string {
 func (r AlarmEventRecord) OnConflict() string {
 	return "unique_fingerprint_alarm_raised_time"
 }
+
+// alarmEventRecordSearchColumns maps the attributes of the API object to the columns storing their values
+var alarmEventRecordSearchColumns = map[string]search.Column{
+	"alarmEventRecordId":    {Name: "alarm_event_record_id", Type: search.ColumnTypeUUID},
+	"alarmDefinitionID":     {Name: "alarm_definition_id", Type: search.ColumnTypeUUID},
+	"probableCauseID":       {Name: "probable_cause_id", Type: search.ColumnTypeUUID},
+	"resourceTypeID":        {Name: "object_type_id", Type: search.ColumnTypeUUID},
+	"resourceID":            {Name: "object_id", Type: search.ColumnTypeUUID},
+	"alarmRaisedTime":       {Name: "alarm_raised_time", Type: search.ColumnTypeTime},
+	"alarmChangedTime":      {Name: "alarm_changed_time", Type: search.ColumnTypeTime},
+	"alarmClearedTime":      {Name: "alarm_cleared_time", Type: search.ColumnTypeTime},
+	"alarmAcknowledgedTime": {Name: "alarm_acknowledged_time", Type: search.ColumnTypeTime},
+	"alarmAcknowledged":     {Name: "alarm_acknowledged", Type: search.ColumnTypeBool},
+	"perceivedSeverity":     {Name: "perceived_severity", Type: search.ColumnTypeInt},
+	"extensions":            {Name: "extensions", Type: search.ColumnTypeJSON},
+}
+
+// SearchColumns returns the columns that can be used to filter the API objects in the database
+func (r AlarmEventRecord) SearchColumns() map[string]search.Column {
+	return alarmEventRecordSearchColumns
+}
+
+// AlarmEventRecordMarker identifies the last AlarmEventRecord of a page.  Alarms are paged from the most recently
+// raised so the next page starts with the records sorted after the marker.
+type AlarmEventRecordMarker struct {
+	AlarmRaisedTime    time.Time `json:"t"`
+	AlarmEventRecordID uuid.UUID `json:"id"`
+}
+
+// NewAlarmEventRecordMarker creates the marker pointing at the given record
+func NewAlarmEventRecordMarker(record AlarmEventRecord) AlarmEventRecordMarker {
+	return AlarmEventRecordMarker{
+		AlarmRaisedTime:    record.AlarmRaisedTime,
+		AlarmEventRecordID: record.AlarmEventRecordID,
+	}
+}
+
+// Encode renders the marker as the opaque value exposed to API clients
+func (m AlarmEventRecordMarker) Encode() string {
+	data, _ := json.Marshal(m) // nolint: errcheck
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodeAlarmEventRecordMarker parses an opaque value previously returned by Encode
+func DecodeAlarmEventRecordMarker(value string) (*AlarmEventRecordMarker, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode marker: %w", err)
+	}
+
+	var marker AlarmEventRecordMarker
+	if err := json.Unmarshal(data, &marker); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal marker: %w", err)
+	}
+	if marker.AlarmRaisedTime.IsZero() || marker.AlarmEventRecordID == uuid.Nil {
+		return nil, fmt.Errorf("marker is incomplete")
+	}
+
+	return &marker, nil
+}
@@ -0,0 +1,36 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AlarmEventRecordArchive represents the alarm_event_record_archive table in the database
+type AlarmEventRecordArchive struct {
+	AlarmEventRecordID uuid.UUID              `db:"alarm_event_record_id"`
+	AlarmClearedTime   *time.Time             `db:"alarm_cleared_time"`
+	Record             map[string]interface{} `db:"record"`
+	ArchivedAt         time.Time              `db:"archived_at"`
+}
+
+// TableName returns the name of the table in the database
+func (r AlarmEventRecordArchive) TableName() string {
+	return "alarm_event_record_archive"
+}
+
+// PrimaryKey returns the primary key of the table
+func (r AlarmEventRecordArchive) PrimaryKey() string {
+	return "alarm_event_record_id"
+}
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r AlarmEventRecordArchive) OnConflict() string {
+	return ""
+}
@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 )
 
 // ServiceConfiguration represents the alarm_service_configuration table in the database
@@ -17,6 +19,8 @@ type ServiceConfiguration struct {
 	ID              uuid.UUID         `db:"id"`
 	RetentionPeriod int               `db:"retention_period"`
 	Extensions      map[string]string `db:"extensions"`
+	// CorrelationRules are evaluated by the alarm_event_record triggers to correlate alarms to their root cause
+	CorrelationRules []generated.AlarmCorrelationRule `db:"correlation_rules"`
 
 	CreatedAt time.Time `db:"created_at"`
 	UpdatedAt time.Time `db:"updated_at"`
@@ -9,6 +9,7 @@ package models
 import (
 	"time"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 
 	"github.com/google/uuid"
@@ -20,10 +21,22 @@ type AlarmSubscription struct {
 	ConsumerSubscriptionID *uuid.UUID                             `db:"consumer_subscription_id"`
 	Filter                 *generated.AlarmSubscriptionInfoFilter `db:"filter"`
 	Callback               string                                 `db:"callback"`
+	// Correlation selects whether the alarms correlated to a root cause alarm are notified.  Nil means RAW.
+	Correlation *generated.AlarmSubscriptionInfoCorrelation `db:"correlation"`
+	// Selector is a filter expression selecting the alarms notified to the subscriber.  Nil means all alarms.
+	Selector *string `db:"selector"`
 
-	EventCursor int64     `db:"event_cursor"`
-	CreatedAt   time.Time `db:"created_at"`
-	UpdatedAt   time.Time `db:"updated_at"`
+	EventCursor int64 `db:"event_cursor"`
+	// SuspendedUntil is set while deliveries are suspended following a notification that could not be delivered.
+	SuspendedUntil *time.Time `db:"suspended_until"`
+	// SigningSecret is the shared secret used to sign notifications.  Deliveries are not signed if it is not set.  The
+	// secrets are excluded from JSON so that they are never leaked by logs.
+	SigningSecret *string `db:"signing_secret" json:"-"`
+	// PreviousSigningSecret is the secret replaced by the last rotation.  It is used until PreviousSigningSecretExpiry.
+	PreviousSigningSecret       *string    `db:"previous_signing_secret" json:"-"`
+	PreviousSigningSecretExpiry *time.Time `db:"previous_signing_secret_expiry"`
+	CreatedAt                   time.Time  `db:"created_at"`
+	UpdatedAt                   time.Time  `db:"updated_at"`
 }
 
 // TableName returns the name of the table in the database
@@ -40,3 +53,13 @@ func (r AlarmSubscription) PrimaryKey() string {
 func (r AlarmSubscription) OnConflict() string {
 	return ""
 }
+
+// SubscriptionCriteria holds the alarm specific criteria of a subscription evaluated when matching notifications
+type SubscriptionCriteria struct {
+	// CorrelatedOnly excludes the alarms correlated to a root cause alarm
+	CorrelatedOnly bool
+	// Selector restricts the notified alarms to those matching the selector of the subscription
+	Selector *search.Selector
+	// InvalidSelector is set if the stored selector could not be parsed, in which case no alarm is notified
+	InvalidSelector bool
+}
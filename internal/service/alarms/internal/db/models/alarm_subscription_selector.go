@@ -0,0 +1,106 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+)
+
+// Attributes of an alarm that can be used in the selector of an alarm subscription
+const (
+	SelectorPerceivedSeverity = "perceivedSeverity"
+	SelectorResourceTypeID    = "resourceTypeID"
+	SelectorResourceID        = "resourceID"
+	SelectorNodeClusterID     = "nodeClusterID"
+	SelectorAlarmDefinitionID = "alarmDefinitionID"
+	SelectorProbableCauseID   = "probableCauseID"
+	// SelectorExtensions is the prefix of the paths selecting an extension, e.g. extensions/namespace
+	SelectorExtensions = "extensions"
+)
+
+var selectorAttributes = []string{
+	SelectorPerceivedSeverity,
+	SelectorResourceTypeID,
+	SelectorResourceID,
+	SelectorNodeClusterID,
+	SelectorAlarmDefinitionID,
+	SelectorProbableCauseID,
+}
+
+// ParseAlarmSubscriptionSelector parses the selector of an alarm subscription and checks that it only refers to the
+// attributes of an alarm supported by ConvertNotificationToSelectorObject.
+func ParseAlarmSubscriptionSelector(text string) (*search.Selector, error) {
+	selector, err := search.ParseSelector(slog.Default(), text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid selector: %w", err)
+	}
+
+	for _, term := range selector.Terms {
+		if err := validateSelectorTerm(term); err != nil {
+			return nil, err
+		}
+	}
+	return selector, nil
+}
+
+// validateSelectorTerm checks that a term refers to a supported attribute and, for the perceived severity, that the
+// values are integers so that the evaluation cannot fail when notifications are matched.
+func validateSelectorTerm(term *search.Term) error {
+	path := strings.Join(term.Path, "/")
+	switch {
+	case len(term.Path) == 2 && term.Path[0] == SelectorExtensions:
+		return nil
+	case len(term.Path) != 1:
+		return fmt.Errorf("invalid attribute '%s' in selector, supported attributes are %s and %s/<key>",
+			path, strings.Join(selectorAttributes, ", "), SelectorExtensions)
+	case term.Path[0] == SelectorPerceivedSeverity:
+		for _, value := range term.Values {
+			if _, err := strconv.Atoi(fmt.Sprint(value)); err != nil {
+				return fmt.Errorf("invalid value '%v' for attribute '%s' in selector, it must be an integer", value, path)
+			}
+		}
+		return nil
+	}
+
+	for _, attribute := range selectorAttributes {
+		if term.Path[0] == attribute {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid attribute '%s' in selector, supported attributes are %s and %s/<key>",
+		path, strings.Join(selectorAttributes, ", "), SelectorExtensions)
+}
+
+// ConvertNotificationToSelectorObject returns the attributes of an alarm notification against which the selector of
+// a subscription is evaluated.  The values use plain types as required by the selector evaluator.  The node cluster
+// ID is only set for the alarms raised by node clusters, whose resource is the node cluster itself.
+func ConvertNotificationToSelectorObject(notification *api.AlarmEventNotification) map[string]any {
+	extensions := make(map[string]any, len(notification.Extensions))
+	for key, value := range notification.Extensions {
+		extensions[key] = value
+	}
+
+	object := map[string]any{
+		SelectorPerceivedSeverity: int(notification.PerceivedSeverity),
+		SelectorResourceTypeID:    notification.ResourceTypeID.String(),
+		SelectorResourceID:        notification.ResourceID.String(),
+		SelectorAlarmDefinitionID: notification.AlarmDefinitionID.String(),
+		SelectorProbableCauseID:   notification.ProbableCauseID.String(),
+		SelectorExtensions:        extensions,
+	}
+
+	if _, hardware := notification.Extensions[HwPluginNameExtension]; !hardware {
+		object[SelectorNodeClusterID] = notification.ResourceID.String()
+	}
+	return object
+}
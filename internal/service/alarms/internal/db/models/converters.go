@@ -9,6 +9,10 @@ package models
 import (
 	"encoding/json"
 	"fmt"
+	"log/slog"
+	"maps"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -29,7 +33,7 @@ func ConvertAlarmEventRecordModelToApi(aerModel AlarmEventRecord) api.AlarmEvent
 		AlarmEventRecordId:    aerModel.AlarmEventRecordID,
 		AlarmRaisedTime:       aerModel.AlarmRaisedTime,
 		PerceivedSeverity:     aerModel.PerceivedSeverity,
-		Extensions:            aerModel.Extensions,
+		Extensions:            withCorrelationParent(aerModel.Extensions, aerModel.CorrelationParentID),
 	}
 
 	if aerModel.AlarmDefinitionID != nil {
@@ -48,6 +52,55 @@ func ConvertAlarmEventRecordModelToApi(aerModel AlarmEventRecord) api.AlarmEvent
 	return record
 }
 
+// withCorrelationParent returns the extensions of an alarm with a reference to its root cause alarm, if any.  The
+// extensions of the record are copied so that the model is left untouched.
+func withCorrelationParent(extensions map[string]string, parentID *uuid.UUID) map[string]string {
+	if parentID == nil {
+		return extensions
+	}
+
+	result := make(map[string]string, len(extensions)+1)
+	maps.Copy(result, extensions)
+	result[ParentAlarmEventRecordIDExtension] = parentID.String()
+	return result
+}
+
+// SetAlarmEventRecordChildren adds the references to the alarms correlated to a root cause alarm to its extensions
+func SetAlarmEventRecordChildren(record *api.AlarmEventRecord, children []uuid.UUID) {
+	if len(children) == 0 {
+		return
+	}
+
+	ids := make([]string, 0, len(children))
+	for _, child := range children {
+		ids = append(ids, child.String())
+	}
+
+	extensions := make(map[string]string, len(record.Extensions)+1)
+	maps.Copy(extensions, record.Extensions)
+	extensions[ChildAlarmEventRecordIDsExtension] = strings.Join(ids, ",")
+	record.Extensions = extensions
+}
+
+// ConvertAlarmEventHistoryModelToApi converts an AlarmEventHistory to an API AlarmEventHistoryRecord
+func ConvertAlarmEventHistoryModelToApi(historyModel AlarmEventHistory) api.AlarmEventHistoryRecord {
+	record := api.AlarmEventHistoryRecord{
+		AlarmEventRecordId:        historyModel.AlarmEventRecordID,
+		NotificationEventType:     historyModel.NotificationEventType,
+		PreviousPerceivedSeverity: historyModel.PreviousPerceivedSeverity,
+		PerceivedSeverity:         historyModel.PerceivedSeverity,
+		AlarmAcknowledgedBy:       historyModel.AlarmAcknowledgedBy,
+		AlarmClearedBy:            historyModel.AlarmClearedBy,
+		AlarmChangedTime:          historyModel.CreatedAt,
+	}
+
+	if historyModel.AlarmChangedTime != nil {
+		record.AlarmChangedTime = *historyModel.AlarmChangedTime
+	}
+
+	return record
+}
+
 // ConvertAlarmEventRecordModelToAlarmEventNotification converts an AlarmEventRecord to api AlarmEventNotification
 func ConvertAlarmEventRecordModelToAlarmEventNotification(aerModel AlarmEventRecord, globalCloudID uuid.UUID) api.AlarmEventNotification {
 	or := fmt.Sprintf("%s%s/%v", constants.O2IMSMonitoringBaseURL, constants.AlarmsPath, aerModel.AlarmEventRecordID.String())
@@ -56,7 +109,7 @@ func ConvertAlarmEventRecordModelToAlarmEventNotification(aerModel AlarmEventRec
 		AlarmAcknowledged:     aerModel.AlarmAcknowledged,
 		AlarmEventRecordId:    aerModel.AlarmEventRecordID,
 		AlarmRaisedTime:       aerModel.AlarmRaisedTime,
-		Extensions:            aerModel.Extensions,
+		Extensions:            withCorrelationParent(aerModel.Extensions, aerModel.CorrelationParentID),
 		GlobalCloudID:         globalCloudID,
 		NotificationEventType: AlarmFilterToEventType(aerModel.NotificationEventType),
 		ObjectRef:             &or,
@@ -93,6 +146,10 @@ func ConvertServiceConfigurationToAPI(config ServiceConfiguration) api.AlarmServ
 		apiModel.Extensions = &config.Extensions
 	}
 
+	if config.CorrelationRules != nil {
+		apiModel.CorrelationRules = &config.CorrelationRules
+	}
+
 	return apiModel
 }
 
@@ -102,6 +159,8 @@ func ConvertSubscriptionModelToApi(subscriptionModel AlarmSubscription) api.Alar
 		AlarmSubscriptionId:    &subscriptionModel.SubscriptionID,
 		Callback:               subscriptionModel.Callback,
 		ConsumerSubscriptionId: subscriptionModel.ConsumerSubscriptionID,
+		Correlation:            subscriptionModel.Correlation,
+		Selector:               subscriptionModel.Selector,
 	}
 
 	if subscriptionModel.Filter != nil {
@@ -117,7 +176,60 @@ func ConvertSubscriptionAPIToModel(subscriptionAPI *api.AlarmSubscriptionInfo) A
 		Callback:               subscriptionAPI.Callback,
 		ConsumerSubscriptionID: subscriptionAPI.ConsumerSubscriptionId,
 		Filter:                 subscriptionAPI.Filter,
+		Correlation:            subscriptionAPI.Correlation,
+		Selector:               subscriptionAPI.Selector,
+		SigningSecret:          subscriptionAPI.SigningSecret,
+	}
+}
+
+// ConvertMaintenanceWindowModelToApi converts a MaintenanceWindow DB model to an API model.  The status is computed
+// relative to the specified time.
+func ConvertMaintenanceWindowModelToApi(windowModel MaintenanceWindow, now time.Time) api.MaintenanceWindow {
+	status := api.SCHEDULED
+	switch {
+	case windowModel.IsActive(now):
+		status = api.ACTIVE
+	case windowModel.SummarySentAt != nil || (windowModel.EndTime != nil && !now.Before(*windowModel.EndTime)):
+		status = api.CLOSED
+	}
+
+	apiModel := api.MaintenanceWindow{
+		MaintenanceWindowId:     &windowModel.MaintenanceWindowID,
+		NodeClusterId:           windowModel.NodeClusterID,
+		StartTime:               windowModel.StartTime,
+		EndTime:                 windowModel.EndTime,
+		Description:             windowModel.Description,
+		ProvisioningRequestName: windowModel.ProvisioningRequest,
+		Status:                  &status,
+		SummarySentAt:           windowModel.SummarySentAt,
+	}
+
+	if windowModel.AlarmDefinitionIDs != nil {
+		apiModel.AlarmDefinitionIds = &windowModel.AlarmDefinitionIDs
+	}
+
+	if windowModel.SummarySentAt != nil {
+		apiModel.SuppressedAlarms = &windowModel.SuppressedAlarms
+		apiModel.ReleasedNotifications = &windowModel.ReleasedNotifications
+	}
+
+	return apiModel
+}
+
+// ConvertMaintenanceWindowAPIToModel converts a MaintenanceWindow API model to a DB model
+func ConvertMaintenanceWindowAPIToModel(windowAPI *api.MaintenanceWindow) MaintenanceWindow {
+	windowModel := MaintenanceWindow{
+		NodeClusterID: windowAPI.NodeClusterId,
+		StartTime:     windowAPI.StartTime,
+		EndTime:       windowAPI.EndTime,
+		Description:   windowAPI.Description,
+	}
+
+	if windowAPI.AlarmDefinitionIds != nil && len(*windowAPI.AlarmDefinitionIds) > 0 {
+		windowModel.AlarmDefinitionIDs = *windowAPI.AlarmDefinitionIds
 	}
+
+	return windowModel
 }
 
 // AlarmFilterToEventType map text to int e.g NEW -> 0
@@ -143,7 +255,25 @@ func ConvertAlertSubToNotificationSub(as *AlarmSubscription) *notifier.Subscript
 		ConsumerSubscriptionID: as.ConsumerSubscriptionID,
 		Callback:               as.Callback,
 		EventCursor:            int(as.EventCursor),
+		SuspendedUntil:         as.SuspendedUntil,
+		SigningSecrets:         notifier.NewSigningSecrets(as.SigningSecret, as.PreviousSigningSecret, as.PreviousSigningSecretExpiry),
+	}
+
+	criteria := SubscriptionCriteria{
+		CorrelatedOnly: as.Correlation != nil && *as.Correlation == api.CORRELATED,
+	}
+
+	if as.Selector != nil {
+		selector, err := ParseAlarmSubscriptionSelector(*as.Selector)
+		if err != nil {
+			// Selectors are validated when subscriptions are created so this is not expected
+			slog.Error("Invalid subscription selector, no alarm will be notified",
+				"subscriptionID", as.SubscriptionID, "error", err)
+		}
+		criteria.Selector = selector
+		criteria.InvalidSelector = err != nil
 	}
+	info.Criteria = criteria
 
 	if as.Filter != nil {
 		info.Filter = (*string)(as.Filter)
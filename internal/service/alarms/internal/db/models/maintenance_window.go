@@ -0,0 +1,54 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// MaintenanceWindowIDExtension is the extension key holding the identifier of the maintenance window that held back
+// the notification of an alarm
+const MaintenanceWindowIDExtension = "maintenanceWindowId"
+
+// MaintenanceWindow represents the maintenance_window table in the database
+type MaintenanceWindow struct {
+	MaintenanceWindowID uuid.UUID   `db:"maintenance_window_id"`
+	NodeClusterID       uuid.UUID   `db:"node_cluster_id"`
+	StartTime           time.Time   `db:"start_time"`
+	EndTime             *time.Time  `db:"end_time"`
+	AlarmDefinitionIDs  []uuid.UUID `db:"alarm_definition_ids"`
+	Description         *string     `db:"description"`
+	// ProvisioningRequest is the name of the ProvisioningRequest whose upgrade opened the window
+	ProvisioningRequest *string `db:"provisioning_request"`
+	// SuppressedAlarms and ReleasedNotifications summarize the window once SummarySentAt is set
+	SuppressedAlarms      int        `db:"suppressed_alarms"`
+	ReleasedNotifications int        `db:"released_notifications"`
+	SummarySentAt         *time.Time `db:"summary_sent_at"`
+	CreatedAt             time.Time  `db:"created_at"`
+}
+
+// TableName returns the name of the table in the database
+func (r MaintenanceWindow) TableName() string {
+	return "maintenance_window"
+}
+
+// PrimaryKey returns the primary key of the table
+func (r MaintenanceWindow) PrimaryKey() string {
+	return "maintenance_window_id"
+}
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r MaintenanceWindow) OnConflict() string {
+	return ""
+}
+
+// IsActive returns true if notifications are held back by the window at the specified time
+func (r MaintenanceWindow) IsActive(now time.Time) bool {
+	return r.SummarySentAt == nil && !now.Before(r.StartTime) && (r.EndTime == nil || now.Before(*r.EndTime))
+}
@@ -0,0 +1,34 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"github.com/google/uuid"
+)
+
+// NodeClusterResource represents the node_cluster_resource table in the database.  It associates the hardware
+// resources to the node cluster they belong to so that the maintenance windows of a node cluster also cover the
+// alarms raised against its hardware.
+type NodeClusterResource struct {
+	ResourceID    uuid.UUID `db:"resource_id"`
+	NodeClusterID uuid.UUID `db:"node_cluster_id"`
+}
+
+// TableName returns the name of the table in the database
+func (r NodeClusterResource) TableName() string {
+	return "node_cluster_resource"
+}
+
+// PrimaryKey returns the primary key of the table
+func (r NodeClusterResource) PrimaryKey() string {
+	return "resource_id"
+}
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r NodeClusterResource) OnConflict() string {
+	return ""
+}
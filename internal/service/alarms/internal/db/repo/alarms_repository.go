@@ -8,6 +8,7 @@ package repo
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"time"
@@ -22,8 +23,10 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql"
 	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/im"
+	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
@@ -36,18 +39,77 @@ type AlarmsRepository struct {
 // Compile time check for interface implementation
 var _ AlarmRepositoryInterface = (*AlarmsRepository)(nil)
 
+// ErrInvalidFilter is returned when a filter can't be translated into a query on the alarm_event_record table
+var ErrInvalidFilter = errors.New("invalid filter")
+
 // WithTransaction a helper function do transaction without exposing anything internal to repo
 func (ar *AlarmsRepository) WithTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error {
 	return pgx.BeginFunc(ctx, ar.Db, fn) //nolint:wrapcheck
 }
 
-// GetAlarmEventRecords grabs all rows of alarm_event_record
-func (ar *AlarmsRepository) GetAlarmEventRecords(ctx context.Context) ([]models.AlarmEventRecord, error) {
-	return svcutils.FindAll[models.AlarmEventRecord](ctx, ar.Db)
+// GetAlarmEventRecords grabs the rows of alarm_event_record matching the selector and, if a node cluster is provided,
+// raised against that node cluster or against the hardware resources of its nodes.  Rows are sorted from the most
+// recently raised and, if a marker is provided, only the rows sorted after the marker are returned.  A limit of zero
+// returns all remaining rows.  As the rows are paged by the database, an error wrapping ErrInvalidFilter is returned
+// if any of the selector terms can't be translated to SQL.
+func (ar *AlarmsRepository) GetAlarmEventRecords(ctx context.Context, selector *search.Selector, nodeClusterID *uuid.UUID, marker *models.AlarmEventRecordMarker, limit int) ([]models.AlarmEventRecord, error) {
+	filterExpr, err := svcutils.CompileFullSelector[models.AlarmEventRecord](ctx, selector)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidFilter, err)
+	}
+
+	var exprs []bob.Expression
+	if filterExpr != nil {
+		exprs = append(exprs, filterExpr)
+	}
+	if nodeClusterID != nil {
+		// The alarms of the node cluster and those of the hardware resources of its nodes
+		m := models.NodeClusterResource{}
+		dbTags := svcutils.GetAllDBTagsFromStruct(m)
+		exprs = append(exprs, psql.Or(
+			psql.Quote("object_id").EQ(psql.Arg(*nodeClusterID)),
+			psql.Quote("object_id").In(psql.Select(
+				sm.Columns(dbTags["ResourceID"]),
+				sm.From(m.TableName()),
+				sm.Where(psql.Quote(dbTags["NodeClusterID"]).EQ(psql.Arg(*nodeClusterID))),
+			)),
+		))
+	}
+	if marker != nil {
+		exprs = append(exprs, psql.Group(psql.Quote("alarm_raised_time"), psql.Quote("alarm_event_record_id")).
+			LT(psql.ArgGroup(marker.AlarmRaisedTime, marker.AlarmEventRecordID)))
+	}
+
+	var record models.AlarmEventRecord
+	tags := svcutils.GetAllDBTagsFromStruct(record)
+	query := psql.Select(
+		sm.Columns(tags.Columns()...),
+		sm.From(record.TableName()),
+		sm.OrderBy(psql.Quote("alarm_raised_time")).Desc(),
+		sm.OrderBy(psql.Quote("alarm_event_record_id")).Desc(),
+	)
+	if len(exprs) > 0 {
+		query.Apply(sm.Where(psql.And(exprs...)))
+	}
+	if limit > 0 {
+		query.Apply(sm.Limit(limit))
+	}
+
+	sql, args, err := query.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create query for alarm event records: %w", err)
+	}
+
+	return svcutils.ExecuteCollectRows[models.AlarmEventRecord](ctx, ar.Db, sql, args)
 }
 
 func (ar *AlarmsRepository) PatchAlarmEventRecordACK(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
-	return svcutils.Update[models.AlarmEventRecord](ctx, ar.Db, id, *record, "AlarmAcknowledged", "AlarmAcknowledgedTime", "PerceivedSeverity", "AlarmClearedTime", "AlarmChangedTime")
+	return svcutils.Update[models.AlarmEventRecord](ctx, ar.Db, id, *record, "AlarmAcknowledged", "AlarmAcknowledgedTime", "AlarmAcknowledgedBy", "PerceivedSeverity", "AlarmClearedTime", "AlarmChangedTime")
+}
+
+// ClearAlarmEventRecord resolves an alarm_event_record on behalf of a user
+func (ar *AlarmsRepository) ClearAlarmEventRecord(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
+	return svcutils.Update[models.AlarmEventRecord](ctx, ar.Db, id, *record, "AlarmStatus", "PerceivedSeverity", "AlarmClearedTime", "AlarmClearedBy")
 }
 
 // GetAlarmEventRecord grabs a row of alarm_event_record using a primary key
@@ -55,6 +117,63 @@ func (ar *AlarmsRepository) GetAlarmEventRecord(ctx context.Context, id uuid.UUI
 	return svcutils.Find[models.AlarmEventRecord](ctx, ar.Db, id)
 }
 
+// GetAlarmEventHistory grabs the state transitions of an alarm_event_record sorted by sequence
+func (ar *AlarmsRepository) GetAlarmEventHistory(ctx context.Context, id uuid.UUID) ([]models.AlarmEventHistory, error) {
+	m := models.AlarmEventHistory{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	q := psql.Select(
+		sm.Columns(dbTags.Columns()...),
+		sm.From(m.TableName()),
+		sm.Where(psql.Quote(dbTags["AlarmEventRecordID"]).EQ(psql.Arg(id))),
+		sm.OrderBy(dbTags["SequenceID"]).Asc(),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GetAlarmEventHistory query: %w", err)
+	}
+
+	return svcutils.ExecuteCollectRows[models.AlarmEventHistory](ctx, ar.Db, sql, params)
+}
+
+// GetChildAlarmEventRecordIDs grabs the identifiers of the alarm_event_record rows correlated to each of the given
+// root cause rows
+func (ar *AlarmsRepository) GetChildAlarmEventRecordIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	children := make(map[uuid.UUID][]uuid.UUID)
+	if len(ids) == 0 {
+		return children, nil
+	}
+
+	m := models.AlarmEventRecord{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+	parents := make([]any, 0, len(ids))
+	for _, id := range ids {
+		parents = append(parents, id)
+	}
+
+	q := psql.Select(
+		sm.Columns(dbTags["AlarmEventRecordID"], dbTags["CorrelationParentID"]),
+		sm.From(m.TableName()),
+		sm.Where(psql.Quote(dbTags["CorrelationParentID"]).In(psql.Arg(parents...))),
+		sm.OrderBy(dbTags["AlarmRaisedTime"]).Asc(),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GetChildAlarmEventRecordIDs query: %w", err)
+	}
+
+	records, err := svcutils.ExecuteCollectRows[models.AlarmEventRecord](ctx, ar.Db, sql, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute GetChildAlarmEventRecordIDs query: %w", err)
+	}
+
+	for _, record := range records {
+		children[*record.CorrelationParentID] = append(children[*record.CorrelationParentID], record.AlarmEventRecordID)
+	}
+
+	return children, nil
+}
+
 // CreateServiceConfiguration inserts a new row of alarm_service_configuration or returns the existing one
 func (ar *AlarmsRepository) CreateServiceConfiguration(ctx context.Context, defaultRetentionPeriod int) (*models.ServiceConfiguration, error) {
 	records, err := svcutils.FindAll[models.ServiceConfiguration](ctx, ar.Db)
@@ -101,7 +220,7 @@ func (ar *AlarmsRepository) GetServiceConfigurations(ctx context.Context) ([]mod
 
 // UpdateServiceConfiguration updates a row of alarm_service_configuration using a primary key
 func (ar *AlarmsRepository) UpdateServiceConfiguration(ctx context.Context, id uuid.UUID, record *models.ServiceConfiguration) (*models.ServiceConfiguration, error) {
-	return svcutils.Update[models.ServiceConfiguration](ctx, ar.Db, id, *record, "RetentionPeriod", "Extensions")
+	return svcutils.Update[models.ServiceConfiguration](ctx, ar.Db, id, *record, "RetentionPeriod", "Extensions", "CorrelationRules")
 }
 
 // GetAlarmSubscriptions grabs all rows of alarm_subscription
@@ -117,7 +236,7 @@ func (ar *AlarmsRepository) DeleteAlarmSubscription(ctx context.Context, id uuid
 
 // CreateAlarmSubscription inserts a new row of alarm_subscription
 func (ar *AlarmsRepository) CreateAlarmSubscription(ctx context.Context, record models.AlarmSubscription) (*models.AlarmSubscription, error) {
-	return svcutils.Create[models.AlarmSubscription](ctx, ar.Db, record, "ConsumerSubscriptionID", "Filter", "Callback", "EventCursor")
+	return svcutils.Create[models.AlarmSubscription](ctx, ar.Db, record, "ConsumerSubscriptionID", "Filter", "Callback", "Correlation", "EventCursor", "SigningSecret")
 }
 
 // GetAlarmSubscription grabs a row of alarm_subscription using a primary key
@@ -141,7 +260,7 @@ func (ar *AlarmsRepository) UpsertAlarmEventCaaSRecord(ctx context.Context, tx p
 		"AlarmAcknowledged", "PerceivedSeverity", "Extensions",
 		"ObjectID", "ObjectTypeID", "AlarmStatus",
 		"Fingerprint", "AlarmDefinitionID", "ProbableCauseID",
-		"GenerationID",
+		"GenerationID", "ClearingType",
 	})
 
 	// Set values
@@ -152,7 +271,7 @@ func (ar *AlarmsRepository) UpsertAlarmEventCaaSRecord(ctx context.Context, tx p
 			record.AlarmAcknowledged, record.PerceivedSeverity, record.Extensions,
 			record.ObjectID, record.ObjectTypeID, record.AlarmStatus,
 			record.Fingerprint, record.AlarmDefinitionID, record.ProbableCauseID,
-			generationID,
+			generationID, record.ClearingType,
 		)))
 	}
 	query.Apply(values...)
@@ -169,7 +288,14 @@ func (ar *AlarmsRepository) UpsertAlarmEventCaaSRecord(ctx context.Context, tx p
 		im.SetExcluded(dbTags["AlarmDefinitionID"]),
 		im.SetExcluded(dbTags["ProbableCauseID"]),
 		im.SetExcluded(dbTags["GenerationID"]),
-		im.Where(psql.Quote(m.TableName(), dbTags["AlarmSource"]).EQ(psql.Arg("alertmanager"))),
+		im.SetExcluded(dbTags["ClearingType"]),
+		// Alarms cleared by an operator stay resolved even if their source still reports them, but the source can
+		// still resolve them
+		im.Where(psql.Quote(m.TableName(), dbTags["AlarmSource"]).EQ(psql.Arg("alertmanager")).
+			And(psql.Or(
+				psql.Quote(m.TableName(), dbTags["AlarmClearedBy"]).IsNull(),
+				psql.Quote("excluded", dbTags["AlarmStatus"]).EQ(psql.Arg(api.Resolved)),
+			))),
 	))
 
 	sql, params, err := query.Build(ctx)
@@ -233,9 +359,190 @@ func (ar *AlarmsRepository) ResolveStaleAlarmEventCaaSRecord(ctx context.Context
 	return nil
 }
 
-// UpdateSubscriptionEventCursor update a given subscription event cursor with a alarm sequence value
+// UpsertAlarmEventHwRecord insert and updating an AlarmEventRecord reported by a hardware plugin.
+func (ar *AlarmsRepository) UpsertAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord, generationID int64) error {
+	if len(records) == 0 {
+		slog.Warn("No records for hardware events upsert")
+		return nil
+	}
+
+	records, err := ar.reuseActiveHwAlarmRaisedTimes(ctx, tx, records)
+	if err != nil {
+		return err
+	}
+
+	m := models.AlarmEventRecord{}
+	query := psql.Insert(im.Into(m.TableName()))
+
+	// Set cols
+	query.Expression.Columns = svcutils.GetColumns(records[0], []string{
+		"AlarmRaisedTime", "AlarmClearedTime", "AlarmAcknowledgedTime",
+		"AlarmAcknowledged", "PerceivedSeverity", "Extensions",
+		"ObjectID", "ObjectTypeID", "AlarmStatus",
+		"Fingerprint", "AlarmDefinitionID", "ProbableCauseID",
+		"GenerationID", "AlarmSource", "ClearingType",
+	})
+
+	// Set values
+	values := make([]bob.Mod[*dialect.InsertQuery], 0, len(records))
+	for _, record := range records {
+		values = append(values, im.Values(psql.Arg(
+			record.AlarmRaisedTime, record.AlarmClearedTime, record.AlarmAcknowledgedTime,
+			record.AlarmAcknowledged, record.PerceivedSeverity, record.Extensions,
+			record.ObjectID, record.ObjectTypeID, record.AlarmStatus,
+			record.Fingerprint, record.AlarmDefinitionID, record.ProbableCauseID,
+			generationID, models.AlarmSourceHardware, record.ClearingType,
+		)))
+	}
+	query.Apply(values...)
+
+	// Set upsert constraints
+	// Cols here should match 'manage_alarm_event trigger' function as needed to trigger a notification using 'should_create_data_change_event'
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+	query.Apply(im.OnConflictOnConstraint(m.OnConflict()).DoUpdate(
+		im.SetExcluded(dbTags["AlarmStatus"]),
+		im.SetExcluded(dbTags["AlarmClearedTime"]),
+		im.SetExcluded(dbTags["PerceivedSeverity"]),
+		im.SetExcluded(dbTags["Extensions"]),
+		im.SetExcluded(dbTags["ObjectID"]),
+		im.SetExcluded(dbTags["ObjectTypeID"]),
+		im.SetExcluded(dbTags["AlarmDefinitionID"]),
+		im.SetExcluded(dbTags["ProbableCauseID"]),
+		im.SetExcluded(dbTags["GenerationID"]),
+		im.SetExcluded(dbTags["ClearingType"]),
+		// Alarms cleared by an operator stay resolved even if their source still reports them, but the source can
+		// still resolve them
+		im.Where(psql.Quote(m.TableName(), dbTags["AlarmSource"]).EQ(psql.Arg(models.AlarmSourceHardware)).
+			And(psql.Or(
+				psql.Quote(m.TableName(), dbTags["AlarmClearedBy"]).IsNull(),
+				psql.Quote("excluded", dbTags["AlarmStatus"]).EQ(psql.Arg(api.Resolved)),
+			))),
+	))
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build query for hardware event upsert: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, sql, params...)
+	if err != nil {
+		return fmt.Errorf("failed to execute hardware upsert query: %w", err)
+	}
+
+	return nil
+}
+
+// reuseActiveHwAlarmRaisedTimes returns a copy of the records in which the raised time of each fault that is already
+// active is replaced by the raised time of its alarm.  Hardware plugins do not necessarily persist when they first saw
+// a fault, e.g., across restarts, so the active alarms are matched on their fingerprint alone; otherwise a new start
+// time would raise the same fault again.
+func (ar *AlarmsRepository) reuseActiveHwAlarmRaisedTimes(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord) ([]models.AlarmEventRecord, error) {
+	m := models.AlarmEventRecord{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+	fingerprints := make([]any, 0, len(records))
+	for _, record := range records {
+		fingerprints = append(fingerprints, record.Fingerprint)
+	}
+
+	q := psql.Select(
+		sm.Columns(dbTags["Fingerprint"], dbTags["AlarmRaisedTime"]),
+		sm.From(m.TableName()),
+		sm.Where(psql.Quote(dbTags["AlarmSource"]).EQ(psql.Arg(models.AlarmSourceHardware))),
+		sm.Where(psql.Quote(dbTags["AlarmStatus"]).NE(psql.Arg(api.Resolved))),
+		sm.Where(psql.Quote(dbTags["Fingerprint"]).In(psql.Arg(fingerprints...))),
+		sm.OrderBy(dbTags["AlarmRaisedTime"]).Asc(),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build active hardware alarms query: %w", err)
+	}
+
+	active, err := svcutils.ExecuteCollectRows[models.AlarmEventRecord](ctx, tx, sql, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute active hardware alarms query: %w", err)
+	}
+
+	// Rows are sorted so that the most recent alarm of a fingerprint wins
+	raisedTimes := make(map[string]time.Time, len(active))
+	for _, record := range active {
+		raisedTimes[record.Fingerprint] = record.AlarmRaisedTime
+	}
+
+	result := make([]models.AlarmEventRecord, len(records))
+	copy(result, records)
+	for i := range result {
+		if raisedTime, found := raisedTimes[result[i].Fingerprint]; found {
+			result[i].AlarmRaisedTime = raisedTime
+		}
+	}
+	return result, nil
+}
+
+// ResolveStaleAlarmEventHwRecord resolve all alerts of a hardware plugin with older generation ID
+func (ar *AlarmsRepository) ResolveStaleAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, hwPluginName string, generationID int64) error {
+	m := models.AlarmEventRecord{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+	var (
+		tableName          = m.TableName()
+		generationIDCol    = dbTags["GenerationID"]
+		clearedTime        = dbTags["AlarmClearedTime"]
+		alarmStatus        = dbTags["AlarmStatus"]
+		perceivedSeverity  = dbTags["PerceivedSeverity"]
+		alarmEventRecordID = dbTags["AlarmEventRecordID"]
+		alarmSource        = dbTags["AlarmSource"]
+		extensions         = dbTags["Extensions"]
+	)
+
+	updateClearedTimeCase := fmt.Sprintf(
+		"%s = CASE WHEN %s IS NULL THEN ? ELSE %s END",
+		clearedTime, clearedTime, clearedTime,
+	)
+
+	query := psql.Update(
+		um.Table(tableName),
+		um.SetCol(alarmStatus).ToArg(api.Resolved),                                                               // Set to resolved
+		um.SetCol(perceivedSeverity).ToArg(api.CLEARED),                                                          // Set corresponding perceivedSeverity
+		um.Set(psql.Raw(updateClearedTimeCase, TimeNow())),                                                       // Set a resolved time if not there already
+		um.Where(psql.Quote(generationIDCol).LT(psql.Arg(generationID))),                                         // An alert is stale if its GenID is less than current
+		um.Where(psql.Quote(alarmSource).EQ(psql.Arg(models.AlarmSourceHardware))),                               // This is only applicable for hardware rows
+		um.Where(psql.Raw(fmt.Sprintf("%s->>'%s' = ?", extensions, models.HwPluginNameExtension), hwPluginName)), // Only rows reported by the same plugin
+		um.Where(psql.Quote(alarmStatus).NE(psql.Arg(api.Resolved))),                                             // If already resolved no need to process that row
+		um.Returning(psql.Quote(alarmEventRecordID)),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build AlarmEventRecord update query when processing hardware notification: %w", err)
+	}
+	records, err := svcutils.ExecuteCollectRows[models.AlarmEventRecord](ctx, tx, sql, params)
+	if err != nil {
+		return err
+	}
+
+	if len(records) > 0 {
+		slog.Info("Successfully resolved stale hardware alarmeventrecords", "hwPluginName", hwPluginName, "records", len(records))
+	}
+	return nil
+}
+
+// UpdateSubscriptionEventCursor update a given subscription event cursor with a alarm sequence value along with the end
+// of its suspension.  The suspension is written even if nil so that it can be cleared.
 func (ar *AlarmsRepository) UpdateSubscriptionEventCursor(ctx context.Context, subscription models.AlarmSubscription) error {
-	_, err := svcutils.Update[models.AlarmSubscription](ctx, ar.Db, subscription.SubscriptionID, subscription, "EventCursor")
+	dbTags := svcutils.GetAllDBTagsFromStruct(subscription)
+
+	q := psql.Update(
+		um.Table(subscription.TableName()),
+		um.SetCol(dbTags["EventCursor"]).ToArg(subscription.EventCursor),
+		um.SetCol(dbTags["SuspendedUntil"]).ToArg(subscription.SuspendedUntil),
+		um.Where(psql.Quote(subscription.PrimaryKey()).EQ(psql.Arg(subscription.SubscriptionID))),
+		um.Returning(dbTags.Columns()...),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build UpdateSubscriptionEventCursor query: %w", err)
+	}
+
+	_, err = svcutils.ExecuteCollectExactlyOneRow[models.AlarmSubscription](ctx, ar.Db, sql, params)
 	if err != nil {
 		return fmt.Errorf("failed to execute UpdateSubscriptionEventCursor query: %w", err)
 	}
@@ -243,6 +550,99 @@ func (ar *AlarmsRepository) UpdateSubscriptionEventCursor(ctx context.Context, s
 	return nil
 }
 
+// UpdateSubscriptionSigningSecret updates the signing secrets of a given subscription.  The previous secret is written
+// even if nil so that it can be cleared.
+func (ar *AlarmsRepository) UpdateSubscriptionSigningSecret(ctx context.Context, subscription models.AlarmSubscription) error {
+	dbTags := svcutils.GetAllDBTagsFromStruct(subscription)
+
+	q := psql.Update(
+		um.Table(subscription.TableName()),
+		um.SetCol(dbTags["SigningSecret"]).ToArg(subscription.SigningSecret),
+		um.SetCol(dbTags["PreviousSigningSecret"]).ToArg(subscription.PreviousSigningSecret),
+		um.SetCol(dbTags["PreviousSigningSecretExpiry"]).ToArg(subscription.PreviousSigningSecretExpiry),
+		um.Where(psql.Quote(subscription.PrimaryKey()).EQ(psql.Arg(subscription.SubscriptionID))),
+		um.Returning(dbTags.Columns()...),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to build UpdateSubscriptionSigningSecret query: %w", err)
+	}
+
+	_, err = svcutils.ExecuteCollectExactlyOneRow[models.AlarmSubscription](ctx, ar.Db, sql, params)
+	if err != nil {
+		return fmt.Errorf("failed to execute UpdateSubscriptionSigningSecret query: %w", err)
+	}
+
+	return nil
+}
+
+// ArchiveAlarmEventRecords moves up to limit resolved AlarmEventRecord tuples cleared more than retentionPeriod days
+// ago to the archive, oldest first, and returns the number of tuples moved.
+func (ar *AlarmsRepository) ArchiveAlarmEventRecords(ctx context.Context, retentionPeriod, limit int) (int64, error) {
+	m := models.AlarmEventRecord{}
+	archive := models.AlarmEventRecordArchive{}
+	history := models.AlarmEventHistory{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+	archiveTags := svcutils.GetAllDBTagsFromStruct(archive)
+	historyTags := svcutils.GetAllDBTagsFromStruct(history)
+
+	// The tuples are deleted and archived by a single statement so that they can never be deleted without being
+	// archived.  The history of each record, which is deleted in cascade, is archived as part of the record.
+	query := psql.RawQuery(fmt.Sprintf(`WITH purged AS (
+	DELETE FROM %[1]s WHERE %[2]s IN (
+		SELECT %[2]s FROM %[1]s
+		WHERE %[3]s = ? AND %[4]s < now() - make_interval(days => ?)
+		ORDER BY %[4]s
+		LIMIT ?
+	)
+	RETURNING *
+)
+INSERT INTO %[5]s (%[6]s, %[7]s, %[8]s)
+SELECT %[2]s, %[4]s, to_jsonb(purged) || jsonb_build_object('history', (
+	SELECT COALESCE(jsonb_agg(to_jsonb(h) ORDER BY h.%[10]s), '[]'::jsonb) FROM %[9]s h WHERE h.%[11]s = purged.%[2]s
+)) FROM purged`,
+		m.TableName(), dbTags["AlarmEventRecordID"], dbTags["AlarmStatus"], dbTags["AlarmClearedTime"],
+		archive.TableName(), archiveTags["AlarmEventRecordID"], archiveTags["AlarmClearedTime"], archiveTags["Record"],
+		history.TableName(), historyTags["SequenceID"], historyTags["AlarmEventRecordID"]),
+		psql.Arg(api.Resolved), psql.Arg(retentionPeriod), psql.Arg(limit))
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build ArchiveAlarmEventRecords query: %w", err)
+	}
+
+	result, err := ar.Db.Exec(ctx, sql, params...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute ArchiveAlarmEventRecords query: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// PurgeAlarmEventRecordArchive deletes the AlarmEventRecordArchive tuples archived more than retentionPeriod days ago
+// and returns the number of tuples deleted.
+func (ar *AlarmsRepository) PurgeAlarmEventRecordArchive(ctx context.Context, retentionPeriod int) (int64, error) {
+	m := models.AlarmEventRecordArchive{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	query := psql.Delete(
+		dm.From(m.TableName()),
+		dm.Where(psql.Quote(dbTags["ArchivedAt"]).LT(psql.Raw("now() - make_interval(days => ?)", retentionPeriod))),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build PurgeAlarmEventRecordArchive query: %w", err)
+	}
+
+	result, err := ar.Db.Exec(ctx, sql, params...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute PurgeAlarmEventRecordArchive query: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
 // GetAllAlarmsDataChange get all outbox entries
 func (ar *AlarmsRepository) GetAllAlarmsDataChange(ctx context.Context) ([]commonmodels.DataChangeEvent, error) {
 	return svcutils.FindAll[commonmodels.DataChangeEvent](ctx, ar.Db)
@@ -269,3 +669,235 @@ func (ar *AlarmsRepository) DeleteAlarmsDataChange(ctx context.Context, dataChan
 
 	return nil
 }
+
+// GetDeadLetterNotifications get the dead letter queue of a subscription sorted by sequence
+func (ar *AlarmsRepository) GetDeadLetterNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]commonmodels.DeadLetterNotification, error) {
+	m := commonmodels.DeadLetterNotification{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	q := psql.Select(
+		sm.Columns(dbTags.Columns()...),
+		sm.From(m.TableName()),
+		sm.Where(psql.Quote(dbTags["SubscriptionID"]).EQ(psql.Arg(subscriptionID))),
+		sm.OrderBy(dbTags["SequenceID"]).Asc(),
+		sm.OrderBy(dbTags["CreatedAt"]).Asc(),
+	)
+	sql, params, err := q.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build GetDeadLetterNotifications query: %w", err)
+	}
+
+	return svcutils.ExecuteCollectRows[commonmodels.DeadLetterNotification](ctx, ar.Db, sql, params)
+}
+
+// GetDeadLetterNotification get a dead letter queue entry with given deadLetterID
+func (ar *AlarmsRepository) GetDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Find[commonmodels.DeadLetterNotification](ctx, ar.Db, deadLetterID)
+}
+
+// CreateDeadLetterNotification add a notification to the dead letter queue of a subscription
+func (ar *AlarmsRepository) CreateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Create[commonmodels.DeadLetterNotification](ctx, ar.Db, *record)
+}
+
+// UpdateDeadLetterNotification update the delivery attempts of a dead letter queue entry
+func (ar *AlarmsRepository) UpdateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Update[commonmodels.DeadLetterNotification](ctx, ar.Db, *record.DeadLetterID, *record,
+		"Attempts", "LastError", "UpdatedAt")
+}
+
+// DeleteDeadLetterNotification delete a dead letter queue entry with given deadLetterID
+func (ar *AlarmsRepository) DeleteDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) error {
+	expr := psql.Quote(commonmodels.DeadLetterNotification{}.PrimaryKey()).EQ(psql.Arg(deadLetterID))
+	if _, err := svcutils.Delete[commonmodels.DeadLetterNotification](ctx, ar.Db, expr); err != nil {
+		return fmt.Errorf("failed to execute DeleteDeadLetterNotification: %w", err)
+	}
+
+	return nil
+}
+
+// GetMaintenanceWindows grabs all rows of maintenance_window
+func (ar *AlarmsRepository) GetMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error) {
+	return svcutils.FindAll[models.MaintenanceWindow](ctx, ar.Db)
+}
+
+// GetMaintenanceWindow grabs a row of maintenance_window using a primary key
+func (ar *AlarmsRepository) GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	return svcutils.Find[models.MaintenanceWindow](ctx, ar.Db, id)
+}
+
+// CreateMaintenanceWindow inserts a new row of maintenance_window
+func (ar *AlarmsRepository) CreateMaintenanceWindow(ctx context.Context, record models.MaintenanceWindow) (*models.MaintenanceWindow, error) {
+	return svcutils.Create[models.MaintenanceWindow](ctx, ar.Db, record, "NodeClusterID", "StartTime", "EndTime", "AlarmDefinitionIDs", "Description")
+}
+
+// DeleteMaintenanceWindow releases the notifications held back by a row of maintenance_window and deletes it in a
+// single transaction, so that the notifications are not sent if the row can't be deleted.  The deleted row is returned,
+// or svcutils.ErrNotFound without changing anything if it does not exist.
+func (ar *AlarmsRepository) DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	var result *models.MaintenanceWindow
+	err := ar.WithTransaction(ctx, func(tx pgx.Tx) error {
+		txRepo := &AlarmsRepository{Db: tx}
+		window, err := txRepo.GetMaintenanceWindow(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		// The window is already released if it has ended
+		released, err := txRepo.ReleaseMaintenanceWindow(ctx, id)
+		if err != nil {
+			return err
+		}
+		if released != nil {
+			window = released
+		}
+
+		expr := psql.Quote(models.MaintenanceWindow{}.PrimaryKey()).EQ(psql.Arg(id))
+		if _, err := svcutils.Delete[models.MaintenanceWindow](ctx, tx, expr); err != nil {
+			return err
+		}
+
+		result = window
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ReleaseMaintenanceWindow closes a maintenance_window and queues the notifications it held back.  Nil is returned if
+// the window does not exist or was already released.
+func (ar *AlarmsRepository) ReleaseMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	query := psql.Select(
+		sm.Columns(svcutils.GetAllDBTagsFromStruct(models.MaintenanceWindow{}).Columns()...),
+		sm.From(psql.F("release_maintenance_window", psql.Arg(id))),
+	)
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build ReleaseMaintenanceWindow query: %w", err)
+	}
+
+	records, err := svcutils.ExecuteCollectRows[models.MaintenanceWindow](ctx, ar.Db, sql, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute ReleaseMaintenanceWindow query: %w", err)
+	}
+	if len(records) == 0 {
+		return nil, nil
+	}
+
+	return &records[0], nil
+}
+
+// GetExpiredMaintenanceWindows grabs the rows of maintenance_window that have ended but whose notifications were not
+// released yet
+func (ar *AlarmsRepository) GetExpiredMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error) {
+	m := models.MaintenanceWindow{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	expr := psql.And(
+		psql.Quote(dbTags["SummarySentAt"]).IsNull(),
+		psql.Quote(dbTags["EndTime"]).LTE(psql.Raw("now()")),
+	)
+	return svcutils.Search[models.MaintenanceWindow](ctx, ar.Db, expr)
+}
+
+// OpenProvisioningMaintenanceWindow inserts an open-ended row of maintenance_window covering the node cluster of a
+// ProvisioningRequest unless one is already open for it.  It returns true if a row was inserted.
+func (ar *AlarmsRepository) OpenProvisioningMaintenanceWindow(ctx context.Context, provisioningRequest string, nodeClusterID uuid.UUID, description string) (bool, error) {
+	m := models.MaintenanceWindow{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	// The conflict target matches the partial unique index allowing a single open window per ProvisioningRequest
+	query := psql.RawQuery(fmt.Sprintf(`INSERT INTO %s (%s, %s, %s, %s) VALUES (?, ?, now(), ?)
+ON CONFLICT (%s) WHERE %s IS NULL DO NOTHING`,
+		m.TableName(), dbTags["ProvisioningRequest"], dbTags["NodeClusterID"], dbTags["StartTime"], dbTags["Description"],
+		dbTags["ProvisioningRequest"], dbTags["EndTime"]),
+		psql.Arg(provisioningRequest), psql.Arg(nodeClusterID), psql.Arg(description))
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to build OpenProvisioningMaintenanceWindow query: %w", err)
+	}
+
+	result, err := ar.Db.Exec(ctx, sql, params...)
+	if err != nil {
+		return false, fmt.Errorf("failed to execute OpenProvisioningMaintenanceWindow query: %w", err)
+	}
+
+	return result.RowsAffected() > 0, nil
+}
+
+// CloseProvisioningMaintenanceWindows ends the open rows of maintenance_window opened by ProvisioningRequests that are
+// not in the provided list, and returns the number of rows ended.
+func (ar *AlarmsRepository) CloseProvisioningMaintenanceWindows(ctx context.Context, activeProvisioningRequests []string) (int64, error) {
+	m := models.MaintenanceWindow{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	exprs := []bob.Expression{
+		psql.Quote(dbTags["ProvisioningRequest"]).IsNotNull(),
+		psql.Quote(dbTags["EndTime"]).IsNull(),
+	}
+	if len(activeProvisioningRequests) > 0 {
+		names := make([]any, 0, len(activeProvisioningRequests))
+		for _, name := range activeProvisioningRequests {
+			names = append(names, name)
+		}
+		exprs = append(exprs, psql.Quote(dbTags["ProvisioningRequest"]).NotIn(psql.Arg(names...)))
+	}
+
+	query := psql.Update(
+		um.Table(m.TableName()),
+		um.SetCol(dbTags["EndTime"]).To(psql.Raw("GREATEST(now(), start_time + interval '1 microsecond')")),
+		um.Where(psql.And(exprs...)),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build CloseProvisioningMaintenanceWindows query: %w", err)
+	}
+
+	result, err := ar.Db.Exec(ctx, sql, params...)
+	if err != nil {
+		return 0, fmt.Errorf("failed to execute CloseProvisioningMaintenanceWindows query: %w", err)
+	}
+
+	return result.RowsAffected(), nil
+}
+
+// ReplaceNodeClusterResources replaces all rows of node_cluster_resource with the provided rows
+func (ar *AlarmsRepository) ReplaceNodeClusterResources(ctx context.Context, records []models.NodeClusterResource) error {
+	m := models.NodeClusterResource{}
+	dbTags := svcutils.GetAllDBTagsFromStruct(m)
+
+	return ar.WithTransaction(ctx, func(tx pgx.Tx) error {
+		sql, params, err := psql.Delete(dm.From(m.TableName())).Build(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to build node cluster resources delete query: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, sql, params...); err != nil {
+			return fmt.Errorf("failed to execute node cluster resources delete query: %w", err)
+		}
+
+		if len(records) == 0 {
+			return nil
+		}
+
+		query := psql.Insert(im.Into(m.TableName(), dbTags["ResourceID"], dbTags["NodeClusterID"]))
+		for _, record := range records {
+			query.Apply(im.Values(psql.Arg(record.ResourceID, record.NodeClusterID)))
+		}
+
+		sql, params, err = query.Build(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to build node cluster resources insert query: %w", err)
+		}
+
+		if _, err := tx.Exec(ctx, sql, params...); err != nil {
+			return fmt.Errorf("failed to execute node cluster resources insert query: %w", err)
+		}
+
+		return nil
+	})
+}
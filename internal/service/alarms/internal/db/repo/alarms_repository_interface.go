@@ -12,6 +12,7 @@ import (
 	"github.com/jackc/pgx/v5"
 
 	"github.com/google/uuid"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 )
@@ -19,9 +20,12 @@ import (
 //go:generate mockgen -source=alarms_repository_interface.go -destination=generated/mock_repo.generated.go -package=generated
 
 type AlarmRepositoryInterface interface {
-	GetAlarmEventRecords(ctx context.Context) ([]models.AlarmEventRecord, error)
+	GetAlarmEventRecords(ctx context.Context, selector *search.Selector, nodeClusterID *uuid.UUID, marker *models.AlarmEventRecordMarker, limit int) ([]models.AlarmEventRecord, error)
 	PatchAlarmEventRecordACK(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error)
+	ClearAlarmEventRecord(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error)
 	GetAlarmEventRecord(ctx context.Context, id uuid.UUID) (*models.AlarmEventRecord, error)
+	GetAlarmEventHistory(ctx context.Context, id uuid.UUID) ([]models.AlarmEventHistory, error)
+	GetChildAlarmEventRecordIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error)
 	CreateServiceConfiguration(ctx context.Context, defaultRetentionPeriod int) (*models.ServiceConfiguration, error)
 	GetServiceConfigurations(ctx context.Context) ([]models.ServiceConfiguration, error)
 	UpdateServiceConfiguration(ctx context.Context, id uuid.UUID, record *models.ServiceConfiguration) (*models.ServiceConfiguration, error)
@@ -31,8 +35,27 @@ type AlarmRepositoryInterface interface {
 	GetAlarmSubscription(ctx context.Context, id uuid.UUID) (*models.AlarmSubscription, error)
 	UpsertAlarmEventCaaSRecord(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord, generationID int64) error
 	ResolveStaleAlarmEventCaaSRecord(ctx context.Context, tx pgx.Tx, generationID int64) error
+	UpsertAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord, generationID int64) error
+	ResolveStaleAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, hwPluginName string, generationID int64) error
 	UpdateSubscriptionEventCursor(ctx context.Context, subscription models.AlarmSubscription) error
+	UpdateSubscriptionSigningSecret(ctx context.Context, subscription models.AlarmSubscription) error
+	ArchiveAlarmEventRecords(ctx context.Context, retentionPeriod, limit int) (int64, error)
+	PurgeAlarmEventRecordArchive(ctx context.Context, retentionPeriod int) (int64, error)
 	GetAllAlarmsDataChange(ctx context.Context) ([]commonmodels.DataChangeEvent, error)
 	DeleteAlarmsDataChange(ctx context.Context, dataChangeId uuid.UUID) error
+	GetDeadLetterNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]commonmodels.DeadLetterNotification, error)
+	GetDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) (*commonmodels.DeadLetterNotification, error)
+	CreateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error)
+	UpdateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error)
+	DeleteDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) error
+	GetMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error)
+	GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	CreateMaintenanceWindow(ctx context.Context, record models.MaintenanceWindow) (*models.MaintenanceWindow, error)
+	DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	ReleaseMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error)
+	GetExpiredMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error)
+	OpenProvisioningMaintenanceWindow(ctx context.Context, provisioningRequest string, nodeClusterID uuid.UUID, description string) (bool, error)
+	CloseProvisioningMaintenanceWindows(ctx context.Context, activeProvisioningRequests []string) (int64, error)
+	ReplaceNodeClusterResources(ctx context.Context, records []models.NodeClusterResource) error
 	WithTransaction(ctx context.Context, fn func(tx pgx.Tx) error) error
 }
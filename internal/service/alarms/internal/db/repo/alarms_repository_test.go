@@ -9,6 +9,7 @@ package repo_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"time"
 
 	"github.com/jackc/pgx/v5"
@@ -18,9 +19,11 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/pashagolub/pgxmock/v4"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	alarmsrepo "github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
 var _ = Describe("AlarmsRepository", func() {
@@ -162,7 +165,7 @@ var _ = Describe("AlarmsRepository", func() {
 							AddRow(id2, now, true, api.INDETERMINATE),
 					)
 
-				records, err := repo.GetAlarmEventRecords(ctx)
+				records, err := repo.GetAlarmEventRecords(ctx, nil, nil, nil, 0)
 				Expect(err).NotTo(HaveOccurred())
 				Expect(records).To(HaveLen(2))
 				Expect(records[0].AlarmEventRecordID).To(Equal(id1))
@@ -170,6 +173,98 @@ var _ = Describe("AlarmsRepository", func() {
 				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
 			})
 		})
+
+		When("a filter, a marker and a limit are provided", func() {
+			It("translates them into the query", func() {
+				now := time.Now()
+				resourceID := uuid.New()
+				markerID := uuid.New()
+				selector := &search.Selector{
+					Terms: []*search.Term{
+						{Operator: search.Eq, Path: []string{"resourceID"}, Values: []any{resourceID.String()}},
+						{Operator: search.In, Path: []string{"perceivedSeverity"}, Values: []any{"1", "2"}},
+						{Operator: search.Eq, Path: []string{"extensions", "cluster"}, Values: []any{"spoke1"}},
+					},
+				}
+				marker := &models.AlarmEventRecordMarker{AlarmRaisedTime: now, AlarmEventRecordID: markerID}
+
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE %s", models.AlarmEventRecord{}.TableName(), regexp.QuoteMeta(
+					`((("object_id" = $1) AND ("perceived_severity" IN ($2, $3)) AND ("extensions"->>$4 = $5)) AND `+
+						`(("alarm_raised_time", "alarm_event_record_id") < ($6, $7))) `+
+						`ORDER BY "alarm_raised_time" DESC, "alarm_event_record_id" DESC LIMIT 11`))).
+					WithArgs(resourceID, 1, 2, "cluster", "spoke1", now, markerID).
+					WillReturnRows(pgxmock.NewRows([]string{"alarm_event_record_id"}))
+
+				records, err := repo.GetAlarmEventRecords(ctx, selector, nil, marker, 11)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(BeEmpty())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+
+		When("a node cluster is provided", func() {
+			It("also selects the alarms of the hardware resources of the node cluster", func() {
+				nodeClusterID := uuid.New()
+				selector := &search.Selector{
+					Terms: []*search.Term{
+						{Operator: search.Eq, Path: []string{"perceivedSeverity"}, Values: []any{"0"}},
+					},
+				}
+
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE %s", models.AlarmEventRecord{}.TableName(), regexp.QuoteMeta(
+					`((("perceived_severity" = $1)) AND (("object_id" = $2) OR ("object_id" IN ((SELECT resource_id `+
+						`FROM node_cluster_resource WHERE ("node_cluster_id" = $3) ))))) `+
+						`ORDER BY "alarm_raised_time" DESC, "alarm_event_record_id" DESC`))).
+					WithArgs(0, nodeClusterID, nodeClusterID).
+					WillReturnRows(pgxmock.NewRows([]string{"alarm_event_record_id"}))
+
+				records, err := repo.GetAlarmEventRecords(ctx, selector, &nodeClusterID, nil, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(BeEmpty())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+
+		DescribeTable("translates filters on UUIDs and extensions",
+			func(term *search.Term, where string, args ...any) {
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE %s", models.AlarmEventRecord{}.TableName(),
+					regexp.QuoteMeta(where))).
+					WithArgs(args...).
+					WillReturnRows(pgxmock.NewRows([]string{"alarm_event_record_id"}))
+
+				records, err := repo.GetAlarmEventRecords(ctx, &search.Selector{Terms: []*search.Term{term}}, nil, nil, 0)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(records).To(BeEmpty())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			},
+			Entry("extension containing an UUID",
+				&search.Term{Operator: search.Eq, Path: []string{"extensions", "managed_cluster_id"},
+					Values: []any{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8"}},
+				`(((lower("extensions"->>$1) = $2)))`,
+				"managed_cluster_id", "6ba7b810-9dad-11d1-80b4-00c04fd430c8"),
+			Entry("UUID column in upper case",
+				&search.Term{Operator: search.Eq, Path: []string{"resourceID"},
+					Values: []any{"6BA7B810-9DAD-11D1-80B4-00C04FD430C8"}},
+				`((("object_id" = $1)))`,
+				uuid.MustParse("6ba7b810-9dad-11d1-80b4-00c04fd430c8")),
+			Entry("ordering of an extension",
+				&search.Term{Operator: search.Gt, Path: []string{"extensions", "version"}, Values: []any{"4.16"}},
+				`((("extensions"->>$1 COLLATE "C" > $2)))`,
+				"version", "4.16"),
+		)
+
+		DescribeTable("rejects filters that can't be translated",
+			func(term *search.Term) {
+				records, err := repo.GetAlarmEventRecords(ctx, &search.Selector{Terms: []*search.Term{term}}, nil, nil, 0)
+				Expect(err).To(MatchError(alarmsrepo.ErrInvalidFilter))
+				Expect(records).To(BeNil())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			},
+			Entry("unknown field", &search.Term{Operator: search.Eq, Path: []string{"unknown"}, Values: []any{"x"}}),
+			Entry("invalid uuid", &search.Term{Operator: search.Eq, Path: []string{"resourceID"}, Values: []any{"x"}}),
+			Entry("invalid time", &search.Term{Operator: search.Gt, Path: []string{"alarmRaisedTime"}, Values: []any{"yesterday"}}),
+			Entry("contains on a number", &search.Term{Operator: search.Cont, Path: []string{"perceivedSeverity"}, Values: []any{"1"}}),
+		)
 	})
 
 	Describe("PatchAlarmEventRecordACK", func() {
@@ -201,6 +296,36 @@ var _ = Describe("AlarmsRepository", func() {
 		})
 	})
 
+	Describe("ClearAlarmEventRecord", func() {
+		It("resolves the alarm on behalf of the user", func() {
+			id := uuid.New()
+			now := time.Now()
+			user := "smo-user"
+			record := &models.AlarmEventRecord{
+				AlarmEventRecordID: id,
+				AlarmStatus:        string(api.Resolved),
+				AlarmClearedTime:   &now,
+				AlarmClearedBy:     &user,
+				PerceivedSeverity:  api.CLEARED,
+				ClearingType:       "MANUAL",
+			}
+
+			mock.ExpectQuery(fmt.Sprintf("UPDATE %s SET", models.AlarmEventRecord{}.TableName())).
+				WithArgs(&user, &now, string(api.Resolved), api.CLEARED, id).
+				WillReturnRows(
+					pgxmock.NewRows([]string{
+						"alarm_event_record_id", "alarm_status", "alarm_cleared_time", "alarm_cleared_by", "perceived_severity",
+					}).AddRow(id, string(api.Resolved), &now, &user, api.CLEARED),
+				)
+
+			result, err := repo.ClearAlarmEventRecord(ctx, id, record)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.AlarmStatus).To(Equal(string(api.Resolved)))
+			Expect(result.AlarmClearedBy).To(Equal(&user))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
 	Describe("GetAlarmEventRecord", func() {
 		When("record exist given ID", func() {
 			It("returns one event record", func() {
@@ -223,6 +348,65 @@ var _ = Describe("AlarmsRepository", func() {
 		})
 	})
 
+	Describe("GetChildAlarmEventRecordIDs", func() {
+		It("groups the correlated alarms by root cause", func() {
+			parent1 := uuid.New()
+			parent2 := uuid.New()
+			child1 := uuid.New()
+			child2 := uuid.New()
+			child3 := uuid.New()
+
+			mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE \\(\"correlation_parent_id\" IN \\(\\$1, \\$2\\)\\)", models.AlarmEventRecord{}.TableName())).
+				WithArgs(parent1, parent2).
+				WillReturnRows(
+					pgxmock.NewRows([]string{"alarm_event_record_id", "correlation_parent_id"}).
+						AddRow(child1, &parent1).
+						AddRow(child2, &parent2).
+						AddRow(child3, &parent1),
+				)
+
+			children, err := repo.GetChildAlarmEventRecordIDs(ctx, []uuid.UUID{parent1, parent2})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(children).To(HaveKeyWithValue(parent1, []uuid.UUID{child1, child3}))
+			Expect(children).To(HaveKeyWithValue(parent2, []uuid.UUID{child2}))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+
+		It("does not query the database without root cause", func() {
+			children, err := repo.GetChildAlarmEventRecordIDs(ctx, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(children).To(BeEmpty())
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("GetAlarmEventHistory", func() {
+		It("returns the transitions sorted by sequence", func() {
+			id := uuid.New()
+			actor := "smo-user"
+			severity := api.MAJOR
+
+			mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE \\(\"alarm_event_record_id\" = \\$1\\) ORDER BY sequence_id ASC", models.AlarmEventHistory{}.TableName())).
+				WithArgs(id).
+				WillReturnRows(
+					pgxmock.NewRows([]string{
+						"history_id", "alarm_event_record_id", "notification_event_type", "alarm_status", "previous_perceived_severity",
+						"perceived_severity", "alarm_acknowledged_by", "alarm_changed_time", "sequence_id", "created_at",
+					}).
+						AddRow(uuid.New(), id, api.AlarmSubscriptionInfoFilterNEW, "firing", nil, api.MAJOR, nil, nil, int64(1), time.Now()).
+						AddRow(uuid.New(), id, api.AlarmSubscriptionInfoFilterACKNOWLEDGE, "firing", &severity, api.MAJOR, &actor, nil, int64(2), time.Now()),
+				)
+
+			records, err := repo.GetAlarmEventHistory(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+			Expect(records[0].NotificationEventType).To(Equal(api.AlarmSubscriptionInfoFilterNEW))
+			Expect(records[0].PreviousPerceivedSeverity).To(BeNil())
+			Expect(*records[1].AlarmAcknowledgedBy).To(Equal(actor))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
 	Describe("GetAlarmSubscription", func() {
 		When("subscription exists", func() {
 			It("retrieves a specific alarm subscription", func() {
@@ -284,7 +468,7 @@ var _ = Describe("AlarmsRepository", func() {
 						records[0].PerceivedSeverity, records[0].Extensions,
 						records[0].ObjectID, records[0].ObjectTypeID,
 						records[0].AlarmStatus, records[0].Fingerprint,
-						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), "alertmanager",
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), records[0].ClearingType, "alertmanager", api.Resolved,
 					).
 					WillReturnResult(pgxmock.NewResult("INSERT", 1))
 
@@ -302,6 +486,41 @@ var _ = Describe("AlarmsRepository", func() {
 			})
 		})
 
+		When("the alarm was cleared by an operator", func() {
+			It("lets alertmanager resolve it but not raise it again", func() {
+				id := uuid.New()
+				records := []models.AlarmEventRecord{
+					{
+						AlarmRaisedTime:   time.Now(),
+						PerceivedSeverity: api.CRITICAL,
+						ObjectID:          &id,
+						Fingerprint:       "9a9e2d82a78cf2b9",
+					},
+				}
+				mock.ExpectBegin()
+
+				mock.ExpectExec(`DO UPDATE SET (.+) WHERE \(\("alarm_event_record"\."alarm_source" = \$\d+\) AND \(\("alarm_event_record"\."alarm_cleared_by" IS NULL\) OR \("excluded"\."alarm_status" = \$\d+\)\)\)`).
+					WithArgs(
+						records[0].AlarmRaisedTime, records[0].AlarmClearedTime,
+						records[0].AlarmAcknowledgedTime, records[0].AlarmAcknowledged,
+						records[0].PerceivedSeverity, records[0].Extensions,
+						records[0].ObjectID, records[0].ObjectTypeID,
+						records[0].AlarmStatus, records[0].Fingerprint,
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), records[0].ClearingType, "alertmanager", api.Resolved,
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+				mock.ExpectCommit()
+				mock.ExpectRollback()
+
+				err := repo.WithTransaction(ctx, func(tx pgx.Tx) error {
+					return repo.UpsertAlarmEventCaaSRecord(ctx, tx, records, int64(0))
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+
 		When("upserting multiple records", func() {
 			It("handles multiple records in a single upsert", func() {
 				id1, id2 := uuid.New(), uuid.New()
@@ -331,14 +550,14 @@ var _ = Describe("AlarmsRepository", func() {
 						records[0].PerceivedSeverity, records[0].Extensions,
 						records[0].ObjectID, records[0].ObjectTypeID,
 						records[0].AlarmStatus, records[0].Fingerprint,
-						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0),
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), records[0].ClearingType,
 						records[1].AlarmRaisedTime, records[1].AlarmClearedTime,
 						records[1].AlarmAcknowledgedTime, records[1].AlarmAcknowledged,
 						records[1].PerceivedSeverity, records[1].Extensions,
 						records[1].ObjectID, records[1].ObjectTypeID,
 						records[1].AlarmStatus, records[1].Fingerprint,
-						records[1].AlarmDefinitionID, records[1].ProbableCauseID, int64(0),
-						"alertmanager",
+						records[1].AlarmDefinitionID, records[1].ProbableCauseID, int64(0), records[1].ClearingType,
+						"alertmanager", api.Resolved,
 					).
 					WillReturnResult(pgxmock.NewResult("INSERT", 2))
 
@@ -388,7 +607,7 @@ var _ = Describe("AlarmsRepository", func() {
 				}
 
 				mock.ExpectQuery(fmt.Sprintf("UPDATE %s SET", subscription.TableName())).
-					WithArgs(subscription.EventCursor, subscription.SubscriptionID).
+					WithArgs(subscription.EventCursor, subscription.SuspendedUntil, subscription.SubscriptionID).
 					WillReturnRows(pgxmock.NewRows([]string{"subscription_id"}).AddRow(subscription.SubscriptionID))
 
 				err := repo.UpdateSubscriptionEventCursor(ctx, subscription)
@@ -405,7 +624,7 @@ var _ = Describe("AlarmsRepository", func() {
 				}
 
 				mock.ExpectQuery(fmt.Sprintf("UPDATE %s SET", subscription.TableName())).
-					WithArgs(subscription.EventCursor, subscription.SubscriptionID).
+					WithArgs(subscription.EventCursor, subscription.SuspendedUntil, subscription.SubscriptionID).
 					WillReturnError(fmt.Errorf("database error"))
 
 				err := repo.UpdateSubscriptionEventCursor(ctx, subscription)
@@ -445,6 +664,167 @@ var _ = Describe("AlarmsRepository", func() {
 		})
 	})
 
+	Describe("UpsertAlarmEventHwRecord", func() {
+		When("upserting a single record", func() {
+			It("successfully upserts hardware alarm event records", func() {
+				id := uuid.New()
+				records := []models.AlarmEventRecord{
+					{
+						AlarmRaisedTime:   time.Now(),
+						PerceivedSeverity: api.CRITICAL,
+						ObjectID:          &id,
+						Fingerprint:       "metal3-hwplugin/ns/host-1/PowerManagementError",
+						Extensions:        map[string]string{models.HwPluginNameExtension: "metal3-hwplugin"},
+					},
+				}
+				// Expect transaction begin
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s", models.AlarmEventRecord{}.TableName())).
+					WithArgs("hardware", api.Resolved, records[0].Fingerprint).
+					WillReturnRows(pgxmock.NewRows([]string{"fingerprint", "alarm_raised_time"}))
+
+				mock.ExpectExec(fmt.Sprintf("INSERT INTO %s", models.AlarmEventRecord{}.TableName())).
+					WithArgs(
+						records[0].AlarmRaisedTime, records[0].AlarmClearedTime,
+						records[0].AlarmAcknowledgedTime, records[0].AlarmAcknowledged,
+						records[0].PerceivedSeverity, records[0].Extensions,
+						records[0].ObjectID, records[0].ObjectTypeID,
+						records[0].AlarmStatus, records[0].Fingerprint,
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), "hardware", records[0].ClearingType, "hardware", api.Resolved,
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+				// Expect transaction commit
+				mock.ExpectCommit()
+
+				// Expect the deferred rollback after commit
+				mock.ExpectRollback()
+
+				err := repo.WithTransaction(ctx, func(tx pgx.Tx) error {
+					return repo.UpsertAlarmEventHwRecord(ctx, tx, records, int64(0))
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the alarm was cleared by an operator", func() {
+			It("lets the hardware plugin resolve it but not raise it again", func() {
+				id := uuid.New()
+				records := []models.AlarmEventRecord{
+					{
+						AlarmRaisedTime:   time.Now(),
+						PerceivedSeverity: api.CRITICAL,
+						ObjectID:          &id,
+						Fingerprint:       "metal3-hwplugin/ns/host-1/PowerManagementError",
+						Extensions:        map[string]string{models.HwPluginNameExtension: "metal3-hwplugin"},
+					},
+				}
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s", models.AlarmEventRecord{}.TableName())).
+					WithArgs("hardware", api.Resolved, records[0].Fingerprint).
+					WillReturnRows(pgxmock.NewRows([]string{"fingerprint", "alarm_raised_time"}))
+
+				mock.ExpectExec(`DO UPDATE SET (.+) WHERE \(\("alarm_event_record"\."alarm_source" = \$\d+\) AND \(\("alarm_event_record"\."alarm_cleared_by" IS NULL\) OR \("excluded"\."alarm_status" = \$\d+\)\)\)`).
+					WithArgs(
+						records[0].AlarmRaisedTime, records[0].AlarmClearedTime,
+						records[0].AlarmAcknowledgedTime, records[0].AlarmAcknowledged,
+						records[0].PerceivedSeverity, records[0].Extensions,
+						records[0].ObjectID, records[0].ObjectTypeID,
+						records[0].AlarmStatus, records[0].Fingerprint,
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), "hardware", records[0].ClearingType, "hardware", api.Resolved,
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+				mock.ExpectCommit()
+				mock.ExpectRollback()
+
+				err := repo.WithTransaction(ctx, func(tx pgx.Tx) error {
+					return repo.UpsertAlarmEventHwRecord(ctx, tx, records, int64(0))
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+
+		When("the fault is already active", func() {
+			It("keeps the raised time of the active alarm", func() {
+				id := uuid.New()
+				raisedTime := time.Now().Add(-time.Hour)
+				records := []models.AlarmEventRecord{
+					{
+						AlarmRaisedTime:   time.Now(),
+						PerceivedSeverity: api.CRITICAL,
+						ObjectID:          &id,
+						Fingerprint:       "metal3-hwplugin/ns/host-1/PowerManagementError",
+						Extensions:        map[string]string{models.HwPluginNameExtension: "metal3-hwplugin"},
+					},
+				}
+
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s", models.AlarmEventRecord{}.TableName())).
+					WithArgs("hardware", api.Resolved, records[0].Fingerprint).
+					WillReturnRows(pgxmock.NewRows([]string{"fingerprint", "alarm_raised_time"}).
+						AddRow(records[0].Fingerprint, raisedTime))
+
+				mock.ExpectExec(fmt.Sprintf("INSERT INTO %s", models.AlarmEventRecord{}.TableName())).
+					WithArgs(
+						raisedTime, records[0].AlarmClearedTime,
+						records[0].AlarmAcknowledgedTime, records[0].AlarmAcknowledged,
+						records[0].PerceivedSeverity, records[0].Extensions,
+						records[0].ObjectID, records[0].ObjectTypeID,
+						records[0].AlarmStatus, records[0].Fingerprint,
+						records[0].AlarmDefinitionID, records[0].ProbableCauseID, int64(0), "hardware", records[0].ClearingType, "hardware", api.Resolved,
+					).
+					WillReturnResult(pgxmock.NewResult("INSERT", 1))
+
+				mock.ExpectCommit()
+				mock.ExpectRollback()
+
+				err := repo.WithTransaction(ctx, func(tx pgx.Tx) error {
+					return repo.UpsertAlarmEventHwRecord(ctx, tx, records, int64(0))
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+				// The records of the caller are left untouched
+				Expect(records[0].AlarmRaisedTime).NotTo(Equal(raisedTime))
+			})
+		})
+	})
+
+	Describe("ResolveStaleAlarmEventHwRecord", func() {
+		When("resolving notifications", func() {
+			It("resolves notifications of the plugin that are older than current generation ID", func() {
+				clearTime := time.Now()
+				alarmsrepo.TimeNow = func() time.Time {
+					return clearTime
+				}
+
+				// Expect transaction begin
+				mock.ExpectBegin()
+
+				mock.ExpectQuery(fmt.Sprintf("UPDATE %s SET", models.AlarmEventRecord{}.TableName())).
+					WithArgs(api.Resolved, api.CLEARED, clearTime, int64(2), "hardware", "metal3-hwplugin", api.Resolved).
+					WillReturnRows(pgxmock.NewRows([]string{"alarm_event_record_id"}).AddRow(uuid.New()))
+
+				// Expect transaction commit
+				mock.ExpectCommit()
+
+				// Expect the deferred rollback after commit
+				mock.ExpectRollback()
+
+				err := repo.WithTransaction(ctx, func(tx pgx.Tx) error {
+					return repo.ResolveStaleAlarmEventHwRecord(ctx, tx, "metal3-hwplugin", int64(2))
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+			})
+		})
+	})
+
 	Describe("GetServiceConfigurations", func() {
 		When("records exist", func() {
 			It("returns all service configurations", func() {
@@ -478,7 +858,7 @@ var _ = Describe("AlarmsRepository", func() {
 					RetentionPeriod: 5,
 				}
 				mock.ExpectQuery(fmt.Sprintf("UPDATE %s SET", models.ServiceConfiguration{}.TableName())).
-					WithArgs(s.Extensions, s.RetentionPeriod, s.ID).
+					WithArgs(s.CorrelationRules, s.Extensions, s.RetentionPeriod, s.ID).
 					WillReturnRows(
 						pgxmock.NewRows([]string{
 							"extensions", "retention_period", "id",
@@ -560,4 +940,147 @@ var _ = Describe("AlarmsRepository", func() {
 			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
 		})
 	})
+
+	Describe("ArchiveAlarmEventRecords", func() {
+		It("moves the expired resolved records to the archive in a single statement", func() {
+			mock.ExpectExec(fmt.Sprintf("WITH purged AS \\(\\s*DELETE FROM %s (.+) INSERT INTO %s",
+				models.AlarmEventRecord{}.TableName(), models.AlarmEventRecordArchive{}.TableName())).
+				WithArgs(api.Resolved, 7, 1000).
+				WillReturnResult(pgxmock.NewResult("INSERT", 3))
+
+			count, err := repo.ArchiveAlarmEventRecords(ctx, 7, 1000)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(3)))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+
+		It("returns an error if the statement fails", func() {
+			mock.ExpectExec("WITH purged AS").
+				WithArgs(api.Resolved, 7, 1000).
+				WillReturnError(fmt.Errorf("db error"))
+
+			_, err := repo.ArchiveAlarmEventRecords(ctx, 7, 1000)
+			Expect(err).To(HaveOccurred())
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("PurgeAlarmEventRecordArchive", func() {
+		It("deletes the records archived before the retention period", func() {
+			mock.ExpectExec(fmt.Sprintf("DELETE FROM %s WHERE \\(\"archived_at\" < now\\(\\) - make_interval\\(days => \\$1\\)\\)",
+				models.AlarmEventRecordArchive{}.TableName())).
+				WithArgs(30).
+				WillReturnResult(pgxmock.NewResult("DELETE", 2))
+
+			count, err := repo.PurgeAlarmEventRecordArchive(ctx, 30)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(2)))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("ReleaseMaintenanceWindow", func() {
+		It("returns the summary of the released window", func() {
+			id := uuid.New()
+			mock.ExpectQuery("SELECT (.+) FROM release_maintenance_window\\(\\$1\\)").
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id", "suppressed_alarms", "released_notifications"}).
+					AddRow(id, 12, 3))
+
+			window, err := repo.ReleaseMaintenanceWindow(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window.SuppressedAlarms).To(Equal(12))
+			Expect(window.ReleasedNotifications).To(Equal(3))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+
+		It("returns nil if the window was already released", func() {
+			id := uuid.New()
+			mock.ExpectQuery("SELECT (.+) FROM release_maintenance_window").
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id"}))
+
+			window, err := repo.ReleaseMaintenanceWindow(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window).To(BeNil())
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("DeleteMaintenanceWindow", func() {
+		table := models.MaintenanceWindow{}.TableName()
+
+		It("releases the notifications and deletes the window in a single transaction", func() {
+			id := uuid.New()
+			mock.ExpectBegin()
+			mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE", table)).
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id"}).AddRow(id))
+			mock.ExpectQuery("SELECT (.+) FROM release_maintenance_window\\(\\$1\\)").
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id", "suppressed_alarms", "released_notifications"}).
+					AddRow(id, 12, 3))
+			mock.ExpectExec(fmt.Sprintf("DELETE FROM %s", table)).
+				WithArgs(id).
+				WillReturnResult(pgxmock.NewResult("DELETE", 1))
+			mock.ExpectCommit()
+			mock.ExpectRollback()
+
+			window, err := repo.DeleteMaintenanceWindow(ctx, id)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(window.SuppressedAlarms).To(Equal(12))
+			Expect(window.ReleasedNotifications).To(Equal(3))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+
+		It("does not release anything if the window does not exist", func() {
+			id := uuid.New()
+			mock.ExpectBegin()
+			mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE", table)).
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id"}))
+			mock.ExpectRollback()
+			mock.ExpectRollback()
+
+			window, err := repo.DeleteMaintenanceWindow(ctx, id)
+			Expect(err).To(MatchError(svcutils.ErrNotFound))
+			Expect(window).To(BeNil())
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+
+		It("rolls back the release if the window can't be deleted", func() {
+			id := uuid.New()
+			mock.ExpectBegin()
+			mock.ExpectQuery(fmt.Sprintf("SELECT (.+) FROM %s WHERE", table)).
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id"}).AddRow(id))
+			mock.ExpectQuery("SELECT (.+) FROM release_maintenance_window").
+				WithArgs(id).
+				WillReturnRows(pgxmock.NewRows([]string{"maintenance_window_id"}).AddRow(id))
+			mock.ExpectExec(fmt.Sprintf("DELETE FROM %s", table)).
+				WithArgs(id).
+				WillReturnError(fmt.Errorf("db error"))
+			mock.ExpectRollback()
+			mock.ExpectRollback()
+
+			window, err := repo.DeleteMaintenanceWindow(ctx, id)
+			Expect(err).To(MatchError(ContainSubstring("db error")))
+			Expect(window).To(BeNil())
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
+
+	Describe("CloseProvisioningMaintenanceWindows", func() {
+		It("ends the windows of the upgrades that are no longer in progress", func() {
+			mock.ExpectExec(fmt.Sprintf("UPDATE %s SET \"end_time\" = GREATEST(.+) WHERE (.+)\"provisioning_request\" NOT IN \\(\\$1, \\$2\\)",
+				models.MaintenanceWindow{}.TableName())).
+				WithArgs("pr-1", "pr-2").
+				WillReturnResult(pgxmock.NewResult("UPDATE", 1))
+
+			count, err := repo.CloseProvisioningMaintenanceWindows(ctx, []string{"pr-1", "pr-2"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(count).To(Equal(int64(1)))
+			Expect(mock.ExpectationsWereMet()).NotTo(HaveOccurred())
+		})
+	})
 })
@@ -14,6 +14,7 @@ import (
 
 	uuid "github.com/google/uuid"
 	pgx "github.com/jackc/pgx/v5"
+	search "github.com/openshift-kni/oran-o2ims/internal/search"
 	models "github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	models0 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	gomock "go.uber.org/mock/gomock"
@@ -42,6 +43,51 @@ func (m *MockAlarmRepositoryInterface) EXPECT() *MockAlarmRepositoryInterfaceMoc
 	return m.recorder
 }
 
+// ArchiveAlarmEventRecords mocks base method.
+func (m *MockAlarmRepositoryInterface) ArchiveAlarmEventRecords(ctx context.Context, retentionPeriod, limit int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveAlarmEventRecords", ctx, retentionPeriod, limit)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveAlarmEventRecords indicates an expected call of ArchiveAlarmEventRecords.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) ArchiveAlarmEventRecords(ctx, retentionPeriod, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAlarmEventRecords", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ArchiveAlarmEventRecords), ctx, retentionPeriod, limit)
+}
+
+// ClearAlarmEventRecord mocks base method.
+func (m *MockAlarmRepositoryInterface) ClearAlarmEventRecord(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearAlarmEventRecord", ctx, id, record)
+	ret0, _ := ret[0].(*models.AlarmEventRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClearAlarmEventRecord indicates an expected call of ClearAlarmEventRecord.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) ClearAlarmEventRecord(ctx, id, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearAlarmEventRecord", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ClearAlarmEventRecord), ctx, id, record)
+}
+
+// CloseProvisioningMaintenanceWindows mocks base method.
+func (m *MockAlarmRepositoryInterface) CloseProvisioningMaintenanceWindows(ctx context.Context, activeProvisioningRequests []string) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseProvisioningMaintenanceWindows", ctx, activeProvisioningRequests)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseProvisioningMaintenanceWindows indicates an expected call of CloseProvisioningMaintenanceWindows.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) CloseProvisioningMaintenanceWindows(ctx, activeProvisioningRequests any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseProvisioningMaintenanceWindows", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).CloseProvisioningMaintenanceWindows), ctx, activeProvisioningRequests)
+}
+
 // CreateAlarmSubscription mocks base method.
 func (m *MockAlarmRepositoryInterface) CreateAlarmSubscription(ctx context.Context, record models.AlarmSubscription) (*models.AlarmSubscription, error) {
 	m.ctrl.T.Helper()
@@ -57,6 +103,36 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) CreateAlarmSubscription(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAlarmSubscription", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).CreateAlarmSubscription), ctx, record)
 }
 
+// CreateDeadLetterNotification mocks base method.
+func (m *MockAlarmRepositoryInterface) CreateDeadLetterNotification(ctx context.Context, record *models0.DeadLetterNotification) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterNotification", ctx, record)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadLetterNotification indicates an expected call of CreateDeadLetterNotification.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) CreateDeadLetterNotification(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterNotification", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).CreateDeadLetterNotification), ctx, record)
+}
+
+// CreateMaintenanceWindow mocks base method.
+func (m *MockAlarmRepositoryInterface) CreateMaintenanceWindow(ctx context.Context, record models.MaintenanceWindow) (*models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateMaintenanceWindow", ctx, record)
+	ret0, _ := ret[0].(*models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateMaintenanceWindow indicates an expected call of CreateMaintenanceWindow.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) CreateMaintenanceWindow(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateMaintenanceWindow", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).CreateMaintenanceWindow), ctx, record)
+}
+
 // CreateServiceConfiguration mocks base method.
 func (m *MockAlarmRepositoryInterface) CreateServiceConfiguration(ctx context.Context, defaultRetentionPeriod int) (*models.ServiceConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -101,6 +177,50 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) DeleteAlarmsDataChange(ctx,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlarmsDataChange", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).DeleteAlarmsDataChange), ctx, dataChangeId)
 }
 
+// DeleteDeadLetterNotification mocks base method.
+func (m *MockAlarmRepositoryInterface) DeleteDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadLetterNotification", ctx, deadLetterID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteDeadLetterNotification indicates an expected call of DeleteDeadLetterNotification.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) DeleteDeadLetterNotification(ctx, deadLetterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetterNotification", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).DeleteDeadLetterNotification), ctx, deadLetterID)
+}
+
+// DeleteMaintenanceWindow mocks base method.
+func (m *MockAlarmRepositoryInterface) DeleteMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteMaintenanceWindow", ctx, id)
+	ret0, _ := ret[0].(*models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteMaintenanceWindow indicates an expected call of DeleteMaintenanceWindow.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) DeleteMaintenanceWindow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteMaintenanceWindow", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).DeleteMaintenanceWindow), ctx, id)
+}
+
+// GetAlarmEventHistory mocks base method.
+func (m *MockAlarmRepositoryInterface) GetAlarmEventHistory(ctx context.Context, id uuid.UUID) ([]models.AlarmEventHistory, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlarmEventHistory", ctx, id)
+	ret0, _ := ret[0].([]models.AlarmEventHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAlarmEventHistory indicates an expected call of GetAlarmEventHistory.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetAlarmEventHistory(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmEventHistory", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetAlarmEventHistory), ctx, id)
+}
+
 // GetAlarmEventRecord mocks base method.
 func (m *MockAlarmRepositoryInterface) GetAlarmEventRecord(ctx context.Context, id uuid.UUID) (*models.AlarmEventRecord, error) {
 	m.ctrl.T.Helper()
@@ -117,18 +237,18 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetAlarmEventRecord(ctx, id
 }
 
 // GetAlarmEventRecords mocks base method.
-func (m *MockAlarmRepositoryInterface) GetAlarmEventRecords(ctx context.Context) ([]models.AlarmEventRecord, error) {
+func (m *MockAlarmRepositoryInterface) GetAlarmEventRecords(ctx context.Context, selector *search.Selector, nodeClusterID *uuid.UUID, marker *models.AlarmEventRecordMarker, limit int) ([]models.AlarmEventRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAlarmEventRecords", ctx)
+	ret := m.ctrl.Call(m, "GetAlarmEventRecords", ctx, selector, nodeClusterID, marker, limit)
 	ret0, _ := ret[0].([]models.AlarmEventRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAlarmEventRecords indicates an expected call of GetAlarmEventRecords.
-func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetAlarmEventRecords(ctx any) *gomock.Call {
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetAlarmEventRecords(ctx, selector, nodeClusterID, marker, limit any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmEventRecords", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetAlarmEventRecords), ctx)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmEventRecords", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetAlarmEventRecords), ctx, selector, nodeClusterID, marker, limit)
 }
 
 // GetAlarmSubscription mocks base method.
@@ -176,6 +296,96 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetAllAlarmsDataChange(ctx a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllAlarmsDataChange", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetAllAlarmsDataChange), ctx)
 }
 
+// GetChildAlarmEventRecordIDs mocks base method.
+func (m *MockAlarmRepositoryInterface) GetChildAlarmEventRecordIDs(ctx context.Context, ids []uuid.UUID) (map[uuid.UUID][]uuid.UUID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChildAlarmEventRecordIDs", ctx, ids)
+	ret0, _ := ret[0].(map[uuid.UUID][]uuid.UUID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChildAlarmEventRecordIDs indicates an expected call of GetChildAlarmEventRecordIDs.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetChildAlarmEventRecordIDs(ctx, ids any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChildAlarmEventRecordIDs", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetChildAlarmEventRecordIDs), ctx, ids)
+}
+
+// GetDeadLetterNotification mocks base method.
+func (m *MockAlarmRepositoryInterface) GetDeadLetterNotification(ctx context.Context, deadLetterID uuid.UUID) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterNotification", ctx, deadLetterID)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterNotification indicates an expected call of GetDeadLetterNotification.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetDeadLetterNotification(ctx, deadLetterID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterNotification", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetDeadLetterNotification), ctx, deadLetterID)
+}
+
+// GetDeadLetterNotifications mocks base method.
+func (m *MockAlarmRepositoryInterface) GetDeadLetterNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterNotifications", ctx, subscriptionID)
+	ret0, _ := ret[0].([]models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterNotifications indicates an expected call of GetDeadLetterNotifications.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetDeadLetterNotifications(ctx, subscriptionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterNotifications", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetDeadLetterNotifications), ctx, subscriptionID)
+}
+
+// GetExpiredMaintenanceWindows mocks base method.
+func (m *MockAlarmRepositoryInterface) GetExpiredMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetExpiredMaintenanceWindows", ctx)
+	ret0, _ := ret[0].([]models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetExpiredMaintenanceWindows indicates an expected call of GetExpiredMaintenanceWindows.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetExpiredMaintenanceWindows(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExpiredMaintenanceWindows", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetExpiredMaintenanceWindows), ctx)
+}
+
+// GetMaintenanceWindow mocks base method.
+func (m *MockAlarmRepositoryInterface) GetMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindow", ctx, id)
+	ret0, _ := ret[0].(*models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindow indicates an expected call of GetMaintenanceWindow.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetMaintenanceWindow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindow", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetMaintenanceWindow), ctx, id)
+}
+
+// GetMaintenanceWindows mocks base method.
+func (m *MockAlarmRepositoryInterface) GetMaintenanceWindows(ctx context.Context) ([]models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMaintenanceWindows", ctx)
+	ret0, _ := ret[0].([]models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMaintenanceWindows indicates an expected call of GetMaintenanceWindows.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetMaintenanceWindows(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMaintenanceWindows", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetMaintenanceWindows), ctx)
+}
+
 // GetServiceConfigurations mocks base method.
 func (m *MockAlarmRepositoryInterface) GetServiceConfigurations(ctx context.Context) ([]models.ServiceConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -191,6 +401,21 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) GetServiceConfigurations(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceConfigurations", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).GetServiceConfigurations), ctx)
 }
 
+// OpenProvisioningMaintenanceWindow mocks base method.
+func (m *MockAlarmRepositoryInterface) OpenProvisioningMaintenanceWindow(ctx context.Context, provisioningRequest string, nodeClusterID uuid.UUID, description string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenProvisioningMaintenanceWindow", ctx, provisioningRequest, nodeClusterID, description)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OpenProvisioningMaintenanceWindow indicates an expected call of OpenProvisioningMaintenanceWindow.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) OpenProvisioningMaintenanceWindow(ctx, provisioningRequest, nodeClusterID, description any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenProvisioningMaintenanceWindow", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).OpenProvisioningMaintenanceWindow), ctx, provisioningRequest, nodeClusterID, description)
+}
+
 // PatchAlarmEventRecordACK mocks base method.
 func (m *MockAlarmRepositoryInterface) PatchAlarmEventRecordACK(ctx context.Context, id uuid.UUID, record *models.AlarmEventRecord) (*models.AlarmEventRecord, error) {
 	m.ctrl.T.Helper()
@@ -206,6 +431,36 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) PatchAlarmEventRecordACK(ctx
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchAlarmEventRecordACK", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).PatchAlarmEventRecordACK), ctx, id, record)
 }
 
+// PurgeAlarmEventRecordArchive mocks base method.
+func (m *MockAlarmRepositoryInterface) PurgeAlarmEventRecordArchive(ctx context.Context, retentionPeriod int) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeAlarmEventRecordArchive", ctx, retentionPeriod)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeAlarmEventRecordArchive indicates an expected call of PurgeAlarmEventRecordArchive.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) PurgeAlarmEventRecordArchive(ctx, retentionPeriod any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeAlarmEventRecordArchive", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).PurgeAlarmEventRecordArchive), ctx, retentionPeriod)
+}
+
+// ReleaseMaintenanceWindow mocks base method.
+func (m *MockAlarmRepositoryInterface) ReleaseMaintenanceWindow(ctx context.Context, id uuid.UUID) (*models.MaintenanceWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseMaintenanceWindow", ctx, id)
+	ret0, _ := ret[0].(*models.MaintenanceWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseMaintenanceWindow indicates an expected call of ReleaseMaintenanceWindow.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) ReleaseMaintenanceWindow(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseMaintenanceWindow", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ReleaseMaintenanceWindow), ctx, id)
+}
+
 // ResolveStaleAlarmEventCaaSRecord mocks base method.
 func (m *MockAlarmRepositoryInterface) ResolveStaleAlarmEventCaaSRecord(ctx context.Context, tx pgx.Tx, generationID int64) error {
 	m.ctrl.T.Helper()
@@ -220,6 +475,49 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) ResolveStaleAlarmEventCaaSRe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStaleAlarmEventCaaSRecord", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ResolveStaleAlarmEventCaaSRecord), ctx, tx, generationID)
 }
 
+// ReplaceNodeClusterResources mocks base method.
+func (m *MockAlarmRepositoryInterface) ReplaceNodeClusterResources(ctx context.Context, records []models.NodeClusterResource) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNodeClusterResources", ctx, records)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplaceNodeClusterResources indicates an expected call of ReplaceNodeClusterResources.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) ReplaceNodeClusterResources(ctx, records any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNodeClusterResources", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ReplaceNodeClusterResources), ctx, records)
+}
+
+// ResolveStaleAlarmEventHwRecord mocks base method.
+func (m *MockAlarmRepositoryInterface) ResolveStaleAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, hwPluginName string, generationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveStaleAlarmEventHwRecord", ctx, tx, hwPluginName, generationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveStaleAlarmEventHwRecord indicates an expected call of ResolveStaleAlarmEventHwRecord.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) ResolveStaleAlarmEventHwRecord(ctx, tx, hwPluginName, generationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveStaleAlarmEventHwRecord", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).ResolveStaleAlarmEventHwRecord), ctx, tx, hwPluginName, generationID)
+}
+
+// UpdateDeadLetterNotification mocks base method.
+func (m *MockAlarmRepositoryInterface) UpdateDeadLetterNotification(ctx context.Context, record *models0.DeadLetterNotification) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeadLetterNotification", ctx, record)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeadLetterNotification indicates an expected call of UpdateDeadLetterNotification.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) UpdateDeadLetterNotification(ctx, record any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeadLetterNotification", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).UpdateDeadLetterNotification), ctx, record)
+}
+
 // UpdateServiceConfiguration mocks base method.
 func (m *MockAlarmRepositoryInterface) UpdateServiceConfiguration(ctx context.Context, id uuid.UUID, record *models.ServiceConfiguration) (*models.ServiceConfiguration, error) {
 	m.ctrl.T.Helper()
@@ -249,6 +547,20 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) UpdateSubscriptionEventCurso
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionEventCursor", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).UpdateSubscriptionEventCursor), ctx, subscription)
 }
 
+// UpdateSubscriptionSigningSecret mocks base method.
+func (m *MockAlarmRepositoryInterface) UpdateSubscriptionSigningSecret(ctx context.Context, subscription models.AlarmSubscription) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionSigningSecret", ctx, subscription)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateSubscriptionSigningSecret indicates an expected call of UpdateSubscriptionSigningSecret.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) UpdateSubscriptionSigningSecret(ctx, subscription any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionSigningSecret", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).UpdateSubscriptionSigningSecret), ctx, subscription)
+}
+
 // UpsertAlarmEventCaaSRecord mocks base method.
 func (m *MockAlarmRepositoryInterface) UpsertAlarmEventCaaSRecord(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord, generationID int64) error {
 	m.ctrl.T.Helper()
@@ -263,6 +575,20 @@ func (mr *MockAlarmRepositoryInterfaceMockRecorder) UpsertAlarmEventCaaSRecord(c
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlarmEventCaaSRecord", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).UpsertAlarmEventCaaSRecord), ctx, tx, records, generationID)
 }
 
+// UpsertAlarmEventHwRecord mocks base method.
+func (m *MockAlarmRepositoryInterface) UpsertAlarmEventHwRecord(ctx context.Context, tx pgx.Tx, records []models.AlarmEventRecord, generationID int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertAlarmEventHwRecord", ctx, tx, records, generationID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpsertAlarmEventHwRecord indicates an expected call of UpsertAlarmEventHwRecord.
+func (mr *MockAlarmRepositoryInterfaceMockRecorder) UpsertAlarmEventHwRecord(ctx, tx, records, generationID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertAlarmEventHwRecord", reflect.TypeOf((*MockAlarmRepositoryInterface)(nil).UpsertAlarmEventHwRecord), ctx, tx, records, generationID)
+}
+
 // WithTransaction mocks base method.
 func (m *MockAlarmRepositoryInterface) WithTransaction(ctx context.Context, fn func(pgx.Tx) error) error {
 	m.ctrl.T.Helper()
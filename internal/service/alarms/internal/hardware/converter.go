@@ -0,0 +1,127 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hardware
+
+import (
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	commonhw "github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+)
+
+// Extension keys added to every hardware alarm so that the reported fault can be traced back to its source
+const (
+	resourceIDExtension = "resourceId"
+	vendorExtension     = "vendor"
+	modelExtension      = "model"
+)
+
+// ConvertHwToAlarmEventRecordModels get alarmEventRecords based on the hardware plugin notification and the hardware
+// alarm definitions.  The object identifiers are derived the same way as the resource server does so that alarms point
+// at the resources and resource types exposed through the inventory API.
+func ConvertHwToAlarmEventRecordModels(cloudID uuid.UUID, hwPluginName string, alerts []api.HardwareAlertEvent) []models.AlarmEventRecord {
+	records := make([]models.AlarmEventRecord, 0, len(alerts))
+	for _, alert := range alerts {
+		// Validate the mandatory fields.  The schema enforces them but an empty value is still possible.
+		if alert.StartsAt.IsZero() || alert.Fingerprint == "" || alert.ResourceId == "" || alert.AlarmName == "" {
+			slog.Error("Hardware alert is missing mandatory fields, skipping.", "hwPluginName", hwPluginName, "alert", alert)
+			continue
+		}
+
+		record := models.AlarmEventRecord{
+			AlarmRaisedTime: alert.StartsAt,
+			AlarmStatus:     string(alert.Status),
+			Fingerprint:     alert.Fingerprint,
+			AlarmSource:     models.AlarmSourceHardware,
+			ClearingType:    string(common.AUTOMATIC),
+		}
+
+		// Make sure the current payload has the right severity
+		if alert.Status == api.Resolved {
+			record.PerceivedSeverity = api.CLEARED
+			if alert.EndsAt != nil && !alert.EndsAt.IsZero() {
+				record.AlarmClearedTime = alert.EndsAt
+			} else {
+				now := time.Now().UTC()
+				record.AlarmClearedTime = &now
+			}
+		} else {
+			record.PerceivedSeverity = severityToPerceivedSeverity(alert.Severity)
+		}
+
+		record.Extensions = getExtensions(hwPluginName, alert)
+
+		// for hardware alerts the object is the resource
+		objectID := commonhw.MakeResourceID(cloudID, hwPluginName, alert.ResourceId)
+		record.ObjectID = &objectID
+
+		// The resource type can only be derived if the plugin knows about the vendor and model of the hardware
+		if alert.Vendor != nil && alert.Model != nil {
+			objectTypeID := commonhw.MakeResourceTypeID(cloudID, hwPluginName, *alert.Vendor, *alert.Model)
+			record.ObjectTypeID = &objectTypeID
+
+			if definition, found := commonhw.FindAlarmDefinition(alert.AlarmName, alert.Severity); found {
+				alarmDefinitionID := commonhw.MakeAlarmDefinitionID(objectTypeID, alert.AlarmName, alert.Severity)
+				record.AlarmDefinitionID = &alarmDefinitionID
+				record.ClearingType = string(definition.ClearingType)
+			} else {
+				slog.Warn("Could not find hardware alarm definition", "name", alert.AlarmName, "severity", alert.Severity)
+			}
+		} else {
+			slog.Warn("Hardware alert has no vendor or model, the alarm definition cannot be derived",
+				"hwPluginName", hwPluginName, "resourceId", alert.ResourceId, "name", alert.AlarmName)
+		}
+
+		// Anything else that's not mentioned explicitly will be handled by DB such ID generation and default values as needed.
+		records = append(records, record)
+	}
+
+	slog.Info("Converted hardware alerts", "hwPluginName", hwPluginName, "records", len(records))
+	return records
+}
+
+// severityToPerceivedSeverity maps the severity reported by a hardware plugin to oran's PerceivedSeverity
+func severityToPerceivedSeverity(input string) api.PerceivedSeverity {
+	switch input {
+	case "critical":
+		return api.CRITICAL
+	case "major":
+		return api.MAJOR
+	case "minor":
+		return api.MINOR
+	case "warning":
+		return api.WARNING
+	default:
+		return api.INDETERMINATE
+	}
+}
+
+// getExtensions builds the oran extensions of a hardware alarm
+func getExtensions(hwPluginName string, alert api.HardwareAlertEvent) map[string]string {
+	result := make(map[string]string)
+	if alert.Extensions != nil {
+		maps.Copy(result, *alert.Extensions)
+	}
+
+	// Keep the source of the alarm last so that it can't be overridden by the plugin
+	result[models.HwPluginNameExtension] = hwPluginName
+	result[resourceIDExtension] = alert.ResourceId
+	if alert.Vendor != nil {
+		result[vendorExtension] = *alert.Vendor
+	}
+	if alert.Model != nil {
+		result[modelExtension] = *alert.Model
+	}
+
+	return result
+}
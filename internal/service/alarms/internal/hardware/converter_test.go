@@ -0,0 +1,170 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hardware_test
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/hardware"
+	commonhw "github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+)
+
+var _ = Describe("ConvertHwToAlarmEventRecordModels", func() {
+	const hwPluginName = "metal3-hwplugin"
+
+	var (
+		cloudID uuid.UUID
+		now     time.Time
+		vendor  string
+		model   string
+	)
+
+	BeforeEach(func() {
+		cloudID = uuid.New()
+		now = time.Now().UTC()
+		vendor = "Dell Inc."
+		model = "PowerEdge R640"
+	})
+
+	It("should convert a firing alert with a known definition", func() {
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:   commonhw.AlarmPowerManagementError,
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "critical",
+				StartsAt:    now,
+				Status:      api.Firing,
+				Vendor:      &vendor,
+				Model:       &model,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(HaveLen(1))
+
+		record := records[0]
+		objectID := commonhw.MakeResourceID(cloudID, hwPluginName, "ns/host-1")
+		objectTypeID := commonhw.MakeResourceTypeID(cloudID, hwPluginName, vendor, model)
+		definitionID := commonhw.MakeAlarmDefinitionID(objectTypeID, commonhw.AlarmPowerManagementError, "critical")
+		Expect(record.AlarmRaisedTime).To(Equal(now))
+		Expect(record.AlarmStatus).To(Equal(string(api.Firing)))
+		Expect(record.PerceivedSeverity).To(Equal(api.CRITICAL))
+		Expect(record.AlarmSource).To(Equal(models.AlarmSourceHardware))
+		Expect(record.AlarmClearedTime).To(BeNil())
+		Expect(*record.ObjectID).To(Equal(objectID))
+		Expect(*record.ObjectTypeID).To(Equal(objectTypeID))
+		Expect(*record.AlarmDefinitionID).To(Equal(definitionID))
+		Expect(record.Extensions).To(HaveKeyWithValue(models.HwPluginNameExtension, hwPluginName))
+		Expect(record.Extensions).To(HaveKeyWithValue("resourceId", "ns/host-1"))
+	})
+
+	It("should clear a resolved alert", func() {
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:   commonhw.AlarmInspectionError,
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "major",
+				StartsAt:    now.Add(-time.Hour),
+				EndsAt:      &now,
+				Status:      api.Resolved,
+				Vendor:      &vendor,
+				Model:       &model,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].PerceivedSeverity).To(Equal(api.CLEARED))
+		Expect(records[0].AlarmClearedTime).To(Equal(&now))
+	})
+
+	It("should leave the definition unset for unknown faults", func() {
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:   "FanFailure",
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "warning",
+				StartsAt:    now,
+				Status:      api.Firing,
+				Vendor:      &vendor,
+				Model:       &model,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].PerceivedSeverity).To(Equal(api.WARNING))
+		Expect(records[0].ObjectTypeID).NotTo(BeNil())
+		Expect(records[0].AlarmDefinitionID).To(BeNil())
+	})
+
+	It("should leave the object type unset without vendor and model", func() {
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:   commonhw.AlarmInspectionError,
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "major",
+				StartsAt:    now,
+				Status:      api.Firing,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].ObjectID).NotTo(BeNil())
+		Expect(records[0].ObjectTypeID).To(BeNil())
+		Expect(records[0].AlarmDefinitionID).To(BeNil())
+	})
+
+	It("should not let the plugin override the source extensions", func() {
+		extensions := map[string]string{
+			models.HwPluginNameExtension: "other",
+			"bmc":                        "redfish://10.0.0.1",
+		}
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:   commonhw.AlarmInspectionError,
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "major",
+				StartsAt:    now,
+				Status:      api.Firing,
+				Extensions:  &extensions,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(HaveLen(1))
+		Expect(records[0].Extensions).To(HaveKeyWithValue(models.HwPluginNameExtension, hwPluginName))
+		Expect(records[0].Extensions).To(HaveKeyWithValue("bmc", "redfish://10.0.0.1"))
+	})
+
+	It("should skip alerts missing mandatory fields", func() {
+		alerts := []api.HardwareAlertEvent{
+			{
+				AlarmName:  commonhw.AlarmInspectionError,
+				ResourceId: "ns/host-1",
+				Severity:   "major",
+				StartsAt:   now,
+				Status:     api.Firing,
+			},
+		}
+
+		records := hardware.ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts)
+		Expect(records).To(BeEmpty())
+	})
+})
@@ -0,0 +1,63 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hardware
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+)
+
+// HandleAlerts can be called when a payload from a hardware plugin is received on `/hardware-alerts/{hwVendorName}`
+func HandleAlerts(ctx context.Context, repository repo.AlarmRepositoryInterface, cloudID uuid.UUID, hwPluginName string, alerts *api.HardwareAlert) error {
+	// Handle nil alerts
+	if alerts == nil {
+		return nil
+	}
+
+	complete := alerts.Complete != nil && *alerts.Complete
+
+	// Nothing to do unless the plugin tells us that none of its faults are active anymore
+	if len(alerts.Alerts) == 0 && !complete {
+		return nil
+	}
+
+	// Combine possible definitions with events
+	aerModels := ConvertHwToAlarmEventRecordModels(cloudID, hwPluginName, alerts.Alerts)
+
+	// Insert and update AlarmEventRecord and optionally resolve stale
+	if err := repository.WithTransaction(ctx, func(tx pgx.Tx) error {
+		// genID to determine if stale
+		generationID := time.Now().UnixNano()
+
+		// Insert or update with alerts
+		if err := repository.UpsertAlarmEventHwRecord(ctx, tx, aerModels, generationID); err != nil {
+			return fmt.Errorf("failed to upsert hardware alarm event record model: %w", err)
+		}
+
+		// Resolve stale only if the plugin sent the full set of its active faults
+		if complete {
+			if err := repository.ResolveStaleAlarmEventHwRecord(ctx, tx, hwPluginName, generationID); err != nil {
+				return fmt.Errorf("could not resolve stale hardware notification: %w", err)
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("failed to handle hardware alerts from %s: %w", hwPluginName, err)
+	}
+
+	slog.Info("Successfully handled hardware AlarmEventRecords", "hwPluginName", hwPluginName)
+	return nil
+}
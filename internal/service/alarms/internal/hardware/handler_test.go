@@ -0,0 +1,102 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hardware_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/hardware"
+)
+
+var _ = Describe("HandleAlerts", func() {
+	const hwPluginName = "metal3-hwplugin"
+
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		mockRepo *generated.MockAlarmRepositoryInterface
+		cloudID  uuid.UUID
+		alerts   []api.HardwareAlertEvent
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = generated.NewMockAlarmRepositoryInterface(ctrl)
+		cloudID = uuid.New()
+		alerts = []api.HardwareAlertEvent{
+			{
+				AlarmName:   "InspectionError",
+				Fingerprint: "fp-1",
+				ResourceId:  "ns/host-1",
+				Severity:    "major",
+				StartsAt:    time.Now(),
+				Status:      api.Firing,
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectTransaction := func() {
+		mockRepo.EXPECT().
+			WithTransaction(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(ctx context.Context, fn func(tx pgx.Tx) error) error {
+				return fn(nil)
+			}).Times(1)
+	}
+
+	It("should do nothing for a nil payload", func() {
+		Expect(hardware.HandleAlerts(ctx, mockRepo, cloudID, hwPluginName, nil)).To(Succeed())
+	})
+
+	It("should upsert without resolving stale alarms for partial payloads", func() {
+		expectTransaction()
+		mockRepo.EXPECT().
+			UpsertAlarmEventHwRecord(gomock.Any(), gomock.Any(), gomock.Len(1), gomock.Any()).
+			Return(nil).Times(1)
+
+		Expect(hardware.HandleAlerts(ctx, mockRepo, cloudID, hwPluginName, &api.HardwareAlert{Alerts: alerts})).To(Succeed())
+	})
+
+	It("should resolve stale alarms of the plugin for complete payloads", func() {
+		complete := true
+		expectTransaction()
+		mockRepo.EXPECT().
+			UpsertAlarmEventHwRecord(gomock.Any(), gomock.Any(), gomock.Len(0), gomock.Any()).
+			Return(nil).Times(1)
+		mockRepo.EXPECT().
+			ResolveStaleAlarmEventHwRecord(gomock.Any(), gomock.Any(), hwPluginName, gomock.Any()).
+			Return(nil).Times(1)
+
+		Expect(hardware.HandleAlerts(ctx, mockRepo, cloudID, hwPluginName,
+			&api.HardwareAlert{Alerts: []api.HardwareAlertEvent{}, Complete: &complete})).To(Succeed())
+	})
+
+	It("should return an error if the upsert fails", func() {
+		expectTransaction()
+		mockRepo.EXPECT().
+			UpsertAlarmEventHwRecord(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(errors.New("db error")).Times(1)
+
+		err := hardware.HandleAlerts(ctx, mockRepo, cloudID, hwPluginName, &api.HardwareAlert{Alerts: alerts})
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("db error"))
+	})
+})
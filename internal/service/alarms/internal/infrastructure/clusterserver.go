@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
 	"os"
 	"sync"
@@ -21,6 +22,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure/clusterserver/generated"
+	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients"
 )
 
@@ -33,12 +35,16 @@ const (
 
 type NodeCluster = generated.NodeCluster
 type NodeClusterType = generated.NodeClusterType
+type ClusterResource = generated.ClusterResource
 
 type ClusterServer struct {
 	client                               *generated.ClientWithResponses
 	nodeClusterIDToNodeClusterTypeID     map[uuid.UUID]uuid.UUID
 	nodeClusterTypeIDToAlarmDictionaryID map[uuid.UUID]uuid.UUID
 	alarmDictionaryIDToAlarmDefinitions  map[uuid.UUID]AlarmDefinition
+	alarmDefinitionIDToClearingType      map[uuid.UUID]commonapi.AlarmDefinitionClearingType
+	// resourceIDToNodeClusterID maps the hardware resources to their node cluster.  It is nil until the first sync.
+	resourceIDToNodeClusterID map[uuid.UUID]uuid.UUID
 
 	sync.Mutex
 }
@@ -90,6 +96,7 @@ func (r *ClusterServer) Setup() error {
 	r.nodeClusterIDToNodeClusterTypeID = make(map[uuid.UUID]uuid.UUID)
 	r.nodeClusterTypeIDToAlarmDictionaryID = make(map[uuid.UUID]uuid.UUID)
 	r.alarmDictionaryIDToAlarmDefinitions = make(map[uuid.UUID]AlarmDefinition)
+	r.alarmDefinitionIDToClearingType = make(map[uuid.UUID]commonapi.AlarmDefinitionClearingType)
 
 	return nil
 }
@@ -116,12 +123,23 @@ func (r *ClusterServer) FetchAll(ctx context.Context) error {
 		return fmt.Errorf("failed to get alarm dictionaries: %w", err)
 	}
 
+	// List cluster resources
+	clusterResources, err := r.getClusterResources(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get cluster resources: %w", err)
+	}
+
 	r.Lock()
 	defer r.Unlock()
 
 	r.nodeClusterIDToNodeClusterTypeID = r.buildNodeClusterIDToNodeClusterTypeID(nodeClusters)
+	r.resourceIDToNodeClusterID = r.buildResourceIDToNodeClusterID(nodeClusters, clusterResources)
 	r.nodeClusterTypeIDToAlarmDictionaryID = r.buildNodeClusterTypeIDToAlarmDictionaryID(nodeClusterTypes)
 	r.alarmDictionaryIDToAlarmDefinitions = r.buildAlarmDictionaryIDToAlarmDefinitions(alarmDictionaries)
+	r.alarmDefinitionIDToClearingType = make(map[uuid.UUID]commonapi.AlarmDefinitionClearingType)
+	for _, dictionary := range alarmDictionaries {
+		r.cacheClearingTypes(dictionary)
+	}
 
 	slog.Info("Successfully synced ClusterServer objects")
 	return nil
@@ -190,6 +208,7 @@ func (r *ClusterServer) GetAlarmDefinitionID(ctx context.Context, nodeClusterTyp
 		definitionsResynced = true
 		alarmDefinitions = getAlarmDefinitionsFromAlarmDictionary(alarmDictionary)
 		r.alarmDictionaryIDToAlarmDefinitions[alarmDictionaryID] = alarmDefinitions
+		r.cacheClearingTypes(alarmDictionary)
 		slog.Info("Mapping alarm dictionary ID to alarm definitions", "alarmDictionaryID", alarmDictionaryID)
 	}
 
@@ -211,6 +230,7 @@ func (r *ClusterServer) GetAlarmDefinitionID(ctx context.Context, nodeClusterTyp
 
 			alarmDefinitions = getAlarmDefinitionsFromAlarmDictionary(alarmDictionary)
 			r.alarmDictionaryIDToAlarmDefinitions[alarmDictionaryID] = alarmDefinitions
+			r.cacheClearingTypes(alarmDictionary)
 			slog.Info("Mapping alarm dictionary ID to alarm definitions", "alarmDictionaryID", alarmDictionaryID)
 
 			alarmDefinitionID, ok = alarmDefinitions[uniqueAlarmDefinitionIdentifier]
@@ -225,6 +245,32 @@ func (r *ClusterServer) GetAlarmDefinitionID(ctx context.Context, nodeClusterTyp
 	return alarmDefinitionID, nil
 }
 
+// GetAlarmDefinitionClearingType gets the clearing type of an alarm definition from the cache.  The cache is populated
+// by GetAlarmDefinitionID, and AUTOMATIC is returned for unknown alarm definitions.
+func (r *ClusterServer) GetAlarmDefinitionClearingType(alarmDefinitionID uuid.UUID) commonapi.AlarmDefinitionClearingType {
+	r.Lock()
+	defer r.Unlock()
+
+	clearingType, ok := r.alarmDefinitionIDToClearingType[alarmDefinitionID]
+	if !ok {
+		return commonapi.AUTOMATIC
+	}
+
+	return clearingType
+}
+
+// GetNodeClusterResources returns a copy of the mapping of hardware resource ID to node cluster ID, or nil if the
+// cluster server objects were not synced yet
+func (r *ClusterServer) GetNodeClusterResources() map[uuid.UUID]uuid.UUID {
+	r.Lock()
+	defer r.Unlock()
+
+	if r.resourceIDToNodeClusterID == nil {
+		return nil
+	}
+	return maps.Clone(r.resourceIDToNodeClusterID)
+}
+
 // Sync starts the sync process for the cluster server objects
 func (r *ClusterServer) Sync(ctx context.Context) {
 	slog.Info("Starting sync process for cluster server objects")
@@ -326,6 +372,24 @@ func (r *ClusterServer) getNodeCluster(ctx context.Context, nodeClusterID uuid.U
 	return *resp.JSON200, nil
 }
 
+// getClusterResources lists all cluster resources
+func (r *ClusterServer) getClusterResources(ctx context.Context) ([]ClusterResource, error) {
+	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.ListRequestTimeout)
+	defer cancel()
+
+	resp, err := r.client.GetClusterResourcesWithResponse(ctxWithTimeout, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute Get operation: %w", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("status code different from 200 OK: %s", resp.Status())
+	}
+
+	slog.Info("Got cluster resources", "count", len(*resp.JSON200))
+	return *resp.JSON200, nil
+}
+
 // getNodeClusterTypes lists all node cluster types
 func (r *ClusterServer) getNodeClusterTypes(ctx context.Context) ([]NodeClusterType, error) {
 	ctxWithTimeout, cancel := context.WithTimeout(ctx, clients.ListRequestTimeout)
@@ -413,6 +477,28 @@ func (r *ClusterServer) buildNodeClusterIDToNodeClusterTypeID(nodeClusters []Nod
 	return mapping
 }
 
+// buildResourceIDToNodeClusterID builds the mapping of hardware resource ID to node cluster ID.  Each cluster resource
+// is a node of a node cluster and refers to the hardware resource it runs on.
+func (r *ClusterServer) buildResourceIDToNodeClusterID(nodeClusters []NodeCluster, clusterResources []ClusterResource) map[uuid.UUID]uuid.UUID {
+	clusterResourceIDToResourceID := make(map[uuid.UUID]uuid.UUID)
+	for _, clusterResource := range clusterResources {
+		if clusterResource.ResourceId != uuid.Nil {
+			clusterResourceIDToResourceID[clusterResource.ClusterResourceId] = clusterResource.ResourceId
+		}
+	}
+
+	mapping := make(map[uuid.UUID]uuid.UUID)
+	for _, nodeCluster := range nodeClusters {
+		for _, clusterResourceID := range nodeCluster.ClusterResourceIds {
+			if resourceID, found := clusterResourceIDToResourceID[clusterResourceID]; found {
+				mapping[resourceID] = nodeCluster.NodeClusterId
+			}
+		}
+	}
+
+	return mapping
+}
+
 // buildNodeClusterTypeIDToAlarmDictionaryID builds the mapping of node cluster type ID to alarm dictionary ID
 func (r *ClusterServer) buildNodeClusterTypeIDToAlarmDictionaryID(nodeClusterTypes []NodeClusterType) map[uuid.UUID]uuid.UUID {
 	mapping := make(map[uuid.UUID]uuid.UUID)
@@ -487,3 +573,15 @@ func getAlarmDefinitionsFromAlarmDictionary(dictionary AlarmDictionary) AlarmDef
 	slog.Debug("Got alarm definitions", "count", len(alarmDefinitions), "alarmDictionaryID", dictionary.AlarmDictionaryId)
 	return alarmDefinitions
 }
+
+// cacheClearingTypes records the clearing type of each alarm definition of an alarm dictionary.  The caller must hold
+// the lock.
+func (r *ClusterServer) cacheClearingTypes(dictionary AlarmDictionary) {
+	if r.alarmDefinitionIDToClearingType == nil {
+		r.alarmDefinitionIDToClearingType = make(map[uuid.UUID]commonapi.AlarmDefinitionClearingType)
+	}
+
+	for _, definition := range dictionary.AlarmDefinition {
+		r.alarmDefinitionIDToClearingType[definition.AlarmDefinitionId] = definition.ClearingType
+	}
+}
@@ -85,10 +85,12 @@ var _ = Describe("ClusterServer", func() {
 				},
 			}
 
+			clusterResource := generated.ClusterResource{ClusterResourceId: uuid.New(), ResourceId: uuid.New()}
 			nodeClusters := []generated.NodeCluster{
 				{
-					NodeClusterId:     firstAssociation.nodeClusterID,
-					NodeClusterTypeId: firstAssociation.nodeClusterTypeID,
+					NodeClusterId:      firstAssociation.nodeClusterID,
+					NodeClusterTypeId:  firstAssociation.nodeClusterTypeID,
+					ClusterResourceIds: []uuid.UUID{clusterResource.ClusterResourceId},
 				},
 				{
 					NodeClusterId:     secondAssociation.nodeClusterID,
@@ -179,9 +181,26 @@ var _ = Describe("ClusterServer", func() {
 					Body:       io.NopCloser(bytes.NewReader(body)),
 				}, nil)
 
+			// A node of a cluster that is not known yet is ignored
+			body, err = json.Marshal([]generated.ClusterResource{clusterResource, {ClusterResourceId: uuid.New(), ResourceId: uuid.New()}})
+			Expect(err).To(BeNil())
+
+			mockRepo.EXPECT().GetClusterResources(gomock.Any(), nil).Return(
+				&http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(bytes.NewReader(body)),
+				}, nil)
+
+			Expect(clusterServer.GetNodeClusterResources()).To(BeNil())
+
 			err = clusterServer.FetchAll(ctx)
 			Expect(err).To(BeNil())
 
+			Expect(clusterServer.GetNodeClusterResources()).To(Equal(map[uuid.UUID]uuid.UUID{
+				clusterResource.ResourceId: firstAssociation.nodeClusterID,
+			}))
+
 			Expect(clusterServer.nodeClusterIDToNodeClusterTypeID).To(HaveLen(2))
 			Expect(clusterServer.nodeClusterTypeIDToAlarmDictionaryID).To(HaveLen(2))
 			Expect(clusterServer.alarmDictionaryIDToAlarmDefinitions).To(HaveLen(2))
@@ -478,4 +497,43 @@ var _ = Describe("ClusterServer", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("GetAlarmDefinitionClearingType", func() {
+		It("should return the clearing type of the alarm definitions fetched from the server", func() {
+			nodeClusterTypeID := uuid.New()
+			alarmDictionaryID := uuid.New()
+			alarmDefinitionID := uuid.New()
+
+			clusterServer.nodeClusterTypeIDToAlarmDictionaryID = map[uuid.UUID]uuid.UUID{nodeClusterTypeID: alarmDictionaryID}
+			clusterServer.alarmDictionaryIDToAlarmDefinitions = make(map[uuid.UUID]AlarmDefinition)
+
+			alarmDictionary := commonsgenerated.AlarmDictionary{
+				AlarmDictionaryId: alarmDictionaryID,
+				AlarmDefinition: []commonsgenerated.AlarmDefinition{
+					{
+						AlarmDefinitionId: alarmDefinitionID,
+						AlarmName:         "alarm1",
+						ClearingType:      commonsgenerated.MANUAL,
+						AlarmAdditionalFields: &map[string]interface{}{
+							"severity": "critical",
+						},
+					},
+				},
+			}
+			body, err := json.Marshal(alarmDictionary)
+			Expect(err).To(BeNil())
+
+			mockRepo.EXPECT().GetAlarmDictionary(gomock.Any(), alarmDictionaryID).Return(
+				&http.Response{
+					StatusCode: http.StatusOK,
+					Header:     http.Header{"Content-Type": []string{"application/json"}},
+					Body:       io.NopCloser(bytes.NewReader(body)),
+				}, nil)
+
+			id, err := clusterServer.GetAlarmDefinitionID(context.Background(), nodeClusterTypeID, "alarm1", "critical")
+			Expect(err).To(BeNil())
+			Expect(clusterServer.GetAlarmDefinitionClearingType(id)).To(Equal(commonsgenerated.MANUAL))
+			Expect(clusterServer.GetAlarmDefinitionClearingType(uuid.New())).To(Equal(commonsgenerated.AUTOMATIC))
+		})
+	})
 })
@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	generated0 "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -53,6 +54,20 @@ func (mr *MockClientMockRecorder) FetchAll(arg0 any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FetchAll", reflect.TypeOf((*MockClient)(nil).FetchAll), arg0)
 }
 
+// GetAlarmDefinitionClearingType mocks base method.
+func (m *MockClient) GetAlarmDefinitionClearingType(alarmDefinitionID uuid.UUID) generated0.AlarmDefinitionClearingType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAlarmDefinitionClearingType", alarmDefinitionID)
+	ret0, _ := ret[0].(generated0.AlarmDefinitionClearingType)
+	return ret0
+}
+
+// GetAlarmDefinitionClearingType indicates an expected call of GetAlarmDefinitionClearingType.
+func (mr *MockClientMockRecorder) GetAlarmDefinitionClearingType(alarmDefinitionID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlarmDefinitionClearingType", reflect.TypeOf((*MockClient)(nil).GetAlarmDefinitionClearingType), alarmDefinitionID)
+}
+
 // GetAlarmDefinitionID mocks base method.
 func (m *MockClient) GetAlarmDefinitionID(ctx context.Context, ObjectTypeID uuid.UUID, name, severity string) (uuid.UUID, error) {
 	m.ctrl.T.Helper()
@@ -29,6 +29,8 @@ type Client interface {
 
 	GetObjectTypeID(ctx context.Context, objectID uuid.UUID) (uuid.UUID, error)
 	GetAlarmDefinitionID(ctx context.Context, ObjectTypeID uuid.UUID, name, severity string) (uuid.UUID, error)
+	// GetAlarmDefinitionClearingType returns the clearing type of an alarm definition returned by GetAlarmDefinitionID
+	GetAlarmDefinitionClearingType(alarmDefinitionID uuid.UUID) generated.AlarmDefinitionClearingType
 
 	// Sync starts a background process to populate and keep up-to-date a local cache with data from the infrastructure servers
 	Sync(ctx context.Context)
@@ -0,0 +1,220 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"maps"
+	"time"
+
+	"github.com/google/uuid"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+)
+
+// DefaultInterval is the time between two executions of the maintenance job.  It bounds the delay with which windows
+// are opened for upgrades, and with which the notifications held back by a window are released once it has ended.
+const DefaultInterval = time.Minute
+
+// NodeClusterResourceSource provides the node cluster of each hardware resource
+type NodeClusterResourceSource interface {
+	// GetNodeClusterResources returns the mapping of hardware resource ID to node cluster ID, or nil if it is not
+	// known yet
+	GetNodeClusterResources() map[uuid.UUID]uuid.UUID
+}
+
+// Job manages the lifecycle of maintenance windows.  A window is opened for the node cluster of each
+// ProvisioningRequest whose upgrade is in progress and ended once the upgrade is no longer in progress.  The
+// notifications held back by the windows that have ended are then released.  The job also keeps the node cluster of
+// each hardware resource up to date in the database so that the windows cover the hardware alarms of their cluster.
+type Job struct {
+	repository repo.AlarmRepositoryInterface
+	hubClient  client.Client
+	resources  NodeClusterResourceSource
+	interval   time.Duration
+	// syncedResources is the mapping last written to the database
+	syncedResources map[uuid.UUID]uuid.UUID
+}
+
+// NewJob creates a maintenance job running every interval.  The node cluster of the hardware resources is not
+// synchronized if resources is nil.
+func NewJob(repository repo.AlarmRepositoryInterface, hubClient client.Client, resources NodeClusterResourceSource,
+	interval time.Duration) *Job {
+	return &Job{
+		repository: repository,
+		hubClient:  hubClient,
+		resources:  resources,
+		interval:   interval,
+	}
+}
+
+// Run executes the job immediately and then every interval until the context is canceled.
+func (j *Job) Run(ctx context.Context) {
+	slog.Info("Maintenance window job started", "interval", j.interval.String())
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.RunOnce(ctx); err != nil {
+			slog.Error("Maintenance window job failed", "error", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Maintenance window job stopped")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// RunOnce synchronizes the windows opened for upgrades and releases the windows that have ended.  Windows are released
+// even if the synchronization fails so that a hub API outage does not hold notifications back indefinitely.
+func (j *Job) RunOnce(ctx context.Context) error {
+	if err := j.syncNodeClusterResources(ctx); err != nil {
+		// The previous mapping stays in effect
+		slog.Error("Failed to synchronize the node cluster of the hardware resources", "error", err)
+	}
+
+	syncErr := j.syncProvisioningRequests(ctx)
+	if syncErr != nil {
+		slog.Error("Failed to synchronize upgrade maintenance windows", "error", syncErr)
+	}
+
+	windows, err := j.repository.GetExpiredMaintenanceWindows(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get expired maintenance windows: %w", err)
+	}
+
+	for _, window := range windows {
+		released, err := j.repository.ReleaseMaintenanceWindow(ctx, window.MaintenanceWindowID)
+		if err != nil {
+			return fmt.Errorf("failed to release maintenance window %s: %w", window.MaintenanceWindowID, err)
+		}
+		if released == nil {
+			// Released concurrently, e.g. by a deletion
+			continue
+		}
+		slog.Info("Maintenance window closed", "maintenanceWindowId", released.MaintenanceWindowID,
+			"nodeClusterId", released.NodeClusterID, "suppressedAlarms", released.SuppressedAlarms,
+			"releasedNotifications", released.ReleasedNotifications)
+	}
+
+	return syncErr
+}
+
+// syncNodeClusterResources writes the node cluster of each hardware resource to the database when it has changed
+func (j *Job) syncNodeClusterResources(ctx context.Context) error {
+	if j.resources == nil {
+		return nil
+	}
+
+	resources := j.resources.GetNodeClusterResources()
+	if resources == nil || (j.syncedResources != nil && maps.Equal(resources, j.syncedResources)) {
+		return nil
+	}
+
+	records := make([]models.NodeClusterResource, 0, len(resources))
+	for resourceID, nodeClusterID := range resources {
+		records = append(records, models.NodeClusterResource{ResourceID: resourceID, NodeClusterID: nodeClusterID})
+	}
+
+	if err := j.repository.ReplaceNodeClusterResources(ctx, records); err != nil {
+		return fmt.Errorf("failed to replace node cluster resources: %w", err)
+	}
+
+	j.syncedResources = resources
+	slog.Info("Synchronized the node cluster of the hardware resources", "resources", len(records))
+	return nil
+}
+
+// syncProvisioningRequests opens a window for each ProvisioningRequest whose upgrade is in progress, and ends the
+// windows of the others.
+func (j *Job) syncProvisioningRequests(ctx context.Context) error {
+	var list provisioningv1alpha1.ProvisioningRequestList
+	if err := j.hubClient.List(ctx, &list); err != nil {
+		return fmt.Errorf("failed to list provisioning requests: %w", err)
+	}
+
+	upgrading := make([]string, 0)
+	for i := range list.Items {
+		pr := &list.Items[i]
+		if !isUpgradeInProgress(pr) {
+			continue
+		}
+		// The window is kept open even if it can't be opened at this time so that a transient error does not
+		// release the notifications in the middle of the upgrade
+		upgrading = append(upgrading, pr.Name)
+
+		nodeClusterID, err := j.getNodeClusterID(ctx, pr)
+		if err != nil {
+			slog.Warn("Unable to open maintenance window for upgrade", "provisioningRequest", pr.Name, "error", err)
+			continue
+		}
+
+		description := fmt.Sprintf("Upgrade of ProvisioningRequest %s", pr.Name)
+		opened, err := j.repository.OpenProvisioningMaintenanceWindow(ctx, pr.Name, nodeClusterID, description)
+		if err != nil {
+			return fmt.Errorf("failed to open maintenance window for provisioning request %s: %w", pr.Name, err)
+		}
+		if opened {
+			slog.Info("Maintenance window opened for upgrade", "provisioningRequest", pr.Name, "nodeClusterId", nodeClusterID)
+		}
+	}
+
+	ended, err := j.repository.CloseProvisioningMaintenanceWindows(ctx, upgrading)
+	if err != nil {
+		return fmt.Errorf("failed to end upgrade maintenance windows: %w", err)
+	}
+	if ended > 0 {
+		slog.Info("Maintenance windows ended for completed upgrades", "count", ended)
+	}
+
+	return nil
+}
+
+// isUpgradeInProgress returns true if the provisioning controller reports that the cluster of a ProvisioningRequest is
+// being upgraded
+func isUpgradeInProgress(pr *provisioningv1alpha1.ProvisioningRequest) bool {
+	condition := meta.FindStatusCondition(pr.Status.Conditions, string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted))
+	return condition != nil && condition.Reason == string(provisioningv1alpha1.CRconditionReasons.InProgress)
+}
+
+// getNodeClusterID returns the identifier of the node cluster provisioned by a ProvisioningRequest, which is the
+// cluster ID label of its ManagedCluster
+func (j *Job) getNodeClusterID(ctx context.Context, pr *provisioningv1alpha1.ProvisioningRequest) (uuid.UUID, error) {
+	details := pr.Status.Extensions.ClusterDetails
+	if details == nil || details.Name == "" {
+		return uuid.Nil, fmt.Errorf("cluster details not set")
+	}
+
+	var cluster clusterv1.ManagedCluster
+	if err := j.hubClient.Get(ctx, types.NamespacedName{Name: details.Name}, &cluster); err != nil {
+		return uuid.Nil, fmt.Errorf("failed to get managed cluster %s: %w", details.Name, err)
+	}
+
+	value, found := cluster.Labels[ctlrutils.ClusterIDLabelName]
+	if !found {
+		return uuid.Nil, fmt.Errorf("no '%s' label found on managed cluster %s", ctlrutils.ClusterIDLabelName, details.Name)
+	}
+
+	nodeClusterID, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to parse '%s' label value '%s': %w", ctlrutils.ClusterIDLabelName, value, err)
+	}
+
+	return nodeClusterID, nil
+}
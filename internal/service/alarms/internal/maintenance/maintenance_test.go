@@ -0,0 +1,163 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clusterv1 "open-cluster-management.io/api/cluster/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients/k8s"
+)
+
+var _ = Describe("Job", func() {
+	var (
+		ctx           context.Context
+		ctrl          *gomock.Controller
+		mockRepo      *generated.MockAlarmRepositoryInterface
+		nodeClusterID uuid.UUID
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = generated.NewMockAlarmRepositoryInterface(ctrl)
+		nodeClusterID = uuid.New()
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	newProvisioningRequest := func(name string, reason provisioningv1alpha1.ConditionReason) *provisioningv1alpha1.ProvisioningRequest {
+		pr := &provisioningv1alpha1.ProvisioningRequest{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		pr.Status.Extensions.ClusterDetails = &provisioningv1alpha1.ClusterDetails{Name: "cluster-" + name}
+		pr.Status.Conditions = []metav1.Condition{{
+			Type:   string(provisioningv1alpha1.PRconditionTypes.UpgradeCompleted),
+			Status: metav1.ConditionFalse,
+			Reason: string(reason),
+		}}
+		return pr
+	}
+
+	newManagedCluster := func(name string, id uuid.UUID) *clusterv1.ManagedCluster {
+		return &clusterv1.ManagedCluster{ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{ctlrutils.ClusterIDLabelName: id.String()},
+		}}
+	}
+
+	newJob := func(objects ...client.Object) *Job {
+		hubClient := fake.NewClientBuilder().WithScheme(k8s.GetSchemeForHub()).WithObjects(objects...).Build()
+		return NewJob(mockRepo, hubClient, nil, time.Minute)
+	}
+
+	Describe("RunOnce", func() {
+		It("opens a window for the upgrades in progress and ends the others", func() {
+			job := newJob(
+				newProvisioningRequest("upgrading", provisioningv1alpha1.CRconditionReasons.InProgress),
+				newManagedCluster("cluster-upgrading", nodeClusterID),
+				newProvisioningRequest("upgraded", provisioningv1alpha1.CRconditionReasons.Completed),
+			)
+
+			gomock.InOrder(
+				mockRepo.EXPECT().OpenProvisioningMaintenanceWindow(gomock.Any(), "upgrading", nodeClusterID, gomock.Any()).Return(true, nil),
+				mockRepo.EXPECT().CloseProvisioningMaintenanceWindows(gomock.Any(), []string{"upgrading"}).Return(int64(1), nil),
+				mockRepo.EXPECT().GetExpiredMaintenanceWindows(gomock.Any()).Return(nil, nil),
+			)
+
+			Expect(job.RunOnce(ctx)).To(Succeed())
+		})
+
+		It("keeps the window of an upgrade whose cluster can't be resolved", func() {
+			job := newJob(newProvisioningRequest("upgrading", provisioningv1alpha1.CRconditionReasons.InProgress))
+
+			gomock.InOrder(
+				mockRepo.EXPECT().CloseProvisioningMaintenanceWindows(gomock.Any(), []string{"upgrading"}).Return(int64(0), nil),
+				mockRepo.EXPECT().GetExpiredMaintenanceWindows(gomock.Any()).Return(nil, nil),
+			)
+
+			Expect(job.RunOnce(ctx)).To(Succeed())
+		})
+
+		It("releases the windows that have ended", func() {
+			job := newJob()
+			expired := models.MaintenanceWindow{MaintenanceWindowID: uuid.New()}
+			concurrent := models.MaintenanceWindow{MaintenanceWindowID: uuid.New()}
+
+			gomock.InOrder(
+				mockRepo.EXPECT().CloseProvisioningMaintenanceWindows(gomock.Any(), []string{}).Return(int64(0), nil),
+				mockRepo.EXPECT().GetExpiredMaintenanceWindows(gomock.Any()).Return([]models.MaintenanceWindow{expired, concurrent}, nil),
+				mockRepo.EXPECT().ReleaseMaintenanceWindow(gomock.Any(), expired.MaintenanceWindowID).Return(&expired, nil),
+				mockRepo.EXPECT().ReleaseMaintenanceWindow(gomock.Any(), concurrent.MaintenanceWindowID).Return(nil, nil),
+			)
+
+			Expect(job.RunOnce(ctx)).To(Succeed())
+		})
+
+		It("releases the windows that have ended even if the synchronization fails", func() {
+			job := newJob()
+			expired := models.MaintenanceWindow{MaintenanceWindowID: uuid.New()}
+
+			gomock.InOrder(
+				mockRepo.EXPECT().CloseProvisioningMaintenanceWindows(gomock.Any(), gomock.Any()).Return(int64(0), fmt.Errorf("db error")),
+				mockRepo.EXPECT().GetExpiredMaintenanceWindows(gomock.Any()).Return([]models.MaintenanceWindow{expired}, nil),
+				mockRepo.EXPECT().ReleaseMaintenanceWindow(gomock.Any(), expired.MaintenanceWindowID).Return(&expired, nil),
+			)
+
+			Expect(job.RunOnce(ctx)).To(MatchError(ContainSubstring("db error")))
+		})
+
+		It("writes the node cluster of the hardware resources when it changes", func() {
+			resourceID := uuid.New()
+			resources := &fakeNodeClusterResources{}
+			hubClient := fake.NewClientBuilder().WithScheme(k8s.GetSchemeForHub()).Build()
+			job := NewJob(mockRepo, hubClient, resources, time.Minute)
+
+			mockRepo.EXPECT().CloseProvisioningMaintenanceWindows(gomock.Any(), gomock.Any()).Return(int64(0), nil).Times(4)
+			mockRepo.EXPECT().GetExpiredMaintenanceWindows(gomock.Any()).Return(nil, nil).Times(4)
+
+			// Nothing is written until the mapping is known
+			Expect(job.RunOnce(ctx)).To(Succeed())
+
+			resources.mapping = map[uuid.UUID]uuid.UUID{resourceID: nodeClusterID}
+			mockRepo.EXPECT().ReplaceNodeClusterResources(gomock.Any(), []models.NodeClusterResource{
+				{ResourceID: resourceID, NodeClusterID: nodeClusterID},
+			}).Return(nil)
+			Expect(job.RunOnce(ctx)).To(Succeed())
+
+			// Unchanged mappings are not written again
+			Expect(job.RunOnce(ctx)).To(Succeed())
+
+			resources.mapping = map[uuid.UUID]uuid.UUID{}
+			mockRepo.EXPECT().ReplaceNodeClusterResources(gomock.Any(), []models.NodeClusterResource{}).Return(nil)
+			Expect(job.RunOnce(ctx)).To(Succeed())
+		})
+	})
+})
+
+// fakeNodeClusterResources returns a fixed mapping of hardware resource ID to node cluster ID
+type fakeNodeClusterResources struct {
+	mapping map[uuid.UUID]uuid.UUID
+}
+
+func (f *fakeNodeClusterResources) GetNodeClusterResources() map[uuid.UUID]uuid.UUID {
+	return f.mapping
+}
@@ -10,12 +10,14 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 
 	"github.com/google/uuid"
 
 	a "github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 )
 
@@ -70,3 +72,60 @@ func (n *NotificationStorageProvider) DeleteNotification(ctx context.Context, da
 
 	return nil
 }
+
+// CreateDeadLetter adds a notification that could not be delivered to the dead letter queue of a subscription
+func (n *NotificationStorageProvider) CreateDeadLetter(ctx context.Context, subscriptionID uuid.UUID, notification *notifier.Notification, reason string) error {
+	record, err := commonmodels.NotificationToDeadLetter(subscriptionID, notification, reason)
+	if err != nil {
+		return fmt.Errorf("failed to convert alarm notification: %w", err)
+	}
+
+	if _, err := n.repository.CreateDeadLetterNotification(ctx, record); err != nil {
+		return fmt.Errorf("failed to add alarm notification to dead letter queue: %w", err)
+	}
+
+	return nil
+}
+
+// GetDeadLetters return the dead letter queue of a subscription in the order it should be redelivered
+func (n *NotificationStorageProvider) GetDeadLetters(ctx context.Context, subscriptionID uuid.UUID) ([]notifier.Notification, error) {
+	records, err := n.repository.GetDeadLetterNotifications(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letter queue: %w", err)
+	}
+
+	notifications := make([]notifier.Notification, 0, len(records))
+	for _, record := range records {
+		notifications = append(notifications, *commonmodels.DeadLetterToNotification(&record))
+	}
+
+	return notifications, nil
+}
+
+// UpdateDeadLetter records a failed attempt to redeliver a notification from the dead letter queue
+func (n *NotificationStorageProvider) UpdateDeadLetter(ctx context.Context, deadLetterID uuid.UUID, reason string) error {
+	record, err := n.repository.GetDeadLetterNotification(ctx, deadLetterID)
+	if err != nil {
+		return fmt.Errorf("failed to get dead letter: %w", err)
+	}
+
+	now := time.Now()
+	record.Attempts++
+	record.LastError = reason
+	record.UpdatedAt = &now
+
+	if _, err := n.repository.UpdateDeadLetterNotification(ctx, record); err != nil {
+		return fmt.Errorf("failed to update dead letter: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteDeadLetter removes a notification from the dead letter queue once it has been redelivered
+func (n *NotificationStorageProvider) DeleteDeadLetter(ctx context.Context, deadLetterID uuid.UUID) error {
+	if err := n.repository.DeleteDeadLetterNotification(ctx, deadLetterID); err != nil {
+		return fmt.Errorf("failed to delete dead letter: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notifier_provider_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestNotifierProvider(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alarms Notifier Provider Suite")
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"log/slog"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
 	a "github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
@@ -23,14 +24,21 @@ var _ notifier.SubscriptionProvider = (*SubscriptionStorageProvider)(nil)
 // SubscriptionStorageProvider implements the SubscriptionProvider interface as a means to abstract the concrete
 // subscription type out of the Notifier
 type SubscriptionStorageProvider struct {
-	repository a.AlarmRepositoryInterface
+	repository        a.AlarmRepositoryInterface
+	selectorEvaluator *search.SelectorEvaluator
 }
 
 // NewSubscriptionStorageProvider creates a new SubscriptionStorageProvider
-func NewSubscriptionStorageProvider(repository a.AlarmRepositoryInterface) notifier.SubscriptionProvider {
-	return &SubscriptionStorageProvider{
-		repository: repository,
+func NewSubscriptionStorageProvider(repository a.AlarmRepositoryInterface) (notifier.SubscriptionProvider, error) {
+	selectorEvaluator, err := search.NewObjectSelectorEvaluator(slog.Default())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subscription evaluator: %w", err)
 	}
+
+	return &SubscriptionStorageProvider{
+		repository:        repository,
+		selectorEvaluator: selectorEvaluator,
+	}, nil
 }
 
 func (s *SubscriptionStorageProvider) GetSubscriptions(ctx context.Context) ([]notifier.SubscriptionInfo, error) {
@@ -54,12 +62,22 @@ func (s *SubscriptionStorageProvider) GetSubscriptions(ctx context.Context) ([]n
 	return subscriptions, nil
 }
 
-func (s *SubscriptionStorageProvider) Matches(subscription *notifier.SubscriptionInfo, notification *notifier.Notification) bool {
+func (s *SubscriptionStorageProvider) Matches(ctx context.Context, subscription *notifier.SubscriptionInfo, notification *notifier.Notification) bool {
 	payload, ok := notification.Payload.(generated.AlarmEventNotification)
 	if !ok {
 		slog.Warn("notification payload is not of type generated.AlarmEventNotification", "type", fmt.Sprintf("%T", notification.Payload))
 		return false
 	}
+	if criteria, ok := subscription.Criteria.(models.SubscriptionCriteria); ok {
+		if criteria.CorrelatedOnly {
+			if _, correlated := payload.Extensions[models.ParentAlarmEventRecordIDExtension]; correlated {
+				return false
+			}
+		}
+		if !s.matchesSelector(ctx, subscription, &criteria, &payload) {
+			return false
+		}
+	}
 	if subscription.Filter == nil {
 		return true
 	}
@@ -67,10 +85,31 @@ func (s *SubscriptionStorageProvider) Matches(subscription *notifier.Subscriptio
 	return models.AlarmFilterToEventType(filter) != payload.NotificationEventType
 }
 
+// matchesSelector evaluates the selector of a subscription against an alarm notification.  Alarms are not notified
+// if the selector is invalid or cannot be evaluated.
+func (s *SubscriptionStorageProvider) matchesSelector(ctx context.Context, subscription *notifier.SubscriptionInfo, criteria *models.SubscriptionCriteria,
+	payload *generated.AlarmEventNotification) bool {
+	if criteria.InvalidSelector {
+		return false
+	}
+	if criteria.Selector == nil {
+		return true
+	}
+
+	result, err := s.selectorEvaluator.Evaluate(ctx, criteria.Selector, models.ConvertNotificationToSelectorObject(payload))
+	if err != nil {
+		slog.Warn("Failed to evaluate subscription selector", "subscriptionID", subscription.SubscriptionID,
+			"selector", criteria.Selector.String(), "error", err)
+		return false
+	}
+	return result
+}
+
 func (s *SubscriptionStorageProvider) UpdateSubscription(ctx context.Context, subscription *notifier.SubscriptionInfo) error {
 	if err := s.repository.UpdateSubscriptionEventCursor(ctx, models.AlarmSubscription{
 		SubscriptionID: subscription.SubscriptionID,
 		EventCursor:    int64(subscription.EventCursor),
+		SuspendedUntil: subscription.SuspendedUntil,
 	}); err != nil {
 		return fmt.Errorf("update subscription failed for %s: %w", subscription.SubscriptionID, err)
 	}
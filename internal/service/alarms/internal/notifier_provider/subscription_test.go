@@ -0,0 +1,114 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notifier_provider_test
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	api "github.com/openshift-kni/oran-o2ims/internal/service/alarms/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/notifier_provider"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+)
+
+var _ = Describe("SubscriptionStorageProvider", func() {
+	var (
+		provider      notifier.SubscriptionProvider
+		clusterID     uuid.UUID
+		caasAlarm     api.AlarmEventNotification
+		hardwareAlarm api.AlarmEventNotification
+	)
+
+	BeforeEach(func() {
+		var err error
+		provider, err = notifier_provider.NewSubscriptionStorageProvider(nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		clusterID = uuid.New()
+		caasAlarm = api.AlarmEventNotification{
+			AlarmDefinitionID:     uuid.New(),
+			PerceivedSeverity:     api.CRITICAL,
+			ResourceID:            clusterID,
+			ResourceTypeID:        uuid.New(),
+			NotificationEventType: api.AlarmEventNotificationNotificationEventTypeNEW,
+			Extensions:            map[string]string{"namespace": "openshift-monitoring"},
+		}
+		hardwareAlarm = api.AlarmEventNotification{
+			AlarmDefinitionID:     uuid.New(),
+			PerceivedSeverity:     api.MAJOR,
+			ResourceID:            uuid.New(),
+			ResourceTypeID:        uuid.New(),
+			NotificationEventType: api.AlarmEventNotificationNotificationEventTypeNEW,
+			Extensions:            map[string]string{models.HwPluginNameExtension: "metal3-hwplugin"},
+		}
+	})
+
+	matches := func(selector *string, payload api.AlarmEventNotification) bool {
+		subscription := models.ConvertAlertSubToNotificationSub(&models.AlarmSubscription{
+			SubscriptionID: uuid.New(),
+			Selector:       selector,
+		})
+		return provider.Matches(context.Background(), subscription, &notifier.Notification{Payload: payload})
+	}
+
+	Describe("Matches", func() {
+		It("matches every alarm if the subscription has no selector", func() {
+			Expect(matches(nil, caasAlarm)).To(BeTrue())
+			Expect(matches(nil, hardwareAlarm)).To(BeTrue())
+		})
+
+		It("matches the critical alarms of a node cluster", func() {
+			selector := fmt.Sprintf("(eq,perceivedSeverity,%d);(eq,nodeClusterID,%s)", api.CRITICAL, clusterID)
+			Expect(matches(&selector, caasAlarm)).To(BeTrue())
+
+			caasAlarm.PerceivedSeverity = api.MINOR
+			Expect(matches(&selector, caasAlarm)).To(BeFalse())
+		})
+
+		It("does not set the node cluster of hardware alarms", func() {
+			selector := fmt.Sprintf("(eq,nodeClusterID,%s)", hardwareAlarm.ResourceID)
+			Expect(matches(&selector, hardwareAlarm)).To(BeFalse())
+
+			selector = fmt.Sprintf("(eq,resourceID,%s)", hardwareAlarm.ResourceID)
+			Expect(matches(&selector, hardwareAlarm)).To(BeTrue())
+		})
+
+		It("matches the alarm definition and resource type", func() {
+			selector := fmt.Sprintf("(in,alarmDefinitionID,%s,%s);(neq,resourceTypeID,%s)",
+				uuid.New(), caasAlarm.AlarmDefinitionID, uuid.New())
+			Expect(matches(&selector, caasAlarm)).To(BeTrue())
+			Expect(matches(&selector, hardwareAlarm)).To(BeFalse())
+		})
+
+		It("matches the extensions", func() {
+			selector := "(eq,extensions/namespace,openshift-monitoring)"
+			Expect(matches(&selector, caasAlarm)).To(BeTrue())
+			Expect(matches(&selector, hardwareAlarm)).To(BeFalse())
+		})
+
+		It("does not match any alarm if the stored selector is invalid", func() {
+			selector := "(eq,alarmRaisedTime,0)"
+			Expect(matches(&selector, caasAlarm)).To(BeFalse())
+		})
+
+		It("applies the selector together with the notification event type filter", func() {
+			selector := fmt.Sprintf("(eq,perceivedSeverity,%d)", api.CRITICAL)
+			filter := string(api.AlarmSubscriptionInfoFilterNEW)
+			subscription := models.ConvertAlertSubToNotificationSub(&models.AlarmSubscription{
+				SubscriptionID: uuid.New(),
+				Selector:       &selector,
+			})
+			subscription.Filter = &filter
+			Expect(provider.Matches(context.Background(), subscription, &notifier.Notification{Payload: caasAlarm})).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,49 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package serviceconfig
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const metricsPrefix = "o2ims_alarms_retention"
+
+var (
+	// archivedRecords counts the resolved alarm event records moved to the archive
+	archivedRecords = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: metricsPrefix + "_archived_records_total",
+		Help: "Number of resolved alarm event records moved to the archive after their retention period.",
+	})
+
+	// purgedArchiveRecords counts the records deleted from the archive
+	purgedArchiveRecords = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: metricsPrefix + "_purged_archive_records_total",
+		Help: "Number of archived alarm event records deleted after the archive retention period.",
+	})
+
+	// runs counts the executions of the retention job by result
+	runs = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: metricsPrefix + "_runs_total",
+		Help: "Number of executions of the alarm retention job by result.",
+	}, []string{"result"})
+
+	// lastSuccess records the time of the last successful execution of the retention job
+	lastSuccess = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: metricsPrefix + "_last_success_timestamp_seconds",
+		Help: "Time of the last successful execution of the alarm retention job.",
+	})
+
+	// retentionPeriod records the retention period applied by the last execution of the retention job
+	retentionPeriod = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: metricsPrefix + "_period_days",
+		Help: "Retention period of resolved alarm event records applied by the alarm retention job.",
+	})
+)
+
+func init() {
+	prometheus.MustRegister(archivedRecords, purgedArchiveRecords, runs, lastSuccess, retentionPeriod)
+}
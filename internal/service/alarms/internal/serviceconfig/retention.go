@@ -0,0 +1,127 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package serviceconfig
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
+)
+
+const (
+	// DefaultRetentionInterval is the time between two executions of the retention job
+	DefaultRetentionInterval = time.Hour
+	// DefaultArchiveRetentionPeriod is the default number of days archived records are kept before being deleted
+	DefaultArchiveRetentionPeriod = 30
+	// archiveBatchSize is the maximum number of records archived by a single statement so that a large backlog does
+	// not hold locks on alarm_event_record for a long time
+	archiveBatchSize = 1000
+)
+
+// RetentionJob enforces the retention period of the alarm service configuration.  Resolved alarm event records are
+// moved to the archive once cleared for longer than the retention period, and archived records are deleted after the
+// archive retention period.
+type RetentionJob struct {
+	repository             repo.AlarmRepositoryInterface
+	interval               time.Duration
+	archiveRetentionPeriod int
+	trigger                chan struct{}
+}
+
+// NewRetentionJob creates a retention job running every interval and keeping the archived records for
+// archiveRetentionPeriod days
+func NewRetentionJob(repository repo.AlarmRepositoryInterface, interval time.Duration,
+	archiveRetentionPeriod int) *RetentionJob {
+	return &RetentionJob{
+		repository:             repository,
+		interval:               interval,
+		archiveRetentionPeriod: archiveRetentionPeriod,
+		trigger:                make(chan struct{}, 1),
+	}
+}
+
+// Trigger requests an immediate execution of the job, e.g. because the retention period has changed.  Requests made
+// while an execution is already pending are coalesced.
+func (j *RetentionJob) Trigger() {
+	if j == nil {
+		return
+	}
+	select {
+	case j.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run executes the job immediately and then every interval, or when triggered, until the context is canceled.
+func (j *RetentionJob) Run(ctx context.Context) {
+	slog.Info("Alarm retention job started", "interval", j.interval.String())
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		if err := j.RunOnce(ctx); err != nil {
+			runs.WithLabelValues("failure").Inc()
+			slog.Error("Alarm retention job failed", "error", err)
+		} else {
+			runs.WithLabelValues("success").Inc()
+			lastSuccess.SetToCurrentTime()
+		}
+
+		select {
+		case <-ctx.Done():
+			slog.Info("Alarm retention job stopped")
+			return
+		case <-ticker.C:
+		case <-j.trigger:
+		}
+	}
+}
+
+// RunOnce archives the resolved alarm event records older than the retention period and purges the archive.
+func (j *RetentionJob) RunOnce(ctx context.Context) error {
+	configs, err := j.repository.GetServiceConfigurations(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get alarm service configuration: %w", err)
+	}
+
+	// There must always be a single record
+	if len(configs) != 1 {
+		return fmt.Errorf("expected a single alarm service configuration record, but got %d", len(configs))
+	}
+
+	period := configs[0].RetentionPeriod
+	if period <= 0 {
+		return fmt.Errorf("invalid retention period: %d", period)
+	}
+	retentionPeriod.Set(float64(period))
+
+	var archived int64
+	for {
+		count, err := j.repository.ArchiveAlarmEventRecords(ctx, period, archiveBatchSize)
+		if err != nil {
+			return fmt.Errorf("failed to archive alarm event records: %w", err)
+		}
+
+		archived += count
+		archivedRecords.Add(float64(count))
+		if count < archiveBatchSize {
+			break
+		}
+	}
+
+	purged, err := j.repository.PurgeAlarmEventRecordArchive(ctx, j.archiveRetentionPeriod)
+	if err != nil {
+		return fmt.Errorf("failed to purge alarm event record archive: %w", err)
+	}
+	purgedArchiveRecords.Add(float64(purged))
+
+	slog.Info("Alarm retention enforced", "retentionPeriod", period, "archived", archived, "purgedFromArchive", purged)
+	return nil
+}
@@ -0,0 +1,115 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package serviceconfig
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo/generated"
+)
+
+var _ = Describe("RetentionJob", func() {
+	var (
+		ctx      context.Context
+		ctrl     *gomock.Controller
+		mockRepo *generated.MockAlarmRepositoryInterface
+		job      *RetentionJob
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		ctrl = gomock.NewController(GinkgoT())
+		mockRepo = generated.NewMockAlarmRepositoryInterface(ctrl)
+		job = NewRetentionJob(mockRepo, time.Hour, 45)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	expectConfiguration := func(retentionPeriod int) {
+		mockRepo.EXPECT().GetServiceConfigurations(gomock.Any()).
+			Return([]models.ServiceConfiguration{{RetentionPeriod: retentionPeriod}}, nil)
+	}
+
+	Describe("RunOnce", func() {
+		It("archives the expired records in batches and purges the archive", func() {
+			expectConfiguration(7)
+			gomock.InOrder(
+				mockRepo.EXPECT().ArchiveAlarmEventRecords(gomock.Any(), 7, archiveBatchSize).Return(int64(archiveBatchSize), nil),
+				mockRepo.EXPECT().ArchiveAlarmEventRecords(gomock.Any(), 7, archiveBatchSize).Return(int64(10), nil),
+				mockRepo.EXPECT().PurgeAlarmEventRecordArchive(gomock.Any(), 45).Return(int64(5), nil),
+			)
+
+			Expect(job.RunOnce(ctx)).To(Succeed())
+		})
+
+		It("fails if there is not exactly one configuration", func() {
+			mockRepo.EXPECT().GetServiceConfigurations(gomock.Any()).Return(nil, nil)
+
+			Expect(job.RunOnce(ctx)).To(MatchError(ContainSubstring("expected a single alarm service configuration")))
+		})
+
+		It("does not archive anything if the retention period is invalid", func() {
+			expectConfiguration(0)
+
+			Expect(job.RunOnce(ctx)).To(MatchError(ContainSubstring("invalid retention period")))
+		})
+
+		It("does not purge the archive if archiving fails", func() {
+			expectConfiguration(7)
+			mockRepo.EXPECT().ArchiveAlarmEventRecords(gomock.Any(), 7, archiveBatchSize).Return(int64(0), fmt.Errorf("db error"))
+
+			Expect(job.RunOnce(ctx)).To(MatchError(ContainSubstring("db error")))
+		})
+	})
+
+	Describe("Run", func() {
+		It("runs immediately and again when triggered", func() {
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+
+			runs := make(chan int, 2)
+			mockRepo.EXPECT().GetServiceConfigurations(gomock.Any()).
+				Return([]models.ServiceConfiguration{{RetentionPeriod: 7}}, nil).Times(1)
+			mockRepo.EXPECT().GetServiceConfigurations(gomock.Any()).
+				Return([]models.ServiceConfiguration{{RetentionPeriod: 1}}, nil).Times(1)
+			mockRepo.EXPECT().ArchiveAlarmEventRecords(gomock.Any(), gomock.Any(), archiveBatchSize).
+				DoAndReturn(func(_ context.Context, retentionPeriod, _ int) (int64, error) {
+					runs <- retentionPeriod
+					return 0, nil
+				}).Times(2)
+			mockRepo.EXPECT().PurgeAlarmEventRecordArchive(gomock.Any(), 45).Return(int64(0), nil).Times(2)
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				job.Run(ctx)
+			}()
+
+			Eventually(runs).Should(Receive(Equal(7)))
+			job.Trigger()
+			Eventually(runs).Should(Receive(Equal(1)))
+
+			cancel()
+			Eventually(done).Should(BeClosed())
+		})
+
+		It("coalesces the triggers received while an execution is pending", func() {
+			job.Trigger()
+			job.Trigger()
+			Expect(job.trigger).To(HaveLen(1))
+		})
+	})
+})
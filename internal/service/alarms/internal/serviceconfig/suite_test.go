@@ -0,0 +1,19 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package serviceconfig
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestServiceConfig(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alarms Service Configuration Suite")
+}
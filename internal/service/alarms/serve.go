@@ -20,6 +20,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/google/uuid"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
@@ -29,6 +30,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/db/repo"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/infrastructure"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/listener"
+	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/maintenance"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/notifier_provider"
 	"github.com/openshift-kni/oran-o2ims/internal/service/alarms/internal/serviceconfig"
 	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
@@ -135,10 +137,17 @@ func Serve(config *api.AlarmsServerConfig) error {
 		}
 	}
 
+	// Parse local cloud id
+	cloudID, err := uuid.Parse(config.CloudID)
+	if err != nil {
+		return fmt.Errorf("failed to parse cloud id: %w", err)
+	}
+
 	// Init server
 	// Create the handler
 	alarmServer := api.AlarmsServer{
 		GlobalCloudID:    globalCloudID,
+		CloudID:          cloudID,
 		AlarmsRepository: alarmRepository,
 		Infrastructure:   infrastructureClients,
 	}
@@ -149,8 +158,13 @@ func Serve(config *api.AlarmsServerConfig) error {
 		return fmt.Errorf("failed to create oauth client configuration for alarms subscribers: %w", err)
 	}
 
+	subscriptionProvider, err := notifier_provider.NewSubscriptionStorageProvider(alarmRepository)
+	if err != nil {
+		return fmt.Errorf("failed to create alarms subscription provider: %w", err)
+	}
+
 	newNotifier := notifier.NewNotifier(
-		notifier_provider.NewSubscriptionStorageProvider(alarmRepository),
+		subscriptionProvider,
 		notifier_provider.NewNotificationStorageProvider(alarmRepository, globalCloudID),
 		notifier.NewClientFactory(oauthConfig, constants.DefaultBackendTokenFile),
 	)
@@ -176,9 +190,14 @@ func Serve(config *api.AlarmsServerConfig) error {
 		slog.Info("Done listening to alarms pg channels")
 	}()
 
-	// Configure server and start alarms cleanup cronjob
-	if err := ConfigAlarmServerCleanup(ctx, &alarmServer, pgConfig); err != nil {
-		return fmt.Errorf("failed configure and start cleanup cronjob: %w", err)
+	// Configure server and start alarms retention job
+	if err := ConfigAlarmServerCleanup(ctx, &alarmServer, config.ArchiveRetentionPeriod); err != nil {
+		return fmt.Errorf("failed configure and start retention job: %w", err)
+	}
+
+	// Start the job managing maintenance windows
+	if err := startMaintenanceJob(ctx, &alarmServer); err != nil {
+		return fmt.Errorf("failed to start maintenance window job: %w", err)
 	}
 
 	alarmServerStrictHandler := generated.NewStrictHandlerWithOptions(&alarmServer, nil,
@@ -197,6 +216,8 @@ func Serve(config *api.AlarmsServerConfig) error {
 	if err != nil {
 		return fmt.Errorf("error creating filter filterAdapter: %w", err)
 	}
+	// The alarms are filtered and paged by the database
+	filterAdapter.SetServerFiltered(constants.O2IMSMonitoringBaseURL + constants.AlarmsPath)
 
 	// Create authn/authz middleware
 	authn, err := auth.GetAuthenticator(ctx, &config.CommonServerConfig)
@@ -225,6 +246,9 @@ func Serve(config *api.AlarmsServerConfig) error {
 	// Register the handler
 	generated.HandlerWithOptions(alarmServerStrictHandler, opt)
 
+	// Expose the alarm retention metrics
+	baseRouter.Handle("GET /metrics", authn(authz(promhttp.Handler())))
+
 	// Server config
 	// Wrap base router with additional middlewares
 	handler := middleware.ChainHandlers(baseRouter,
@@ -304,8 +328,12 @@ func gracefulShutdownWithTasks(srv *http.Server, alarmsServer *api.AlarmsServer)
 	}
 }
 
-// ConfigAlarmServerCleanup configure server and launch the cleanup cronjob for resolved alarm events
-func ConfigAlarmServerCleanup(ctx context.Context, alarmServer *api.AlarmsServer, pgConfig db.PgConfig) error {
+// ConfigAlarmServerCleanup configure server and launch the retention job for resolved alarm events
+func ConfigAlarmServerCleanup(ctx context.Context, alarmServer *api.AlarmsServer, archiveRetentionPeriod int) error {
+	if archiveRetentionPeriod <= 0 {
+		return fmt.Errorf("invalid archive retention period: %d", archiveRetentionPeriod)
+	}
+
 	// Add Alarm Service Configuration to the database
 	serviceConfig, err := alarmServer.AlarmsRepository.CreateServiceConfiguration(ctx, api.DefaultRetentionPeriod)
 	if err != nil {
@@ -313,22 +341,51 @@ func ConfigAlarmServerCleanup(ctx context.Context, alarmServer *api.AlarmsServer
 	}
 	slog.Info("Alarm Service configuration created/found", "retentionPeriod", serviceConfig.RetentionPeriod, "extensions", serviceConfig.Extensions)
 
-	// Init ServiceConfig and start cronjob
-	alarmServer.ServiceConfig, err = serviceconfig.LoadEnvConfig()
+	// Remove the cronjob used by previous releases so that records are never deleted without being archived
+	config, err := serviceconfig.LoadEnvConfig()
 	if err != nil {
 		return fmt.Errorf("failed to load alarm service configuration: %w", err)
 	}
-	alarmServer.ServiceConfig.PgConnConfig = pgConfig
-	clientForHub, err := k8s.NewClientForHub()
+	config.HubClient, err = k8s.NewClientForHub()
+	if err != nil {
+		return fmt.Errorf("failed to create k8s client for hub: %w", err)
+	}
+	if err := config.RemoveLegacyCleanupCronJob(ctx); err != nil {
+		return fmt.Errorf("failed to remove legacy alarms cleanup cron job: %w", err)
+	}
+
+	alarmServer.RetentionJob = serviceconfig.NewRetentionJob(alarmServer.AlarmsRepository, serviceconfig.DefaultRetentionInterval,
+		archiveRetentionPeriod)
+	alarmServer.Wg.Add(1)
+	go func() {
+		defer alarmServer.Wg.Done()
+		alarmServer.RetentionJob.Run(ctx)
+	}()
+
+	return nil
+}
+
+// startMaintenanceJob launches the job opening maintenance windows for upgrades and releasing the notifications held
+// back by the windows that have ended
+func startMaintenanceJob(ctx context.Context, alarmServer *api.AlarmsServer) error {
+	hubClient, err := k8s.NewClientForHub()
 	if err != nil {
 		return fmt.Errorf("failed to create k8s client for hub: %w", err)
 	}
-	alarmServer.ServiceConfig.HubClient = clientForHub
 
-	if err := alarmServer.ServiceConfig.EnsureCleanupCronJob(ctx, serviceConfig); err != nil {
-		return fmt.Errorf("failed to start alarms cleanup cron job: %w", err)
+	var resources maintenance.NodeClusterResourceSource
+	for _, c := range alarmServer.Infrastructure.Clients {
+		if clusterServer, ok := c.(*infrastructure.ClusterServer); ok {
+			resources = clusterServer
+		}
 	}
-	slog.Info("Successfully created initial set of cronjob and resources")
+
+	job := maintenance.NewJob(alarmServer.AlarmsRepository, hubClient, resources, maintenance.DefaultInterval)
+	alarmServer.Wg.Add(1)
+	go func() {
+		defer alarmServer.Wg.Done()
+		job.Run(ctx)
+	}()
 
 	return nil
 }
@@ -170,10 +170,22 @@ type Subscription struct {
 
 	// Filter Criteria for events which do not need to be reported or will be filtered by the subscription
 	// notification service. Therefore, if a filter is not provided then all events are reported.
+	// The filter uses the same syntax as the filter query parameter. A change is reported if the filter
+	// matches either the prior or the post state of the object. The objectType attribute holds the type of
+	// the object (e.g. NodeCluster).
 	Filter *string `json:"filter,omitempty"`
 
+	// SigningSecret Optional shared secret used to sign the notifications sent to the subscriber. When set, each notification
+	// carries an HMAC-SHA256 signature in the X-O2ims-Signature header. The secret is never returned.
+	SigningSecret *string `json:"signingSecret,omitempty"`
+
 	// SubscriptionId Identifier for the Subscription. This identifier is allocated by the O-Cloud.
 	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+
+	// VerifyCallback Requests a verification handshake with the callback before the subscription is created. A
+	// CallbackVerification challenge is POSTed to the callback, and the subscription is rejected unless the
+	// challenge is echoed back.
+	VerifyCallback *bool `json:"verifyCallback,omitempty"`
 }
 
 // ClusterResourceId defines model for clusterResourceId.
@@ -746,6 +758,9 @@ type GetSubscriptionsParams struct {
 // CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
 type CreateSubscriptionJSONRequestBody = Subscription
 
+// RotateSubscriptionSigningSecretJSONRequestBody defines body for RotateSubscriptionSigningSecret for application/json ContentType.
+type RotateSubscriptionSigningSecretJSONRequestBody = externalRef0.SigningSecretRotation
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get API versions
@@ -799,6 +814,15 @@ type ServerInterface interface {
 	// Get subscription
 	// (GET /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId})
 	GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -1460,6 +1484,99 @@ func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaySubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSubscriptionSigningSecret(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1597,6 +1714,9 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions", wrapper.CreateSubscription)
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}", wrapper.DeleteSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}", wrapper.GetSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters", wrapper.GetSubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters/replay", wrapper.ReplaySubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/signingSecret/rotate", wrapper.RotateSubscriptionSigningSecret)
 
 	return m
 }
@@ -2562,6 +2682,192 @@ func (response GetSubscription500ApplicationProblemPlusJSONResponse) VisitGetSub
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+type GetSubscriptionDeadLettersResponseObject interface {
+	VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionDeadLetters200JSONResponse externalRef0.DeadLetterQueue
+
+func (response GetSubscriptionDeadLetters200JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+type ReplaySubscriptionDeadLettersResponseObject interface {
+	VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type ReplaySubscriptionDeadLetters202Response struct {
+}
+
+func (response ReplaySubscriptionDeadLetters202Response) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecretRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Body           *RotateSubscriptionSigningSecretJSONRequestBody
+}
+
+type RotateSubscriptionSigningSecretResponseObject interface {
+	VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error
+}
+
+type RotateSubscriptionSigningSecret200JSONResponse externalRef0.SigningSecretStatus
+
+func (response RotateSubscriptionSigningSecret200JSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
 	// Get API versions
@@ -2615,6 +2921,15 @@ type StrictServerInterface interface {
 	// Get subscription
 	// (GET /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId})
 	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(ctx context.Context, request GetSubscriptionDeadLettersRequestObject) (GetSubscriptionDeadLettersResponseObject, error)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(ctx context.Context, request ReplaySubscriptionDeadLettersRequestObject) (ReplaySubscriptionDeadLettersResponseObject, error)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureCluster/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(ctx context.Context, request RotateSubscriptionSigningSecretRequestObject) (RotateSubscriptionSigningSecretResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -3089,103 +3404,211 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request GetSubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionDeadLetters(ctx, request.(GetSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (sh *strictHandler) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request ReplaySubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaySubscriptionDeadLetters(ctx, request.(ReplaySubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaySubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaySubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitReplaySubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (sh *strictHandler) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request RotateSubscriptionSigningSecretRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	var body RotateSubscriptionSigningSecretJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateSubscriptionSigningSecret(ctx, request.(RotateSubscriptionSigningSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateSubscriptionSigningSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateSubscriptionSigningSecretResponseObject); ok {
+		if err := validResponse.VisitRotateSubscriptionSigningSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xde3MbN5L/KijeVcXe40MvK7ZSqSutJG9Ya1s6PfbqylRF4EyPiGgGGAMYyYyj736F",
-	"x7wx5JCUFTs7+ScWCTQaje5f/9DADL/0PBbFjAKVonfwpRdjjiOQwPVfXpgICfwcBEu4B2NffeiD8DiJ",
-	"JWG0d9C7ouRTAoj4QCUJCHDEAoSR7Ym47Tqc0F6/B59xFIfQO+jtvva96f7udDDdDrYGe/6ON3j9ehoM",
-	"Xu3v7e3v/7gFwdZ2r98jaogYy1mv36M4Uj3rOvV7HD4lhIPfO5A8gX5PeDOIsFI2YDzCsnfQSxKiWsp5",
-	"rIQIyQm97T0+9qvyLufxJvNEaoDaZLfx/ptXP74a7AZvtgZ7MH01mL4O8OB18Bp2doM3b7xgq9VkrXIb",
-	"TphFEaO/4pj8ymKg6v84xDw6Jp6aK+bz9vOnSHdFftb36Ra6rtRXmHf4lkDoi/p8L2dEoKvzMfqUAJ+j",
-	"LC6QUgGEFEjOsEQ4DJGKoBA+IywlJ9NEgkCYAyLUCxMffEQokjNQLhIzKpR3TOjNzc2E4jD8NdDj2w9S",
-	"Q+gxi5ZI2/WKU/YhwEmo5hzgUIBqn4Qhniq7G/O0MgJ81no2GeKIRRFGApQFJPgoJEKqtdcKIQ4BcKAe",
-	"CCQZsqJQwFmUzjkJpZ7xCfZm1U6ICITth2qufcQ4UoN9SvTX2TDqS1FQYjpHIsRiBmKI3jI+odbh+kUt",
-	"lAI3Hkuo5PMbJJKpkcUC8w18lkAFYVTcmFEOsoWxEqzRf85bjqw4225C/3cGanWJKHgIEfQHiRIBPqLM",
-	"TuCBhCGaQqqbr01iTG78gwhj2WpDBPdAEdE6z7Vfwec4JB6R4Tx3sUQQequaTOiNUfomV6gaktrS9Tk1",
-	"OF/ZFiUHbOVewRP4lZ1nIZKe36tuQRq/Ub2sxyBM/Q3czLpXw3q09TEFQWokIy1zIA4y4VR72garv/6q",
-	"hxJ4fdUvAHNvhjxOJHCC9RoeMSoxoQIxCmqpIsYBiXLDfmWZICIeCxkVQ6RdoNJcu8CEyiQOAXlGvooQ",
-	"TBGLgWPJeD/zkdxx1HIWlbjHYaKc4XIGWT/kYTqhU9V4ni5ywMKQPagBjFWEXuM/0Gna5w/0HrDWYJ3/",
-	"/pjQPwbZf4V/rvGfkqXclcobJRm9x9KbgbAIYy3ipSuiPtJGaNQL3cCnG/OXWxYRCD4lOFQxtECckXUr",
-	"l8m65YBVAMgZpk3yUllws4Isxp16GlmELtNLu02Q9xSN9gqXzjEEIRZOsCBr2RxzWdUJ5rKNLGqdokGW",
-	"z0AgymTqHA26WVnWKZr1UpKW+YWVZY2/WNYy+/+hIvIy61VKFqqTwjsloCDHAqr9i01/A0/Wc8mEpl1t",
-	"+8Z8gorpJBEOgjKwU6KC+DChy/OHAtmfX8AnB6D3T/7nZZZCLnOzKAqhBGN+m0Rq25dN0IJVVVetxKeb",
-	"AgCyKMYcxIR6M/DusvUwK8iWBv8w1UiHlcJcs8bpAAKJJI4ZlyhKQkkUhKdAXLWiViAdPzPlhFZt2ZCK",
-	"tX5EzoCjm5OLG7W2N1cXdQMT6jTwRf/q4mU5TVsjpzGiMiMW/dQN1AAixprVKDpHAXw1jSkgkXDOEupb",
-	"tyH0NgT0KWESxHBCF8+7yEisO5s8hG6iebpFvXH6jWYDP+StfqjMJ1uBLLM25GHtV4qP9DUhMV4QoSgR",
-	"EkUqblHAuGGoZr8kdWL2iSIGakq6kcP38tyqmY1r5kTtnwozRX/D1P9bJbyyBVQmUqvd0h4/NYVXdemX",
-	"MzTDW5dTtEyRXI+XjfxM86zF/IwyH46MnBVKG6pXOnxVw/1XOzvbr/b3Bq+nW68Ge9v7eDANvN2Bt/Nq",
-	"a+rt78E2xu5dfVmXzXb0BVkrlm2Kc3OXbNYuW9SV2mySIplmE1phhsVu1clh/HrX35riAX4FMNgLtoPB",
-	"FF7vDYLd3b3pzvb2/r4XuCdXUWaTmT2mjfXe0FrsaIbpLXxgaiYeNjOsTnhMjWgVynjKEqlYPaH3QCXj",
-	"c+RpEYoT5DL6vZir7CIJmLomoyKJgF8ssW2WNlHM2T2x4KxCOZWQbkmLhtHWXjJ95by5gidK+UvdoqrC",
-	"aYGPZEhosswB2kID5GkS20fbaIAi5pNg3kc7aIB8UBhrVp4mUe/g41Z/u79znalCqIRb4FVdXHY4REnN",
-	"yyRDHGIOAqg02FeUossWsp0lDLM6h8C9AFfn71L6YFqq/RgRKfFLPTDNCU67qsY76MXxybuTy5OXQzS2",
-	"hZaYEaU9m1DmsrOPJdbwIJAPAaGmmOeFWLG33eHOcD+rAOSEUgs2KU/TbDW8IpZad6G4SRyHxIiKOWH8",
-	"VH9zIbHUO9AR4yhmQhY+NgFcM1ylVUMRk4j2NtpCL47OTw4vT14qqrKNXrw/PR6//b+XeprlXU7ZShO6",
-	"3EwLDbPIGmnrCdVW5oY0EYoyzykZyHyqDVQR+AQWKtiE8YJPLbLQhLZ0pOUWKq/4hgZ6LOL3xyoKNEHU",
-	"tcPQR+VzilagjWwnlPWqwjTmkgRY6Z+e9TjqiOMMkkRKMVHa8YV4iR5mRJtTL7I9pCECTbFiZmluJBIi",
-	"0SJrZR9gzvG8Vz8+apehUz0rZlObFYmpB+1ws+XJ1XjpuNq3zXcFKw3baVEarDr2L0mEKeKAfTwNARW+",
-	"TIPEfVBYGyUn4M4kIcDsP6iIwVNz9dELlRyUOX3MffI7+C9R7l3oxR3MM+fQW2JMQqYCR49kMJ3kTjuh",
-	"GQMw7lswY+bADSgUQTQFfupIb2nNm+ktaGVVREG7kNA7HdS3oJpWnHapkxr2Vh39g61buJbBvQp8gZc7",
-	"3CwnZVnkNURjhONYz6+Nz1Vgy3UgrCdc9s3+gsPUrGcLbHPTtDb4hnTXGhdd9/x5UTCvBiQbhrBznt9z",
-	"HNcnkHvD8lBySnNZY7EfZ+7p8GWXm37I953t3FN1SJVtkXpbRbyEKA41MbH9iwFf0LCegVtkOwJUfli1",
-	"lJFqVhrcRgfCQpBb6tjVPcxYetCvqJoUZpvVenNn1/KYCHMlgDB6vEKQSfgs00nM7ckuikDOmD5X9Ati",
-	"1d+1xMHugaPTo5AlProgpoTYgkH8g7MkdgTmqf4HDs0Zra6d3Oqm5g6Ex6KYE1vPriaSNIvpa0a2TWEt",
-	"npp9uWElO1yuXdchBfZoLnTYMqausjIqJE88WfPfTVnjhohb0+Sbw1nkBNomvVeB1+Vzp0+AEWtk0RbV",
-	"yPHyMbUTOuByuAYzqtZaXRC6mCs1AVjfWeZ0JA1niC5JX+0ZVjGFudnVEwRaaYDvLtyatV816EqSlkbe",
-	"6jx2k+hrdvxVaVSxJLxy7bledK9wfRyGU+zduZNUkIThHH1KcGhc5Op8rLIQzmlJzJkHfsLTfZSHqflM",
-	"CITRGTNJThlzQsepVrpyUyypV48DZlLG4mA0EhEb2k+HHovU36P77RHzFI/4NZvlr2wqgN9r0lhnFC0r",
-	"6w4kzGbJAlM9FkjXlv0E0vpvUeqwDSY3XUM6Sk8z1eB2MGNSn+mqcuFklkPMuKKBjGcnd0ZuThyLC48m",
-	"tFQJV8YiHuhbRBwCxqGPSKBvH4WWCqsRs/iVM6D6yNDqpcI/1aEBfsTq1i6Z0hbU81ZEKA2Yl57zqh6n",
-	"A00oh+scBFYXSmHuKQ3nzRdVSzujNGxcAeu4fHZ4Nv4XcNE2gtG9aZzGzuHZ2BW897nIfP7bw63hlhOP",
-	"VlNUtNM05bFWF7FEZRyTovxM7Y+F2dgpPF4X+Ox/cgh6B73/GOUPB4zsYd1osb0dXDfh5IxDQD6XLTdi",
-	"OyQSA0IDjg3LTjhkoDW6317fqvriOASEkhVQ3F5kz7oN69ZULQ59c2UBN94cf2fXKAKJdd3/DuYDe4qI",
-	"CRfZ9gILwTyidsuRuRwYJGHey0Ieh1AHoeMBg5ottILmCLWJQ/kKlcz1k+x4wZ6YaubJPC/R5wd+wtON",
-	"p7FMiIW0TX9C2PfB79szRr9vDh5JdpPBnDf2Do+PT457/Z45IlH/0kcn45PjQiTnKIbL6+YCsjMDkirb",
-	"Ja5z71zdKSj1OSYC/PzAUH1/xkmE+Rz9E+aIUGtm7TMof9qgHeW3Gi8gDAWFQ0Zvgecs8z5b97Lm+a0Y",
-	"TH2EnUVzRtMbaCkETGildz5pQhVlLOQzrDG9cBdW329WCik24wNWMh+UO8xwHAMF36oigApz501pAUEA",
-	"nhT9kjp9c4lWF7RJFGNPOS/mgDOsEnMhIWrIZHoS77CQxo2XuXB12ZDFIURosfJU82B/0fAfnHQ4W0kx",
-	"Y1ya+4LZeZPq1lRiAaz+3RCQqfcq+qFL+1ZXIpDuqYyXSKbASmXBub6ajGmi/l0JtqvL0/eHl+MjFWaH",
-	"H64O3zmDLMIU30IEVI6pBB5gd20vA7GsuXYj3d6Ul+zeRGlrLxVyTEVEpFrw7DDphEoi5+nm4/zk4vJ8",
-	"fHQ5Pv1woG96FXgFGr+/QBeGKwnT2YCmfjIiItI48OnO+P1FpfySmkB/55x1NSfFd0VCrJF8yeKQ7DkD",
-	"xsvPKoj0DohZuRL3K12kji3w3MEcvTj758sMfSa05seZATOr/4TIEIYlTUrSrYgMPtH4eNVjIpXumAD/",
-	"HFSiOtTKiAWRcJsQX9dRlbZpZ8VVMeEIm+7DFpXvOvAXI7EOCvVM54DipulUQrIpItw+cr0KA8lWczUG",
-	"kj9K52YgZWazNmeriHK4Q4unAtW2dVzLwFVvNg9QjEvbC5u+H4icEZXpsu3FKpk3G+FCT7SR9P+rQvCr",
-	"0Wa6K3ipJY18OVS4Kb3EotyRC11XmWGewwS6B+oznt0ZEYlWDpvyTmXbUtQUUzRVlCBlmL42tMrgpgDl",
-	"VTsLFsgHheI+hOQeuH20hQgV137iyabCl8b3hqtrg/PDD8i0MGQTVHoo0coDw1LEDJuNtXWLGHg69+J5",
-	"2DBiPoSKYUxo6XM7G7eOf2LKQ6hLet940jNu5gpT7X42PgqLamBCP+BKRB1LsL7dJ4apY7Mk9JVn6yhT",
-	"pNEsMM4sWHNlPW72MHHr/Fl+UroBjZYgZymgM9usliNrG7m2WfOMs2kI0TFITELzNoJy9su23ofZk9bl",
-	"z89K7Rffc+gd0jmiSTS1N6YzIYXnuPtqmQoX9nL8NJ7OuN1IkSgOtXlwWjqoTdjX03LdrZ0lEaaD7BgC",
-	"PschprZ2mMK1jhki0v25fYzVBJi2Wrksd8QoBS99tMLHEk+xACRJBD5iiXTBZFpvd6l4dT4u3HDVGYjk",
-	"QKFdO9W0WUM0oWOJIjxHcx3JQcLNNrFAhkiAfMhGqrEBTpzlT4ll0nDy+8vl5RkyDZDH/MI9t4WmrN+R",
-	"lkSGTtvo/WC/uooiiTTulUWb6g0ayxQY9AN1pv6iH5ovKCVZs4p9/Yw6xNJQ74Qroqt32CHzcEh+N36I",
-	"xoFJvETo/T0tbM71M4GTnmafB9MQ07tJr2+ZTxoANi/jUOjKQVqibuAC0skCqs6DPY9xX5MAhsYnl2/R",
-	"+dsjtPvm9T76uHvt9K2a8YhAQD2WcHyrC+aWEKmBrI5iQisL4jMvySI027mnol/A8HZoHqP/5fL9u5dq",
-	"O07Lrojyp3zMjb68cq8vwfcnlMhC/sZCJFFWdalYuukMJnXBgg2HHouWBkElI9iIyFDn2nX3V4CXcCJN",
-	"CjCQyXAiZzsN16QPz8YoUW52epjIGdoxnNJc+iCKLnkc9MLhUKAgZA/6iCZkD/aem2pzlDdRHwqPxWZk",
-	"zkI4MCVhtWTAFYFU1Aad6z/ROQtVSiq0s6ce00Lbi+wj0155JbsDesXDgpHvYO6FDN+VTrs44DASI8Yx",
-	"VasgmcfCkUpNxB94Bk5HWlapDm3spZ9mgc8SOMXhMfNEExk+VYqjLIeii1I6+XG4hV6cepIp/Xe2dvZe",
-	"9vq9pKR6Kf+IIRtwTIeM34589kBDhv3/Jv7PP+69MYAeMEc8no219xuDjUvld1S8lRMSD6jQEW2f/jmM",
-	"sTcDtKNPPMp6PTw8DLH+Wmtj+4rRu/HRyYeLk8HOcGs4k1FYQNLeIg2Us2kCUjml6PcsW+gd9Hbt2UuM",
-	"5Uxb3HmkYAWOFMO4LxyG3IKsG+dcP5EnbJHVPqaYEl9luezgJcv4hUNAe9CnrWdKq7aM3fsHyMMwzM5i",
-	"9J1T/TIYrcrO1pZ9KkkClebgJg7tIo9+E2YXmT9atfbxjDCeWnklQ+J5IIQ5fWZTRa7NKWTdAuns1RQf",
-	"+729hXpbKPuvjfWv8ELHFP6O/fS6ntFr+9vQ64oqfGCc/A6+UWz321DsLeNT4vugl/HVt7KMGhcVAzf3",
-	"CxBwzviwlKv02WWapT4WswH2I0LLCcImkuvH637P8jETi6VQVskU3wolLj18612rMRfCyf32qLyTspuO",
-	"pbCSxlKlvETSS5oO4KgO0y+9pu2jeyXyJqOFb7567K/Tv/zSqPVkBJt11jc71NJuhKWb1U/zEm+tsrAK",
-	"0C72iQ5pO6R9DqT9GkBb9+gC3JY+Xg9yR19qxa/HVjBsNkZi4VsMl0Dx/GmAuFq72xjSNkWyVZBruRk7",
-	"9PqroNfe1t63odVlXm8EPz1gesCmGhOwhPrDf1Ne64jBzfB21U1zymQiQhlv3jFnRdgI/8Z44wXMGgC/",
-	"V2K/6W10h3gdX/ueEaQeuGvvjx0PtK4GIs6Xazdtk49co3U75WfeKbseln+SrXGzL3Rw28Ht97o9dnt1",
-	"AXF5Cc7Wht3RF+fbBVbfKje9Oa8NHK+Oxs43InzV/bETv9bcEJv3SDnM1SFWtyXutsTPtSWux+CT4utm",
-	"lLYlm+2Y7J/NZL8Oi+0IbEdg/1IE9mm5a523rsVZ3e8YXIa5m9LVZ6aqG9FUl4U6WOpYasdSn42lVkNw",
-	"MyCtvAFnNZJaI8xNLPVDdZSOpT4zS62+NutJWKp7/bt80NHU75Wm1j36aeF19KX2yrGvWFutBv2qqFt/",
-	"PdpXJao1jOrqqR0ydUy1q6e2x9LKxc95K2ytvcAh0L/dtybKbnr385lB9yte9HTZtoPhDoY7GP5zb3o2",
-	"4duTwfP6VYQWBYSuePBnFg+evnDQ1Qy6msFfpmbwdOWCMr3duEywHFc3IarPWBl4sqpABzsdE+2Y6J9W",
-	"ENgMKIsvsl/vYlVJQgM6XpRG6WjnM9PO0k9sPAnvrC16lwA63vl9AGrTG60cQCsqsJXibPnza/vD0K4f",
-	"PQH94nRMnWjpAkvTpRSw5kVjIOTfmT9/MiZYxoTy68wkT+CxBkzbX3HsBfhjfm7dr/3qS4c6Hep8B6jT",
-	"jDAm1FuDzKpkbvSl/CNFjwafQnD9Kvux/lwgvBSgTMsKQK3G5yo/ntTEgRZggv0ZlGZM6GKv2/L9lbDC",
-	"RF3J1xfykdXqW8tivrKD+1oB//zcYlGJq+MaHd51ePcN78gWcaUVNDKj6AkZMMvfs3swGum3e8+YkAev",
-	"t7bMj/bZQZf/wozjPNa+17d0xPvYX01U8YJ4XZ6p/bWR2fBkpBVZe+hyHZEOVZ3vKFlFtqPeaEWXveDx",
-	"+vH/AwAA///a+0SNbKEAAA==",
+	"H4sIAAAAAAAC/+xde3MbuZH/KijeVcXO8S3qYaVSV4qkzapiW44k5x5L1wqc6RERzwA0gJHM7Pq7X+E1",
+	"Tww5FGXZu0f/sSuSQE+j0f3rRqMH+KUTsGTBKFApOse/dBaY4wQkcP0piFMhgV+BYCkP4CJUX4YgAk4W",
+	"kjDaOe68p+RTCoiEQCWJCHDEIoSR7Ym47dqf0k63A59xsoihc9zZOwqD2cHerDcbRcPeJBwHvaOjWdTb",
+	"P5hMDg4OhxANR51uh6hHLLCcd7odihPVs85Tt8PhU0o4hJ1jyVPodkQwhwQrZiPGEyw7x500JaqlXC4U",
+	"ESE5oXedL1+6VXo3y8U240TqAbXBjvDBq/3D/d5e9GrYm8Bsvzc7inDvKDqC8V706lUQDVsN1jK35YBZ",
+	"kjD6M16Qn9kCqPo/jjFPzkigxor5sv34KdJdUZj1fbqJrjP1FcYd/0AgDkV9vDdzItD7qwv0KQW+RJld",
+	"IMUCCCmQnGOJcBwjZUExfEZYSk5mqQSBMAdEaBCnIYSIUCTnoFRkwahQ2jGlt7e3U4rj+OdIP99+4QSh",
+	"n1mUhGvXKQ45hAinsRpzhGMBqn0ax3gWgxNPKyHAZ81nkyBOWZJgJEBJQEKIYiKkmnvNEOIQAQcagECS",
+	"IUsKRZwlbsxpLPWIz3Ewr3ZCRCBsv1Rj7SLGkXrYp1T/zKLCj6LAxGyJRIzFHEQf/cD4lFqF6xa5UAzc",
+	"Biylki9vkUhnhhaLzC/wWQIVhFFxa55ynE2MpWCF/ue85cCSs+2m9L/moGaXiIKGEEH/IFEqIESU2QE8",
+	"kDhGM3C8hVokRuRGP4gwkq02RHAPFBHN81LrFXxexCQgMl7mKpYKQu9Ukym9NUzf5gxVTVJLuj6mBuUr",
+	"y6KkgK3UK3oCvbLjLFjS82vVHUijN6qX1RiEabiFmln1apiPtjqmIEg9yVDLFIiDTDmFcLvZf/ysxxJ4",
+	"fdavAfNgjgJOJHCC9RyeMioxoQIxCmqqEsYBiXLDbmWaICEBixkVfaRVoNJcq8CUynQRAwoMfWUhmCK2",
+	"AI4l412Ea4qjprPIxD2OU6UMN3PI+qEA0ymdqcZLN8kRi2P2oB5gpCL0HP+KLl2fX9EbwJqDx/z7dUp/",
+	"7WX/Cn8+4p+ipdSVyltFGb3BMpiDsAhjJRK4GZFzK4RGvtAtfLpFqJkWEQg+pThWNrSCnKF1J9fRuuOA",
+	"lQHIOaZN9BwtuN2AFuNePg0tQtfxpdUmynuKRnnFa8cYgxArB1igBbdtaVUHmNM2tKhVigZaIQOBKJNO",
+	"ORp4s7SsUjTzpSit0wtLi9AWtNbJ/1dlkTdzqNk8MVqu8E4RKNCxgGo/sdk/IZB1XzKlrqtt3+hPUNGd",
+	"pMIToPTskKggIUzpev+hQPbPL+CTB9C7539/mbmQm1wsmJsHY36XJkBlPkALVlVeNROfbgsAyJIF5iCm",
+	"NJhD8DGbDzODbK3x9x1H2qwU5po5dg8QSKSLBeMSJWksySK2/TxS1Ay452einNKqLBtcseaPyDlwdHt+",
+	"favm9vb9dV3AhHoFfN19f/2y7KatkJ2NKM+IRdepgXqAWGAd1ahwjgKEahgzQCLlnKU0tGpD6F0M6FPK",
+	"JIj+lK4edzEiseps/BC6TZZuiXrr1RvVtfuHvNUfKuPJZiDzrA1+WOuVike6OiAxWpCgJBUSJcpuUcS4",
+	"iVDNeklqxxwSSRhVQ9KNPLqX+1Yd2fhGTtT6qTBS9EdMwz9WzCubQCUiNdst5fGnJvO6frlphGbi1vUh",
+	"WsZIzsfLxvhMsb4mPqMshFNDZ4PUhurlHl/l8GB/PB7tH0x6R7Phfm8yOsC9WRTs9YLx/nAWHExghLF/",
+	"VV/mZbsVfYHWhmmb4tj8KZtHpy3qTG03SJHOsgFtMMJit+rgMD7aC4cz3MP7AL1JNIp6Mzia9KK9vcls",
+	"PBodHASRf3AVZrYZ2RfXWK8NrcRO55jewVumRhJgM8LqgC+oIa1MGc9YKhGmiNB7oJLxJQo0CUSLNLqd",
+	"BVfeRRLQTwsYFWkC/HqNbDO3iRac3RMLzsqUHQW3JC0KRkt7zfC7nSKD54r5G92iysJlIR7JkNB4mWM0",
+	"RD0U6CC2i0aohxIWkmjZRWPUQyEojDUzT9Okc/zTsDvqjj9krBAq4Q54lRefHE5QWtMyyRCHBQcBVBrs",
+	"K1LRaQvZThImsrqCyD8B769eu/DBtFTrMSJc4Oc00PkEr1xV4zF6cXb++vzm/GUfXdhEy4IRxT2bUuaT",
+	"c4gl1vAgUAgRoSaZF8RYRW97/XH/IMsA5AGlJmxcnvpBdVeUDe9CxSaLRUwMqQUnjF/qX64llnoFOmAc",
+	"LZiQha+NAdcEV2nVkMQkor2MhujF6dX5yc35S8Q4GqEXby7PLn74n5d6mOVVTllKU7peTCsFs0oarvWU",
+	"ailzEzQRijLNKQnIfKsFVCH4BBIqyITxgk6tktCUtlSk9RIqz/iWAvpSxO+fqijQBFEfPII+Le9TtAJt",
+	"ZDuhrFcVpjGXJMKBdA0ufHnEiwyShAsxkev4QrxED3Oixakn2dBR8zTDAkLkfCORkIgWXiv7AnOOl536",
+	"9lE7D+34rIgNESokpgG0w82WO1cXa5+retrfClLqt+Oi9LDqs39ME0wRBxyqbQlU+NEZiX+jsPaUPAD3",
+	"OgkBZv1BxQICNdYQvaBMIiXOEPOQ/AvClyjXLvTiIywz5VBdJSYxU4ajn2QwneRKO6VZBGDUtyBGdOXj",
+	"PTeOBJIZ8EuPe3M5b6aXoJVZEQXuYkI/aqO+A9W0orRrldREb9Wnv7V5C980+GeBr9Byj5rlQZnr2GSN",
+	"CV4s9Pja6FwFtnwbwnrAZd3srthMzXq2wDZ/mNYG35DuWotFH7v/vMqYNwOSLU3YO87fsh3XB5Brw3pT",
+	"8lKb0k31OFNPjy771PRtvu5sp56qg2O2hettZfESkkWsAxPbv2jwBQ7rHriFtyNA5dtNUxmOs9LDrXUg",
+	"LAS5o55V3cOcuY1+CBGRwiyzWi/u7FyeEWFKAgijZxsYmYTP0g1iaXd2UQJyzvS+Ylggqz7XHAe7B44u",
+	"T2OWhuiamBRiiwjir5ylC49hXuo/cGz2aHXu5E43NTUQKp/Hic1nVx2J82KqERNQnYunjr78sJJtLle5",
+	"K2iKHYxLY+osK6NC8jSQNf3dNmrcEnFrnHx3OIu8QNvE9ybwun7s9Akw4hFetEU28mL9M7USeuCy/4jI",
+	"qJpr9UHo6lipCcC63jSnx2l4TXSN+2ofYRVdmD+6egJDKz3gN2duzdxvanQlSmstb/M4dhvra1b8TcOo",
+	"Ykp449xzPeleifVxHM9w8NHvpKI0jpdIbZcaFVHVh5IhnIclC84CCFPu1lEBpuY7IRBG75hxckqYU3rh",
+	"uNKZm2JKvbodMJdyIY4HA5Gwvv22H7BEfR7cjwYsUHHEz9kof2YzAfxeB431iKJlZt2DhNkoWWSyxwLp",
+	"3HKYgsv/Fqn222ByUxnSqdvNVA+3DzMiDZnOKhd2ZjksGJcQIsaznTtDNw8cixOPprSUCVfCIgHoKiIO",
+	"EePQRSRC2BJxeezMfuUcqN4ytHxhnvPQdzUMumcqwO72KlMVSyrxZ4QFknmTSvVqH524DRIiMqp5QUWs",
+	"Q7LE1lnYrXH1k86kIjtTC6VoQicgS8URtlBK/61NOKuIRXMWh6KY1DTVDaYtegH9u34RAF76tkRzwt1i",
+	"U71BW7P4bjAGeBUOcW+CJ+o/waT3CqLD3iQcwiiKRhCNZy99OqOWBITeXUPAQa4IhsUcKw0Qul0WM6re",
+	"tQyy1WTJisoyU9Ohd44FyC4CVcZW7DSlAeacgFAY8+Obk9Pe9Y8n4/0D/QgsU57VQ/5373JMEtG7zn6Y",
+	"Aw6Bm/mwDCo1g3vglV3nBH9+DfROzjvH4/2Dbich1H0eHVSl0+08KMO5pPHSbPi12J/0WHrJjO1mTt6K",
+	"CKX9LHA1BqrHZU8vZvqP2YSugoTy9/kIPApwD5xEy9MSVttKa11oXQnfO1euIhwj3dUZ/hzTUMzxR7Ul",
+	"JE1G3zkANNNAUMcOYleaEPbRyZQ6Jv5RpBvMcRyDteF3l9c3RvOK9LvZvlSVOgdlRBCilNrSMFWRUaQI",
+	"wZwpyePgYylemDEWA6YeJagmMpzkfP7VUyt68u7iH8BFW4eL7k1jhz0n7y58vvY+J5mrzKg/7A+94cNm",
+	"jIp2nLplp+VFrGEZL0iRfsb2T4XR2CF8+VBYfv47V3unnX8b5O/yDOze+mC1vD1L05STdxwi8rksuQHT",
+	"EENoxLFZFKccshhjcD96vFT1ex4QEUo2CLrseydZt35dmqrFSWgqjHDjix6v7RwlILHepvsIy57d9MeE",
+	"iwzZsRAsIMrpJaaWN0rjvJc1QA6xxi3P+0A1WWgGTcVD05InVDYPJcfp/LdeKLIgSPV2X5hylycykomx",
+	"kLbpnxAOQwi7tiQg7Jo6AZIVHpnygM7J2dn5WafbMTua6i+903lxftb5UJtcy34+bz7sf2diGoWMqa9M",
+	"JWd3Bop9jomA0LkEM+x3nCSYL9HfYIkItWLWOoPyl4PardAtxyvi+wLDMaN3wPNF4X0272XO8yI2BbnY",
+	"u8fFqCsYdRAwpZXe+aAJlUDDQviJtRsslK6rX+4UQ5gqgWJF80Gpw1xto1AILSsCqMhjLYgiCKToltgx",
+	"jsLsP5FkgbVrwBxwhlViKSQkDetePYjXWEijxutUuDptyOIQIrSYKK5pcLjq8W+9q9dsJsWccWnKe7Pt",
+	"YdWtKSMKWP3dYJBOe9VqQe/EWV6V21Y9lfBSyRRYKS+41G8SYJqqvyvG9v7m8s3JzcWpMrOTt+9PXnuN",
+	"LMEU30ECVF5QCTzC/lR8BmJZc0Rce5MNtqkExa2tAeaYioRINeHZ3u85lUQuXa7g6vz65uri9Obi8u0x",
+	"+sEKz4Zi6OLNNbo2SxthOhvQ1C8yJUQaBb4cX7y5rmRLnQj0b95RV33S4mNx/aqRfM3kkOy1IMbLrxYJ",
+	"FzWbmSst1UrvPSws8HyEJXrx7m8vM/SZ0poeZwLMpP4nRPrQL3FSom5JZPCJLs423dVV7o4JCK9AOaoT",
+	"zYxYYQl3KQn1tofi1nVGXPdG2HTvt9ioqgN/0RLroFD3dB4obhpOxSSbLMKvIx82iUCy2dwsAsm6NUQg",
+	"5cjm0TFbhZRHHVq8xKuWgxc1D1zV5j6qtCPCuW+1kCHK02Ursk08b/aEaz3QxqD/H5UAv2ptpjuSrO40",
+	"8ulQ5qb4Eqt8R070scz0cx8m0D3QkPGsxEukwdwl9KG6bClyiimaqZDARZihWTFiZPPFQbWzYJF8UCge",
+	"QkzuVYqHRbYAnbMwDWTDoEHje0Olae/q5C0yLUywCco9lMLKYxOlCLVeVMGJVYsFcDf24vZ1P2EhxCrC",
+	"mNLS93Y0fh6/octDaOf0vnOnZ9TMZ6bqe2cfhUk1MKHfRyeijiVYF+OKvlNslsah0uwsrWomGGcSrKmy",
+	"fm6W6WztP8sHGzSg0RrkLBl0JpvNfGRtIdfWa54BDl+DlMBX1+uflNXNlgwoMVMm0SzDMLvKLqZH6x5V",
+	"SkgW0hPlvE1VkZ2ef5KAcESzV3RLPMyxQBEmMZQziiNfbbxNx514UsE3JFE7MVBP+KIHLMyq2yUGPqWQ",
+	"QmnPIsQSeopZf0mWE+6anKodHlDJl05FVWcU6975cwvvtuBJdBRMoDccw35vgveOerP9aNKbjEM4gv1Z",
+	"uIcnbZy6WqCdc+6zRhVAgPopyza7TK7qlE+Onc8yfyVBmmk6RvvDvXVvUfjZKFFb4GXMsHpHTSAi0YNW",
+	"wzm+BzQDoE1Zeu+e6ZpXJupTxDi5IxTHJY7KI9+fjYNwtnfYm2CY9CYwGfdmR/iwt/cKBwezw9kYj0Zt",
+	"ZsYt732MXdvfEM0tphV3k7HPPNJFuNI8WLR62tuYQwU/S7bRrZetF0Zf/lVhnQOQovoWjbw4oo2B8O/K",
+	"2NarodgIBFEFQEkJWYgwJo6YmlMcx1NaFbMwGm4sSb/ArDGL6D0hk3JTLjv2b0eIVCx0PsqXsS6Napv1",
+	"TYMz8QQAG+w3uWC5OCItefZgAxIPOh4eDY9G4dF+b/9w76A3GY/2ezjCs97h4XhyMNqfTOBg2MoGndze",
+	"U0linx3KNlJHOJLAdV7Rzqqax5SD2i8tqpSdyGxjiQjtCacUc619DeBmUoUcrE6FhEMg42XZbVU2Y8fD",
+	"8X5vOOrtDW9G4+Ph8Hg4/N/HmXLtfcKyQrU0wHeczWJIzkBiEmvtq0QN2SbASXZEU/n7d6X2qwukOyd0",
+	"WQDPnEgeBArtYApv+uQrOWPDjNuULlEiTYDKDG9rAw71sHxx1VzVL/Wy+iV1MBCm5gHucRlI2J0Ce/6N",
+	"CfW11Mraf8oohcC9k612NlSZrtakELFU+jTdFer4WFQ1LPmrcdr4SL5k0aroOG3mUFWzSJTgJVrqNUWU",
+	"cpOwLqRlSIRCyJ5Uy0tw4rVRiWXaUDL6483NO2QaoICFhRdkVoqy7iElkbFXNjoz3a3OokgTvQIrkzb7",
+	"SOq9RbtEocwlx81pWwWmJGtmsasPt4KF1MNZpHzBhNmUUDvuMfmX0UN0Eekn6lNSyD3QwjaBPkxk2tF5",
+	"sONZjOnHaadrczDOAGyGAMdC72G42paGrIRcLlooDw4CxkOdjmDo4vzmB3T1wynae3V0gH7a++DVrZrw",
+	"iEBAA5ZyfAdhnppRD7I8iimtTEjIgjSz0GwPwZE2pSv6/K0fb968fml8a0kVUX48gHkVKC/50W/PdqeU",
+	"yEImAQuRJtn+T0XSTcVbTgULMlRFXGuNoArIxiIy1GmJwNfFmpkrJlfF4/DwuNKZclBUrGyRiMMixno7",
+	"i0QI02V3qk+zITQ1B4jNwJ3EwOhddiCIYoVRY9njCZqzlAskWK4X+QN1jo6zODapJckQkb6AaE3xkFcA",
+	"ZQQ+CofBIT6KRnAQTfB49irYC/fhMHqFR7O9YD/ctGanNsMlDh8zv9crUNMcceFAh1tNcJ/L814/p6Am",
+	"zgWHe8JSYR58/nlB+NIfShFPrDfHZhdUP61QcePYqoZQAeYqai4UWKngNXX5WLON6ljKtJdKEufxVmOs",
+	"NNo4Vto21v0ace3q+O2D7xVjAUHKiTSpKzOtDKdyPm54G/vk3YWpbLw8SeUcjQvVU7p+HQUc9LhxLFAU",
+	"swe9jIzZg32dTrU5zZuoL0XAFubJnMVwbEpZuK6R6xyblCy60h/RFYvVVBTa5SCQtb3OvjLtlQ9jH4G+",
+	"53EBkj/CMogZ/lgqquWA40QMGMdUYbZkAYsHysxI2AtM8DXQtEr1M0Ze+tAMVQDOKY7PWCCakvi6HBBl",
+	"uT90XQo+D/tD9OIykEzxPx6OJ6oMMi2xXopWRZ/1OKZ9xu8GIXugKoPynyT88+HklQn/Iubx3u8uNK4a",
+	"gV2UyoZQ8eWfmARAhfb/9pCRkwUO5oDG/WGNr4eHhz7WP2tubF8xeH1xev72+rw37g/7c5nEhbirs4oD",
+	"pWw6cVqprup2LPKpdJmtGVtgOdcS95ZCWYIDhZb3hSKuO58buNJJMROjZKchuYS9kpyjkK8PCrXGtp5Y",
+	"S8+UhFh46PwV5EkcZzVk3Y47c1azMh4O7eEnEqjmSie/zSQP/imMx85PcHl0WZkwmloB6TQIQAhT5M5m",
+	"Euu1kVcCbvRqiF+6nclKvm3g8x9b819ZRXqG8BccurcCDV+j74Ov91ThA+PqNRLD2N73wdgPjM9IGIKe",
+	"xv3vZRo1LuoKbv0ag0lU90u+StdcOi/1U9Eb4DAhtNP1OJIPqibTrt6MLZZMWflSfCcUOVc02PmgnrkS",
+	"Tu5Hg/IOEIF2sOJsqbItTkA0Akf1Md3SafA/+WcibzJYecD2l+5j+pfPpn4cjWi7zvoFki8ftsTS7eo+",
+	"8tKUWkJ0E6BdrRM7pN0h7XMg7dcA2rpGF+C29PXjIHfwS23T/ksrGDZpFLHysoQ1ULx8GiCusL89pG2L",
+	"ZJsg13ox7tDr94Jek+Hk++DqJt+dgNAVxj1gk7uNWErD/v/TuNZjg9vh7aaLZhfJJIQy3rxizrZsEvxP",
+	"xhtfHKsB8BtF9rteRu8Qbxev/ZYRpG64j14fe87N2gxEvHd4NS2TT31P262Un3ml7JmFp1kaN+vCDm53",
+	"cPtbXR77tbqAuLwEZ4+G3cEv3kMMN18qNx3Q3waON0djH89fd33sxa9HLojlHPzi2iHWbkm8WxI/15K4",
+	"boNPiq/bhbQto9ldJPutI9mvE8XuAthdAPu7CmCfNnatx62Piln9Vxmsw9xtw9VnDlW3ClN9EtrB0i5K",
+	"3UWpzxalVk1wOyCtHLu5WZBaC5ibotS31afsotRnjlIrM/A0Uap//nf+YBem/lbD1LpGPy28Dn6pnXP8",
+	"FXOrVaPfFHVrvH7dQLWGUbt86g6ZdpHqLp/aHksrhZ/LVthaO3guYvzxKLtt7eczg+5XLPT0yXYHwzsY",
+	"3sHwt630bMK3J4Pnx2cRWiQQdsmDb5k8ePrEwS5nsMsZ/G5yBk+XLiiHt1unCdbj6jaB6jNmBp4sK7CD",
+	"nV0kuotEv1lCYDugLB5b87jCqhKFBnS8Lj1lF3Y+c9hZFP/TxJ21Sd85gF3c+dsA1KYTrTxAKyqw5XC2",
+	"/P0HdUMBE9J3tyroC58w9aKlDyxNl5LBmnPGQMi/sHD5ZJFgGRPKp5nZ+ywrwDT6is9egT/2QOr6mW47",
+	"1NmhzvePOs0IY0y9NchsGswNfikfSfjF4FMM0nPC7Jn+XiC8FqBMywpAbRbPlflqjIFWYIIZxgpM2Nne",
+	"bsn3e8IKY3UlXV8Zj2yW31pn85UV3Ncy+OePLValuHaxxg7vdnj3Ha/IvmasNMhv1mmXFdvoUpvygerm",
+	"4mF3i65tSMxp7fq6ubypvp7E3Gcg42X1QpqVoH1WGND3jN8tbhXaENUrN4Dt8H2H7zt8/8ZbGI2Wib8J",
+	"xA/0rRk6uebP5J3TUFjUUJBbuoC2QDa7dENDuh6Uzz2wCBEpPAKIGM8vLeujyqUMCvw5JOweQnPTTXYt",
+	"lbnyLPMxPndwpUf4fB5h3Obyt/y+QT0Mc0xdNv4dKO9AeQfKTw3KCSb6HuI6MF+BNb3vAp5Lt/MM9EU1",
+	"0AzQ1yCF52KdlhcqVW459eCuIpBdfjNj9hoWdYGRQnrPbTi5K3jUnUqW+pQaal5E1yIpInrpbqKnwfSn",
+	"33hqfWdWg+0qmQs3wnV7VsNnY9teBdUEOLqNvm1ZuzujzuHOxe1c3M7FPaeL03Zn0NdYb+MVbKuc2waM",
+	"GQb0uAwK59c4HQ8G+qrJORPy+Gg4HGrMtQ+tXXBWuOFTX4PoK/e310aV3iD40t2MVPH8gTo9U1rWhmbD",
+	"wVuWZO1Mr8eQ9LDqPQJ3E9qecjZLuvRL58uHL/83AJx3kPUyxAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
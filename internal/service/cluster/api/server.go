@@ -13,10 +13,12 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/cluster/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/repo"
@@ -105,7 +107,15 @@ func (r *ClusterServer) GetClusterResources(ctx context.Context, request api.Get
 		}, nil
 	}
 
-	records, err := r.Repo.GetClusterResources(ctx)
+	selector, err := commonapi.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return api.GetClusterResources400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	records, err := r.Repo.GetClusterResources(ctx, selector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get cluster resources: %w", err)
 	}
@@ -243,7 +253,15 @@ func (r *ClusterServer) GetNodeClusters(ctx context.Context, request api.GetNode
 		}, nil
 	}
 
-	records, err := r.Repo.GetNodeClusters(ctx)
+	selector, err := commonapi.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return api.GetNodeClusters400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	records, err := r.Repo.GetNodeClusters(ctx, selector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get node clusters: %w", err)
 	}
@@ -371,10 +389,21 @@ func (r *ClusterServer) GetSubscriptions(ctx context.Context, request api.GetSub
 
 // validateSubscription validates a subscription before accepting the request
 func (r *ClusterServer) validateSubscription(ctx context.Context, request api.CreateSubscriptionRequestObject) error {
+	if request.Body.Filter != nil {
+		if _, err := search.ParseSelector(slog.Default(), *request.Body.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
 	if err := commonapi.ValidateCallbackURL(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback); err != nil {
 		return fmt.Errorf("invalid callback url: %w", err)
 	}
-	// TODO: add validation of filter and move to common if filter syntax is the same for all servers
+
+	if request.Body.VerifyCallback != nil && *request.Body.VerifyCallback {
+		if err := commonapi.VerifyCallback(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback, request.Body.SigningSecret); err != nil {
+			return fmt.Errorf("callback verification failed: %w", err)
+		}
+	}
 	return nil
 }
 
@@ -501,6 +530,114 @@ func (r *ClusterServer) DeleteSubscription(ctx context.Context, request api.Dele
 	return api.DeleteSubscription200Response{}, nil
 }
 
+// GetSubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ClusterServer) GetSubscriptionDeadLetters(ctx context.Context, request api.GetSubscriptionDeadLettersRequestObject) (api.GetSubscriptionDeadLettersResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	records, err := r.Repo.GetDeadLetterNotifications(ctx, request.SubscriptionId)
+	if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	object := models2.DeadLetterQueueToModel(request.SubscriptionId, record.SuspendedUntil, records)
+	return api.GetSubscriptionDeadLetters200JSONResponse(object), nil
+}
+
+// ReplaySubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ClusterServer) ReplaySubscriptionDeadLetters(ctx context.Context, request api.ReplaySubscriptionDeadLettersRequestObject) (api.ReplaySubscriptionDeadLettersResponseObject, error) {
+	_, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier to redeliver the notifications
+	r.SubscriptionEventHandler.ReplayDeadLetters(ctx, request.SubscriptionId)
+
+	slog.Info("Dead letter queue replay requested", "subscriptionId", request.SubscriptionId)
+	return api.ReplaySubscriptionDeadLetters202Response{}, nil
+}
+
+// RotateSubscriptionSigningSecret receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ClusterServer) RotateSubscriptionSigningSecret(ctx context.Context, request api.RotateSubscriptionSigningSecretRequestObject) (api.RotateSubscriptionSigningSecretResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if err == nil {
+		secrets := notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry).
+			Rotate(request.Body.SigningSecret, time.Now())
+		record.SigningSecret = &secrets.Current
+		record.PreviousSigningSecret = secrets.Previous
+		record.PreviousSigningSecretExpiry = secrets.PreviousExpiry
+		record, err = r.Repo.UpdateSubscriptionSigningSecret(ctx, record)
+	}
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier so that the next deliveries are signed with the new secret
+	r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed:      false,
+		Subscription: models.SubscriptionToInfo(record),
+	})
+
+	slog.Info("Subscription signing secret rotated", "subscriptionId", request.SubscriptionId)
+	return api.RotateSubscriptionSigningSecret200JSONResponse(generated.SigningSecretStatus{
+		SubscriptionId:       request.SubscriptionId,
+		PreviousSecretExpiry: record.PreviousSigningSecretExpiry,
+	}), nil
+}
+
 // GetAlarmDictionaries receives the API request to this endpoint, executes the request, and responds appropriately
 func (r *ClusterServer) GetAlarmDictionaries(ctx context.Context, request api.GetAlarmDictionariesRequestObject) (api.GetAlarmDictionariesResponseObject, error) {
 	records, err := r.Repo.GetAlarmDictionaries(ctx)
@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/google/uuid"
 	. "github.com/onsi/ginkgo/v2"
@@ -18,7 +19,9 @@ import (
 
 	apigenerated "github.com/openshift-kni/oran-o2ims/internal/service/cluster/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/repo/generated"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 )
 
@@ -343,4 +346,137 @@ var _ = Describe("Cluster Server", func() {
 		})
 	})
 
+	Describe("GetSubscriptionDeadLetters", func() {
+		When("subscription does not exist", func() {
+			It("returns not found", func() {
+				mockRepo.EXPECT().
+					GetSubscription(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.GetSubscriptionDeadLetters(ctx, apigenerated.GetSubscriptionDeadLettersRequestObject{
+					SubscriptionId: testUUID,
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp).To(BeAssignableToTypeOf(apigenerated.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{}))
+			})
+		})
+		When("subscription is no longer suspended", func() {
+			It("returns the queue without the expired suspension", func() {
+				suspendedUntil := time.Now().Add(-time.Minute)
+				deadLetterID := uuid.New()
+				mockRepo.EXPECT().
+					GetSubscription(ctx, testUUID).
+					Return(&models.Subscription{SubscriptionID: &testUUID, SuspendedUntil: &suspendedUntil}, nil)
+				mockRepo.EXPECT().
+					GetDeadLetterNotifications(ctx, testUUID).
+					Return([]models.DeadLetterNotification{
+						{
+							DeadLetterID:   &deadLetterID,
+							SubscriptionID: testUUID,
+							SequenceID:     7,
+							Attempts:       2,
+						},
+					}, nil)
+
+				resp, err := server.GetSubscriptionDeadLetters(ctx, apigenerated.GetSubscriptionDeadLettersRequestObject{
+					SubscriptionId: testUUID,
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp).To(BeAssignableToTypeOf(apigenerated.GetSubscriptionDeadLetters200JSONResponse{}))
+				queue := resp.(apigenerated.GetSubscriptionDeadLetters200JSONResponse)
+				Expect(queue.SuspendedUntil).To(BeNil())
+				Expect(queue.Notifications).To(HaveLen(1))
+				Expect(queue.Notifications[0].DeadLetterId).To(Equal(deadLetterID))
+				Expect(queue.Notifications[0].Attempts).To(Equal(2))
+			})
+		})
+	})
+
+	Describe("CreateSubscription", func() {
+		When("the filter is invalid", func() {
+			It("returns bad request", func() {
+				filter := "(eq,objectType"
+				resp, err := server.CreateSubscription(ctx, apigenerated.CreateSubscriptionRequestObject{
+					Body: &apigenerated.Subscription{Callback: "https://smo.example.com/notifications", Filter: &filter},
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp).To(BeAssignableToTypeOf(apigenerated.CreateSubscription400ApplicationProblemPlusJSONResponse{}))
+				problem := resp.(apigenerated.CreateSubscription400ApplicationProblemPlusJSONResponse)
+				Expect(problem.Detail).To(ContainSubstring("invalid filter"))
+				Expect(*problem.AdditionalAttributes).To(HaveKeyWithValue("filter", filter))
+			})
+		})
+	})
+
+	Describe("RotateSubscriptionSigningSecret", func() {
+		var handler *fakeSubscriptionEventHandler
+
+		BeforeEach(func() {
+			handler = &fakeSubscriptionEventHandler{}
+			server.SubscriptionEventHandler = handler
+		})
+
+		When("subscription does not exist", func() {
+			It("returns not found", func() {
+				mockRepo.EXPECT().
+					GetSubscription(ctx, testUUID).
+					Return(nil, svcutils.ErrNotFound)
+
+				resp, err := server.RotateSubscriptionSigningSecret(ctx, apigenerated.RotateSubscriptionSigningSecretRequestObject{
+					SubscriptionId: testUUID,
+					Body:           &common.SigningSecretRotation{SigningSecret: "new-secret-value"},
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp).To(BeAssignableToTypeOf(apigenerated.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse{}))
+				Expect(handler.events).To(BeEmpty())
+			})
+		})
+		When("subscription does not have a secret", func() {
+			It("sets the secret and signals the notifier", func() {
+				mockRepo.EXPECT().
+					GetSubscription(ctx, testUUID).
+					Return(&models.Subscription{SubscriptionID: &testUUID}, nil)
+				mockRepo.EXPECT().
+					UpdateSubscriptionSigningSecret(ctx, gomock.Any()).
+					DoAndReturn(func(_ context.Context, record *models.Subscription) (*models.Subscription, error) {
+						Expect(*record.SigningSecret).To(Equal("new-secret-value"))
+						Expect(record.PreviousSigningSecret).To(BeNil())
+						Expect(record.PreviousSigningSecretExpiry).To(BeNil())
+						return record, nil
+					})
+
+				resp, err := server.RotateSubscriptionSigningSecret(ctx, apigenerated.RotateSubscriptionSigningSecretRequestObject{
+					SubscriptionId: testUUID,
+					Body:           &common.SigningSecretRotation{SigningSecret: "new-secret-value"},
+				})
+
+				Expect(err).To(BeNil())
+				Expect(resp).To(BeAssignableToTypeOf(apigenerated.RotateSubscriptionSigningSecret200JSONResponse{}))
+				Expect(resp.(apigenerated.RotateSubscriptionSigningSecret200JSONResponse).PreviousSecretExpiry).To(BeNil())
+				Expect(handler.events).To(HaveLen(1))
+				Expect(handler.events[0].Subscription.SigningSecrets.Current).To(Equal("new-secret-value"))
+			})
+		})
+	})
+
 })
+
+// fakeSubscriptionEventHandler records the subscription events sent to the notifier
+type fakeSubscriptionEventHandler struct {
+	events []*notifier.SubscriptionEvent
+}
+
+func (f *fakeSubscriptionEventHandler) SubscriptionEvent(ctx context.Context, event *notifier.SubscriptionEvent) {
+	f.events = append(f.events, event)
+}
+
+func (f *fakeSubscriptionEventHandler) ReplayDeadLetters(ctx context.Context, subscriptionID uuid.UUID) {
+}
+
+func (f *fakeSubscriptionEventHandler) GetClientFactory() notifier.ClientProvider {
+	return nil
+}
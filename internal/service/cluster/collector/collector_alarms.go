@@ -10,6 +10,7 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"strings"
 	"sync"
 
 	"github.com/go-logr/logr"
@@ -369,13 +370,18 @@ func (d *AlarmsDataSource) createAlarmDefinitions(rules []monitoringv1.Rule, ala
 		description := rule.Annotations["description"]
 		runbookURL := rule.Annotations["runbook_url"]
 
+		clearingType := common.AUTOMATIC
+		if strings.EqualFold(rule.Annotations[ctlrutils.AlarmDefinitionClearingTypeAnnotation], string(common.MANUAL)) {
+			clearingType = common.MANUAL
+		}
+
 		record := commonmodels.AlarmDefinition{
 			AlarmName:             rule.Alert,
 			AlarmLastChange:       version,
 			AlarmChangeType:       string(common.ADDED),
 			AlarmDescription:      fmt.Sprintf("Summary: %s\nDescription: %s", summary, description),
 			ProposedRepairActions: runbookURL,
-			ClearingType:          string(common.AUTOMATIC),
+			ClearingType:          string(clearingType),
 			AlarmAdditionalFields: &additionalFields,
 			Severity:              rule.Labels[ctlrutils.AlarmDefinitionSeverityField],
 			IsThanosRule:          isThanosRule,
@@ -31,7 +31,7 @@ import (
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/async"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients/k8s"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
-	"github.com/openshift-kni/oran-o2ims/internal/service/resources/collector"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
 )
 
 // Interface compile enforcement
@@ -192,7 +192,7 @@ func (d *K8SDataSource) getInventoryResourceID(agent *v1beta1.Agent) uuid.UUID {
 		return uuid.Nil
 	}
 
-	return collector.MakeResourceID(d.cloudID, hwMgrID, hwMgrNodeID)
+	return hardware.MakeResourceID(d.cloudID, hwMgrID, hwMgrNodeID)
 }
 
 // convertAgentToClusterResource converts an Agent CR to a ClusterResource object
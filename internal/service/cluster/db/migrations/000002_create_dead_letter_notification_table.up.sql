@@ -0,0 +1,20 @@
+-- Suspends deliveries to a subscriber after a notification could not be delivered
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ NULL;
+
+-- Table: dead_letter_notification
+-- Description: notifications that could not be delivered to a subscriber, kept so that they can be redelivered
+CREATE TABLE IF NOT EXISTS dead_letter_notification
+(
+    dead_letter_id  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID    NOT NULL,
+    notification_id UUID    NOT NULL, -- data_change_id of the original notification
+    sequence_id     INTEGER NOT NULL, -- sequence_id of the original notification
+    payload         JSONB   NOT NULL, -- notification as it would have been sent to the subscriber
+    attempts        INTEGER NOT NULL DEFAULT 1,
+    last_error      TEXT    NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (subscription_id) REFERENCES subscription (subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letter_notification_subscription ON dead_letter_notification (subscription_id, sequence_id);
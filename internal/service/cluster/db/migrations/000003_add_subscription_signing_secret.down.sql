@@ -0,0 +1,3 @@
+ALTER TABLE subscription DROP COLUMN IF EXISTS previous_signing_secret_expiry;
+ALTER TABLE subscription DROP COLUMN IF EXISTS previous_signing_secret;
+ALTER TABLE subscription DROP COLUMN IF EXISTS signing_secret;
@@ -0,0 +1,5 @@
+-- Shared secrets used to sign the notifications sent to a subscriber.  The previous secret remains in use until its
+-- expiry so that subscribers can roll over to a rotated secret.
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS signing_secret TEXT NULL;
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS previous_signing_secret TEXT NULL;
+ALTER TABLE subscription ADD COLUMN IF NOT EXISTS previous_signing_secret_expiry TIMESTAMPTZ NULL;
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -45,6 +46,21 @@ func (r ClusterResource) PrimaryKey() string { return "cluster_resource_id" }
 // OnConflict returns the column or constraint to be used in the UPSERT operation
 func (r ClusterResource) OnConflict() string { return "" }
 
+// clusterResourceSearchColumns maps the attributes of the API object to the columns storing their values
+var clusterResourceSearchColumns = map[string]search.Column{
+	"clusterResourceId":     {Name: "cluster_resource_id", Type: search.ColumnTypeUUID},
+	"clusterResourceTypeId": {Name: "cluster_resource_type_id", Type: search.ColumnTypeUUID},
+	"resourceId":            {Name: "resource_id", Type: search.ColumnTypeUUID},
+	"name":                  {Name: "name", Type: search.ColumnTypeText},
+	"description":           {Name: "description", Type: search.ColumnTypeText},
+	"extensions":            {Name: "extensions", Type: search.ColumnTypeJSON},
+}
+
+// SearchColumns returns the columns that can be used to filter the API objects in the database
+func (r ClusterResource) SearchColumns() map[string]search.Column {
+	return clusterResourceSearchColumns
+}
+
 // ClusterResourceIDs represents the data returned in a customized query to return the list of ClusterResource ID values
 // associated to each NodeCluster
 type ClusterResourceIDs struct {
@@ -141,6 +141,7 @@ func SubscriptionFromModel(object *generated.Subscription) *models.Subscription
 		Filter:                 object.Filter,
 		Callback:               object.Callback,
 		EventCursor:            0,
+		SigningSecret:          object.SigningSecret,
 	}
 
 	return &record
@@ -154,6 +155,9 @@ func SubscriptionToInfo(record *models.Subscription) *notifier.SubscriptionInfo
 		Callback:               record.Callback,
 		Filter:                 record.Filter,
 		EventCursor:            record.EventCursor,
+		SuspendedUntil:         record.SuspendedUntil,
+		SigningSecrets:         notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry),
+		Criteria:               models.NewSubscriptionCriteria(record),
 	}
 }
 
@@ -172,6 +176,22 @@ func getEventType(before, after map[string]interface{}) int {
 	}
 }
 
+// getObjectType returns the API type name of the objects stored in a table
+func getObjectType(objectType string) string {
+	switch objectType {
+	case ClusterResourceType{}.TableName():
+		return "ClusterResourceType"
+	case ClusterResource{}.TableName():
+		return "ClusterResource"
+	case NodeClusterType{}.TableName():
+		return "NodeClusterType"
+	case NodeCluster{}.TableName():
+		return "NodeCluster"
+	default:
+		return objectType
+	}
+}
+
 // getObjectReference builds a partial URL referencing the API path location of the object
 func getObjectReference(objectType string, objectID uuid.UUID) *string {
 	var value string
@@ -217,6 +237,7 @@ func DataChangeEventToNotification(record *models.DataChangeEvent) *notifier.Not
 		NotificationID: *record.DataChangeID,
 		SequenceID:     *record.SequenceID,
 		Payload:        DataChangeEventToModel(record),
+		ObjectType:     getObjectType(record.ObjectType),
 	}
 }
 
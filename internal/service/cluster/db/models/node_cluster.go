@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -44,3 +45,18 @@ func (r NodeCluster) PrimaryKey() string { return "node_cluster_id" }
 
 // OnConflict returns the column or constraint to be used in the UPSERT operation
 func (r NodeCluster) OnConflict() string { return "" }
+
+// nodeClusterSearchColumns maps the attributes of the API object to the columns storing their values
+var nodeClusterSearchColumns = map[string]search.Column{
+	"nodeClusterId":                  {Name: "node_cluster_id", Type: search.ColumnTypeUUID},
+	"nodeClusterTypeId":              {Name: "node_cluster_type_id", Type: search.ColumnTypeUUID},
+	"clientNodeClusterId":            {Name: "client_node_cluster_id", Type: search.ColumnTypeUUID},
+	"artifactResourceId":             {Name: "artifact_resource_id", Type: search.ColumnTypeUUID},
+	"name":                           {Name: "name", Type: search.ColumnTypeText},
+	"description":                    {Name: "description", Type: search.ColumnTypeText},
+	"clusterDistributionDescription": {Name: "cluster_distribution_description", Type: search.ColumnTypeText},
+	"extensions":                     {Name: "extensions", Type: search.ColumnTypeJSON},
+}
+
+// SearchColumns returns the columns that can be used to filter the API objects in the database
+func (r NodeCluster) SearchColumns() map[string]search.Column { return nodeClusterSearchColumns }
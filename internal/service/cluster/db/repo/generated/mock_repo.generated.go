@@ -13,6 +13,7 @@ import (
 	reflect "reflect"
 
 	uuid "github.com/google/uuid"
+	search "github.com/openshift-kni/oran-o2ims/internal/search"
 	models "github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/models"
 	models0 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	gomock "go.uber.org/mock/gomock"
@@ -71,6 +72,21 @@ func (mr *MockRepositoryInterfaceMockRecorder) CreateDataSource(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDataSource", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateDataSource), arg0, arg1)
 }
 
+// CreateDeadLetterNotification mocks base method.
+func (m *MockRepositoryInterface) CreateDeadLetterNotification(arg0 context.Context, arg1 *models0.DeadLetterNotification) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeadLetterNotification", arg0, arg1)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeadLetterNotification indicates an expected call of CreateDeadLetterNotification.
+func (mr *MockRepositoryInterfaceMockRecorder) CreateDeadLetterNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeadLetterNotification", reflect.TypeOf((*MockRepositoryInterface)(nil).CreateDeadLetterNotification), arg0, arg1)
+}
+
 // CreateSubscription mocks base method.
 func (m *MockRepositoryInterface) CreateSubscription(arg0 context.Context, arg1 *models0.Subscription) (*models0.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -116,6 +132,21 @@ func (mr *MockRepositoryInterfaceMockRecorder) DeleteDataChangeEvent(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataChangeEvent", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteDataChangeEvent), arg0, arg1)
 }
 
+// DeleteDeadLetterNotification mocks base method.
+func (m *MockRepositoryInterface) DeleteDeadLetterNotification(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDeadLetterNotification", arg0, arg1)
+	ret0, _ := ret[0].(int64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDeadLetterNotification indicates an expected call of DeleteDeadLetterNotification.
+func (mr *MockRepositoryInterfaceMockRecorder) DeleteDeadLetterNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDeadLetterNotification", reflect.TypeOf((*MockRepositoryInterface)(nil).DeleteDeadLetterNotification), arg0, arg1)
+}
+
 // DeleteSubscription mocks base method.
 func (m *MockRepositoryInterface) DeleteSubscription(arg0 context.Context, arg1 uuid.UUID) (int64, error) {
 	m.ctrl.T.Helper()
@@ -237,18 +268,18 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetClusterResourceTypes(arg0 any)
 }
 
 // GetClusterResources mocks base method.
-func (m *MockRepositoryInterface) GetClusterResources(arg0 context.Context) ([]models.ClusterResource, error) {
+func (m *MockRepositoryInterface) GetClusterResources(arg0 context.Context, arg1 *search.Selector) ([]models.ClusterResource, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetClusterResources", arg0)
+	ret := m.ctrl.Call(m, "GetClusterResources", arg0, arg1)
 	ret0, _ := ret[0].([]models.ClusterResource)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetClusterResources indicates an expected call of GetClusterResources.
-func (mr *MockRepositoryInterfaceMockRecorder) GetClusterResources(arg0 any) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) GetClusterResources(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterResources", reflect.TypeOf((*MockRepositoryInterface)(nil).GetClusterResources), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterResources", reflect.TypeOf((*MockRepositoryInterface)(nil).GetClusterResources), arg0, arg1)
 }
 
 // GetClusterResourcesNotIn mocks base method.
@@ -296,6 +327,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetDataSourceByName(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDataSourceByName", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDataSourceByName), arg0, arg1)
 }
 
+// GetDeadLetterNotification mocks base method.
+func (m *MockRepositoryInterface) GetDeadLetterNotification(arg0 context.Context, arg1 uuid.UUID) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterNotification", arg0, arg1)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterNotification indicates an expected call of GetDeadLetterNotification.
+func (mr *MockRepositoryInterfaceMockRecorder) GetDeadLetterNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterNotification", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDeadLetterNotification), arg0, arg1)
+}
+
+// GetDeadLetterNotifications mocks base method.
+func (m *MockRepositoryInterface) GetDeadLetterNotifications(arg0 context.Context, arg1 uuid.UUID) ([]models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDeadLetterNotifications", arg0, arg1)
+	ret0, _ := ret[0].([]models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDeadLetterNotifications indicates an expected call of GetDeadLetterNotifications.
+func (mr *MockRepositoryInterfaceMockRecorder) GetDeadLetterNotifications(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDeadLetterNotifications", reflect.TypeOf((*MockRepositoryInterface)(nil).GetDeadLetterNotifications), arg0, arg1)
+}
+
 // GetNodeCluster mocks base method.
 func (m *MockRepositoryInterface) GetNodeCluster(arg0 context.Context, arg1 uuid.UUID) (*models.NodeCluster, error) {
 	m.ctrl.T.Helper()
@@ -407,18 +468,18 @@ func (mr *MockRepositoryInterfaceMockRecorder) GetNodeClusterTypes(arg0 any) *go
 }
 
 // GetNodeClusters mocks base method.
-func (m *MockRepositoryInterface) GetNodeClusters(arg0 context.Context) ([]models.NodeCluster, error) {
+func (m *MockRepositoryInterface) GetNodeClusters(arg0 context.Context, arg1 *search.Selector) ([]models.NodeCluster, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNodeClusters", arg0)
+	ret := m.ctrl.Call(m, "GetNodeClusters", arg0, arg1)
 	ret0, _ := ret[0].([]models.NodeCluster)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetNodeClusters indicates an expected call of GetNodeClusters.
-func (mr *MockRepositoryInterfaceMockRecorder) GetNodeClusters(arg0 any) *gomock.Call {
+func (mr *MockRepositoryInterfaceMockRecorder) GetNodeClusters(arg0, arg1 any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeClusters", reflect.TypeOf((*MockRepositoryInterface)(nil).GetNodeClusters), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNodeClusters", reflect.TypeOf((*MockRepositoryInterface)(nil).GetNodeClusters), arg0, arg1)
 }
 
 // GetNodeClustersNotIn mocks base method.
@@ -496,6 +557,21 @@ func (mr *MockRepositoryInterfaceMockRecorder) UpdateDataSource(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDataSource", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateDataSource), arg0, arg1)
 }
 
+// UpdateDeadLetterNotification mocks base method.
+func (m *MockRepositoryInterface) UpdateDeadLetterNotification(arg0 context.Context, arg1 *models0.DeadLetterNotification) (*models0.DeadLetterNotification, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDeadLetterNotification", arg0, arg1)
+	ret0, _ := ret[0].(*models0.DeadLetterNotification)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDeadLetterNotification indicates an expected call of UpdateDeadLetterNotification.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateDeadLetterNotification(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDeadLetterNotification", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateDeadLetterNotification), arg0, arg1)
+}
+
 // UpdateSubscription mocks base method.
 func (m *MockRepositoryInterface) UpdateSubscription(arg0 context.Context, arg1 *models0.Subscription) (*models0.Subscription, error) {
 	m.ctrl.T.Helper()
@@ -511,6 +587,36 @@ func (mr *MockRepositoryInterfaceMockRecorder) UpdateSubscription(arg0, arg1 any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscription", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateSubscription), arg0, arg1)
 }
 
+// UpdateSubscriptionEventCursor mocks base method.
+func (m *MockRepositoryInterface) UpdateSubscriptionEventCursor(arg0 context.Context, arg1 *models0.Subscription) (*models0.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionEventCursor", arg0, arg1)
+	ret0, _ := ret[0].(*models0.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscriptionEventCursor indicates an expected call of UpdateSubscriptionEventCursor.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateSubscriptionEventCursor(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionEventCursor", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateSubscriptionEventCursor), arg0, arg1)
+}
+
+// UpdateSubscriptionSigningSecret mocks base method.
+func (m *MockRepositoryInterface) UpdateSubscriptionSigningSecret(arg0 context.Context, arg1 *models0.Subscription) (*models0.Subscription, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSubscriptionSigningSecret", arg0, arg1)
+	ret0, _ := ret[0].(*models0.Subscription)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSubscriptionSigningSecret indicates an expected call of UpdateSubscriptionSigningSecret.
+func (mr *MockRepositoryInterfaceMockRecorder) UpdateSubscriptionSigningSecret(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSubscriptionSigningSecret", reflect.TypeOf((*MockRepositoryInterface)(nil).UpdateSubscriptionSigningSecret), arg0, arg1)
+}
+
 // UpsertAlarmDefinitions mocks base method.
 func (m *MockRepositoryInterface) UpsertAlarmDefinitions(arg0 context.Context, arg1 []models0.AlarmDefinition) ([]models0.AlarmDefinition, error) {
 	m.ctrl.T.Helper()
@@ -16,6 +16,7 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/dialect"
 	"github.com/stephenafamo/bob/dialect/psql/im"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/models"
 	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
@@ -38,9 +39,10 @@ func (r *ClusterRepository) GetNodeClusterType(ctx context.Context, id uuid.UUID
 	return svcutils.Find[models.NodeClusterType](ctx, r.Db, id)
 }
 
-// GetNodeClusters returns the list of NodeCluster records or an empty list if none exist; otherwise an error
-func (r *ClusterRepository) GetNodeClusters(ctx context.Context) ([]models.NodeCluster, error) {
-	return svcutils.FindAll[models.NodeCluster](ctx, r.Db)
+// GetNodeClusters returns the list of NodeCluster records matching the selector or an empty list if none exist;
+// otherwise an error
+func (r *ClusterRepository) GetNodeClusters(ctx context.Context, selector *search.Selector) ([]models.NodeCluster, error) {
+	return svcutils.SearchWithSelector[models.NodeCluster](ctx, r.Db, nil, selector)
 }
 
 // GetNodeClustersNotIn returns the list of NodeCluster records not matching the list of keys provided, or an empty list
@@ -115,9 +117,10 @@ func (r *ClusterRepository) GetClusterResourceType(ctx context.Context, id uuid.
 	return svcutils.Find[models.ClusterResourceType](ctx, r.Db, id)
 }
 
-// GetClusterResources returns the list of ClusterResource records or an empty list if none exist; otherwise an error
-func (r *ClusterRepository) GetClusterResources(ctx context.Context) ([]models.ClusterResource, error) {
-	return svcutils.FindAll[models.ClusterResource](ctx, r.Db)
+// GetClusterResources returns the list of ClusterResource records matching the selector or an empty list if none
+// exist; otherwise an error
+func (r *ClusterRepository) GetClusterResources(ctx context.Context, selector *search.Selector) ([]models.ClusterResource, error) {
+	return svcutils.SearchWithSelector[models.ClusterResource](ctx, r.Db, nil, selector)
 }
 
 // GetClusterResourcesNotIn returns the list of ClusterResource records not matching the list of keys provided, or an
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/cluster/db/models"
 	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
@@ -22,7 +23,7 @@ type RepositoryInterface interface {
 	repo.RepositoryInterface
 	GetNodeClusterTypes(context.Context) ([]models.NodeClusterType, error)
 	GetNodeClusterType(context.Context, uuid.UUID) (*models.NodeClusterType, error)
-	GetNodeClusters(context.Context) ([]models.NodeCluster, error)
+	GetNodeClusters(context.Context, *search.Selector) ([]models.NodeCluster, error)
 	GetNodeClustersNotIn(context.Context, []any) ([]models.NodeCluster, error)
 	GetNodeCluster(context.Context, uuid.UUID) (*models.NodeCluster, error)
 	GetNodeClusterByName(context.Context, string) (*models.NodeCluster, error)
@@ -30,7 +31,7 @@ type RepositoryInterface interface {
 	GetNodeClusterResourceIDs(context.Context, ...any) ([]models.ClusterResourceIDs, error)
 	GetClusterResourceTypes(context.Context) ([]models.ClusterResourceType, error)
 	GetClusterResourceType(context.Context, uuid.UUID) (*models.ClusterResourceType, error)
-	GetClusterResources(context.Context) ([]models.ClusterResource, error)
+	GetClusterResources(context.Context, *search.Selector) ([]models.ClusterResource, error)
 	GetClusterResourcesNotIn(context.Context, []any) ([]models.ClusterResource, error)
 	GetClusterResource(context.Context, uuid.UUID) (*models.ClusterResource, error)
 	UpsertAlarmDefinitions(context.Context, []commonmodels.AlarmDefinition) ([]commonmodels.AlarmDefinition, error)
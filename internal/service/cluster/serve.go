@@ -125,7 +125,10 @@ func Serve(config *api.ClusterServerConfig) error {
 
 	// Create the notifier with our resource-specific subscription and notification providers.
 	notificationsProvider := repo2.NewNotificationStorageProvider(commonRepository)
-	subscriptionsProvider := repo2.NewSubscriptionStorageProvider(commonRepository, collector.NewNotificationTransformer())
+	subscriptionsProvider, err := repo2.NewSubscriptionStorageProvider(commonRepository, collector.NewNotificationTransformer())
+	if err != nil {
+		return fmt.Errorf("failed to create subscription provider: %w", err)
+	}
 	clientFactory := notifier.NewClientFactory(oauthConfig, constants.DefaultBackendTokenFile)
 	clusterNotifier := notifier.NewNotifier(subscriptionsProvider, notificationsProvider, clientFactory)
 
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"path"
 	"strings"
+	"time"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	openapi_types "github.com/oapi-codegen/runtime/types"
@@ -130,6 +131,61 @@ type AlarmDictionary struct {
 // AlarmDictionaryManagementInterfaceId defines model for AlarmDictionary.ManagementInterfaceId.
 type AlarmDictionaryManagementInterfaceId string
 
+// CallbackVerification The challenge POSTed to the callback of a new subscription when its verification is requested. The request
+// carries the X-O2ims-Callback-Verification header and, if the subscription has a signing secret, is signed like
+// any other notification. The subscriber must respond with a 2xx status and a CallbackVerificationResponse
+// holding the same challenge.
+type CallbackVerification struct {
+	// Challenge Random value to be echoed back by the subscriber.
+	Challenge string `json:"challenge"`
+}
+
+// CallbackVerificationResponse The response expected from the callback of a subscription to a CallbackVerification.
+type CallbackVerificationResponse struct {
+	// Challenge The challenge received in the CallbackVerification.
+	Challenge string `json:"challenge"`
+}
+
+// DeadLetterNotification A notification that could not be delivered to a subscriber.
+type DeadLetterNotification struct {
+	// Attempts Number of times delivery of the notification has failed.
+	Attempts int `json:"attempts"`
+
+	// CreatedAt Time when the notification was added to the queue.
+	CreatedAt time.Time `json:"createdAt"`
+
+	// DeadLetterId Identifier of the entry in the dead letter queue.
+	DeadLetterId openapi_types.UUID `json:"deadLetterId"`
+
+	// LastError The error returned by the last delivery attempt.
+	LastError string `json:"lastError"`
+
+	// Notification The notification payload, as it would have been sent to the subscriber.
+	Notification map[string]interface{} `json:"notification"`
+
+	// NotificationId Identifier of the original notification.
+	NotificationId openapi_types.UUID `json:"notificationId"`
+
+	// SequenceId Sequence number of the original notification.
+	SequenceId int `json:"sequenceId"`
+
+	// UpdatedAt Time of the last delivery attempt.
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// DeadLetterQueue The notifications that could not be delivered to a subscriber. A notification is added to this queue once all
+// delivery attempts have failed, or when it is raised while the subscription is suspended.
+type DeadLetterQueue struct {
+	Notifications []DeadLetterNotification `json:"notifications"`
+
+	// SubscriptionId Identifier of the subscription that owns the queue.
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+
+	// SuspendedUntil Set while the subscription is suspended after a delivery failure. Notifications raised before this time
+	// are not sent to the subscriber and are added directly to the queue.
+	SuspendedUntil *time.Time `json:"suspendedUntil,omitempty"`
+}
+
 // ProblemDetails defines model for ProblemDetails.
 type ProblemDetails struct {
 	// AdditionalAttributes Any number of additional attributes, as defined in a specification or by an implementation.
@@ -155,6 +211,23 @@ type ProblemDetails struct {
 	Type *string `json:"type,omitempty"`
 }
 
+// SigningSecretRotation The new shared secret used to sign the notifications sent to a subscriber. The secret it replaces, if any,
+// continues to be used alongside the new one for 24 hours so that the subscriber can roll over to it.
+type SigningSecretRotation struct {
+	// SigningSecret The new shared secret.
+	SigningSecret string `json:"signingSecret"`
+}
+
+// SigningSecretStatus The result of the rotation of the shared secret of a subscription.
+type SigningSecretStatus struct {
+	// PreviousSecretExpiry Set if the subscription had a secret before the rotation. Notifications carry a signature computed with
+	// the previous secret until this time.
+	PreviousSecretExpiry *time.Time `json:"previousSecretExpiry,omitempty"`
+
+	// SubscriptionId Identifier of the subscription.
+	SubscriptionId openapi_types.UUID `json:"subscriptionId"`
+}
+
 // AlarmDictionaryId defines model for alarmDictionaryId.
 type AlarmDictionaryId = openapi_types.UUID
 
@@ -173,55 +246,72 @@ type Filter = string
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xa3XPbuBH/V3bYztzlRpbzcU1T39yDx3bmNBfHri1fH6JMBZFLEQ0I0Piwo178v3cW",
-	"AD9EUpZz12n7UD9ZIrD47e5vP7DUr0mqykpJlNYkR78mFdOsRIvaf2KC6fKUp5YryfRmltGXGZpU84q+",
-	"S46SG8lvHQLPUFqec9SgcmAS/FbImr3ThUwmCX5mZSUwOUpevcnS1etXq4PVi/z5wffZy/TgzZtVfvCn",
-	"199///r1n59j/vxFMkk4nVExWySTRLKSdg5BTRKNt45rzJIjqx1OEpMWWDJCmytdMpscJc5xWmk3FQkx",
-	"VnO5Th4eJgkT4i1HkZmhcvOCG7i5msGtQ72BxjhA56GxBmzBLDAhgMwo8DMwazVfOYsGmEbgMhUuwwy4",
-	"BFsgaDSVkganC7mQy+VyIZkQf8/9+fGLWmt/Zlftel3S1S/DnDlBCuZMGKT1Tgi2IiMHWww1xs8e1C6t",
-	"T1RZMjBI6lrMQHBjyav+dNCYo0aZogGrIIqCXKuyVtAJ69U7Y2nR3wTcAItfkmITUBrosFvnHzfH0EPT",
-	"AbHagBHMFGim8FbphYxUmnRREIBlqpy0erME41ZBlsrDE/xsURqupFmGU44aL0QJ0cI/tisPo7i4biH/",
-	"ViC5kpsOHbiR31hwBjOQKipwz4WAFdbYMm+SYPJABm6CZfsLAe9QAveYN55E+LkSPOVWbFo+OcPlmpYs",
-	"5DKAXraA+sHmLT3UaQfTtm2xxbYhl/J/A4miUp0Y+c9TaI02kIR2RXoAk9nv4FTk0g7jP5VQlFzopCCt",
-	"YYtG67T0tPodrn6ii4VFPXTxNTKdFpBqblFz5h12oqRlXBpQEskvpdIIZnvhpOcTLHmqhJJmCt7fveXe",
-	"3wtpXSUQ0iCfuM8kqAo1s0pPGkK0LCHfdUHcMeHI8/MCm32QMrmQK1q8qT2aKyHUPR0QTGC8Q7/ARb3n",
-	"C5wj8wh+y9+Xhfxy0Px1/v0NfySLuCntkiTDObNpgSbmjmiRtPYIfeWNsBMXLPF2GT6Ny+IG8NYxQQHz",
-	"iLgga233yVprZMR2WzC5S14tC5dfIUvpUZxBFpf7cHna5O1Os9NeYq+OAo15VMGOrH06trL6CraygywZ",
-	"SbFDVqbQgFS2JscObFFWJMVuXCRpHy+irGj8x2Xts/8Xish5s2urMtAmSm4koCMnZs/4Sa3+gakdFo6F",
-	"rLfG9TuLB3RrhzMjrcdBVEkanuFC7i8WlGR//BZvR7L35Oyvz5p6MW/NQs0BCWZ67Upq4RsFY7LqY/Ug",
-	"bpedBKjKimk0C5kWmH5q/BE8qPYG/7RG5MOKcm7wcX2AAeOqSmkLpROWUwqvE3Hfih5AfX5jyoXs23JH",
-	"3fX4uC1Qw/Lsekm+Xd5cDw3M5aiBryc318+2a3I0ch0jKXU0ZlLTgA4wFfMtDDVqEjEjNVYIxmmtnMwi",
-	"bbhcC4Rbpyya6UI+rne3/Yh0DnUIluUGUuGMRb0c5Y0v/d+0q77p6dN4oKmsO+qw5xU1HxPffQQWlFA6",
-	"Y6GkuIVc6dB7hmuP9YU549QYkEp+0Qj32trq25gxzTldgzqawndMZt/1wqtxIJmIvP1Ee/ywK7z6rt/f",
-	"joUmdX8/1gBpcTzb2Yz5PuuxZuyhfug77uPL2S+oje/G+s3ZTIabLxmJrZSzwOAuLK7D+vhyFtBWmsLV",
-	"cvRS71qRrRovps+nz0evz/GbkFKTh0kHlXkarPpqEA82e/CxinflNxg/dKBHvA8fJwm3WPqFf9SYJ0fJ",
-	"Hw7bicdhNOZhx5KtSkxrtqHPTvNLjTn/vG2TQ/WSl+aAy1wzY7VLrdM4k3cordKbw7sXT7SXn2dgziW3",
-	"T3VlM19ptk2HdqIVx1mISrZzxvEuWr9EyzJmGXzCzUFI/xXj2gTaWwXMGJVyZhHK0P/mTrS7YlHQKHw+",
-	"0WiU0ykCqRu8OFDcAzwpmFzj3D8bKp7xlNmQYb0kApr6HWHyotLUaY0ZZE7H+3C0jGDGxqU/AMsySmYZ",
-	"UqrKJlCqjOe8CVbpyuToQ3J8enp2mkyS07N3Z3P/3/nF6ezt7Ow0+TjwZITf+m1sOHap1R3PqFCDG5uT",
-	"tXBXSPA14wYzuqNwU2f/S81LpjfwM9LtP5rZcwbaIZjXY8+oq0HcQfgIYKHkGjU0z+8av28jbxM/VX8G",
-	"HYH1wlTJusmqg3she7tbpbm06GtnKKYamS9Pneuev68TICbJoIxk3hMdClZVKDGLUAxKE9o6QoF5jqk1",
-	"ky04k3BP9G0DLyuWEnmZRtZkIbMxFsstCvcs+o4ZG2i8j8J9t0FMOsAl3Bc8LULhGTA4e+z497567PSk",
-	"Kaj78n1trNpB/rjEVCCj/3cEZM1eA/cFeqMFrNyA30nGc1ZRskqZEBt/+2bS0f+9YLuZX5wfz2cnFGbH",
-	"72+O340GWckkWyP1tjNpUecsxbFAa5JYs9zTyK8HdYc6mtejjX2zZtKU3JLDg2G4gTNpud3APCStq7Pr",
-	"+dXsZD67eH/kmxky3sXBiVAug9n5NVyjvuOhA+Qm9sx+rFdyGwh88XJ2fh00bwpRbQL/bFTrfgGqPr1X",
-	"ZPfUFwGfyfc4hzdzM6W3Z2+mHrMFz8mO4O1ZQRUTzyfcwLeXPz9rss9CDnjcGLCx+g/ApzjdQrIlPYpo",
-	"0ifMTntm2m8VrSplMLtCKlTHHox5JBLWjmeMLnqEtt4M2u8GFraPBtpD9wXDh5HE343EYVIYVrqRVLxL",
-	"nV5I7oqIcY583NluNK77unajfZ0z3m5stzFPa716+0Yc/YTXUHQFnQ1qa5+nYfrXWcdNXZjvuS041bA6",
-	"wKdfU1ObE669Vju78l96HXg/jsJ2ShyDctDangKJcJnHqkIr9LeCmbbVycAdykzp0G9iBsZ5cCzMzHv3",
-	"ii5SJmFFxb7uHTNvaKrNFabE1v5mo3J7T/k5Q8HvUMe5LF3HtMpcancojT5zj5eti4Or4/cQVoQ2Einx",
-	"bzWMR6H/MAUL97lIiwp1rftVbGl9cShVhoJ6h4Xc+j5qM47xv1jMAP5fzv7Hy1mg2ViYevrF+Og4NaQJ",
-	"/96Vm2EuYVUleHjj4YmtnMiI2T7KqB0MDmaNBQdU9uc2L7SfXBm3X83vyEZ7MudWQDe2+brqN7iijdbD",
-	"S61WAstTtIyL8BuI7brW3KCPm1f7299fbq0f+Hnbm8dyA9KVq/hDiUZI54cDE/JJnWmpJjXJMtBa6Xgf",
-	"4mUlvC1YPQEYaJd5tYakOobClUwe0O2KrUR4x8xkOKDJzT5AuKmv2fHtaogmb7Xp1qzrREmJaT0EzJhl",
-	"K2YQLC8xA+XsWE7k0lhqysYg3lzNOsN9X254mxU8j2ukuxHCQs4slGwDGx+2udPhttdpc3gOGTYnDUq/",
-	"5mPIjWXWmfFW5Kf5/BLCAkhVhm1GedSU8QzKOmvUPklwK0Zt4691k74XjSt9ktsWHYYwMLN1FvCvfsIY",
-	"xf9wowPKqt0QJ/53EljZ0EE7Tf2qvygLlTLB/xl4CLM8VFlu/DVddu7Y/u3VIvF95dFKMPlpkUxim1MH",
-	"QCzCTBg/AKhC/77rDmxHS36fPCxNlc58xVcwO5u/hau3J/DqL29ew4dXH0e5NTAeN4AyVU6zNVXDuvuh",
-	"gyJGs5A9h2QqdU2ENhfwWvS3OF1Pw085fpqfv3tGt2q5TUVo59El+rQRX5JVGg1KO1lIbjvFmhnjymZ4",
-	"0rN0fzhdWFuZo8PDmoIdG05TVe4Ngl76jxHRZJ1hun3wEZ+rkR6Nugc4UWWpJJxTd2U8bS6bH6R57IKn",
-	"KI13eJyaH1csLRBe+vG006Kj1v39/ZT5x1Ol14dxrzl8Nzs5e399dvBy+nxa2FJ0Au0JOHw56s2ZJ4mq",
-	"ULKKJ0fJqzgqr5gtKD08PPwrAAD//19rTH9lJwAA",
+	"H4sIAAAAAAAC/+xbWXMjOXL+Kxm0I2Z6g2RLFHW0JvZB0a2OZWwfWkk9dng4YWUVski4UUAJQEmid/q/",
+	"O3DUyaLEnrHXfrCeWFVAIo8vDySgv49SlRdKkrRmdP73UYEac7Kk/RMK1Pk7nlquJOrNgrmXjEyqeeHe",
+	"jc5HXyS/Lwk4I2l5xkmDygAl+KnA6rnTpRyNR/SEeSFodD46OmNpcnKUTJLD7GAyZ7N0cnaWZJPjk/n8",
+	"5OT0gLKDw9F4xN0aBdr1aDySmLuZ20yNR5ruS66Jjc6tLmk8MumacnTcZkrnaEfno7LkbqTdFI6IsZrL",
+	"1ejbt/EIhXjPSTCzLdztmhv4cr2A+5L0BmrlgFuPjDVg12gBhQCnRkFPgNZqnpSWDKAm4DIVJSMGXIJd",
+	"E2gyhZKGpku5lHd3d0uJQvx75tePLyqp/Zptsatxo7Z8jDIshRMwQ2HIjS+FwERQpYttienJM7VL6rcq",
+	"zxEMOXEtMRDcWGdVvzpoykiTTMmAVRBJQaZVXglYCuvFu8R03Z8E3ADGl06wMSgNbrH70n9WWeujaTGR",
+	"bMAINGsyU3iv9FJGKI3bXDgG7lJVSqs3d2DKJNBSWfhCT5ak4Uqau7DKeW2FSCFq+M/NyNeRXBy3lP+y",
+	"JmdKblpw4Eb+YKE0xECqKMAjFwISqnhjXiVB5QEM3ATN9gcCPZAE7nneeBDRUyF4yq3YNHgqDZcrN2Qp",
+	"7wLTdw1DfWfzmt6WaQfSurrooG0bS9l/A4iiUC0f+cdDaEU2gMTNivAAlOwPYCpiaYfy9wWUCy5upUCt",
+	"RosmW2pJ7I+Zek8TC0t628Q3hDpdQ6q5Jc3RG+ytkha5NKAkObvkShOY7sBxzyaU81QJJc0UvL17w729",
+	"l9KWhSBIA32HfZSgCtJolR4DbqHE2a7NxAOK0ln+dk31PEhRLmXiBm8qi2ZKCPXoFggqMN6gv8Hnas5v",
+	"8JHQc/B7/n5byt8m9V/r5+/4c7QcNqW9c5ThI9p0TSbGjqiRtLKIXUcl7OQL7uj+DmA3LW6A7ksUYNVz",
+	"5AKtlX2J1koTWtIuicpd9CpadPcdtJQe5DPQ4vIlvjxssmam2akv8aKMgox5VsAWLbrbl1ZfwIZ2oCUj",
+	"KHbQYooMSGUrcOzgLdKKoNjNl6P0Ei4iLS73oPWS/n9zHnm7pi2f5wHlLrg5Ai06MXrGJ5X8B6V2O3Es",
+	"ZTU1jt+ZPKCdO0ozUHpMokjScEZL+XKycEH2zz/S/UD0Hl/+7VWdL24btaAOC6NelTlJ2wgYg1WfV8/E",
+	"/V0rAKq8QE1mKdM1pV9rewQLqhedf1px5N0KJYs2rhYwYMqiUNpCXgrLCxHnDWjRM1CtX6tyKfu63JF3",
+	"PX/crknD3eXNnbPt3ZebbQVzOajgm/GXm1fdnByVXPlI6ioaM65g4BYwBfoSxhVqkog5MRICU2qtSski",
+	"bLhcCYL7Ulky06V8Xu52+RHhHPIQ3OUbSEVpLOm7Qdz41P9DM+qHnjy1BerMuiMPe1y54mPsq4+Aghzy",
+	"0ljInd9CpnSoPcO2x/rEzLjlSjqR/KAB7DW51ZcxQ5Jztw1qSQp/Qsn+1HOv2oBORc7ae+rjp13udfPq",
+	"e8uxUKS+XI/VjDR8vNpZjDnWny3GvlUffcV9cbX4mbTx1Vi/OFvIsPN1SsJElRYQHsLgyq0vrhaB20I7",
+	"d7WcPNWHhmQjxuH0YHowuH2Ob0JIHX0bt7gy+7FVbQ3iwuYF/rDgbfo1j7+0WI/8fvt1POKWcj/wnzVl",
+	"o/PRP71uOh6vozJftzTZiIRa48Y9l5pfacr4U1cnr9WM52bCZabRWF2mttS0kA8krdKb1w+He+rL9zMo",
+	"45LbfU1Z91fqadNtPbkRFyx4Je7scXyI2s/JIkOL8JU2kxD+C+TaBNhbBWiMSjlagjzUv1kpmlkxKWgS",
+	"Pp5oMqrUKYETN1hxS3DP4Ns1yhXd+m/bgjOeog0R1lNyjKZ+Rui8qDQttSYGrNRxPxw1I9DYOPQnQMZc",
+	"MGMkyLofuWI847WzyjIfnf8yunj37vLdaDx6d/nh8tb/+vj53eL94vLd6NctS0b2G7sNNceutHrgjAwg",
+	"lEN9sobdhBz7Grkh5vYo3FTR/0rzHPUG/kob4DKq2WMGmiaYl+OFVlfNcYvDZxgWSq5IQ/39obZ7l/Mm",
+	"8KNkgNAiWA1MlayKrMq5l7I3uxGaS0uS1clUE/r01NruuS8rxxBKp1B0NB8dHNZYFCSJRVYMSRPKOscF",
+	"ZRml1ow77Iz9UOXLBp4XmFo3WxNWjILZGEt5B8I9jX5AYwOMX4Jw32wQgw5wCY9rnq5D4tlCMHtu+U+Y",
+	"DyxcW9KslbahJI5ZO9AfppgKQvd7h0NW6DXwuCavtMArN+BnOuWVVrlglaIQG7/7Rlm63z1n+3L7+ePF",
+	"7eKtc7OLT18uPgw6WY4SV5STtAtpSWeY0oI9E8Tq4cCr8aAeSEf1em5j3axRmpxbZ/CgGG7gUlpuN3Ab",
+	"gtb15c3t9eLt7eLzp3N4H5X3efJWqJLB4uMN3JB+4KEC5CbWzL6tl3MbAPx5tvh4EySvE1GlAv9tUOp+",
+	"Aiq+flJO76lPAj6Sv2AcXvfNlO723kzVZguWky3C3V5BEQPPV9rAj1d/fVVHn6XcwnGtwFrrPwGf0rTD",
+	"SYd6JFGHT1i866npZa1oVShD7JpcorrwzJhnPGFVcoYyDX5QTQbtZwOG6YOO9q19wPDLQOBve+J2UNjO",
+	"dAOheJc4PZfc5RHDGPl1Z7lRm+77yo162o5yo1vG7Fd69eYNGHqPYyi3BV1s5dY+TqfQG8dNlZgfuV1z",
+	"6USNDj79npxar3DjpdpZlf/cq8D7fhSmg1Xb6aDRvXMkx5d5Lis0RH8vM9MmOxl4IMmUDvUmMTClZw5D",
+	"z7y3r2hzihISl+yr2pF5RQOCKSh1aO1PNiqzj6gJGAn+QDr2Zd12TCtWpnaH0OQj93Da+jy5vvgEYUQo",
+	"I8kF/k7BeB7qD7PGsJ+LsChIV7Jfx5LWJ4dcMRKAki1l532UZpjH/8VkBvD/6ez/eDoLMBtyU/e+8o+W",
+	"UUOY8Oeu3GzHEiwKwcOJhwe2KgVzyPZehnnV48Nag1tQ9uvWB9p7Z8bu0fyOaPRC5Ow4dK2b78t+W1u0",
+	"wXz4FoVIMP36M+l6/nB+SV1sILkiuPp8cxscwm9vIgVnIgRJj679W092lbIEbl0MbVYAbqpLBGHDR9Xj",
+	"UqaoNY87hn+dfPZNhorLSZtNWBMy0oCSjas2WmfpNRpAMHzlt22GUk127JZ2r/yh7FcXnOUm7oDa3hOY",
+	"iuQS0qH9F+4v1FF89vQExqItTdxyDWnz2s8xtJRrJVgVdT0Ea5UONXrqj9vmuEbJVB4RHPaJlK4VMfCG",
+	"SDZtXSSkp52e3HFySAfZafqGzXBOJ8lZepQd4il7Q7Nknp7gizhvONsXUZUOhpFV3QpxJ/3kt6D1fYou",
+	"uDrWtWqHwqffo8ousjWlxB+a+yq7yP/DlPmOkH0ga0m3HXxbjItu6Pe1SepDnlQWkrqeiL2sHjZ6pay1",
+	"lBd2YC/xqcyTWF7ynEyvSKEuD877MuSCWEdjh7WUXFpakfb7bn98yS7sgIF4TiGIbK3wiCb0tqpQdF9S",
+	"SdN23crQ0sQxO1SQsFq5i+cycJ18yHXLK2i4ySD87Gbd1gUvnGdn6ZwmBzM6nszx6GySHGfzyXzG6IyO",
+	"E3aE830KbIHGXmqt9DB0yX2qu++V57tJjXGiPbv8dRQZzHQOxwdHQzzIZ6F32zdLgRuhkI19brXw6GG4",
+	"xgeChEiCIWkrg3VhuIX+Ntn9TKQ0X3GJohvLex47S1lydDqZI80nc5rPJskZnk6O3mB6kpwmMzw83Mcy",
+	"VRNtiLGb+A1k4zF7cTefDblHWbBn3UNlz5t9H3fohaWOb2xZoiN996urO6oA0oZv28nbEj0f9f7mPOtl",
+	"zJnvinjQi5a8E0a4Cf4MyhkQhVjKvk5NgHNwG3+OHqscRyp0sV2tLGi7JuHuSNgUvsU7lPU7Uu3dRdiR",
+	"JgbK7DYz+7lUN+n6Y4fHWPYPxL3Ts4OzQ3Z2PDk+PTqZzGeHxxPMMJmcns7mJ4fH8zmdHOzlXZWSvkjL",
+	"xZCH2X1UDJhZ0oCNWzijlZqm8KmDn2i1hDLlbxVw43PcUqL2UNsRtkLdpykCiHFNqRWbbkLqnYnODmbH",
+	"k4PDydHB7eHs/ODg/ODg336fk/aMOe6hZ8i1rrRKBOXvyCIX4c5zN/nXJ2YX9VXe7vurzviBpNqpS+Sm",
+	"FQMbIq2Lwj5PVJ0VLlvNkeCdSsfzD+70l5O0ddjcko55sYbKo3WZo5xoQoaJCHdKUYYFquVq94/HavE2",
+	"Zdg9e611of5WSUlpVYsytJigIQ8bBqq0Q7Dm0liUKQ2x6G5aN5d5vKfxpgvgcVdxuptDWMqFhRw3sPHb",
+	"9KzU4XSn1dbkGTCqV9pq9Wk+6JB+nzMciv9ye3tVbYRSxajpIDyryu1EZ7kVg7rxxzjjvhVNmfumRpd0",
+	"OHSFha12/f6qVzg29RuLFlNW7WZx7O9FU2FDx7zUrj/tD8aESlHw/4wbxUXmV/TX8PgDydaZmr+tthz5",
+	"PvJ5IlB+XY7GQTO1A8SmGwrjN3JF6NfvOvOym2IP8GCaKh32mgoWl7fv4fr9Wzh6c3YCvxz9OoitLeVx",
+	"AyRTVWpcEWu6nW6hyKNZyp5BmErL2kPrA7eK9I80XU3D1e2/3H788CpkzQ4Uobl/kpMPG/FSXKHJkLTj",
+	"pfS9hKo5h8aUeX1Y2tN0P/CurS3M+evXFQRbOpymKn/RCfrRN3hEHXWGwu1NaD7c+N7DtbLP1dCuc7L2",
+	"h4ihVVFfPXDtiq3dj6kzUre2caTifG5BUyHQH/TyDFBuxkt/N5LLMtw9T6i616Pkqr5e5lhRMrjxbA5r",
+	"VWoDRjUgaBb0PW6thAitWauA26G6xrQVsacCuuH2jB2kp3iWHdJJNsdZ8iY9Ysd0mr3Bw+QoPWa+Pfb0",
+	"geTKrkfns+OT8Sjnsno+PHnRnB0OXzTmzTPxMNyOqsKJjmavnrtG3mpsbG/IC00PXJUmLHz5VHC9Ga6I",
+	"hrtg4TKAX62ubxq2+pWQ679tYt8MbanJ35Isq8OLcJugYqmGqrRcNGXTzpLn8LtLnj9asv5PlKfPl2Hb",
+	"0PnmM3+mBs5m3KkBuP8WURI+KkYitBKv6n9E85oUPKXYPou35S4KTNcEM38trdSiFd4eHx+n6D9PlV69",
+	"jnPN6w+Lt5efbi4ns+nBdG1z0Uq4e/Dh29C9+2XjkSpIYsFdwyNekXP/reZQ++3bfw0A4/sPOl03AAA=",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -38,6 +38,7 @@ type FilterAdapter struct {
 	selectorEvaluator *search.SelectorEvaluator
 	selectorParser    *search.SelectorParser
 	schemaValidator   *SchemaValidator
+	serverFiltered    map[string]bool
 }
 
 // NewFilterAdapter creates a new filter adapter to be passed to a ResponseFilter
@@ -52,14 +53,6 @@ func NewFilterAdapterWithSchemas(logger *slog.Logger, schemas map[string]*openap
 		return nil, fmt.Errorf("failed to build paths parser: %w", err)
 	}
 
-	pathEvaluator, err := search.NewPathEvaluator().
-		SetLogger(logger).
-		SetAllowMissingFields(true).
-		Build()
-	if err != nil {
-		return nil, fmt.Errorf("failed to build path evaluator: %w", err)
-	}
-
 	selectorParser, err := search.NewSelectorParser().
 		SetLogger(logger).
 		Build()
@@ -67,10 +60,7 @@ func NewFilterAdapterWithSchemas(logger *slog.Logger, schemas map[string]*openap
 		return nil, fmt.Errorf("failed to build selector parser: %w", err)
 	}
 
-	selectorEvaluator, err := search.NewSelectorEvaluator().
-		SetLogger(logger).
-		SetPathEvaluator(pathEvaluator.Evaluate).
-		Build()
+	selectorEvaluator, err := search.NewObjectSelectorEvaluator(logger)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build selector evaluator: %w", err)
 	}
@@ -88,6 +78,18 @@ func NewFilterAdapterWithSchemas(logger *slog.Logger, schemas map[string]*openap
 	}, nil
 }
 
+// SetServerFiltered sets the paths of the list endpoints whose handlers already apply the whole filter, for example
+// because the results are paged by the database.  The responses of those endpoints aren't filtered again, as the
+// in-memory evaluation doesn't handle missing values in the same way and would return short pages.  The filter is
+// still parsed and validated.
+func (a *FilterAdapter) SetServerFiltered(paths ...string) *FilterAdapter {
+	a.serverFiltered = make(map[string]bool, len(paths))
+	for _, path := range paths {
+		a.serverFiltered[path] = true
+	}
+	return a
+}
+
 // ParseFilter delegates the function of parsing the filter fields to the selector parser.
 func (a *FilterAdapter) ParseFilter(query string) (*search.Selector, error) {
 	selector, err := a.selectorParser.Parse(query)
@@ -257,6 +259,11 @@ func ResponseFilter(adapter *FilterAdapter) Middleware {
 				}
 			}
 
+			if len(selector.Terms) > 0 && r.Method == http.MethodGet && adapter.serverFiltered[r.URL.Path] {
+				next.ServeHTTP(w, r)
+				return
+			}
+
 			// Override the response writer with an FilterResponseInterceptor so we can capture the output
 			i := &FilterResponseInterceptor{
 				original: w,
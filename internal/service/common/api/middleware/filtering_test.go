@@ -105,6 +105,26 @@ var _ = Describe("ResponseFilter", func() {
 		Expect(record.Name).To(Equal("hello"))
 	})
 
+	It("should not filter again the list of a server filtered path", func() {
+		adapter.SetServerFiltered("/some-endpoint")
+		req.URL.RawQuery = testFilterParams
+		handler.ServeHTTP(recorder, req)
+
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		var list []object
+		err := json.Unmarshal(recorder.Body.Bytes(), &list)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(list).To(HaveLen(2))
+	})
+
+	It("should still reject an invalid filter on a server filtered path", func() {
+		adapter.SetServerFiltered("/some-endpoint")
+		req.URL.RawQuery = "filter=(eq,name"
+		handler.ServeHTTP(recorder, req)
+
+		Expect(recorder.Code).To(Equal(http.StatusBadRequest))
+	})
+
 	It("should process the list request without a filter", func() {
 		handler.ServeHTTP(recorder, req)
 
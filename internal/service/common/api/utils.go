@@ -7,7 +7,11 @@ SPDX-License-Identifier: Apache-2.0
 package api
 
 import (
+	"bytes"
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io"
 	"log/slog"
@@ -15,10 +19,20 @@ import (
 	"net/url"
 	"time"
 
+	"github.com/google/uuid"
+
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 )
 
+// CallbackVerificationHeader identifies the requests sent to a callback to verify a new subscription
+const CallbackVerificationHeader = "X-O2ims-Callback-Verification"
+
+// maxCallbackVerificationResponseSize limits the amount of data read from the response to a verification challenge
+const maxCallbackVerificationResponseSize = 4096
+
 // ValidateCallbackURL ensures that the URL used in subscription callback meets our requirements
 func ValidateCallbackURL(ctx context.Context, c notifier.ClientProvider, callback string) error {
 	// Validate URL
@@ -46,6 +60,79 @@ func ValidateCallbackURL(ctx context.Context, c notifier.ClientProvider, callbac
 	return nil
 }
 
+// VerifyCallback performs the verification handshake with the callback of a new subscription.  A random challenge is
+// POSTed to the callback, signed with the signing secret of the subscription if any, and the subscriber must echo it
+// back.  The client is obtained from the same provider as the notifier so that authentication behaves the same as for
+// real deliveries.
+func VerifyCallback(ctx context.Context, c notifier.ClientProvider, callback string, signingSecret *string) error {
+	authType := ctlrutils.DetermineAuthType(callback)
+	client, err := c.NewClient(ctx, authType)
+	if err != nil {
+		return fmt.Errorf("failed to create client: %w", err)
+	}
+
+	value := make([]byte, 16)
+	if _, err := rand.Read(value); err != nil {
+		return fmt.Errorf("failed to generate challenge: %w", err)
+	}
+	challenge := hex.EncodeToString(value)
+
+	if err := CheckCallbackChallenge(ctx, client, callback, challenge, notifier.NewSigningSecrets(signingSecret, nil, nil)); err != nil {
+		return fmt.Errorf("verification handshake failed: %w", err)
+	}
+
+	return nil
+}
+
+// CheckCallbackChallenge sends a CallbackVerification to the callback URL and expects a 2xx response echoing the
+// challenge.
+func CheckCallbackChallenge(ctx context.Context, client *http.Client, callbackURL, challenge string, secrets *notifier.SigningSecrets) error {
+	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+
+	body, err := json.Marshal(generated.CallbackVerification{Challenge: challenge})
+	if err != nil {
+		return fmt.Errorf("failed to marshal challenge: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create POST request: %w", err)
+	}
+
+	now := time.Now()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(CallbackVerificationHeader, "true")
+	notifier.SetDeliveryHeaders(req, uuid.New(), body, secrets.Active(now), now)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to perform POST request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+			slog.Warn("failed to close response body", "error", err)
+		}
+	}(resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected status code: got %d, expected 2xx", resp.StatusCode)
+	}
+
+	var response generated.CallbackVerificationResponse
+	if err := json.NewDecoder(io.LimitReader(resp.Body, maxCallbackVerificationResponseSize)).Decode(&response); err != nil {
+		return fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	if response.Challenge != challenge {
+		return fmt.Errorf("challenge not echoed back by the callback")
+	}
+
+	slog.Info("Verification handshake passed", "status", resp.StatusCode)
+	return nil
+}
+
 // CheckCallbackReachabilityGET sends a GET request to the callback URL and expects a 204 No Content response.
 func CheckCallbackReachabilityGET(ctx context.Context, client *http.Client, callbackURL string) error {
 	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
@@ -75,6 +162,20 @@ func CheckCallbackReachabilityGET(ctx context.Context, client *http.Client, call
 	return nil
 }
 
+// ParseFilter parses the value of the `filter` query parameter of a list request so that it can be evaluated by the
+// database.  A nil selector is returned when the parameter isn't set.
+func ParseFilter(filter *string) (*search.Selector, error) {
+	if filter == nil {
+		return nil, nil
+	}
+
+	selector, err := search.ParseSelector(slog.Default(), *filter)
+	if err != nil {
+		return nil, fmt.Errorf("invalid filter: %w", err)
+	}
+	return selector, nil
+}
+
 // GracefulShutdown allow graceful shutdown with timeout
 func GracefulShutdown(srv *http.Server) error {
 	// Create shutdown context with 10 second timeout
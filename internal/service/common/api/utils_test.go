@@ -5,11 +5,17 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
+	"io"
 	"net/http"
 	"net/http/httptest"
 
+	"github.com/google/uuid"
+
 	commonapi "github.com/openshift-kni/oran-o2ims/api/common"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/api"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -118,6 +124,77 @@ var _ = Describe("CheckCallbackReachabilityGET", func() {
 	})
 })
 
+var _ = Describe("VerifyCallback", func() {
+	var (
+		ctx      context.Context
+		callback string
+		server   *httptest.Server
+		headers  http.Header
+		body     []byte
+		respond  func(w http.ResponseWriter, challenge string)
+		stub     *stubClientProvider
+	)
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		respond = func(w http.ResponseWriter, challenge string) {
+			w.Header().Set("Content-Type", "application/json")
+			_ = json.NewEncoder(w).Encode(generated.CallbackVerificationResponse{Challenge: challenge})
+		}
+		server = httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			headers = r.Header.Clone()
+			body, _ = io.ReadAll(r.Body)
+			var verification generated.CallbackVerification
+			_ = json.Unmarshal(body, &verification)
+			respond(w, verification.Challenge)
+		}))
+		callback = server.URL
+		stub = &stubClientProvider{client: server.Client()}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("succeeds when the challenge is echoed back", func() {
+		Expect(api.VerifyCallback(ctx, stub, callback, nil)).To(Succeed())
+		Expect(headers.Get(api.CallbackVerificationHeader)).To(Equal("true"))
+		Expect(headers.Get(notifier.SignatureHeader)).To(BeEmpty())
+	})
+
+	It("signs the challenge with the signing secret of the subscription", func() {
+		secret := "subscription-secret-value"
+		Expect(api.VerifyCallback(ctx, stub, callback, &secret)).To(Succeed())
+
+		deliveryID, err := uuid.Parse(headers.Get(notifier.DeliveryIDHeader))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(notifier.Verify(secret, headers.Get(notifier.TimestampHeader), deliveryID, body,
+			headers.Get(notifier.SignatureHeader))).To(BeTrue())
+	})
+
+	It("fails when the challenge is not echoed back", func() {
+		respond = func(w http.ResponseWriter, challenge string) {
+			_ = json.NewEncoder(w).Encode(generated.CallbackVerificationResponse{Challenge: "something else"})
+		}
+		err := api.VerifyCallback(ctx, stub, callback, nil)
+		Expect(err).To(MatchError(ContainSubstring("challenge not echoed back")))
+	})
+
+	It("fails when the callback returns an error", func() {
+		respond = func(w http.ResponseWriter, challenge string) {
+			w.WriteHeader(http.StatusNotFound)
+		}
+		err := api.VerifyCallback(ctx, stub, callback, nil)
+		Expect(err).To(MatchError(ContainSubstring("unexpected status code")))
+	})
+
+	It("fails when the callback is unreachable", func() {
+		server.Close()
+		err := api.VerifyCallback(ctx, stub, callback, nil)
+		Expect(err).To(MatchError(ContainSubstring("verification handshake failed")))
+	})
+})
+
 // stubClientProvider is a simple stub that implements the notifier.ClientProvider interface.
 type stubClientProvider struct {
 	client *http.Client
@@ -126,3 +203,26 @@ type stubClientProvider struct {
 func (s *stubClientProvider) NewClient(ctx context.Context, authType commonapi.AuthType) (*http.Client, error) {
 	return s.client, nil
 }
+
+var _ = Describe("ParseFilter", func() {
+	It("returns nil if the filter isn't set", func() {
+		selector, err := api.ParseFilter(nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selector).To(BeNil())
+	})
+
+	It("parses the filter", func() {
+		filter := "(eq,name,a);(in,extensions/site,b,c)"
+		selector, err := api.ParseFilter(&filter)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(selector.Terms).To(HaveLen(2))
+		Expect(selector.Terms[1].Path).To(Equal([]string{"extensions", "site"}))
+	})
+
+	It("fails if the filter is invalid", func() {
+		filter := "(eq,name"
+		selector, err := api.ParseFilter(&filter)
+		Expect(err).To(MatchError(ContainSubstring("invalid filter: invalid syntax")))
+		Expect(selector).To(BeNil())
+	})
+})
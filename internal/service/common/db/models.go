@@ -6,8 +6,17 @@ SPDX-License-Identifier: Apache-2.0
 
 package db
 
+import "github.com/openshift-kni/oran-o2ims/internal/search"
+
 type Model interface {
 	PrimaryKey() string
 	TableName() string
 	OnConflict() string
 }
+
+// SearchableModel is implemented by the models whose API representation can be filtered by the database.  The
+// returned map associates the names of the attributes of the API object to the columns storing their values.
+type SearchableModel interface {
+	Model
+	SearchColumns() map[string]search.Column
+}
@@ -0,0 +1,90 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+)
+
+// NotificationToDeadLetter converts a Notification that could not be delivered to a DeadLetterNotification.  The
+// payload is stored as a generic JSON document since it is already in the form expected by the subscriber.
+func NotificationToDeadLetter(subscriptionID uuid.UUID, notification *notifier.Notification, reason string) (*DeadLetterNotification, error) {
+	data, err := json.Marshal(notification.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notification payload: %w", err)
+	}
+
+	var payload map[string]interface{}
+	if err := json.Unmarshal(data, &payload); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal notification payload: %w", err)
+	}
+
+	return &DeadLetterNotification{
+		SubscriptionID: subscriptionID,
+		NotificationID: notification.NotificationID,
+		SequenceID:     notification.SequenceID,
+		Payload:        payload,
+		Attempts:       1,
+		LastError:      reason,
+	}, nil
+}
+
+// DeadLetterToNotification converts a DeadLetterNotification to a generic Notification that can be redelivered
+func DeadLetterToNotification(record *DeadLetterNotification) *notifier.Notification {
+	return &notifier.Notification{
+		NotificationID: record.NotificationID,
+		SequenceID:     record.SequenceID,
+		Payload:        record.Payload,
+		DeadLetterID:   record.DeadLetterID,
+	}
+}
+
+// DeadLetterToModel converts a DeadLetterNotification to its API model
+func DeadLetterToModel(record *DeadLetterNotification) common.DeadLetterNotification {
+	object := common.DeadLetterNotification{
+		DeadLetterId:   *record.DeadLetterID,
+		NotificationId: record.NotificationID,
+		SequenceId:     record.SequenceID,
+		Notification:   record.Payload,
+		Attempts:       record.Attempts,
+		LastError:      record.LastError,
+	}
+
+	if record.CreatedAt != nil {
+		object.CreatedAt = *record.CreatedAt
+	}
+	if record.UpdatedAt != nil {
+		object.UpdatedAt = *record.UpdatedAt
+	}
+
+	return object
+}
+
+// DeadLetterQueueToModel converts the content of the dead letter queue of a subscription to its API model
+func DeadLetterQueueToModel(subscriptionID uuid.UUID, suspendedUntil *time.Time, records []DeadLetterNotification) common.DeadLetterQueue {
+	object := common.DeadLetterQueue{
+		SubscriptionId: subscriptionID,
+		Notifications:  make([]common.DeadLetterNotification, 0, len(records)),
+	}
+
+	if suspendedUntil != nil && suspendedUntil.After(time.Now()) {
+		object.SuspendedUntil = suspendedUntil
+	}
+
+	for i := range records {
+		object.Notifications = append(object.Notifications, DeadLetterToModel(&records[i]))
+	}
+
+	return object
+}
@@ -0,0 +1,45 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
+)
+
+// Interface compile enforcement
+var _ db.Model = (*DeadLetterNotification)(nil)
+
+// DeadLetterNotification represents a record in the dead_letter_notification table.  It holds a notification that
+// could not be delivered to a subscriber so that it can be inspected and delivered again later.
+type DeadLetterNotification struct {
+	DeadLetterID   *uuid.UUID             `db:"dead_letter_id"`
+	SubscriptionID uuid.UUID              `db:"subscription_id"`
+	NotificationID uuid.UUID              `db:"notification_id"`
+	SequenceID     int                    `db:"sequence_id"`
+	Payload        map[string]interface{} `db:"payload"`
+	Attempts       int                    `db:"attempts"`
+	LastError      string                 `db:"last_error"`
+	CreatedAt      *time.Time             `db:"created_at"`
+	UpdatedAt      *time.Time             `db:"updated_at"`
+}
+
+// TableName returns the table name associated to this model
+func (r DeadLetterNotification) TableName() string {
+	return "dead_letter_notification"
+}
+
+// PrimaryKey returns the primary key column associated to this model
+func (r DeadLetterNotification) PrimaryKey() string { return "dead_letter_id" }
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r DeadLetterNotification) OnConflict() string {
+	return ""
+}
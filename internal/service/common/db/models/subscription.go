@@ -7,10 +7,12 @@ SPDX-License-Identifier: Apache-2.0
 package models
 
 import (
+	"log/slog"
 	"time"
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -24,8 +26,16 @@ type Subscription struct {
 	Filter                 *string    `db:"filter"`
 	Callback               string     `db:"callback"`
 	// EventCursor holds the SequenceID of the last processed event.  Sequences start at 1 so we initialize this to 0.
-	EventCursor int        `db:"event_cursor"`
-	CreatedAt   *time.Time `db:"created_at"`
+	EventCursor int `db:"event_cursor"`
+	// SuspendedUntil is set while deliveries are suspended following a notification that could not be delivered.
+	SuspendedUntil *time.Time `db:"suspended_until"`
+	// SigningSecret is the shared secret used to sign notifications.  Deliveries are not signed if it is not set.  The
+	// secrets are excluded from JSON so that they are never leaked by logs.
+	SigningSecret *string `db:"signing_secret" json:"-"`
+	// PreviousSigningSecret is the secret replaced by the last rotation.  It is used until PreviousSigningSecretExpiry.
+	PreviousSigningSecret       *string    `db:"previous_signing_secret" json:"-"`
+	PreviousSigningSecretExpiry *time.Time `db:"previous_signing_secret_expiry"`
+	CreatedAt                   *time.Time `db:"created_at"`
 }
 
 // TableName returns the table name associated to this model
@@ -38,3 +48,28 @@ func (r Subscription) PrimaryKey() string { return "subscription_id" }
 
 // OnConflict returns the column or constraint to be used in the UPSERT operation
 func (r Subscription) OnConflict() string { return "" }
+
+// SubscriptionCriteria holds the parsed filter of an inventory or cluster subscription evaluated when matching
+// notifications
+type SubscriptionCriteria struct {
+	// Selector restricts the notified changes to the objects matching the filter of the subscription
+	Selector *search.Selector
+	// InvalidFilter is set if the stored filter could not be parsed, in which case no change is notified
+	InvalidFilter bool
+}
+
+// NewSubscriptionCriteria returns the criteria of a subscription from its stored filter
+func NewSubscriptionCriteria(record *Subscription) SubscriptionCriteria {
+	if record.Filter == nil {
+		return SubscriptionCriteria{}
+	}
+
+	selector, err := search.ParseSelector(slog.Default(), *record.Filter)
+	if err != nil {
+		// Filters are validated when subscriptions are created so this is not expected
+		slog.Error("Invalid subscription filter, no change will be notified",
+			"subscriptionID", record.SubscriptionID, "error", err)
+		return SubscriptionCriteria{InvalidFilter: true}
+	}
+	return SubscriptionCriteria{Selector: selector}
+}
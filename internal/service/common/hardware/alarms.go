@@ -0,0 +1,134 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+// Package hardware holds the definitions shared by the servers that expose the data reported by the hardware plugins,
+// so that the alarms reported against a resource refer to the identifiers exposed by the inventory.
+package hardware
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+)
+
+// Names of the hardware faults that can be reported by hardware plugins.  They are used along with the severity to
+// look up the matching alarm definition of a resource type.
+const (
+	AlarmRegistrationError            = "RegistrationError"
+	AlarmProvisionedRegistrationError = "ProvisionedRegistrationError"
+	AlarmInspectionError              = "InspectionError"
+	AlarmPreparationError             = "PreparationError"
+	AlarmProvisioningError            = "ProvisioningError"
+	AlarmPowerManagementError         = "PowerManagementError"
+	AlarmDetachError                  = "DetachError"
+	AlarmServicingError               = "ServicingError"
+	AlarmOperationalStatusError       = "OperationalStatusError"
+)
+
+// AlarmDefinitionVersion is the version of the hardware fault catalog.  It must be bumped whenever the catalog
+// is changed.
+const AlarmDefinitionVersion = "1.0.0"
+
+const (
+	defaultProposedRepairActions = "Please consult the hardware vendor documentation"
+	bmcConnectivityRepairActions = "Verify the BMC address, credentials and network connectivity"
+	provisioningRepairActions    = "Check the BareMetalHost status and the BMC event log"
+)
+
+// AlarmDefinition describes a hardware fault that can be raised against a resource.
+type AlarmDefinition struct {
+	Name                  string
+	Severity              string
+	Description           string
+	ProposedRepairActions string
+	ClearingType          common.AlarmDefinitionClearingType
+}
+
+// AlarmDefinitions is the catalog of hardware faults known to the O-Cloud.  Every resource type exposes the
+// full catalog in its alarm dictionary since the faults are reported by the BMC regardless of the server model.
+var AlarmDefinitions = []AlarmDefinition{
+	{
+		Name:                  AlarmRegistrationError,
+		Severity:              "critical",
+		Description:           "The BMC of the host could not be reached or the credentials were rejected",
+		ProposedRepairActions: bmcConnectivityRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmProvisionedRegistrationError,
+		Severity:              "critical",
+		Description:           "The BMC of a provisioned host could not be reached or the credentials were rejected",
+		ProposedRepairActions: bmcConnectivityRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmPowerManagementError,
+		Severity:              "critical",
+		Description:           "The host could not be powered on or off through its BMC",
+		ProposedRepairActions: defaultProposedRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmInspectionError,
+		Severity:              "major",
+		Description:           "The hardware inspection of the host failed",
+		ProposedRepairActions: provisioningRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmPreparationError,
+		Severity:              "major",
+		Description:           "The BIOS, RAID or firmware configuration of the host failed",
+		ProposedRepairActions: provisioningRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmProvisioningError,
+		Severity:              "major",
+		Description:           "The image could not be written to the host",
+		ProposedRepairActions: provisioningRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmServicingError,
+		Severity:              "major",
+		Description:           "A firmware or BIOS update of a provisioned host failed",
+		ProposedRepairActions: provisioningRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmDetachError,
+		Severity:              "minor",
+		Description:           "The host could not be detached from the provisioning service",
+		ProposedRepairActions: provisioningRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+	{
+		Name:                  AlarmOperationalStatusError,
+		Severity:              "major",
+		Description:           "The host reported an operational error without a more specific cause",
+		ProposedRepairActions: defaultProposedRepairActions,
+		ClearingType:          common.AUTOMATIC,
+	},
+}
+
+// MakeAlarmDefinitionID calculates the alarm definition ID of a hardware fault for a given resource type.  The
+// value is derived from the resource type so that each alarm dictionary has its own set of definitions.
+func MakeAlarmDefinitionID(resourceTypeID uuid.UUID, name, severity string) uuid.UUID {
+	return uuid.NewSHA1(resourceTypeID, []byte(fmt.Sprintf("%s/%s", name, severity)))
+}
+
+// FindAlarmDefinition looks up a hardware fault in the catalog by name and severity
+func FindAlarmDefinition(name, severity string) (*AlarmDefinition, bool) {
+	for i := range AlarmDefinitions {
+		if AlarmDefinitions[i].Name == name && AlarmDefinitions[i].Severity == severity {
+			return &AlarmDefinitions[i], true
+		}
+	}
+	return nil, false
+}
@@ -0,0 +1,34 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package hardware
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+)
+
+// Defines the UUID namespace values used to generated name based UUID values for the resources and resource types
+// reported by the hardware plugins.  These values are selected arbitrarily.
+const (
+	ResourceUUIDNamespace     = "8ef67482-1215-470d-9a43-eb02af4a7c05"
+	ResourceTypeUUIDNamespace = "255c4b4c-84a8-4c95-95ba-217e1688a03d"
+)
+
+// MakeResourceID calculates a UUID value to be used as the ResourceID.  The cloudID and hwPluginRef are added to the node
+// id value to ensure we get a globally unique value.
+func MakeResourceID(cloudID uuid.UUID, hwPluginRef, hwMgrNodeID string) uuid.UUID {
+	return ctlrutils.MakeUUIDFromNames(ResourceUUIDNamespace, cloudID, hwPluginRef, hwMgrNodeID)
+}
+
+// MakeResourceTypeID calculates a UUID value to be used as the ResourceTypeID.  Resource types are defined by the
+// vendor and model of the hardware reported by a given hardware plugin.
+func MakeResourceTypeID(cloudID uuid.UUID, hwPluginRef, vendor, model string) uuid.UUID {
+	return ctlrutils.MakeUUIDFromNames(ResourceTypeUUIDNamespace, cloudID, hwPluginRef, fmt.Sprintf("%s/%s", vendor, model))
+}
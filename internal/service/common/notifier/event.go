@@ -22,8 +22,9 @@ import (
 	"github.com/google/uuid"
 )
 
-// sendNotification sends the inventory change notification to the subscriber
-func sendNotification(ctx context.Context, client *http.Client, url string, event Notification) error {
+// sendNotification sends the inventory change notification to the subscriber.  The request is signed with the active
+// secrets of the subscription, if any.
+func sendNotification(ctx context.Context, client *http.Client, url string, secrets *SigningSecrets, event Notification) error {
 	body, err := json.Marshal(event.Payload)
 	if err != nil {
 		return fmt.Errorf("failed to marshal notification payload: %w", err)
@@ -36,6 +37,8 @@ func sendNotification(ctx context.Context, client *http.Client, url string, even
 	}
 
 	request.Header.Set("Content-Type", "application/json")
+	now := time.Now()
+	SetDeliveryHeaders(request, event.NotificationID, body, secrets.Active(now), now)
 	response, err := client.Do(request)
 	if err != nil {
 		return fmt.Errorf("failed to send notification: %w", err)
@@ -57,25 +60,47 @@ func sendNotification(ctx context.Context, client *http.Client, url string, even
 // number of retries has been reached.  When it completes or timeout it sends a job completion event and signals back
 // to the worker that this current event is complete
 func processEvent(ctx context.Context, logger *slog.Logger, client *http.Client,
-	completionChannel chan *SubscriptionJobComplete, event Notification, subscriptionID uuid.UUID, url string) {
+	completionChannel chan *SubscriptionJobComplete, event Notification, subscriptionID uuid.UUID, url string,
+	secrets *SigningSecrets) {
 	logger.Info("processing data change event",
 		"notificationID", event.NotificationID, "sequenceID", event.SequenceID)
 
-	_ = callUrl(ctx, logger, client, url, event)
+	err := callUrl(ctx, logger, client, url, secrets, event)
 
-	completionChannel <- &SubscriptionJobComplete{
+	completionChannel <- newSubscriptionJobComplete(subscriptionID, &event, err)
+}
+
+// skipEvent reports a notification as undelivered without attempting to send it because the subscription is suspended.
+func skipEvent(ctx context.Context, logger *slog.Logger, completionChannel chan *SubscriptionJobComplete,
+	event Notification, subscriptionID uuid.UUID) {
+	logger.Info("subscription suspended; skipping data change event",
+		"notificationID", event.NotificationID, "sequenceID", event.SequenceID)
+
+	select {
+	case completionChannel <- newSubscriptionJobComplete(subscriptionID, &event, ErrSubscriptionSuspended):
+	case <-ctx.Done():
+	}
+}
+
+// newSubscriptionJobComplete creates the completion event for a notification
+func newSubscriptionJobComplete(subscriptionID uuid.UUID, event *Notification, err error) *SubscriptionJobComplete {
+	return &SubscriptionJobComplete{
 		subscriptionID: subscriptionID,
 		notificationID: event.NotificationID,
 		sequenceID:     event.SequenceID,
+		deadLetterID:   event.DeadLetterID,
+		notification:   event,
+		err:            err,
 	}
 }
 
 // callUrl with retry
-func callUrl(ctx context.Context, logger *slog.Logger, client *http.Client, url string, event Notification) error {
+func callUrl(ctx context.Context, logger *slog.Logger, client *http.Client, url string, secrets *SigningSecrets,
+	event Notification) error {
 	var err error = nil
 	delay := retryDelay
 	for attempt := 0; attempt < maxRetries; attempt++ {
-		err = sendNotification(ctx, client, url, event)
+		err = sendNotification(ctx, client, url, secrets, event)
 		if err == nil {
 			break
 		}
@@ -107,8 +132,6 @@ func callUrl(ctx context.Context, logger *slog.Logger, client *http.Client, url
 	if err != nil {
 		logger.Error("error sending notification; retries exceeded", "error", err,
 			"notificationID", event.NotificationID, "sequenceID", event.SequenceID)
-		// TODO: If we were able to send this one then we are not likely to be able to send any
-		//  of the others so perhaps we should purge our queue, or enter a longer backoff period.
 		return fmt.Errorf("error sending notification; retries exceeded: %w", err)
 	} else {
 		logger.Info("notification sent",
@@ -8,9 +8,11 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"sort"
+	"time"
 
 	"github.com/google/uuid"
 )
@@ -28,6 +30,11 @@ type Notification struct {
 	NotificationID uuid.UUID
 	SequenceID     int
 	Payload        interface{}
+	// ObjectType is the API type name of the object described by an inventory or cluster change notification (e.g.
+	// ResourcePool).  It is used to evaluate subscription filters and is not sent to subscribers.
+	ObjectType string
+	// DeadLetterID is set when the notification is being redelivered from the dead letter queue of a subscription
+	DeadLetterID *uuid.UUID
 }
 
 // SubscriptionInfo defines a generic subscription object.  The intent is to abstract away the differences between the
@@ -38,6 +45,12 @@ type SubscriptionInfo struct {
 	Callback               string
 	Filter                 *string
 	EventCursor            int
+	// SuspendedUntil is set while deliveries to the subscriber are suspended following a failed notification
+	SuspendedUntil *time.Time
+	// SigningSecrets is set if the deliveries to the subscriber must be signed
+	SigningSecrets *SigningSecrets
+	// Criteria holds the domain specific matching criteria of the subscription evaluated by the SubscriptionProvider
+	Criteria any
 }
 
 // NotificationProvider must be implemented by a domain specific model implementor so that the notifier can manage
@@ -45,22 +58,26 @@ type SubscriptionInfo struct {
 type NotificationProvider interface {
 	GetNotifications(ctx context.Context) ([]Notification, error)
 	DeleteNotification(ctx context.Context, notificationID uuid.UUID) error
+	CreateDeadLetter(ctx context.Context, subscriptionID uuid.UUID, notification *Notification, reason string) error
+	GetDeadLetters(ctx context.Context, subscriptionID uuid.UUID) ([]Notification, error)
+	UpdateDeadLetter(ctx context.Context, deadLetterID uuid.UUID, reason string) error
+	DeleteDeadLetter(ctx context.Context, deadLetterID uuid.UUID) error
 }
 
 // SubscriptionProvider must be implemented by a domain specific model implementor so that the notifier can manage
 // subscriptions on its behalf.
 type SubscriptionProvider interface {
 	GetSubscriptions(ctx context.Context) ([]SubscriptionInfo, error)
-	Matches(subscription *SubscriptionInfo, notification *Notification) bool
+	Matches(ctx context.Context, subscription *SubscriptionInfo, notification *Notification) bool
 	UpdateSubscription(ctx context.Context, subscription *SubscriptionInfo) error
 	Transform(subscription *SubscriptionInfo, notification *Notification) (*Notification, error)
 }
 
-// SubscriptionEvent defines the information sent to the notifier when a subscription is added/removed
+// SubscriptionEvent defines the information sent to the notifier when a subscription is added/updated/removed
 type SubscriptionEvent struct {
-	// Removed defines whether the subscription has been removed or added
+	// Removed defines whether the subscription has been removed or added/updated
 	Removed bool
-	// Subscription is the subscription being added/removed
+	// Subscription is the subscription being added/updated/removed
 	Subscription *SubscriptionInfo
 }
 
@@ -68,6 +85,7 @@ type SubscriptionEvent struct {
 // is prepared to handle subscription events.
 type SubscriptionEventHandler interface {
 	SubscriptionEvent(ctx context.Context, event *SubscriptionEvent)
+	ReplayDeadLetters(ctx context.Context, subscriptionID uuid.UUID)
 	GetClientFactory() ClientProvider
 }
 
@@ -79,6 +97,8 @@ type Notifier struct {
 	notificationChannel chan *Notification
 	// subscriptionChannel is used to receive notifications about new/deleted subscriptions
 	subscriptionChannel chan *SubscriptionEvent
+	// replayChannel is used to receive requests to redeliver the dead letter queue of a subscription
+	replayChannel chan uuid.UUID
 	// subscriptionJobCompleteChannel is used to be notified by a worker that it has completed
 	// handling a notification.
 	subscriptionJobCompleteChannel chan *SubscriptionJobComplete
@@ -96,6 +116,7 @@ func NewNotifier(subscriptionProvider SubscriptionProvider, notificationProvider
 	clientProvider ClientProvider) *Notifier {
 	eventChannel := make(chan *Notification, DefaultBufferedChannelSize)
 	subscriptionChannel := make(chan *SubscriptionEvent, DefaultBufferedChannelSize)
+	replayChannel := make(chan uuid.UUID, DefaultBufferedChannelSize)
 	subscriberJobCompleteChannel := make(chan *SubscriptionJobComplete, CompletionChannelSize)
 	return &Notifier{
 		clientProvider:                 clientProvider,
@@ -103,6 +124,7 @@ func NewNotifier(subscriptionProvider SubscriptionProvider, notificationProvider
 		notificationProvider:           notificationProvider,
 		notificationChannel:            eventChannel,
 		subscriptionChannel:            subscriptionChannel,
+		replayChannel:                  replayChannel,
 		subscriptionJobCompleteChannel: subscriberJobCompleteChannel,
 		workers:                        make(map[uuid.UUID]*SubscriptionWorker),
 	}
@@ -129,6 +151,10 @@ func (n *Notifier) Run(ctx context.Context) error {
 			if err := n.handleSubscriptionEvent(ctx, e); err != nil {
 				slog.Error("failed to handle subscription event", "error", err)
 			}
+		case id := <-n.replayChannel:
+			if err := n.handleReplayEvent(ctx, id); err != nil {
+				slog.Error("failed to handle dead letter replay", "subscriptionID", id, "error", err)
+			}
 		case <-ctx.Done():
 			n.shutdownWorkers()
 			slog.Info("context terminated; notifier exiting")
@@ -220,7 +246,7 @@ func (n *Notifier) handleNotification(ctx context.Context, event *Notification)
 
 	count := 0
 	for _, worker := range n.workers {
-		if n.subscriptionProvider.Matches(worker.subscription, event) {
+		if n.subscriptionProvider.Matches(ctx, worker.subscription, event) {
 			clone, err := n.subscriptionProvider.Transform(worker.subscription, event)
 			if err != nil {
 				slog.Error("failed to transform notification", "subscription", worker.subscription.SubscriptionID, "error", err)
@@ -257,6 +283,16 @@ func (n *Notifier) SubscriptionEvent(ctx context.Context, event *SubscriptionEve
 	}
 }
 
+// ReplayDeadLetters should be used to request that the dead letter queue of a subscription is delivered again.  This
+// also ends any suspension of the subscription.
+func (n *Notifier) ReplayDeadLetters(ctx context.Context, subscriptionID uuid.UUID) {
+	select {
+	case n.replayChannel <- subscriptionID:
+	case <-ctx.Done():
+		slog.Info("context terminated; aborting ReplayDeadLetters attempt")
+	}
+}
+
 // GetClientFactory return the underlying factory to create httpclient that can be used reach callback url
 func (n *Notifier) GetClientFactory() ClientProvider {
 	return n.clientProvider
@@ -309,6 +345,9 @@ func (n *Notifier) handleSubscriptionEvent(ctx context.Context, event *Subscript
 		delete(n.workers, event.Subscription.SubscriptionID)
 		// attempt to release any notifications that were queued by this worker.
 		n.releaseNotifications(ctx, worker.GetNotifications())
+	} else if worker, found := n.workers[subscriptionID]; found {
+		// The subscription has been updated.  Only the signing secrets can change after it has been created.
+		worker.UpdateSigningSecrets(event.Subscription.SigningSecrets)
 	} else {
 		worker, err := NewSubscriptionWorker(ctx, n.clientProvider, n.subscriptionJobCompleteChannel, event.Subscription)
 		if err != nil {
@@ -324,15 +363,27 @@ func (n *Notifier) handleSubscriptionEvent(ctx context.Context, event *Subscript
 }
 
 // handleSubscriptionJobCompleteEvent handles a job completion event, removes the subscriber from the event job,
+// moves undelivered notifications to the dead letter queue and updates the state of the subscription.
 func (n *Notifier) handleSubscriptionJobCompleteEvent(ctx context.Context, event *SubscriptionJobComplete) error {
 	slog.Debug("handling subscription job complete event",
 		"NotificationID", event.notificationID, "subscriptionID", event.subscriptionID)
 
+	replayed := event.deadLetterID != nil
+
 	// Lookup the subscription worker for this event
 	if worker, found := n.workers[event.subscriptionID]; found {
-		// Update the subscription's event cursor.
+		if err := n.handleDeadLetter(ctx, event); err != nil {
+			// Leave the cursor untouched so that the notification is not released until it has been persisted
+			return err
+		}
+
+		// Update the subscription's event cursor and suspension.  Redelivered notifications are older than the
+		// cursor, so they don't move it.
 		subscription := worker.subscription
-		subscription.EventCursor = event.sequenceID
+		if !replayed {
+			subscription.EventCursor = event.sequenceID
+		}
+		subscription.SuspendedUntil = event.suspendedUntil
 		if err := n.subscriptionProvider.UpdateSubscription(ctx, subscription); err != nil {
 			return fmt.Errorf("failed to update subscription: %w", err)
 		}
@@ -341,5 +392,75 @@ func (n *Notifier) handleSubscriptionJobCompleteEvent(ctx context.Context, event
 		slog.Debug("subscription worker not found", "subscriptionID", event.subscriptionID)
 	}
 
+	if replayed {
+		// The original notification was already released when it was added to the dead letter queue.
+		return nil
+	}
+
 	return n.releaseNotification(ctx, event.notificationID, event.sequenceID)
 }
+
+// handleDeadLetter updates the dead letter queue of the subscription according to the outcome of the delivery.
+func (n *Notifier) handleDeadLetter(ctx context.Context, event *SubscriptionJobComplete) error {
+	switch {
+	case event.deadLetterID == nil && event.err != nil:
+		slog.Warn("adding notification to the dead letter queue", "subscriptionID", event.subscriptionID,
+			"NotificationID", event.notificationID, "sequenceID", event.sequenceID, "reason", event.err)
+		if err := n.notificationProvider.CreateDeadLetter(ctx, event.subscriptionID, event.notification,
+			event.err.Error()); err != nil {
+			return fmt.Errorf("failed to add notification to the dead letter queue: %w", err)
+		}
+	case event.deadLetterID != nil && event.err == nil:
+		if err := n.notificationProvider.DeleteDeadLetter(ctx, *event.deadLetterID); err != nil {
+			return fmt.Errorf("failed to delete redelivered notification from the dead letter queue: %w", err)
+		}
+	case event.deadLetterID != nil && !errors.Is(event.err, ErrSubscriptionSuspended):
+		if err := n.notificationProvider.UpdateDeadLetter(ctx, *event.deadLetterID, event.err.Error()); err != nil {
+			return fmt.Errorf("failed to update notification in the dead letter queue: %w", err)
+		}
+	}
+	return nil
+}
+
+// handleReplayEvent ends the suspension of a subscription and queues the content of its dead letter queue for
+// redelivery.
+func (n *Notifier) handleReplayEvent(ctx context.Context, subscriptionID uuid.UUID) error {
+	worker, found := n.workers[subscriptionID]
+	if !found {
+		return fmt.Errorf("subscription worker not found for %s", subscriptionID)
+	}
+
+	notifications, err := n.notificationProvider.GetDeadLetters(ctx, subscriptionID)
+	if err != nil {
+		return fmt.Errorf("failed to get dead letters: %w", err)
+	}
+
+	worker.Resume()
+	if worker.subscription.SuspendedUntil != nil {
+		worker.subscription.SuspendedUntil = nil
+		if err := n.subscriptionProvider.UpdateSubscription(ctx, worker.subscription); err != nil {
+			return fmt.Errorf("failed to update subscription: %w", err)
+		}
+	}
+
+	// Skip notifications that are still queued from a previous request
+	queued := make(map[uuid.UUID]bool)
+	for _, notification := range worker.GetNotifications() {
+		if notification.DeadLetterID != nil {
+			queued[*notification.DeadLetterID] = true
+		}
+	}
+
+	count := 0
+	for i := range notifications {
+		notification := notifications[i]
+		if notification.DeadLetterID == nil || queued[*notification.DeadLetterID] {
+			continue
+		}
+		worker.NewNotification(&notification)
+		count++
+	}
+
+	slog.Info("dead letter queue replayed", "subscriptionID", subscriptionID, "count", count)
+	return nil
+}
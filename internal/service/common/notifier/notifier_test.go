@@ -0,0 +1,226 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notifier
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	commonapi "github.com/openshift-kni/oran-o2ims/api/common"
+)
+
+type fakeClientProvider struct{}
+
+func (f *fakeClientProvider) NewClient(ctx context.Context, authType commonapi.AuthType) (*http.Client, error) {
+	return &http.Client{}, nil
+}
+
+type fakeNotificationProvider struct {
+	deleted     []uuid.UUID
+	deadLetters []Notification
+	created     []uuid.UUID
+	updated     []uuid.UUID
+	redelivered []uuid.UUID
+}
+
+func (f *fakeNotificationProvider) GetNotifications(ctx context.Context) ([]Notification, error) {
+	return nil, nil
+}
+
+func (f *fakeNotificationProvider) DeleteNotification(ctx context.Context, notificationID uuid.UUID) error {
+	f.deleted = append(f.deleted, notificationID)
+	return nil
+}
+
+func (f *fakeNotificationProvider) CreateDeadLetter(ctx context.Context, subscriptionID uuid.UUID, notification *Notification, reason string) error {
+	f.created = append(f.created, notification.NotificationID)
+	return nil
+}
+
+func (f *fakeNotificationProvider) GetDeadLetters(ctx context.Context, subscriptionID uuid.UUID) ([]Notification, error) {
+	return f.deadLetters, nil
+}
+
+func (f *fakeNotificationProvider) UpdateDeadLetter(ctx context.Context, deadLetterID uuid.UUID, reason string) error {
+	f.updated = append(f.updated, deadLetterID)
+	return nil
+}
+
+func (f *fakeNotificationProvider) DeleteDeadLetter(ctx context.Context, deadLetterID uuid.UUID) error {
+	f.redelivered = append(f.redelivered, deadLetterID)
+	return nil
+}
+
+type fakeSubscriptionProvider struct {
+	updates []SubscriptionInfo
+}
+
+func (f *fakeSubscriptionProvider) GetSubscriptions(ctx context.Context) ([]SubscriptionInfo, error) {
+	return nil, nil
+}
+
+func (f *fakeSubscriptionProvider) Matches(_ context.Context, subscription *SubscriptionInfo, notification *Notification) bool {
+	return true
+}
+
+func (f *fakeSubscriptionProvider) UpdateSubscription(ctx context.Context, subscription *SubscriptionInfo) error {
+	f.updates = append(f.updates, *subscription)
+	return nil
+}
+
+func (f *fakeSubscriptionProvider) Transform(subscription *SubscriptionInfo, notification *Notification) (*Notification, error) {
+	return notification, nil
+}
+
+var _ = Describe("Dead letter queue", func() {
+	var (
+		ctx           context.Context
+		cancel        context.CancelFunc
+		notifications *fakeNotificationProvider
+		subscriptions *fakeSubscriptionProvider
+		notifier      *Notifier
+		subscription  *SubscriptionInfo
+		worker        *SubscriptionWorker
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		notifications = &fakeNotificationProvider{}
+		subscriptions = &fakeSubscriptionProvider{}
+		notifier = NewNotifier(subscriptions, notifications, &fakeClientProvider{})
+		subscription = &SubscriptionInfo{
+			SubscriptionID: uuid.New(),
+			Callback:       "https://smo.example.com/notifications",
+			EventCursor:    1,
+		}
+
+		var err error
+		worker, err = NewSubscriptionWorker(ctx, notifier.clientProvider, notifier.subscriptionJobCompleteChannel, subscription)
+		Expect(err).ToNot(HaveOccurred())
+		notifier.workers[subscription.SubscriptionID] = worker
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	Describe("Job completion", func() {
+		It("Moves undelivered notifications to the dead letter queue", func() {
+			notification := &Notification{NotificationID: uuid.New(), SequenceID: 2}
+			until := time.Now().Add(suspensionPeriod)
+			event := newSubscriptionJobComplete(subscription.SubscriptionID, notification, errors.New("notification failed: 503"))
+			event.suspendedUntil = &until
+
+			Expect(notifier.handleSubscriptionJobCompleteEvent(ctx, event)).To(Succeed())
+			Expect(notifications.created).To(Equal([]uuid.UUID{notification.NotificationID}))
+			Expect(subscriptions.updates).To(HaveLen(1))
+			Expect(subscriptions.updates[0].EventCursor).To(Equal(2))
+			Expect(subscriptions.updates[0].SuspendedUntil).To(Equal(&until))
+			Expect(notifications.deleted).To(Equal([]uuid.UUID{notification.NotificationID}))
+		})
+
+		It("Removes redelivered notifications from the dead letter queue", func() {
+			deadLetterID := uuid.New()
+			notification := &Notification{NotificationID: uuid.New(), SequenceID: 1, DeadLetterID: &deadLetterID}
+			subscription.EventCursor = 5
+
+			event := newSubscriptionJobComplete(subscription.SubscriptionID, notification, nil)
+			Expect(notifier.handleSubscriptionJobCompleteEvent(ctx, event)).To(Succeed())
+			Expect(notifications.redelivered).To(Equal([]uuid.UUID{deadLetterID}))
+			Expect(notifications.created).To(BeEmpty())
+			Expect(subscriptions.updates[0].EventCursor).To(Equal(5))
+			Expect(notifications.deleted).To(BeEmpty())
+		})
+
+		It("Records failed redeliveries", func() {
+			deadLetterID := uuid.New()
+			notification := &Notification{NotificationID: uuid.New(), SequenceID: 1, DeadLetterID: &deadLetterID}
+
+			event := newSubscriptionJobComplete(subscription.SubscriptionID, notification, errors.New("notification failed: 503"))
+			Expect(notifier.handleSubscriptionJobCompleteEvent(ctx, event)).To(Succeed())
+			Expect(notifications.updated).To(Equal([]uuid.UUID{deadLetterID}))
+			Expect(notifications.created).To(BeEmpty())
+		})
+
+		It("Leaves skipped redeliveries untouched", func() {
+			deadLetterID := uuid.New()
+			notification := &Notification{NotificationID: uuid.New(), SequenceID: 1, DeadLetterID: &deadLetterID}
+
+			event := newSubscriptionJobComplete(subscription.SubscriptionID, notification, ErrSubscriptionSuspended)
+			Expect(notifier.handleSubscriptionJobCompleteEvent(ctx, event)).To(Succeed())
+			Expect(notifications.updated).To(BeEmpty())
+			Expect(notifications.redelivered).To(BeEmpty())
+			Expect(notifications.created).To(BeEmpty())
+		})
+	})
+
+	Describe("Suspension", func() {
+		It("Suspends the subscription when a notification can't be delivered", func() {
+			event := newSubscriptionJobComplete(subscription.SubscriptionID, &Notification{}, errors.New("failed"))
+			worker.updateSuspension(event)
+			Expect(event.suspendedUntil).ToNot(BeNil())
+			Expect(*event.suspendedUntil).To(BeTemporally("~", time.Now().Add(suspensionPeriod), time.Minute))
+
+			// Skipped notifications don't extend the suspension:
+			until := *event.suspendedUntil
+			event = newSubscriptionJobComplete(subscription.SubscriptionID, &Notification{}, ErrSubscriptionSuspended)
+			worker.updateSuspension(event)
+			Expect(event.suspendedUntil).To(Equal(&until))
+
+			// A delivered notification ends it:
+			event = newSubscriptionJobComplete(subscription.SubscriptionID, &Notification{}, nil)
+			worker.updateSuspension(event)
+			Expect(event.suspendedUntil).To(BeNil())
+		})
+
+		It("Skips notifications while the subscription is suspended", func() {
+			until := time.Now().Add(time.Hour)
+			worker.suspendedUntil = &until
+			notification := &Notification{NotificationID: uuid.New(), SequenceID: 2}
+
+			worker.processNextEvent(ctx, notification)
+
+			var event *SubscriptionJobComplete
+			Eventually(worker.currentEventDone).Should(Receive(&event))
+			Expect(event.notificationID).To(Equal(notification.NotificationID))
+			Expect(event.err).To(MatchError(ErrSubscriptionSuspended))
+		})
+	})
+
+	Describe("Replay", func() {
+		It("Resumes the subscription and queues the dead letters once", func() {
+			until := time.Now().Add(time.Hour)
+			worker.suspendedUntil = &until
+			subscription.SuspendedUntil = &until
+			first, second := uuid.New(), uuid.New()
+			notifications.deadLetters = []Notification{
+				{NotificationID: uuid.New(), SequenceID: 1, DeadLetterID: &first},
+				{NotificationID: uuid.New(), SequenceID: 2, DeadLetterID: &second},
+			}
+
+			Expect(notifier.handleReplayEvent(ctx, subscription.SubscriptionID)).To(Succeed())
+			Expect(worker.suspendedUntil).To(BeNil())
+			Expect(subscriptions.updates).To(HaveLen(1))
+			Expect(subscriptions.updates[0].SuspendedUntil).To(BeNil())
+			Expect(worker.GetNotifications()).To(HaveLen(2))
+
+			// Replaying again doesn't queue the notifications that are still pending:
+			Expect(notifier.handleReplayEvent(ctx, subscription.SubscriptionID)).To(Succeed())
+			Expect(worker.GetNotifications()).To(HaveLen(2))
+		})
+
+		It("Fails for unknown subscriptions", func() {
+			Expect(notifier.handleReplayEvent(ctx, uuid.New())).ToNot(Succeed())
+		})
+	})
+})
@@ -8,6 +8,7 @@ package notifier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
@@ -25,12 +26,28 @@ const maxRetries = 5
 // retryDelay defines the amount of time between each successive notification attempt
 const retryDelay = 10 * time.Second // TODO: increase
 
+// suspensionPeriod defines the amount of time during which no delivery is attempted to a subscriber after a
+// notification could not be delivered.  Notifications raised during that period are added directly to the dead letter
+// queue of the subscription.
+const suspensionPeriod = 30 * time.Minute
+
+// ErrSubscriptionSuspended is reported for notifications that were not sent because the subscription is suspended.
+var ErrSubscriptionSuspended = errors.New("subscription is suspended")
+
 // SubscriptionJobComplete is the event sent from the subscription worker to the notifier to report that it has
-// successfully sent a notification for the data change event
+// finished handling a notification for the data change event
 type SubscriptionJobComplete struct {
 	subscriptionID uuid.UUID
 	notificationID uuid.UUID
 	sequenceID     int
+	// deadLetterID is set if the notification was redelivered from the dead letter queue
+	deadLetterID *uuid.UUID
+	// notification is the notification that was handled
+	notification *Notification
+	// err is set if the notification could not be delivered
+	err error
+	// suspendedUntil is the end of the suspension of the subscription after handling the notification
+	suspendedUntil *time.Time
 }
 
 // SubscriptionWorker is a placeholder that represents a go routine created to monitor events for a subscription
@@ -48,8 +65,12 @@ type SubscriptionWorker struct {
 	cancel context.CancelFunc
 	// workQueue represents the list of work to be done by the worker
 	workQueue []*Notification
-	// workMutex protects the workQueue from concurrent changes
+	// workMutex protects the workQueue, suspendedUntil and signingSecrets from concurrent changes
 	workMutex sync.Mutex
+	// suspendedUntil is set while deliveries are suspended following a failed notification
+	suspendedUntil *time.Time
+	// signingSecrets holds the secrets used to sign the deliveries, if any
+	signingSecrets *SigningSecrets
 	// currentEventDone signals back to the worker that the current event has been processed
 	currentEventDone chan *SubscriptionJobComplete
 	// client is used to communicate to the subscriber
@@ -85,6 +106,8 @@ func NewSubscriptionWorker(ctx context.Context, clientProvider ClientProvider, s
 		currentEventDone:               make(chan *SubscriptionJobComplete, 1),
 		client:                         client,
 		logger:                         logger,
+		suspendedUntil:                 subscription.SuspendedUntil,
+		signingSecrets:                 subscription.SigningSecrets,
 	}, nil
 }
 
@@ -107,6 +130,25 @@ func (w *SubscriptionWorker) GetNotifications() []*Notification {
 	return w.workQueue
 }
 
+// Resume ends the suspension of the subscription so that the next notification is delivered
+func (w *SubscriptionWorker) Resume() {
+	w.workMutex.Lock()
+	defer w.workMutex.Unlock()
+	if w.suspendedUntil != nil {
+		w.logger.Info("Subscription resumed")
+		w.suspendedUntil = nil
+	}
+}
+
+// UpdateSigningSecrets replaces the secrets used to sign the deliveries following a rotation.  Deliveries already in
+// progress continue to use the secrets in effect when they were started.
+func (w *SubscriptionWorker) UpdateSigningSecrets(secrets *SigningSecrets) {
+	w.workMutex.Lock()
+	defer w.workMutex.Unlock()
+	w.signingSecrets = secrets
+	w.logger.Info("Subscription signing secrets updated")
+}
+
 // Shutdown terminates the worker and releases any pending events
 func (w *SubscriptionWorker) Shutdown() {
 	w.cancel()
@@ -133,6 +175,8 @@ func (w *SubscriptionWorker) Run() {
 
 // handleCurrentEventCompletion handles the end of the current event and looks for another event to process.
 func (w *SubscriptionWorker) handleCurrentEventCompletion(e *SubscriptionJobComplete) {
+	w.updateSuspension(e)
+
 	// Forward to the notifier so that it can release it. This may block if the notifier is busy handling other
 	// completion jobs or new notifications.  We need to combine this into a select that also checks the context
 	// cancellation to ensure that we are not stuck here if the subscription is deleted or the notifier is shutdown
@@ -159,8 +203,32 @@ func (w *SubscriptionWorker) handleCurrentEventCompletion(e *SubscriptionJobComp
 	w.processNextEvent(w.ctx, w.workQueue[0])
 }
 
+// updateSuspension suspends the subscription if the notification could not be delivered, or ends the suspension if
+// it was, and records the resulting state in the completion event.
+func (w *SubscriptionWorker) updateSuspension(e *SubscriptionJobComplete) {
+	w.workMutex.Lock()
+	defer w.workMutex.Unlock()
+
+	switch {
+	case e.err == nil:
+		w.suspendedUntil = nil
+	case !errors.Is(e.err, ErrSubscriptionSuspended):
+		until := time.Now().Add(suspensionPeriod)
+		w.suspendedUntil = &until
+		w.logger.Warn("Subscription suspended", "until", until)
+	}
+	e.suspendedUntil = w.suspendedUntil
+}
+
 // processNextEvent looks for the next event to be processed.
 func (w *SubscriptionWorker) processNextEvent(ctx context.Context, nextEvent *Notification) {
+	if w.suspendedUntil != nil && time.Now().Before(*w.suspendedUntil) {
+		// Don't burn retries on a subscriber that is known to be unreachable
+		go skipEvent(ctx, w.logger, w.currentEventDone, *nextEvent, w.subscription.SubscriptionID)
+		return
+	}
+
 	// Launch a task to send the notification (or retry on failures)
-	go processEvent(ctx, w.logger, w.client, w.currentEventDone, *nextEvent, w.subscription.SubscriptionID, w.subscription.Callback)
+	go processEvent(ctx, w.logger, w.client, w.currentEventDone, *nextEvent, w.subscription.SubscriptionID,
+		w.subscription.Callback, w.signingSecrets)
 }
@@ -0,0 +1,139 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notifier
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Headers added to each notification so that subscribers can authenticate deliveries and detect duplicates.
+const (
+	// DeliveryIDHeader carries the unique identifier of the notification.  Redeliveries keep the same value.
+	DeliveryIDHeader = "X-O2ims-Delivery-Id"
+	// TimestampHeader carries the time of the delivery attempt expressed in seconds since the Unix epoch.
+	TimestampHeader = "X-O2ims-Timestamp"
+	// SignatureHeader carries one HMAC-SHA256 signature per active secret of the subscription.
+	SignatureHeader = "X-O2ims-Signature"
+)
+
+// signatureScheme prefixes each signature in the SignatureHeader value
+const signatureScheme = "sha256="
+
+// SigningSecretRotationPeriod is the time during which the previous secret of a subscription remains in use after a
+// rotation so that subscribers can roll over to the new secret without rejecting deliveries.
+const SigningSecretRotationPeriod = 24 * time.Hour
+
+// SigningSecrets holds the shared secrets used to sign the notifications sent to a subscriber.
+type SigningSecrets struct {
+	// Current is the secret set by the last rotation
+	Current string
+	// Previous is the secret replaced by the last rotation
+	Previous *string
+	// PreviousExpiry is the time after which Previous is no longer used
+	PreviousExpiry *time.Time
+}
+
+// NewSigningSecrets creates the SigningSecrets of a subscription from its stored attributes.  It returns nil if the
+// subscription does not have a secret.
+func NewSigningSecrets(current, previous *string, previousExpiry *time.Time) *SigningSecrets {
+	if current == nil || *current == "" {
+		return nil
+	}
+	return &SigningSecrets{
+		Current:        *current,
+		Previous:       previous,
+		PreviousExpiry: previousExpiry,
+	}
+}
+
+// String prevents the secrets from being leaked by logs.
+func (s SigningSecrets) String() string {
+	return "[redacted]"
+}
+
+// MarshalJSON prevents the secrets from being leaked by structured logs.
+func (s SigningSecrets) MarshalJSON() ([]byte, error) {
+	return []byte(`"[redacted]"`), nil
+}
+
+// Active returns the list of secrets that must be used to sign a delivery at the specified time.
+func (s *SigningSecrets) Active(now time.Time) []string {
+	if s == nil || s.Current == "" {
+		return nil
+	}
+
+	secrets := []string{s.Current}
+	if s.Previous != nil && *s.Previous != "" && s.PreviousExpiry != nil && now.Before(*s.PreviousExpiry) {
+		secrets = append(secrets, *s.Previous)
+	}
+	return secrets
+}
+
+// Rotate returns the secrets resulting from replacing the current secret by a new one.  The current secret, if any,
+// remains in use until the end of the SigningSecretRotationPeriod.
+func (s *SigningSecrets) Rotate(secret string, now time.Time) *SigningSecrets {
+	rotated := &SigningSecrets{Current: secret}
+	if s != nil && s.Current != "" {
+		previous := s.Current
+		expiry := now.Add(SigningSecretRotationPeriod)
+		rotated.Previous = &previous
+		rotated.PreviousExpiry = &expiry
+	}
+	return rotated
+}
+
+// Sign computes the hex encoded HMAC-SHA256 of a delivery.  The signed content is the timestamp, the delivery ID,
+// and the raw body separated by '.' characters, which binds the signature to a single delivery attempt.
+func Sign(secret, timestamp string, deliveryID uuid.UUID, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write([]byte(deliveryID.String()))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify checks that the value of a SignatureHeader contains a valid signature of the delivery for the specified
+// secret.
+func Verify(secret, timestamp string, deliveryID uuid.UUID, body []byte, header string) bool {
+	expected := []byte(Sign(secret, timestamp, deliveryID, body))
+	for _, value := range strings.Split(header, ",") {
+		signature, found := strings.CutPrefix(strings.TrimSpace(value), signatureScheme)
+		if found && hmac.Equal([]byte(signature), expected) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetDeliveryHeaders adds the delivery ID, timestamp and, if the subscription has secrets, signature headers to a
+// notification request.
+func SetDeliveryHeaders(request *http.Request, deliveryID uuid.UUID, body []byte, secrets []string, now time.Time) {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	request.Header.Set(DeliveryIDHeader, deliveryID.String())
+	request.Header.Set(TimestampHeader, timestamp)
+
+	if len(secrets) == 0 {
+		return
+	}
+
+	signatures := make([]string, 0, len(secrets))
+	for _, secret := range secrets {
+		signatures = append(signatures, fmt.Sprintf("%s%s", signatureScheme, Sign(secret, timestamp, deliveryID, body)))
+	}
+	request.Header.Set(SignatureHeader, strings.Join(signatures, ", "))
+}
@@ -0,0 +1,144 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package notifier
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Signed deliveries", func() {
+	var (
+		now      time.Time
+		previous string
+	)
+
+	BeforeEach(func() {
+		now = time.Now()
+		previous = "previous-secret-value"
+	})
+
+	Describe("Active secrets", func() {
+		It("Returns nothing if the subscription has no secret", func() {
+			var secrets *SigningSecrets
+			Expect(secrets.Active(now)).To(BeEmpty())
+			Expect(NewSigningSecrets(nil, nil, nil)).To(BeNil())
+		})
+
+		It("Includes the previous secret until it expires", func() {
+			expiry := now.Add(time.Hour)
+			secrets := NewSigningSecrets(ptr("current-secret-value"), &previous, &expiry)
+			Expect(secrets.Active(now)).To(Equal([]string{"current-secret-value", previous}))
+			Expect(secrets.Active(expiry)).To(Equal([]string{"current-secret-value"}))
+		})
+
+		It("Keeps the replaced secret for the rotation period", func() {
+			secrets := NewSigningSecrets(&previous, nil, nil).Rotate("current-secret-value", now)
+			Expect(secrets.Current).To(Equal("current-secret-value"))
+			Expect(secrets.Previous).To(Equal(&previous))
+			Expect(*secrets.PreviousExpiry).To(Equal(now.Add(SigningSecretRotationPeriod)))
+		})
+
+		It("Does not have a previous secret after the first rotation", func() {
+			var secrets *SigningSecrets
+			rotated := secrets.Rotate("current-secret-value", now)
+			Expect(rotated.Previous).To(BeNil())
+			Expect(rotated.PreviousExpiry).To(BeNil())
+		})
+
+		It("Does not leak secrets through formatting", func() {
+			secrets := SigningSecrets{Current: "current-secret-value"}
+			Expect(secrets.String()).ToNot(ContainSubstring("current-secret-value"))
+			data, err := secrets.MarshalJSON()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).ToNot(ContainSubstring("current-secret-value"))
+		})
+	})
+
+	Describe("Sending a notification", func() {
+		var (
+			server   *httptest.Server
+			headers  http.Header
+			body     []byte
+			event    Notification
+			received chan struct{}
+		)
+
+		BeforeEach(func() {
+			received = make(chan struct{}, 1)
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				headers = r.Header.Clone()
+				body, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusNoContent)
+				received <- struct{}{}
+			}))
+			event = Notification{NotificationID: uuid.New(), SequenceID: 1, Payload: map[string]string{"key": "value"}}
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("Sets the delivery headers without a signature if the subscription has no secret", func() {
+			Expect(sendNotification(context.Background(), server.Client(), server.URL, nil, event)).To(Succeed())
+			Eventually(received).Should(Receive())
+			Expect(headers.Get(DeliveryIDHeader)).To(Equal(event.NotificationID.String()))
+			_, err := strconv.ParseInt(headers.Get(TimestampHeader), 10, 64)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(headers.Get(SignatureHeader)).To(BeEmpty())
+		})
+
+		It("Signs the delivery with every active secret", func() {
+			expiry := now.Add(time.Hour)
+			secrets := NewSigningSecrets(ptr("current-secret-value"), &previous, &expiry)
+
+			Expect(sendNotification(context.Background(), server.Client(), server.URL, secrets, event)).To(Succeed())
+			Eventually(received).Should(Receive())
+
+			timestamp := headers.Get(TimestampHeader)
+			signature := headers.Get(SignatureHeader)
+			Expect(Verify("current-secret-value", timestamp, event.NotificationID, body, signature)).To(BeTrue())
+			Expect(Verify(previous, timestamp, event.NotificationID, body, signature)).To(BeTrue())
+			Expect(Verify("another-secret-value", timestamp, event.NotificationID, body, signature)).To(BeFalse())
+			Expect(Verify("current-secret-value", "0", event.NotificationID, body, signature)).To(BeFalse())
+		})
+	})
+
+	Describe("Subscription updates", func() {
+		It("Replaces the secrets of the existing worker", func() {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			notifier := NewNotifier(&fakeSubscriptionProvider{}, &fakeNotificationProvider{}, &fakeClientProvider{})
+			subscription := &SubscriptionInfo{
+				SubscriptionID: uuid.New(),
+				Callback:       "https://smo.example.com/notifications",
+			}
+			Expect(notifier.handleSubscriptionEvent(ctx, &SubscriptionEvent{Subscription: subscription})).To(Succeed())
+			worker := notifier.workers[subscription.SubscriptionID]
+			Expect(worker.signingSecrets).To(BeNil())
+
+			updated := *subscription
+			updated.SigningSecrets = NewSigningSecrets(ptr("current-secret-value"), nil, nil)
+			Expect(notifier.handleSubscriptionEvent(ctx, &SubscriptionEvent{Subscription: &updated})).To(Succeed())
+			Expect(notifier.workers[subscription.SubscriptionID]).To(BeIdenticalTo(worker))
+			Expect(worker.signingSecrets).To(Equal(updated.SigningSecrets))
+		})
+	})
+})
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -10,9 +10,11 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
+	"time"
 
 	"github.com/google/uuid"
 
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
 )
@@ -20,16 +22,27 @@ import (
 // Compile time check for interface compliance
 var _ notifier.NotificationProvider = (*NotificationStorageProvider)(nil)
 
+// NotificationConverter converts a persisted data change event to the notification published to the subscribers
+type NotificationConverter func(record *commonmodels.DataChangeEvent) *notifier.Notification
+
 // NotificationStorageProvider implements the NotificationProvider interface as a means to abstract the concrete
 // notification type out of the Notifier
 type NotificationStorageProvider struct {
 	repository *CommonRepository
+	converter  NotificationConverter
 }
 
 // NewNotificationStorageProvider creates a new NotificationProvider
 func NewNotificationStorageProvider(repository *CommonRepository) notifier.NotificationProvider {
+	return NewNotificationStorageProviderWithConverter(repository, models.DataChangeEventToNotification)
+}
+
+// NewNotificationStorageProviderWithConverter creates a new NotificationProvider publishing the data change events
+// with a server-specific notification type
+func NewNotificationStorageProviderWithConverter(repository *CommonRepository, converter NotificationConverter) notifier.NotificationProvider {
 	return &NotificationStorageProvider{
 		repository: repository,
+		converter:  converter,
 	}
 }
 
@@ -42,7 +55,7 @@ func (p *NotificationStorageProvider) GetNotifications(ctx context.Context) ([]n
 
 	var notifications []notifier.Notification
 	for _, record := range records {
-		notifications = append(notifications, *models.DataChangeEventToNotification(&record))
+		notifications = append(notifications, *p.converter(&record))
 	}
 
 	return notifications, nil
@@ -61,3 +74,60 @@ func (p *NotificationStorageProvider) DeleteNotification(ctx context.Context, no
 	}
 	return nil
 }
+
+// CreateDeadLetter adds a notification that could not be delivered to the dead letter queue of a subscription.
+func (p *NotificationStorageProvider) CreateDeadLetter(ctx context.Context, subscriptionID uuid.UUID, notification *notifier.Notification, reason string) error {
+	record, err := commonmodels.NotificationToDeadLetter(subscriptionID, notification, reason)
+	if err != nil {
+		return fmt.Errorf("failed to convert notification %s: %w", notification.NotificationID, err)
+	}
+
+	_, err = p.repository.CreateDeadLetterNotification(ctx, record)
+	if err != nil {
+		return fmt.Errorf("failed to create dead letter for notification %s: %w", notification.NotificationID, err)
+	}
+	return nil
+}
+
+// GetDeadLetters returns the content of the dead letter queue of a subscription in the order it should be redelivered.
+func (p *NotificationStorageProvider) GetDeadLetters(ctx context.Context, subscriptionID uuid.UUID) ([]notifier.Notification, error) {
+	records, err := p.repository.GetDeadLetterNotifications(ctx, subscriptionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get dead letters for subscription %s: %w", subscriptionID, err)
+	}
+
+	notifications := make([]notifier.Notification, 0, len(records))
+	for _, record := range records {
+		notifications = append(notifications, *commonmodels.DeadLetterToNotification(&record))
+	}
+
+	return notifications, nil
+}
+
+// UpdateDeadLetter records a failed attempt to redeliver a notification from the dead letter queue.
+func (p *NotificationStorageProvider) UpdateDeadLetter(ctx context.Context, deadLetterID uuid.UUID, reason string) error {
+	record, err := p.repository.GetDeadLetterNotification(ctx, deadLetterID)
+	if err != nil {
+		return fmt.Errorf("failed to get dead letter %s: %w", deadLetterID, err)
+	}
+
+	now := time.Now()
+	record.Attempts++
+	record.LastError = reason
+	record.UpdatedAt = &now
+
+	_, err = p.repository.UpdateDeadLetterNotification(ctx, record)
+	if err != nil {
+		return fmt.Errorf("failed to update dead letter %s: %w", deadLetterID, err)
+	}
+	return nil
+}
+
+// DeleteDeadLetter removes a notification from the dead letter queue once it has been redelivered.
+func (p *NotificationStorageProvider) DeleteDeadLetter(ctx context.Context, deadLetterID uuid.UUID) error {
+	_, err := p.repository.DeleteDeadLetterNotification(ctx, deadLetterID)
+	if err != nil {
+		return fmt.Errorf("failed to delete dead letter %s: %w", deadLetterID, err)
+	}
+	return nil
+}
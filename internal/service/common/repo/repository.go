@@ -54,6 +54,95 @@ func (r *CommonRepository) UpdateSubscription(ctx context.Context, subscription
 	return svcutils.Update[commonmodels.Subscription](ctx, r.Db, *subscription.SubscriptionID, *subscription)
 }
 
+// UpdateSubscriptionEventCursor updates the event cursor and the end of the suspension of a Subscription tuple.  Unlike
+// UpdateSubscription, the suspension is written even if nil so that it can be cleared.
+func (r *CommonRepository) UpdateSubscriptionEventCursor(ctx context.Context, subscription *commonmodels.Subscription) (*commonmodels.Subscription, error) {
+	m := commonmodels.Subscription{}
+	all := svcutils.GetAllDBTagsFromStruct(m)
+
+	query := psql.Update(
+		um.Table(m.TableName()),
+		um.SetCol(all["EventCursor"]).ToArg(subscription.EventCursor),
+		um.SetCol(all["SuspendedUntil"]).ToArg(subscription.SuspendedUntil),
+		um.Where(psql.Quote(m.PrimaryKey()).EQ(psql.Arg(*subscription.SubscriptionID))),
+		um.Returning(all.Columns()...),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subscription event cursor update query: %w", err)
+	}
+
+	return svcutils.ExecuteCollectExactlyOneRow[commonmodels.Subscription](ctx, r.Db, sql, params)
+}
+
+// UpdateSubscriptionSigningSecret updates the signing secrets of a Subscription tuple.  Unlike UpdateSubscription,
+// the previous secret is written even if nil so that it can be cleared.
+func (r *CommonRepository) UpdateSubscriptionSigningSecret(ctx context.Context, subscription *commonmodels.Subscription) (*commonmodels.Subscription, error) {
+	m := commonmodels.Subscription{}
+	all := svcutils.GetAllDBTagsFromStruct(m)
+
+	query := psql.Update(
+		um.Table(m.TableName()),
+		um.SetCol(all["SigningSecret"]).ToArg(subscription.SigningSecret),
+		um.SetCol(all["PreviousSigningSecret"]).ToArg(subscription.PreviousSigningSecret),
+		um.SetCol(all["PreviousSigningSecretExpiry"]).ToArg(subscription.PreviousSigningSecretExpiry),
+		um.Where(psql.Quote(m.PrimaryKey()).EQ(psql.Arg(*subscription.SubscriptionID))),
+		um.Returning(all.Columns()...),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subscription signing secret update query: %w", err)
+	}
+
+	return svcutils.ExecuteCollectExactlyOneRow[commonmodels.Subscription](ctx, r.Db, sql, params)
+}
+
+// GetDeadLetterNotifications retrieves the DeadLetterNotification tuples of a subscription sorted by sequence or
+// returns an empty array if no tuples are found
+func (r *CommonRepository) GetDeadLetterNotifications(ctx context.Context, subscriptionID uuid.UUID) ([]commonmodels.DeadLetterNotification, error) {
+	m := commonmodels.DeadLetterNotification{}
+	all := svcutils.GetAllDBTagsFromStruct(m)
+
+	query := psql.Select(
+		sm.Columns(all.Columns()...),
+		sm.From(m.TableName()),
+		sm.Where(psql.Quote(all["SubscriptionID"]).EQ(psql.Arg(subscriptionID))),
+		sm.OrderBy(all["SequenceID"]).Asc(),
+		sm.OrderBy(all["CreatedAt"]).Asc(),
+	)
+
+	sql, params, err := query.Build(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build dead letter notifications query: %w", err)
+	}
+
+	return svcutils.ExecuteCollectRows[commonmodels.DeadLetterNotification](ctx, r.Db, sql, params)
+}
+
+// GetDeadLetterNotification retrieves a specific DeadLetterNotification tuple or returns ErrNotFound if not found
+func (r *CommonRepository) GetDeadLetterNotification(ctx context.Context, id uuid.UUID) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Find[commonmodels.DeadLetterNotification](ctx, r.Db, id)
+}
+
+// CreateDeadLetterNotification creates a new DeadLetterNotification tuple
+func (r *CommonRepository) CreateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Create[commonmodels.DeadLetterNotification](ctx, r.Db, *record)
+}
+
+// UpdateDeadLetterNotification updates the delivery attempts of a specific DeadLetterNotification tuple
+func (r *CommonRepository) UpdateDeadLetterNotification(ctx context.Context, record *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error) {
+	return svcutils.Update[commonmodels.DeadLetterNotification](ctx, r.Db, *record.DeadLetterID, *record,
+		"Attempts", "LastError", "UpdatedAt")
+}
+
+// DeleteDeadLetterNotification deletes a DeadLetterNotification tuple
+func (r *CommonRepository) DeleteDeadLetterNotification(ctx context.Context, id uuid.UUID) (int64, error) {
+	expr := psql.Quote(commonmodels.DeadLetterNotification{}.PrimaryKey()).EQ(psql.Arg(id))
+	return svcutils.Delete[commonmodels.DeadLetterNotification](ctx, r.Db, expr)
+}
+
 // GetDataSourceByName retrieves a specific DataSource tuple by name or returns ErrNotFound if not found
 func (r *CommonRepository) GetDataSourceByName(ctx context.Context, name string) (*commonmodels.DataSource, error) {
 	e := psql.Quote("name").EQ(psql.Arg(name))
@@ -20,10 +20,17 @@ type RepositoryInterface interface {
 	DeleteSubscription(context.Context, uuid.UUID) (int64, error)
 	CreateSubscription(context.Context, *commonmodels.Subscription) (*commonmodels.Subscription, error)
 	UpdateSubscription(context.Context, *commonmodels.Subscription) (*commonmodels.Subscription, error)
+	UpdateSubscriptionEventCursor(context.Context, *commonmodels.Subscription) (*commonmodels.Subscription, error)
+	UpdateSubscriptionSigningSecret(context.Context, *commonmodels.Subscription) (*commonmodels.Subscription, error)
 	GetDataSourceByName(context.Context, string) (*commonmodels.DataSource, error)
 	CreateDataSource(context.Context, *commonmodels.DataSource) (*commonmodels.DataSource, error)
 	UpdateDataSource(context.Context, *commonmodels.DataSource) (*commonmodels.DataSource, error)
 	CreateDataChangeEvent(context.Context, *commonmodels.DataChangeEvent) (*commonmodels.DataChangeEvent, error)
 	DeleteDataChangeEvent(context.Context, uuid.UUID) (int64, error)
 	GetDataChangeEvents(context.Context) ([]commonmodels.DataChangeEvent, error)
+	GetDeadLetterNotifications(context.Context, uuid.UUID) ([]commonmodels.DeadLetterNotification, error)
+	GetDeadLetterNotification(context.Context, uuid.UUID) (*commonmodels.DeadLetterNotification, error)
+	CreateDeadLetterNotification(context.Context, *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error)
+	UpdateDeadLetterNotification(context.Context, *commonmodels.DeadLetterNotification) (*commonmodels.DeadLetterNotification, error)
+	DeleteDeadLetterNotification(context.Context, uuid.UUID) (int64, error)
 }
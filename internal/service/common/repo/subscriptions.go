@@ -8,14 +8,22 @@ package repo
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"log/slog"
+	"maps"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
 )
 
+// objectTypeAttribute is the attribute holding the API type name of the object when evaluating subscription filters
+const objectTypeAttribute = "objectType"
+
 // Compile time check for interface compliance
 var _ notifier.SubscriptionProvider = (*SubscriptionStorageProvider)(nil)
 
@@ -28,16 +36,23 @@ type NotificationTransformer interface {
 // SubscriptionStorageProvider implements the SubscriptionProvider interface as a means to abstract the concrete
 // subscription type out of the Notifier
 type SubscriptionStorageProvider struct {
-	repository  *CommonRepository
-	transformer NotificationTransformer
+	repository        *CommonRepository
+	transformer       NotificationTransformer
+	selectorEvaluator *search.SelectorEvaluator
 }
 
 // NewSubscriptionStorageProvider creates a new SubscriptionProvider
-func NewSubscriptionStorageProvider(repository *CommonRepository, transformer NotificationTransformer) notifier.SubscriptionProvider {
-	return &SubscriptionStorageProvider{
-		repository:  repository,
-		transformer: transformer,
+func NewSubscriptionStorageProvider(repository *CommonRepository, transformer NotificationTransformer) (notifier.SubscriptionProvider, error) {
+	selectorEvaluator, err := search.NewObjectSelectorEvaluator(slog.Default())
+	if err != nil {
+		return nil, fmt.Errorf("failed to build subscription evaluator: %w", err)
 	}
+
+	return &SubscriptionStorageProvider{
+		repository:        repository,
+		transformer:       transformer,
+		selectorEvaluator: selectorEvaluator,
+	}, nil
 }
 
 // GetSubscriptions returns the list of subscriptions persisted to the database
@@ -56,32 +71,89 @@ func (p *SubscriptionStorageProvider) GetSubscriptions(ctx context.Context) ([]n
 }
 
 // UpdateSubscription updates the subscription on behalf of the Notifier.  Currently only supports setting the event
-// cursor.
+// cursor and the end of the suspension.
 func (p *SubscriptionStorageProvider) UpdateSubscription(ctx context.Context, subscription *notifier.SubscriptionInfo) error {
-	record, err := p.repository.GetSubscription(ctx, subscription.SubscriptionID)
+	// Only update the event cursor and suspension since those are the only pieces of data updated by the notifier
+	_, err := p.repository.UpdateSubscriptionEventCursor(ctx, &commonmodels.Subscription{
+		SubscriptionID: &subscription.SubscriptionID,
+		EventCursor:    subscription.EventCursor,
+		SuspendedUntil: subscription.SuspendedUntil,
+	})
 	if errors.Is(err, svcutils.ErrNotFound) {
 		return fmt.Errorf("subscription %s not found", subscription.SubscriptionID)
 	} else if err != nil {
-		return fmt.Errorf("failed to get subscription %s: %w", subscription.SubscriptionID, err)
+		return fmt.Errorf("failed to update subscription %s: %w", subscription.SubscriptionID, err)
 	}
 
-	// Only update the event cursor since that's the only piece of data updated by the notifier
-	record.EventCursor = subscription.EventCursor
+	return nil
+}
+
+// Matches determines if an event matches the filter defined for this subscription.  The filter is evaluated against
+// the prior and the post state of the object so that subscribers are notified of the changes bringing an object into
+// or out of the set of objects they are interested in.  The objectType attribute holds the API type name of the object
+// (e.g. ResourcePool) so that a filter can select the kinds of objects notified.
+func (p *SubscriptionStorageProvider) Matches(ctx context.Context, subscription *notifier.SubscriptionInfo, notification *notifier.Notification) bool {
+	criteria, ok := subscription.Criteria.(commonmodels.SubscriptionCriteria)
+	if !ok {
+		return true
+	}
+	if criteria.InvalidFilter {
+		return false
+	}
+	if criteria.Selector == nil {
+		return true
+	}
 
-	_, err = p.repository.UpdateSubscription(ctx, record)
+	prior, post, err := getObjectStates(notification.Payload)
 	if err != nil {
-		return fmt.Errorf("failed to update subscription %s: %w", subscription.SubscriptionID, err)
+		slog.Warn("Failed to get object states of notification", "notificationID", notification.NotificationID, "error", err)
+		return false
 	}
 
-	return nil
+	states := make([]map[string]any, 0, 2)
+	for _, state := range []map[string]any{prior, post} {
+		if state != nil {
+			states = append(states, state)
+		}
+	}
+	if len(states) == 0 {
+		states = append(states, map[string]any{})
+	}
+
+	for _, state := range states {
+		object := make(map[string]any, len(state)+1)
+		maps.Copy(object, state)
+		object[objectTypeAttribute] = notification.ObjectType
+
+		result, err := p.selectorEvaluator.Evaluate(ctx, criteria.Selector, object)
+		if err != nil {
+			slog.Warn("Failed to evaluate subscription filter", "subscriptionID", subscription.SubscriptionID,
+				"filter", criteria.Selector.String(), "error", err)
+			return false
+		}
+		if result {
+			return true
+		}
+	}
+	return false
 }
 
-// Matches determines if an event matches the filter defined for this subscription
-func (p *SubscriptionStorageProvider) Matches(subscription *notifier.SubscriptionInfo, notification *notifier.Notification) bool {
-	// TODO: implement filtering.  Currently not defined in the spec, but a reasonable approach may be to implement
-	//  the same kind of filtering as on the API query parameters although may not make complete sense given that
-	//  different object types have different fields and a filter can only contain 1 description.
-	return true
+// getObjectStates returns the prior and post object states of an inventory or cluster change notification.  The
+// payload is converted to its JSON representation so that both notification types are handled alike.
+func getObjectStates(payload any) (prior, post map[string]any, err error) {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to marshal notification payload: %w", err)
+	}
+
+	var states struct {
+		PriorObjectState map[string]any `json:"priorObjectState"`
+		PostObjectState  map[string]any `json:"postObjectState"`
+	}
+	if err := json.Unmarshal(data, &states); err != nil {
+		return nil, nil, fmt.Errorf("failed to unmarshal notification payload: %w", err)
+	}
+	return states.PriorObjectState, states.PostObjectState, nil
 }
 
 // Transform updates the notification with subscription-specific information.
@@ -0,0 +1,95 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	clusterapi "github.com/openshift-kni/oran-o2ims/internal/service/cluster/api/generated"
+	commonmodels "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	inventoryapi "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
+)
+
+var _ = Describe("SubscriptionStorageProvider", func() {
+	var (
+		provider notifier.SubscriptionProvider
+		pool     map[string]any
+	)
+
+	BeforeEach(func() {
+		var err error
+		provider, err = NewSubscriptionStorageProvider(nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+
+		pool = map[string]any{
+			"resourcePoolId": uuid.NewString(),
+			"name":           "pool-1",
+			"extensions":     map[string]any{"site": "berlin"},
+		}
+	})
+
+	subscription := func(filter *string) *notifier.SubscriptionInfo {
+		id := uuid.New()
+		return &notifier.SubscriptionInfo{
+			SubscriptionID: id,
+			Filter:         filter,
+			Criteria:       commonmodels.NewSubscriptionCriteria(&commonmodels.Subscription{SubscriptionID: &id, Filter: filter}),
+		}
+	}
+
+	inventoryChange := func(objectType string, prior, post map[string]any) *notifier.Notification {
+		payload := inventoryapi.InventoryChangeNotification{NotificationId: uuid.New()}
+		if prior != nil {
+			payload.PriorObjectState = &prior
+		}
+		if post != nil {
+			payload.PostObjectState = &post
+		}
+		return &notifier.Notification{NotificationID: payload.NotificationId, ObjectType: objectType, Payload: payload}
+	}
+
+	Describe("Matches", func() {
+		It("matches every change if the subscription has no filter", func() {
+			Expect(provider.Matches(context.Background(), subscription(nil), inventoryChange("ResourcePool", nil, pool))).To(BeTrue())
+		})
+
+		It("matches the object type", func() {
+			filter := "(eq,objectType,ResourcePool)"
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("ResourcePool", nil, pool))).To(BeTrue())
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("Resource", nil, pool))).To(BeFalse())
+		})
+
+		It("matches either the prior or the post state", func() {
+			filter := "(eq,objectType,ResourcePool);(eq,extensions/site,berlin)"
+			moved := map[string]any{"resourcePoolId": pool["resourcePoolId"], "extensions": map[string]any{"site": "paris"}}
+
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("ResourcePool", nil, pool))).To(BeTrue())
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("ResourcePool", pool, moved))).To(BeTrue())
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("ResourcePool", moved, nil))).To(BeFalse())
+		})
+
+		It("matches cluster change notifications", func() {
+			clusterID := uuid.NewString()
+			filter := "(eq,objectType,NodeCluster);(eq,nodeClusterId," + clusterID + ")"
+			state := map[string]any{"nodeClusterId": clusterID, "name": "spoke-1"}
+			payload := clusterapi.ClusterChangeNotification{NotificationId: uuid.New(), PriorObjectState: &state}
+
+			Expect(provider.Matches(context.Background(), subscription(&filter), &notifier.Notification{ObjectType: "NodeCluster", Payload: payload})).To(BeTrue())
+			Expect(provider.Matches(context.Background(), subscription(&filter), &notifier.Notification{ObjectType: "ClusterResource", Payload: payload})).To(BeFalse())
+		})
+
+		It("does not match any change if the stored filter is invalid", func() {
+			filter := "(eq,objectType"
+			Expect(provider.Matches(context.Background(), subscription(&filter), inventoryChange("ResourcePool", nil, pool))).To(BeFalse())
+		})
+	})
+})
@@ -23,6 +23,7 @@ import (
 	"github.com/stephenafamo/bob/dialect/psql/sm"
 	"github.com/stephenafamo/bob/dialect/psql/um"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -73,6 +74,15 @@ func FindAll[T db.Model](ctx context.Context, db DBQuery, fields ...string) ([]T
 // The `whereExpr` argument is a custom expression to filter the records.
 // If no records are found then an empty array is returned.
 func Search[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expression, fields ...string) ([]T, error) {
+	return SearchWithSelector[T](ctx, db, whereExpr, nil, fields...)
+}
+
+// SearchWithSelector retrieves tuples from the database table specified using a custom expression and an API selector.
+// The `selector` argument is the parsed `filter` query parameter of a list request.  Its terms are translated into SQL
+// when the model implements db.SearchableModel; terms that can't be translated are left to the ResponseFilter
+// middleware which evaluates the full selector against the API objects.
+// The remaining arguments behave as in Search.
+func SearchWithSelector[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expression, selector *search.Selector, fields ...string) ([]T, error) {
 	// Build sql query
 	var record T
 	tags := GetAllDBTagsFromStruct(record)
@@ -80,6 +90,14 @@ func Search[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expressio
 		tags = GetDBTagsFromStructFields(record, fields...)
 	}
 
+	if selectorExpr := CompileSelector[T](ctx, selector); selectorExpr != nil {
+		if whereExpr == nil {
+			whereExpr = selectorExpr
+		} else {
+			whereExpr = psql.And(whereExpr, selectorExpr)
+		}
+	}
+
 	if whereExpr == nil {
 		whereExpr = psql.RawQuery("1=1")
 	}
@@ -96,6 +114,64 @@ func Search[T db.Model](ctx context.Context, db DBQuery, whereExpr bob.Expressio
 	return ExecuteCollectRows[T](ctx, db, sql, args)
 }
 
+// CompileSelector translates the terms of an API selector into an expression on the table of the model.  Nil is
+// returned if the model doesn't implement db.SearchableModel or if none of the terms can be translated.
+func CompileSelector[T db.Model](ctx context.Context, selector *search.Selector) bob.Expression {
+	var record T
+	if _, ok := any(record).(db.SearchableModel); !ok || selector == nil || len(selector.Terms) == 0 {
+		return nil
+	}
+
+	compiler, err := newSelectorCompiler[T]()
+	if err != nil {
+		// Let the ResponseFilter middleware evaluate the whole selector
+		slog.WarnContext(ctx, "failed to build selector compiler", "table", record.TableName(), "error", err.Error())
+		return nil
+	}
+
+	expr, remaining := compiler.Compile(ctx, selector)
+	if len(remaining.Terms) > 0 {
+		slog.DebugContext(ctx, "filter partially evaluated in memory", "table", record.TableName(),
+			"remaining", remaining.String())
+	}
+	return expr
+}
+
+// CompileFullSelector translates all the terms of an API selector into an expression on the table of the model.  It
+// is used by the queries paged by the database, which can't leave terms to the ResponseFilter middleware.  An error
+// wrapping search.ErrUntranslatableTerm is returned if any of the terms can't be translated.
+func CompileFullSelector[T db.Model](ctx context.Context, selector *search.Selector) (bob.Expression, error) {
+	if selector == nil || len(selector.Terms) == 0 {
+		return nil, nil
+	}
+
+	compiler, err := newSelectorCompiler[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	// nolint: wrapcheck
+	return compiler.CompileAll(ctx, selector)
+}
+
+// newSelectorCompiler creates a selector compiler for the columns of the model.
+func newSelectorCompiler[T db.Model]() (*search.SQLCompiler, error) {
+	var record T
+	searchable, ok := any(record).(db.SearchableModel)
+	if !ok {
+		return nil, fmt.Errorf("table %s can't be searched", record.TableName())
+	}
+
+	compiler, err := search.NewSQLCompiler().
+		SetLogger(slog.Default()).
+		SetColumns(searchable.SearchColumns()).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("failed to build selector compiler: %w", err)
+	}
+	return compiler, nil
+}
+
 // Delete deletes a specific tuple from the database table specified using a custom expression.
 // The `whereExpr` argument is a custom expression to filter the records.
 // The number of rows affected is returned on success; otherwise an error is returned.
@@ -0,0 +1,112 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package utils
+
+import (
+	"context"
+	"log/slog"
+	"regexp"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/pashagolub/pgxmock/v4"
+	"github.com/stephenafamo/bob/dialect/psql"
+
+	"github.com/openshift-kni/oran-o2ims/internal/search"
+)
+
+type searchableDBModel struct {
+	RecordID   uuid.UUID         `db:"record_id"`
+	Name       string            `db:"name"`
+	Extensions map[string]string `db:"extensions"`
+}
+
+func (m searchableDBModel) TableName() string {
+	return "searchable_table"
+}
+
+func (m searchableDBModel) PrimaryKey() string {
+	return "record_id"
+}
+
+func (m searchableDBModel) OnConflict() string {
+	return ""
+}
+
+func (m searchableDBModel) SearchColumns() map[string]search.Column {
+	return map[string]search.Column{
+		"recordId":   {Name: "record_id", Type: search.ColumnTypeUUID},
+		"name":       {Name: "name", Type: search.ColumnTypeText},
+		"extensions": {Name: "extensions", Type: search.ColumnTypeJSON},
+	}
+}
+
+var _ = Describe("Repository", func() {
+	var ctx context.Context
+
+	parse := func(text string) *search.Selector {
+		parser, err := search.NewSelectorParser().SetLogger(slog.Default()).Build()
+		Expect(err).NotTo(HaveOccurred())
+		selector, err := parser.Parse(text)
+		Expect(err).NotTo(HaveOccurred())
+		return selector
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+	})
+
+	Describe("CompileSelector", func() {
+		It("returns nil for models that can't be searched", func() {
+			Expect(CompileSelector[searchableDBModel](ctx, nil)).To(BeNil())
+			Expect(CompileSelector[*mockDBModel](ctx, parse("(eq,name,a)"))).To(BeNil())
+		})
+	})
+
+	Describe("CompileFullSelector", func() {
+		It("translates all the terms of the selector", func() {
+			expr, err := CompileFullSelector[searchableDBModel](ctx, parse("(eq,name,a);(eq,extensions/site,b)"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(expr).NotTo(BeNil())
+		})
+
+		It("fails if a term can't be translated", func() {
+			expr, err := CompileFullSelector[searchableDBModel](ctx, parse("(eq,name,a);(cont,recordId,c)"))
+			Expect(err).To(MatchError(search.ErrUntranslatableTerm))
+			Expect(expr).To(BeNil())
+		})
+	})
+
+	Describe("SearchWithSelector", func() {
+		var mock pgxmock.PgxPoolIface
+
+		BeforeEach(func() {
+			var err error
+			mock, err = pgxmock.NewPool()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			mock.Close()
+		})
+
+		It("combines the expression with the translatable terms of the selector", func() {
+			mock.ExpectQuery("SELECT (.+) FROM searchable_table WHERE "+regexp.QuoteMeta(
+				`(("record_id" <> $1) AND (("name" = $2) AND ("extensions"->>$3 = $4)))`)).
+				WithArgs(uuid.Nil, "a", "site", "b").
+				WillReturnRows(pgxmock.NewRows([]string{"record_id", "name"}).AddRow(uuid.New(), "a"))
+
+			records, err := SearchWithSelector[searchableDBModel](ctx, mock,
+				psql.Quote("record_id").NE(psql.Arg(uuid.Nil)),
+				parse("(eq,name,a);(eq,extensions/site,b);(cont,recordId,c)"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(records).To(HaveLen(1))
+			Expect(mock.ExpectationsWereMet()).To(Succeed())
+		})
+	})
+})
@@ -29,6 +29,39 @@ const (
 	Oauth2Scopes = "oauth2.Scopes"
 )
 
+// Defines values for DryRunResourceAction.
+const (
+	Create    DryRunResourceAction = "create"
+	Unchanged DryRunResourceAction = "unchanged"
+	Update    DryRunResourceAction = "update"
+)
+
+// Defines values for ProvisioningPhaseTimelinePhase.
+const (
+	PhaseClusterInstallation   ProvisioningPhaseTimelinePhase = "ClusterInstallation"
+	PhaseHardwareConfiguration ProvisioningPhaseTimelinePhase = "HardwareConfiguration"
+	PhaseHardwareProvisioning  ProvisioningPhaseTimelinePhase = "HardwareProvisioning"
+	PhasePolicyConfiguration   ProvisioningPhaseTimelinePhase = "PolicyConfiguration"
+	PhaseResourceCreation      ProvisioningPhaseTimelinePhase = "ResourceCreation"
+	PhaseUpgrade               ProvisioningPhaseTimelinePhase = "Upgrade"
+	PhaseValidation            ProvisioningPhaseTimelinePhase = "Validation"
+)
+
+// Defines values for ProvisioningPhaseTimelineResult.
+const (
+	PhaseFailed     ProvisioningPhaseTimelineResult = "Failed"
+	PhaseInProgress ProvisioningPhaseTimelineResult = "InProgress"
+	PhaseSucceeded  ProvisioningPhaseTimelineResult = "Succeeded"
+	PhaseTimedOut   ProvisioningPhaseTimelineResult = "TimedOut"
+)
+
+// Defines values for ProvisioningRequestChangeNotificationNotificationEventType.
+const (
+	N0 ProvisioningRequestChangeNotificationNotificationEventType = 0
+	N1 ProvisioningRequestChangeNotificationNotificationEventType = 1
+	N2 ProvisioningRequestChangeNotificationNotificationEventType = 2
+)
+
 // Defines values for ProvisioningStatusProvisioningPhase.
 const (
 	Deleting    ProvisioningStatusProvisioningPhase = "deleting"
@@ -38,12 +71,106 @@ const (
 	Progressing ProvisioningStatusProvisioningPhase = "progressing"
 )
 
+// DryRunResource A resource rendered by a dry-run, and how it differs from the applied one.
+type DryRunResource struct {
+	// Action The change that would be made to the resource.
+	Action DryRunResourceAction `json:"action"`
+
+	// Diff A unified diff between the applied and the rendered resource, set when the resource would be updated.
+	Diff *string `json:"diff,omitempty"`
+
+	// Kind Kind of the resource.
+	Kind string `json:"kind"`
+
+	// Name Name of the resource. It is empty for a NodeAllocationRequest that would be created, as the hardware
+	// plugin assigns its identifier.
+	Name *string `json:"name,omitempty"`
+
+	// Namespace Namespace of the resource.
+	Namespace *string `json:"namespace,omitempty"`
+}
+
+// DryRunResourceAction The change that would be made to the resource.
+type DryRunResourceAction string
+
 // ProvisionedResourceSets The resources that have been successfully provisioned as part of the provisioning process.
 type ProvisionedResourceSets struct {
 	// NodeClusterId Identifier of the NodeCluster that has been provisioned.
 	NodeClusterId *openapi_types.UUID `json:"nodeClusterId,omitempty"`
 }
 
+// ProvisioningCondition A condition reported in the status of a provisioning request.
+type ProvisioningCondition struct {
+	// LastTransitionTime Timestamp of the last transition of the condition.
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+
+	// Message Message describing the last transition of the condition.
+	Message *string `json:"message,omitempty"`
+
+	// Reason Reason for the last transition of the condition.
+	Reason *string `json:"reason,omitempty"`
+
+	// Status Status of the condition, one of True, False or Unknown.
+	Status string `json:"status"`
+
+	// Type Type of the condition.
+	Type string `json:"type"`
+}
+
+// ProvisioningPhaseTimeline The last attempt of a provisioning phase.
+type ProvisioningPhaseTimeline struct {
+	// Attempts The number of times the phase was started, including the retries after a failure and the reruns for a
+	// day-2 change.
+	Attempts int `json:"attempts"`
+
+	// EndTime Timestamp indicating when the last attempt of the phase finished. It is absent while the phase is in progress.
+	EndTime *time.Time `json:"endTime,omitempty"`
+
+	// Phase The provisioning phase.
+	Phase ProvisioningPhaseTimelinePhase `json:"phase"`
+
+	// Result The result of the last attempt of the phase.
+	Result ProvisioningPhaseTimelineResult `json:"result"`
+
+	// StartTime Timestamp indicating when the last attempt of the phase started.
+	StartTime time.Time `json:"startTime"`
+}
+
+// ProvisioningPhaseTimelinePhase The provisioning phase.
+type ProvisioningPhaseTimelinePhase string
+
+// ProvisioningPhaseTimelineResult The result of the last attempt of the phase.
+type ProvisioningPhaseTimelineResult string
+
+// ProvisioningRequestChangeNotification Information about a provisioning request change notification
+type ProvisioningRequestChangeNotification struct {
+	// ChangedConditions The types of the conditions that were added, removed, or whose status, reason or message changed.
+	ChangedConditions *[]string `json:"changedConditions,omitempty"`
+
+	// ConsumerSubscriptionId The value provided by the consumer in the subscription
+	ConsumerSubscriptionId *openapi_types.UUID `json:"consumerSubscriptionId,omitempty"`
+
+	// NotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+	NotificationEventType ProvisioningRequestChangeNotificationNotificationEventType `json:"notificationEventType"`
+
+	// NotificationId A unique identifier to represent this notification event
+	NotificationId openapi_types.UUID `json:"notificationId"`
+
+	// ObjectRef The URL to the provisioning request. This is not required if the notificationEventType is 2 (DELETE).
+	ObjectRef *string `json:"objectRef,omitempty"`
+
+	// PostObjectState The ProvisioningRequestState after the change. This is required if the notificationEventType is
+	// 0 (CREATE) or 1 (MODIFY).
+	PostObjectState *map[string]interface{} `json:"postObjectState,omitempty"`
+
+	// PriorObjectState The ProvisioningRequestState before the change. This is required if the notificationEventType is
+	// 1 (MODIFY) or 2 (DELETE).
+	PriorObjectState *map[string]interface{} `json:"priorObjectState,omitempty"`
+}
+
+// ProvisioningRequestChangeNotificationNotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+type ProvisioningRequestChangeNotificationNotificationEventType int
+
 // ProvisioningRequestData Input parameters for a provisioning request.
 type ProvisioningRequestData struct {
 	// Description Human readable description of the provisioning request.
@@ -65,6 +192,21 @@ type ProvisioningRequestData struct {
 	TemplateVersion string `json:"templateVersion"`
 }
 
+// ProvisioningRequestDryRunResult The resources rendered from a provisioning request by a dry-run, and how they differ from the applied ones.
+type ProvisioningRequestDryRunResult struct {
+	// ClusterInstance The rendered ClusterInstance.
+	ClusterInstance map[string]interface{} `json:"clusterInstance"`
+
+	// NodeAllocationRequest The rendered NodeAllocationRequest. It is absent when the template skips hardware provisioning.
+	NodeAllocationRequest *map[string]interface{} `json:"nodeAllocationRequest,omitempty"`
+
+	// PolicyConfigMaps The rendered ConfigMaps holding the policy template parameters.
+	PolicyConfigMaps []map[string]interface{} `json:"policyConfigMaps"`
+
+	// Resources The changes that provisioning would make to the rendered resources.
+	Resources []DryRunResource `json:"resources"`
+}
+
 // ProvisioningRequestInfo Information about a provisioning request.
 type ProvisioningRequestInfo struct {
 	// ProvisionedResourceSets The resources that have been successfully provisioned as part of the provisioning process.
@@ -77,6 +219,30 @@ type ProvisioningRequestInfo struct {
 	Status ProvisioningStatus `json:"status"`
 }
 
+// ProvisioningRequestState The state of a provisioning request reported in a ProvisioningRequestChangeNotification.
+type ProvisioningRequestState struct {
+	// Conditions The conditions reported in the status of the provisioning request.
+	Conditions []ProvisioningCondition `json:"conditions"`
+
+	// Name Human readable name of the provisioning request.
+	Name string `json:"name"`
+
+	// ProvisionedResourceSets The resources that have been successfully provisioned as part of the provisioning process.
+	ProvisionedResourceSets ProvisionedResourceSets `json:"provisionedResourceSets"`
+
+	// ProvisioningRequestId Identifier for the provisioning request.
+	ProvisioningRequestId openapi_types.UUID `json:"provisioningRequestId"`
+
+	// Status Details about the status of the provisioning request.
+	Status ProvisioningStatus `json:"status"`
+
+	// TemplateName Name of the template used for the provisioning request.
+	TemplateName string `json:"templateName"`
+
+	// TemplateVersion Version of the template used for the provisioning request.
+	TemplateVersion string `json:"templateVersion"`
+}
+
 // ProvisioningStatus Details about the status of the provisioning request.
 type ProvisioningStatus struct {
 	// Message Message describing the status of the provisioning request.
@@ -85,6 +251,9 @@ type ProvisioningStatus struct {
 	// ProvisioningPhase Current state of the provisioning request.
 	ProvisioningPhase *ProvisioningStatusProvisioningPhase `json:"provisioningPhase,omitempty"`
 
+	// Timeline The start, end and result of each provisioning phase that has started, in the order the phases are run.
+	Timeline *[]ProvisioningPhaseTimeline `json:"timeline,omitempty"`
+
 	// UpdateTime Timestamp indicating the last time the status of the provisioning request was updated.
 	UpdateTime *time.Time `json:"updateTime,omitempty"`
 }
@@ -92,9 +261,44 @@ type ProvisioningStatus struct {
 // ProvisioningStatusProvisioningPhase Current state of the provisioning request.
 type ProvisioningStatusProvisioningPhase string
 
+// Subscription Information about a provisioning request subscription.
+type Subscription struct {
+	// Callback The fully qualified URI to a consumer procedure which can process a Post of the
+	// ProvisioningRequestChangeNotification.
+	Callback string `json:"callback"`
+
+	// ConsumerSubscriptionId Identifier for the consumer of events sent due to the Subscription.
+	ConsumerSubscriptionId *openapi_types.UUID `json:"consumerSubscriptionId,omitempty"`
+
+	// Filter Criteria for events which do not need to be reported or will be filtered by the subscription
+	// notification service. Therefore, if a filter is not provided then all events are reported.
+	// The filter uses the same syntax as the filter query parameter and is evaluated against the
+	// ProvisioningRequestState of the provisioning request. A change is reported if the filter matches either
+	// the prior or the post state of the provisioning request.
+	Filter *string `json:"filter,omitempty"`
+
+	// SigningSecret Optional shared secret used to sign the notifications sent to the subscriber. When set, each notification
+	// carries an HMAC-SHA256 signature in the X-O2ims-Signature header. The secret is never returned.
+	SigningSecret *string `json:"signingSecret,omitempty"`
+
+	// SubscriptionId Identifier for the Subscription. This identifier is allocated by the O-Cloud.
+	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+
+	// VerifyCallback Requests a verification handshake with the callback before the subscription is created. A
+	// CallbackVerification challenge is POSTed to the callback, and the subscription is rejected unless the
+	// challenge is echoed back.
+	VerifyCallback *bool `json:"verifyCallback,omitempty"`
+}
+
+// DryRun defines model for dryRun.
+type DryRun = bool
+
 // ProvisioningRequestId defines model for provisioningRequestId.
 type ProvisioningRequestId = openapi_types.UUID
 
+// SubscriptionId defines model for subscriptionId.
+type SubscriptionId = openapi_types.UUID
+
 // GetProvisioningRequestsParams defines parameters for GetProvisioningRequests.
 type GetProvisioningRequestsParams struct {
 	// AllFields This URI query parameter requests that all complex attributes are included in the response.
@@ -187,12 +391,116 @@ type GetProvisioningRequestsParams struct {
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// CreateProvisioningRequestParams defines parameters for CreateProvisioningRequest.
+type CreateProvisioningRequestParams struct {
+	// DryRun Validate and render the provisioning request without creating or updating anything.
+	DryRun *DryRun `form:"dryRun,omitempty" json:"dryRun,omitempty"`
+}
+
+// GetSubscriptionsParams defines parameters for GetSubscriptions.
+type GetSubscriptionsParams struct {
+	// AllFields This URI query parameter requests that all complex attributes are included in the response.
+	//
+	// ```
+	// all_fields
+	// ```
+	AllFields *externalRef0.AllFields `form:"all_fields,omitempty" json:"all_fields,omitempty"`
+
+	// ExcludeFields Comma separated list of field references to exclude from the result.
+	//
+	// Each field reference is a field name, or a sequence of field names separated by slashes. For
+	// example, to exclude the `country` subfield of the `extensions` field:
+	//
+	// ```
+	// exclude_fields=extensions/country
+	// ```
+	//
+	// When this parameter isn't used no field will be excluded.
+	//
+	// Fields in this list will be excluded even if they are explicitly included using the
+	// `fields` parameter.
+	ExcludeFields *externalRef0.ExcludeFields `form:"exclude_fields,omitempty" json:"exclude_fields,omitempty"`
+
+	// Fields Comma separated list of field references to include in the result.
+	//
+	// Each field reference is a field name, or a sequence of field names separated by slashes. For
+	// example, to get the `name` field and the `country` subfield of the `extensions` field:
+	//
+	// ```
+	// fields=name,extensions/country
+	// ```
+	//
+	// When this parameter isn't used all the fields will be returned.
+	Fields *externalRef0.Fields `form:"fields,omitempty" json:"fields,omitempty"`
+
+	// Filter Search criteria.
+	//
+	// Contains one or more search criteria, separated by semicolons. Each search criteria is a
+	// tuple containing an operator, a field reference and one or more values. The operator can
+	// be any of the following strings:
+	//
+	// | Operator | Meaning                                                     |
+	// |----------|-------------------------------------------------------------|
+	// | `cont`   | Matches if the field contains the value                     |
+	// | `eq`     | Matches if the field is equal to the value                  |
+	// | `gt`     | Matches if the field is greater than the value              |
+	// | `gte`    | Matches if the field is greater than or equal to the value  |
+	// | `in`     | Matches if the field is one of the values                   |
+	// | `lt`     | Matches if the field is less than the value                 |
+	// | `lte`    | Matches if the field is less than or equal to the the value |
+	// | `ncont`  | Matches if the field does not contain the value             |
+	// | `neq`    | Matches if the field is not equal to the value              |
+	// | `nin`    | Matches if the field is not one of the values               |
+	//
+	// The field reference is the name of one of the fields of the object, or a sequence of
+	// name of fields separated by slashes. For example, to use the `country` sub-field inside
+	// the `extensions` field:
+	//
+	// ```
+	// filter=(eq,extensions/country,EQ)
+	// ```
+	//
+	// The values are the arguments of the operator. For example, the `eq` operator compares
+	// checks if the value of the field is equal to the value.
+	//
+	// The `in` and `nin` operators support multiple values. For example, to check if the `country`
+	// sub-field inside the `extensions` field is either `ES` or `US:
+	//
+	// ```
+	// filter=(in,extensions/country,ES,US)
+	// ```
+	//
+	// When values contain commas, slashes or spaces they need to be surrounded by single quotes.
+	// For example, to check if the `name` field is the string `my cluster`:
+	//
+	// ```
+	// filter=(eq,name,'my cluster')
+	// ```
+	//
+	// When multiple criteria separated by semicolons are used, all of them must match for the
+	// complete condition to match. For example, the following will check if the `name` is
+	// `my cluster` *and* the `country` extension is `ES`:
+	//
+	// ```
+	// filter=(eq,name,'my cluster');(eq,extensions/country,ES)
+	// ```
+	//
+	// When this parameter isn't used all the results will be returned.
+	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
+}
+
 // CreateProvisioningRequestJSONRequestBody defines body for CreateProvisioningRequest for application/json ContentType.
 type CreateProvisioningRequestJSONRequestBody = ProvisioningRequestData
 
 // UpdateProvisioningRequestJSONRequestBody defines body for UpdateProvisioningRequest for application/json ContentType.
 type UpdateProvisioningRequestJSONRequestBody = ProvisioningRequestData
 
+// CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
+type CreateSubscriptionJSONRequestBody = Subscription
+
+// RotateSubscriptionSigningSecretJSONRequestBody defines body for RotateSubscriptionSigningSecret for application/json ContentType.
+type RotateSubscriptionSigningSecretJSONRequestBody = externalRef0.SigningSecretRotation
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 	// Get API versions
@@ -206,7 +514,7 @@ type ServerInterface interface {
 	GetProvisioningRequests(w http.ResponseWriter, r *http.Request, params GetProvisioningRequestsParams)
 	// Create a provisioning request
 	// (POST /o2ims-infrastructureProvisioning/v1/provisioningRequests)
-	CreateProvisioningRequest(w http.ResponseWriter, r *http.Request)
+	CreateProvisioningRequest(w http.ResponseWriter, r *http.Request, params CreateProvisioningRequestParams)
 	// Delete a provisioning request
 	// (DELETE /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId})
 	DeleteProvisioningRequest(w http.ResponseWriter, r *http.Request, provisioningRequestId ProvisioningRequestId)
@@ -216,6 +524,27 @@ type ServerInterface interface {
 	// Update a provisioning request
 	// (PUT /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId})
 	UpdateProvisioningRequest(w http.ResponseWriter, r *http.Request, provisioningRequestId ProvisioningRequestId)
+	// Get subscriptions
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions)
+	GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams)
+	// Create subscriptions
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions)
+	CreateSubscription(w http.ResponseWriter, r *http.Request)
+	// Delete subscription
+	// (DELETE /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId})
+	DeleteSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Get subscription
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId})
+	GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -327,14 +656,27 @@ func (siw *ServerInterfaceWrapper) GetProvisioningRequests(w http.ResponseWriter
 // CreateProvisioningRequest operation middleware
 func (siw *ServerInterfaceWrapper) CreateProvisioningRequest(w http.ResponseWriter, r *http.Request) {
 
+	var err error
+
 	ctx := r.Context()
 
 	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-provisioner"})
 
 	r = r.WithContext(ctx)
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateProvisioningRequestParams
+
+	// ------------- Optional query parameter "dryRun" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "dryRun", r.URL.Query(), &params.DryRun)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "dryRun", Err: err})
+		return
+	}
+
 	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateProvisioningRequest(w, r)
+		siw.Handler.CreateProvisioningRequest(w, r, params)
 	}))
 
 	for _, middleware := range siw.HandlerMiddlewares {
@@ -437,68 +779,300 @@ func (siw *ServerInterfaceWrapper) UpdateProvisioningRequest(w http.ResponseWrit
 	handler.ServeHTTP(w, r)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
-}
+// GetSubscriptions operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptions(w http.ResponseWriter, r *http.Request) {
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
-}
+	var err error
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+	ctx := r.Context()
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader", "role:o2ims-subscriber"})
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	r = r.WithContext(ctx)
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSubscriptionsParams
 
-type RequiredParamError struct {
-	ParamName string
-}
+	// ------------- Optional query parameter "all_fields" -------------
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "all_fields", r.URL.Query(), &params.AllFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "all_fields", Err: err})
+		return
+	}
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	// ------------- Optional query parameter "exclude_fields" -------------
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	err = runtime.BindQueryParameter("form", true, false, "exclude_fields", r.URL.Query(), &params.ExcludeFields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "exclude_fields", Err: err})
+		return
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	// ------------- Optional query parameter "fields" -------------
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
-}
+	err = runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "fields", Err: err})
+		return
+	}
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
-}
+	// ------------- Optional query parameter "filter" -------------
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
-}
+	err = runtime.BindQueryParameter("form", true, false, "filter", r.URL.Query(), &params.Filter)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filter", Err: err})
+		return
+	}
 
-type TooManyValuesForParamError struct {
-	ParamName string
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSubscription operation middleware
+func (siw *ServerInterfaceWrapper) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-subscriber"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSubscription(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSubscription operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-subscriber"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscription operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader", "role:o2ims-subscriber"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscription(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaySubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSubscriptionSigningSecret(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
 	Count     int
 }
 
@@ -564,6 +1138,13 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.DeleteProvisioningRequest)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.GetProvisioningRequest)
 	m.HandleFunc("PUT "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId}", wrapper.UpdateProvisioningRequest)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions", wrapper.GetSubscriptions)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions", wrapper.CreateSubscription)
+	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}", wrapper.DeleteSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}", wrapper.GetSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters", wrapper.GetSubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters/replay", wrapper.ReplaySubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/signingSecret/rotate", wrapper.RotateSubscriptionSigningSecret)
 
 	return m
 }
@@ -689,293 +1270,709 @@ func (response GetProvisioningRequests200JSONResponse) VisitGetProvisioningReque
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequests400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetProvisioningRequests400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequests400ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequests401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequests401ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequests403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequests403ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequests500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequests500ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequestRequestObject struct {
+	Params CreateProvisioningRequestParams
+	Body   *CreateProvisioningRequestJSONRequestBody
+}
+
+type CreateProvisioningRequestResponseObject interface {
+	VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error
+}
+
+type CreateProvisioningRequest200JSONResponse ProvisioningRequestDryRunResult
+
+func (response CreateProvisioningRequest200JSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest201JSONResponse ProvisioningRequestInfo
+
+func (response CreateProvisioningRequest201JSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest409ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateProvisioningRequest409ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProvisioningRequestRequestObject struct {
+	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+}
+
+type DeleteProvisioningRequestResponseObject interface {
+	VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error
+}
+
+type DeleteProvisioningRequest200Response struct {
+}
+
+func (response DeleteProvisioningRequest200Response) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response DeleteProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequestRequestObject struct {
+	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+}
+
+type GetProvisioningRequestResponseObject interface {
+	VisitGetProvisioningRequestResponse(w http.ResponseWriter) error
+}
+
+type GetProvisioningRequest200JSONResponse ProvisioningRequestInfo
+
+func (response GetProvisioningRequest200JSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequestRequestObject struct {
+	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+	Body                  *UpdateProvisioningRequestJSONRequestBody
+}
+
+type UpdateProvisioningRequestResponseObject interface {
+	VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error
+}
+
+type UpdateProvisioningRequest200JSONResponse ProvisioningRequestInfo
+
+func (response UpdateProvisioningRequest200JSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest412ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest412ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest422ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest422ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response UpdateProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionsRequestObject struct {
+	Params GetSubscriptionsParams
+}
+
+type GetSubscriptionsResponseObject interface {
+	VisitGetSubscriptionsResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptions200JSONResponse []Subscription
+
+func (response GetSubscriptions200JSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptions400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptions401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptions403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptions500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptions500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscriptionRequestObject struct {
+	Body *CreateSubscriptionJSONRequestBody
+}
+
+type CreateSubscriptionResponseObject interface {
+	VisitCreateSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type CreateSubscription201JSONResponse Subscription
+
+func (response CreateSubscription201JSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(201)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateSubscription400ApplicationProblemPlusJSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateSubscription401ApplicationProblemPlusJSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateSubscription403ApplicationProblemPlusJSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSubscription500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response CreateSubscription500ApplicationProblemPlusJSONResponse) VisitCreateSubscriptionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSubscriptionRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+type DeleteSubscriptionResponseObject interface {
+	VisitDeleteSubscriptionResponse(w http.ResponseWriter) error
+}
+
+type DeleteSubscription200Response struct {
+}
+
+func (response DeleteSubscription200Response) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type DeleteSubscription401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequests400ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription401ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(400)
+	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequests401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type DeleteSubscription403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequests401ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription403ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(401)
+	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequests403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type DeleteSubscription404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequests403ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription404ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(403)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequests500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type DeleteSubscription500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequests500ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestsResponse(w http.ResponseWriter) error {
+func (response DeleteSubscription500ApplicationProblemPlusJSONResponse) VisitDeleteSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequestRequestObject struct {
-	Body *CreateProvisioningRequestJSONRequestBody
+type GetSubscriptionRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
 }
 
-type CreateProvisioningRequestResponseObject interface {
-	VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error
+type GetSubscriptionResponseObject interface {
+	VisitGetSubscriptionResponse(w http.ResponseWriter) error
 }
 
-type CreateProvisioningRequest201JSONResponse ProvisioningRequestInfo
+type GetSubscription200JSONResponse Subscription
 
-func (response CreateProvisioningRequest201JSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription200JSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(201)
+	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscription400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response CreateProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscription401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response CreateProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscription403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response CreateProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequest409ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscription404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response CreateProvisioningRequest409ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(409)
+	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type CreateProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscription500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response CreateProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitCreateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscription500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProvisioningRequestRequestObject struct {
-	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+type GetSubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
 }
 
-type DeleteProvisioningRequestResponseObject interface {
-	VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error
+type GetSubscriptionDeadLettersResponseObject interface {
+	VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type DeleteProvisioningRequest200Response struct {
-}
+type GetSubscriptionDeadLetters200JSONResponse externalRef0.DeadLetterQueue
 
-func (response DeleteProvisioningRequest200Response) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters200JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
-	return nil
+
+	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response DeleteProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response DeleteProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response DeleteProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type DeleteProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response DeleteProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitDeleteProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequestRequestObject struct {
-	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
+type ReplaySubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
 }
 
-type GetProvisioningRequestResponseObject interface {
-	VisitGetProvisioningRequestResponse(w http.ResponseWriter) error
+type ReplaySubscriptionDeadLettersResponseObject interface {
+	VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error
 }
 
-type GetProvisioningRequest200JSONResponse ProvisioningRequestInfo
-
-func (response GetProvisioningRequest200JSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+type ReplaySubscriptionDeadLetters202Response struct {
+}
 
-	return json.NewEncoder(w).Encode(response)
+func (response ReplaySubscriptionDeadLetters202Response) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
 }
 
-type GetProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type GetProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response GetProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitGetProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequestRequestObject struct {
-	ProvisioningRequestId ProvisioningRequestId `json:"provisioningRequestId"`
-	Body                  *UpdateProvisioningRequestJSONRequestBody
+type RotateSubscriptionSigningSecretRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Body           *RotateSubscriptionSigningSecretJSONRequestBody
 }
 
-type UpdateProvisioningRequestResponseObject interface {
-	VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error
+type RotateSubscriptionSigningSecretResponseObject interface {
+	VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error
 }
 
-type UpdateProvisioningRequest200JSONResponse ProvisioningRequestInfo
+type RotateSubscriptionSigningSecret200JSONResponse externalRef0.SigningSecretStatus
 
-func (response UpdateProvisioningRequest200JSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret200JSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/json")
 	w.WriteHeader(200)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequest400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response UpdateProvisioningRequest400ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(400)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequest401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response UpdateProvisioningRequest401ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(401)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequest403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response UpdateProvisioningRequest403ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(403)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequest404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response UpdateProvisioningRequest404ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(404)
 
 	return json.NewEncoder(w).Encode(response)
 }
 
-type UpdateProvisioningRequest412ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
-
-func (response UpdateProvisioningRequest412ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(412)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateProvisioningRequest422ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
-
-func (response UpdateProvisioningRequest422ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/problem+json")
-	w.WriteHeader(422)
-
-	return json.NewEncoder(w).Encode(response)
-}
-
-type UpdateProvisioningRequest500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+type RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
 
-func (response UpdateProvisioningRequest500ApplicationProblemPlusJSONResponse) VisitUpdateProvisioningRequestResponse(w http.ResponseWriter) error {
+func (response RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
 	w.Header().Set("Content-Type", "application/problem+json")
 	w.WriteHeader(500)
 
@@ -1005,6 +2002,27 @@ type StrictServerInterface interface {
 	// Update a provisioning request
 	// (PUT /o2ims-infrastructureProvisioning/v1/provisioningRequests/{provisioningRequestId})
 	UpdateProvisioningRequest(ctx context.Context, request UpdateProvisioningRequestRequestObject) (UpdateProvisioningRequestResponseObject, error)
+	// Get subscriptions
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions)
+	GetSubscriptions(ctx context.Context, request GetSubscriptionsRequestObject) (GetSubscriptionsResponseObject, error)
+	// Create subscriptions
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions)
+	CreateSubscription(ctx context.Context, request CreateSubscriptionRequestObject) (CreateSubscriptionResponseObject, error)
+	// Delete subscription
+	// (DELETE /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId})
+	DeleteSubscription(ctx context.Context, request DeleteSubscriptionRequestObject) (DeleteSubscriptionResponseObject, error)
+	// Get subscription
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId})
+	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(ctx context.Context, request GetSubscriptionDeadLettersRequestObject) (GetSubscriptionDeadLettersResponseObject, error)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(ctx context.Context, request ReplaySubscriptionDeadLettersRequestObject) (ReplaySubscriptionDeadLettersResponseObject, error)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureProvisioning/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(ctx context.Context, request RotateSubscriptionSigningSecretRequestObject) (RotateSubscriptionSigningSecretResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -1111,9 +2129,11 @@ func (sh *strictHandler) GetProvisioningRequests(w http.ResponseWriter, r *http.
 }
 
 // CreateProvisioningRequest operation middleware
-func (sh *strictHandler) CreateProvisioningRequest(w http.ResponseWriter, r *http.Request) {
+func (sh *strictHandler) CreateProvisioningRequest(w http.ResponseWriter, r *http.Request, params CreateProvisioningRequestParams) {
 	var request CreateProvisioningRequestRequestObject
 
+	request.Params = params
+
 	var body CreateProvisioningRequestJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
@@ -1226,67 +2246,316 @@ func (sh *strictHandler) UpdateProvisioningRequest(w http.ResponseWriter, r *htt
 	}
 }
 
+// GetSubscriptions operation middleware
+func (sh *strictHandler) GetSubscriptions(w http.ResponseWriter, r *http.Request, params GetSubscriptionsParams) {
+	var request GetSubscriptionsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptions(ctx, request.(GetSubscriptionsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptions")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionsResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateSubscription operation middleware
+func (sh *strictHandler) CreateSubscription(w http.ResponseWriter, r *http.Request) {
+	var request CreateSubscriptionRequestObject
+
+	var body CreateSubscriptionJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateSubscription(ctx, request.(CreateSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateSubscriptionResponseObject); ok {
+		if err := validResponse.VisitCreateSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteSubscription operation middleware
+func (sh *strictHandler) DeleteSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request DeleteSubscriptionRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteSubscription(ctx, request.(DeleteSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteSubscriptionResponseObject); ok {
+		if err := validResponse.VisitDeleteSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSubscription operation middleware
+func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request GetSubscriptionRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscription(ctx, request.(GetSubscriptionRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscription")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request GetSubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionDeadLetters(ctx, request.(GetSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (sh *strictHandler) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request ReplaySubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaySubscriptionDeadLetters(ctx, request.(ReplaySubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaySubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaySubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitReplaySubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (sh *strictHandler) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request RotateSubscriptionSigningSecretRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	var body RotateSubscriptionSigningSecretJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateSubscriptionSigningSecret(ctx, request.(RotateSubscriptionSigningSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateSubscriptionSigningSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateSubscriptionSigningSecretResponseObject); ok {
+		if err := validResponse.VisitRotateSubscriptionSigningSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xbe3PbNrb/KhjeO9Oml3racRLd6R+uk2w00yReP7ozG3kqiDwU0YAADYC21cTffQcP",
-	"viRSlO1267bOP7FE4LzP7xwcQl+8gCcpZ8CU9CZfvBQLnIACYT4FPEk4+xmn5GeeAtP/Y0rfEqCheR6C",
-	"DARJFeHMm3hnMZHo/GSKLjMQK1SQQgIuM5BKIhVjhTClSDOlcIOwUoIsMgUSYQGIsIBmIYSIMKRiQAJk",
-	"ypmE/ozN2Hw+nzFM6c+R4e++8HyPaOaGp+d7DCfgTbxyned7MoghwVbgCGdUeRMvwlSCXp9RihcUvIkS",
-	"GfieWqV6v1SCsKV3e+s3GQFujJxthjjiSYKRBG0BBSGiRCrEI2QEQgIiEMACkEhx5EihSPAk1zmjymj8",
-	"Bgfx+iZEJMLuS62rj7hAmtllZh4XbPRDWRFisUKSYhmD7KO3XMwY3GDtBL8qhRZgHvCMKbGaI5ktLC0e",
-	"2Sdwo4BJwpmcWy6TwjGOgjP69+XKgSPn1s3Yv2LQ3iWyEiFEsm8UyiSEiHGnwDWhFC0gly00JrEmt/FB",
-	"pLXs+kIEV8AQMTKvTFzBTUpJQBRdlSGWScKWesmMza3Q81KgvgksZyFvYqLK39SpJfjqtqgF4E7hFf0G",
-	"ceX0rGTSfz+qlqBs3OhdLmIQZuEDwsyFV4s/do0xDUGak6VWBJAAlQlmIu0B3r+/16kCsen1U8AiiFEg",
-	"iAJBsPHhEWcKEyYRZ6BdlXABSNYX+mtugoQEnHIm+8iEwNpyEwIzprKUAgosfZ0hmCGegsCKC7+IkTJw",
-	"tDurQlxhmulgOIuh2IcCzGZsoRevcidHnFJ+rRlYq0jj46/oY77nK3oP2Ehwn39fZ+xrr/hX+fMe/zQt",
-	"Ha5MzTVl9B6rIAbpEMZZJMg9or8yRmiVC83hcm4/NdMiEsFlhqnOoS3kLK2l6qK1FIB1AqgYszZ6OS2Y",
-	"34EWF41yWlqEdcllwiYqd8pWe9FOHSlIuVXBCq0uHUta6wqWtC0t5oKihVbIQSLGVR4cLbI5Wi4o2uXS",
-	"lLriwtFyxt9Oq8v+X3VGnhW7asVCb9J4pwlU6DhAdZ/44hcI1GYtmbF8q1vfWk9QtZxksqFB6TmVmCQh",
-	"zFh3/dAg+/23cNkA6P6bfz4rSshZaRbdQmjCWCyzRHfKhYIOrNZlNUJczisAyJMUC5AzFsQQfC78YT3I",
-	"O5O/n0tk0kpjrvVxzkAimaUpFwolGVVEQ3gOxOtWNALk/AtTzti6LVtKsZGPqBgEmr85nWvfzs9PNw1M",
-	"WKOBT/3z02f1Mu2MnOeIroxY+nkYaAYyxaar0e0cAwi1GgtAMhOCZyx0YUPYkgK6zLgC2Z+x7XpXOxIX",
-	"zrYOoXmyQgHNpAIxb4wb0w18U676Zk2fwgNFZW2pwyaudD/im4bERkGCkkwqlOi8RREXtkO15yVlCnNI",
-	"dGOgVTKLGmKvrK2ms2nSnOjzU0VT9B1m4Xdr6VU4UJtIe3tHe/x/W3qtu767Q7N9a3eLVghSyvGstT8z",
-	"fdb2/iwV/Ipo6Qlbntjj6zTc7M3OGbnMAJEQmCIRAaG9iFF1d376XZd4NN6D/ecHL3rw8tWiNxqHez28",
-	"//ygtz8+OBjtj17sD4fDXIMUq7hUoFk239OMiIAwP8qW+kVcJFiferOM6JXr+t7mi82h4zinD+EJSJ6J",
-	"AE5BNR74jYPMCne4j/EVoAUAQzILApAyyihdlQbRrjUuVznq1WyVCq43WVulQsObImBYMx7CkfVskyem",
-	"NRdowh/KDbls0opWkaZf8wke7b94GS5e9Q6ej6Lefrg37L06CA96L3E02tt/OQpfvHjl+Z3mLL6xBdC7",
-	"9Uublj57jZVxDg5tRmN6XFHYzCf8dSVZmqkyYaQBiG3hVjdhjdi6Ad9lCWZIAA7xggKqPGz0VM6mZj+T",
-	"z/ocwXJAat20ZrM8uDukyvuG3cSR5o9edWHPLfR8LyHsR2BLFXuTUYM8OyJAJe4cWjcLhsx0rAIU2kxS",
-	"kiWzZUFvPH3/8Z4o0RGRvqcgSSlW8KHRyh8qZs1XWhzeqlOTsfPt3QbOVx7XRo5NAV+usIkccKYVzjuk",
-	"jymww+Mp+mkPWRRDIUSElWPEnFNN4C9NaZqv/AmEbMwS9+Chxrra741e9PZ6oy4z3VZh/VMr9JvkqaPF",
-	"ms83dWv0wMVu2DVlEW/ylo1DbSC84Jm6Azal7UXnfwVE3sT7n0E5qh64cjVoq1XNCZxD7k4U17bpGqmw",
-	"yuRdCJzaHTu40fDwW81QMO9y0GkhY903r0FhQqXzi+139couNK17KQEp8bIBQd7bB65sLNxodUceZV7k",
-	"9ZowqTClNpSImfemgi8FSOl1YPVxjGWDgEeZEMCUkai7hLAsMX4CFmoWhoPhbj9FGY0IpaBTL8LE/hEC",
-	"BaWfX1Q1qm/ckDxLQ6zgjDShsv5WKpykiLCQBFjlVqVYKqRIAjvaGF1jiSynjcZ5PBzv94aj3uj52Xg4",
-	"2RtPxi//XS0oelNP89qtz2mYax4eT1vhtAkxruoQe3g8bcKLq5JkpVr2h/3hbyCo3E3SfP7uZJEdIuOU",
-	"VOkXYn+qaONUuL3wPaIg6QSb7fYuFcdC4JUJOEGOBUTkpm65AR+TRPYIiwSWSmSBygRM2RUwxcVqcDW6",
-	"t1WPBV9QSBz+OKivWqVofw+Ll4HtbfGXzRSq++mQrRDLkoU7ihVEKq8afX0EqbQIGMkUAhKZFONm6rdY",
-	"6R6WaOMkwJT5vtK1lgqHRq3NcDlEse5ce0XnCjcpxcwyyNnZBoZIxIPAwFNQRSZttTV05IxBkJ/+Q6zw",
-	"AkswSBAinqkmgDFIygJoEvH8ZFqZ6pnWqmhQ3Uwkl7RdQjRjU4USvEIrM02JMmGmQ6SSMCRCIRScHAaV",
-	"PasgTZLLlkqmT53vzs6Oc+ALeAiu9eoypeNBmIIlCJMeRNFG28iYC+Wve1FmSYLFao000nT7aKr0royG",
-	"duYbY7Z073UrQineLqJvXqNCqow6aSZSLsGgCuUBpuRXG4doGhmOZh5ProDZlzDG6mZsPfMMQk0WFLPP",
-	"M8+3likSAMkYU4owlWaMZipGmHtls003X3QFDw4CLkJToDiavjl7i07eHqG9Vy8P0Ke9i8bY2jAekQhY",
-	"wDOBlxDaLXqdZuRklDO25pCQB1mRodUG3JD+FvrLvn3T++7s/Y/P0HUMrB6KqBxEJWBgw03HUwESmPJn",
-	"jCjpBrX2zJYlxQhyzdLrxTVWKpWTwSAPwYoN+wFPOpNgrW10GVGgzmYvqNMGgkwQtTrVFcJCJseZisct",
-	"Fzb0ySnTYfbxMFMxGqNromJjxYAS3TEFAozjMJUoovxaJ5L+394QMWuOyiX6Sxnw1HIWnMLElpaysxXe",
-	"xPs4nr4/RWX3LtAJp7rHqOzQTq4sPjEf7Todl/wzsHNBK2b+DKuAcvy57zygbTwQgGkiB1xgpv2geMDp",
-	"QBcnEvYCC6gDQ6tW0azFzGQMbhQIhulrHjSg0cfeyeEH9FELjKZMgYhwAOi0WlA8XXW1nGc/vLaQbA9P",
-	"ZuIdKDPcspO9EwjRO6yKDbli19fXfQFhjJUJm82idzw1sW8NNa0VcVQ9HXi+R0kAzHbIjuthioMY0Ni0",
-	"TpuMsXnc52I5cHvl4Mfp0ZsPp2964/6wH6uEVqDU6xRCh5znb/Y8vud6Bm/i7fWH/T3dQWEVG6s3NihV",
-	"qgPdbFxV+qslqE13nZj5sQWgYqie93HajEUvVxT/cj4jQVyRAGyW21cvhLNp6E28f4A6pLRo73Te2qtL",
-	"RpTxcJg7HJiyvWBKXXgMfpG2jy3ntffu+KQN2bULBNVJLF8obBqfRgvk2msVb31vf6vcDtX+78Hyr7WI",
-	"DSr8gMP8MGPlGj0Ouc6ZBgouyK8QWsH2Hodgb7lYkDAE48bnj8WNBiB1M64zCQQCIbjo18qWOQ7lBetT",
-	"tRzgMCGssUJc6OOSa81sLtZSWddVvJSaXAIK677Zu9A8uzHlanR3WMlzKSGMi3ZMKTrWBP/CReupdwNm",
-	"3muyjxponrDjCTv+zNixmbgPQ5CGae/dkKRppidb4OG4iZlfu9f9qdk75ZLB1nvft/599tevTN+PRvSw",
-	"zea1v3b3gyBzp7Fc2yuTjYHcXbq1znB4Qt0n1P1zoK7fNhlogOPGcK8gciO8Xtz6XsplA8AemRusEmHE",
-	"4HrL68k6stpdDWnt7t2AVD/wcPWbNV+tbyHrMyElMrjdALTR7ymGxbEO3AqMucItL9mesOqvglX7w1eP",
-	"Q6ojziJK7HuZvyyAbgFLi1Etdy66AfMh7ezgS+PNlFsLvxQUNN1I0N/LrXdE6iBsdzSD8N0a3OZ7NG29",
-	"4Rags8p1At0ToNwJUPYfh1Rn5RtQCNtvVjCuUMQzFvb/rshjE/PeyON3H4VDd32p43r1Lsfh3xks/tC+",
-	"q3ZerNisFZ6eTo1PwPkEnI/uzNuWrzsde7NGLE2p+RGVuZpPpHK/ttgJR8/N1cHfG0of2SH6jwdzd2Pz",
-	"6RD9BN2PHLr3R+PHIf2xgPIXkvZmthVwPH4s0eh+4mYvhTJF1Opve2ywdeX+A4s7iGQ5G41soSrvOU0G",
-	"A3O9MuZSTV4Oh/b2tePdfQ18y8u5zd9rVhW4vbj9TwAAAP//PfBti35JAAA=",
+	"H4sIAAAAAAAC/+xdbXPbOJL+KyjdVe1kj5IlWX6Jr/aD13FuXDeJvbYze3cj1xgiWxY2FMAAoB3tjP/7",
+	"Fd5IkAIlyk4ynh3lS2wSBLob3U83Gg34l07M5hmjQKXoHP3SyTDHc5DA9W8xm88Z/Rln5GeWAVX/4zR9",
+	"SyBN9PsERMxJJgmjnaPO9YwI9OHyDH3KgS9Q0RXi8CkHIQWSMywRTlOkBk3hM8JScjLJJQiEOSBC4zRP",
+	"IEGEIjkDxEFkjArojemY3t7ejilO05+nenz7oBN1iBpcj9mJOhTPoXPUKdt1oo6IZzDHhuApzlPZOepM",
+	"cSpAtc/TFE9S6BxJnkPUkYtMfS8kJ/Su8/gYhYQAnzWdTYI4YfM5RgKUBCQkKCVCIjZFmiDEYQocaAwC",
+	"SYZsV2jK2dzxnKdSc3yK41n9I0QEwvah4jVCjCM12Kdcv2ZT76XwiJgskEixmIHoobeMjyl8xmoSIp8K",
+	"RcBtzHIq+eIWiXxi+mJT8wY+S6CCMCpuzShHxcTYHqzQ/1K23LHd2XZj+vcZqNklwtMQIuifJMoFJIgy",
+	"y8ADSVM0AUdbokViRG70gwgj2XpDBPdAEdE0L7RewecsJTGR6aJUsVwQeqeajOmtIfq2JKinFctKqHOk",
+	"tSpa5qlB+aqyqChgK/WafgG9snx6lvTtteoOpNEb9ZXVGIRp8gw1s+rVMB9tdUxBkBrJ9FYoEAeZcwrJ",
+	"82b/6bOeSuDLs34FmMczFHMigROs5/CEUYkJFYhRUFM1ZxyQqDaMatMEcxKzlFHRQ1oFas21CoypzLMU",
+	"UGz6VxaCKWIZcCwZjxBeUhw1nT4R9zjNlTJcz6D4DsWYjulENV64SZ6yNGUPagAjFaHn+Fd07r75Fb0D",
+	"rCl4yr9fx/TXbvHP+/EJ/1RfSl2pvFU9o3dYxjMQFmGsRGI3I3JmhdBIF7qFT7cINfdFBIJPOU6VDa3o",
+	"zvR1J9f1dccBS+DK+9Km/lxfcLtBX4wH6TR9EbqOLq020/JL0SivdC2PKQixkkGvL7ht21edwbJv0xe1",
+	"StHQV8JAIMqkU44G2mxfVima6VI9rdML2xehLfpaJ/9flUVez2DJ5onRcoV3qgOvHwuo9jc2+QfEctmX",
+	"jKn71LZv9CfIdye5CAQoXcsSFSSBMV3vPxTI/uU7+BQA9Oj0b68KF3JdigVzMzDmd/kcqCwZtGBVp1UT",
+	"8enWA0A2zzAHMabxDOKPxXyYGWRrjb/nKNJmpTDXzLEbQCCRZxnjEs3zVJIstd8FpKgJcOMXohzTuiwb",
+	"XLGmj8gZcHR7enWr5vb2w9WygAkNCvgq+nD1quqmrZCdjSjPiEXk1EANIDKsoxoVzlGARLExASRyzllO",
+	"E6s2hN6lgD7lTILojelqvv2IxKqz8UPodr5AcZoLCfw2qDfq0+hPZas/1fgpZqDwrA1+WOuVikciHZAY",
+	"LZijeS4kmiu7RVPGTYRq1ktSO+aESMKoYkk3Cuhe6Vt1ZBPinKj1k8cp+jOmyZ9r5lVMoBKRmu2W8vjP",
+	"JvO6erVphGbi1vUhWkFIScerxvhMkb4mPkv44jKny8HYjzglCZYm7uFAE+0LAWWc3RPFrZK6Xe+iByJn",
+	"LJco5oClesE4yrPE/IzpQs4IvatxYlahIbotScHlrF7NFovXCWMpYKoZ8Qm7NHSdJct8faDkUw6IJEAl",
+	"mRLgSh1xkK266AfDXRjt7R904fD1pDsYJrtdPNrb746G+/uD0eBg1O/33VRkWM5KjsK0RR01EOGQOGmU",
+	"DE8Zn2PZOerkOUk6BcPexIl8UvC1AaP+Z3UG9/eGw8He/qh7OOnvdUeDfdydTOPdbjzc60/i/REMMA4z",
+	"WCPmOZw9usZ6XfhG68IlCJbzGJa5PEbcvrNKarAHo4QvujynkdbfGXtARKKETKfARZmCwFmWEtBxfa+j",
+	"VSgDLgnooXFsxljO/gCKZ5jegUnzPLA8TZTJznECzpk5qlS3QPN55+injrYO6EQdbRr6B2o6Sjo3/jwU",
+	"72uyiTqKg5AQcqqmONEcognIBwBa4dAtRgshOQIjJECihxnQCt0lV4aYpBci5yOhAc37b0KLJW5FDgWD",
+	"Jwa4zqiQmMZBTo1i1bt+b8OpStfoTGpnPc/kQnsSjN6zBI7TlMVYfWhNrjZdZjqUTzJucYZ58oA5jGmW",
+	"5neEIiwEuaMCESk8M6objaBs0MSA9udhLvSr1VIK9/zoG9dPZgoip6w3RXMTkipCLhz4QOLs6AqkCCu2",
+	"o8SmMGf4HtAEgCKRxzEIMc3TdFGipdIs7dikY6UCpBln6iMjsqp1UZaAU4OADp1VYEt1/L78wNEmDGke",
+	"NVUB4sHo4DCZvO7u7w2m3VGy2+++3k/2u4d4OtgdHQ6Sg4PXnagF1jbLlNC7ExephAyzDGM4ZIzLMuUr",
+	"JJa5WOF9lkSWYiGvOaZCd3hNQgaingqJ55kTm/oIyeIr97igqyqxYX846vYH3cHe9bB/tDs8Gh7+ny8h",
+	"BQVdqUYOaPwchMB3AaLemRfIPJ3YdOSGpPkid5FPiAoOWITm4lI/d5HmhoOf0QvO7jgIERrSTGUgoVVM",
+	"caXryC0kr5VrRG9VSIMYRx/oR8oeaiO/ten7pUHNg6X5X2SwhhdrRB4srAUZ/bbg82aNQVzMsACliCmh",
+	"EIYZLX0spcLsgAlkqoeARzYfNEAXzecTCxfKCAwaqY7QAxbK3LhGe5MvdjrIQXICAuGpBOU4ppikOQfP",
+	"X/KcCuNVxjTBi+7QOv+aHxgUQiFUwh1wJRWgyTozJTQhsQmUCydcl07JyZRQImaQOJ+HJwKoct8kBa8Z",
+	"0RsHmVXZusfyjXxwNDo46u+1N3I9QHgCwlPooh+7oFDNo45zRCcc3KPvrfv1Ncl7fMLolNzl3DX3Q4g0",
+	"dU8vWEriRb3th+yO4wSqQVa4gyq/UedzV9Hfvcdc+3PFiFbvCjf6SYAl/byBr8q7OsH6ZQOH6lWYTf2q",
+	"4FWbsNCLpgZHn6ey4iVCGufPYAUEr1Q8AIkGj7eYpPoHpdbJeS6rkvabtpVvZSz9xO9FPyhGLfDGDG0A",
+	"mcsva3kWP76Yu6whrDErn/Ji+qIS99Yhrw10TzRAvWcqgDIRcCDAooZKwijCE7V4D4chbqlD/d7qwGza",
+	"JEUk1IDQivZld2hDzQfggHCSKIzmMGf36gfG0cOMCRctqTfaizOObLRh6avnSX4KubmbqEMkzEUgE1II",
+	"FnOOFx29cUVFPgd+tWadXaRPjfhsks5yqHsoIj6vJ03tmsAz6vhCP70HKq+DTv/cy0sXGTFNkzhCfdS1",
+	"S50IDVAXzVlCposIDVEXJZCChDEtjbwfDaLhTciX+bScJQ2L0FrGQTLEIeOgnZTOgfm96O1r2U4SRt8v",
+	"YRqegA+XP7iVdzCWRrpkw4yPnN25TGFQyqrxEH335vSH0+vTV0a7lp0hE/JcU6ZCvQa3GDBQ3drGHLJI",
+	"J5RUtqVwTPvou5PL0+Pr01fKJgbou3fnb87e/m+V4BIsMk4YfzrFE5gyDs8kuSRSkdwgY0dyDSZrSthk",
+	"IS1x8g2WOiWFE4NEOL3wYM2mGuuwmeWyzOTayHBF+rAKlJXOar92vs/nmCIOOFF1Osh7GVxce+vE0iNJ",
+	"Ox2Yukx540ctUy41qqiXgVlPjtA/dP2GXduwE3XmhP4A9E7O/PDZs652GV0vVeAWd6swoGxNhE3xlJB9",
+	"9e78iVnftQimXHiKJbxfm9hyLc0GwUqeQsJ2n68XsGt5UamFCyl82cI465jp8MFh7nkG9PjiDP24i0zu",
+	"FiUwJbRMdriRKgT/EsqsuJY/AhdBK7Evnius+1F3cNDd7Q7WiakeqDWk8rXxVNGiNufLvAVnoC12ucT4",
+	"qgjfpvKKpK9OfDfEeeGsud6KNHnzYNo8mNuLa+ndBvIsUbVk8HoVoaHk7ppBggnhpVU0VLUViY8kE0Vi",
+	"uCK3GkwECc28Zdo7nIl1gigaohlLixSF6aUkqnQ+vc5yQFuOXg9oC31YtaVh7buiISZjPscfvd2N2i5C",
+	"lZR/5ypO6/zbTllyu2P3dHZqGzpLdNbMLV7aKVgSqs9ZS+NRi5+nL4lCKp81J9lXSaMpNx/2fi5eadVj",
+	"7bNKmrJtByaB2QYD9RhRoxha5w79cDOsp6ojaE6bV/LsGLVaHQcxbM1atny/IrO/yhW1spbwPkPAuL95",
+	"7NYcrX1NC3hO/PdVorqn29RvExE2xoDfMt56VoS1NqayU7IKjDzjXgdIVw17O29AYpIK6yjam30VZTbd",
+	"Mms3xlKSGxEviVzbHeisWXldhBP+JznnQGUJxysJshnkDGhi8r5udPPbNE+nJDXZ3KlL6+rklHpfSSZX",
+	"P1xW55XbTjq7GiGgiS1rcjlwUHXiy/sX5Uazt32kOWW8KIhSDU2JG8+tK9kY26tbZgF8N1UYG6S0yz1O",
+	"MoeWuqM3yly9x5h+wVT3kon5WdVnJKeXq5lqXhyn6QTHH8PqYIoZVA2qqZ5RR7okQ7jM3OoChiTnoLbY",
+	"1BkCTM0zIVRwwYTbJRjTDSKNUqozKTNxtLMj5qxnn/ZiNle/79wPdtiQzMXPPuc/s4kAfh/e+m6bsg54",
+	"zIJjZQz3QKVAek2U5EXQ7/faa+Mbm855nLhyUTW4HcyIN2E6QeuVvhaxFeNFaaTpt0zaVPPqlQyzkhWJ",
+	"dZoSuE5eRohMEbZ9uIxwkbmXM6C6JNOShXlJQs/ViOsvc2G3mIXy02JBJf7sKolsk/rpQAU5RCBQmXll",
+	"YgjfYUKFbNSfq7XYio7d/gzx49CpT8bcVsabYmZTOa7zwMg5b6XG63E8UIhqIGVnyV1EBsRfBSMmcqf9",
+	"KsQcAqv288xkYpGYYTXHQrcz0YZkSH29lF+2uiqZrw4T4D2ki28FyMggvP/RmMaYm71/ir5/d3zSvfr+",
+	"eLi3r4fAUtm8xfr/6Z4rO+xeFS9mgBPVvfYphkClSXAPvFa4O8efXWppuLdfTTXtL2+HPnAi4ZymC1M3",
+	"2aLMM2DLFUMNZj1NIqS0oPPuScrypPeUatA6DKjVRslBQAHugZPp4qSCzNXq3nrhjj1Ui5H+1Nn2DNNE",
+	"zFRWQpUfGxSznfobFb4AFfO27K+HjsfUEfGj3288w2kK1qYuzq+ujeb5/UdFfUi9dw7Kw0GCcmpP16ii",
+	"dr9HiGdMSR7HHys7Hq6WeVkJ6ikRJ7lQ6Bo4bnd8cdYY3Icc7X014D++OAt51vuyS29V1ev3+u0CgJWE",
+	"inaUumOhlhaxhmScEb//cq/Y48ay8HjTMohbLe9QIMfJBYcp+VyVnHH1XUKnHAvJ81jhzBlVXojxxc79",
+	"4MlSfQM4+QGkBL66IOC4ujdr0/wq80eZVG43gZTca7+rYyQPZjco1HpfK9KynRanJSs0qMDbeJLe2lIr",
+	"a9bHMhwfl8ndyggq4NU1B87CP+WQQ691NVRSCLddEStQyRfOr6iPUaq/LsctVWIXj6aH8Qi6/SHsdUd4",
+	"97A72ZuOuqNhAoewN0l28ahNGKZWAaecMx4OgkG9KryW8wjqo3Jy7HxW6asI0kzTEdrr764rZAiTUekt",
+	"w4uUYVOaTVzJtleLHPb2neBeweqqheUpYpzcERWA+J9WOd+bDONksnvQHWEYdUcwGnYnh/igu/sax/uT",
+	"g8kQDwZtZsadFAwRdmXf+WWNragbDUPmYVd1jebBpqun/QklTRXbiJa37j3uq2/9iidffX0j9zm62RQI",
+	"/6aMbb0aio1AENUAlFSQhQhj4oipOcVpOqZ1MQuj4caSbOkTUKX/RCCOiYDEK/SsBx4iFxnQxMWd9WJ7",
+	"jyv14Km+rcGZBPzcBnGr1b0KR1ry7MEeMw+g48Fh/3CQHO519w52Vdp2sNfFUzzpHhwMR/uDvdEI9tsl",
+	"cJ3cPlBJ0pAdyjZSLwqIi1m1lcQ99L6iUnYiiwCVCO0JxxRzrX0N4KYDTuwq5FBCOMTqlo+K21pO2eyp",
+	"lM1u/3owPOr3j/r9J1YnLh3vqipUSwO84GySwtxmTu2umR81FGU4x8VtOc3lOb+EvHEloKELDzzLTry7",
+	"eLSD8UoVMBIZxKUNM663xSkiSqRzoLLA2yWGE81WKK6aqV2YbrELo+5owdQM4IYrQILFsU6sxv5aXEmt",
+	"ltdllELsjscmWOKJTleSOSSI5cENGtK4HX+sM1/lsXdtfMWS0aY5HKXNFKIxPZNojhdooY8bT3Ouj08T",
+	"L3QnU5RAMZIFq9JIOdnkfIUC7O+vry9cajNmCdhV8DpRLntISWQalI2YMS6j+iyKfD7HfFHrWpe46rIC",
+	"MSvchs3Q6PIJjyjJmkmM9D1DkEnNTpbzjAlTOqtW7in5p9FDdDbVI+oLK8g9UA0TTEtd3+sw7ui10tEk",
+	"xfTjuBMZyRQGoJIsaYpwKnSyzaXBGqodwydO6sqD45hxU7zA0Nnp9Vt0+fYE7b4+3Ec/7d4EdWtJeEQg",
+	"oDHLOb7TSTlsdljUQJZGMaa1CUlYnBcW6m9M6a6/g95dz1yF9P31ux9eGd9aUUVUntSeg4aNIjsIAqiM",
+	"xpRIYWt9Te1YPi8SlTVJNyV6nQp6MlQJ37VGUAdkt8dlUaclAl/5ubdLJlfF4/DwtBRcNSjyM2R6bz7F",
+	"MQiThKWLaKwvFiE0N3c5TcAdimf0rribQZHCqLHs4QjNWM4FEqzUi3JAnaDnLE0Ruzf1xyRYrrEmCRkU",
+	"QBWBD5N+fIAPpwPYn47wcPI63k324GD6Gg8mu/Fesmnub2mGKxQ+ZX6vVqBm9fQHt5rgfq/O+/Kx8UD1",
+	"C9wTlgsz8OnnjPBFOJQigVhvhhM1ghnNy9w5suohVIy5ipq9RK0KXnOp4mMiZy7DbUgqtFeFd2W81Rgr",
+	"DTaOlZ4b636NuHZ1/HYTKrMWEOecyMWViv7NtDKcy9mw4f5DVe+pN0HOj3M5Q0MvC5sSoBLFHDTfOBVo",
+	"mrIHvYxM2YMtEVRtTsom6qGIWWZG5iyFI5MSK3fxudoeGJ69uyqreoCjS5aqSfG+4Do7XzS+1L8G2pWw",
+	"UbS9Kh6Z9srrsY9AP/DUA/GPsIhThj9Wtuw44HQudhjHVKG8ZDFLd5RhkqQbm3BtR/dVydwZCetbD+Cz",
+	"BE5x+obFAas9714ev0d6IwKdUQl8imNAV364qhbFms7rv74xAZ+pctMXzsQa5ux1DZeQoO+xLD5wjD08",
+	"PPQ4JDMstVNaDqkvzjQKG2GdVZKVqHbALSUxUAHeqMcZjmeAhr1+cGCsX/cYv9ux34qdH85OTt9fnXaH",
+	"vX5vJuepF6h11hKhVLQTLed2o47FS5Vk6/V7KmOVYTnTUg8mYv1edxTQ3nt55LuQB7nU+TRh91btnTYu",
+	"X63E6HoolxbehqbdtdQYZW4+ssjS+S+Qx2lapLGjjrs5VJMy7PfdhAOVJuedpVY9dv5hz0WXd3E8ObMt",
+	"jMrW8N2/IoBNJNbLqqAEHPeKxceoM1pJt42Z/uPZ9NcWoAEW/ooTt99p6Bq8DLo+UAUUjJN/QmII230Z",
+	"hL1lfEKSBPQ07r2UadQAqTeRda2EyXH3Km5Ob/s4B/eT7xZwMic06FFu1LaQXfgZW6yYsnLD+E6fYZ2D",
+	"xGpV3rlRY67HlPvB5rDibGlOKOPNmFKsh+f4H4w37u4twcw71e2LBpotdmyx4/eMHcuG+zwECRTOboYk",
+	"obIf0QAPF6HBosq16j+FZ6dssrPy2vXH6CnfV28sf1of0+d9rKveHm+eCZkb15D6Z1uWj9VsEK2tVYct",
+	"6m5R9/eBulFTJiEAx0F19xA5CK839haAUJUrYAkCYZ1JDFdv/p3IGTLXXUbNVdlEJ531bZyJdx0nJGPa",
+	"6uLNqPn2P1fhaos/dN7VJHLc4c8xrZ/+9L6150D1GXiK4DMR0l77Fr4jtCyYrd+nV/UvRnYBcNvYwxjh",
+	"WjTWXfyVJYsvFrs2nrarZuBsLedXC6HXnQ9eB/8ZcJVQtPhvDwGvPtTxGHWG/cHX5MA4sjWU24qQ1YRu",
+	"ndW/hLMa9V+/DKrU4eOUxPJf2oOu8JYGnhvO5Kz3mM9Zz+z8Ejwe+Gj8bwqhU8Nv9HOx8jR31f+YL76E",
+	"/wlS27g4WAF0hrm1QLcFlI0AZfQyqLouC2wgaT6aR5lEU5bTpPdHRR5jmE9Gnmh9LsQUVIh1t8K3yYd8",
+	"ZbD4TeOuSsLAk9mq02PbSGwLnFvgfFlJjyZ7bZX3yINYakq81iYEQjj6Qdf0f20o/SOlAVqBuT1KsV1E",
+	"b6H7hUP3aDB8GdRfcCj/tIM7o6gIHA5fijbaeyrMmQMqiVz8YZcNxq989YSFX2b5/J3XSpFo0zbsVWXI",
+	"7f7rN95/9cX/ZTZdlyZ963q3m62/w3WHV1wdWHaIGmw5AK4+b7O52vJaqND+YsV4v87CoIoPbVYDg684",
+	"dst9tLr0tgi0RaAXjkDNaGP3qtoCzpNCvZ1fqgdsWu5GrUMp07KGUpsFeFW6nrvvFASGrQH+/lffZnn4",
+	"R0qVNgOG3WISVbNrDlA221FaZ/O1Jd3XMvhvH2Cs2jDaBhxbvNvi3Qteon31gGmnvCyqXeJso3uaqncE",
+	"mHLYhxnoazJsQ2IuINDXNZZNdXWsuaJDXbZTu2NpJXK/8Rh6ySDe4qKsDaG9dqndFuS3IL8F+d/4qFmj",
+	"ZeLfDud39G0wOtcWTvKd0kRY6BAZUP/Yqt9tcZmMxnXNWchHsCkiUgSkMGW8vIyvh2qXjZjzEfrPkZZH",
+	"ILyr/ApHE/IJuhhi8e3cwrDNpYblPZqaDXN8o+B/i8xbZN4i85dG5jkmVAdJS+h8Cdb0Xg5GV66e2tG3",
+	"MEEzSl+BFIFbo554Yf8y+No/0Gludpowe4hMnalzp9pqVz2V/uBJF4bZ3sfU9BaEdS0SH9YrF299GWD/",
+	"8ptRrS+EazBgJXPhOPx2VW0t7zlrQh3dRl8lrn2eUedk6+e2fm7r576ln9N2Z9DXWG/j/YKrPNwGhBkC",
+	"NF8Ghcsrx452dvQ9qjMm5NGh+lN/jzfFoOv/8sSKezLsVWdeC7+U7DF6WuehUjA7UuVN5/Hm8f8HAKjF",
+	"Ac7poAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -9,14 +9,20 @@ package api
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 
 	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/controllers/dryrun"
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -25,16 +31,27 @@ import (
 
 	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
 	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	api "github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/repo"
 )
 
 type ProvisioningServer struct {
-	HubClient client.Client
+	HubClient                client.Client
+	Repo                     *repo.ProvisioningRepository
+	SubscriptionEventHandler notifier.SubscriptionEventHandler
+	// DryRun renders the resources of a provisioning request for the dry-run requests
+	DryRun dryrun.Func
 }
 
 type ProvisioningServerConfig struct {
 	svcutils.CommonServerConfig
+	// DryRun renders the resources of a provisioning request for the dry-run requests. It is set by the caller so
+	// that the server doesn't depend on the controllers.
+	DryRun dryrun.Func
 }
 
 // ProvisioningServer implements StrictServerInterface. This ensures that we've conformed to the `StrictServerInterface` with a compile-time check
@@ -139,6 +156,10 @@ func (r *ProvisioningServer) CreateProvisioningRequest(ctx context.Context, requ
 		return nil, err
 	}
 
+	if request.Params.DryRun != nil && *request.Params.DryRun {
+		return r.dryRunProvisioningRequest(ctx, provisioningRequest)
+	}
+
 	err = r.HubClient.Create(ctx, provisioningRequest)
 	if err != nil {
 		if k8serrors.IsAlreadyExists(err) {
@@ -178,6 +199,44 @@ func (r *ProvisioningServer) CreateProvisioningRequest(ctx context.Context, requ
 	return api.CreateProvisioningRequest201JSONResponse(provisioningRequestInfo), nil
 }
 
+// dryRunProvisioningRequest validates and renders a provisioning request without creating or updating anything.
+// When a provisioning request with the same identifier exists, the rendered resources are compared with the
+// ones applied for it.
+func (r *ProvisioningServer) dryRunProvisioningRequest(ctx context.Context,
+	provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (api.CreateProvisioningRequestResponseObject, error) {
+	existingProvisioningRequest := &provisioningv1alpha1.ProvisioningRequest{}
+	err := r.HubClient.Get(ctx, types.NamespacedName{Name: provisioningRequest.Name}, existingProvisioningRequest)
+	switch {
+	case err == nil:
+		existingProvisioningRequest.Spec = provisioningRequest.Spec
+		provisioningRequest = existingProvisioningRequest
+	case !k8serrors.IsNotFound(err):
+		return nil, fmt.Errorf("failed to get ProvisioningRequest (%s): %w", provisioningRequest.Name, err)
+	}
+
+	plan, err := r.DryRun(ctx, r.HubClient, slog.Default(), provisioningRequest)
+	if err != nil {
+		if ctlrutils.IsInputError(err) {
+			return api.CreateProvisioningRequest400ApplicationProblemPlusJSONResponse(common.ProblemDetails{
+				AdditionalAttributes: &map[string]string{
+					"provisioningRequestId": provisioningRequest.Name,
+				},
+				Detail: err.Error(),
+				Status: http.StatusBadRequest,
+			}), nil
+		}
+		return nil, fmt.Errorf("failed to perform the dry-run of ProvisioningRequest (%s): %w", provisioningRequest.Name, err)
+	}
+
+	result, err := convertDryRunPlanToApi(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	slog.Info("Performed the dry-run of ProvisioningRequest", "provisioningRequestId", provisioningRequest.Name)
+	return api.CreateProvisioningRequest200JSONResponse(result), nil
+}
+
 // UpdateProvisioningRequest handles an API request to update a provisioning request
 func (r *ProvisioningServer) UpdateProvisioningRequest(ctx context.Context, request api.UpdateProvisioningRequestRequestObject) (api.UpdateProvisioningRequestResponseObject, error) {
 	if request.Body.ProvisioningRequestId.String() != request.ProvisioningRequestId.String() {
@@ -270,6 +329,280 @@ func (r *ProvisioningServer) DeleteProvisioningRequest(ctx context.Context, requ
 	return api.DeleteProvisioningRequest200Response{}, nil
 }
 
+// GetSubscriptions receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ProvisioningServer) GetSubscriptions(ctx context.Context, request api.GetSubscriptionsRequestObject) (api.GetSubscriptionsResponseObject, error) {
+	options := commonapi.NewFieldOptions(request.Params.AllFields, request.Params.Fields, request.Params.ExcludeFields)
+	if err := options.Validate(api.Subscription{}); err != nil {
+		return api.GetSubscriptions400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	records, err := r.Repo.GetSubscriptions(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	objects := make([]api.Subscription, len(records))
+	for i, record := range records {
+		objects[i] = models.SubscriptionToModel(&record)
+	}
+
+	return api.GetSubscriptions200JSONResponse(objects), nil
+}
+
+// validateSubscription validates a subscription before accepting the request
+func (r *ProvisioningServer) validateSubscription(ctx context.Context, request api.CreateSubscriptionRequestObject) error {
+	if request.Body.Filter != nil {
+		if _, err := search.ParseSelector(slog.Default(), *request.Body.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
+	if err := commonapi.ValidateCallbackURL(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback); err != nil {
+		return fmt.Errorf("invalid callback url: %w", err)
+	}
+
+	if request.Body.VerifyCallback != nil && *request.Body.VerifyCallback {
+		if err := commonapi.VerifyCallback(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback, request.Body.SigningSecret); err != nil {
+			return fmt.Errorf("callback verification failed: %w", err)
+		}
+	}
+	return nil
+}
+
+// CreateSubscription receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ProvisioningServer) CreateSubscription(ctx context.Context, request api.CreateSubscriptionRequestObject) (api.CreateSubscriptionResponseObject, error) {
+	consumerSubscriptionId := "<null>"
+	if request.Body.ConsumerSubscriptionId != nil {
+		consumerSubscriptionId = request.Body.ConsumerSubscriptionId.String()
+	}
+
+	// Validate the subscription
+	if err := r.validateSubscription(ctx, request); err != nil {
+		filter := "<null>"
+		if request.Body.Filter != nil {
+			filter = *request.Body.Filter
+		}
+		return api.CreateSubscription400ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"consumerSubscriptionId": consumerSubscriptionId,
+				"callback":               request.Body.Callback,
+				"filter":                 filter,
+			},
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	// Convert from Model -> DB
+	record := models.SubscriptionFromModel(request.Body)
+
+	// Set internal fields
+	record.EventCursor = 0
+
+	result, err := r.Repo.CreateSubscription(ctx, record)
+	if err != nil {
+		if strings.Contains(err.Error(), "unique_callback") {
+			// 409 is a more common choice for a duplicate entry, but the conformance tests expect a 400
+			return api.CreateSubscription400ApplicationProblemPlusJSONResponse{
+				AdditionalAttributes: &map[string]string{
+					"consumerSubscriptionId": consumerSubscriptionId,
+					"callback":               request.Body.Callback,
+				},
+				Detail: "callback value must be unique",
+				Status: http.StatusBadRequest,
+			}, nil
+		}
+		slog.Error("error writing database record", "target", record, "error", err.Error())
+		return api.CreateSubscription500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"consumerSubscriptionId": consumerSubscriptionId,
+			},
+			Detail: err.Error(),
+			// TODO: map errors to 400 if possible; else 500
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier to handle this new subscription
+	r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed:      false,
+		Subscription: models.SubscriptionToInfo(result),
+	})
+
+	response := models.SubscriptionToModel(result)
+	return api.CreateSubscription201JSONResponse(response), nil
+}
+
+// GetSubscription receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ProvisioningServer) GetSubscription(ctx context.Context, request api.GetSubscriptionRequestObject) (api.GetSubscriptionResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetSubscription404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.GetSubscription500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	object := models.SubscriptionToModel(record)
+	return api.GetSubscription200JSONResponse(object), nil
+}
+
+// DeleteSubscription receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ProvisioningServer) DeleteSubscription(ctx context.Context, request api.DeleteSubscriptionRequestObject) (api.DeleteSubscriptionResponseObject, error) {
+	count, err := r.Repo.DeleteSubscription(ctx, request.SubscriptionId)
+	if err != nil {
+		return api.DeleteSubscription500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	if count == 0 {
+		return api.DeleteSubscription404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	}
+
+	// Signal the notifier to handle this subscription change
+	r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed: true,
+		Subscription: models.SubscriptionToInfo(&models2.Subscription{
+			SubscriptionID: &request.SubscriptionId,
+		}),
+	})
+
+	return api.DeleteSubscription200Response{}, nil
+}
+
+// GetSubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ProvisioningServer) GetSubscriptionDeadLetters(ctx context.Context, request api.GetSubscriptionDeadLettersRequestObject) (api.GetSubscriptionDeadLettersResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	records, err := r.Repo.GetDeadLetterNotifications(ctx, request.SubscriptionId)
+	if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	object := models2.DeadLetterQueueToModel(request.SubscriptionId, record.SuspendedUntil, records)
+	return api.GetSubscriptionDeadLetters200JSONResponse(object), nil
+}
+
+// ReplaySubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ProvisioningServer) ReplaySubscriptionDeadLetters(ctx context.Context, request api.ReplaySubscriptionDeadLettersRequestObject) (api.ReplaySubscriptionDeadLettersResponseObject, error) {
+	_, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier to redeliver the notifications
+	r.SubscriptionEventHandler.ReplayDeadLetters(ctx, request.SubscriptionId)
+
+	slog.Info("Dead letter queue replay requested", "subscriptionId", request.SubscriptionId)
+	return api.ReplaySubscriptionDeadLetters202Response{}, nil
+}
+
+// RotateSubscriptionSigningSecret receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ProvisioningServer) RotateSubscriptionSigningSecret(ctx context.Context, request api.RotateSubscriptionSigningSecretRequestObject) (api.RotateSubscriptionSigningSecretResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if err == nil {
+		secrets := notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry).
+			Rotate(request.Body.SigningSecret, time.Now())
+		record.SigningSecret = &secrets.Current
+		record.PreviousSigningSecret = secrets.Previous
+		record.PreviousSigningSecretExpiry = secrets.PreviousExpiry
+		record, err = r.Repo.UpdateSubscriptionSigningSecret(ctx, record)
+	}
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier so that the next deliveries are signed with the new secret
+	r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed:      false,
+		Subscription: models.SubscriptionToInfo(record),
+	})
+
+	slog.Info("Subscription signing secret rotated", "subscriptionId", request.SubscriptionId)
+	return api.RotateSubscriptionSigningSecret200JSONResponse(common.SigningSecretStatus{
+		SubscriptionId:       request.SubscriptionId,
+		PreviousSecretExpiry: record.PreviousSigningSecretExpiry,
+	}), nil
+}
+
 // convertProvisioningRequestCRToApi converts a ProvisioningRequest CR to an API model ProvisioningRequestInfo
 func convertProvisioningRequestCRToApi(id uuid.UUID, provisioningRequest provisioningv1alpha1.ProvisioningRequest) (api.ProvisioningRequestInfo, error) {
 	provisioningRequestInfo := api.ProvisioningRequestInfo{}
@@ -300,6 +633,10 @@ func convertProvisioningRequestCRToApi(id uuid.UUID, provisioningRequest provisi
 	if !provisioningRequest.Status.ProvisioningStatus.UpdateTime.IsZero() {
 		status.UpdateTime = &provisioningRequest.Status.ProvisioningStatus.UpdateTime.Time
 	}
+	if len(provisioningRequest.Status.Extensions.Timeline) > 0 {
+		timeline := convertTimelineToApi(provisioningRequest.Status.Extensions.Timeline)
+		status.Timeline = &timeline
+	}
 	provisioningRequestInfo.Status = status
 
 	// Convert the OCloudNodeClusterId string to uuid if it exists
@@ -318,6 +655,25 @@ func convertProvisioningRequestCRToApi(id uuid.UUID, provisioningRequest provisi
 	return provisioningRequestInfo, nil
 }
 
+// convertTimelineToApi converts the provisioning timeline of a ProvisioningRequest to API models ProvisioningPhaseTimeline
+func convertTimelineToApi(entries []provisioningv1alpha1.ProvisioningTimelineEntry) []api.ProvisioningPhaseTimeline {
+	timeline := make([]api.ProvisioningPhaseTimeline, 0, len(entries))
+	for _, entry := range entries {
+		phaseTimeline := api.ProvisioningPhaseTimeline{
+			Phase:     api.ProvisioningPhaseTimelinePhase(entry.Phase),
+			StartTime: entry.StartTime.Time,
+			Result:    api.ProvisioningPhaseTimelineResult(entry.Result),
+			Attempts:  entry.Attempts,
+		}
+		if entry.EndTime != nil {
+			endTime := entry.EndTime.Time
+			phaseTimeline.EndTime = &endTime
+		}
+		timeline = append(timeline, phaseTimeline)
+	}
+	return timeline
+}
+
 // convertProvisioningRequestApiToCR converts an API model ProvisioningRequestData to a ProvisioningRequest CR
 func convertProvisioningRequestApiToCR(request api.ProvisioningRequestData) (*provisioningv1alpha1.ProvisioningRequest, error) {
 	// Marshal the TemplateParameters map into bytes
@@ -341,3 +697,48 @@ func convertProvisioningRequestApiToCR(request api.ProvisioningRequestData) (*pr
 
 	return provisioningRequest, nil
 }
+
+// convertDryRunPlanToApi converts the outcome of a dry-run to an API model ProvisioningRequestDryRunResult
+func convertDryRunPlanToApi(plan *dryrun.Plan) (api.ProvisioningRequestDryRunResult, error) {
+	result := api.ProvisioningRequestDryRunResult{
+		ClusterInstance:  plan.ClusterInstance.Object,
+		PolicyConfigMaps: []map[string]interface{}{},
+		Resources:        []api.DryRunResource{},
+	}
+
+	if plan.NodeAllocationRequest != nil {
+		nodeAllocationRequest, err := runtime.DefaultUnstructuredConverter.ToUnstructured(plan.NodeAllocationRequest)
+		if err != nil {
+			return result, fmt.Errorf("failed to convert the NodeAllocationRequest: %w", err)
+		}
+		result.NodeAllocationRequest = &nodeAllocationRequest
+	}
+
+	for _, configMap := range plan.PolicyConfigMaps {
+		policyConfigMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(configMap)
+		if err != nil {
+			return result, fmt.Errorf("failed to convert the ConfigMap %s: %w", configMap.Name, err)
+		}
+		result.PolicyConfigMaps = append(result.PolicyConfigMaps, policyConfigMap)
+	}
+
+	for _, resource := range plan.Resources {
+		result.Resources = append(result.Resources, api.DryRunResource{
+			Kind:      resource.Kind,
+			Name:      optionalString(resource.Name),
+			Namespace: optionalString(resource.Namespace),
+			Action:    api.DryRunResourceAction(resource.Action),
+			Diff:      optionalString(resource.Diff),
+		})
+	}
+
+	return result, nil
+}
+
+// optionalString returns a pointer to a string, or nil when it is empty
+func optionalString(value string) *string {
+	if value == "" {
+		return nil
+	}
+	return &value
+}
@@ -14,12 +14,15 @@ import (
 	"github.com/spf13/cobra"
 	"k8s.io/klog/v2"
 
+	"github.com/openshift-kni/oran-o2ims/internal/controllers"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning"
 	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api"
 )
 
-var config api.ProvisioningServerConfig
+var config = api.ProvisioningServerConfig{
+	DryRun: controllers.DryRunProvisioningRequest,
+}
 
 // provisioningServe represents start provisioning command
 var provisioningServe = &cobra.Command{
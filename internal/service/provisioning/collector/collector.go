@@ -0,0 +1,264 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package collector
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/tools/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/async"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/repo"
+)
+
+// Interface compile enforcement
+var _ async.AsyncEventHandler = (*Collector)(nil)
+
+const provisioningRequestReflectorName = "provisioning-request-reflector"
+
+// NotificationHandler defines an interface over which notifications are published.
+type NotificationHandler interface {
+	Notify(ctx context.Context, event *notifier.Notification)
+}
+
+// Collector watches the ProvisioningRequest CRs and persists their state.  A change event is stored, and notified to
+// the subscribers, each time the state reported by the API changes.
+type Collector struct {
+	hubClient           client.WithWatch
+	repository          *repo.ProvisioningRepository
+	notificationHandler NotificationHandler
+}
+
+// NewCollector creates a new collector instance
+func NewCollector(hubClient client.WithWatch, repository *repo.ProvisioningRepository, notificationHandler NotificationHandler) *Collector {
+	return &Collector{
+		hubClient:           hubClient,
+		repository:          repository,
+		notificationHandler: notificationHandler,
+	}
+}
+
+// Run starts watching the ProvisioningRequest CRs.  This method does not return unless the context is canceled.
+func (c *Collector) Run(ctx context.Context) error {
+	// The Reflector package uses a channel to signal stop events rather than a context, so use this go routine to
+	// bridge the two worlds.
+	stopCh := make(chan struct{})
+	go func() {
+		<-ctx.Done()
+		slog.Info("context canceled; stopping reflectors")
+		close(stopCh)
+	}()
+
+	lister := cache.ListWatch{
+		ListFunc: func(options metav1.ListOptions) (runtime.Object, error) {
+			var provisioningRequestList provisioningv1alpha1.ProvisioningRequestList
+			err := c.hubClient.List(ctx, &provisioningRequestList, &client.ListOptions{Raw: &options})
+			if err != nil {
+				return nil, fmt.Errorf("error listing provisioning requests: %w", err)
+			}
+			return &provisioningRequestList, nil
+		},
+		WatchFunc: func(options metav1.ListOptions) (watch.Interface, error) {
+			var provisioningRequestList provisioningv1alpha1.ProvisioningRequestList
+			w, err := c.hubClient.Watch(ctx, &provisioningRequestList, &client.ListOptions{Raw: &options})
+			if err != nil {
+				return nil, fmt.Errorf("error watching provisioning requests: %w", err)
+			}
+			return w, nil
+		},
+		DisableChunking: false,
+	}
+
+	store := async.NewReflectorStore(&provisioningv1alpha1.ProvisioningRequest{})
+	reflector := cache.NewNamedReflector(provisioningRequestReflectorName, &lister, &provisioningv1alpha1.ProvisioningRequest{}, store, time.Duration(0))
+	slog.Info("starting provisioning request reflector")
+	go reflector.Run(stopCh)
+
+	// Process the incoming events until the context is canceled
+	slog.Info("starting to receive from provisioning request reflector store")
+	store.Receive(ctx, c)
+	return nil
+}
+
+// HandleAsyncEvent persists the state of a ProvisioningRequest received from the Reflector, or deletes it, and notifies
+// the resulting change if any.
+func (c *Collector) HandleAsyncEvent(ctx context.Context, obj interface{}, eventType async.AsyncEventType) (uuid.UUID, error) {
+	provisioningRequest, ok := obj.(*provisioningv1alpha1.ProvisioningRequest)
+	if !ok {
+		// This should never happen since we watch for a specific type
+		slog.Warn("Unknown object type", "type", fmt.Sprintf("%T", obj))
+		return uuid.Nil, nil
+	}
+
+	slog.Debug("handleWatchEvent received for provisioning request", "name", provisioningRequest.Name, "type", eventType)
+
+	record, err := convertProvisioningRequestToRecord(provisioningRequest)
+	if err != nil {
+		// The name of a ProvisioningRequest created through the API is its identifier; filter out any other one.
+		slog.Debug("ignoring provisioning request", "name", provisioningRequest.Name, "error", err)
+		return uuid.Nil, nil
+	}
+
+	if eventType == async.Deleted {
+		err = c.deleteProvisioningRequest(ctx, record.ProvisioningRequestID)
+		if err != nil {
+			return uuid.Nil, fmt.Errorf("failed to delete provisioning request '%s': %w", record.ProvisioningRequestID, err)
+		}
+		return record.ProvisioningRequestID, nil
+	}
+
+	err = c.persistProvisioningRequest(ctx, record)
+	if err != nil {
+		return uuid.Nil, fmt.Errorf("failed to persist provisioning request '%s': %w", record.ProvisioningRequestID, err)
+	}
+
+	return record.ProvisioningRequestID, nil
+}
+
+// HandleSyncComplete deletes the ProvisioningRequest records that no longer have a matching CR.
+func (c *Collector) HandleSyncComplete(ctx context.Context, objectType runtime.Object, keys []uuid.UUID) error {
+	ids := make([]any, len(keys))
+	for i, key := range keys {
+		ids[i] = key
+	}
+
+	records, err := c.repository.GetProvisioningRequestsNotIn(ctx, ids)
+	if err != nil {
+		return fmt.Errorf("failed to get stale provisioning requests: %w", err)
+	}
+
+	for _, record := range records {
+		slog.Info("deleting stale provisioning request", "provisioningRequestId", record.ProvisioningRequestID)
+		if err := c.deleteProvisioningRequest(ctx, record.ProvisioningRequestID); err != nil {
+			return fmt.Errorf("failed to delete stale provisioning request '%s': %w", record.ProvisioningRequestID, err)
+		}
+	}
+
+	return nil
+}
+
+// convertToModel converts a record to the API model stored in the change events
+func convertToModel(object interface{}) any {
+	record, _ := object.(models.ProvisioningRequest)
+	return models.ProvisioningRequestToModel(&record)
+}
+
+// persistProvisioningRequest stores the state of a ProvisioningRequest unless the state reported by the API is
+// unchanged, and notifies the change.
+func (c *Collector) persistProvisioningRequest(ctx context.Context, record *models.ProvisioningRequest) error {
+	existing, err := c.repository.GetProvisioningRequest(ctx, record.ProvisioningRequestID)
+	if err != nil && !errors.Is(err, svcutils.ErrNotFound) {
+		return fmt.Errorf("failed to get provisioning request: %w", err)
+	}
+
+	// The CR is updated far more often than its reported state, so skip the updates that would not be notified.
+	if existing != nil && reflect.DeepEqual(convertToModel(*existing), convertToModel(*record)) {
+		return nil
+	}
+
+	dataChangeEvent, err := svcutils.PersistObjectWithChangeEvent(
+		ctx, c.repository.Db, *record, record.ProvisioningRequestID, nil, convertToModel)
+	if err != nil {
+		return fmt.Errorf("failed to persist provisioning request: %w", err)
+	}
+
+	if dataChangeEvent != nil {
+		c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+	}
+
+	return nil
+}
+
+// deleteProvisioningRequest deletes the stored state of a ProvisioningRequest and notifies the deletion.
+func (c *Collector) deleteProvisioningRequest(ctx context.Context, id uuid.UUID) error {
+	// Delete the stored record rather than the one built from the CR so that the prior state is the one last notified.
+	record, err := c.repository.GetProvisioningRequest(ctx, id)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get provisioning request: %w", err)
+	}
+
+	dataChangeEvent, err := svcutils.DeleteObjectWithChangeEvent(ctx, c.repository.Db, *record, id, nil, convertToModel)
+	if err != nil {
+		return fmt.Errorf("failed to delete provisioning request: %w", err)
+	}
+
+	if dataChangeEvent != nil {
+		c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+	}
+
+	return nil
+}
+
+// convertProvisioningRequestToRecord converts a ProvisioningRequest CR to a DB record
+func convertProvisioningRequestToRecord(provisioningRequest *provisioningv1alpha1.ProvisioningRequest) (*models.ProvisioningRequest, error) {
+	// The provisioningRequestId is used as the name of the ProvisioningRequest CR
+	id, err := uuid.Parse(provisioningRequest.Name)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert name (%s) to uuid: %w", provisioningRequest.Name, err)
+	}
+
+	status := provisioningRequest.Status.ProvisioningStatus
+	record := &models.ProvisioningRequest{
+		ProvisioningRequestID: id,
+		Name:                  provisioningRequest.Spec.Name,
+		TemplateName:          provisioningRequest.Spec.TemplateName,
+		TemplateVersion:       provisioningRequest.Spec.TemplateVersion,
+		Conditions:            make([]models.ProvisioningRequestCondition, 0, len(provisioningRequest.Status.Conditions)),
+	}
+
+	if status.ProvisioningPhase != "" {
+		phase := string(status.ProvisioningPhase)
+		record.ProvisioningPhase = &phase
+	}
+	if status.ProvisioningDetails != "" {
+		record.ProvisioningDetails = &status.ProvisioningDetails
+	}
+	if !status.UpdateTime.IsZero() {
+		updateTime := status.UpdateTime.UTC()
+		record.UpdateTime = &updateTime
+	}
+	if status.ProvisionedResources != nil && status.ProvisionedResources.OCloudNodeClusterId != "" {
+		nodeClusterID, err := uuid.Parse(status.ProvisionedResources.OCloudNodeClusterId)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert OCloudNodeClusterId (%s) to uuid: %w",
+				status.ProvisionedResources.OCloudNodeClusterId, err)
+		}
+		record.NodeClusterID = &nodeClusterID
+	}
+
+	for _, condition := range provisioningRequest.Status.Conditions {
+		value := models.ProvisioningRequestCondition{
+			Type:    condition.Type,
+			Status:  string(condition.Status),
+			Reason:  condition.Reason,
+			Message: condition.Message,
+		}
+		if !condition.LastTransitionTime.IsZero() {
+			lastTransitionTime := condition.LastTransitionTime.UTC()
+			value.LastTransitionTime = &lastTransitionTime
+		}
+		record.Conditions = append(record.Conditions, value)
+	}
+
+	return record, nil
+}
@@ -0,0 +1,124 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package collector
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	provisioningv1alpha1 "github.com/openshift-kni/oran-o2ims/api/provisioning/v1alpha1"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+)
+
+var _ = Describe("Provisioning Collector", func() {
+	var (
+		id                  uuid.UUID
+		nodeClusterID       uuid.UUID
+		provisioningRequest *provisioningv1alpha1.ProvisioningRequest
+	)
+
+	BeforeEach(func() {
+		id = uuid.New()
+		nodeClusterID = uuid.New()
+		provisioningRequest = &provisioningv1alpha1.ProvisioningRequest{
+			ObjectMeta: metav1.ObjectMeta{Name: id.String()},
+			Spec: provisioningv1alpha1.ProvisioningRequestSpec{
+				Name:            "cluster-1",
+				TemplateName:    "sno-ran-du",
+				TemplateVersion: "v4-17-3-1",
+			},
+			Status: provisioningv1alpha1.ProvisioningRequestStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               "Validated",
+						Status:             metav1.ConditionTrue,
+						Reason:             "Completed",
+						LastTransitionTime: metav1.Now(),
+					},
+				},
+				ProvisioningStatus: provisioningv1alpha1.ProvisioningStatus{
+					ProvisioningPhase:   provisioningv1alpha1.StateFulfilled,
+					ProvisioningDetails: "Provisioning request has completed successfully",
+					UpdateTime:          metav1.Now(),
+					ProvisionedResources: &provisioningv1alpha1.ProvisionedResources{
+						OCloudNodeClusterId: nodeClusterID.String(),
+					},
+				},
+			},
+		}
+	})
+
+	Describe("convertProvisioningRequestToRecord", func() {
+		It("converts the status of the provisioning request", func() {
+			record, err := convertProvisioningRequestToRecord(provisioningRequest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.ProvisioningRequestID).To(Equal(id))
+			Expect(record.Name).To(Equal("cluster-1"))
+			Expect(*record.ProvisioningPhase).To(Equal("fulfilled"))
+			Expect(*record.ProvisioningDetails).To(Equal("Provisioning request has completed successfully"))
+			Expect(*record.NodeClusterID).To(Equal(nodeClusterID))
+			Expect(record.Conditions).To(HaveLen(1))
+			Expect(record.Conditions[0].Type).To(Equal("Validated"))
+			Expect(record.Conditions[0].Status).To(Equal("True"))
+		})
+
+		It("leaves the unset status attributes empty", func() {
+			provisioningRequest.Status = provisioningv1alpha1.ProvisioningRequestStatus{}
+			record, err := convertProvisioningRequestToRecord(provisioningRequest)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.ProvisioningPhase).To(BeNil())
+			Expect(record.ProvisioningDetails).To(BeNil())
+			Expect(record.UpdateTime).To(BeNil())
+			Expect(record.NodeClusterID).To(BeNil())
+			Expect(record.Conditions).To(BeEmpty())
+		})
+
+		It("rejects a provisioning request not named after its identifier", func() {
+			provisioningRequest.Name = "cluster-1"
+			_, err := convertProvisioningRequestToRecord(provisioningRequest)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("converts an unchanged record read back from the database to the same state", func() {
+			record, err := convertProvisioningRequestToRecord(provisioningRequest)
+			Expect(err).ToNot(HaveOccurred())
+
+			// The database returns the timestamps in the local time zone
+			stored := *record
+			updateTime := record.UpdateTime.In(time.FixedZone("EST", -5*60*60))
+			stored.UpdateTime = &updateTime
+
+			Expect(reflect.DeepEqual(convertToModel(stored), convertToModel(*record))).To(BeTrue())
+		})
+	})
+
+	Describe("NotificationTransformer", func() {
+		It("sets the consumer subscription identifier of the subscriber", func() {
+			consumerSubscriptionID := uuid.New()
+			notification := &notifier.Notification{
+				NotificationID: uuid.New(),
+				Payload:        generated.ProvisioningRequestChangeNotification{},
+			}
+
+			result, err := NewNotificationTransformer().Transform(&notifier.SubscriptionInfo{
+				ConsumerSubscriptionID: &consumerSubscriptionID,
+			}, notification)
+			Expect(err).ToNot(HaveOccurred())
+
+			payload, ok := result.Payload.(generated.ProvisioningRequestChangeNotification)
+			Expect(ok).To(BeTrue())
+			Expect(*payload.ConsumerSubscriptionId).To(Equal(consumerSubscriptionID))
+			Expect(notification.Payload.(generated.ProvisioningRequestChangeNotification).ConsumerSubscriptionId).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1 @@
+-- No down script required for the baseline
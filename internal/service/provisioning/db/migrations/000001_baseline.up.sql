@@ -0,0 +1,63 @@
+-- Table: event
+-- Description:  outbox pattern table
+CREATE TABLE IF NOT EXISTS data_change_event
+(
+    data_change_id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    object_type    VARCHAR(64) NOT NULL, -- Table name reference
+    object_id      UUID        NOT NUll, -- Primary key
+    parent_id      UUID        NULL,
+    before_state   json        NULL,
+    after_state    json        NULL,
+    sequence_id    SERIAL,               -- track insertion order rather than rely on timestamp since precision may cause ambiguity
+    created_at     TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP
+);
+
+-- Table: subscription
+CREATE TABLE IF NOT EXISTS subscription
+(
+    subscription_id                UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    consumer_subscription_id       UUID,
+    filter                         TEXT,
+    callback                       TEXT    NOT NULL,
+    event_cursor                   INTEGER NOT NULL DEFAULT 0,
+    suspended_until                TIMESTAMPTZ NULL, -- deliveries are suspended after a notification could not be delivered
+    signing_secret                 TEXT    NULL,
+    previous_signing_secret        TEXT    NULL,     -- remains in use until its expiry after a rotation
+    previous_signing_secret_expiry TIMESTAMPTZ NULL,
+    created_at                     TIMESTAMPTZ      DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT unique_callback UNIQUE (callback)
+);
+
+-- Table: dead_letter_notification
+-- Description: notifications that could not be delivered to a subscriber, kept so that they can be redelivered
+CREATE TABLE IF NOT EXISTS dead_letter_notification
+(
+    dead_letter_id  UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    subscription_id UUID    NOT NULL,
+    notification_id UUID    NOT NULL, -- data_change_id of the original notification
+    sequence_id     INTEGER NOT NULL, -- sequence_id of the original notification
+    payload         JSONB   NOT NULL, -- notification as it would have been sent to the subscriber
+    attempts        INTEGER NOT NULL DEFAULT 1,
+    last_error      TEXT    NOT NULL,
+    created_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    updated_at      TIMESTAMPTZ DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (subscription_id) REFERENCES subscription (subscription_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_dead_letter_notification_subscription ON dead_letter_notification (subscription_id, sequence_id);
+
+-- Table: provisioning_request
+-- Description: last known state of each ProvisioningRequest, compared against to detect the changes to notify
+CREATE TABLE IF NOT EXISTS provisioning_request
+(
+    provisioning_request_id UUID PRIMARY KEY,
+    name                    VARCHAR(255) NOT NULL,
+    template_name           VARCHAR(255) NOT NULL,
+    template_version        VARCHAR(255) NOT NULL,
+    provisioning_phase      VARCHAR(64)  NULL,
+    provisioning_details    TEXT         NULL,
+    update_time             TIMESTAMPTZ  NULL,
+    node_cluster_id         UUID         NULL,
+    conditions              json         NULL,
+    created_at              TIMESTAMPTZ           DEFAULT CURRENT_TIMESTAMP
+);
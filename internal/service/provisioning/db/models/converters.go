@@ -0,0 +1,217 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"fmt"
+	"log/slog"
+	"reflect"
+	"slices"
+
+	"github.com/google/uuid"
+
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+)
+
+// ProvisioningRequestObjectType is the API type name of the objects notified to the subscribers
+const ProvisioningRequestObjectType = "ProvisioningRequest"
+
+// ProvisioningRequestToModel converts a DB tuple to the API model reported in the change notifications
+func ProvisioningRequestToModel(record *ProvisioningRequest) generated.ProvisioningRequestState {
+	object := generated.ProvisioningRequestState{
+		ProvisioningRequestId: record.ProvisioningRequestID,
+		Name:                  record.Name,
+		TemplateName:          record.TemplateName,
+		TemplateVersion:       record.TemplateVersion,
+		Status: generated.ProvisioningStatus{
+			Message: record.ProvisioningDetails,
+		},
+		ProvisionedResourceSets: generated.ProvisionedResourceSets{
+			NodeClusterId: record.NodeClusterID,
+		},
+		Conditions: make([]generated.ProvisioningCondition, 0, len(record.Conditions)),
+	}
+
+	if record.ProvisioningPhase != nil {
+		phase := generated.ProvisioningStatusProvisioningPhase(*record.ProvisioningPhase)
+		object.Status.ProvisioningPhase = &phase
+	}
+
+	if record.UpdateTime != nil {
+		// Normalize the timestamps read back from the database so that unchanged states compare as equal
+		updateTime := record.UpdateTime.UTC()
+		object.Status.UpdateTime = &updateTime
+	}
+
+	for _, condition := range record.Conditions {
+		value := generated.ProvisioningCondition{
+			Type:   condition.Type,
+			Status: condition.Status,
+		}
+		if condition.Reason != "" {
+			value.Reason = &condition.Reason
+		}
+		if condition.Message != "" {
+			value.Message = &condition.Message
+		}
+		if condition.LastTransitionTime != nil {
+			lastTransitionTime := condition.LastTransitionTime.UTC()
+			value.LastTransitionTime = &lastTransitionTime
+		}
+		object.Conditions = append(object.Conditions, value)
+	}
+
+	return object
+}
+
+// SubscriptionToModel converts a DB tuple to an API Model
+func SubscriptionToModel(record *models.Subscription) generated.Subscription {
+	object := generated.Subscription{
+		Callback:               record.Callback,
+		ConsumerSubscriptionId: record.ConsumerSubscriptionID,
+		Filter:                 record.Filter,
+		SubscriptionId:         record.SubscriptionID,
+	}
+
+	return object
+}
+
+// SubscriptionFromModel converts an API model to a DB tuple
+func SubscriptionFromModel(object *generated.Subscription) *models.Subscription {
+	id := uuid.Must(uuid.NewRandom())
+
+	record := models.Subscription{
+		SubscriptionID:         &id,
+		ConsumerSubscriptionID: object.ConsumerSubscriptionId,
+		Filter:                 object.Filter,
+		Callback:               object.Callback,
+		EventCursor:            0,
+		SigningSecret:          object.SigningSecret,
+	}
+
+	return &record
+}
+
+// SubscriptionToInfo converts a Subscription to a generic SubscriptionInfo
+func SubscriptionToInfo(record *models.Subscription) *notifier.SubscriptionInfo {
+	return &notifier.SubscriptionInfo{
+		SubscriptionID:         *record.SubscriptionID,
+		ConsumerSubscriptionID: record.ConsumerSubscriptionID,
+		Callback:               record.Callback,
+		Filter:                 record.Filter,
+		EventCursor:            record.EventCursor,
+		SuspendedUntil:         record.SuspendedUntil,
+		SigningSecrets:         notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry),
+		Criteria:               models.NewSubscriptionCriteria(record),
+	}
+}
+
+// getEventType determines the event type based on the object transition
+func getEventType(before, after map[string]interface{}) int {
+	switch {
+	case before == nil && after != nil:
+		return 0
+	case before != nil && after != nil:
+		return 1
+	case before != nil:
+		return 2
+	default:
+		slog.Warn("unsupported event type", "before", before, "after", after)
+		return -1
+	}
+}
+
+// getObjectReference builds a partial URL referencing the API path location of the object
+func getObjectReference(objectType string, objectID uuid.UUID) *string {
+	if objectType != (ProvisioningRequest{}).TableName() {
+		return nil
+	}
+	value := fmt.Sprintf("%s%s/%s", constants.O2IMSProvisioningBaseURL, constants.ProvisioningRequestsPath, objectID.String())
+	return &value
+}
+
+// getConditions indexes the conditions of a serialized ProvisioningRequestState by type.  Only the attributes
+// describing the condition are kept so that a new transition time alone is not reported as a change.
+func getConditions(state map[string]interface{}) map[string]map[string]interface{} {
+	result := make(map[string]map[string]interface{})
+	conditions, _ := state["conditions"].([]interface{})
+	for _, item := range conditions {
+		condition, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		conditionType, ok := condition["type"].(string)
+		if !ok {
+			continue
+		}
+		result[conditionType] = map[string]interface{}{
+			"status":  condition["status"],
+			"reason":  condition["reason"],
+			"message": condition["message"],
+		}
+	}
+	return result
+}
+
+// getChangedConditions returns the sorted types of the conditions that were added, removed, or whose status, reason or
+// message differ between the prior and the post state of a ProvisioningRequest.
+func getChangedConditions(before, after map[string]interface{}) []string {
+	prior := getConditions(before)
+	post := getConditions(after)
+
+	var result []string
+	for conditionType, condition := range post {
+		if value, found := prior[conditionType]; !found || !reflect.DeepEqual(value, condition) {
+			result = append(result, conditionType)
+		}
+	}
+	for conditionType := range prior {
+		if _, found := post[conditionType]; !found {
+			result = append(result, conditionType)
+		}
+	}
+
+	slices.Sort(result)
+	return result
+}
+
+// DataChangeEventToModel converts a DB tuple to an API model
+func DataChangeEventToModel(record *models.DataChangeEvent) generated.ProvisioningRequestChangeNotification {
+	eventType := getEventType(record.BeforeState, record.AfterState)
+	object := generated.ProvisioningRequestChangeNotification{
+		NotificationEventType: generated.ProvisioningRequestChangeNotificationNotificationEventType(eventType),
+		NotificationId:        *record.DataChangeID,
+		ObjectRef:             getObjectReference(record.ObjectType, record.ObjectID),
+	}
+
+	if record.AfterState != nil {
+		object.PostObjectState = &record.AfterState
+	}
+
+	if record.BeforeState != nil {
+		object.PriorObjectState = &record.BeforeState
+	}
+
+	if changedConditions := getChangedConditions(record.BeforeState, record.AfterState); len(changedConditions) > 0 {
+		object.ChangedConditions = &changedConditions
+	}
+
+	return object
+}
+
+// DataChangeEventToNotification converts a DataChangeEvent to a generic Notification
+func DataChangeEventToNotification(record *models.DataChangeEvent) *notifier.Notification {
+	return &notifier.Notification{
+		NotificationID: *record.DataChangeID,
+		SequenceID:     *record.SequenceID,
+		Payload:        DataChangeEventToModel(record),
+		ObjectType:     ProvisioningRequestObjectType,
+	}
+}
@@ -0,0 +1,140 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+)
+
+var _ = Describe("Provisioning request converters", func() {
+	var (
+		id       uuid.UUID
+		changeID uuid.UUID
+		sequence int
+	)
+
+	BeforeEach(func() {
+		id = uuid.New()
+		changeID = uuid.New()
+		sequence = 1
+	})
+
+	state := func(phase string, conditions ...map[string]any) map[string]any {
+		items := make([]any, 0, len(conditions))
+		for _, condition := range conditions {
+			items = append(items, condition)
+		}
+		return map[string]any{
+			"provisioningRequestId": id.String(),
+			"status":                map[string]any{"provisioningPhase": phase},
+			"conditions":            items,
+		}
+	}
+
+	condition := func(conditionType, status, reason string) map[string]any {
+		return map[string]any{
+			"type":               conditionType,
+			"status":             status,
+			"reason":             reason,
+			"lastTransitionTime": time.Now().UTC().Format(time.RFC3339),
+		}
+	}
+
+	event := func(before, after map[string]any) *models.DataChangeEvent {
+		return &models.DataChangeEvent{
+			DataChangeID: &changeID,
+			ObjectType:   ProvisioningRequest{}.TableName(),
+			ObjectID:     id,
+			BeforeState:  before,
+			AfterState:   after,
+			SequenceID:   &sequence,
+		}
+	}
+
+	It("reports the conditions of a new provisioning request as changed", func() {
+		notification := DataChangeEventToNotification(event(nil,
+			state("pending", condition("Validated", "True", "Completed"))))
+
+		Expect(notification.ObjectType).To(Equal(ProvisioningRequestObjectType))
+		payload, ok := notification.Payload.(generated.ProvisioningRequestChangeNotification)
+		Expect(ok).To(BeTrue())
+		Expect(payload.NotificationEventType).To(Equal(generated.ProvisioningRequestChangeNotificationNotificationEventType(0)))
+		Expect(*payload.ObjectRef).To(Equal("/o2ims-infrastructureProvisioning/v1/provisioningRequests/" + id.String()))
+		Expect(payload.PriorObjectState).To(BeNil())
+		Expect(*payload.ChangedConditions).To(Equal([]string{"Validated"}))
+	})
+
+	It("reports the conditions added, removed or transitioned by a change", func() {
+		payload := DataChangeEventToModel(event(
+			state("progressing",
+				condition("Validated", "True", "Completed"),
+				condition("HardwareProvisioned", "False", "InProgress"),
+				condition("ClusterInstanceRendered", "True", "Completed")),
+			state("progressing",
+				condition("Validated", "True", "Completed"),
+				condition("HardwareProvisioned", "True", "Completed"),
+				condition("ClusterProvisioned", "False", "InProgress"))))
+
+		Expect(payload.NotificationEventType).To(Equal(generated.ProvisioningRequestChangeNotificationNotificationEventType(1)))
+		Expect(*payload.ChangedConditions).To(Equal([]string{"ClusterInstanceRendered", "ClusterProvisioned", "HardwareProvisioned"}))
+	})
+
+	It("does not report conditions when only the phase changed", func() {
+		payload := DataChangeEventToModel(event(
+			state("progressing", condition("Validated", "True", "Completed")),
+			state("fulfilled", condition("Validated", "True", "Completed"))))
+
+		Expect(payload.ChangedConditions).To(BeNil())
+		Expect((*payload.PostObjectState)["status"]).To(Equal(map[string]any{"provisioningPhase": "fulfilled"}))
+	})
+
+	It("reports the deletion of a provisioning request", func() {
+		payload := DataChangeEventToModel(event(state("deleting"), nil))
+
+		Expect(payload.NotificationEventType).To(Equal(generated.ProvisioningRequestChangeNotificationNotificationEventType(2)))
+		Expect(payload.PostObjectState).To(BeNil())
+		Expect(payload.PriorObjectState).ToNot(BeNil())
+	})
+
+	It("converts a record to the state reported to the subscribers", func() {
+		phase := "fulfilled"
+		details := "Provisioning request has completed successfully"
+		nodeClusterID := uuid.New()
+		updateTime := time.Date(2025, 1, 15, 20, 32, 28, 0, time.FixedZone("EST", -5*60*60))
+
+		object := ProvisioningRequestToModel(&ProvisioningRequest{
+			ProvisioningRequestID: id,
+			Name:                  "cluster-1",
+			TemplateName:          "sno-ran-du",
+			TemplateVersion:       "v4-17-3-1",
+			ProvisioningPhase:     &phase,
+			ProvisioningDetails:   &details,
+			UpdateTime:            &updateTime,
+			NodeClusterID:         &nodeClusterID,
+			Conditions: []ProvisioningRequestCondition{
+				{Type: "Validated", Status: "True", Reason: "Completed"},
+			},
+		})
+
+		Expect(object.ProvisioningRequestId).To(Equal(id))
+		Expect(*object.Status.ProvisioningPhase).To(Equal(generated.ProvisioningStatusProvisioningPhase("fulfilled")))
+		Expect(*object.Status.Message).To(Equal(details))
+		Expect(object.Status.UpdateTime.Location()).To(Equal(time.UTC))
+		Expect(object.Status.UpdateTime.Equal(updateTime)).To(BeTrue())
+		Expect(*object.ProvisionedResourceSets.NodeClusterId).To(Equal(nodeClusterID))
+		Expect(object.Conditions).To(HaveLen(1))
+		Expect(*object.Conditions[0].Reason).To(Equal("Completed"))
+		Expect(object.Conditions[0].Message).To(BeNil())
+	})
+})
@@ -0,0 +1,53 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
+)
+
+// Interface compile enforcement
+var _ db.Model = (*ProvisioningRequest)(nil)
+
+// ProvisioningRequest represents a record in the provisioning_request table.  It holds the last known state of a
+// ProvisioningRequest CR so that changes to it can be detected and notified to subscribers.
+type ProvisioningRequest struct {
+	ProvisioningRequestID uuid.UUID                      `db:"provisioning_request_id"` // Non-nil because the CR name is the identifier
+	Name                  string                         `db:"name"`
+	TemplateName          string                         `db:"template_name"`
+	TemplateVersion       string                         `db:"template_version"`
+	ProvisioningPhase     *string                        `db:"provisioning_phase"`
+	ProvisioningDetails   *string                        `db:"provisioning_details"`
+	UpdateTime            *time.Time                     `db:"update_time"`
+	NodeClusterID         *uuid.UUID                     `db:"node_cluster_id"`
+	Conditions            []ProvisioningRequestCondition `db:"conditions"`
+	CreatedAt             *time.Time                     `db:"created_at"`
+}
+
+// ProvisioningRequestCondition represents a condition of a ProvisioningRequest as stored in the conditions column.
+type ProvisioningRequestCondition struct {
+	Type               string     `json:"type"`
+	Status             string     `json:"status"`
+	Reason             string     `json:"reason,omitempty"`
+	Message            string     `json:"message,omitempty"`
+	LastTransitionTime *time.Time `json:"lastTransitionTime,omitempty"`
+}
+
+// TableName returns the table name associated to this model
+func (r ProvisioningRequest) TableName() string {
+	return "provisioning_request"
+}
+
+// PrimaryKey returns the primary key column associated to this model
+func (r ProvisioningRequest) PrimaryKey() string { return "provisioning_request_id" }
+
+// OnConflict returns the column or constraint to be used in the UPSERT operation
+func (r ProvisioningRequest) OnConflict() string { return "" }
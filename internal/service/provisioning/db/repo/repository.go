@@ -0,0 +1,40 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package repo
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"github.com/stephenafamo/bob"
+	"github.com/stephenafamo/bob/dialect/psql"
+
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
+	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/models"
+)
+
+// ProvisioningRepository defines the database repository for the provisioning server tables
+type ProvisioningRepository struct {
+	repo.CommonRepository
+}
+
+// GetProvisioningRequest returns a ProvisioningRequest record matching the specified UUID value or ErrNotFound if no
+// record matched; otherwise an error
+func (r *ProvisioningRepository) GetProvisioningRequest(ctx context.Context, id uuid.UUID) (*models.ProvisioningRequest, error) {
+	return svcutils.Find[models.ProvisioningRequest](ctx, r.Db, id)
+}
+
+// GetProvisioningRequestsNotIn returns the list of ProvisioningRequest records not matching the list of keys provided,
+// or an empty list if none exist; otherwise an error
+func (r *ProvisioningRepository) GetProvisioningRequestsNotIn(ctx context.Context, keys []any) ([]models.ProvisioningRequest, error) {
+	var e bob.Expression = nil
+	if len(keys) > 0 {
+		e = psql.Quote(models.ProvisioningRequest{}.PrimaryKey()).NotIn(psql.Arg(keys...))
+	}
+	return svcutils.Search[models.ProvisioningRequest](ctx, r.Db, e)
+}
@@ -24,9 +24,16 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 
+	"github.com/openshift-kni/oran-o2ims/internal/constants"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/clients/k8s"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
+	repo2 "github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
 	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api"
 	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/collector"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/provisioning/db/repo"
 )
 
 // Provisioning server config values
@@ -34,6 +41,9 @@ const (
 	readTimeout  = 5 * time.Second
 	writeTimeout = 10 * time.Second
 	idleTimeout  = 120 * time.Second
+
+	username = "provisioning"
+	database = "provisioning"
 )
 
 // Serve start provisioning server
@@ -60,6 +70,7 @@ func Serve(config *api.ProvisioningServerConfig) error {
 	signal.Notify(shutdown, os.Interrupt, syscall.SIGTERM)
 
 	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
 
 	go func() {
 		sig := <-shutdown
@@ -73,10 +84,54 @@ func Serve(config *api.ProvisioningServerConfig) error {
 		return fmt.Errorf("error creating client for hub: %w", err)
 	}
 
+	password, exists := os.LookupEnv(ctlrutils.ProvisioningPasswordEnvName)
+	if !exists {
+		return fmt.Errorf("missing %s environment variable", ctlrutils.ProvisioningPasswordEnvName)
+	}
+
+	// Init DB client
+	pool, err := db.NewPgxPool(ctx, db.GetPgConfig(username, password, database))
+	if err != nil {
+		return fmt.Errorf("failed to connected to DB: %w", err)
+	}
+	defer func() {
+		slog.Info("Closing DB connection")
+		pool.Close()
+	}()
+
+	// Init the repositories
+	commonRepository := &repo2.CommonRepository{
+		Db: pool,
+	}
+	repository := &repo.ProvisioningRepository{
+		CommonRepository: *commonRepository,
+	}
+
+	// Create the OAuth client config
+	oauthConfig, err := config.CommonServerConfig.CreateOAuthConfig(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create oauth client configuration: %w", err)
+	}
+
+	// Create the notifier with our provisioning-specific subscription and notification providers.
+	notificationsProvider := repo2.NewNotificationStorageProviderWithConverter(commonRepository, models.DataChangeEventToNotification)
+	subscriptionsProvider, err := repo2.NewSubscriptionStorageProvider(commonRepository, collector.NewNotificationTransformer())
+	if err != nil {
+		return fmt.Errorf("failed to create subscription provider: %w", err)
+	}
+	clientFactory := notifier.NewClientFactory(oauthConfig, constants.DefaultBackendTokenFile)
+	provisioningNotifier := notifier.NewNotifier(subscriptionsProvider, notificationsProvider, clientFactory)
+
+	// Create the collector
+	provisioningCollector := collector.NewCollector(hubClient, repository, provisioningNotifier)
+
 	// Init server
 	// Create the handler
 	server := api.ProvisioningServer{
-		HubClient: hubClient,
+		HubClient:                hubClient,
+		Repo:                     repository,
+		SubscriptionEventHandler: provisioningNotifier,
+		DryRun:                   config.DryRun,
 	}
 
 	serverStrictHandler := generated.NewStrictHandlerWithOptions(&server, nil,
@@ -149,6 +204,24 @@ func Serve(config *api.ProvisioningServerConfig) error {
 		}), slog.LevelError),
 	}
 
+	// Start provisioning notifier
+	notifierErrors := make(chan error, 1)
+	go func() {
+		slog.Info("Starting provisioning notifier")
+		if err := provisioningNotifier.Run(ctx); err != nil {
+			notifierErrors <- err
+		}
+	}()
+
+	// Start provisioning collector
+	collectorErrors := make(chan error, 1)
+	go func() {
+		slog.Info("Starting provisioning collector")
+		if err := provisioningCollector.Run(ctx); err != nil {
+			collectorErrors <- err
+		}
+	}()
+
 	// Channel to listen for errors coming from the listener.
 	serverErrors := make(chan error, 1)
 	// Start server
@@ -160,15 +233,26 @@ func Serve(config *api.ProvisioningServerConfig) error {
 		}
 	}()
 
+	defer func() {
+		// Cancel the context in case it wasn't already canceled
+		cancel()
+		// Shutdown the http server
+		slog.Info("Shutting down server")
+		if err := common.GracefulShutdown(srv); err != nil {
+			slog.Error("error shutting down server", "error", err)
+		}
+	}()
+
 	// Blocking select
 	select {
 	case err := <-serverErrors:
 		return fmt.Errorf("error starting server: %w", err)
+	case err := <-collectorErrors:
+		return fmt.Errorf("error starting collector: %w", err)
+	case err := <-notifierErrors:
+		return fmt.Errorf("error starting notifier: %w", err)
 	case <-ctx.Done():
-		slog.Info("Shutting down server")
-		if err := common.GracefulShutdown(srv); err != nil {
-			return fmt.Errorf("error shutting down server: %w", err)
-		}
+		slog.Info("Process shutting down")
 	}
 
 	return nil
@@ -28,6 +28,13 @@ const (
 	Oauth2Scopes = "oauth2.Scopes"
 )
 
+// Defines values for HardwareResourceChangeNotificationNotificationEventType.
+const (
+	HardwareResourceCreate HardwareResourceChangeNotificationNotificationEventType = 0
+	HardwareResourceDelete HardwareResourceChangeNotificationNotificationEventType = 2
+	HardwareResourceModify HardwareResourceChangeNotificationNotificationEventType = 1
+)
+
 // Defines values for InventoryChangeNotificationNotificationEventType.
 const (
 	N0 InventoryChangeNotificationNotificationEventType = 0
@@ -83,6 +90,28 @@ type DeploymentManager struct {
 	SupportedLocations []string `json:"supportedLocations"`
 }
 
+// HardwareResourceChangeNotification Resource change notification sent by a hardware plugin to its inventory subscribers
+type HardwareResourceChangeNotification struct {
+	// ConsumerSubscriptionId The value provided by the consumer in the subscription
+	ConsumerSubscriptionId *openapi_types.UUID `json:"consumerSubscriptionId,omitempty"`
+
+	// NotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+	NotificationEventType HardwareResourceChangeNotificationNotificationEventType `json:"notificationEventType"`
+
+	// NotificationId A unique identifier to represent this notification event
+	NotificationId openapi_types.UUID `json:"notificationId"`
+
+	// Object The changed resource, as defined by the ResourceInfo schema of the hardware plugin inventory API.
+	Object *map[string]interface{} `json:"object,omitempty"`
+
+	// ObjectRef The URL to the resource in the inventory API of the hardware plugin. This is not provided if the
+	// notificationEventType is 2 (DELETE).
+	ObjectRef *string `json:"objectRef,omitempty"`
+}
+
+// HardwareResourceChangeNotificationNotificationEventType One of the following values: 0 - create, 1 - modify, 2 - delete
+type HardwareResourceChangeNotificationNotificationEventType int
+
 // InventoryChangeNotification Information about an inventory change notification
 type InventoryChangeNotification struct {
 	// ConsumerSubscriptionId The value provided by the consumer in the subscription
@@ -237,10 +266,22 @@ type Subscription struct {
 
 	// Filter Criteria for events which do not need to be reported or will be filtered by the subscription
 	// notification service. Therefore, if a filter is not provided then all events are reported.
+	// The filter uses the same syntax as the filter query parameter. A change is reported if the filter
+	// matches either the prior or the post state of the object. The objectType attribute holds the type of
+	// the object (e.g. ResourcePool).
 	Filter *string `json:"filter,omitempty"`
 
+	// SigningSecret Optional shared secret used to sign the notifications sent to the subscriber. When set, each notification
+	// carries an HMAC-SHA256 signature in the X-O2ims-Signature header. The secret is never returned.
+	SigningSecret *string `json:"signingSecret,omitempty"`
+
 	// SubscriptionId Identifier for the Subscription. This identifier is allocated by the O-Cloud.
 	SubscriptionId *openapi_types.UUID `json:"subscriptionId,omitempty"`
+
+	// VerifyCallback Requests a verification handshake with the callback before the subscription is created. A
+	// CallbackVerification challenge is POSTed to the callback, and the subscription is rejected unless the
+	// challenge is echoed back.
+	VerifyCallback *bool `json:"verifyCallback,omitempty"`
 }
 
 // DeploymentManagerId defines model for deploymentManagerId.
@@ -755,11 +796,20 @@ type GetSubscriptionsParams struct {
 	Filter *externalRef0.Filter `form:"filter,omitempty" json:"filter,omitempty"`
 }
 
+// HwInventoryNotificationJSONRequestBody defines body for HwInventoryNotification for application/json ContentType.
+type HwInventoryNotificationJSONRequestBody = HardwareResourceChangeNotification
+
 // CreateSubscriptionJSONRequestBody defines body for CreateSubscription for application/json ContentType.
 type CreateSubscriptionJSONRequestBody = Subscription
 
+// RotateSubscriptionSigningSecretJSONRequestBody defines body for RotateSubscriptionSigningSecret for application/json ContentType.
+type RotateSubscriptionSigningSecretJSONRequestBody = externalRef0.SigningSecretRotation
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
+	// Receive hardware inventory changes
+	// (POST /internal/v1/hardware-inventory/{hwPluginName})
+	HwInventoryNotification(w http.ResponseWriter, r *http.Request, hwPluginName string)
 	// Get API versions
 	// (GET /o2ims-infrastructureInventory/api_versions)
 	GetAllVersions(w http.ResponseWriter, r *http.Request)
@@ -805,6 +855,15 @@ type ServerInterface interface {
 	// Get subscription
 	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
 	GetSubscription(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId)
 }
 
 // ServerInterfaceWrapper converts contexts to parameters.
@@ -816,6 +875,37 @@ type ServerInterfaceWrapper struct {
 
 type MiddlewareFunc func(http.Handler) http.Handler
 
+// HwInventoryNotification operation middleware
+func (siw *ServerInterfaceWrapper) HwInventoryNotification(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "hwPluginName" -------------
+	var hwPluginName string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "hwPluginName", r.PathValue("hwPluginName"), &hwPluginName, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "hwPluginName", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.HwInventoryNotification(w, r, hwPluginName)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 // GetAllVersions operation middleware
 func (siw *ServerInterfaceWrapper) GetAllVersions(w http.ResponseWriter, r *http.Request) {
 
@@ -1414,6 +1504,99 @@ func (siw *ServerInterfaceWrapper) GetSubscription(w http.ResponseWriter, r *htt
 	handler.ServeHTTP(w, r)
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-reader"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (siw *ServerInterfaceWrapper) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ReplaySubscriptionDeadLetters(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (siw *ServerInterfaceWrapper) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "subscriptionId" -------------
+	var subscriptionId SubscriptionId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "subscriptionId", r.PathValue("subscriptionId"), &subscriptionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "subscriptionId", Err: err})
+		return
+	}
+
+	ctx := r.Context()
+
+	ctx = context.WithValue(ctx, Oauth2Scopes, []string{"role:o2ims-admin", "role:o2ims-maintainer"})
+
+	r = r.WithContext(ctx)
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RotateSubscriptionSigningSecret(w, r, subscriptionId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
 type UnescapedCookieParamError struct {
 	ParamName string
 	Err       error
@@ -1534,6 +1717,7 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 		ErrorHandlerFunc:   options.ErrorHandlerFunc,
 	}
 
+	m.HandleFunc("POST "+options.BaseURL+"/internal/v1/hardware-inventory/{hwPluginName}", wrapper.HwInventoryNotification)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/api_versions", wrapper.GetAllVersions)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1", wrapper.GetCloudInfo)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/api_versions", wrapper.GetMinorVersions)
@@ -1549,10 +1733,46 @@ func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.H
 	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions", wrapper.CreateSubscription)
 	m.HandleFunc("DELETE "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}", wrapper.DeleteSubscription)
 	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}", wrapper.GetSubscription)
+	m.HandleFunc("GET "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters", wrapper.GetSubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters/replay", wrapper.ReplaySubscriptionDeadLetters)
+	m.HandleFunc("POST "+options.BaseURL+"/o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/signingSecret/rotate", wrapper.RotateSubscriptionSigningSecret)
 
 	return m
 }
 
+type HwInventoryNotificationRequestObject struct {
+	HwPluginName string `json:"hwPluginName"`
+	Body         *HwInventoryNotificationJSONRequestBody
+}
+
+type HwInventoryNotificationResponseObject interface {
+	VisitHwInventoryNotificationResponse(w http.ResponseWriter) error
+}
+
+type HwInventoryNotification200Response struct {
+}
+
+func (response HwInventoryNotification200Response) VisitHwInventoryNotificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(200)
+	return nil
+}
+
+type HwInventoryNotification400Response struct {
+}
+
+func (response HwInventoryNotification400Response) VisitHwInventoryNotificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(400)
+	return nil
+}
+
+type HwInventoryNotification500Response struct {
+}
+
+func (response HwInventoryNotification500Response) VisitHwInventoryNotificationResponse(w http.ResponseWriter) error {
+	w.WriteHeader(500)
+	return nil
+}
+
 type GetAllVersionsRequestObject struct {
 }
 
@@ -2401,8 +2621,197 @@ func (response GetSubscription500ApplicationProblemPlusJSONResponse) VisitGetSub
 	return json.NewEncoder(w).Encode(response)
 }
 
+type GetSubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+type GetSubscriptionDeadLettersResponseObject interface {
+	VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type GetSubscriptionDeadLetters200JSONResponse externalRef0.DeadLetterQueue
+
+func (response GetSubscriptionDeadLetters200JSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitGetSubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLettersRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+}
+
+type ReplaySubscriptionDeadLettersResponseObject interface {
+	VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error
+}
+
+type ReplaySubscriptionDeadLetters202Response struct {
+}
+
+func (response ReplaySubscriptionDeadLetters202Response) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.WriteHeader(202)
+	return nil
+}
+
+type ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters400ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters401ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters403ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse) VisitReplaySubscriptionDeadLettersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecretRequestObject struct {
+	SubscriptionId SubscriptionId `json:"subscriptionId"`
+	Body           *RotateSubscriptionSigningSecretJSONRequestBody
+}
+
+type RotateSubscriptionSigningSecretResponseObject interface {
+	VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error
+}
+
+type RotateSubscriptionSigningSecret200JSONResponse externalRef0.SigningSecretStatus
+
+func (response RotateSubscriptionSigningSecret200JSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret400ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret401ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret403ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(403)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse externalRef0.ProblemDetails
+
+func (response RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse) VisitRotateSubscriptionSigningSecretResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(500)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
 // StrictServerInterface represents all server handlers.
 type StrictServerInterface interface {
+	// Receive hardware inventory changes
+	// (POST /internal/v1/hardware-inventory/{hwPluginName})
+	HwInventoryNotification(ctx context.Context, request HwInventoryNotificationRequestObject) (HwInventoryNotificationResponseObject, error)
 	// Get API versions
 	// (GET /o2ims-infrastructureInventory/api_versions)
 	GetAllVersions(ctx context.Context, request GetAllVersionsRequestObject) (GetAllVersionsResponseObject, error)
@@ -2448,6 +2857,15 @@ type StrictServerInterface interface {
 	// Get subscription
 	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId})
 	GetSubscription(ctx context.Context, request GetSubscriptionRequestObject) (GetSubscriptionResponseObject, error)
+	// Get the dead letter queue of a subscription
+	// (GET /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters)
+	GetSubscriptionDeadLetters(ctx context.Context, request GetSubscriptionDeadLettersRequestObject) (GetSubscriptionDeadLettersResponseObject, error)
+	// Redeliver the dead letter queue of a subscription
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/deadLetters/replay)
+	ReplaySubscriptionDeadLetters(ctx context.Context, request ReplaySubscriptionDeadLettersRequestObject) (ReplaySubscriptionDeadLettersResponseObject, error)
+	// Rotate the signing secret of a subscription
+	// (POST /o2ims-infrastructureInventory/v1/subscriptions/{subscriptionId}/signingSecret/rotate)
+	RotateSubscriptionSigningSecret(ctx context.Context, request RotateSubscriptionSigningSecretRequestObject) (RotateSubscriptionSigningSecretResponseObject, error)
 }
 
 type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
@@ -2479,6 +2897,39 @@ type strictHandler struct {
 	options     StrictHTTPServerOptions
 }
 
+// HwInventoryNotification operation middleware
+func (sh *strictHandler) HwInventoryNotification(w http.ResponseWriter, r *http.Request, hwPluginName string) {
+	var request HwInventoryNotificationRequestObject
+
+	request.HwPluginName = hwPluginName
+
+	var body HwInventoryNotificationJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.HwInventoryNotification(ctx, request.(HwInventoryNotificationRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "HwInventoryNotification")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(HwInventoryNotificationResponseObject); ok {
+		if err := validResponse.VisitHwInventoryNotificationResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetAllVersions operation middleware
 func (sh *strictHandler) GetAllVersions(w http.ResponseWriter, r *http.Request) {
 	var request GetAllVersionsRequestObject
@@ -2872,117 +3323,230 @@ func (sh *strictHandler) GetSubscription(w http.ResponseWriter, r *http.Request,
 	}
 }
 
+// GetSubscriptionDeadLetters operation middleware
+func (sh *strictHandler) GetSubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request GetSubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetSubscriptionDeadLetters(ctx, request.(GetSubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetSubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetSubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitGetSubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// ReplaySubscriptionDeadLetters operation middleware
+func (sh *strictHandler) ReplaySubscriptionDeadLetters(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request ReplaySubscriptionDeadLettersRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ReplaySubscriptionDeadLetters(ctx, request.(ReplaySubscriptionDeadLettersRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ReplaySubscriptionDeadLetters")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ReplaySubscriptionDeadLettersResponseObject); ok {
+		if err := validResponse.VisitReplaySubscriptionDeadLettersResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RotateSubscriptionSigningSecret operation middleware
+func (sh *strictHandler) RotateSubscriptionSigningSecret(w http.ResponseWriter, r *http.Request, subscriptionId SubscriptionId) {
+	var request RotateSubscriptionSigningSecretRequestObject
+
+	request.SubscriptionId = subscriptionId
+
+	var body RotateSubscriptionSigningSecretJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RotateSubscriptionSigningSecret(ctx, request.(RotateSubscriptionSigningSecretRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RotateSubscriptionSigningSecret")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RotateSubscriptionSigningSecretResponseObject); ok {
+		if err := validResponse.VisitRotateSubscriptionSigningSecretResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+x9+3LbOLL3q6D0fVWb7BFlSZZl2Vtbp7y2M6OaOPbxZfZsRakxSDYtbEiAAUA72hlX",
-	"7YOc83L7JKdw4Z3UxXYyyZbyT2yJBLobjV//0A3Av3Y8FsWMApWic/hrJ8YcRyCB6988FkWM/oJj8guL",
-	"gar/cRi+IRD6+nsfhMdJLAmjncPO9ZwIdHM5RZ8S4AuUNYU4fEpASIHkHEuEwxCpTkP4jLCUnLiJBIEw",
-	"B0SoFyY++IhQJOeAOIiYUQG9GZ3R29vbGcVh+Eug+7cfdLodojrXfXa6HYoj6Bx28uc63Y7w5hBhI3CA",
-	"k1B2DjsBDgWo55MwxG4InUPJE+h25CJW7wvJCb3rPD52m4wAn7WcbYY4ZlGEkQBlAQk+ComQiAVIC4Q4",
-	"BMCBeiCQZMg2hQLOolTnJJRa41PszasvISIQth8qXbuIcaQ6+5Tor7Nu1JeiIIS7QCLEYg6ih94wPqPw",
-	"GatB6BalUALceiyhki9ukUhc0xYLzDfwWQIVhFFxa3o5zAbGtmCN/uf8yR3bnH1uRv86BzW6RBQ8hAj6",
-	"B4kSAT6izCrwQMIQuZDK5muTGJMb/yDCWLb6IIJ7oIhomRfar+BzHBKPyHCRu1giCL1Tj8zorRH6Nheo",
-	"px3LWqhzqL2qW9epxfnKtig54FruFbyAX1k9CzPp63vVHUjjN+ot6zEIU/8Zbmbdq2U81vUxBUGqJ9Na",
-	"5kAcZMKp9rRnjP7TRz2UwOujfgWYe3PkcSKBE6zH8JhRiQkViFFQQxUxDkiUH+xWhgki4rGQUdFD2gUq",
-	"j2sXmFGZxCEgz7SvZgimiMXAsWS8m/lI7jhqOItC3OMwUc5wPYfsPeRhOqOueniRDnLAwpA9qA6MVYQe",
-	"49/QefrOb+gMsJbgKf9+m9HfnOxf4ccn/FNtKXel8la1jM6w9OYgLMJYi3jpiKiPtBFa5UK38OnW/Nbc",
-	"FhEIPiU4VHNoSXOmrTu5qq07DlhNADnHtK29tC243aAtxhvlNG0Rukou7TZB/qZotVe4UscQhFiqYKGt",
-	"VTrmbVUVzNs2bVHrFC1t+QwEokymztEim23LOkW7XKqlVX5h27LGX97WKvv/pmbkdfZWKViolxTeqQYK",
-	"7VhAtb8x9+/gyXosmdH0Vft8azxBxXCSiAaC4liVqCA+zOjq+KFA9s+v4FMDoHdP/+t1FkKuc7MoCqEa",
-	"xvwuiRRTzhS0YFWVVQvx6bYAgCyKMQcxo94cvI/ZeJgRZCsnfy+VSE8rhblmjNMOBBJJHDMuUZSEkigI",
-	"T4G4akUtQNp/ZsoZrdqyJRRr+YicA0e3p1e3amxvb67qBia00cBX3Zur1+UwbY2czhEVGbHopm6gOhAx",
-	"1qxG0TkK4Cs1XEAi4Zwl1LduQ+hdCOhTwiSI3owu17vISKw7mziEbqMF8sJESOC3jX6j2cAf8qf+UNEn",
-	"G4EssrbEYe1Xio90NSExXhChKBESRWreooBxw1DNeknqwOwTRQyUSvqhBt/LY6tmNk2aE7V+KmiK/oip",
-	"/8fK9MoGUJlIjfaa9vhT2/SqDv1qhmZ462qKlgmSy/G6lZ9pnrWcn/kQh2yhJvsZpvgO+NSvM7MbSj4l",
-	"gIgPVJKAAFdjiFH+LorMy1Vpx3vD4WBvPHImbn/PGQ3G2HEDb9fxhnt91xuPYIBxKn2M5TwXvkmubkct",
-	"sAkHP13E5poFjEdYrXeThKgn65pyECzhHmygYPpKVa3die+5413XcQdB3xn5Q8+ZTNzA2RuPRuPxfh+C",
-	"/qBZrYIQL6PNBWPhEzRCMWPhy6tlpXkZ1a4X8VMGC6kWa6oN8Phgb3/P2Q0O+s4I3D3HnQTYmQQTGO4G",
-	"Bwde0F+umpXmeaqJxM002UC14mtVzTCe7Pp9Fzt4D8AZBYPAcWEycoLd3ZE7HAzGYy9o1qwizHM0e0wf",
-	"1ov5k+rcrSs6paZJhbnYZYlcAicxV9FfEjCpOhxjl4Qk/R37Jk7g8KL0XEXE7koBFAoXG0+Zholm6ttc",
-	"L2QV0wyFSEXsdIKipoL6cUYF8HuiIruLFeazLFWhPUuoIMA8HTctF6r3ZExhlTJ8UymlBPaIXLy4JfA9",
-	"Jjpf2C1Ip7TloLQBH6VdK73PneOQJT66bFVpRtfWaa2QNM2niCUPTUZDOlVbmE6kKJkdVit87ymRa8XE",
-	"qNi6qsSPSYQp4oB9ZWlU+DKlyvU5UZLyLCMBTX3nzKTe9VubUotAYh9LjD7CwjEsPcaEC8NOJENYCOYR",
-	"LAFFJk0RJGH+lvVXDqG26NpjbCDohexRU5zpAV3TbQopoEZPGI6H3iQY7Dt7Q3fPGY0HI+cA9sbOZDDE",
-	"MBwEeIL31/EECwI3nDRVFAAFSRgukFoRKfl8XWBQ9q8bVf+oZ6HR4nzoRwKlINNDV0QtP7Vr629izu6J",
-	"DwLNaMbZ06e7hpuC4q1qdqRW+RXH5JIx+YgYDRfVoDOXMhaHOzvRomf973A8Gu02qp2i6Fs165Y7413I",
-	"XBymD05PbCHlATgoPyR3NIfIc4M4V0TCK/EaPcyJNze6NMC0FUIYPYiEqBkZ7QeYc7ywXCQNie9bKKn2",
-	"5PI8LzhgadgbjdEtR7QCpH9omDdTeg9UMr44nmN6B++YcmbT1FpBliKStoA83QSixTZq0ZZRkUTAr1YQ",
-	"lyyJkDpbhq5pC2mCvsg69GisnDhFAU+V8Nf6iaoI54XsTLYuNGvuQ9RHDvJ0Sq+LBshBEfNJsOiiIXKQ",
-	"D2rFaTycJlHn8H2/O+gOc/MTKkEhTUWWJjscoaRG4SRDHGIVN6k0HlpsRRdx5HqWMH5wCUHzANxcvk1n",
-	"h3kyjX8mDZb6crpCbrSreniIXp2cvj29Pn3dQ1NbdooZUdKzGWVNdjbBYBGDQD4EhJrSphfiRADa7Q17",
-	"46wekqfXdMMmAaCTjqp7FljZxYyq2RIS01TMCePn+psrqaIRpv4O4yhmQhY+LoWb3HCVp1pKukSsb6M+",
-	"enV8eXp0ffoaMY4G6NXZ+cn0zd9eG0ZYyvmWrTSjq8201DDLrJE+PaPaytzAJaEo85yWeFxt8AUsVLAJ",
-	"4wWfWmahGV3TkVZbqDzizzRQJRJUUKANopoA3IQthcx6F0IJbJ/JFVMSjkUNhK/OzhGWyNPf3wEFQUSv",
-	"ziRZ4q/mkdk7KjqY+uBh5/Sq0+3ME1dxg8TtF2JprrqJ7t4a1KyiEKFCYl2DSzlArlYjxTc9hYsUjLHH",
-	"mRBZezOatijQR8oeaIqaeXsmlj2sacrUpYVkPN/UYbub0enZFcoCd5VKTbyBN9gb+M5wcnDgjLyDsePu",
-	"jwNnFMDBsD8euXv77lpRch1GnZYjKu6SWW8Dh4kWTqvDrMW/Wwa5h6ZUAqd6/FTPJqH8QOScUEVgai/8",
-	"LmxdsXPOmNQUPQwzPl3zl/MhiQRSFIIH2KiXErASCIqck/dqXPtwZ0ctWsM5E/Jw0u/3WxJnOUQVGGh5",
-	"3rUw1oK+TbCVLuzXy+SU86YvCnJZ01VnpMyHRvAK9XpJNA9jljSMyN1cIhd0BYvpLE2ARITDUO/rStMa",
-	"jCOma0LZizmUmJw4CTS9kTrMFBcb/58r3tb5fzv5RrQdmzTbycxbW4P8Hqv4oo1bUPxICJCrJjhXHk1w",
-	"iGgSufmMT5vvKgqR5Zk01SzyC7XuTElGZu05FsgFoArDc9DyE13RIlKgNAmW6uSlGKe8Uq29tGNhJX6K",
-	"0wrrSBs8u+NgvO/tD53JwWDPGe2PXMfdHR8448HBBPAg2HfHQZPb3XGWxA0j9hMsHhj3FbtRpIHeIfNk",
-	"MYHtQsjonUCS9TZYrS6vcDQkPlKn2zhLthI868WJQib+wJvswf7IGcLBxBnBru9MAvAc2MOT0YF/MN73",
-	"xpv00VYlWKIwurZ0US/GmhFlb7y7u+/3wZm4AM5o3991cOC5zq43Hg68IMBDd604IvHdci9QH7vKDxhX",
-	"NFcIEizshr06yDw9eVEpPNXKNZUiR3mWV4NFhqlWv8zdS2i1LI6onjeLJYWK1RcJKKb9byON2iZTFYWz",
-	"TJnftl4rzWmPxQotsx24KZOa+k3MOkXV4pN5Cmwd3w/Z+pkp1csdsDuO4znxcIjSlxvHSQG6DxK8VWn8",
-	"05sX4cq1Aanw4NYU/DeZiV5VO14GneqdygKnQN2fXG7xd93hPgxGzmhvcuCM/INdB8P+2PF9D+/tHQwO",
-	"dmGNcksL5mUwVyPFhQnUyIuXQVhzFnIphOWV6TKE4RDz6IR4unrHF6vYYsOe2qNKC8+vPJWF/sZwsSJT",
-	"PjgR8yFcF2/0wzV9cZ55cheaU9olHS+77H87g8aF2HPgJdWrAC/gkyRaNouPFWmod/cmocYbQsRZ2NxV",
-	"uqrOiHIvz4F3bt6dnL6Zvjs96XQ7x+dnFzfXp51u593p9V/PL3+avvuh0+1cXZ9fHv1wqqZJLnH+bKvI",
-	"PxHaADs/a/coMKJ//fN/4vlCqHBA5OJf//zfdns1yHzx49+upsdHbzvdztvzH/RPJTkL3784uXwOEmJv",
-	"z9sf9Z3d0X7fGeFx4GBvcuDg4f5+f3BwEIwnw3VA/h6ozxp2XlxYZ05tedlIf69YBOiY8ZhxPWu6aEq9",
-	"XnM/XDRCzM/mC8VrbRqnCV3WnW33w/5w1BsM1gb9jMo2pjusdVLAyNWoOGl1mq0kuMVC2cYVufo+n+re",
-	"lzB0sfdxwwJyVoKLOfPATzjYcqmHqflMqPXnBTOYrcZnRrM0lc5nFwuNbcVgEbGe/bTnsUj9vnM/2GEa",
-	"WX7JtPyFuaZ23eRN69Ybm9mT0ZIFpqYmkK64+Qlk+d2ifdeZRG1HVY7THa+qc9uZManPdK2tsHvXpB3A",
-	"VxMh3d1p2s2RoDjwaEZL9UGbmdMnTTgEjNvEiW0kre5leUc5B6pTklYuzHMZWspjYnNrl0z51bfZqCh6",
-	"TsNF+2HGIiRk06ZpwjaRqYvpz22o1sTv7lOkM3Pn6GLaNHkLQJnrP+j1e83J3M0EFetJmh6ks7KIFSLj",
-	"mBTbz8R+X9DGqvD4Yc1U53J7N2S1Ek4uOATkc9lyO2xIIuEQGnAsJE88mXDIQGvnfvB0q2ourYIS2QDF",
-	"NYc3sYyk+NLA8o+yTXpth2q/BnVuLgJrAc3GkrbFja9QyRxRyIqudh+J3qrDPC/RVdVC8sBYJsRC2kf/",
-	"hLDvg9+1Oy/8rtmOQbLd7pbNHZ2caCZnCsfqJ11Qnp6eFGZyjmK4PG5NQHaR7oTCDfs07Fww4rqgxOeY",
-	"CPDzbRTq+wtOIswX6CdYIEKtmbXPoHwBtt5+DivxEsJQEDhk9A54vlS7z8a9LHl+cgJTX2+rra3tVKi0",
-	"p5RSCJjRytu50oRK0IdP0niGNaYXzkvqM7BKIMVmfMCqzQflDnMcx0DtzlGs4rEw56KUFBAE4EnRLYnT",
-	"NQctdY2FRDHWGR7MAWdYJRZCQtQSybQSb7GQxo1XuXB12FBKWgkt7iirebC/rPt3jcu/bCTFnHFpln9p",
-	"MNWvNbfohYDVzy0TMvVeRT9AG83ISgTSbyrjJZIpsPJ0RZVxFGGaqJ8rk+3m+vzs6Hp6rKbZ0bsbs1yq",
-	"yZNva56mJc2miZaBWL5hMSuBInYP3JpXS2sPnnFMRUSkGnBjGCLQKZVELsyaakYvT6+uL6fH19Pzd4f6",
-	"NFApi3l2ha7SKqzMi/j69HxE7Fbk8+H07KqyDTA1gf6uUetqTIo/FgmxRvIVg0Oys+iMl8+zizSdZkau",
-	"xP1Kh21jCzwfYYFeXfz0OkOfGa35cWbAzOp/QqQHvZIkpdZtExl8ounJZrslTbhjAvxLUIHqyGvZ7ZnN",
-	"hLuE+Hpfh5I2fVlxVUw4wub1xolW4Xd14C/OxDoo1CNdAxS3qVOZkm0zotlHPmzCQEr5wPUZSPZaCwMp",
-	"M5snc7ZKUw3uUMlqtm0brW8JqXqzOWQ/LS0vbPiubQvpbRJ5sx6utKI/r0xlNMuHzOsKXmpBIx8ONd2U",
-	"XGJZ7MgbfaowvTyGCWTSHFmGRSRaOGzSn5VlS1FSTJGrKEHKMM3+GxXBY/CUT1dfFiyQDwrFfQjJPfBF",
-	"Vl6NOfMTT7YoDRrfWzb0OpdH75B5wpBNUOGhRCsPDUsRc2wW1tYtYuCp7sV0fc9kfDH1Z7T0udWmWcbf",
-	"MeQhtA1633jQa8uz/mzcz86PwqAamNAlWCLqWIL1nmfRSx2bJaGvPFvPMkUazQDjzII1V9b9ZhdOrR0/",
-	"i0DdikYrkLM0oYtZ1g1iZG0ht27UvODMDSE6AYlJKOrba/PzcUfZbVzPODd3RBeFjU15I4W7vrrF9LYK",
-	"Uxl+2mQ4twspEsVmSwVOUwcNp+KUWk0nDuZJhKmTFZbgcxxianOHKVzrOUNEuj63Vx2ZCaatVk7LHTNK",
-	"wUv3UflYYhcLQJJE4COWyCaYzPaoNIiod0tm+/51BCI5UGjXTiVtlxDN6FSiCC/QQs/kIOFmmVggQyRA",
-	"PmQ91diAPoRTT39KLJOWDYI/Xl9fIPMA8pgPOcgsNWX95IgkMmy0jV4PdqujKJJI4165aZO9QVOZAoO+",
-	"dMXkX/S2joJQkrWL2NX3mEEsDfVOuCK6eoWtt5mSfxg/RNPABF4i9PqeFhbn+t6YWUezz0M3xPTjrGMP",
-	"k2UTwMZlHAqdOUhT1C1cQDaygKrzYM9j3NckgKHp6fUbdPnmGO0eTMbo/e6HRt+qGU8fePNYwvGdTphb",
-	"QqQ6Ss/KzWhlQHzmJdkMzVbuadOvoHfXM1et/Xh99va1Wo7Tsiui/CaICDRsZJl7fTSoO6NEFuI3FiKJ",
-	"sqxLxdJtNZjUBQs27HksWjkJKhHBzogMdT40nYgQ4CWcSBMCDGQynMj5sGUz0tHFFCXKzc6PEjlHQ8Mp",
-	"Te2ZKLrkcdADh0OBgpA96BJNyB5M7Us/c5w/oj4UHotNz5yFcGhSwtiP9Gl3zWzQkfoNXbJQBaTCU2pg",
-	"gWePXepfG56ztRG38OxV9pF5Xvku+wj0hoeFofgICy9k+GOpJsYBh5HYYRxTNVaSeSzcUQGM+I5nQHdH",
-	"t1XKVhur6mP28Nlsjj9hnmijzGbLeRZp0VUp6Oz3+ujVuSeZkn/YH45ed7qdpCR6KUqJHnM4pj3G73Z8",
-	"9kBDhv3/JP6f90cHBvbNUZbKrL2Y2qOp5vBDMUmf74DXThwSD6jQM99eTHAUY28OaKgrI2XJHh4eelh/",
-	"reWx74qdt9Pj03dXp86w1+/NZRQWELezXAblloX6b1bP6HYsr+gcdnZtlSbGcq6tvqL4oNjIfaFwcgey",
-	"bqJLfcOLsAlZe+1NSpKV/bIiTcYOCgVDWxTUFjRpWJvy7vwA8igMs7qNrmDry0W1KMN+357rlEClKfLE",
-	"oR3qnb8Ls+LMb354cilHGH+tXPGXeB4IYSrVzFVE3FQs6xZItVcqPnY7o6VyW9j7j2fLX+GQDSr8Bfvp",
-	"Pa9GrsG3IdcNVSjBOPkH+Eaw3W9DsDeMu8T3QQ/j3rcyjOkho/QcPXDOeK8U13SdM41o7+sRpimcfHj8",
-	"0O1Y7mbmYmkqpxumD9930kJd54Pqc3U1cx0YMdFatB6takaL/ERit3Qt8vtms+eP7Cy9Nvmx+5T3yzcO",
-	"P60NezGpGoovhn2Fc5wb4dwaQ7RFuy3afbdolzu0xpMnot3mDCqlDRGhjLfTp2z1HuG/M966c6eGkWeq",
-	"2W+aU22BYwsc3zNw1CfuM+CjdlXPZiBSv2VKtODCSb2jfzMS9cSX9b7ZZzOwtarT9esG64chN2BoK5xg",
-	"C7JbkP1uQbbBpwso24CaT8bbnV8brkt73HQV234p6Gok3hiIm+53+6JryAbgesZSstlSW7j6d4GrUX/0",
-	"bUh1nZdrwU/35zxgU8wKWEL93hZeC7eTPhtdi+eYNyOypXPrbRz2stT8lr5+ZfpauhLjRZhrfdS3UWBL",
-	"Wr9bVC27cwFRy7j4FDDd+bV8R8QTCGrD/TRLEXZjgK3cYvFFGWkZi55BRmtW2SLQlodueehXQswKKn1Z",
-	"yMy+FpuCZ36tot4buwmSimfDaHfLbL8Ws31ZVrsltNtwsg0nvwcBb8Lphtjy4nEl/+p5DH3jKPMVgkzh",
-	"Xs6vwuxfhNVvEXiLwFsE/n0I/ZeB4OtFDE9MLes/1rECSE3z29Ty70TA9XHMl00tZ6O+jQPb1PLXwNUv",
-	"mlmWFp+qOGpw6ylYmnNWcxHlc3hrfmXXUoB9Mlu1V2V+Ff5poOglMsupVbYAtCWiWyL69Ymo/fs2z4LM",
-	"4sWjm9HP5ktz23joVamfF+ChL8Ejn8tlvx8eWroU+UV4aG3Qt1FgS0O/D1Rtu12gAW1FBbZSrC1//sH+",
-	"gdOma6pBX3XZesl4I16at0pz1twOAUL+hfmLF+OEZVgo30EheQKPNWwafMG+l0CQ+cvBfu2q7i3ybJHn",
-	"e0CedpQxc31toNmc1O38Wr5c/tGgVAhNf2P4RH8uEG74SwhljDJPVjBqM1ZXufS+jQktgQV7fXUNFjJU",
-	"2M6+7erv3wktzKwr+fpSVrJZvmvVnK+s477UhP/69GJZuqsRV7ZsY4t3W7z7NtZly9jSBhKZXrRCBszy",
-	"e8/qf/xbPWs7XX0zeOthdnvbWsO5pMfu6maXLiht02WD1Fu1Nxdnf7Skiwj1wkTf7phd34GpX7pVxIpR",
-	"6ii7H2AdyZsq17adchJzo8YKJ6wqjZm9tps01tyO6Dx+ePy/AAAA///3q3H4yKIAAA==",
+	"H4sIAAAAAAAC/+x9/XIbOZLnqyB4FzHtPRZFUiRFaWLiQivJbUXblleSe3ev6WihqrJEjItAGUBJ5roV",
+	"sQ9y93LzJBf4qG8UPyT5oyfY/7TFQgGZicQvE4lE1pdOwBYJo0Cl6Bx96SSY4wVI4PqvgC0WjP6OE/I7",
+	"S4Cq/+M4fkkgDvXzEETASSIJo52jzvWcCPT+8hx9SoEvUd4V4vApBSEFknMsEY5jpAaN4TPCUnLipxIE",
+	"whwQoUGchhAiQpGcA+IgEkYF9GZ0Rm9ubmYUx/HvkR7f/tDpdogaXI/Z6XYoXkDnqFO063Q7IpjDAhuC",
+	"I5zGsnPUiXAsQLVP4xj7MXSOJE+h25HLRL0vJCf0tvPw0HUJAT5rOtsEccIWC4wEKAlICFFMhEQsQpog",
+	"xCECDjQAgSRDtisUcbbIeE5jqTk+w8G8/hIiAmH7o+K1ixhHarBPqX7MotJDUSLCXyIRYzEH0UMvGZ9R",
+	"+IzVJHTLVCgCbgKWUsmXN0ikvumLReYJfJZABWFU3JhRjvKJsT1Yof+taLlnu7PtZvTf56Bml4iShhBB",
+	"/yJRKiBElFkG7kkcIx8y2kItEiNyox9EGMnWGyK4A4qIpnmp9Qo+JzEJiIyXhYqlgtBb1WRGbwzRNwVB",
+	"Pa1YVkKdI61V3SZPLcpXlUVFATdSr+gZ9MryWVpJ316rbkEavVFvWY1BmIZPUDOrXi3zsamOKQhSI5ne",
+	"cgXiIFNOIXza7D9+1mMJvDnrV4B5MEcBJxI4wXoOTxiVmFCBGAU1VQvGAYlqw25tmmBBAhYzKnpIq0Ct",
+	"uVaBGZVpEgMKTP9qhWCKWAIcS8a7CDcUR01nmYg7HKdKGa7nkL+HAkxn1FeNl9kkRyyO2b0awEhF6Dn+",
+	"A11k7/yB3gDWFDzmvz9m9A8v/6/0z0f8p/pS6krljeoZvcEymIOwCGMlEmQzIudWCK10oRv4dINQe19E",
+	"IPiU4litoRXdmb5u5bq+bjlgCVxZX9rWX9YX3GzRF+NOOk1fhK6jS6tNVLwpWuUVr+UxBiFWMljqC242",
+	"7avOYNG36YtapWjpK2QgEGUyU44W2mxfVina6VI9rdML2xehG/S1Tv5/qBV5PYfGmidGyxXeqQ5K/VhA",
+	"tX8x/+8QyKYtmdHsVdu+1Z6gsjlJhcNB8SxLVJAQZnS9/VAg+7ef4JMD0Ltn//YiNyHXhVgwNwNjfpsu",
+	"gMqCQQtWdVo1EZ9uSgDIFgnmIGY0mEPwMZ8PM4Ns7eLvZRTpZaUw18xxNoBAIk0SxiVapLEkSWzfc0hR",
+	"E5CNn4tyRuuybDHFmj4i58DRzdnVjZrbm/dXTQET6hTwVff91YuqmbZCztaIsoxYdDM1UAOIBGuvRrlz",
+	"FCBUbPiARMo5S2lo1YbQ2xjQp5RJEL0ZXc132SOx6mzsELpZLFEQp0ICv3HqjXq1+5ei1V9q/OQzkFvW",
+	"Fjus9Ur5I13tkBgtWKBFKiRaqHWLIsaNh2r2S1Ib5pBIwqhiSTdy6F5hW7Vn4+KcqP1TiVP0L5iG/1Jb",
+	"XvkEKhGp2d5QHn9tW15XL7b10Izfut5Fywkp6HjR6p8p0tf4ZyEkMVuqxf4GU3wL/DxsembvKfmUAiIh",
+	"UEkiAlzNIUbFu2hhXq5TOxkPh4PxZORN/f7YGw0m2POjYN8LhuO+H0xGMMA4oz7Bcl4Q76Kr21EbbMIh",
+	"zDaxBWcR4wssO0edNCWqZZNTDoKlPIAtGMxeqbO1Pw0Df7Lve/4g6nujcBh406kfeePJaDSZHPQh6g/c",
+	"bJWIeB5u3jEWP4IjlDAWPz9blprnYe16mTxmspDqscHaAE8Oxwdjbz867Hsj8MeeP42wN42mMNyPDg+D",
+	"qL+aNUvN01gTqZ9zsgVr5dfqnGE83Q/7PvbwGMAbRYPI82E68qL9/ZE/HAwmkyByc1Yj5imcPWSN9Wb+",
+	"tL52m4yeU9MlYRRhn6VyBZwknCXAJQHdeYAT7JOYZH/j0NgJHL+rtKuR2F1LgELhcueZp2GsmXpa8IUs",
+	"Y9pDIVIgATpA0WBB/XNGBfA7oiy7jxXmszxUoTVLKCPAAm03JWsZyYjCMmX8TcWUIjggcvnsksB3mOh4",
+	"YbdEneKWg+IGQpQNrfi+8E5ilobospWlGd2Yp41M0nmxRKzz4BIa0qHa0nIiZcrstFrie4+xXGsWRk3W",
+	"dSZepQtMEQccKkmj0sPMVW6uiQqVb3InwDV24Zk0h35tQ2oLkDjEEqOPsPSMl55gwoXxTiRDWAgWECwB",
+	"LUyYIkrj4i2rrxxiLdGN59hA0DPJo8E40xO6odqUQkBOTRhOhsE0Ghx446E/9kaTwcg7hPHEmw6GGIaD",
+	"CE/xwSaaYEHgPSeuEwVAURrHS6R2RIq+UB8wKPk3har/qbqzXFwMw4VAGcj00BWhgdnWmCcJZ3ckBIFm",
+	"NPfZs9Zd45uC8lvV6sik8gUn5JIx+YAYjZd1ozOXMhFHe3uLZc/q39FkNNp3sp2h6Gu16lYr423MfBxn",
+	"Dc9P7UHKPXBQekhuaQGRFwZxroiEn8QLdD8nwdzw4oBpS4QwfBAJCzcy2h8w53hpfZHMJP7W4pJqTa6u",
+	"85ICVqbdKYxu1aKVIP2DY928wjy8xxwyoD2ZY3oLb5nSadNjU7pZWxToxoiWWiOhxOQvEUZz2zVK4vSW",
+	"6H2XsmyE3gGVjC8zL8QHLppWmVGRLoBfrXFw8mBDppQ5Cmc9ZIH8sncyo5sssDJfZ4roa92iTsJFKYqT",
+	"7x/N3vwI9ZGHAh3666IB8tCChSRadtEQeSiEGCSYlUDTRefot3530B0W00SoBINInz3VwrvDXJ8jKO1p",
+	"zJwepdOc0jd6SMeDUz1+50ONVZeYj1Ha8CQlQxwSDnrG9UKpKALcaVdlE0FbdXROrtGxMHduuggLFEJE",
+	"aDHVGUPK80DGb8xmpK6EhfYdvztvsSXmX5cQuSl6f/m6MFN2JVgdq/TeQkLmQ2hxFVprogwz6lQ61XqI",
+	"fjo9e312ffaiQnZln1NgS21C25TZBQnnGRObYIHD7y4L2YEQu6W+aqn/IGtxE+U3LavqnKlgFjTbRJvR",
+	"uT2JThhR1LMZZS45G/9wmUCx/glFQYxTAWi/N+xN8iPSIuKuOzYxQfVAva56NrQLFbxNkpiYrhJOGL/Q",
+	"T64klvqIbo9xlDAhSz87l1+3U2vVkuVBxOYy6qOfTi7Pjq/PXiDG0QD99Obi9Pzlf77QbFaPgapSmtH1",
+	"YlopmFXSyFrPqJYyNx4UoSjXnBZYrXf4DBIqyYTxkk6tktCMbqhI6yVUnfEnCuj5ANx4sgqZlYirYPvE",
+	"7WO2L8eiAcJXby4QlijQz2+BgiCi19xcsjRcv7XM3/nSsSHwzlHn7KrT7cxTX20XUr/feXCwbhz+YIPd",
+	"Wo0hQoXENChtCwq2nLt+M1K8zMAYB5wJkfc3o1mPAn2k7J5mqFn0Z2zZ/YaizFRaSMaLPC873Iyev7lC",
+	"ueGu766mwSAYjAehN5weHnqj4HDi+QeTyBtFcDjsT0b++MDfyEpussnOTihr6pJLbwuFWSy9VoXZaEve",
+	"Msk9dE4lcKrnT41szpjuiZwTijBtvvBdNvBqw84Zk3rXHsf5FruhLxdDshCIKJ4ibNjLHLAKCIpim95r",
+	"bL+P9vZUHCueMyGPpv1+f62PWdqUVtddyya2xK8LtjIvfrPgbvUo5VlBLu+6royUheAEr1iHUIR7GrPu",
+	"0ILcziXyQR9qMx24jZBY4DgGXgreMo6YnJd+QgWUmGMyEmn3RmozU44//E+u/LbO/9grclP3bBx9Lxdv",
+	"IyzxPQJ7ZRm3oPixECDXLXCuNJrgGNF04RcrvtgtkqgIPWtXs+xfqFAUItVX0BwL5ANQheEFaIWpmm0d",
+	"wcji4hlPQYZxSisTxqVWLKzIz3BaYR1pg2d/Ek0OgoOhNz0cjL3Rwcj3/P3JoTcZHE4BD6IDfxK51O6W",
+	"szRxzNgvsLxnPBQoBMqkotq0LJ9p+RAzeiuQZL0tAlirDz0dsdBM6bYOnK8Fz+Z5Zelw7jCYjuFg5A3h",
+	"cOqNYD/0phEEHozxdHQYHk4Ogsk2Y7QdHK5gGF1bd1FvxtyIMp7s7x+EffCmvjppOwj3PRwFvrcfTIaD",
+	"IIrw0N/Ijkh8u1oL1M++0gPGlZsrBImWNoe3CTKPj2fWzqIbJ7i1c8/qKq8bixxTLX+5ulfQapUdUSNv",
+	"Z0tKh9hfxaCY/n+Mk5U2muoonAfPw7b9WmVNBywhEBZJ+ZkndR66POsMVcsti6j4Jrofs80jU2qUW2C3",
+	"HCdzEuAYZS8758nEGSUE6072zt4/i6/cmJCaH9x6KvdDHk6tSydZBZ3qndoGp+S6P/oENtz3hwcwGHmj",
+	"8fTQG4WH+x6Gg4kXhgEejw8Hh/uwwQlsC+blMNdwiksLyOkXr4IwdxRyJYQVySpVCMMx5otTEqi3MF+u",
+	"8xYdafbHtR6efhhdJfoHw8UaTcXkLFgI8aZ4oxs3+K0eYRCZb+l4VWX/wxs4N2JPgZeMrxK8QEjSxapV",
+	"fKKchuZwL1NqtCFGnMXuobJdde4o94oYeOf929Ozl+dvz0473c7JxZt376/POt3O27Prf7+4/OX87c+d",
+	"bufq+uLy+OezzocyxUXbVpJ/IdQBO79q9Sh5RP/47/+bzJdCmQMil//47//XLi8Hze9e/efV+cnx6063",
+	"8/riZ/2vCp2l58/uXD4FCXEwDg5GfW9/dND3RngSeTiYHnp4eHDQHxweRpPpcBOQvwMaMkcy1jurzJks",
+	"L53u7xVbADphPGFcr5ouOqdBzz0OF06I+dU8QIxnYRwXumy62u6G/eGoNxhsDPq5K+sMd1jpZIBRsFFT",
+	"0voyW+vglg/Ktj6Ra6b+1dPh4tjHwcctc0ryI7iEswDClIPNoAgwNb8Jtf98x4TM5mdG8zCVjmeXDxrb",
+	"8kPEgvXsr72ALdTfe3eDPaaR5fecy9+Zb9JZXNq06Xmj23syXLLInKkJk+8QppDHd8vy3WQRtd1eO8mS",
+	"4NXgdjAj0pDps7ZSQr8JO0CoFkKW8G36LZCgPPGoes6cRQUVpACHiHEbOLGdNA6r5RyoDklaujAvaOhl",
+	"V1/0m6kAe0lA2SKxpBJ/Rtj8ZJvULj330HF2bExE3mtxD0e9M6MLez3H3qhQj/T5ErIzlShFE/pYpnKn",
+	"xt6v0//WW/T8IjWaM3WjpnTUYy7FmLboJ+jd9lB5b/nClUpf9NyttK1n9gsioesDjwl94YwNk1vlqVxB",
+	"wMGRGXGRWLsr5ljNsdDtco9Hvd04ObO6KllZHXwlcH2lQIDsIlD3G8svzWiAOScgFIq8enN84l29Oh6O",
+	"J3oILNU6t8b9Pzwdhvau8gdzwKHJmYSMQKVIcAe8dh1hgT+/Bnor552j4XjS7SwIzf4eTOrS6XbuOZFw",
+	"QeOlSSzeIA/asZYrC/Wb53VywGHBgdvmkWh5UkFjewVf38DvNpKybKkAjPSr2dKeYxqKOf5oHDGblGw6",
+	"Rb5e6k10IMImMoQ9dDyjGRG/lvsN5ipubVfpu4ur6yKTLuu/m5/H13vn8Hezr06pvTOoruqUe4RgzpTk",
+	"cfCxclrqMxYDpg4lqNno3I65LKhrd/Pu/Nc2N8O14brLXA+DLnk2UdWaljyXQmUGvX7PfbqyHaFiM0qz",
+	"y+6WFrGGZJyQcv852b+VuLEsPHzY8OxhtbwdYeaUk3ccIvK5Krk9piGG0IhjIXkaKJzJvYi9u8Hjpao3",
+	"txARSrZwq/SmGoX5az33tvs4T6RvK3zxLfay7qwMTaDJ9GqLNoRqzUPFNGYWWqfTsiBIdZpDKZpnJBNj",
+	"IW3Tv6pjEwi7NhUq7Jr8KJKZgGx7dXx6qrdWJpND/UtneJyfnXY+NCbXkl/M23nYuhtRyJi6rsMU5Pqg",
+	"yOeYCAgzk2DYfsfJAvMl+gWWiFArZq0zqIiIbJZgZSle4cGXCFaHNMCL2MldPu9VyovbjQpysSvYonxX",
+	"e5M4g4AZrb1dME2oBBqWHEyszWCppoF6cqsIwlQJVGdc3it1mOMkAWpvd2AkgIrCm4IogkCKboUcYyjM",
+	"oSdZJFibBswB51gllkLCoiXzSjPxGgtp1HidCtenDWW7SELLWd8NDQ5XDf/WGY/JZ1LMGZcmHpP5H/o1",
+	"d49BDFj9u2VBZtqr9gOghWZoVWZbvamEl0qmwCrQKQ6MowWmqfp3bbG9v754c3x9fqKW2fHb9yZ+0aCn",
+	"uHp0nuUYnIcrQCxvXuQkIKa8PiNeTa29HM4xFQsi1YQbwRCBzqgkcmmCHDN6eXZ1fXl+cn1+8fYIvbTC",
+	"y48V3lyhqywtQhZZNbrCzYLY60IXw/M3V7VU/UwE+pmT67pNSj6Wd6gayddMDsnrxTBerTkjMq/ZzFxl",
+	"M1YpiJFY4PkIS/TTu19e5Ogzow09zgWYS/2viPSgV6Gk0rvtIodPdH663Y0GY+6YgPASlKE6DlpuZOQr",
+	"4TYloU60UtRmLyOu30bYvL5JgnMT+MsrsQkKTUvngOI2dmpLsm1FuHXkwzYeSCVAv7kHkr/W4oFUPZtH",
+	"+2y1rhzqUDtmaMvjbuZo1bW5h2rtiMjMdyNPq7eN5c1HuNKM/ro2tuimD5nXkWRNo1FMh1puii6xynYU",
+	"nT6WmF5hwwQyccc85ClSTRw25xG1bUuZUkyRr1yCzMM0CXHKgicQKJ2uvyxYJPXFhhBicgd8mec7JJyF",
+	"aSBbmAaN7y0Z9t7l8VtkWhhnE5R5qLiVR8ZLEWq/qJwTqxYJ8Iz38vlZzxzBYBrOaOV3y42bxu9o8hDa",
+	"Gb0f3Oi1HXz8qn/P1kdpUg1M6JwIIppYgvUlBNHLFJulcag0Ow+cmgnGuQQbqqzHzWOZG9vPMlC3otEa",
+	"5Kws6PKxxxY2srGR29RqngIOX4OUwFffUzquqpvGw0CLmTKdk2kxzO6yy+HRpkWVEhaJK93zbZGESBYg",
+	"sk7z2m0VGlSeYYRJDNWI4sB1J8iG445dl+TIQp21QDPgq3PL9a47Cwx8SiGFyqlEiCV4ilj3XfNMuJvl",
+	"WdtLv4YS9TKK9dvFuKUcPTyKpsEIvP4Qxt4I7089fxyNvNEwhCmM/XAfjzZKAMJCnnHuWo3KgQD1KI82",
+	"Z5Fc9VIxOXY+q/RVBGmm6QiN+/vrbo+5yaj0luBlzHCobzISie61Gs7xHZi005YovfPW+5qrYs0pYpzc",
+	"EnVwUH61lpboD4PQ3z/wRhhG3ghGQ8+f4gNv/xAHE//AH+LBYJOZybb3LsKu7LNa2u566kZD1/JIk3Dl",
+	"8mDR6mnfZDk0Lm+X1ka3eV2nxH31qcK6DEDK6lte5GWOtgbCf1OLbb0aiq1AENUAlFSQhQizxBFTc4rj",
+	"eEbrYhZGw81K0pXtNGYRk5CtQ27KZMfu4wiRikTHo1wR6wpXT9nftBgThwOwxXlT5iyXOdKSZ/fWIXGg",
+	"48G0Px2E07E3PtifeKPhYOzhCPvewcFwNBmMRyOY9Ddag5nc3lNJYtc6lJtIHeFIAtdxRTurah5TDj30",
+	"tqJSdiLzgyUitCWcUcy19rWAmwkVcrA6FRIOgYyXVbNVO20d9odjrz/w9vvXg+FRv3/U7/+fxy3lRt2i",
+	"qkJtuADfcebHsDgFiUksmjfvimo6x3nt7idU2TmmyxJ4Fp2UKoNXrsoTWtrJmTXMuA3pEiXSBVCZ461s",
+	"1tBRbLn8qrnKOfPynDNVMRpTM0A2XA4S9qTAFkY2rr6WWlX7TxilEGRXLNTJho8FaE0KEUulS9Pz9HUH",
+	"ifoiVX4lWC8+UmxZtCpmlLZTqPJVJFrgJVrqPUWUchOwLoVlSIRCyEdqxCU4cVEuJJZpy92hV9fX75Bp",
+	"gAIWQrHdWSnKpoWURMZO2ejIdLc+iyJd6B1YtWtzjoTOZbZFoSwLjpuM7xJRkrWT2NVVzyGRmp0k5QkT",
+	"5lBC30Aj/2X0EJ1HekR93ZLcAS0dE+gqs7OOjoMd+TGmH2cdW3omXwA2QoBjoc8wsuyVlqiEXCYbKA8O",
+	"AsZDHY5g6Pzs+iW6fHmC9g+nE/Tb/genbjWERwQCGrCU41sIi9CMGsjSKGa0NiEhC9J8heZnCFnXJjlF",
+	"F2Z/df3m9QtjWyuqiIq6kQvQsJEn9YAAKrszSmQpkoCFynTKzn9qkm5Lz8pUsCRDlaa1dhHUAdmsiBx1",
+	"NkTgq3LOzCWTq/xxuH9c6kzVKSpntkjEIYmxPs4iEcJ02Z3pMseEpqayvA9ZiU519SqrFKtIYdSs7OEI",
+	"zVnKBRKs0ItiQB2j4yyOTWhJMkSkyyFakzzkFEAVgadhPzjA02gAk2iEh/5hsB+O4SA6xAN/PxiH2+bs",
+	"NGa4QuFj5vdqBWqa2qd5HqjVhOzv6rw36yE2xJlwuCMsFWbgs88J4Uu3K0Ucvt4cm1NQPVop4yYjq+5C",
+	"BZgrr7mUYKWc1zSLx5pj1IykXHuVe1f4W62+0mBrX+mpvu7X8GtX+28fXKUVBAQpJ9KErsy0MpzK+bDl",
+	"VpOq36NzFy+OUzlHw1L2VEyAShRw0HzjWKAoZvd6Gxmze921aXNSNFE/ioAlZmTOYjgyqSw4XOhKmjoi",
+	"i47VX+iSxWoiSq24zqTLm13qPx3tCqjI217lP5n2ytKxj0Df87gE3B9hGcQMf6wk13LA8ULsMY6pQnbJ",
+	"AhbvqcVIQi8wLtqe7quSZWOkqkt4wmdzy/6UBaIt1G/urucRQnRVcVEPen3000UgmaJfpWerZMm0QnrF",
+	"pxU95nFMe4zf7oXsnqo4y/8m4d8ORofGSYxYkxA116bsnamiUE4uKq7S6yUVkwCo0H6CLXp6nOBgDmjY",
+	"6zcou7+/72H9WNNj3xV7r89Pzt5enXnDXr83l4u45J91VtOg1LLTbeZhdTsWI1VgzWaXJVjOtdT3iC12",
+	"oDKls2JUXp4rvfdlfv9OV6ZSJ6cP6o2ECekq+xYAubNODV9RA06gJBXzUuaxmSEIG6WwOppwnsevOq/u",
+	"c17f1mpGlb6v9Fsj7lq66JJVOTM82QTi7PwoyA6DHeVry2JYWby2jkYfTGMQ8l9ZuLQlrSRQLUMd2jdc",
+	"7P1dGH+k6GpVgGKD0nwPDw91QvUP5uNPevaH/b4jTl3qBHEzr/qkMAAhdI6/0qmR69V/xWH2WSrVZuxq",
+	"k1XXyGpK6iCsrVes9xWFNhUqUS8alt/8Pfqtk2lw54PqZE0WoHIV7koZjLfgVGaZcht/yWvEZ6dVChCy",
+	"HorNcUmhbbr8jDYU+GeQx3GcJ1C6J+NZ1GNNaujDQyNkcFWaX8R8iXVgwCmBjHvFYqEJLXRbr/9/PZn+",
+	"WgjFwUJN+0b9wY9B13uqzB7j5L8gNITt/xiEvWTcJ2EItLRYvz9VToDoVRw1jfKZi/Zb02Vy+UcfHj6U",
+	"IeZnkJWlXAKULGN2I0C5G2wEI2azKlqLDrnRoqjV1bBxLrEXTfZWfmPwofuY96uf53tcH/YrXg8fviL2",
+	"lSqcbYVzG0zRDu12aPenRbtCoSP2eLTb3oPK3IYFoYy3u0958HqB/8546xWaBka+Ud3+0D7VDjh2wPFn",
+	"Bo7mwn0CfDTq2m8HIs1PMogWXDhtDvRP5kQ98mV9o/zJHthGaRSNOXCUCdvCQ1ujBDuQ3YHsnxZkHTpd",
+	"QlkHaj4ab/e+OL4t8rDtLrb9C1rrkXhrIHYQ/HX3kA7gesJW0i2pHVz9s8DVqD/6Mai6rhyo2Isy99jk",
+	"ckQspWFvB6+lT3k9GV3LFf62c2QrFR3bfNjLSvc79/Ubu69l8T+P59qc9Z0V2Dmtf1pUrapzCVGruPgY",
+	"MN37Uq2e+ggH1VG5eSXCbg2wVQq/rkdaxaInOKMNqewQaOeH7vzQb4SYNVT6upCZPxbbgmf+orkasg2S",
+	"iifDaHfn2X4rz/Z5vdqdQ7szJztz8j0ccBdOO2zLs9uV4tHTPPStrcw3MDIFZ9/Gs38Wr36HwDsE3iHw",
+	"93Hovw4Eq8o5jwwt68/YrgFS0/0utPydHHAl/mcOLeezvrMDu9Dyt8DVrxpZlhaf6jhqcOsxWLr3pfzn",
+	"0/zWonb2SoB9tLdqKPw2/qeBoueILGdS2QHQzhHdOaLf3hG1X35+EmSW781v5366PyfV5odeVcZ5Bj/0",
+	"OfzIp/qyfx4/tCz+5/FDG5O+swI7N/TPgapt5TIcaCtqsJVhbfX3Dw/dlnoJJxz0NydaP7/nxEvzVmXN",
+	"fp3yAlVY2KSQwOArjr0CgmxZzEZlmR3y7JDnz4A87Shj1vrGQLO9U7f3pVob6cGgVAzSUeruVP8u6uWw",
+	"HBhlWtYwajuvrkpXqye0AhYMG01YyFFht/p2u79/JrQwq66i6yu9ku3iXevWfG0f97UW/Ld3L1aFu5y4",
+	"svM2dni3w7sfY1/2db2lvaLI/2bxsa3q61dru5pvIGYf9LMNiSkcq798UzRFmAMypZVlvKzXxl8J26cl",
+	"hn5kBN/gAwdb4nrtYyQ7hN8h/A7hv/N5RuvKxN8J5Pd0Ce9lew3UMxraDwZo0K18Da/UbV4BXIO6Zstl",
+	"IFiEiBQOEUSMF19Q6aFahWjMAXFYsDsITdn9/BsZ5vsruZVxGYRLzeG3swnDTb5EU3z8SLNhKkXl/O9g",
+	"eQfLO1h+blheYKI/itiE5kuwS+8HAejKxwL2dN18aIfoK5DCUed/w+871D665kBe1UFei99ntio8hXuN",
+	"9Y7i/IUxeNQnHmzvM2p6c2K6FkkZ0yufSngeVH/+E6iNP+HRsnqVzEXG4UZVsL8J2fbLFG2Qo9vojz9q",
+	"g2fUOdwZuZ2R2xm5b2nk9Loz6GtWb+sXYVaZty0IMwRovgwKF1+LONrb01++mjMhj6b9fl9jrh10/Xfg",
+	"Wysm2i8bOIrfPHTXd7sya8F2XXni6NV+pxplNSS7iFCVvKXkndeIVYazXLrWklEZKOtgI8pd1yNsP9VM",
+	"ua06K5XxqXVmLnRv05m7H1U2++H/DwAAKn47WswAAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
@@ -13,8 +13,10 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/openshift-kni/oran-o2ims/internal/constants"
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
@@ -38,12 +40,19 @@ type ResourceServerConfig struct {
 	ExternalAddress string
 }
 
+// HwInventoryNotificationHandler defines an interface over which the resource changes pushed by the hardware plugins
+// are passed on to be persisted
+type HwInventoryNotificationHandler interface {
+	HandleHwInventoryNotification(ctx context.Context, hwPluginName string, notification *api.HardwareResourceChangeNotification) error
+}
+
 // ResourceServer defines the instance attributes for an instance of a resource server
 type ResourceServer struct {
-	Config                   *ResourceServerConfig
-	Info                     api.OCloudInfo
-	Repo                     *repo.ResourcesRepository
-	SubscriptionEventHandler notifier.SubscriptionEventHandler
+	Config                         *ResourceServerConfig
+	Info                           api.OCloudInfo
+	Repo                           *repo.ResourcesRepository
+	SubscriptionEventHandler       notifier.SubscriptionEventHandler
+	HwInventoryNotificationHandler HwInventoryNotificationHandler
 }
 
 // GetAllVersions receives the API request to this endpoint, executes the request, and responds appropriately
@@ -170,11 +179,22 @@ func (r *ResourceServer) GetSubscriptions(ctx context.Context, request api.GetSu
 
 // validateSubscription validates a subscription before accepting the request
 func (r *ResourceServer) validateSubscription(ctx context.Context, request api.CreateSubscriptionRequestObject) error {
+	if request.Body.Filter != nil {
+		if _, err := search.ParseSelector(slog.Default(), *request.Body.Filter); err != nil {
+			return fmt.Errorf("invalid filter: %w", err)
+		}
+	}
+
 	if err := commonapi.ValidateCallbackURL(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback); err != nil {
 		return fmt.Errorf("invalid callback url: %w", err)
 	}
 
-	// TODO: add validation of filter and move to common if filter syntax is the same for all servers
+	if request.Body.VerifyCallback != nil && *request.Body.VerifyCallback {
+		if err := commonapi.VerifyCallback(ctx, r.SubscriptionEventHandler.GetClientFactory(), request.Body.Callback, request.Body.SigningSecret); err != nil {
+			return fmt.Errorf("callback verification failed: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -301,6 +321,114 @@ func (r *ResourceServer) DeleteSubscription(ctx context.Context, request api.Del
 	return api.DeleteSubscription200Response{}, nil
 }
 
+// GetSubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds appropriately
+func (r *ResourceServer) GetSubscriptionDeadLetters(ctx context.Context, request api.GetSubscriptionDeadLettersRequestObject) (api.GetSubscriptionDeadLettersResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.GetSubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	records, err := r.Repo.GetDeadLetterNotifications(ctx, request.SubscriptionId)
+	if err != nil {
+		return api.GetSubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	object := models2.DeadLetterQueueToModel(request.SubscriptionId, record.SuspendedUntil, records)
+	return api.GetSubscriptionDeadLetters200JSONResponse(object), nil
+}
+
+// ReplaySubscriptionDeadLetters receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ResourceServer) ReplaySubscriptionDeadLetters(ctx context.Context, request api.ReplaySubscriptionDeadLettersRequestObject) (api.ReplaySubscriptionDeadLettersResponseObject, error) {
+	_, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.ReplaySubscriptionDeadLetters404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.ReplaySubscriptionDeadLetters500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier to redeliver the notifications
+	r.SubscriptionEventHandler.ReplayDeadLetters(ctx, request.SubscriptionId)
+
+	slog.Info("Dead letter queue replay requested", "subscriptionId", request.SubscriptionId)
+	return api.ReplaySubscriptionDeadLetters202Response{}, nil
+}
+
+// RotateSubscriptionSigningSecret receives the API request to this endpoint, executes the request, and responds
+// appropriately
+func (r *ResourceServer) RotateSubscriptionSigningSecret(ctx context.Context, request api.RotateSubscriptionSigningSecretRequestObject) (api.RotateSubscriptionSigningSecretResponseObject, error) {
+	record, err := r.Repo.GetSubscription(ctx, request.SubscriptionId)
+	if err == nil {
+		secrets := notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry).
+			Rotate(request.Body.SigningSecret, time.Now())
+		record.SigningSecret = &secrets.Current
+		record.PreviousSigningSecret = secrets.Previous
+		record.PreviousSigningSecretExpiry = secrets.PreviousExpiry
+		record, err = r.Repo.UpdateSubscriptionSigningSecret(ctx, record)
+	}
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return api.RotateSubscriptionSigningSecret404ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: "requested subscription not found",
+			Status: http.StatusNotFound,
+		}, nil
+	} else if err != nil {
+		return api.RotateSubscriptionSigningSecret500ApplicationProblemPlusJSONResponse{
+			AdditionalAttributes: &map[string]string{
+				"subscriptionId": request.SubscriptionId.String(),
+			},
+			Detail: err.Error(),
+			Status: http.StatusInternalServerError,
+		}, nil
+	}
+
+	// Signal the notifier so that the next deliveries are signed with the new secret
+	r.SubscriptionEventHandler.SubscriptionEvent(ctx, &notifier.SubscriptionEvent{
+		Removed:      false,
+		Subscription: models.SubscriptionToInfo(record),
+	})
+
+	slog.Info("Subscription signing secret rotated", "subscriptionId", request.SubscriptionId)
+	return api.RotateSubscriptionSigningSecret200JSONResponse(generated.SigningSecretStatus{
+		SubscriptionId:       request.SubscriptionId,
+		PreviousSecretExpiry: record.PreviousSigningSecretExpiry,
+	}), nil
+}
+
 // GetResourcePools receives the API request to this endpoint, executes the request, and responds appropriately
 func (r *ResourceServer) GetResourcePools(ctx context.Context, request api.GetResourcePoolsRequestObject) (api.GetResourcePoolsResponseObject, error) {
 	options := commonapi.NewFieldOptions(request.Params.AllFields, request.Params.Fields, request.Params.ExcludeFields)
@@ -311,7 +439,15 @@ func (r *ResourceServer) GetResourcePools(ctx context.Context, request api.GetRe
 		}, nil
 	}
 
-	records, err := r.Repo.GetResourcePools(ctx)
+	selector, err := commonapi.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return api.GetResourcePools400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
+	records, err := r.Repo.GetResourcePools(ctx, selector)
 	if err != nil {
 		return nil, fmt.Errorf("failed to retrieve resource pools: %w", err)
 	}
@@ -359,6 +495,14 @@ func (r *ResourceServer) GetResources(ctx context.Context, request api.GetResour
 		}, nil
 	}
 
+	selector, err := commonapi.ParseFilter(request.Params.Filter)
+	if err != nil {
+		return api.GetResources400ApplicationProblemPlusJSONResponse{
+			Detail: err.Error(),
+			Status: http.StatusBadRequest,
+		}, nil
+	}
+
 	// First, find the pool
 	if exists, err := r.Repo.ResourcePoolExists(ctx, request.ResourcePoolId); err == nil && !exists {
 		return api.GetResources404ApplicationProblemPlusJSONResponse{
@@ -379,7 +523,7 @@ func (r *ResourceServer) GetResources(ctx context.Context, request api.GetResour
 	}
 
 	// Next, get the resources
-	records, err := r.Repo.GetResourcePoolResources(ctx, request.ResourcePoolId)
+	records, err := r.Repo.GetResourcePoolResources(ctx, request.ResourcePoolId, selector)
 	if err != nil {
 		return api.GetResources500ApplicationProblemPlusJSONResponse{
 			AdditionalAttributes: &map[string]string{
@@ -495,3 +639,21 @@ func (r *ResourceServer) GetResourceType(ctx context.Context, request api.GetRes
 	object := models.ResourceTypeToModel(record)
 	return api.GetResourceType200JSONResponse(object), nil
 }
+
+// HwInventoryNotification receives the resource changes pushed by a hardware plugin
+func (r *ResourceServer) HwInventoryNotification(ctx context.Context, request api.HwInventoryNotificationRequestObject) (api.HwInventoryNotificationResponseObject, error) {
+	if request.Body == nil || request.Body.Object == nil {
+		slog.Error("hardware inventory notification object is missing", "hwPluginName", request.HwPluginName)
+		return api.HwInventoryNotification400Response{}, nil
+	}
+
+	if err := r.HwInventoryNotificationHandler.HandleHwInventoryNotification(ctx, request.HwPluginName, request.Body); err != nil {
+		msg := "failed to handle hardware inventory notification"
+		slog.Error(msg, "hwPluginName", request.HwPluginName, "error", err)
+		return nil, fmt.Errorf("%s: %w", msg, err)
+	}
+
+	slog.Debug("Received hardware inventory notification", "hwPluginName", request.HwPluginName,
+		"notificationId", request.Body.NotificationId, "eventType", request.Body.NotificationEventType)
+	return api.HwInventoryNotification200Response{}, nil
+}
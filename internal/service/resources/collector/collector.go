@@ -21,12 +21,17 @@ import (
 	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/repo"
 )
 
 const pollingDelay = 1 * time.Minute
 
+// resyncDelay defines how often the data of a subscribed data source is collected, and its subscription verified, to
+// recover from missed notifications
+const resyncDelay = 5 * time.Minute
+
 // asyncEventBufferSize defines the number of buffered entries in the async event channel
 const asyncEventBufferSize = 10
 
@@ -54,6 +59,13 @@ type WatchableDataSource interface {
 	Watch(ctx context.Context) error
 }
 
+// SubscribedDataSource defines an interface of a data source whose backend pushes change notifications once its Watch
+// method has subscribed to them.  Its data is only collected at the resync interval while its subscription is active.
+type SubscribedDataSource interface {
+	WatchableDataSource
+	IsSubscribed() bool
+}
+
 // NotificationHandler defines an interface over which notifications are published.
 type NotificationHandler interface {
 	Notify(ctx context.Context, event *notifier.Notification)
@@ -71,16 +83,28 @@ type Collector struct {
 	dataSources         []DataSource
 	AsyncChangeEvents   chan *async.AsyncChangeEvent
 	loader              DataSourceLoader
+	// hwInventoryNotifications receives the resource changes pushed by the hardware plugins
+	hwInventoryNotifications chan *hwInventoryNotification
+	// lastCollected records when the data of each data source was last collected
+	lastCollected map[string]time.Time
+}
+
+// hwInventoryNotification defines a resource change received from a hardware plugin
+type hwInventoryNotification struct {
+	hwPluginName string
+	notification *api.HardwareResourceChangeNotification
 }
 
 // NewCollector creates a new collector instance
 func NewCollector(repo *repo.ResourcesRepository, notificationHandler NotificationHandler, loader DataSourceLoader, dataSources []DataSource) *Collector {
 	return &Collector{
-		repository:          repo,
-		notificationHandler: notificationHandler,
-		dataSources:         dataSources,
-		loader:              loader,
-		AsyncChangeEvents:   make(chan *async.AsyncChangeEvent, asyncEventBufferSize),
+		repository:               repo,
+		notificationHandler:      notificationHandler,
+		dataSources:              dataSources,
+		loader:                   loader,
+		AsyncChangeEvents:        make(chan *async.AsyncChangeEvent, asyncEventBufferSize),
+		hwInventoryNotifications: make(chan *hwInventoryNotification, asyncEventBufferSize),
+		lastCollected:            make(map[string]time.Time),
 	}
 }
 
@@ -110,6 +134,11 @@ func (c *Collector) Run(ctx context.Context) error {
 			if err := c.handleAsyncEvent(ctx, event); err != nil {
 				slog.Error("failed to handle async change", "event", event, "error", err)
 			}
+		case n := <-c.hwInventoryNotifications:
+			if err := c.handleHwInventoryNotification(ctx, n); err != nil {
+				slog.Error("failed to handle hardware inventory notification",
+					"hwPluginName", n.hwPluginName, "notificationId", n.notification.NotificationId, "error", err)
+			}
 		case <-time.After(pollingDelay):
 			c.execute(ctx)
 		case <-ctx.Done():
@@ -207,6 +236,11 @@ func (c *Collector) watchForChanges(ctx context.Context) error {
 			continue
 		}
 
+		if _, ok := d.(SubscribedDataSource); ok {
+			// Subscriptions are (re)established by the main loop since the backend may not be reachable yet
+			continue
+		}
+
 		if err := d.(WatchableDataSource).Watch(ctx); err != nil {
 			slog.Error("failed to watch for changes", "source", d.Name(), "error", err)
 			return fmt.Errorf("failed to watch for changes: %w", err)
@@ -231,17 +265,38 @@ func (c *Collector) execute(ctx context.Context) {
 			continue
 		}
 
-		d.IncrGenerationID()
-		slog.Debug("collecting data from data source", "source", d.Name(), "generationID", d.GetGenerationID())
-		if err := c.executeOneDataSource(ctx, rd); err != nil {
-			slog.Warn("failed to collect data from data source", "source", d.Name(), "error", err)
-		} else {
-			slog.Debug("collected data from data source", "source", d.Name())
+		if sd, ok := d.(SubscribedDataSource); ok {
+			resyncDue := time.Since(c.lastCollected[d.Name()]) >= resyncDelay
+			if !sd.IsSubscribed() || resyncDue {
+				// Subscribing again at each resync verifies that the backend has not lost the subscription
+				if err := sd.Watch(ctx); err != nil {
+					// Keep polling the data source until the subscription succeeds
+					slog.Warn("failed to subscribe to data source", "source", d.Name(), "error", err)
+				}
+			}
+
+			if sd.IsSubscribed() && !resyncDue {
+				continue
+			}
 		}
+
+		c.collectDataSource(ctx, rd)
 	}
 	slog.Debug("collector loop complete", "sources", len(c.dataSources))
 }
 
+// collectDataSource collects all data from a data source under a new generation id
+func (c *Collector) collectDataSource(ctx context.Context, dataSource ResourceDataSource) {
+	dataSource.IncrGenerationID()
+	slog.Debug("collecting data from data source", "source", dataSource.Name(), "generationID", dataSource.GetGenerationID())
+	if err := c.executeOneDataSource(ctx, dataSource); err != nil {
+		slog.Warn("failed to collect data from data source", "source", dataSource.Name(), "error", err)
+	} else {
+		c.lastCollected[dataSource.Name()] = time.Now()
+		slog.Debug("collected data from data source", "source", dataSource.Name())
+	}
+}
+
 func (c *Collector) purgeStaleResources(ctx context.Context, dataSource DataSource) (int, error) {
 	resources, err := c.repository.FindStaleResources(ctx, dataSource.GetID(), dataSource.GetGenerationID())
 	if err != nil {
@@ -420,39 +475,55 @@ func (c *Collector) collectResources(ctx context.Context, dataSource ResourceDat
 		}
 		seen[resourceType.ResourceTypeID] = true
 
-		dataChangeEvent, err := svcutils.PersistObjectWithChangeEvent(
-			ctx, c.repository.Db, *resourceType, resourceType.ResourceTypeID, nil, func(object interface{}) any {
-				record, _ := object.(models.ResourceType)
-				return models.ResourceTypeToModel(&record)
-			})
-		if err != nil {
-			return nil, fmt.Errorf("failed to persist resource type': %w", err)
-		}
-
-		if dataChangeEvent != nil {
-			c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+		if err := c.persistResourceType(ctx, resourceType); err != nil {
+			return nil, err
 		}
 	}
 
 	// Loop over the set of resources and insert (or update) as needed
 	for _, resource := range resources {
-		dataChangeEvent, err := svcutils.PersistObjectWithChangeEvent(
-			ctx, c.repository.Db, resource, resource.ResourceID, &resource.ResourcePoolID, func(object interface{}) any {
-				record, _ := object.(models.Resource)
-				return models.ResourceToModel(&record, nil)
-			})
-		if err != nil {
-			return nil, fmt.Errorf("failed to persist resource: %w", err)
-		}
-
-		if dataChangeEvent != nil {
-			c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+		if err := c.persistResource(ctx, &resource); err != nil {
+			return nil, err
 		}
 	}
 
 	return resources, nil
 }
 
+// persistResourceType persists a ResourceType object and signals any change event to the notification processor
+func (c *Collector) persistResourceType(ctx context.Context, resourceType *models.ResourceType) error {
+	dataChangeEvent, err := svcutils.PersistObjectWithChangeEvent(
+		ctx, c.repository.Db, *resourceType, resourceType.ResourceTypeID, nil, func(object interface{}) any {
+			record, _ := object.(models.ResourceType)
+			return models.ResourceTypeToModel(&record)
+		})
+	if err != nil {
+		return fmt.Errorf("failed to persist resource type': %w", err)
+	}
+
+	if dataChangeEvent != nil {
+		c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+	}
+	return nil
+}
+
+// persistResource persists a Resource object and signals any change event to the notification processor
+func (c *Collector) persistResource(ctx context.Context, resource *models.Resource) error {
+	dataChangeEvent, err := svcutils.PersistObjectWithChangeEvent(
+		ctx, c.repository.Db, *resource, resource.ResourceID, &resource.ResourcePoolID, func(object interface{}) any {
+			record, _ := object.(models.Resource)
+			return models.ResourceToModel(&record, nil)
+		})
+	if err != nil {
+		return fmt.Errorf("failed to persist resource: %w", err)
+	}
+
+	if dataChangeEvent != nil {
+		c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+	}
+	return nil
+}
+
 // collectResourcePools collects ResourcePool objects from the data source, persists them to the database,
 // and signals any change events to the notification processor.
 func (c *Collector) collectResourcePools(ctx context.Context, dataSource ResourceDataSource) ([]models.ResourcePool, error) {
@@ -581,3 +652,78 @@ func (c *Collector) handleAsyncDeploymentManagerEvent(ctx context.Context, deplo
 
 	return nil
 }
+
+// HandleHwInventoryNotification queues a resource change pushed by a hardware plugin so that it is processed by the
+// main loop along with the data collected from the other data sources.
+func (c *Collector) HandleHwInventoryNotification(ctx context.Context, hwPluginName string,
+	notification *api.HardwareResourceChangeNotification) error {
+	select {
+	case c.hwInventoryNotifications <- &hwInventoryNotification{hwPluginName: hwPluginName, notification: notification}:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("failed to queue hardware inventory notification: %w", ctx.Err())
+	}
+}
+
+// handleHwInventoryNotification applies a resource change pushed by a hardware plugin.  A resource in a pool that is
+// not yet known triggers a full collection of the data source since pools are not part of the notifications.
+func (c *Collector) handleHwInventoryNotification(ctx context.Context, n *hwInventoryNotification) error {
+	dataSource, ok := c.findDataSource(hwPluginDataSourceName(n.hwPluginName)).(*HwPluginDataSource)
+	if !ok {
+		return fmt.Errorf("no data source found for hardware plugin '%s'", n.hwPluginName)
+	}
+
+	resource, err := dataSource.ConvertResourceChange(n.notification)
+	if err != nil {
+		return err
+	}
+
+	if n.notification.NotificationEventType == api.HardwareResourceDelete {
+		return c.deleteResource(ctx, resource.ResourceID)
+	}
+
+	exists, err := c.repository.ResourcePoolExists(ctx, resource.ResourcePoolID)
+	if err != nil {
+		return fmt.Errorf("failed to check resource pool '%s': %w", resource.ResourcePoolID, err)
+	}
+	if !exists {
+		slog.Info("Resource pool not yet known; collecting data source", "source", dataSource.Name(),
+			"resourcePoolID", resource.ResourcePoolID)
+		c.collectDataSource(ctx, dataSource)
+		return nil
+	}
+
+	resourceType, err := dataSource.MakeResourceType(resource)
+	if err != nil {
+		return fmt.Errorf("failed to make resource type from '%v': %w", resource, err)
+	}
+
+	if err := c.persistResourceType(ctx, resourceType); err != nil {
+		return err
+	}
+	return c.persistResource(ctx, resource)
+}
+
+// deleteResource deletes a Resource object and signals the change event to the notification processor
+func (c *Collector) deleteResource(ctx context.Context, resourceID uuid.UUID) error {
+	record, err := c.repository.GetResource(ctx, resourceID)
+	if errors.Is(err, svcutils.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get resource '%s': %w", resourceID, err)
+	}
+
+	dataChangeEvent, err := svcutils.DeleteObjectWithChangeEvent(ctx, c.repository.Db, *record, record.ResourceID,
+		&record.ResourcePoolID, func(object interface{}) any {
+			r, _ := object.(models.Resource)
+			return models.ResourceToModel(&r, nil)
+		})
+	if err != nil {
+		return fmt.Errorf("failed to delete resource '%s': %w", resourceID, err)
+	}
+
+	if dataChangeEvent != nil {
+		c.notificationHandler.Notify(ctx, models.DataChangeEventToNotification(dataChangeEvent))
+	}
+	return nil
+}
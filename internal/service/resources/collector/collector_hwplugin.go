@@ -8,9 +8,11 @@ package collector
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"log/slog"
 	"net/http"
+	"net/url"
 
 	"github.com/google/uuid"
 
@@ -20,11 +22,18 @@ import (
 	inventoryclient "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/api/client/inventory"
 	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/async"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+	api "github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
 )
 
 // Interface compile enforcement
 var _ ResourceDataSource = (*HwPluginDataSource)(nil)
+var _ SubscribedDataSource = (*HwPluginDataSource)(nil)
+
+// HwInventoryNotificationPath is the internal path on which the resource server receives the resource changes pushed
+// by the hardware plugins.  The name of the hardware plugin is appended to it.
+const HwInventoryNotificationPath = "/internal/v1/hardware-inventory"
 
 // HwPluginDataSource defines an instance of a data source collector that interacts with the ACM search-api
 type HwPluginDataSource struct {
@@ -34,16 +43,14 @@ type HwPluginDataSource struct {
 	cloudID       uuid.UUID
 	globalCloudID uuid.UUID
 	client        *inventoryclient.InventoryClient
+	// subscriptionID identifies the inventory subscription created on the hardware plugin
+	subscriptionID *uuid.UUID
 }
 
-// Defines the UUID namespace values used to generated name based UUID values for inventory objects.
-// These values are selected arbitrarily.
-// TODO: move to somewhere more generic
-const (
-	ResourcePoolUUIDNamespace = "daee6434-767a-485d-816b-bc04c21f1acf"
-	ResourceUUIDNamespace     = "8ef67482-1215-470d-9a43-eb02af4a7c05"
-	ResourceTypeUUIDNamespace = "255c4b4c-84a8-4c95-95ba-217e1688a03d"
-)
+// Defines the UUID namespace value used to generated name based UUID values for resource pools.  The values used for
+// resources and resource types are shared with the alarms server through the common hardware package.
+// This value is selected arbitrarily.
+const ResourcePoolUUIDNamespace = "daee6434-767a-485d-816b-bc04c21f1acf"
 
 const (
 	vendorExtension           = "vendor"
@@ -80,7 +87,12 @@ func NewHwPluginDataSource(ctx context.Context, hubClient rtclient.Client, hwplu
 
 // Name returns the name of this data source
 func (d *HwPluginDataSource) Name() string {
-	return fmt.Sprintf("HardwarePlugin(name=%s)", d.hwplugin.Name)
+	return hwPluginDataSourceName(d.hwplugin.Name)
+}
+
+// hwPluginDataSourceName returns the name of the data source of a hardware plugin
+func hwPluginDataSourceName(hwPluginName string) string {
+	return fmt.Sprintf("HardwarePlugin(name=%s)", hwPluginName)
 }
 
 // GetID returns the data source ID for this data source
@@ -112,12 +124,84 @@ func (d *HwPluginDataSource) IncrGenerationID() int {
 	return d.generationID
 }
 
+// Watch subscribes to the resource changes of the hardware plugin.  A subscription previously created for the same
+// callback is reused so that restarts of the resource server do not accumulate subscriptions on the plugin.  The data
+// source is no longer considered subscribed if the subscription cannot be found or created.
+func (d *HwPluginDataSource) Watch(ctx context.Context) error {
+	d.subscriptionID = nil
+
+	callback := fmt.Sprintf("%s%s/%s", ctlrutils.GetServiceURL(ctlrutils.InventoryResourceServerName),
+		HwInventoryNotificationPath, url.PathEscape(d.hwplugin.Name))
+
+	existing, err := d.client.GetSubscriptionsWithResponse(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get subscriptions: %w", err)
+	}
+
+	if existing.StatusCode() != http.StatusOK || existing.JSON200 == nil {
+		return fmt.Errorf("failed to get subscriptions, status: %d", existing.StatusCode())
+	}
+
+	for _, subscription := range *existing.JSON200 {
+		if subscription.Callback == callback && subscription.SubscriptionId != nil {
+			d.subscriptionID = subscription.SubscriptionId
+			slog.Info("Reusing hardware plugin subscription", "name", d.hwplugin.Name, "subscriptionID", *d.subscriptionID)
+			return nil
+		}
+	}
+
+	consumerSubscriptionID := d.dataSourceID
+	result, err := d.client.CreateSubscriptionWithResponse(ctx, inventoryclient.Subscription{
+		Callback:               callback,
+		ConsumerSubscriptionId: &consumerSubscriptionID,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create subscription: %w", err)
+	}
+
+	if result.StatusCode() != http.StatusCreated || result.JSON201 == nil || result.JSON201.SubscriptionId == nil {
+		return fmt.Errorf("failed to create subscription, status: %d", result.StatusCode())
+	}
+
+	d.subscriptionID = result.JSON201.SubscriptionId
+	slog.Info("Created hardware plugin subscription", "name", d.hwplugin.Name, "subscriptionID", *d.subscriptionID)
+	return nil
+}
+
+// IsSubscribed returns true if the data source is subscribed to the resource changes of the hardware plugin
+func (d *HwPluginDataSource) IsSubscribed() bool {
+	return d.subscriptionID != nil
+}
+
+// ConvertResourceChange converts the resource carried by a change notification of the hardware plugin
+func (d *HwPluginDataSource) ConvertResourceChange(notification *api.HardwareResourceChangeNotification) (*models.Resource, error) {
+	if notification.Object == nil {
+		return nil, fmt.Errorf("notification '%s' has no object", notification.NotificationId)
+	}
+
+	data, err := json.Marshal(*notification.Object)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal notification object: %w", err)
+	}
+
+	var resource inventoryclient.ResourceInfo
+	if err := json.Unmarshal(data, &resource); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal notification object: %w", err)
+	}
+
+	if resource.ResourceId == "" {
+		return nil, fmt.Errorf("notification '%s' has no resource id", notification.NotificationId)
+	}
+
+	return d.convertResource(&resource), nil
+}
+
 // MakeResourceType creates an instance of a ResourceType from a Resource object.
 func (d *HwPluginDataSource) MakeResourceType(resource *models.Resource) (*models.ResourceType, error) {
 	vendor := resource.Extensions[vendorExtension].(string)
 	model := resource.Extensions[modelExtension].(string)
 	name := fmt.Sprintf("%s/%s", vendor, model)
-	resourceTypeID := ctlrutils.MakeUUIDFromNames(ResourceTypeUUIDNamespace, d.cloudID, d.hwplugin.Name, name)
+	resourceTypeID := hardware.MakeResourceTypeID(d.cloudID, d.hwplugin.Name, vendor, model)
 
 	// TODO: finish filling this in with data
 	result := models.ResourceType{
@@ -199,17 +283,10 @@ func (d *HwPluginDataSource) convertResourcePool(pool *inventoryclient.ResourceP
 	}
 }
 
-// MakeResourceID calculates a UUID value to be used as the ResourceID.  The cloudID and hwPluginRef are added to the node
-// id value to ensure we get a globally unique value.
-func MakeResourceID(cloudID uuid.UUID, hwPluginRef, hwMgrNodeID string) uuid.UUID {
-	return ctlrutils.MakeUUIDFromNames(ResourceUUIDNamespace, cloudID, hwPluginRef, hwMgrNodeID)
-}
-
 func (d *HwPluginDataSource) convertResource(resource *inventoryclient.ResourceInfo) *models.Resource {
 	// The resourceID computed here must
-	resourceID := MakeResourceID(d.cloudID, d.hwplugin.Name, resource.ResourceId)
-	name := fmt.Sprintf("%s/%s", resource.Vendor, resource.Model)
-	resourceTypeID := ctlrutils.MakeUUIDFromNames(ResourceTypeUUIDNamespace, d.cloudID, d.hwplugin.Name, name)
+	resourceID := hardware.MakeResourceID(d.cloudID, d.hwplugin.Name, resource.ResourceId)
+	resourceTypeID := hardware.MakeResourceTypeID(d.cloudID, d.hwplugin.Name, resource.Vendor, resource.Model)
 
 	result := &models.Resource{
 		ResourceID:     resourceID,
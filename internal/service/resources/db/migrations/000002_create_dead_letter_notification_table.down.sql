@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS dead_letter_notification;
+
+ALTER TABLE subscription DROP COLUMN IF EXISTS suspended_until;
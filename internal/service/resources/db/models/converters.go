@@ -16,6 +16,7 @@ import (
 	commonapi "github.com/openshift-kni/oran-o2ims/internal/service/common/api"
 	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
 	models2 "github.com/openshift-kni/oran-o2ims/internal/service/common/db/models"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/notifier"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/api/generated"
 )
@@ -23,11 +24,7 @@ import (
 // managementInterfaceID defines the unique identifier for the IMS O2 interface
 const managementInterfaceID = "O2IMS"
 
-// dummyDefinitionID is a temporary value used to render a placeholder alarm definition.  To be replaced when we support
-// retrieving alarm dictionaries from the hardware manager
-const dummyDefinitionID = "46a600ca-bb4d-470d-b8ca-0f95989518e4"
-
-// dummyVersion is a temporary value to used to render a placeholder alarm dictionary/definition.
+// dummyVersion is a temporary value used to render the alarm dictionary schema version.
 const dummyVersion = "0.0.0"
 
 // DeploymentManagerToModel converts a DB tuple to an API Model
@@ -73,26 +70,11 @@ func DeploymentManagerToModel(record *DeploymentManager, options *commonapi.Fiel
 
 // ResourceTypeToModel converts a DB tuple to an API Model
 func ResourceTypeToModel(record *ResourceType) generated.ResourceType {
-	alarmAdditionalFields := map[string]interface{}{}
 	object := generated.ResourceType{
-		// TODO: fill-in a proper alarm dictionary when we can get it from the hardware manager
 		AlarmDictionary: &common.AlarmDictionary{
-			AlarmDefinition: []common.AlarmDefinition{
-				{
-					AlarmAdditionalFields: &alarmAdditionalFields,
-					AlarmChangeType:       common.ADDED,
-					AlarmDefinitionId:     uuid.MustParse(dummyDefinitionID),
-					AlarmDescription:      "Sample alarm definition",
-					AlarmLastChange:       dummyVersion,
-					AlarmName:             "Sample alarm name",
-					ClearingType:          common.MANUAL,
-					ManagementInterfaceId: []common.AlarmDefinitionManagementInterfaceId{managementInterfaceID},
-					PkNotificationField:   []string{"alarmDefinitionID"},
-					ProposedRepairActions: "Please consult the documentation",
-				},
-			},
+			AlarmDefinition:              makeHardwareAlarmDefinitions(record.ResourceTypeID),
 			AlarmDictionarySchemaVersion: dummyVersion,
-			AlarmDictionaryVersion:       dummyVersion,
+			AlarmDictionaryVersion:       hardware.AlarmDefinitionVersion,
 			EntityType:                   fmt.Sprintf("%s/%s", record.Model, record.Version),
 			ManagementInterfaceId:        []common.AlarmDictionaryManagementInterfaceId{"O2IMS"},
 			PkNotificationField:          []string{"alarmDictionaryID"},
@@ -134,6 +116,7 @@ func SubscriptionFromModel(object *generated.Subscription) *models2.Subscription
 		Filter:                 object.Filter,
 		Callback:               object.Callback,
 		EventCursor:            0,
+		SigningSecret:          object.SigningSecret,
 	}
 
 	return &record
@@ -208,6 +191,22 @@ func getEventType(before, after map[string]interface{}) int {
 	}
 }
 
+// getObjectType returns the API type name of the objects stored in a table
+func getObjectType(objectType string) string {
+	switch objectType {
+	case ResourceType{}.TableName():
+		return "ResourceType"
+	case Resource{}.TableName():
+		return "Resource"
+	case ResourcePool{}.TableName():
+		return "ResourcePool"
+	case DeploymentManager{}.TableName():
+		return "DeploymentManager"
+	default:
+		return objectType
+	}
+}
+
 // getObjectReference builds a partial URL referencing the API path location of the object
 func getObjectReference(objectType string, objectID uuid.UUID, parentID *uuid.UUID) *string {
 	var value string
@@ -253,6 +252,7 @@ func DataChangeEventToNotification(record *models2.DataChangeEvent) *notifier.No
 		NotificationID: *record.DataChangeID,
 		SequenceID:     *record.SequenceID,
 		Payload:        DataChangeEventToModel(record),
+		ObjectType:     getObjectType(record.ObjectType),
 	}
 }
 
@@ -264,5 +264,8 @@ func SubscriptionToInfo(record *models2.Subscription) *notifier.SubscriptionInfo
 		Callback:               record.Callback,
 		Filter:                 record.Filter,
 		EventCursor:            record.EventCursor,
+		SuspendedUntil:         record.SuspendedUntil,
+		SigningSecrets:         notifier.NewSigningSecrets(record.SigningSecret, record.PreviousSigningSecret, record.PreviousSigningSecretExpiry),
+		Criteria:               models2.NewSubscriptionCriteria(record),
 	}
 }
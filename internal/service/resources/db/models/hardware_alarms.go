@@ -0,0 +1,38 @@
+/*
+SPDX-FileCopyrightText: Red Hat
+
+SPDX-License-Identifier: Apache-2.0
+*/
+
+package models
+
+import (
+	"github.com/google/uuid"
+
+	ctlrutils "github.com/openshift-kni/oran-o2ims/internal/controllers/utils"
+	common "github.com/openshift-kni/oran-o2ims/internal/service/common/api/generated"
+	"github.com/openshift-kni/oran-o2ims/internal/service/common/hardware"
+)
+
+// makeHardwareAlarmDefinitions renders the hardware fault catalog as the alarm definitions of a resource type
+func makeHardwareAlarmDefinitions(resourceTypeID uuid.UUID) []common.AlarmDefinition {
+	definitions := make([]common.AlarmDefinition, 0, len(hardware.AlarmDefinitions))
+	for _, definition := range hardware.AlarmDefinitions {
+		alarmAdditionalFields := map[string]interface{}{
+			ctlrutils.AlarmDefinitionSeverityField: definition.Severity,
+		}
+		definitions = append(definitions, common.AlarmDefinition{
+			AlarmAdditionalFields: &alarmAdditionalFields,
+			AlarmChangeType:       common.ADDED,
+			AlarmDefinitionId:     hardware.MakeAlarmDefinitionID(resourceTypeID, definition.Name, definition.Severity),
+			AlarmDescription:      definition.Description,
+			AlarmLastChange:       hardware.AlarmDefinitionVersion,
+			AlarmName:             definition.Name,
+			ClearingType:          definition.ClearingType,
+			ManagementInterfaceId: []common.AlarmDefinitionManagementInterfaceId{managementInterfaceID},
+			PkNotificationField:   []string{"alarmDefinitionID"},
+			ProposedRepairActions: definition.ProposedRepairActions,
+		})
+	}
+	return definitions
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -43,3 +44,15 @@ func (r Resource) PrimaryKey() string { return "resource_id" }
 
 // OnConflict returns the column or constraint to be used in the UPSERT operation
 func (r Resource) OnConflict() string { return "" }
+
+// resourceSearchColumns maps the attributes of the API object to the columns storing their values
+var resourceSearchColumns = map[string]search.Column{
+	"resourceId":     {Name: "resource_id", Type: search.ColumnTypeUUID},
+	"resourceTypeId": {Name: "resource_type_id", Type: search.ColumnTypeUUID},
+	"resourcePoolId": {Name: "resource_pool_id", Type: search.ColumnTypeUUID},
+	"description":    {Name: "description", Type: search.ColumnTypeText},
+	"extensions":     {Name: "extensions", Type: search.ColumnTypeJSON},
+}
+
+// SearchColumns returns the columns that can be used to filter the API objects in the database
+func (r Resource) SearchColumns() map[string]search.Column { return resourceSearchColumns }
@@ -11,6 +11,7 @@ import (
 
 	"github.com/google/uuid"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/db"
 )
 
@@ -42,3 +43,17 @@ func (r ResourcePool) PrimaryKey() string { return "resource_pool_id" }
 
 // OnConflict returns the column or constraint to be used in the UPSERT operation
 func (r ResourcePool) OnConflict() string { return "" }
+
+// resourcePoolSearchColumns maps the attributes of the API object to the columns storing their values
+var resourcePoolSearchColumns = map[string]search.Column{
+	"resourcePoolId":   {Name: "resource_pool_id", Type: search.ColumnTypeUUID},
+	"globalLocationId": {Name: "global_location_id", Type: search.ColumnTypeUUID},
+	"oCloudId":         {Name: "o_cloud_id", Type: search.ColumnTypeUUID},
+	"name":             {Name: "name", Type: search.ColumnTypeText},
+	"description":      {Name: "description", Type: search.ColumnTypeText},
+	"location":         {Name: "location", Type: search.ColumnTypeText},
+	"extensions":       {Name: "extensions", Type: search.ColumnTypeJSON},
+}
+
+// SearchColumns returns the columns that can be used to filter the API objects in the database
+func (r ResourcePool) SearchColumns() map[string]search.Column { return resourcePoolSearchColumns }
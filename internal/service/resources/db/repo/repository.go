@@ -13,6 +13,7 @@ import (
 	"github.com/stephenafamo/bob"
 	"github.com/stephenafamo/bob/dialect/psql"
 
+	"github.com/openshift-kni/oran-o2ims/internal/search"
 	"github.com/openshift-kni/oran-o2ims/internal/service/common/repo"
 	svcutils "github.com/openshift-kni/oran-o2ims/internal/service/common/utils"
 	"github.com/openshift-kni/oran-o2ims/internal/service/resources/db/models"
@@ -53,9 +54,10 @@ func (r *ResourcesRepository) GetResourceType(ctx context.Context, id uuid.UUID)
 	return svcutils.Find[models.ResourceType](ctx, r.Db, id)
 }
 
-// GetResourcePools retrieves all ResourcePool tuples or returns an empty array if no tuples are found
-func (r *ResourcesRepository) GetResourcePools(ctx context.Context) ([]models.ResourcePool, error) {
-	return svcutils.FindAll[models.ResourcePool](ctx, r.Db)
+// GetResourcePools retrieves all ResourcePool tuples matching the selector or returns an empty array if no tuples are
+// found
+func (r *ResourcesRepository) GetResourcePools(ctx context.Context, selector *search.Selector) ([]models.ResourcePool, error) {
+	return svcutils.SearchWithSelector[models.ResourcePool](ctx, r.Db, nil, selector)
 }
 
 // GetResourcePool retrieves a specific ResourcePool tuple or returns ErrNotFound if not found
@@ -78,10 +80,11 @@ func (r *ResourcesRepository) UpdateResourcePool(ctx context.Context, resourcePo
 	return svcutils.Update[models.ResourcePool](ctx, r.Db, resourcePool.ResourcePoolID, *resourcePool)
 }
 
-// GetResourcePoolResources retrieves all Resource tuples for a specific ResourcePool returns an empty array if not found
-func (r *ResourcesRepository) GetResourcePoolResources(ctx context.Context, id uuid.UUID) ([]models.Resource, error) {
+// GetResourcePoolResources retrieves all Resource tuples for a specific ResourcePool matching the selector or returns
+// an empty array if not found
+func (r *ResourcesRepository) GetResourcePoolResources(ctx context.Context, id uuid.UUID, selector *search.Selector) ([]models.Resource, error) {
 	e := psql.Quote("resource_pool_id").EQ(psql.Arg(id))
-	return svcutils.Search[models.Resource](ctx, r.Db, e)
+	return svcutils.SearchWithSelector[models.Resource](ctx, r.Db, e, selector)
 }
 
 // GetResource retrieves a specific Resource tuple or returns ErrNotFound if not found
@@ -129,7 +129,10 @@ func Serve(config *api.ResourceServerConfig) error {
 
 	// Create the notifier with our resource-specific subscription and notification providers.
 	notificationsProvider := repo2.NewNotificationStorageProvider(commonRepository)
-	subscriptionsProvider := repo2.NewSubscriptionStorageProvider(commonRepository, collector.NewNotificationTransformer())
+	subscriptionsProvider, err := repo2.NewSubscriptionStorageProvider(commonRepository, collector.NewNotificationTransformer())
+	if err != nil {
+		return fmt.Errorf("failed to create subscription provider: %w", err)
+	}
 	clientFactory := notifier.NewClientFactory(oauthConfig, constants.DefaultBackendTokenFile)
 	resourceNotifier := notifier.NewNotifier(subscriptionsProvider, notificationsProvider, clientFactory)
 
@@ -153,7 +156,8 @@ func Serve(config *api.ResourceServerConfig) error {
 			OCloudId:      cloudID,
 			ServiceUri:    config.ExternalAddress,
 		},
-		SubscriptionEventHandler: resourceNotifier,
+		SubscriptionEventHandler:       resourceNotifier,
+		HwInventoryNotificationHandler: resourceCollector,
 	}
 
 	serverStrictHandler := generated.NewStrictHandlerWithOptions(&server, nil,
@@ -126,3 +126,24 @@ func IsInputError(err error) bool {
 
 	return errors.As(err, &inputErr)
 }
+
+// PlacementError reports that the free resources cannot satisfy the placement constraints of a node group
+type PlacementError struct {
+	err error
+}
+
+func (p *PlacementError) Error() string {
+	return p.err.Error()
+}
+
+func NewPlacementError(format string, args ...interface{}) *PlacementError {
+	return &PlacementError{
+		err: fmt.Errorf(format, args...),
+	}
+}
+
+func IsPlacementError(err error) bool {
+	var placementErr *PlacementError
+
+	return errors.As(err, &placementErr)
+}
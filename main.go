@@ -16,6 +16,8 @@ import (
 
 	hwpluginscmd "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/cmd"
 	metal3plugincmd "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/metal3/cmd"
+	redfishplugincmd "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/redfish/cmd"
+	simulatorplugincmd "github.com/openshift-kni/oran-o2ims/hwmgr-plugins/simulator/cmd"
 	alarmscmd "github.com/openshift-kni/oran-o2ims/internal/service/alarms/cmd"
 	artifactscmd "github.com/openshift-kni/oran-o2ims/internal/service/artifacts/cmd"
 	clustercmd "github.com/openshift-kni/oran-o2ims/internal/service/cluster/cmd"
@@ -41,6 +43,8 @@ func main() {
 		AddCommand(cmd.Version).
 		AddCommand(hwpluginscmd.Start).
 		AddCommand(metal3plugincmd.Start).
+		AddCommand(simulatorplugincmd.Start).
+		AddCommand(redfishplugincmd.Start).
 		AddCommand(alarmscmd.GetAlarmRootCmd).             // TODO: all server should have same root to share init info
 		AddCommand(clustercmd.GetClusterRootCmd).          // TODO: all server should have same root to share init info
 		AddCommand(inventorycmd.GetResourcesRootCmd).      // TODO: all server should have same root to share init info